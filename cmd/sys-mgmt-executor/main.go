@@ -23,9 +23,29 @@ import (
 )
 
 func main() {
-	result, err := json.Marshal(executor.Execute(os.Args, func(arg ...string) ([]byte, error) {
+	dockerExecutor := func(arg ...string) ([]byte, error) {
 		return exec.Command("docker", arg...).CombinedOutput()
-	}))
+	}
+
+	// logs are streamed to stdout as they're read rather than returned as a result
+	if len(os.Args) > 2 && os.Args[2] == executor.Logs {
+		arguments, errorMessage := executor.LogsArguments(os.Args, dockerExecutor)
+		if errorMessage != "" {
+			fmt.Fprint(os.Stderr, errorMessage)
+			os.Exit(1)
+		}
+
+		cmd := exec.Command("docker", arguments...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stdout
+		if err := cmd.Run(); err != nil {
+			fmt.Fprint(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	result, err := json.Marshal(executor.Execute(os.Args, dockerExecutor))
 	switch {
 	case err != nil:
 		fmt.Printf("json.Marshal error: %s", err.Error())
@@ -0,0 +1,208 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+const bearerPrefix = "Bearer "
+
+// elevatedAllCommands marks every deviceCommand of the profile as elevated when it follows the label prefix
+const elevatedAllCommands = "*"
+
+// the public keys verifying the bearer tokens, loaded again when the configured path changes
+var (
+	publicKeysMutex sync.Mutex
+	publicKeysPath  string
+	publicKeys      []interface{}
+)
+
+// authorizeCommand verifies the caller may issue the command to the device. Only set (PUT) commands of the
+// deviceCommands marked as elevated in the device profile are restricted; they require the configured elevated role to
+// be present in the caller's verified claims.
+func authorizeCommand(
+	originalRequest *http.Request,
+	device contract.Device,
+	command contract.Command,
+	access config.CommandAccessInfo) error {
+
+	if !access.Enabled || originalRequest.Method != http.MethodPut {
+		return nil
+	}
+
+	if !isElevatedCommand(device.Profile.Labels, access.ElevatedLabelPrefix, command.Name) {
+		return nil
+	}
+
+	for _, role := range rolesFromRequest(originalRequest, access) {
+		if role == access.ElevatedRole {
+			return nil
+		}
+	}
+
+	return errors.NewErrCommandForbidden(command.Name, access.ElevatedRole)
+}
+
+// isElevatedCommand reports whether the profile labels mark the deviceCommand as elevated, with a label made of the
+// prefix followed by the deviceCommand name, or by '*' for every deviceCommand of the profile, i.e.
+// "elevated-command:ValveOpen".
+func isElevatedCommand(labels []string, prefix string, name string) bool {
+	if prefix == "" {
+		return false
+	}
+	for _, label := range labels {
+		if !strings.HasPrefix(label, prefix) {
+			continue
+		}
+		marked := strings.TrimSpace(strings.TrimPrefix(label, prefix))
+		if marked == elevatedAllCommands || (marked != "" && marked == name) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadPublicKeys returns the RSA and ECDSA public keys of the PEM file, given as public keys or certificates. The keys
+// are cached until another path is configured.
+func loadPublicKeys(path string) ([]interface{}, error) {
+	publicKeysMutex.Lock()
+	defer publicKeysMutex.Unlock()
+
+	if path == publicKeysPath && publicKeys != nil {
+		return publicKeys, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the public keys verifying the bearer tokens: %s", err.Error())
+	}
+	var keys []interface{}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		var key interface{}
+		switch block.Type {
+		case "PUBLIC KEY":
+			if key, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				return nil, fmt.Errorf("invalid public key in %s: %s", path, err.Error())
+			}
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate in %s: %s", path, err.Error())
+			}
+			key = cert.PublicKey
+		default:
+			continue
+		}
+		switch key.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no RSA or ECDSA public key in %s", path)
+	}
+
+	publicKeysPath = path
+	publicKeys = keys
+	return keys, nil
+}
+
+// verifyToken returns the claims of the token once its signature is verified by one of the keys, and its expiry and
+// not before times are checked
+func verifyToken(token string, keys []interface{}) (jwt.MapClaims, bool) {
+	for _, key := range keys {
+		tokenClaims := jwt.MapClaims{}
+		parsed, err := jwt.ParseWithClaims(token, tokenClaims, func(t *jwt.Token) (interface{}, error) {
+			switch key.(type) {
+			case *rsa.PublicKey:
+				if _, ok := t.Method.(*jwt.SigningMethodRSA); ok {
+					return key, nil
+				}
+			case *ecdsa.PublicKey:
+				if _, ok := t.Method.(*jwt.SigningMethodECDSA); ok {
+					return key, nil
+				}
+			}
+			return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+		})
+		if err == nil && parsed.Valid {
+			return tokenClaims, true
+		}
+	}
+	return nil, false
+}
+
+// claimsFromRequest returns the claims of the request's bearer token once verified with the public keys of the PEM
+// file, or nil when the token is missing or fails the verification. Tokens aren't trusted when no key is configured,
+// nor when the service verifies the trusted headers of the API gateway, the only claims then being theirs.
+func claimsFromRequest(r *http.Request, publicKeyPath string) jwt.MapClaims {
+	if publicKeyPath == "" || claims.TrustedOnly(r.Context()) {
+		return nil
+	}
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return nil
+	}
+
+	keys, err := loadPublicKeys(publicKeyPath)
+	if err != nil {
+		return nil
+	}
+	tokenClaims, ok := verifyToken(strings.TrimPrefix(header, bearerPrefix), keys)
+	if !ok {
+		return nil
+	}
+	return tokenClaims
+}
+
+// rolesFromRequest returns the roles forwarded by the API gateway in the trusted headers, otherwise the roles held in
+// the claim of the request's verified bearer token. The claim may contain a single role or an array of roles. An empty
+// slice is returned when the token is missing or fails the verification.
+func rolesFromRequest(r *http.Request, access config.CommandAccessInfo) []string {
+	if c, ok := claims.FromContext(r.Context()); ok {
+		return c.Roles
+	}
+	switch value := claimsFromRequest(r, access.PublicKeyPath)[access.RoleClaim].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var roles []string
+		for _, v := range value {
+			if role, ok := v.(string); ok {
+				roles = append(roles, role)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
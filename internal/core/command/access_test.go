@@ -0,0 +1,176 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSigningKey signs the tokens of the tests, its public key being written to the PEM file of writeTestPublicKeys
+var testSigningKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+// writeTestPublicKeys writes the PEM file of the public key of testSigningKey along with another ECDSA key, and returns
+// its path
+func writeTestPublicKeys(t *testing.T) string {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var data []byte
+	for _, key := range []interface{}{&ecKey.PublicKey, &testSigningKey.PublicKey} {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		require.NoError(t, err)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+	path := filepath.Join(t.TempDir(), "jwt-public-keys.pem")
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+	return path
+}
+
+// newTestToken returns a token holding the claims signed with testSigningKey
+func newTestToken(t *testing.T, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(testSigningKey)
+	require.NoError(t, err)
+	return token
+}
+
+func newAccessRequest(t *testing.T, method string, roles interface{}) *http.Request {
+	req, err := http.NewRequest(method, "http://localhost/command", nil)
+	require.NoError(t, err)
+	if roles != nil {
+		req.Header.Set("Authorization", bearerPrefix+newTestToken(t, jwt.MapClaims{"roles": roles}))
+	}
+	return req
+}
+
+// newForgedAccessRequest returns a request holding a token which isn't signed with testSigningKey
+func newForgedAccessRequest(t *testing.T, roles interface{}, signingMethod jwt.SigningMethod, key interface{}) *http.Request {
+	req, err := http.NewRequest(http.MethodPut, "http://localhost/command", nil)
+	require.NoError(t, err)
+	token, err := jwt.NewWithClaims(signingMethod, jwt.MapClaims{"roles": roles}).SignedString(key)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", bearerPrefix+token)
+	return req
+}
+
+// newTrustedAccessRequest returns a request holding the roles forwarded by the API gateway besides the token roles
+func newTrustedAccessRequest(t *testing.T, method string, roles interface{}, trustedRoles ...string) *http.Request {
+	req := newAccessRequest(t, method, roles)
+	return req.WithContext(claims.NewContext(req.Context(), claims.Claims{Subject: "alice", Roles: trustedRoles}))
+}
+
+// newUntrustedAccessRequest returns the token request as passed on by the middleware of a service verifying the trusted
+// headers, the request holding none
+func newUntrustedAccessRequest(t *testing.T, method string, roles interface{}) *http.Request {
+	keyPath := filepath.Join(t.TempDir(), "trusted-headers.key")
+	require.NoError(t, ioutil.WriteFile(keyPath, []byte("secret"), 0600))
+
+	var passed *http.Request
+	middleware := claims.NewMiddleware(claims.TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, logger.NewMockClient())
+	middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		passed = r
+	})).ServeHTTP(httptest.NewRecorder(), newAccessRequest(t, method, roles))
+	require.NotNil(t, passed)
+	return passed
+}
+
+func TestAuthorizeCommand(t *testing.T) {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	access := config.CommandAccessInfo{
+		Enabled:             true,
+		RoleClaim:           "roles",
+		ElevatedRole:        "admin",
+		ElevatedLabelPrefix: "elevated-command:",
+		PublicKeyPath:       writeTestPublicKeys(t),
+	}
+	withoutKeys := access
+	withoutKeys.PublicKeyPath = ""
+	valve := contract.Device{Profile: contract.DeviceProfile{Labels: []string{"valve", "elevated-command:ValveOpen", "elevated-command:ValveClose"}}}
+	pump := contract.Device{Profile: contract.DeviceProfile{Labels: []string{"elevated-command:*"}}}
+	open := contract.Command{Name: "ValveOpen"}
+	status := contract.Command{Name: "ValveStatus"}
+
+	tests := []struct {
+		name      string
+		request   *http.Request
+		device    contract.Device
+		command   contract.Command
+		access    config.CommandAccessInfo
+		forbidden bool
+	}{
+		{"disabled", newAccessRequest(t, http.MethodPut, "operator"), valve, open, config.CommandAccessInfo{}, false},
+		{"read elevated command as operator", newAccessRequest(t, http.MethodGet, "operator"), valve, open, access, false},
+		{"set elevated command as operator", newAccessRequest(t, http.MethodPut, "operator"), valve, open, access, true},
+		{"set elevated command without token", newAccessRequest(t, http.MethodPut, nil), valve, open, access, true},
+		{"set elevated command as admin", newAccessRequest(t, http.MethodPut, "admin"), valve, open, access, false},
+		{"set elevated command with role array", newAccessRequest(t, http.MethodPut, []string{"operator", "admin"}), valve, open, access, false},
+		{"set unrestricted command as operator", newAccessRequest(t, http.MethodPut, "operator"), valve, status, access, false},
+		{"set wildcard command as operator", newAccessRequest(t, http.MethodPut, "operator"), pump, status, access, true},
+		{"set elevated command with unsigned token", newForgedAccessRequest(t, "admin", jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType), valve, open, access, true},
+		{"set elevated command with HMAC token", newForgedAccessRequest(t, "admin", jwt.SigningMethodHS256, []byte("secret")), valve, open, access, true},
+		{"set elevated command with token of unknown key", newForgedAccessRequest(t, "admin", jwt.SigningMethodRS256, otherKey), valve, open, access, true},
+		{"set elevated command as admin without keys", newAccessRequest(t, http.MethodPut, "admin"), valve, open, withoutKeys, true},
+		{"set elevated command as trusted admin", newTrustedAccessRequest(t, http.MethodPut, nil, "operator", "admin"), valve, open, access, false},
+		{"trusted roles override token roles", newTrustedAccessRequest(t, http.MethodPut, "admin", "operator"), valve, open, access, true},
+		{"token ignored when verifying trusted headers", newUntrustedAccessRequest(t, http.MethodPut, "admin"), valve, open, access, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeCommand(tt.request, tt.device, tt.command, tt.access)
+			if tt.forbidden {
+				require.Error(t, err)
+				assert.IsType(t, errors.ErrCommandForbidden{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadPublicKeys(t *testing.T) {
+	keys, err := loadPublicKeys(writeTestPublicKeys(t))
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, ioutil.WriteFile(empty, []byte("no key"), 0600))
+	_, err = loadPublicKeys(empty)
+	assert.Error(t, err)
+
+	_, err = loadPublicKeys(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+type auditContextKey struct{}
+
+// auditCommands returns a middleware recording every command issued through the routes it is used on when the
+// command audit is enabled. Requests to routes without a command in their path are not recorded.
+func auditCommands(dic *di.Container) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writable := commandContainer.ConfigurationFrom(dic.Get).Writable
+			audit := writable.CommandAudit
+			vars := mux.Vars(r)
+			if !audit.Enabled || (vars[COMMANDID] == "" && vars[COMMANDNAME] == "") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			begin := time.Now()
+			record := &models.CommandAudit{
+				Timestamp:     begin.UnixNano() / int64(time.Millisecond),
+				CorrelationId: correlation.FromContext(r.Context()),
+				User:          userFromRequest(r, audit.UserClaim, writable.CommandAccess.PublicKeyPath),
+				DeviceId:      vars[ID],
+				DeviceName:    vars[NAME],
+				CommandId:     vars[COMMANDID],
+				CommandName:   vars[COMMANDNAME],
+				Method:        r.Method,
+			}
+			if r.Body != nil {
+				body, err := ioutil.ReadAll(r.Body)
+				_ = r.Body.Close()
+				if err == nil && len(body) > 0 {
+					hash := sha256.Sum256(body)
+					record.ParamsHash = hex.EncodeToString(hash[:])
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			}
+
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), auditContextKey{}, record)))
+			record.StatusCode = recorder.statusCode
+			record.Latency = time.Since(begin).Milliseconds()
+
+			if _, err := container.DBClientFrom(dic.Get).AddCommandAudit(*record); err != nil {
+				bootstrapContainer.LoggingClientFrom(dic.Get).Error(
+					fmt.Sprintf("failed to record the audit of command %s%s issued to device %s%s: %s",
+						record.CommandId, record.CommandName, record.DeviceId, record.DeviceName, err.Error()))
+			}
+		})
+	}
+}
+
+// auditTarget completes the audit record of the request with the device and command the request was resolved to
+func auditTarget(ctx context.Context, device contract.Device, command contract.Command) {
+	if record, ok := ctx.Value(auditContextKey{}).(*models.CommandAudit); ok {
+		record.DeviceId, record.DeviceName = device.Id, device.Name
+		record.CommandId, record.CommandName = command.Id, command.Name
+	}
+}
+
+// userFromRequest returns the subject forwarded by the API gateway in the trusted headers, otherwise the caller held in
+// the claim of the request's bearer token verified with the public keys of the PEM file, or an empty string when the
+// token is missing, fails the verification or doesn't hold the claim
+func userFromRequest(r *http.Request, claim string, publicKeyPath string) string {
+	if c, ok := claims.FromContext(r.Context()); ok {
+		return c.Subject
+	}
+	user, _ := claimsFromRequest(r, publicKeyPath)[claim].(string)
+	return user
+}
+
+// statusRecorder records the status code written to the response
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (sr *statusRecorder) WriteHeader(statusCode int) {
+	sr.statusCode = statusCode
+	sr.ResponseWriter.WriteHeader(statusCode)
+}
+
+// purgeCommandAudits deletes the audit records older than the configured retention every purge interval until ctx is
+// cancelled. The retention is read on every purge, so changes apply without a restart. Nothing is purged when no
+// purge interval is configured.
+func purgeCommandAudits(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) error {
+	audit := commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAudit
+	if audit.PurgeInterval == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(audit.PurgeInterval)
+	if err != nil {
+		return fmt.Errorf("invalid CommandAudit PurgeInterval '%s': %s", audit.PurgeInterval, err.Error())
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			retention := commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAudit.Retention
+			if retention == "" {
+				continue
+			}
+			age, err := time.ParseDuration(retention)
+			if err != nil {
+				lc.Error(fmt.Sprintf("invalid CommandAudit Retention '%s': %s", retention, err.Error()))
+				continue
+			}
+			deleted, err := container.DBClientFrom(dic.Get).DeleteCommandAuditsOld(age.Milliseconds())
+			if err != nil && err != db.ErrNotFound {
+				lc.Error(fmt.Sprintf("failed to purge command audit records: %s", err.Error()))
+				continue
+			}
+			lc.Debug(fmt.Sprintf("purged %d command audit record(s) older than %s", deleted, retention))
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// auditDBClient records the audits added through it, the other DBClient methods aren't used by the audit middleware
+type auditDBClient struct {
+	interfaces.DBClient
+	audits []models.CommandAudit
+}
+
+func (c *auditDBClient) AddCommandAudit(a models.CommandAudit) (string, error) {
+	c.audits = append(c.audits, a)
+	return "", nil
+}
+
+func newAuditRouter(dbClient *auditDBClient, audit config.CommandAuditInfo, publicKeyPath string, statusCode int) *mux.Router {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Writable: config.WritableInfo{
+				CommandAudit:  audit,
+				CommandAccess: config.CommandAccessInfo{PublicKeyPath: publicKeyPath},
+			}}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+
+	handler := func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(statusCode) }
+	r := mux.NewRouter()
+	d := r.PathPrefix("/" + DEVICE).Subrouter()
+	d.Use(auditCommands(dic))
+	d.HandleFunc("/{"+ID+"}", handler)
+	d.HandleFunc("/{"+ID+"}/"+COMMAND+"/{"+COMMANDID+"}", handler)
+	return r
+}
+
+func TestAuditCommands(t *testing.T) {
+	token := newTestToken(t, jwt.MapClaims{"sub": "operator"})
+	publicKeyPath := writeTestPublicKeys(t)
+	audit := config.CommandAuditInfo{Enabled: true, UserClaim: "sub"}
+
+	t.Run("command recorded", func(t *testing.T) {
+		dbClient := &auditDBClient{}
+		req := httptest.NewRequest(http.MethodPut, "/device/d1/command/c1", strings.NewReader(`{"speed":"10"}`))
+		req.Header.Set("Authorization", bearerPrefix+token)
+		newAuditRouter(dbClient, audit, publicKeyPath, http.StatusForbidden).ServeHTTP(httptest.NewRecorder(), req)
+
+		require.Len(t, dbClient.audits, 1)
+		recorded := dbClient.audits[0]
+		assert.Equal(t, "operator", recorded.User)
+		assert.Equal(t, "d1", recorded.DeviceId)
+		assert.Equal(t, "c1", recorded.CommandId)
+		assert.Equal(t, http.MethodPut, recorded.Method)
+		assert.Equal(t, http.StatusForbidden, recorded.StatusCode)
+		assert.Len(t, recorded.ParamsHash, 64)
+		assert.NotZero(t, recorded.Timestamp)
+	})
+
+	t.Run("request without command not recorded", func(t *testing.T) {
+		dbClient := &auditDBClient{}
+		req := httptest.NewRequest(http.MethodGet, "/device/d1", nil)
+		newAuditRouter(dbClient, audit, publicKeyPath, http.StatusOK).ServeHTTP(httptest.NewRecorder(), req)
+		assert.Empty(t, dbClient.audits)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		dbClient := &auditDBClient{}
+		req := httptest.NewRequest(http.MethodGet, "/device/d1/command/c1", nil)
+		newAuditRouter(dbClient, config.CommandAuditInfo{}, publicKeyPath, http.StatusOK).ServeHTTP(httptest.NewRecorder(), req)
+		assert.Empty(t, dbClient.audits)
+	})
+}
@@ -0,0 +1,93 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/concurrency"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// QueueWaitHeader is the response header telling how long a command waited for the other commands of the device to
+// complete, e.g. '1.5s', when the commands executed concurrently on a device are limited.
+const QueueWaitHeader = "X-Command-Queue-Wait"
+
+type limiterContextKey struct{}
+
+// newCommandLimiter creates the limiter of the commands executed concurrently on each device from the configuration
+func newCommandLimiter(defaultLimit int, profileLimits map[string]int, maxQueued int, queueTimeout string) (*concurrency.Limiter, error) {
+	var timeout time.Duration
+	if queueTimeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(queueTimeout); err != nil || timeout < 0 {
+			return nil, fmt.Errorf("invalid CommandConcurrency.QueueTimeout '%s'", queueTimeout)
+		}
+	}
+	return concurrency.NewLimiter(defaultLimit, profileLimits, maxQueued, timeout), nil
+}
+
+// limitCommands returns a middleware handing the command concurrency limiter to the commands of the requests, when
+// the commands executed concurrently on a device are limited.
+func limitCommands(dic *di.Container) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limiter := container.CommandLimiterFrom(dic.Get)
+			if limiter == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(withCommandLimiter(r.Context(), limiter)))
+		})
+	}
+}
+
+// withCommandLimiter returns the context limiting the commands executed with it, unchanged when the limiter is nil
+func withCommandLimiter(ctx context.Context, limiter *concurrency.Limiter) context.Context {
+	if limiter == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, limiterContextKey{}, limiter)
+}
+
+// acquireDevice waits for the device to execute the command when the commands are limited, and returns the function
+// to call once the command completed along with how long the command waited, negative when not limited.
+func acquireDevice(ctx context.Context, device contract.Device) (func(), time.Duration, error) {
+	limiter, ok := ctx.Value(limiterContextKey{}).(*concurrency.Limiter)
+	if !ok {
+		return func() {}, -1, nil
+	}
+
+	release, waited, err := limiter.Acquire(ctx, device.Name, device.Profile.Name)
+	switch err {
+	case nil:
+		return release, waited, nil
+	case concurrency.ErrQueueFull:
+		return nil, waited, errors.NewErrCommandQueueFull(device.Name)
+	case concurrency.ErrTimeout:
+		return nil, waited, errors.NewErrCommandQueueTimeout(device.Name, waited)
+	default:
+		return nil, waited, err
+	}
+}
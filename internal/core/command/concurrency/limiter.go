@@ -0,0 +1,144 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrQueueFull is returned when the device already has the maximum number of commands waiting
+	ErrQueueFull = errors.New("too many commands waiting for the device")
+	// ErrTimeout is returned when the device didn't become available before the queue timeout
+	ErrTimeout = errors.New("timed out waiting for the device")
+)
+
+// Limiter limits the number of commands executed concurrently on each device, so that the commands issued to a
+// device which handles one request at a time, e.g. a serial device, aren't interleaved. Commands over the limit wait
+// in the order they arrived for a command of the device to complete.
+type Limiter struct {
+	defaultLimit  int
+	profileLimits map[string]int
+	maxQueued     int
+	timeout       time.Duration
+	now           func() time.Time
+
+	mutex   sync.Mutex
+	devices map[string]*device
+}
+
+// device is the slots of a device, taken by its commands being executed, and the number of its commands waiting for
+// a slot
+type device struct {
+	slots  chan struct{}
+	queued int
+}
+
+// NewLimiter creates a Limiter executing up to defaultLimit commands concurrently on a device, or the limit of its
+// profile in profileLimits. A limit of zero or less doesn't limit the commands. Up to maxQueued commands wait for
+// each device, unbounded when zero, for up to the timeout, until the command is cancelled when zero.
+func NewLimiter(defaultLimit int, profileLimits map[string]int, maxQueued int, timeout time.Duration) *Limiter {
+	return &Limiter{
+		defaultLimit:  defaultLimit,
+		profileLimits: profileLimits,
+		maxQueued:     maxQueued,
+		timeout:       timeout,
+		now:           time.Now,
+		devices:       make(map[string]*device),
+	}
+}
+
+// Limit returns the number of commands executed concurrently on the devices of the profile, zero when unlimited
+func (l *Limiter) Limit(profile string) int {
+	limit, ok := l.profileLimits[profile]
+	if !ok {
+		limit = l.defaultLimit
+	}
+	if limit < 0 {
+		return 0
+	}
+	return limit
+}
+
+// Acquire waits for the device to execute one more command, and returns the function to call once the command
+// completed along with how long the command waited. The limit of the device is that of its profile when the device is
+// first seen, changes apply after a restart.
+func (l *Limiter) Acquire(ctx context.Context, deviceName string, profile string) (func(), time.Duration, error) {
+	l.mutex.Lock()
+	d, ok := l.devices[deviceName]
+	if !ok {
+		limit := l.Limit(profile)
+		if limit == 0 {
+			l.mutex.Unlock()
+			return func() {}, 0, nil
+		}
+		d = &device{slots: make(chan struct{}, limit)}
+		l.devices[deviceName] = d
+	}
+
+	select {
+	case d.slots <- struct{}{}:
+		l.mutex.Unlock()
+		return d.release, 0, nil
+	default:
+	}
+
+	if l.maxQueued > 0 && d.queued >= l.maxQueued {
+		l.mutex.Unlock()
+		return nil, 0, ErrQueueFull
+	}
+	d.queued++
+	l.mutex.Unlock()
+
+	defer func() {
+		l.mutex.Lock()
+		d.queued--
+		l.mutex.Unlock()
+	}()
+
+	var expired <-chan time.Time
+	if l.timeout > 0 {
+		timer := time.NewTimer(l.timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	start := l.now()
+	select {
+	case d.slots <- struct{}{}:
+		return d.release, l.now().Sub(start), nil
+	case <-expired:
+		return nil, l.now().Sub(start), ErrTimeout
+	case <-ctx.Done():
+		return nil, l.now().Sub(start), ctx.Err()
+	}
+}
+
+// Queued returns the number of commands waiting for the device
+func (l *Limiter) Queued(deviceName string) int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if d, ok := l.devices[deviceName]; ok {
+		return d.queued
+	}
+	return 0
+}
+
+func (d *device) release() {
+	<-d.slots
+}
@@ -0,0 +1,122 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package concurrency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitQueued waits for the number of commands waiting for the device to reach the expected count
+func waitQueued(t *testing.T, l *Limiter, deviceName string, expected int) {
+	require.Eventually(t, func() bool { return l.Queued(deviceName) == expected }, time.Second, time.Millisecond)
+}
+
+func TestLimit(t *testing.T) {
+	l := NewLimiter(1, map[string]int{"Camera": 4, "Unlimited": 0, "Negative": -1}, 0, 0)
+
+	assert.Equal(t, 1, l.Limit("Serial"))
+	assert.Equal(t, 4, l.Limit("Camera"))
+	assert.Equal(t, 0, l.Limit("Unlimited"))
+	assert.Equal(t, 0, l.Limit("Negative"))
+}
+
+func TestAcquireSerializesDeviceCommands(t *testing.T) {
+	l := NewLimiter(1, nil, 0, time.Minute)
+
+	release, waited, err := l.Acquire(context.Background(), "serial", "Serial")
+	require.NoError(t, err)
+	assert.Zero(t, waited)
+
+	// Other devices aren't held up by the command of the first one.
+	other, _, err := l.Acquire(context.Background(), "other", "Serial")
+	require.NoError(t, err)
+	other()
+
+	acquired := make(chan error)
+	go func() {
+		second, _, err := l.Acquire(context.Background(), "serial", "Serial")
+		if err == nil {
+			second()
+		}
+		acquired <- err
+	}()
+	waitQueued(t, l, "serial", 1)
+
+	select {
+	case <-acquired:
+		t.Fatal("second command executed while the first one was still executing")
+	default:
+	}
+
+	release()
+	require.NoError(t, <-acquired)
+	assert.Equal(t, 0, l.Queued("serial"))
+}
+
+func TestAcquireProfileLimit(t *testing.T) {
+	l := NewLimiter(1, map[string]int{"Camera": 2, "Unlimited": 0}, 0, time.Millisecond)
+
+	_, _, err := l.Acquire(context.Background(), "camera", "Camera")
+	require.NoError(t, err)
+	_, _, err = l.Acquire(context.Background(), "camera", "Camera")
+	require.NoError(t, err)
+	_, _, err = l.Acquire(context.Background(), "camera", "Camera")
+	assert.Equal(t, ErrTimeout, err)
+
+	for i := 0; i < 10; i++ {
+		_, _, err = l.Acquire(context.Background(), "unlimited", "Unlimited")
+		require.NoError(t, err)
+	}
+}
+
+func TestAcquireTimeout(t *testing.T) {
+	l := NewLimiter(1, nil, 0, 10*time.Millisecond)
+
+	_, _, err := l.Acquire(context.Background(), "serial", "Serial")
+	require.NoError(t, err)
+
+	release, waited, err := l.Acquire(context.Background(), "serial", "Serial")
+	assert.Equal(t, ErrTimeout, err)
+	assert.Nil(t, release)
+	assert.True(t, waited >= 10*time.Millisecond, "waited %s", waited)
+	assert.Equal(t, 0, l.Queued("serial"))
+}
+
+func TestAcquireQueueFull(t *testing.T) {
+	l := NewLimiter(1, nil, 1, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, _, err := l.Acquire(ctx, "serial", "Serial")
+	require.NoError(t, err)
+
+	queued := make(chan error)
+	go func() {
+		_, _, err := l.Acquire(ctx, "serial", "Serial")
+		queued <- err
+	}()
+	waitQueued(t, l, "serial", 1)
+
+	_, _, err = l.Acquire(ctx, "serial", "Serial")
+	assert.Equal(t, ErrQueueFull, err)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-queued)
+}
@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommandLimiter(t *testing.T) {
+	_, err := newCommandLimiter(1, nil, 0, "")
+	assert.NoError(t, err)
+	_, err = newCommandLimiter(1, nil, 0, "10s")
+	assert.NoError(t, err)
+	_, err = newCommandLimiter(1, nil, 0, "soon")
+	assert.Error(t, err)
+	_, err = newCommandLimiter(1, nil, 0, "-1s")
+	assert.Error(t, err)
+}
+
+func TestAcquireDevice(t *testing.T) {
+	device := contract.Device{Name: "serial", Profile: contract.DeviceProfile{Name: "Serial"}}
+
+	release, waited, err := acquireDevice(context.Background(), device)
+	require.NoError(t, err)
+	assert.True(t, waited < 0, "commands without limiter shouldn't report a wait")
+	release()
+
+	limiter, err := newCommandLimiter(1, nil, 0, "1ms")
+	require.NoError(t, err)
+	ctx := withCommandLimiter(context.Background(), limiter)
+
+	release, waited, err = acquireDevice(ctx, device)
+	require.NoError(t, err)
+	assert.Zero(t, waited)
+
+	_, _, err = acquireDevice(ctx, device)
+	assert.IsType(t, errors.ErrCommandQueueTimeout{}, err)
+
+	release()
+	release, _, err = acquireDevice(ctx, device)
+	require.NoError(t, err)
+	release()
+
+	full, err := newCommandLimiter(1, nil, 1, "")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(withCommandLimiter(context.Background(), full))
+	defer cancel()
+	_, _, err = acquireDevice(ctx, device)
+	require.NoError(t, err)
+	go acquireDevice(ctx, device)
+	require.Eventually(t, func() bool { return full.Queued(device.Name) == 1 }, time.Second, time.Millisecond)
+	_, _, err = acquireDevice(ctx, device)
+	assert.IsType(t, errors.ErrCommandQueueFull{}, err)
+}
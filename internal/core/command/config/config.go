@@ -15,23 +15,148 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messagebus"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/shutdown"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable    WritableInfo
-	Clients     map[string]bootstrapConfig.ClientInfo
-	Databases   map[string]bootstrapConfig.Database
-	Registry    bootstrapConfig.RegistryInfo
-	Service     bootstrapConfig.ServiceInfo
-	SecretStore bootstrapConfig.SecretStoreInfo
+	Writable         WritableInfo
+	Clients          map[string]bootstrapConfig.ClientInfo
+	Databases        map[string]bootstrapConfig.Database
+	Registry         bootstrapConfig.RegistryInfo
+	Service          bootstrapConfig.ServiceInfo
+	SecretStore      bootstrapConfig.SecretStoreInfo
+	MessageQueue     MessageQueueInfo
+	CommandResponses CommandResponsesInfo
+	// DeviceMetrics reports the commands issued by device, and their failures, to core-metadata
+	DeviceMetrics devicemetrics.ReportingInfo
+	// Standalone resolves core-metadata from an endpoints file instead of the Clients configuration or the registry
+	Standalone endpoints.StandaloneInfo
+	// RequestLimits rejects the request bodies larger than the configured sizes with 413
+	RequestLimits bodylimit.LimitsInfo
+	// TrustedHeaders verifies the claims of the caller forwarded by the API gateway
+	TrustedHeaders claims.TrustedHeadersInfo
+	// Twin reconciles the devices against the desired state of their twin in core-metadata
+	Twin TwinInfo
+	// CommandConcurrency limits the commands executed concurrently on each device
+	CommandConcurrency CommandConcurrencyInfo
+
+	// Shutdown drains the requests being handled when the service is exiting, before releasing its resources
+	Shutdown shutdown.ShutdownInfo
+
+	// ConfigDrift reports the drift of the running configuration, and reloads the sections drifted in the registry
+	ConfigDrift configdrift.DriftInfo
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
 type WritableInfo struct {
 	LogLevel        string
 	InsecureSecrets bootstrapConfig.InsecureSecrets
+	CommandAccess   CommandAccessInfo
+	CommandAudit    CommandAuditInfo
+	// Compression compresses the responses of the service
+	Compression compression.ResponsesInfo
+	// FeatureFlags enable the experimental behaviors of the service by name, toggled at runtime through the registry
+	FeatureFlags map[string]bool
+}
+
+// CommandAccessInfo contains the configuration used to restrict set (PUT) commands to callers holding an elevated role.
+type CommandAccessInfo struct {
+	// Enabled turns on role enforcement for the deviceCommands marked as elevated in their device profile.
+	Enabled bool
+	// RoleClaim is the name of the JWT claim holding the caller's role(s), either a string or an array of strings.
+	RoleClaim string
+	// ElevatedRole is the role required to issue a set command of an elevated deviceCommand.
+	ElevatedRole string
+	// ElevatedLabelPrefix marks a deviceCommand as elevated with a label of its device profile made of the prefix
+	// followed by the deviceCommand name, i.e. 'elevated-command:ValveOpen', or by '*' for every deviceCommand of the
+	// profile.
+	ElevatedLabelPrefix string
+	// PublicKeyPath is the PEM file of the public keys, or certificates, verifying the signature of the callers' JWTs.
+	// The JWTs aren't trusted when empty, the roles being taken from the trusted headers of the API gateway only.
+	PublicKeyPath string
+}
+
+// CommandAuditInfo contains the configuration of the audit trail recording the commands issued to devices.
+type CommandAuditInfo struct {
+	// Enabled turns on recording every command issued through the service.
+	Enabled bool
+	// UserClaim is the name of the JWT claim identifying the caller recorded with each command.
+	UserClaim string
+	// Retention is how long the records are kept, e.g. '720h'. Records are kept forever when empty.
+	Retention string
+	// PurgeInterval is how often records older than Retention are deleted. Changes apply after a restart.
+	PurgeInterval string
+}
+
+// MessageQueueInfo provides parameters related to connecting to the message bus the device services publish their
+// command responses to.
+type MessageQueueInfo struct {
+	// Host is the hostname or IP address of the broker, if applicable.
+	Host string
+	// Port defines the port on which to access the message queue.
+	Port int
+	// Protocol indicates the protocol to use when accessing the message queue.
+	Protocol string
+	// Indicates the message queue platform being used.
+	Type string
+	// SubscribeTopic is the topic the device services publish their command responses to.
+	SubscribeTopic string
+	// Provides additional configuration properties which do not fit within the existing field.
+	// Typically the key is the name of the configuration property and the value is a string representation of the
+	// desired value for the configuration property.
+	Optional map[string]string
+	// JetStream configures the stream and the durable consumer the command responses are received from when Type is
+	// 'jetstream'.
+	JetStream messagebus.JetStreamInfo
+}
+
+// CommandResponsesInfo contains the configuration of the command responses device services publish to the message
+// bus once they accepted a command, which callers receive by request ID from the command response endpoint.
+type CommandResponsesInfo struct {
+	// Enabled turns on subscribing to the MessageQueue for command responses.
+	Enabled bool
+	// Retention is how long a received response is kept for callers asking for it after it was received, e.g. '5m'.
+	Retention string
+	// Timeout is how long a caller waits for the responses it asked for, e.g. '5m'.
+	Timeout string
+}
+
+// TwinInfo contains the configuration of the reconciliation of the devices against their twin.
+type TwinInfo struct {
+	// Enabled turns on reconciling the devices, the set commands are issued without any role so the elevated
+	// commands of CommandAccess can't be reconciled.
+	Enabled bool
+	// ReconcileInterval is how often the devices are read and set to their desired state, e.g. '30s'.
+	ReconcileInterval string
+}
+
+// CommandConcurrencyInfo contains the configuration limiting the commands executed concurrently on each device, so
+// that the commands issued to a device handling one request at a time, e.g. a serial device, aren't interleaved.
+// Changes apply after a restart.
+type CommandConcurrencyInfo struct {
+	// Enabled turns on limiting the commands executed concurrently on each device.
+	Enabled bool
+	// DefaultLimit is the number of commands executed concurrently on a device, 1 executing them one at a time.
+	// The commands aren't limited when zero.
+	DefaultLimit int
+	// ProfileLimits overrides DefaultLimit for the devices of a device profile, by profile name.
+	ProfileLimits map[string]int
+	// MaxQueued is the number of commands waiting for a device, the commands over it are rejected with 429. The
+	// waiting commands aren't limited when zero.
+	MaxQueued int
+	// QueueTimeout is how long a command waits for a device, e.g. '10s', before being rejected with 503. The
+	// commands wait until the caller cancels them when empty.
+	QueueTimeout string
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
@@ -64,6 +189,16 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 	return ok
 }
 
+// GetFeatureFlags returns the feature flags of the Writable configuration, by name.
+func (c *ConfigurationStruct) GetFeatureFlags() map[string]bool {
+	return c.Writable.FeatureFlags
+}
+
+// GetConfigDriftInfo returns the configuration of the drift detection of the running configuration.
+func (c *ConfigurationStruct) GetConfigDriftInfo() configdrift.DriftInfo {
+	return c.ConfigDrift
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -87,6 +222,21 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 	return c.Registry
 }
 
+// GetDeviceMetricsInfo returns the device activity reporting configuration and the core-metadata client configuration.
+func (c *ConfigurationStruct) GetDeviceMetricsInfo() (devicemetrics.ReportingInfo, bootstrapConfig.ClientInfo) {
+	return c.DeviceMetrics, c.Clients["Metadata"]
+}
+
+// GetStandaloneInfo returns the configuration of the endpoints file the other services are resolved from.
+func (c *ConfigurationStruct) GetStandaloneInfo() endpoints.StandaloneInfo {
+	return c.Standalone
+}
+
+// GetShutdownInfo returns the configuration of the graceful shutdown of the service.
+func (c *ConfigurationStruct) GetShutdownInfo() shutdown.ShutdownInfo {
+	return c.Shutdown
+}
+
 // GetDatabaseInfo returns a database information map.
 func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Database {
 	return c.Databases
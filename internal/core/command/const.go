@@ -22,4 +22,14 @@ const (
 	COMMANDID        = "commandid"
 	COMMANDNAME      = "commandname"
 	DEVICE           = "device"
+	AUDIT            = "audit"
+	START            = "start"
+	END              = "end"
+	LIMIT            = "limit"
+	TRANSFORM        = "transform"
+	SIMULATION       = "simulation"
+	RESPONSE         = "response"
+	REQUESTID        = "requestId"
+	RESOURCES        = "resources"
+	NAMES            = "names"
 )
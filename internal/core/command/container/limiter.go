@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/concurrency"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// CommandLimiterName contains the name of the command concurrency limiter instance in the DIC.
+var CommandLimiterName = di.TypeInstanceToName((*concurrency.Limiter)(nil))
+
+// CommandLimiterFrom helper function queries the DIC and returns the command concurrency limiter, or nil when the
+// commands executed concurrently on a device aren't limited.
+func CommandLimiterFrom(get di.Get) *concurrency.Limiter {
+	limiter, ok := get(CommandLimiterName).(*concurrency.Limiter)
+	if !ok {
+		return nil
+	}
+	return limiter
+}
@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/coredata"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// CoreDataReadingClientName contains the name of the core-data reading client implementation in the DIC.
+var CoreDataReadingClientName = di.TypeInstanceToName((*coredata.ReadingClient)(nil))
+
+// CoreDataReadingClientFrom helper function queries the DIC and returns the client implementation, or nil when
+// core-data isn't configured.
+func CoreDataReadingClientFrom(get di.Get) coredata.ReadingClient {
+	client, ok := get(CoreDataReadingClientName).(coredata.ReadingClient)
+	if !ok {
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/responses"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// CommandResponseHubName contains the name of the command response hub instance in the DIC.
+var CommandResponseHubName = di.TypeInstanceToName((*responses.Hub)(nil))
+
+// CommandResponseHubFrom helper function queries the DIC and returns the command response hub, or nil when the
+// command responses aren't received over the message bus.
+func CommandResponseHubFrom(get di.Get) *responses.Hub {
+	hub, ok := get(CommandResponseHubName).(*responses.Hub)
+	if !ok {
+		return nil
+	}
+	return hub
+}
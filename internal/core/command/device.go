@@ -19,12 +19,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/deprecation"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -38,7 +41,8 @@ func executeCommandByDeviceID(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
-	httpCaller internal.HttpCaller) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
 
 	if originalRequest == nil {
 		return nil, "", errors.NewErrExtractingInfoFromRequest()
@@ -77,7 +81,7 @@ func executeCommandByDeviceID(
 		return nil, "", errors.NewErrCommandNotAssociatedWithDevice(commandID, deviceID)
 	}
 
-	return executeCommandByDevice(ctx, d, c, body, lc, originalRequest, httpCaller)
+	return executeCommandByDevice(ctx, d, c, body, lc, dbClient, originalRequest, httpCaller, access)
 }
 
 // extractDeviceIdAndCommandIdFromRequest extracts deviceID and commandID from r, which
@@ -104,7 +108,8 @@ func executeCommandByName(
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
-	httpCaller internal.HttpCaller) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
 
 	d, err := deviceClient.DeviceForName(ctx, dn)
 	if err != nil {
@@ -120,7 +125,7 @@ func executeCommandByName(
 		return nil, "", err
 	}
 
-	return executeCommandByDevice(ctx, d, command, body, lc, originalRequest, httpCaller)
+	return executeCommandByDevice(ctx, d, command, body, lc, dbClient, originalRequest, httpCaller, access)
 }
 
 func executeCommandByDevice(
@@ -129,8 +134,10 @@ func executeCommandByDevice(
 	command contract.Command,
 	body string,
 	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
 	originalRequest *http.Request,
-	httpCaller internal.HttpCaller) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) (deviceServiceResponse *http.Response, theResponseBody string, failure error) {
 
 	var method string
 	var ex Executor
@@ -140,11 +147,41 @@ func executeCommandByDevice(
 		return nil, "", errors.NewErrParsingOriginalRequest("method")
 	}
 
+	auditTarget(ctx, device, command)
+	metricsTarget(ctx, device)
+
+	if err := authorizeCommand(originalRequest, device, command, access); err != nil {
+		return nil, "", err
+	}
+
+	transforms, err := loadValueTransforms(device, dbClient)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, simulated, err := deviceSimulation(device, dbClient)
+	if err != nil {
+		return nil, "", err
+	}
+
 	switch originalRequest.Method {
 	case http.MethodPut:
-		ex, err = NewPutCommand(device, command, body, ctx, httpCaller, lc, originalRequest)
+		if len(transforms) > 0 {
+			if body, err = transformParameters(body, transforms); err != nil {
+				return nil, "", err
+			}
+		}
+		if simulated {
+			ex = newSimulatedCommand(ctx, device, command, http.MethodPut, body, dbClient, lc)
+		} else {
+			ex, err = NewPutCommand(device, command, body, ctx, httpCaller, lc, originalRequest)
+		}
 	case http.MethodGet:
-		ex, err = NewGetCommand(device, command, ctx, httpCaller, lc, originalRequest)
+		if simulated {
+			ex = newSimulatedCommand(ctx, device, command, http.MethodGet, "", dbClient, lc)
+		} else {
+			ex, err = NewGetCommand(device, command, ctx, httpCaller, lc, originalRequest)
+		}
 	default:
 		lc.Error(fmt.Sprintf("unknown method: %s", method))
 	}
@@ -153,17 +190,48 @@ func executeCommandByDevice(
 		return nil, "", err
 	}
 
+	release, waited, err := acquireDevice(ctx, device)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+
 	deviceServiceResponse, err = ex.Execute()
 	if err != nil {
 		return nil, "", err
 	}
 
+	if waited >= 0 {
+		if deviceServiceResponse.Header == nil {
+			deviceServiceResponse.Header = make(http.Header)
+		}
+		deviceServiceResponse.Header.Set(QueueWaitHeader, waited.String())
+	}
+
+	for _, r := range deprecation.CommandResources(device.Profile, command.Name, originalRequest.Method == http.MethodPut) {
+		lc.Warn(fmt.Sprintf("command %s of device %s uses deprecated deviceResource %s", command.Name, device.Name, r.Name))
+		if deviceServiceResponse.Header == nil {
+			deviceServiceResponse.Header = make(http.Header)
+		}
+		deviceServiceResponse.Header.Add(deprecation.WarningHeader, deprecation.Warning(r))
+	}
+
 	responseBody := new(bytes.Buffer)
 	_, readErr := responseBody.ReadFrom(deviceServiceResponse.Body)
 	if readErr != nil {
 		return nil, "", readErr
 	}
 
+	if len(transforms) > 0 && originalRequest.Method == http.MethodGet &&
+		deviceServiceResponse.StatusCode == http.StatusOK &&
+		strings.Contains(deviceServiceResponse.Header.Get(clients.ContentType), clients.ContentTypeJSON) {
+		transformed, err := transformReadings(responseBody.Bytes(), transforms)
+		if err != nil {
+			return nil, "", fmt.Errorf("transforming the readings of device %s failed: %v", device.Name, err)
+		}
+		return deviceServiceResponse, string(transformed), nil
+	}
+
 	return deviceServiceResponse, responseBody.String(), nil
 }
 
@@ -20,9 +20,11 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces/mocks"
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
 	mdMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 
@@ -151,7 +153,8 @@ func TestExecuteGETCommandByDeviceIDAndCommandID(t *testing.T) {
 				logger.NewMockClient(),
 				newMockDBClient(),
 				newMockDeviceClient(),
-				httpCaller)
+				httpCaller,
+				config.CommandAccessInfo{})
 			if actualErr == nil {
 				t.Fatal("expected error")
 			}
@@ -182,5 +185,7 @@ func newMockDBClient() interfaces.DBClient {
 	dbMock.On("GetCommandsByDeviceId", TestDeviceID).Return([]models.Command{{Id: TestCommandID}}, nil)
 	dbMock.On("GetCommandsByDeviceId", ExistingDeviceID).Return([]models.Command{{Id: ExistingDeviceID}}, nil)
 	dbMock.On("GetCommandsByDeviceId", DeviceIDd200c200).Return([]models.Command{{Id: ExistingDeviceID}}, nil)
+	dbMock.On("GetDeviceValueTransforms", mock.Anything).Return(nil, db.ErrNotFound)
+	dbMock.On("GetDeviceSimulation", mock.Anything).Return(commandModels.DeviceSimulation{}, db.ErrNotFound)
 	return dbMock
 }
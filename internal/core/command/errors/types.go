@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type ErrDeviceLocked struct {
 	device string
@@ -59,3 +62,127 @@ func (e ErrBadRequest) Error() string {
 func NewErrParsingOriginalRequest(invalid string) error {
 	return ErrBadRequest{value: invalid}
 }
+
+// ErrCommandForbidden is a struct that serves as the value receiver
+// for Error as defined for NewErrCommandForbidden
+type ErrCommandForbidden struct {
+	command string
+	role    string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrCommandForbidden) Error() string {
+	return fmt.Sprintf("command '%s' requires the '%s' role", e.command, e.role)
+}
+
+// NewErrCommandForbidden returns the relevant, properly-
+// constructed error type.
+func NewErrCommandForbidden(command string, role string) error {
+	return ErrCommandForbidden{command: command, role: role}
+}
+
+// ErrLimitExceeded is a struct that serves as the value receiver
+// for Error as defined for NewErrLimitExceeded
+type ErrLimitExceeded struct {
+	limit int
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("result count exceeds configured max %d", e.limit)
+}
+
+// NewErrLimitExceeded returns the relevant, properly-
+// constructed error type.
+func NewErrLimitExceeded(limit int) error {
+	return ErrLimitExceeded{limit: limit}
+}
+
+// ErrInvalidValueTransform is a struct that serves as the value receiver
+// for Error as defined for NewErrInvalidValueTransform
+type ErrInvalidValueTransform struct {
+	resource string
+	reason   string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrInvalidValueTransform) Error() string {
+	return fmt.Sprintf("invalid value transformation of resource '%s': %s", e.resource, e.reason)
+}
+
+// NewErrInvalidValueTransform returns the relevant, properly-
+// constructed error type.
+func NewErrInvalidValueTransform(resource string, reason string) error {
+	return ErrInvalidValueTransform{resource: resource, reason: reason}
+}
+
+// ErrInvalidSimulatedValue is a struct that serves as the value receiver
+// for Error as defined for NewErrInvalidSimulatedValue
+type ErrInvalidSimulatedValue struct {
+	resource string
+	reason   string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrInvalidSimulatedValue) Error() string {
+	return fmt.Sprintf("invalid simulated value of resource '%s': %s", e.resource, e.reason)
+}
+
+// NewErrInvalidSimulatedValue returns the relevant, properly-
+// constructed error type.
+func NewErrInvalidSimulatedValue(resource string, reason string) error {
+	return ErrInvalidSimulatedValue{resource: resource, reason: reason}
+}
+
+// ErrResourceNotReadable is a struct that serves as the value receiver
+// for Error as defined for NewErrResourceNotReadable
+type ErrResourceNotReadable struct {
+	device   string
+	resource string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrResourceNotReadable) Error() string {
+	return fmt.Sprintf("no command of device '%s' reads resource '%s'", e.device, e.resource)
+}
+
+// NewErrResourceNotReadable returns the relevant, properly-
+// constructed error type.
+func NewErrResourceNotReadable(device string, resource string) error {
+	return ErrResourceNotReadable{device: device, resource: resource}
+}
+
+// ErrCommandQueueFull is a struct that serves as the value receiver
+// for Error as defined for NewErrCommandQueueFull
+type ErrCommandQueueFull struct {
+	device string
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrCommandQueueFull) Error() string {
+	return fmt.Sprintf("device '%s' already has the maximum number of commands waiting", e.device)
+}
+
+// NewErrCommandQueueFull returns the relevant, properly-
+// constructed error type.
+func NewErrCommandQueueFull(device string) error {
+	return ErrCommandQueueFull{device: device}
+}
+
+// ErrCommandQueueTimeout is a struct that serves as the value receiver
+// for Error as defined for NewErrCommandQueueTimeout
+type ErrCommandQueueTimeout struct {
+	device string
+	waited time.Duration
+}
+
+// Error returns a meaningful string message describing error details.
+func (e ErrCommandQueueTimeout) Error() string {
+	return fmt.Sprintf("device '%s' still busy with other commands after waiting %s", e.device, e.waited)
+}
+
+// NewErrCommandQueueTimeout returns the relevant, properly-
+// constructed error type.
+func NewErrCommandQueueTimeout(device string, waited time.Duration) error {
+	return ErrCommandQueueTimeout{device: device, waited: waited}
+}
@@ -17,20 +17,26 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"sync"
-
-	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
+	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/responses"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messagebus"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/coredata"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 	"github.com/gorilla/mux"
 )
 
@@ -47,7 +53,7 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the command service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
 
 	// TODO: there is an outstanding known issue (https://github.com/edgexfoundry/edgex-go/issues/2462)
@@ -63,12 +69,155 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	// initialize clients required by the service
 	dic.Update(di.ServiceConstructorMap{
 		container.MetadataDeviceClientName: func(get di.Get) interface{} {
-			return metadata.NewDeviceClient(local.New(configuration.Clients["Metadata"].Url() + clients.ApiDeviceRoute))
+			return metadata.NewDeviceClient(endpoints.NewURLClient(
+				pkgContainer.EndpointsRegistryFrom(get), "Metadata", configuration.Clients["Metadata"], clients.ApiDeviceRoute))
 		},
 		errorContainer.ErrorHandlerName: func(get di.Get) interface{} {
 			return errorconcept.NewErrorHandler(lc)
 		},
 	})
 
+	// The GET commands of the simulated devices are answered with their last readings in core-data
+	if coreDataInfo, ok := configuration.Clients["CoreData"]; ok {
+		dic.Update(di.ServiceConstructorMap{
+			container.CoreDataReadingClientName: func(get di.Get) interface{} {
+				return coredata.NewReadingClient(endpoints.NewURLClient(
+					pkgContainer.EndpointsRegistryFrom(get), "CoreData", coreDataInfo, clients.ApiReadingRoute))
+			},
+		})
+	}
+
+	// The callers' JWTs are verified with these keys, a key file which can't be loaded would reject them all
+	if path := configuration.Writable.CommandAccess.PublicKeyPath; path != "" {
+		if _, err := loadPublicKeys(path); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	if err := purgeCommandAudits(ctx, wg, dic); err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+
+	if configuration.CommandResponses.Enabled {
+		// The commands being executed when the service is exiting wait for their responses, the subscription is kept
+		// until they are drained.
+		drainedCtx := pkgContainer.ShutdownCoordinatorFrom(dic.Get).Context()
+		if err := subscribeCommandResponses(drainedCtx, wg, startupTimer, dic); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
+	if configuration.CommandConcurrency.Enabled {
+		limits := configuration.CommandConcurrency
+		limiter, err := newCommandLimiter(limits.DefaultLimit, limits.ProfileLimits, limits.MaxQueued, limits.QueueTimeout)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+		dic.Update(di.ServiceConstructorMap{
+			container.CommandLimiterName: func(get di.Get) interface{} {
+				return limiter
+			},
+		})
+	}
+
+	if configuration.Twin.Enabled {
+		if err := reconcileDeviceTwins(ctx, wg, dic); err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
 	return true
 }
+
+// subscribeCommandResponses connects to the message bus and hands the command responses published by the device
+// services over to the command response hub, until the service is exiting.
+func subscribeCommandResponses(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) error {
+	configuration := container.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	retention, err := time.ParseDuration(configuration.CommandResponses.Retention)
+	if err != nil {
+		return fmt.Errorf("invalid CommandResponses.Retention '%s': %s", configuration.CommandResponses.Retention, err.Error())
+	}
+	if _, err = time.ParseDuration(configuration.CommandResponses.Timeout); err != nil {
+		return fmt.Errorf("invalid CommandResponses.Timeout '%s': %s", configuration.CommandResponses.Timeout, err.Error())
+	}
+
+	msgClient, err := messagebus.NewMessageClient(
+		msgTypes.MessageBusConfig{
+			SubscribeHost: msgTypes.HostInfo{
+				Host:     configuration.MessageQueue.Host,
+				Port:     configuration.MessageQueue.Port,
+				Protocol: configuration.MessageQueue.Protocol,
+			},
+			Type:     configuration.MessageQueue.Type,
+			Optional: configuration.MessageQueue.Optional,
+		},
+		configuration.MessageQueue.JetStream)
+	if err != nil {
+		return fmt.Errorf("failed to create messaging client: %s", err.Error())
+	}
+
+	for startupTimer.HasNotElapsed() {
+		err = msgClient.Connect()
+		if err == nil {
+			break
+		}
+
+		lc.Warn(fmt.Sprintf("couldn't connect to message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to message bus in allotted time")
+	}
+
+	messages := make(chan msgTypes.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []msgTypes.TopicChannel{{Topic: configuration.MessageQueue.SubscribeTopic, Messages: messages}}
+	if err = msgClient.Subscribe(topics, messageErrors); err != nil {
+		_ = msgClient.Disconnect()
+		return fmt.Errorf("failed to subscribe to the '%s' topic: %s", configuration.MessageQueue.SubscribeTopic, err.Error())
+	}
+
+	hub := responses.NewHub(retention)
+	dic.Update(di.ServiceConstructorMap{
+		container.CommandResponseHubName: func(get di.Get) interface{} {
+			return hub
+		},
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				if err := msgClient.Disconnect(); err != nil {
+					lc.Error("failed to disconnect from the Message Bus")
+					return
+				}
+				lc.Info("Message Bus disconnected")
+				return
+			case err := <-messageErrors:
+				lc.Error(fmt.Sprintf("failed to receive a command response: %s", err.Error()))
+			case envelope := <-messages:
+				lc.Debug(fmt.Sprintf("received the command response to request %s", envelope.CorrelationID))
+				hub.Publish(envelope)
+			}
+		}
+	}()
+
+	lc.Info(fmt.Sprintf(
+		"Connected to %s Message Bus @ %s://%s:%d subscribed to '%s' topic for command responses",
+		configuration.MessageQueue.Type,
+		configuration.MessageQueue.Protocol,
+		configuration.MessageQueue.Host,
+		configuration.MessageQueue.Port,
+		configuration.MessageQueue.SubscribeTopic))
+	return nil
+}
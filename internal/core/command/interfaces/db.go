@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -11,4 +13,14 @@ type DBClient interface {
 	GetCommandsByName(id string) ([]contract.Command, error)
 	GetCommandsByDeviceId(id string) ([]contract.Command, error)
 	GetCommandByNameAndDeviceId(cname string, did string) (contract.Command, error)
+	AddCommandAudit(a models.CommandAudit) (string, error)
+	GetCommandAudits(start int64, end int64, limit int) ([]models.CommandAudit, error)
+	GetCommandAuditsByDevice(deviceName string, start int64, end int64, limit int) ([]models.CommandAudit, error)
+	DeleteCommandAuditsOld(age int64) (int, error)
+	SetDeviceValueTransforms(deviceId string, transforms map[string]models.ValueTransform) error
+	GetDeviceValueTransforms(deviceId string) (map[string]models.ValueTransform, error)
+	DeleteDeviceValueTransforms(deviceId string) error
+	SetDeviceSimulation(deviceId string, simulation models.DeviceSimulation) error
+	GetDeviceSimulation(deviceId string) (models.DeviceSimulation, error)
+	DeleteDeviceSimulation(deviceId string) error
 }
@@ -2,6 +2,7 @@
 
 package mocks
 
+import commandmodels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
 
@@ -10,11 +11,81 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddCommandAudit provides a mock function with given fields: a
+func (_m *DBClient) AddCommandAudit(a commandmodels.CommandAudit) (string, error) {
+	ret := _m.Called(a)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(commandmodels.CommandAudit) string); ok {
+		r0 = rf(a)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(commandmodels.CommandAudit) error); ok {
+		r1 = rf(a)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DeleteCommandAuditsOld provides a mock function with given fields: age
+func (_m *DBClient) DeleteCommandAuditsOld(age int64) (int, error) {
+	ret := _m.Called(age)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(int64) int); ok {
+		r0 = rf(age)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(age)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteDeviceSimulation provides a mock function with given fields: deviceId
+func (_m *DBClient) DeleteDeviceSimulation(deviceId string) error {
+	ret := _m.Called(deviceId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(deviceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDeviceValueTransforms provides a mock function with given fields: deviceId
+func (_m *DBClient) DeleteDeviceValueTransforms(deviceId string) error {
+	ret := _m.Called(deviceId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(deviceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllCommands provides a mock function with given fields:
 func (_m *DBClient) GetAllCommands() ([]models.Command, error) {
 	ret := _m.Called()
@@ -38,6 +109,52 @@ func (_m *DBClient) GetAllCommands() ([]models.Command, error) {
 	return r0, r1
 }
 
+// GetCommandAudits provides a mock function with given fields: start, end, limit
+func (_m *DBClient) GetCommandAudits(start int64, end int64, limit int) ([]commandmodels.CommandAudit, error) {
+	ret := _m.Called(start, end, limit)
+
+	var r0 []commandmodels.CommandAudit
+	if rf, ok := ret.Get(0).(func(int64, int64, int) []commandmodels.CommandAudit); ok {
+		r0 = rf(start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]commandmodels.CommandAudit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64, int) error); ok {
+		r1 = rf(start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommandAuditsByDevice provides a mock function with given fields: deviceName, start, end, limit
+func (_m *DBClient) GetCommandAuditsByDevice(deviceName string, start int64, end int64, limit int) ([]commandmodels.CommandAudit, error) {
+	ret := _m.Called(deviceName, start, end, limit)
+
+	var r0 []commandmodels.CommandAudit
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int) []commandmodels.CommandAudit); ok {
+		r0 = rf(deviceName, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]commandmodels.CommandAudit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int) error); ok {
+		r1 = rf(deviceName, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCommandById provides a mock function with given fields: id
 func (_m *DBClient) GetCommandById(id string) (models.Command, error) {
 	ret := _m.Called(id)
@@ -125,3 +242,75 @@ func (_m *DBClient) GetCommandsByName(id string) ([]models.Command, error) {
 
 	return r0, r1
 }
+
+// GetDeviceSimulation provides a mock function with given fields: deviceId
+func (_m *DBClient) GetDeviceSimulation(deviceId string) (commandmodels.DeviceSimulation, error) {
+	ret := _m.Called(deviceId)
+
+	var r0 commandmodels.DeviceSimulation
+	if rf, ok := ret.Get(0).(func(string) commandmodels.DeviceSimulation); ok {
+		r0 = rf(deviceId)
+	} else {
+		r0 = ret.Get(0).(commandmodels.DeviceSimulation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(deviceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeviceValueTransforms provides a mock function with given fields: deviceId
+func (_m *DBClient) GetDeviceValueTransforms(deviceId string) (map[string]commandmodels.ValueTransform, error) {
+	ret := _m.Called(deviceId)
+
+	var r0 map[string]commandmodels.ValueTransform
+	if rf, ok := ret.Get(0).(func(string) map[string]commandmodels.ValueTransform); ok {
+		r0 = rf(deviceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]commandmodels.ValueTransform)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(deviceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDeviceSimulation provides a mock function with given fields: deviceId, simulation
+func (_m *DBClient) SetDeviceSimulation(deviceId string, simulation commandmodels.DeviceSimulation) error {
+	ret := _m.Called(deviceId, simulation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, commandmodels.DeviceSimulation) error); ok {
+		r0 = rf(deviceId, simulation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetDeviceValueTransforms provides a mock function with given fields: deviceId, transforms
+func (_m *DBClient) SetDeviceValueTransforms(deviceId string, transforms map[string]commandmodels.ValueTransform) error {
+	ret := _m.Called(deviceId, transforms)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]commandmodels.ValueTransform) error); ok {
+		r0 = rf(deviceId, transforms)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -22,7 +22,14 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/configdrift"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/featureflags"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	shutdownHandler "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/shutdown"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/shutdown"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap"
@@ -58,6 +65,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := handlers.NewHttpServer(router, true)
+	coordinator := shutdown.NewCoordinator(httpServer)
+	router.Use(coordinator.Middleware)
 
 	bootstrap.Run(
 		ctx,
@@ -69,8 +78,17 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		startupTimer,
 		dic,
 		[]interfaces.BootstrapHandler{
-			handlers.SecureProviderBootstrapHandler,
-			database.NewDatabase(httpServer, configuration).BootstrapHandler,
+			shutdownHandler.NewGracefulShutdown(coordinator, configuration).BootstrapHandler,
+			secret.BootstrapHandler,
+			endpoints.NewStandalone(configuration).BootstrapHandler,
+			database.NewDatabase(coordinator, configuration).BootstrapHandler,
+			devicemetrics.NewReporting(configuration).BootstrapHandler,
+			featureflags.NewFeatureFlags(configuration).BootstrapHandler,
+			configdrift.NewConfigDrift(
+				f,
+				clients.CoreCommandServiceKey,
+				internal.ConfigStemCore+internal.ConfigMajorVersion,
+				configuration).BootstrapHandler,
 			NewBootstrap(router).BootstrapHandler,
 			telemetry.BootstrapHandler,
 			httpServer.BootstrapHandler,
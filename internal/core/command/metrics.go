@@ -0,0 +1,62 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+type metricsContextKey struct{}
+
+// countCommands returns a middleware counting the commands issued to each device, and the commands which failed, when
+// the device metrics are reported. Requests which don't resolve to a device command, i.e. listing the commands of a
+// device, aren't counted.
+func countCommands(dic *di.Container) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			reporter := container.DeviceMetricsReporterFrom(dic.Get)
+			if reporter == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var deviceName string
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), metricsContextKey{}, &deviceName)))
+
+			counters := devicemetrics.Counters{CommandsIssued: 1}
+			if recorder.statusCode >= http.StatusBadRequest {
+				counters.CommandFailures = 1
+			}
+			reporter.Count(deviceName, counters)
+		})
+	}
+}
+
+// metricsTarget records the device the command of the request is issued to
+func metricsTarget(ctx context.Context, device contract.Device) {
+	if deviceName, ok := ctx.Value(metricsContextKey{}).(*string); ok {
+		*deviceName = device.Name
+	}
+}
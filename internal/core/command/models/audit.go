@@ -0,0 +1,35 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// CommandAudit records a command issued to a device through core-command
+type CommandAudit struct {
+	Id            string `json:"id"`
+	Timestamp     int64  `json:"timestamp"`
+	CorrelationId string `json:"correlationId,omitempty"`
+	// User is the caller taken from the bearer token of the request, empty when the request had no token
+	User        string `json:"user,omitempty"`
+	DeviceId    string `json:"deviceId,omitempty"`
+	DeviceName  string `json:"deviceName,omitempty"`
+	CommandId   string `json:"commandId,omitempty"`
+	CommandName string `json:"commandName,omitempty"`
+	Method      string `json:"method"`
+	// ParamsHash is the hex encoded SHA-256 of the request body, empty when the request had no body
+	ParamsHash string `json:"paramsHash,omitempty"`
+	// StatusCode is the HTTP status code core-command responded with
+	StatusCode int `json:"statusCode"`
+	// Latency is the time taken to respond in milliseconds
+	Latency int64 `json:"latency"`
+}
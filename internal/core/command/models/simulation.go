@@ -0,0 +1,20 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package models
+
+// DeviceSimulation marks a device as simulated: core-command answers its GET commands and accepts its SET commands
+// without calling its device service. Values holds the values set to the device resources while simulated, by name.
+type DeviceSimulation struct {
+	Values map[string]string `json:"values,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// ValueTransform overrides the transformation properties of a device resource of the device's profile for a single
+// device. A property left empty keeps the value of the profile.
+type ValueTransform struct {
+	Base   string `json:"base,omitempty"`
+	Scale  string `json:"scale,omitempty"`
+	Offset string `json:"offset,omitempty"`
+}
@@ -0,0 +1,220 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/deprecation"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// readDeviceResources reads the named resources of a device with as few GET commands as possible and merges the
+// readings of the events the device service returns into one event, in the order of the names. The warnings about
+// the deprecated deviceResources read by the commands are returned with the event.
+func readDeviceResources(
+	originalRequest *http.Request,
+	ctx context.Context,
+	dn string,
+	names []string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) (event []byte, warnings []string, failure error) {
+
+	d, err := deviceClient.DeviceForName(ctx, dn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if d.AdminState == contract.Locked {
+		return nil, nil, errors.NewErrDeviceLocked(d.Name)
+	}
+
+	commands, err := dbClient.GetCommandsByDeviceId(d.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	covering, err := coveringCommands(d, commands, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The names are meant for core-command, the other query parameters are passed on to the device service.
+	proxiedRequest := originalRequest.Clone(ctx)
+	query := proxiedRequest.URL.Query()
+	query.Del(NAMES)
+	proxiedRequest.URL.RawQuery = query.Encode()
+
+	readings := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		readings[name] = nil
+	}
+	var origin int64
+	seen := make(map[string]bool)
+	for _, c := range covering {
+		response, body, err := executeCommandByDevice(ctx, d, c, "", lc, dbClient, proxiedRequest, httpCaller, access)
+		if err != nil {
+			return nil, nil, err
+		}
+		if response.StatusCode != http.StatusOK {
+			return nil, nil, types.NewErrServiceClient(response.StatusCode, []byte(body))
+		}
+		if !strings.Contains(response.Header.Get(clients.ContentType), clients.ContentTypeJSON) {
+			return nil, nil, fmt.Errorf("command %s of device %s returned %s, only JSON events can be merged",
+				c.Name, d.Name, response.Header.Get(clients.ContentType))
+		}
+		for _, warning := range response.Header.Values(deprecation.WarningHeader) {
+			if !seen[warning] {
+				seen[warning] = true
+				warnings = append(warnings, warning)
+			}
+		}
+
+		commandOrigin, err := mergeReadings([]byte(body), readings)
+		if err != nil {
+			return nil, nil, fmt.Errorf("decoding the event of command %s of device %s failed: %v", c.Name, d.Name, err)
+		}
+		if commandOrigin > origin {
+			origin = commandOrigin
+		}
+	}
+
+	// Resources the device service didn't return a reading for are left out of the event.
+	merged := map[string]interface{}{"device": d.Name}
+	if origin > 0 {
+		merged["origin"] = origin
+	}
+	ordered := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		if readings[name] != nil {
+			ordered = append(ordered, readings[name])
+		}
+	}
+	merged["readings"] = ordered
+
+	event, err = json.Marshal(merged)
+	return event, warnings, err
+}
+
+// mergeReadings keeps the first reading of each resource of readings found in the event and returns the origin of
+// the event.
+func mergeReadings(body []byte, readings map[string]interface{}) (int64, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var event map[string]interface{}
+	if err := decoder.Decode(&event); err != nil {
+		return 0, err
+	}
+
+	eventReadings, _ := event["readings"].([]interface{})
+	for _, r := range eventReadings {
+		reading, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := reading["name"].(string)
+		if current, requested := readings[name]; requested && current == nil {
+			readings[name] = reading
+		}
+	}
+
+	origin, _ := event["origin"].(json.Number)
+	value, _ := origin.Int64()
+	return value, nil
+}
+
+// coveringCommands picks the GET commands of the device reading the names. The command reading the most of the names
+// not covered yet is picked first, so that the device service is called as few times as possible.
+func coveringCommands(device contract.Device, commands []contract.Command, names []string) ([]contract.Command, error) {
+	uncovered := make(map[string]bool, len(names))
+	for _, name := range names {
+		uncovered[name] = true
+	}
+
+	reads := make([][]string, len(commands))
+	for i, c := range commands {
+		if c.Get.Action.Path != "" {
+			reads[i] = commandReadResources(device.Profile, c.Name)
+		}
+	}
+
+	var covering []contract.Command
+	for len(uncovered) > 0 {
+		best, bestCount := -1, 0
+		for i := range commands {
+			count := 0
+			for _, name := range reads[i] {
+				if uncovered[name] {
+					count++
+				}
+			}
+			if count > bestCount {
+				best, bestCount = i, count
+			}
+		}
+
+		if best < 0 {
+			for _, name := range names {
+				if uncovered[name] {
+					return nil, errors.NewErrResourceNotReadable(device.Name, name)
+				}
+			}
+		}
+
+		covering = append(covering, commands[best])
+		for _, name := range reads[best] {
+			delete(uncovered, name)
+		}
+	}
+
+	return covering, nil
+}
+
+// commandReadResources returns the deviceResources read by the GET of the command: those of the Get
+// resourceOperations of the deviceCommand of the same name, or else the deviceResource of the same name.
+func commandReadResources(profile contract.DeviceProfile, commandName string) []string {
+	for _, dc := range profile.DeviceCommands {
+		if dc.Name != commandName {
+			continue
+		}
+		var names []string
+		for _, ro := range dc.Get {
+			if ro.DeviceResource != "" {
+				names = append(names, ro.DeviceResource)
+			} else if ro.Object != "" {
+				names = append(names, ro.Object)
+			}
+		}
+		return names
+	}
+	return []string{commandName}
+}
@@ -0,0 +1,183 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newResourcesDevice() contract.Device {
+	return contract.Device{
+		Id:         "thermostat-id",
+		Name:       "thermostat",
+		AdminState: contract.Unlocked,
+		Profile: contract.DeviceProfile{
+			Name: "Thermostat-Profile",
+			DeviceResources: []contract.DeviceResource{
+				{Name: "Temperature"}, {Name: "Humidity"}, {Name: "Mode"}, {Name: "SetPoint"},
+			},
+			DeviceCommands: []contract.ProfileResource{
+				{Name: "Climate", Get: []contract.ResourceOperation{{DeviceResource: "Temperature"}, {DeviceResource: "Humidity"}}},
+				{Name: "SetPoint", Set: []contract.ResourceOperation{{DeviceResource: "SetPoint"}}},
+			},
+		},
+		Service: contract.DeviceService{
+			Addressable: contract.Addressable{Protocol: "http", Address: "localhost", Port: 49990},
+		},
+	}
+}
+
+func newResourcesCommands() []contract.Command {
+	getCommand := func(name string) contract.Command {
+		return contract.Command{
+			Id:   name + "-id",
+			Name: name,
+			Get:  contract.Get{Action: contract.Action{Path: "/api/v1/device/{deviceId}/" + name}},
+		}
+	}
+	return []contract.Command{
+		getCommand("Temperature"),
+		getCommand("Climate"),
+		getCommand("Mode"),
+		{Id: "SetPoint-id", Name: "SetPoint", Put: contract.Put{Action: contract.Action{Path: "/api/v1/device/{deviceId}/SetPoint"}}},
+	}
+}
+
+func TestCoveringCommands(t *testing.T) {
+	tests := []struct {
+		name     string
+		names    []string
+		expected []string
+		notFound string
+	}{
+		{"one command reads the resources", []string{"Humidity", "Temperature"}, []string{"Climate"}, ""},
+		{"fewest commands", []string{"Mode", "Temperature", "Humidity"}, []string{"Climate", "Mode"}, ""},
+		{"resource of a command", []string{"Temperature"}, []string{"Temperature"}, ""},
+		{"resource only set", []string{"Mode", "SetPoint"}, nil, "SetPoint"},
+		{"unknown resource", []string{"Pressure"}, nil, "Pressure"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			covering, err := coveringCommands(newResourcesDevice(), newResourcesCommands(), tt.names)
+			if tt.notFound != "" {
+				require.Error(t, err)
+				assert.Equal(t, errors.NewErrResourceNotReadable("thermostat", tt.notFound), err)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, c := range covering {
+				names = append(names, c.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestRestGetDeviceResourcesByName(t *testing.T) {
+	device := newResourcesDevice()
+	events := map[string]string{
+		"/api/v1/device/thermostat-id/Climate": `{"device":"thermostat","origin":1604000000000000000,"readings":[` +
+			`{"name":"Temperature","value":"21.5","valueType":"Float64","floatEncoding":"eNotation"},{"name":"Humidity","value":"40","valueType":"Int16"}]}`,
+		"/api/v1/device/thermostat-id/Mode": `{"device":"thermostat","origin":1604000000000000001,"readings":[` +
+			`{"name":"Mode","value":"heat","valueType":"String"}]}`,
+	}
+
+	tests := []struct {
+		name           string
+		names          string
+		status         int
+		expectedStatus int
+	}{
+		{"readings merged", "Mode,Temperature, Humidity,Mode", http.StatusOK, http.StatusOK},
+		{"no names", " ,", http.StatusOK, http.StatusBadRequest},
+		{"resource not readable", "SetPoint", http.StatusOK, http.StatusNotFound},
+		{"device service failure", "Mode", http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deviceClient := &mocks.DeviceClient{}
+			deviceClient.On("DeviceForName", mock.Anything, device.Name).Return(device, nil)
+			dbClient := createMockWithOutlines([]mockOutline{
+				{"GetCommandsByDeviceId", []interface{}{device.Id}, []interface{}{newResourcesCommands(), nil}},
+			})
+			httpCaller := &mocks.HttpCaller{}
+			var queries []string
+			httpCaller.On("Do", mock.Anything).Return(func(req *http.Request) *http.Response {
+				queries = append(queries, req.URL.RawQuery)
+				return &http.Response{
+					StatusCode: tt.status,
+					Header:     http.Header{clients.ContentType: {clients.ContentTypeJSON}},
+					Body:       ioutil.NopCloser(strings.NewReader(events[req.URL.Path])),
+				}
+			}, nil)
+
+			req := httptest.NewRequest(http.MethodGet, cmdURI+"/name/thermostat/resources?ds-pushevent=no&"+NAMES+"="+url.QueryEscape(tt.names), nil)
+			req = mux.SetURLVars(req, map[string]string{NAME: device.Name})
+			rr := httptest.NewRecorder()
+			loggerMock := logger.NewMockClient()
+			restGetDeviceResourcesByName(
+				rr,
+				req,
+				loggerMock,
+				dbClient,
+				deviceClient,
+				errorconcept.NewErrorHandler(loggerMock),
+				httpCaller,
+				config.CommandAccessInfo{})
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			assert.Len(t, queries, 2, "the device service must be called once per command")
+			for _, query := range queries {
+				assert.Equal(t, "ds-pushevent=no", query, "the names must not be passed on to the device service")
+			}
+
+			var event contract.Event
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &event))
+			assert.Equal(t, device.Name, event.Device)
+			assert.Equal(t, int64(1604000000000000001), event.Origin)
+			require.Len(t, event.Readings, 3)
+			assert.Equal(t, "Mode", event.Readings[0].Name)
+			assert.Equal(t, "Temperature", event.Readings[1].Name)
+			assert.Equal(t, "21.5", event.Readings[1].Value)
+			assert.Equal(t, "Humidity", event.Readings[2].Name)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package responses
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// Response is the response of a device service to a command, received over the message bus after the device service
+// accepted the command. RequestId is the correlation ID of the command request.
+type Response struct {
+	RequestId   string          `json:"requestId"`
+	ContentType string          `json:"contentType,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+	Received    int64           `json:"received"`
+}
+
+// newResponse converts a message envelope to a Response, a payload which isn't JSON is kept as a JSON string
+func newResponse(envelope types.MessageEnvelope, received time.Time) Response {
+	payload := json.RawMessage(envelope.Payload)
+	if !json.Valid(payload) {
+		payload, _ = json.Marshal(string(envelope.Payload))
+	}
+	return Response{
+		RequestId:   envelope.CorrelationID,
+		ContentType: envelope.ContentType,
+		Payload:     payload,
+		Received:    received.UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// Hub correlates the command responses received over the message bus to the callers waiting for them by request ID.
+// Responses are retained for a while, so a caller subscribing after the response was received still gets it.
+type Hub struct {
+	retention time.Duration
+	now       func() time.Time
+
+	mutex    sync.Mutex
+	retained map[string]Response
+	waiters  map[string]map[chan Response]struct{}
+}
+
+// NewHub creates a Hub retaining the received responses for the given duration
+func NewHub(retention time.Duration) *Hub {
+	return &Hub{
+		retention: retention,
+		now:       time.Now,
+		retained:  make(map[string]Response),
+		waiters:   make(map[string]map[chan Response]struct{}),
+	}
+}
+
+// Publish hands the response carried by the envelope over to the callers waiting for its request ID. Envelopes
+// without correlation ID can't be correlated and are ignored.
+func (h *Hub) Publish(envelope types.MessageEnvelope) {
+	if envelope.CorrelationID == "" {
+		return
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	now := h.now()
+	h.purge(now)
+	response := newResponse(envelope, now)
+	h.retained[response.RequestId] = response
+	for waiter := range h.waiters[response.RequestId] {
+		deliver(waiter, response)
+	}
+}
+
+// Subscribe returns a channel receiving the responses to the given request IDs, starting with those already retained,
+// and the function to call once the caller stops waiting for them.
+func (h *Hub) Subscribe(requestIds []string) (<-chan Response, func()) {
+	waiter := make(chan Response, len(requestIds))
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.purge(h.now())
+	for _, id := range requestIds {
+		if h.waiters[id] == nil {
+			h.waiters[id] = make(map[chan Response]struct{})
+		}
+		h.waiters[id][waiter] = struct{}{}
+		if response, ok := h.retained[id]; ok {
+			deliver(waiter, response)
+		}
+	}
+
+	cancel := func() {
+		h.mutex.Lock()
+		defer h.mutex.Unlock()
+		for _, id := range requestIds {
+			delete(h.waiters[id], waiter)
+			if len(h.waiters[id]) == 0 {
+				delete(h.waiters, id)
+			}
+		}
+	}
+	return waiter, cancel
+}
+
+// purge drops the responses retained for longer than the retention
+func (h *Hub) purge(now time.Time) {
+	oldest := now.Add(-h.retention).UnixNano() / int64(time.Millisecond)
+	for id, response := range h.retained {
+		if response.Received < oldest {
+			delete(h.retained, id)
+		}
+	}
+}
+
+// deliver sends the response without blocking, a waiter only takes one response per request ID so its buffer can
+// only be full when a device service answered a request twice
+func deliver(waiter chan Response, response Response) {
+	select {
+	case waiter <- response:
+	default:
+	}
+}
@@ -0,0 +1,77 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func envelope(requestId string, payload string) types.MessageEnvelope {
+	return types.MessageEnvelope{CorrelationID: requestId, ContentType: "application/json", Payload: []byte(payload)}
+}
+
+func TestHubDeliversToWaiters(t *testing.T) {
+	hub := NewHub(time.Minute)
+	received, cancel := hub.Subscribe([]string{"req-1", "req-2"})
+	defer cancel()
+
+	hub.Publish(envelope("other", `{}`))
+	hub.Publish(envelope("req-2", `{"device":"d2"}`))
+	hub.Publish(envelope("", `{}`))
+
+	require.Len(t, received, 1)
+	response := <-received
+	assert.Equal(t, "req-2", response.RequestId)
+	assert.JSONEq(t, `{"device":"d2"}`, string(response.Payload))
+}
+
+func TestHubRetainsResponses(t *testing.T) {
+	now := time.Now()
+	hub := NewHub(time.Minute)
+	hub.now = func() time.Time { return now }
+
+	hub.Publish(envelope("req-1", `{"device":"d1"}`))
+	hub.Publish(envelope("req-2", "not json"))
+
+	received, cancel := hub.Subscribe([]string{"req-1", "req-2"})
+	require.Len(t, received, 2)
+	cancel()
+	first, second := <-received, <-received
+	assert.Equal(t, "req-1", first.RequestId)
+	assert.Equal(t, "req-2", second.RequestId)
+	assert.Equal(t, `"not json"`, string(second.Payload), "payload which isn't JSON should be kept as a string")
+
+	// responses retained for longer than the retention aren't delivered anymore
+	now = now.Add(2 * time.Minute)
+	received, cancel = hub.Subscribe([]string{"req-1"})
+	defer cancel()
+	assert.Len(t, received, 0)
+}
+
+func TestHubCancel(t *testing.T) {
+	hub := NewHub(time.Minute)
+	received, cancel := hub.Subscribe([]string{"req-1"})
+	cancel()
+
+	hub.Publish(envelope("req-1", `{}`))
+	assert.Len(t, received, 0)
+	assert.Empty(t, hub.waiters)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
 	commandMocks "github.com/edgexfoundry/edgex-go/internal/core/command/interfaces/mocks"
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
 	"github.com/edgexfoundry/edgex-go/internal/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
@@ -240,7 +241,8 @@ func TestRestPutDeviceCommandByCommandID(t *testing.T) {
 				tt.dbMock,
 				tt.dcMock,
 				errorconcept.NewErrorHandler(loggerMock),
-				httpCaller)
+				httpCaller,
+				config.CommandAccessInfo{})
 			response := rr.Result()
 			require.Equal(t, tt.expectedStatus, response.StatusCode)
 		})
@@ -335,7 +337,8 @@ func TestRestGetDeviceCommandByCommandID(t *testing.T) {
 				tt.dbMock,
 				tt.dcMock,
 				errorconcept.NewErrorHandler(loggerMock),
-				httpCaller)
+				httpCaller,
+				config.CommandAccessInfo{})
 			response := rr.Result()
 			require.Equal(t, tt.expectedStatus, response.StatusCode)
 		})
@@ -348,6 +351,8 @@ func createMockWithOutlines(outlines []mockOutline) interfaces.DBClient {
 	for _, o := range outlines {
 		dbMock.On(o.methodName, o.arg...).Return(o.ret...)
 	}
+	dbMock.On("GetDeviceValueTransforms", mock.Anything).Return(nil, db.ErrNotFound)
+	dbMock.On("GetDeviceSimulation", mock.Anything).Return(commandModels.DeviceSimulation{}, db.ErrNotFound)
 	return &dbMock
 }
 
@@ -0,0 +1,100 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// restGetCommandAudits returns the audit records of the commands issued between start and end
+// api/v1/audit/start/{start}/end/{end}/{limit}
+func restGetCommandAudits(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	configuration *config.ConfigurationStruct,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	getCommandAudits(w, r, lc, configuration, httpErrorHandler, dbClient.GetCommandAudits)
+}
+
+// restGetCommandAuditsByDevice returns the audit records of the commands issued to the named device between start
+// and end
+// api/v1/audit/device/{name}/start/{start}/end/{end}/{limit}
+func restGetCommandAuditsByDevice(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	configuration *config.ConfigurationStruct,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	name := mux.Vars(r)[NAME]
+	getCommandAudits(w, r, lc, configuration, httpErrorHandler,
+		func(start int64, end int64, limit int) ([]models.CommandAudit, error) {
+			return dbClient.GetCommandAuditsByDevice(name, start, end, limit)
+		})
+}
+
+func getCommandAudits(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	httpErrorHandler errorconcept.ErrorHandler,
+	query func(start int64, end int64, limit int) ([]models.CommandAudit, error)) {
+
+	vars := mux.Vars(r)
+	start, err := strconv.ParseInt(vars[START], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	end, err := strconv.ParseInt(vars[END], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	limit, err := strconv.Atoi(vars[LIMIT])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if limit > configuration.Service.MaxResultCount {
+		httpErrorHandler.Handle(w, errors.NewErrLimitExceeded(configuration.Service.MaxResultCount), errorconcept.Common.LimitExceeded)
+		return
+	}
+
+	audits, err := query(start, end, limit)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.RetrieveError_StatusInternalServer)
+		return
+	}
+
+	pkg.Encode(audits, w, lc)
+}
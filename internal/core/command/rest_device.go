@@ -18,10 +18,13 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/deprecation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
@@ -38,9 +41,10 @@ func restGetDeviceCommandByCommandID(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	httpCaller internal.HttpCaller) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
 
-	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller, access)
 }
 
 func restPutDeviceCommandByCommandID(
@@ -50,9 +54,10 @@ func restPutDeviceCommandByCommandID(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	httpCaller internal.HttpCaller) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
 
-	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommand(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller, access)
 }
 
 func issueDeviceCommand(
@@ -62,7 +67,8 @@ func issueDeviceCommand(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	httpCaller internal.HttpCaller) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
 
 	defer originalRequest.Body.Close()
 
@@ -78,7 +84,8 @@ func issueDeviceCommand(
 		lc,
 		dbClient,
 		deviceClient,
-		httpCaller)
+		httpCaller,
+		access)
 
 	if err != nil {
 		httpErrorHandler.HandleManyVariants(
@@ -87,8 +94,13 @@ func issueDeviceCommand(
 			[]errorconcept.ErrorConceptType{
 				errorconcept.NewServiceClientHttpError(err),
 				errorconcept.Device.Locked,
+				errorconcept.Command.Forbidden,
 				errorconcept.Database.NotFound,
 				errorconcept.Command.NotAssociatedWithDevice,
+				errorconcept.Command.InvalidValueTransform,
+				errorconcept.Command.InvalidSimulatedValue,
+				errorconcept.Command.QueueFull,
+				errorconcept.Command.QueueTimeout,
 			},
 			errorconcept.Default.InternalServerError)
 		return
@@ -108,6 +120,18 @@ func issueDeviceCommand(
 	// Set the returned header Content-type based on header Content-type received in
 	// the Device Service request (No need to inspect it).
 	w.Header().Set(clients.ContentType, headers[clients.ContentType])
+	// Pass on the warnings about deprecated deviceResources used by the command.
+	for _, warning := range deviceServiceResponse.Header.Values(deprecation.WarningHeader) {
+		w.Header().Add(deprecation.WarningHeader, warning)
+	}
+	// Pass on how long the command waited for the other commands of the device.
+	if wait := deviceServiceResponse.Header.Get(QueueWaitHeader); wait != "" {
+		w.Header().Set(QueueWaitHeader, wait)
+	}
+	// Tell the command was answered on behalf of a simulated device.
+	if simulated := deviceServiceResponse.Header.Get(SimulatedHeader); simulated != "" {
+		w.Header().Set(SimulatedHeader, simulated)
+	}
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(deviceServiceResponseBody))
 }
@@ -119,9 +143,10 @@ func restGetDeviceCommandByNames(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	httpCaller internal.HttpCaller) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
 
-	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller, access)
 }
 
 func restPutDeviceCommandByNames(
@@ -131,9 +156,10 @@ func restPutDeviceCommandByNames(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	httpCaller internal.HttpCaller) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
 
-	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller)
+	issueDeviceCommandByNames(w, originalRequest, lc, dbClient, deviceClient, httpErrorHandler, httpCaller, access)
 }
 
 func issueDeviceCommandByNames(
@@ -143,7 +169,8 @@ func issueDeviceCommandByNames(
 	dbClient interfaces.DBClient,
 	deviceClient metadata.DeviceClient,
 	httpErrorHandler errorconcept.ErrorHandler,
-	httpCaller internal.HttpCaller) {
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
 
 	defer originalRequest.Body.Close()
 
@@ -168,7 +195,8 @@ func issueDeviceCommandByNames(
 		lc,
 		dbClient,
 		deviceClient,
-		httpCaller)
+		httpCaller,
+		access)
 
 	if err != nil {
 		httpErrorHandler.HandleManyVariants(
@@ -177,7 +205,12 @@ func issueDeviceCommandByNames(
 			[]errorconcept.ErrorConceptType{
 				errorconcept.NewServiceClientHttpError(err),
 				errorconcept.Device.Locked,
+				errorconcept.Command.Forbidden,
 				errorconcept.Database.NotFound,
+				errorconcept.Command.InvalidValueTransform,
+				errorconcept.Command.InvalidSimulatedValue,
+				errorconcept.Command.QueueFull,
+				errorconcept.Command.QueueTimeout,
 			},
 			errorconcept.Default.InternalServerError)
 		return
@@ -197,10 +230,92 @@ func issueDeviceCommandByNames(
 	// Set the returned header Content-type based on header Content-type received in
 	// the Device Service request (No need to inspect it).
 	w.Header().Set(clients.ContentType, headers[clients.ContentType])
+	// Pass on the warnings about deprecated deviceResources used by the command.
+	for _, warning := range deviceServiceResponse.Header.Values(deprecation.WarningHeader) {
+		w.Header().Add(deprecation.WarningHeader, warning)
+	}
+	// Pass on how long the command waited for the other commands of the device.
+	if wait := deviceServiceResponse.Header.Get(QueueWaitHeader); wait != "" {
+		w.Header().Set(QueueWaitHeader, wait)
+	}
+	// Tell the command was answered on behalf of a simulated device.
+	if simulated := deviceServiceResponse.Header.Get(SimulatedHeader); simulated != "" {
+		w.Header().Set(SimulatedHeader, simulated)
+	}
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(deviceServiceResponseBody))
 }
 
+func restGetDeviceResourcesByName(
+	w http.ResponseWriter,
+	originalRequest *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler,
+	httpCaller internal.HttpCaller,
+	access config.CommandAccessInfo) {
+
+	dn := mux.Vars(originalRequest)[NAME]
+	ctx := originalRequest.Context()
+
+	names := resourceNames(originalRequest.URL.Query().Get(NAMES))
+	if len(names) == 0 {
+		httpErrorHandler.Handle(w, errors.NewErrParsingOriginalRequest(NAMES), errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	event, warnings, err := readDeviceResources(
+		originalRequest,
+		ctx,
+		dn,
+		names,
+		lc,
+		dbClient,
+		deviceClient,
+		httpCaller,
+		access)
+	if err != nil {
+		httpErrorHandler.HandleManyVariants(
+			w,
+			err,
+			[]errorconcept.ErrorConceptType{
+				errorconcept.NewServiceClientHttpError(err),
+				errorconcept.Device.Locked,
+				errorconcept.Database.NotFound,
+				errorconcept.Command.ResourceNotReadable,
+				errorconcept.Command.InvalidValueTransform,
+				errorconcept.Command.InvalidSimulatedValue,
+				errorconcept.Command.QueueFull,
+				errorconcept.Command.QueueTimeout,
+			},
+			errorconcept.Default.InternalServerError)
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	// Pass on the warnings about deprecated deviceResources read by the commands.
+	for _, warning := range warnings {
+		w.Header().Add(deprecation.WarningHeader, warning)
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(event)
+}
+
+// resourceNames splits the comma separated list of resource names, leaving out empty and repeated names.
+func resourceNames(list string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func restGetCommandsByDeviceID(
 	w http.ResponseWriter,
 	originalRequest *http.Request,
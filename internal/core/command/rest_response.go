@@ -0,0 +1,195 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	// ContentTypeEventStream is the content type of Server-Sent Events
+	ContentTypeEventStream = "text/event-stream"
+
+	// responseKeepAliveInterval is how often a comment is written to an idle event stream, so that proxies don't
+	// close it while a slow device is processing a command
+	responseKeepAliveInterval = 15 * time.Second
+)
+
+// restGetCommandResponses waits for the responses the device services publish to the message bus for the commands
+// of the given request IDs, the correlation IDs of the command requests. Callers accepting an event stream receive
+// every response as an event until all of them are received, others long-poll for the first response and receive
+// no content when none is received in time.
+// api/v1/command/response?requestId={requestId},...
+func restGetCommandResponses(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	hub *responses.Hub,
+	configuration *config.ConfigurationStruct,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	if hub == nil {
+		httpErrorHandler.Handle(
+			w,
+			errors.New("command responses aren't received over the message bus"),
+			errorconcept.Default.ServiceUnavailable)
+		return
+	}
+	requestIds := parseRequestIds(r)
+	if len(requestIds) == 0 {
+		httpErrorHandler.Handle(w, errors.New("requestId is required"), errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	timeout, err := time.ParseDuration(configuration.CommandResponses.Timeout)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	received, cancel := hub.Subscribe(requestIds)
+	defer cancel()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	if !acceptsEventStream(r) {
+		select {
+		case response := <-received:
+			pkg.Encode(response, w, lc)
+		case <-timer.C:
+			w.WriteHeader(http.StatusNoContent)
+		case <-r.Context().Done():
+		}
+		return
+	}
+
+	stream := newResponseStreamWriter(w)
+	keepAlive := time.NewTicker(responseKeepAliveInterval)
+	defer keepAlive.Stop()
+	pending := make(map[string]bool, len(requestIds))
+	for _, id := range requestIds {
+		pending[id] = true
+	}
+	for len(pending) > 0 {
+		select {
+		case response := <-received:
+			if !pending[response.RequestId] {
+				continue
+			}
+			delete(pending, response.RequestId)
+			err = stream.writeEvent("response", response.RequestId, response)
+		case <-keepAlive.C:
+			err = stream.writeComment("keep-alive")
+		case <-timer.C:
+			ids := make([]string, 0, len(pending))
+			for _, id := range requestIds {
+				if pending[id] {
+					ids = append(ids, id)
+				}
+			}
+			err = stream.writeEvent("timeout", "", ids)
+			pending = nil
+		case <-r.Context().Done():
+			return
+		}
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to stream command responses: %s", err.Error()))
+			return
+		}
+	}
+}
+
+// parseRequestIds returns the request IDs given as requestId query parameters, each of which may be a comma
+// separated list
+func parseRequestIds(r *http.Request) []string {
+	var ids []string
+	for _, value := range r.URL.Query()[REQUESTID] {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// acceptsEventStream tells whether the client asked for Server-Sent Events
+func acceptsEventStream(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if strings.TrimSpace(strings.Split(accept, ";")[0]) == ContentTypeEventStream {
+			return true
+		}
+	}
+	return false
+}
+
+// responseStreamWriter writes Server-Sent Events to a response, flushing the response after every event.
+type responseStreamWriter struct {
+	w http.ResponseWriter
+}
+
+func newResponseStreamWriter(w http.ResponseWriter) *responseStreamWriter {
+	w.Header().Set(clients.ContentType, ContentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	s := &responseStreamWriter{w: w}
+	s.flush()
+	return s
+}
+
+// writeEvent writes the event with the JSON encoding of data, which holds on a single line
+func (s *responseStreamWriter) writeEvent(event string, id string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var sb strings.Builder
+	if id != "" {
+		sb.WriteString("id: " + id + "\n")
+	}
+	sb.WriteString("event: " + event + "\n")
+	sb.WriteString("data: " + string(b) + "\n\n")
+	if _, err = s.w.Write([]byte(sb.String())); err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+func (s *responseStreamWriter) writeComment(comment string) error {
+	if _, err := s.w.Write([]byte(": " + comment + "\n\n")); err != nil {
+		return err
+	}
+	s.flush()
+	return nil
+}
+
+func (s *responseStreamWriter) flush() {
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,96 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getCommandResponses(hub *responses.Hub, query string, accept string, timeout string) *httptest.ResponseRecorder {
+	lc := logger.NewMockClient()
+	configuration := &config.ConfigurationStruct{CommandResponses: config.CommandResponsesInfo{Enabled: true, Timeout: timeout}}
+	req := httptest.NewRequest(http.MethodGet, "/"+COMMAND+"/"+RESPONSE+"?"+query, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rr := httptest.NewRecorder()
+	restGetCommandResponses(rr, req, lc, hub, configuration, errorconcept.NewErrorHandler(lc))
+	return rr
+}
+
+func publishCommandResponse(hub *responses.Hub, requestId string) {
+	hub.Publish(types.MessageEnvelope{CorrelationID: requestId, Payload: []byte(`{"device":"` + requestId + `"}`)})
+}
+
+func TestGetCommandResponsesLongPoll(t *testing.T) {
+	hub := responses.NewHub(time.Minute)
+	publishCommandResponse(hub, "req-1")
+
+	rr := getCommandResponses(hub, REQUESTID+"=req-0,req-1", "", "1s")
+	require.Equal(t, http.StatusOK, rr.Code)
+	var response responses.Response
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+	assert.Equal(t, "req-1", response.RequestId)
+	assert.JSONEq(t, `{"device":"req-1"}`, string(response.Payload))
+
+	rr = getCommandResponses(hub, REQUESTID+"=req-0", "", "10ms")
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+}
+
+func TestGetCommandResponsesEventStream(t *testing.T) {
+	hub := responses.NewHub(time.Minute)
+	publishCommandResponse(hub, "req-1")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		publishCommandResponse(hub, "req-2")
+	}()
+
+	rr := getCommandResponses(hub, REQUESTID+"=req-1&"+REQUESTID+"=req-2", ContentTypeEventStream, "1s")
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, ContentTypeEventStream, rr.Header().Get("Content-Type"))
+	events := strings.Split(strings.TrimSpace(rr.Body.String()), "\n\n")
+	require.Len(t, events, 2)
+	assert.True(t, strings.HasPrefix(events[0], "id: req-1\nevent: response\ndata: {"), events[0])
+	assert.True(t, strings.HasPrefix(events[1], "id: req-2\nevent: response\ndata: {"), events[1])
+
+	// the stream ends with the requests still pending once the timeout elapsed
+	rr = getCommandResponses(hub, REQUESTID+"=req-1,req-3", ContentTypeEventStream, "10ms")
+	events = strings.Split(strings.TrimSpace(rr.Body.String()), "\n\n")
+	require.Len(t, events, 2)
+	assert.Equal(t, `event: timeout`+"\n"+`data: ["req-3"]`, events[1])
+}
+
+func TestGetCommandResponsesInvalid(t *testing.T) {
+	rr := getCommandResponses(responses.NewHub(time.Minute), "", "", "1s")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = getCommandResponses(nil, REQUESTID+"=req-1", "", "1s")
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+}
@@ -0,0 +1,138 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+
+	"github.com/gorilla/mux"
+)
+
+// restGetDeviceSimulation returns the simulation of the named device, not found when the device isn't simulated
+// api/v1/device/name/{name}/simulation
+func restGetDeviceSimulation(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		handleDeviceSimulationError(w, err, httpErrorHandler)
+		return
+	}
+
+	simulation, err := dbClient.GetDeviceSimulation(d.Id)
+	if err != nil {
+		handleDeviceSimulationError(w, err, httpErrorHandler)
+		return
+	}
+	if simulation.Values == nil {
+		simulation.Values = map[string]string{}
+	}
+
+	pkg.Encode(simulation, w, lc)
+}
+
+// restSetDeviceSimulation simulates the named device, its commands being answered by core-command rather than by its
+// device service. The values of the optional body are those its GET commands answer with, they are validated against
+// the device resources of the device's profile.
+// api/v1/device/name/{name}/simulation
+func restSetDeviceSimulation(
+	w http.ResponseWriter,
+	r *http.Request,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer r.Body.Close()
+
+	var simulation models.DeviceSimulation
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&simulation); err != nil {
+			httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+			return
+		}
+	}
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		handleDeviceSimulationError(w, err, httpErrorHandler)
+		return
+	}
+
+	if err = validateSimulatedValues(d, simulation.Values); err == nil {
+		simulationMutex.Lock()
+		err = dbClient.SetDeviceSimulation(d.Id, simulation)
+		simulationMutex.Unlock()
+	}
+	if err != nil {
+		handleDeviceSimulationError(w, err, httpErrorHandler)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("true"))
+}
+
+// restDeleteDeviceSimulation stops simulating the named device, its commands are sent to its device service again
+// api/v1/device/name/{name}/simulation
+func restDeleteDeviceSimulation(
+	w http.ResponseWriter,
+	r *http.Request,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		handleDeviceSimulationError(w, err, httpErrorHandler)
+		return
+	}
+
+	simulationMutex.Lock()
+	err = dbClient.DeleteDeviceSimulation(d.Id)
+	simulationMutex.Unlock()
+	if err != nil {
+		handleDeviceSimulationError(w, err, httpErrorHandler)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("true"))
+}
+
+func handleDeviceSimulationError(w http.ResponseWriter, err error, httpErrorHandler errorconcept.ErrorHandler) {
+	httpErrorHandler.HandleManyVariants(
+		w,
+		err,
+		[]errorconcept.ErrorConceptType{
+			errorconcept.NewServiceClientHttpError(err),
+			errorconcept.Database.NotFound,
+			errorconcept.Command.InvalidSimulatedValue,
+		},
+		errorconcept.Default.InternalServerError)
+}
@@ -0,0 +1,138 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+
+	"github.com/gorilla/mux"
+)
+
+// restGetDeviceValueTransforms returns the value transformation overrides of the named device, by device resource
+// name
+// api/v1/device/name/{name}/transform
+func restGetDeviceValueTransforms(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		handleDeviceValueTransformError(w, err, httpErrorHandler)
+		return
+	}
+
+	transforms, err := dbClient.GetDeviceValueTransforms(d.Id)
+	if err != nil && err != db.ErrNotFound {
+		handleDeviceValueTransformError(w, err, httpErrorHandler)
+		return
+	}
+	if transforms == nil {
+		transforms = map[string]models.ValueTransform{}
+	}
+
+	pkg.Encode(transforms, w, lc)
+}
+
+// restSetDeviceValueTransforms replaces the value transformation overrides of the named device. The overrides are
+// validated against the device resources of the device's profile.
+// api/v1/device/name/{name}/transform
+func restSetDeviceValueTransforms(
+	w http.ResponseWriter,
+	r *http.Request,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer r.Body.Close()
+
+	var transforms map[string]models.ValueTransform
+	if err := json.NewDecoder(r.Body).Decode(&transforms); err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		handleDeviceValueTransformError(w, err, httpErrorHandler)
+		return
+	}
+
+	if len(transforms) == 0 {
+		err = dbClient.DeleteDeviceValueTransforms(d.Id)
+		if err == db.ErrNotFound {
+			err = nil
+		}
+	} else if _, err = deviceValueTransforms(d, transforms); err == nil {
+		err = dbClient.SetDeviceValueTransforms(d.Id, transforms)
+	}
+	if err != nil {
+		handleDeviceValueTransformError(w, err, httpErrorHandler)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("true"))
+}
+
+// restDeleteDeviceValueTransforms removes the value transformation overrides of the named device, its values are
+// transformed by its profile again
+// api/v1/device/name/{name}/transform
+func restDeleteDeviceValueTransforms(
+	w http.ResponseWriter,
+	r *http.Request,
+	dbClient interfaces.DBClient,
+	deviceClient metadata.DeviceClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	d, err := deviceClient.DeviceForName(r.Context(), mux.Vars(r)[NAME])
+	if err != nil {
+		handleDeviceValueTransformError(w, err, httpErrorHandler)
+		return
+	}
+
+	if err = dbClient.DeleteDeviceValueTransforms(d.Id); err != nil {
+		handleDeviceValueTransformError(w, err, httpErrorHandler)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("true"))
+}
+
+func handleDeviceValueTransformError(w http.ResponseWriter, err error, httpErrorHandler errorconcept.ErrorHandler) {
+	httpErrorHandler.HandleManyVariants(
+		w,
+		err,
+		[]errorconcept.ErrorConceptType{
+			errorconcept.NewServiceClientHttpError(err),
+			errorconcept.Database.NotFound,
+			errorconcept.Command.InvalidValueTransform,
+		},
+		errorconcept.Default.InternalServerError)
+}
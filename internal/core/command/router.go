@@ -19,10 +19,16 @@ import (
 
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/usage"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -58,13 +64,85 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Usage
+	r.HandleFunc(
+		usage.ApiUsageRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			usage.DefaultRecorder.ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Feature flags
+	r.HandleFunc(
+		featureflag.ApiFeatureFlagsRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.FeatureFlagsFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Configuration drift
+	r.HandleFunc(
+		configdrift.ApiConfigDriftRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.ConfigDriftDetectorFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	b := r.PathPrefix(clients.ApiBase).Subrouter()
 
 	loadDeviceRoutes(b, dic)
+	loadAuditRoutes(b, dic)
+	loadResponseRoutes(b, dic)
 
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(usage.Middleware)
+	r.Use(bodylimit.NewMiddleware(commandContainer.ConfigurationFrom(dic.Get).RequestLimits, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(claims.NewMiddleware(commandContainer.ConfigurationFrom(dic.Get).TrustedHeaders, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(compression.NewMiddleware(func() compression.ResponsesInfo {
+		return commandContainer.ConfigurationFrom(dic.Get).Writable.Compression
+	}))
+}
+
+func loadAuditRoutes(b *mux.Router, dic *di.Container) {
+	// /api/<version>/audit
+	a := b.PathPrefix("/" + AUDIT).Subrouter()
+
+	a.HandleFunc(
+		"/"+START+"/{"+START+"}/"+END+"/{"+END+"}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandAudits(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	a.HandleFunc(
+		"/"+DEVICE+"/{"+NAME+"}/"+START+"/{"+START+"}/"+END+"/{"+END+"}/{"+LIMIT+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandAuditsByDevice(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+}
+
+func loadResponseRoutes(b *mux.Router, dic *di.Container) {
+	// /api/<version>/command/response
+	b.HandleFunc(
+		"/"+COMMAND+"/"+RESPONSE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCommandResponses(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				commandContainer.CommandResponseHubFrom(dic.Get),
+				commandContainer.ConfigurationFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
 }
 
 func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
@@ -81,6 +159,10 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 		}).Methods(http.MethodGet)
 
 	d := b.PathPrefix("/" + DEVICE).Subrouter()
+	d.Use(auditCommands(dic))
+	d.Use(countCommands(dic))
+	d.Use(limitCommands(dic))
+	d.Use(simulateCommands(dic))
 
 	// /api/<version>/device
 	d.HandleFunc(
@@ -104,7 +186,8 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				&http.Client{},
+				commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAccess)
 		}).Methods(http.MethodGet)
 	d.HandleFunc(
 		"/{"+ID+"}/"+COMMAND+"/{"+COMMANDID+"}",
@@ -116,7 +199,8 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				&http.Client{},
+				commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAccess)
 		}).Methods(http.MethodPut)
 	// In the block of code above, as well as in the one that follows below,
 	// there are two references each to http.Client. Putting them into the
@@ -154,7 +238,8 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				&http.Client{},
+				commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAccess)
 		}).Methods(http.MethodGet)
 	dn.HandleFunc(
 		"/{"+NAME+"}/"+COMMAND+"/{"+COMMANDNAME+"}",
@@ -166,6 +251,82 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				commandContainer.MetadataDeviceClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
-				&http.Client{})
+				&http.Client{},
+				commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAccess)
+		}).Methods(http.MethodPut)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+RESOURCES,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDeviceResourcesByName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				&http.Client{},
+				commandContainer.ConfigurationFrom(dic.Get).Writable.CommandAccess)
+		}).Methods(http.MethodGet)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+TRANSFORM,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDeviceValueTransforms(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+TRANSFORM,
+		func(w http.ResponseWriter, r *http.Request) {
+			restSetDeviceValueTransforms(
+				w,
+				r,
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodPut)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+TRANSFORM,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteDeviceValueTransforms(
+				w,
+				r,
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+SIMULATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDeviceSimulation(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+SIMULATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restSetDeviceSimulation(
+				w,
+				r,
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	dn.HandleFunc(
+		"/{"+NAME+"}/"+SIMULATION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteDeviceSimulation(
+				w,
+				r,
+				container.DBClientFrom(dic.Get),
+				commandContainer.MetadataDeviceClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodDelete)
 }
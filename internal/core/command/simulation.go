@@ -0,0 +1,264 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/coredata"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// SimulatedHeader is the response header set to 'true' when the command was answered by core-command on behalf of a
+// simulated device rather than by its device service.
+const SimulatedHeader = "X-Command-Simulated"
+
+type readingClientContextKey struct{}
+
+// simulationMutex serializes the updates of the values of the simulated devices
+var simulationMutex sync.Mutex
+
+// simulateCommands returns a middleware handing the core-data reading client to the commands of the requests, so that
+// the GET commands of the simulated devices answer with their last readings.
+func simulateCommands(dic *di.Container) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			client := container.CoreDataReadingClientFrom(dic.Get)
+			if client == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(withReadingClient(r.Context(), client)))
+		})
+	}
+}
+
+// withReadingClient returns the context reading the last readings of the simulated devices with the client, unchanged
+// when the client is nil
+func withReadingClient(ctx context.Context, client coredata.ReadingClient) context.Context {
+	if client == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, readingClientContextKey{}, client)
+}
+
+// deviceSimulation returns the simulation of the device, and whether the device is simulated
+func deviceSimulation(device contract.Device, dbClient interfaces.DBClient) (models.DeviceSimulation, bool, error) {
+	simulation, err := dbClient.GetDeviceSimulation(device.Id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return models.DeviceSimulation{}, false, nil
+		}
+		return models.DeviceSimulation{}, false, err
+	}
+	return simulation, true, nil
+}
+
+// simulatedCommand answers a command of a simulated device without calling its device service
+type simulatedCommand struct {
+	ctx      context.Context
+	device   contract.Device
+	command  contract.Command
+	method   string
+	body     string
+	dbClient interfaces.DBClient
+	lc       logger.LoggingClient
+}
+
+func newSimulatedCommand(
+	ctx context.Context,
+	device contract.Device,
+	command contract.Command,
+	method string,
+	body string,
+	dbClient interfaces.DBClient,
+	lc logger.LoggingClient) simulatedCommand {
+
+	return simulatedCommand{
+		ctx:      ctx,
+		device:   device,
+		command:  command,
+		method:   method,
+		body:     body,
+		dbClient: dbClient,
+		lc:       lc,
+	}
+}
+
+// Execute answers a GET command with an event holding the simulated values of the resources the command reads, and
+// a PUT command by storing the values of the resources the command writes.
+func (sc simulatedCommand) Execute() (*http.Response, error) {
+	if sc.method == http.MethodPut {
+		return sc.set()
+	}
+	return sc.get()
+}
+
+func (sc simulatedCommand) get() (*http.Response, error) {
+	simulation, _, err := deviceSimulation(sc.device, sc.dbClient)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := time.Now().UnixNano() / int64(time.Millisecond)
+	readings := []map[string]interface{}{}
+	for _, name := range commandReadResources(sc.device.Profile, sc.command.Name) {
+		value, ok := sc.simulatedValue(simulation, name)
+		if !ok {
+			// like the device services, no reading is returned for a resource without a value
+			continue
+		}
+		reading := map[string]interface{}{
+			"name":   name,
+			"value":  value,
+			"device": sc.device.Name,
+			"origin": origin,
+		}
+		if resource, ok := profileResource(sc.device.Profile, name); ok && resource.Properties.Value.Type != "" {
+			reading["valueType"] = resource.Properties.Value.Type
+			// the simulated values are kept as text, never Base64 encoded
+			if isFloatType(resource.Properties.Value.Type) {
+				reading["floatEncoding"] = contract.ENotation
+			}
+		}
+		readings = append(readings, reading)
+	}
+
+	event, err := json.Marshal(map[string]interface{}{
+		"device":   sc.device.Name,
+		"origin":   origin,
+		"readings": readings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return simulatedResponse(http.StatusOK, clients.ContentTypeJSON, string(event)), nil
+}
+
+// simulatedValue returns the value set to the resource while simulated, or else the value of its last reading in
+// core-data, or else the default value of the resource in the profile, or else the zero value of its boolean or
+// numeric type. False is returned when the resource has none of them.
+func (sc simulatedCommand) simulatedValue(simulation models.DeviceSimulation, name string) (string, bool) {
+	if value, ok := simulation.Values[name]; ok && value != "" {
+		return value, true
+	}
+
+	if client, ok := sc.ctx.Value(readingClientContextKey{}).(coredata.ReadingClient); ok {
+		readings, err := client.ReadingsForNameAndDevice(sc.ctx, name, sc.device.Name, 1)
+		if err != nil {
+			sc.lc.Warn(fmt.Sprintf("reading the last %s reading of simulated device %s from core-data failed: %s",
+				name, sc.device.Name, err.Error()))
+		} else if len(readings) > 0 && readings[0].Value != "" {
+			return readings[0].Value, true
+		}
+	}
+
+	resource, _ := profileResource(sc.device.Profile, name)
+	value := resource.Properties.Value
+	switch {
+	case value.DefaultValue != "":
+		return value.DefaultValue, true
+	case strings.EqualFold(value.Type, "bool"):
+		return "false", true
+	case isNumericType(value.Type):
+		return "0", true
+	}
+	return "", false
+}
+
+func (sc simulatedCommand) set() (*http.Response, error) {
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(sc.body), &params); err != nil {
+		return nil, errors.NewErrInvalidSimulatedValue(sc.command.Name, "malformed parameters: "+err.Error())
+	}
+
+	simulationMutex.Lock()
+	defer simulationMutex.Unlock()
+
+	simulation, simulated, err := deviceSimulation(sc.device, sc.dbClient)
+	if err != nil {
+		return nil, err
+	}
+	if !simulated {
+		return nil, db.ErrNotFound
+	}
+	if simulation.Values == nil {
+		simulation.Values = make(map[string]string)
+	}
+	for _, name := range commandWriteResources(sc.device.Profile, sc.command.Name) {
+		param, ok := params[name]
+		if !ok {
+			continue
+		}
+		if value, ok := param.(string); ok {
+			simulation.Values[name] = value
+		} else {
+			simulation.Values[name] = fmt.Sprint(param)
+		}
+	}
+	if err = sc.dbClient.SetDeviceSimulation(sc.device.Id, simulation); err != nil {
+		return nil, err
+	}
+
+	return simulatedResponse(http.StatusOK, clients.ContentTypeText, ""), nil
+}
+
+// validateSimulatedValues checks the values set to a simulated device are those of resources of its profile
+func validateSimulatedValues(device contract.Device, values map[string]string) error {
+	for name := range values {
+		if _, ok := profileResource(device.Profile, name); !ok {
+			return errors.NewErrInvalidSimulatedValue(name, "no such resource in profile "+device.Profile.Name)
+		}
+	}
+	return nil
+}
+
+func profileResource(profile contract.DeviceProfile, name string) (contract.DeviceResource, bool) {
+	for _, r := range profile.DeviceResources {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return contract.DeviceResource{}, false
+}
+
+func simulatedResponse(statusCode int, contentType string, body string) *http.Response {
+	header := make(http.Header)
+	header.Set(clients.ContentType, contentType)
+	header.Set(SimulatedHeader, "true")
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
@@ -0,0 +1,209 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	mdMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/coredata"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// lastReadingClient answers the last readings of the resources from the map, none for an empty value, failing for
+// the resources left out
+type lastReadingClient struct {
+	coredata.ReadingClient
+	values map[string]string
+}
+
+func (c lastReadingClient) ReadingsForNameAndDevice(_ context.Context, name string, _ string, _ int) ([]contract.Reading, error) {
+	value, ok := c.values[name]
+	if !ok {
+		return nil, fmt.Errorf("core-data unavailable")
+	}
+	if value == "" {
+		return []contract.Reading{}, nil
+	}
+	return []contract.Reading{{Name: name, Value: value}}, nil
+}
+
+func newSimulationDevice() contract.Device {
+	device := newResourcesDevice()
+	device.Profile.DeviceResources = []contract.DeviceResource{
+		{Name: "Temperature", Properties: contract.ProfileProperty{Value: contract.PropertyValue{Type: "Float64"}}},
+		{Name: "Humidity", Properties: contract.ProfileProperty{Value: contract.PropertyValue{Type: "Int16", DefaultValue: "45"}}},
+		{Name: "Mode", Properties: contract.ProfileProperty{Value: contract.PropertyValue{Type: "String"}}},
+		{Name: "SetPoint", Properties: contract.ProfileProperty{Value: contract.PropertyValue{Type: "Float64"}}},
+	}
+	device.Profile.DeviceCommands = append(device.Profile.DeviceCommands, contract.ProfileResource{
+		Name: "Status",
+		Get: []contract.ResourceOperation{
+			{DeviceResource: "SetPoint"}, {DeviceResource: "Temperature"}, {DeviceResource: "Humidity"}, {DeviceResource: "Mode"},
+		},
+	})
+	return device
+}
+
+func TestSimulatedCommandGet(t *testing.T) {
+	device := newSimulationDevice()
+	command := contract.Command{Name: "Status", Get: contract.Get{Action: contract.Action{Path: "/api/v1/device/{deviceId}/Status"}}}
+
+	tests := []struct {
+		name     string
+		client   coredata.ReadingClient
+		expected []string
+	}{
+		{"last readings", lastReadingClient{values: map[string]string{"Temperature": "21.5", "Humidity": "40", "Mode": "heat"}},
+			[]string{"19", "21.5", "40", "heat"}},
+		{"profile defaults without readings", lastReadingClient{values: map[string]string{"Temperature": "", "Humidity": "", "Mode": ""}},
+			[]string{"19", "0", "45"}},
+		{"profile defaults when core-data fails", lastReadingClient{}, []string{"19", "0", "45"}},
+		{"profile defaults without core-data", nil, []string{"19", "0", "45"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClient := &mocks.DBClient{}
+			dbClient.On("GetDeviceSimulation", device.Id).
+				Return(models.DeviceSimulation{Values: map[string]string{"SetPoint": "19"}}, nil)
+
+			ctx := withReadingClient(context.Background(), tt.client)
+			response, err := newSimulatedCommand(ctx, device, command, http.MethodGet, "", dbClient, logger.NewMockClient()).Execute()
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			assert.Equal(t, "true", response.Header.Get(SimulatedHeader))
+
+			var event contract.Event
+			require.NoError(t, json.NewDecoder(response.Body).Decode(&event))
+			assert.Equal(t, device.Name, event.Device)
+			require.Len(t, event.Readings, len(tt.expected))
+			for i, value := range tt.expected {
+				assert.Equal(t, value, event.Readings[i].Value, event.Readings[i].Name)
+			}
+		})
+	}
+}
+
+func TestSimulatedCommandSet(t *testing.T) {
+	device := newSimulationDevice()
+	command := contract.Command{Name: "SetPoint", Put: contract.Put{Action: contract.Action{Path: "/api/v1/device/{deviceId}/SetPoint"}}}
+
+	dbClient := &mocks.DBClient{}
+	dbClient.On("GetDeviceSimulation", device.Id).
+		Return(models.DeviceSimulation{Values: map[string]string{"Mode": "heat"}}, nil)
+	dbClient.On("SetDeviceSimulation", device.Id,
+		models.DeviceSimulation{Values: map[string]string{"Mode": "heat", "SetPoint": "21.5"}}).Return(nil)
+
+	response, err := newSimulatedCommand(context.Background(), device, command, http.MethodPut,
+		`{"SetPoint":"21.5","Mode":"cool"}`, dbClient, logger.NewMockClient()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	dbClient.AssertExpectations(t)
+
+	_, err = newSimulatedCommand(context.Background(), device, command, http.MethodPut,
+		`{"SetPoint":`, dbClient, logger.NewMockClient()).Execute()
+	require.Error(t, err)
+}
+
+func TestExecuteCommandOfSimulatedDevice(t *testing.T) {
+	device := newSimulationDevice()
+	commands := newResourcesCommands()
+	dbClient := &mocks.DBClient{}
+	dbClient.On("GetDeviceValueTransforms", device.Id).Return(nil, db.ErrNotFound)
+	dbClient.On("GetDeviceSimulation", device.Id).Return(models.DeviceSimulation{}, nil)
+	httpCaller := &mdMocks.HttpCaller{}
+
+	req := httptest.NewRequest(http.MethodGet, cmdURI+"/name/thermostat/command/Climate", nil)
+	response, body, err := executeCommandByDevice(req.Context(), device, commands[1], "", logger.NewMockClient(),
+		dbClient, req, httpCaller, config.CommandAccessInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, "true", response.Header.Get(SimulatedHeader))
+	assert.Contains(t, body, `"name":"Temperature"`)
+	httpCaller.AssertNotCalled(t, "Do", mock.Anything)
+}
+
+func TestRestSetDeviceSimulation(t *testing.T) {
+	device := newSimulationDevice()
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{"no values", "", http.StatusOK},
+		{"values", `{"values":{"Temperature":"21.5"}}`, http.StatusOK},
+		{"unknown resource", `{"values":{"Pressure":"1013"}}`, http.StatusBadRequest},
+		{"malformed body", `{"values":`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deviceClient := &mdMocks.DeviceClient{}
+			deviceClient.On("DeviceForName", mock.Anything, device.Name).Return(device, nil)
+			dbClient := &mocks.DBClient{}
+			dbClient.On("SetDeviceSimulation", device.Id, mock.Anything).Return(nil)
+
+			req := httptest.NewRequest(http.MethodPut, cmdURI+"/name/thermostat/"+SIMULATION, strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{NAME: device.Name})
+			rr := httptest.NewRecorder()
+			restSetDeviceSimulation(rr, req, dbClient, deviceClient, errorconcept.NewErrorHandler(logger.NewMockClient()))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				dbClient.AssertCalled(t, "SetDeviceSimulation", device.Id, mock.Anything)
+			} else {
+				dbClient.AssertNotCalled(t, "SetDeviceSimulation", device.Id, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestRestGetDeviceSimulation(t *testing.T) {
+	device := newSimulationDevice()
+	deviceClient := &mdMocks.DeviceClient{}
+	deviceClient.On("DeviceForName", mock.Anything, device.Name).Return(device, nil)
+	dbClient := &mocks.DBClient{}
+	dbClient.On("GetDeviceSimulation", device.Id).Return(models.DeviceSimulation{}, db.ErrNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, cmdURI+"/name/thermostat/"+SIMULATION, nil)
+	req = mux.SetURLVars(req, map[string]string{NAME: device.Name})
+	rr := httptest.NewRecorder()
+	loggerMock := logger.NewMockClient()
+	restGetDeviceSimulation(rr, req, loggerMock, dbClient, deviceClient, errorconcept.NewErrorHandler(loggerMock))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code, "a device not simulated has no simulation")
+	body, _ := ioutil.ReadAll(rr.Body)
+	assert.NotEmpty(t, body)
+}
@@ -0,0 +1,259 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// transform is the transformation a device service applies to the raw value of a device resource: the base raised
+// to the power of the value when the base is set, multiplied by the scale, plus the offset.
+type transform struct {
+	base   float64
+	scale  float64
+	offset float64
+}
+
+func parseTransform(base string, scale string, offset string) (transform, error) {
+	t := transform{scale: 1}
+	var err error
+	if base != "" {
+		if t.base, err = strconv.ParseFloat(base, 64); err != nil {
+			return transform{}, fmt.Errorf("base '%s' is not a number", base)
+		}
+		if t.base != 0 && (t.base < 0 || t.base == 1) {
+			return transform{}, fmt.Errorf("base %s can't be inverted", base)
+		}
+	}
+	if scale != "" {
+		if t.scale, err = strconv.ParseFloat(scale, 64); err != nil {
+			return transform{}, fmt.Errorf("scale '%s' is not a number", scale)
+		}
+		if t.scale == 0 {
+			return transform{}, fmt.Errorf("scale 0 can't be inverted")
+		}
+	}
+	if offset != "" {
+		if t.offset, err = strconv.ParseFloat(offset, 64); err != nil {
+			return transform{}, fmt.Errorf("offset '%s' is not a number", offset)
+		}
+	}
+	return t, nil
+}
+
+func (t transform) apply(raw float64) float64 {
+	v := raw
+	if t.base != 0 {
+		v = math.Pow(t.base, v)
+	}
+	return v*t.scale + t.offset
+}
+
+func (t transform) invert(v float64) (float64, error) {
+	raw := (v - t.offset) / t.scale
+	if t.base != 0 {
+		if raw <= 0 {
+			return 0, fmt.Errorf("value %v is out of the range of base %v", v, t.base)
+		}
+		raw = math.Log(raw) / math.Log(t.base)
+	}
+	return raw, nil
+}
+
+// resourceTransform converts the values of a device resource between the transformation of the device's profile,
+// applied by the device service, and the transformation overridden for the device.
+type resourceTransform struct {
+	valueType string
+	profile   transform
+	device    transform
+}
+
+// toDevice converts a value transformed by the profile into the value transformed by the device's override
+func (t resourceTransform) toDevice(value string) (string, error) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", fmt.Errorf("value '%s' is not a number", value)
+	}
+	raw, err := t.profile.invert(v)
+	if err != nil {
+		return "", err
+	}
+	return t.format(t.device.apply(raw)), nil
+}
+
+// toProfile converts a value transformed by the device's override into the value transformed by the profile
+func (t resourceTransform) toProfile(value string) (string, error) {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return "", fmt.Errorf("value '%s' is not a number", value)
+	}
+	raw, err := t.device.invert(v)
+	if err != nil {
+		return "", err
+	}
+	return t.format(t.profile.apply(raw)), nil
+}
+
+func (t resourceTransform) format(v float64) string {
+	if isFloatType(t.valueType) {
+		return strconv.FormatFloat(v, 'e', -1, 64)
+	}
+	return strconv.FormatInt(int64(math.Round(v)), 10)
+}
+
+func isFloatType(valueType string) bool {
+	return strings.HasPrefix(strings.ToLower(valueType), "float")
+}
+
+func isNumericType(valueType string) bool {
+	t := strings.ToLower(valueType)
+	return strings.HasPrefix(t, "int") || strings.HasPrefix(t, "uint") || strings.HasPrefix(t, "float")
+}
+
+// deviceValueTransforms resolves the value transformation overrides of the device against the device resources of
+// its profile, by resource name
+func deviceValueTransforms(
+	device contract.Device,
+	overrides map[string]models.ValueTransform) (map[string]resourceTransform, error) {
+
+	transforms := make(map[string]resourceTransform, len(overrides))
+	for name, override := range overrides {
+		var resource *contract.DeviceResource
+		for i := range device.Profile.DeviceResources {
+			if device.Profile.DeviceResources[i].Name == name {
+				resource = &device.Profile.DeviceResources[i]
+				break
+			}
+		}
+		if resource == nil {
+			return nil, errors.NewErrInvalidValueTransform(name, "no such resource in profile "+device.Profile.Name)
+		}
+
+		value := resource.Properties.Value
+		if !isNumericType(value.Type) {
+			return nil, errors.NewErrInvalidValueTransform(name, "resource of type "+value.Type+" isn't numeric")
+		}
+		profile, err := parseTransform(value.Base, value.Scale, value.Offset)
+		if err != nil {
+			return nil, errors.NewErrInvalidValueTransform(name, "profile "+err.Error())
+		}
+
+		base, scale, offset := value.Base, value.Scale, value.Offset
+		if override.Base != "" {
+			base = override.Base
+		}
+		if override.Scale != "" {
+			scale = override.Scale
+		}
+		if override.Offset != "" {
+			offset = override.Offset
+		}
+		overridden, err := parseTransform(base, scale, offset)
+		if err != nil {
+			return nil, errors.NewErrInvalidValueTransform(name, err.Error())
+		}
+
+		transforms[name] = resourceTransform{valueType: value.Type, profile: profile, device: overridden}
+	}
+	return transforms, nil
+}
+
+// loadValueTransforms returns the value transforms of the device, none when the device has no override
+func loadValueTransforms(device contract.Device, dbClient interfaces.DBClient) (map[string]resourceTransform, error) {
+	overrides, err := dbClient.GetDeviceValueTransforms(device.Id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return deviceValueTransforms(device, overrides)
+}
+
+// transformReadings converts the values of the readings of the event returned by a device service into the values
+// transformed by the device's overrides. Float values encoded in Base64 are left as is.
+func transformReadings(body []byte, transforms map[string]resourceTransform) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var event map[string]interface{}
+	if err := decoder.Decode(&event); err != nil {
+		return nil, err
+	}
+
+	readings, _ := event["readings"].([]interface{})
+	for _, r := range readings {
+		reading, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := reading["name"].(string)
+		t, ok := transforms[name]
+		if !ok || reading["floatEncoding"] == contract.Base64Encoding {
+			continue
+		}
+		value, _ := reading["value"].(string)
+		transformed, err := t.toDevice(value)
+		if err != nil {
+			return nil, errors.NewErrInvalidValueTransform(name, err.Error())
+		}
+		reading["value"] = transformed
+	}
+
+	return json.Marshal(event)
+}
+
+// transformParameters converts the values of the parameters of a PUT command, given in the units of the device's
+// overrides, into the values the device service expects
+func transformParameters(body string, transforms map[string]resourceTransform) (string, error) {
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &params); err != nil {
+		// the device service reports the malformed body
+		return body, nil
+	}
+
+	for name, param := range params {
+		t, ok := transforms[name]
+		if !ok {
+			continue
+		}
+		value, ok := param.(string)
+		if !ok {
+			continue
+		}
+		transformed, err := t.toProfile(value)
+		if err != nil {
+			return "", errors.NewErrInvalidValueTransform(name, err.Error())
+		}
+		params[name] = transformed
+	}
+
+	b, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,140 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTransformDevice() contract.Device {
+	return contract.Device{
+		Id:   "thermometer-id",
+		Name: "thermometer",
+		Profile: contract.DeviceProfile{
+			Name: "Thermometer-Profile",
+			DeviceResources: []contract.DeviceResource{
+				{Name: "Temperature", Properties: contract.ProfileProperty{
+					Value: contract.PropertyValue{Type: "Float64", Scale: "0.1", Offset: "-40"},
+				}},
+				{Name: "Humidity", Properties: contract.ProfileProperty{
+					Value: contract.PropertyValue{Type: "Int16"},
+				}},
+				{Name: "Label", Properties: contract.ProfileProperty{
+					Value: contract.PropertyValue{Type: "String"},
+				}},
+			},
+		},
+	}
+}
+
+func TestDeviceValueTransforms(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]models.ValueTransform
+		valid     bool
+	}{
+		{"offset", map[string]models.ValueTransform{"Temperature": {Offset: "-39.5"}}, true},
+		{"scale and base", map[string]models.ValueTransform{"Humidity": {Scale: "2", Base: "10"}}, true},
+		{"unknown resource", map[string]models.ValueTransform{"Pressure": {Scale: "2"}}, false},
+		{"non numeric resource", map[string]models.ValueTransform{"Label": {Scale: "2"}}, false},
+		{"zero scale", map[string]models.ValueTransform{"Humidity": {Scale: "0"}}, false},
+		{"invalid offset", map[string]models.ValueTransform{"Humidity": {Offset: "one"}}, false},
+		{"base one", map[string]models.ValueTransform{"Humidity": {Base: "1"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := deviceValueTransforms(newTransformDevice(), tt.overrides)
+			if tt.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.IsType(t, errors.ErrInvalidValueTransform{}, err)
+		})
+	}
+}
+
+func TestTransformReadings(t *testing.T) {
+	transforms, err := deviceValueTransforms(newTransformDevice(), map[string]models.ValueTransform{
+		// the sensor reads half a degree too high, its raw value is in tenths of a degree
+		"Temperature": {Offset: "-40.5"},
+		"Humidity":    {Scale: "2"},
+	})
+	require.NoError(t, err)
+
+	event := contract.Event{
+		Device: "thermometer",
+		Readings: []contract.Reading{
+			{Name: "Temperature", Value: "2.15e+01", ValueType: "Float64", FloatEncoding: contract.ENotation},
+			{Name: "Humidity", Value: "40", ValueType: "Int16"},
+			{Name: "Label", Value: "kitchen", ValueType: "String"},
+		},
+	}
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	body, err = transformReadings(body, transforms)
+	require.NoError(t, err)
+
+	var transformed contract.Event
+	require.NoError(t, json.Unmarshal(body, &transformed))
+	assert.Equal(t, "thermometer", transformed.Device)
+	require.Len(t, transformed.Readings, 3)
+	assert.Equal(t, "2.1e+01", transformed.Readings[0].Value)
+	assert.Equal(t, "80", transformed.Readings[1].Value)
+	assert.Equal(t, "kitchen", transformed.Readings[2].Value)
+}
+
+func TestTransformParameters(t *testing.T) {
+	transforms, err := deviceValueTransforms(newTransformDevice(), map[string]models.ValueTransform{
+		"Temperature": {Offset: "-40.5"},
+		"Humidity":    {Scale: "2"},
+	})
+	require.NoError(t, err)
+
+	body, err := transformParameters(`{"Temperature":"2.1e+01","Humidity":"80","Label":"kitchen"}`, transforms)
+	require.NoError(t, err)
+
+	var params map[string]string
+	require.NoError(t, json.Unmarshal([]byte(body), &params))
+	assert.Equal(t, map[string]string{"Temperature": "2.15e+01", "Humidity": "40", "Label": "kitchen"}, params)
+
+	_, err = transformParameters(`{"Humidity":"high"}`, transforms)
+	assert.IsType(t, errors.ErrInvalidValueTransform{}, err)
+}
+
+func TestTransformInvert(t *testing.T) {
+	tr, err := parseTransform("10", "2", "1")
+	require.NoError(t, err)
+
+	v := tr.apply(3)
+	assert.Equal(t, 2001.0, v)
+	raw, err := tr.invert(v)
+	require.NoError(t, err)
+	assert.InDelta(t, 3, raw, 1e-9)
+
+	_, err = tr.invert(0)
+	assert.Error(t, err, "value out of the range of the base inverted")
+}
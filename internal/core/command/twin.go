@@ -0,0 +1,318 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/interfaces"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/types"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// twinClient reads the twins of the devices from core-metadata and reports their reconciliation
+type twinClient interface {
+	AllTwins(ctx context.Context) ([]twin.Twin, error)
+	Report(ctx context.Context, deviceName string, report twin.Report) error
+}
+
+// twinReconciler issues the set commands bringing the devices to the desired state of their twin
+type twinReconciler struct {
+	twins        twinClient
+	lc           logger.LoggingClient
+	dbClient     interfaces.DBClient
+	deviceClient metadata.DeviceClient
+	httpCaller   internal.HttpCaller
+	// access returns the current command access configuration, the elevated set commands can't be reconciled
+	access func() config.CommandAccessInfo
+}
+
+// reconcileDeviceTwins reconciles the devices against their twin every Twin.ReconcileInterval, until the service is
+// exiting.
+func reconcileDeviceTwins(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) error {
+	configuration := container.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	interval, err := time.ParseDuration(configuration.Twin.ReconcileInterval)
+	if err != nil || interval <= 0 {
+		return fmt.Errorf("invalid Twin.ReconcileInterval '%s'", configuration.Twin.ReconcileInterval)
+	}
+
+	reconciler := &twinReconciler{
+		twins: twin.NewClient(endpoints.NewURLClient(
+			pkgContainer.EndpointsRegistryFrom(dic.Get), "Metadata", configuration.Clients["Metadata"], "")),
+		lc:           lc,
+		dbClient:     pkgContainer.DBClientFrom(dic.Get),
+		deviceClient: container.MetadataDeviceClientFrom(dic.Get),
+		httpCaller:   &http.Client{},
+		access: func() config.CommandAccessInfo {
+			return container.ConfigurationFrom(dic.Get).Writable.CommandAccess
+		},
+	}
+
+	// The set commands of the reconciliation wait for the commands issued to the devices through the service, and the
+	// simulated devices answer with their last readings.
+	commandCtx := withReadingClient(withCommandLimiter(ctx, container.CommandLimiterFrom(dic.Get)),
+		container.CoreDataReadingClientFrom(dic.Get))
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reconciler.reconcileAll(commandCtx)
+			}
+		}
+	}()
+
+	lc.Info(fmt.Sprintf("Reconciling the devices against their twin every %s", interval))
+	return nil
+}
+
+// reconcileAll reconciles every device with a twin and reports the outcome to core-metadata
+func (r *twinReconciler) reconcileAll(ctx context.Context) {
+	twins, err := r.twins.AllTwins(ctx)
+	if err != nil {
+		r.lc.Error(fmt.Sprintf("reading the device twins from core-metadata failed: %s", err.Error()))
+		return
+	}
+	for _, t := range twins {
+		if ctx.Err() != nil {
+			return
+		}
+		report, changed := r.reconcile(ctx, t)
+		if !changed {
+			continue
+		}
+		if err := r.twins.Report(ctx, t.DeviceName, report); err != nil {
+			r.lc.Error(fmt.Sprintf("reporting the twin of device %s to core-metadata failed: %s", t.DeviceName, err.Error()))
+		}
+	}
+}
+
+// reconcile reads the desired deviceResources of the device, sets those which differ and reads them again. It returns
+// the report of the reconciliation, and whether it tells core-metadata anything new.
+func (r *twinReconciler) reconcile(ctx context.Context, t twin.Twin) (twin.Report, bool) {
+	report := twin.Report{Version: t.Version, Reported: t.Reported, State: twin.StateFailed}
+
+	names := make([]string, 0, len(t.Desired))
+	for name := range t.Desired {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	reported, err := r.read(ctx, t.DeviceName, names)
+	if err != nil {
+		report.Errors = failAll(names, err)
+		return report, true
+	}
+	previous := t.Reported
+	report.Reported = reported
+	t.Reported = reported
+	differences := t.Differences()
+	if len(differences) == 0 {
+		report.State = twin.StateInSync
+		inSync := t.Status.State == twin.StateInSync && t.Status.Version == t.Version
+		return report, !inSync || !sameValues(reported, previous)
+	}
+
+	report.Errors = r.write(ctx, t, differences)
+	if reported, err = r.read(ctx, t.DeviceName, names); err != nil {
+		report.Errors = failAll(names, err)
+		return report, true
+	}
+	report.Reported = reported
+	t.Reported = reported
+	for _, name := range t.Differences() {
+		if _, failed := report.Errors[name]; !failed {
+			report.Errors[name] = fmt.Sprintf("the device reports '%s' instead of '%s'", reported[name], t.Desired[name])
+		}
+	}
+	if len(report.Errors) == 0 {
+		report.State = twin.StateInSync
+		report.Errors = nil
+	}
+	return report, true
+}
+
+// read returns the values of the named deviceResources read from the device
+func (r *twinReconciler) read(ctx context.Context, deviceName string, names []string) (map[string]string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, clientsDeviceNameURL(deviceName)+"/resources", nil)
+	if err != nil {
+		return nil, err
+	}
+	body, _, err := readDeviceResources(request, ctx, deviceName, names, r.lc, r.dbClient, r.deviceClient, r.httpCaller, r.access())
+	if err != nil {
+		return nil, err
+	}
+	var event contract.Event
+	if err = json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(event.Readings))
+	for _, reading := range event.Readings {
+		values[reading.Name] = reading.Value
+	}
+	return values, nil
+}
+
+// write issues the set commands of the device writing the named deviceResources to their desired value, and returns
+// the reasons the deviceResources couldn't be set
+func (r *twinReconciler) write(ctx context.Context, t twin.Twin, names []string) map[string]string {
+	failures := make(map[string]string)
+	device, err := r.deviceClient.DeviceForName(ctx, t.DeviceName)
+	if err != nil {
+		return failAll(names, err)
+	}
+	commands, err := r.dbClient.GetCommandsByDeviceId(device.Id)
+	if err != nil {
+		return failAll(names, err)
+	}
+
+	unset := make(map[string]bool, len(names))
+	for _, name := range names {
+		unset[name] = true
+	}
+	for _, name := range names {
+		if !unset[name] {
+			continue
+		}
+		command, writes, found := setCommand(device, commands, name)
+		if !found {
+			delete(unset, name)
+			failures[name] = fmt.Sprintf("no set command of device %s writes deviceResource %s", device.Name, name)
+			continue
+		}
+
+		// The command sets every differing deviceResource it writes at once
+		parameters := make(map[string]string)
+		for _, w := range writes {
+			if unset[w] {
+				parameters[w] = t.Desired[w]
+				delete(unset, w)
+			}
+		}
+		if err := r.set(ctx, device, command, parameters); err != nil {
+			for w := range parameters {
+				failures[w] = err.Error()
+			}
+		}
+	}
+	return failures
+}
+
+// set issues the set command with the parameters to the device
+func (r *twinReconciler) set(ctx context.Context, device contract.Device, command contract.Command, parameters map[string]string) error {
+	body, err := json.Marshal(parameters)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, clientsDeviceNameURL(device.Name)+"/command/"+url.PathEscape(command.Name), nil)
+	if err != nil {
+		return err
+	}
+	response, responseBody, err := executeCommandByDevice(ctx, device, command, string(body), r.lc, r.dbClient, request, r.httpCaller, r.access())
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return types.NewErrServiceClient(response.StatusCode, []byte(responseBody))
+	}
+	return nil
+}
+
+// setCommand returns the set command of the device writing the named deviceResource, and the deviceResources it writes
+func setCommand(device contract.Device, commands []contract.Command, name string) (contract.Command, []string, bool) {
+	for _, c := range commands {
+		if c.Put.Action.Path == "" {
+			continue
+		}
+		writes := commandWriteResources(device.Profile, c.Name)
+		for _, w := range writes {
+			if w == name {
+				return c, writes, true
+			}
+		}
+	}
+	return contract.Command{}, nil, false
+}
+
+// commandWriteResources returns the deviceResources written by the deviceCommand, a command without a deviceCommand
+// writes the deviceResource of the same name.
+func commandWriteResources(profile contract.DeviceProfile, commandName string) []string {
+	for _, dc := range profile.DeviceCommands {
+		if dc.Name != commandName {
+			continue
+		}
+		var names []string
+		for _, ro := range dc.Set {
+			if ro.DeviceResource != "" {
+				names = append(names, ro.DeviceResource)
+			} else if ro.Object != "" {
+				names = append(names, ro.Object)
+			}
+		}
+		return names
+	}
+	return []string{commandName}
+}
+
+func clientsDeviceNameURL(deviceName string) string {
+	return clients.ApiDeviceRoute + "/name/" + url.PathEscape(deviceName)
+}
+
+func failAll(names []string, err error) map[string]string {
+	failures := make(map[string]string, len(names))
+	for _, name := range names {
+		failures[name] = err.Error()
+	}
+	return failures
+}
+
+func sameValues(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,215 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTwinClient struct {
+	twins   []twin.Twin
+	reports map[string]twin.Report
+}
+
+func (c *stubTwinClient) AllTwins(ctx context.Context) ([]twin.Twin, error) {
+	return c.twins, nil
+}
+
+func (c *stubTwinClient) Report(ctx context.Context, deviceName string, report twin.Report) error {
+	c.reports[deviceName] = report
+	return nil
+}
+
+func newTwinDevice() contract.Device {
+	readWrite := func(name string) contract.ProfileResource {
+		return contract.ProfileResource{
+			Name: name,
+			Get:  []contract.ResourceOperation{{DeviceResource: name}},
+			Set:  []contract.ResourceOperation{{DeviceResource: name}},
+		}
+	}
+	return contract.Device{
+		Id:         "thermostat-id",
+		Name:       "thermostat",
+		AdminState: contract.Unlocked,
+		Profile: contract.DeviceProfile{
+			Name:            "Thermostat-Profile",
+			Labels:          []string{"elevated-command:SetPoint"},
+			DeviceResources: []contract.DeviceResource{{Name: "SetPoint"}, {Name: "Mode"}},
+			DeviceCommands:  []contract.ProfileResource{readWrite("SetPoint"), readWrite("Mode")},
+		},
+		Service: contract.DeviceService{
+			Addressable: contract.Addressable{Protocol: "http", Address: "localhost", Port: 49990},
+		},
+	}
+}
+
+func newTwinCommands() []contract.Command {
+	command := func(name string) contract.Command {
+		path := "/api/v1/device/{deviceId}/" + name
+		return contract.Command{
+			Id:   name + "-id",
+			Name: name,
+			Get:  contract.Get{Action: contract.Action{Path: path}},
+			Put:  contract.Put{Action: contract.Action{Path: path}, ParameterNames: []string{name}},
+		}
+	}
+	return []contract.Command{command("SetPoint"), command("Mode")}
+}
+
+// newTwinDeviceService returns an HttpCaller behaving as a device service holding the values of the resources. The
+// set commands fail with the status for the resources of failures.
+func newTwinDeviceService(values map[string]string, failures map[string]int) (*mocks.HttpCaller, *[]string) {
+	var sets []string
+	httpCaller := &mocks.HttpCaller{}
+	httpCaller.On("Do", mock.Anything).Return(func(req *http.Request) *http.Response {
+		name := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+		if req.Method == http.MethodPut {
+			body, _ := ioutil.ReadAll(req.Body)
+			sets = append(sets, string(body))
+			if status, failed := failures[name]; failed {
+				return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader("set failed"))}
+			}
+			var parameters map[string]string
+			_ = json.Unmarshal(body, &parameters)
+			for k, v := range parameters {
+				values[k] = v
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(""))}
+		}
+		event := fmt.Sprintf(`{"device":"thermostat","origin":1,"readings":[{"name":"%s","value":"%s"}]}`, name, values[name])
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{clients.ContentType: {clients.ContentTypeJSON}},
+			Body:       ioutil.NopCloser(strings.NewReader(event)),
+		}
+	}, nil)
+	return httpCaller, &sets
+}
+
+func TestReconcileDeviceTwins(t *testing.T) {
+	desired := map[string]string{"SetPoint": "21", "Mode": "heat"}
+	tests := []struct {
+		name           string
+		status         twin.Status
+		values         map[string]string
+		failures       map[string]int
+		access         config.CommandAccessInfo
+		expectedSets   []string
+		expectedState  string
+		expectedErrors []string
+		expectNoReport bool
+		expectedValues map[string]string
+	}{
+		{
+			name:          "differing resource set",
+			status:        twin.Status{State: twin.StatePending, Version: 1},
+			values:        map[string]string{"SetPoint": "20", "Mode": "heat"},
+			expectedSets:  []string{`{"SetPoint":"21"}`},
+			expectedState: twin.StateInSync,
+		},
+		{
+			name:           "set command failure",
+			status:         twin.Status{State: twin.StatePending, Version: 1},
+			values:         map[string]string{"SetPoint": "20", "Mode": "cool"},
+			failures:       map[string]int{"Mode": http.StatusInternalServerError},
+			expectedSets:   []string{`{"Mode":"heat"}`, `{"SetPoint":"21"}`},
+			expectedState:  twin.StateFailed,
+			expectedErrors: []string{"Mode"},
+		},
+		{
+			name:   "elevated command",
+			status: twin.Status{State: twin.StatePending, Version: 1},
+			values: map[string]string{"SetPoint": "20", "Mode": "heat"},
+			access: config.CommandAccessInfo{
+				Enabled: true, ElevatedRole: "admin", ElevatedLabelPrefix: "elevated-command:",
+			},
+			expectedState:  twin.StateFailed,
+			expectedErrors: []string{"SetPoint"},
+		},
+		{
+			name:           "already in sync",
+			status:         twin.Status{State: twin.StateInSync, Version: 1},
+			values:         map[string]string{"SetPoint": "21", "Mode": "heat"},
+			expectNoReport: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := newTwinDevice()
+			deviceClient := &mocks.DeviceClient{}
+			deviceClient.On("DeviceForName", mock.Anything, device.Name).Return(device, nil)
+			dbClient := createMockWithOutlines([]mockOutline{
+				{"GetCommandsByDeviceId", []interface{}{device.Id}, []interface{}{newTwinCommands(), nil}},
+			})
+			httpCaller, sets := newTwinDeviceService(tt.values, tt.failures)
+			twins := &stubTwinClient{
+				twins: []twin.Twin{{
+					DeviceName: device.Name,
+					Desired:    desired,
+					Version:    1,
+					Reported:   map[string]string{"SetPoint": "21", "Mode": "heat"},
+					Status:     tt.status,
+				}},
+				reports: make(map[string]twin.Report),
+			}
+			reconciler := &twinReconciler{
+				twins:        twins,
+				lc:           logger.NewMockClient(),
+				dbClient:     dbClient,
+				deviceClient: deviceClient,
+				httpCaller:   httpCaller,
+				access:       func() config.CommandAccessInfo { return tt.access },
+			}
+
+			reconciler.reconcileAll(context.Background())
+
+			assert.Equal(t, tt.expectedSets, *sets)
+			report, reported := twins.reports[device.Name]
+			if tt.expectNoReport {
+				assert.False(t, reported, "nothing changed since the last report")
+				return
+			}
+			require.True(t, reported)
+			assert.Equal(t, int64(1), report.Version)
+			assert.Equal(t, tt.expectedState, report.State)
+			assert.Equal(t, tt.values, report.Reported)
+			var failed []string
+			for name := range report.Errors {
+				failed = append(failed, name)
+			}
+			assert.ElementsMatch(t, tt.expectedErrors, failed)
+		})
+	}
+}
@@ -15,18 +15,75 @@ package config
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messagebus"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/shutdown"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/openapi"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
 )
 
 type ConfigurationStruct struct {
 	Writable     WritableInfo
 	MessageQueue MessageQueueInfo
+	Export       ExportInfo
+	RemoteWrite  RemoteWriteInfo
+	InfluxDB     InfluxDBInfo
+	MemoryUsage  MemoryUsageInfo
+	OpenAPI      openapi.OpenAPIInfo
 	Clients      map[string]bootstrapConfig.ClientInfo
 	Databases    map[string]bootstrapConfig.Database
 	Registry     bootstrapConfig.RegistryInfo
 	Service      bootstrapConfig.ServiceInfo
 	SecretStore  bootstrapConfig.SecretStoreInfo
+
+	// Dependencies declares the dependencies gating the startup and readiness of the service, by name
+	Dependencies map[string]dependency.DependencyInfo
+
+	// DatabaseEncryption encrypts the stored events and readings
+	DatabaseEncryption db.EncryptionInfo
+
+	// DeviceMetrics reports the events received by device to core-metadata
+	DeviceMetrics devicemetrics.ReportingInfo
+
+	// DatabaseIndexes declares the secondary indexes of the stored events, i.e. by tag, by name
+	DatabaseIndexes map[string]db.IndexInfo
+
+	// DatabaseReplication routes the read-only queries to the read replicas declared in the Databases section
+	DatabaseReplication db.ReplicationInfo
+
+	// RequestLimits rejects the request bodies larger than the configured sizes with 413
+	RequestLimits bodylimit.LimitsInfo
+	// TrustedHeaders verifies the claims of the caller forwarded by the API gateway
+	TrustedHeaders claims.TrustedHeadersInfo
+
+	// Standalone resolves core-metadata from an endpoints file instead of the Clients configuration or the registry
+	Standalone endpoints.StandaloneInfo
+
+	// WriteBehind stores the events received through the V2 API asynchronously, in batches
+	WriteBehind WriteBehindInfo
+
+	// Retention downsamples the readings removed by age into rollups rather than only deleting them
+	Retention RetentionInfo
+
+	// ReadingDeletion paces the jobs deleting the readings of a device in the background
+	ReadingDeletion ReadingDeletionInfo
+
+	// Shutdown drains the requests being handled when the service is exiting, before releasing its resources
+	Shutdown shutdown.ShutdownInfo
+
+	// ConfigDrift reports the drift of the running configuration, and reloads the sections drifted in the registry
+	ConfigDrift configdrift.DriftInfo
 }
 
 type WritableInfo struct {
@@ -37,7 +94,59 @@ type WritableInfo struct {
 	ValidateCheck              bool
 	LogLevel                   string
 	ChecksumAlgo               string
+	AssetLabelPrefix           string
+	PublishOnly                PublishOnlyInfo
+	Ingestion                  IngestionInfo
+	ReadingRange               ReadingRangeInfo
 	InsecureSecrets            bootstrapConfig.InsecureSecrets
+	// Compression compresses the responses of the service
+	Compression compression.ResponsesInfo
+	// FeatureFlags enable the experimental behaviors of the service by name, toggled at runtime through the registry
+	FeatureFlags map[string]bool
+}
+
+// IngestionInfo names the ingestion middleware run in each stage of the V2 event ingestion path, in order. The
+// stages run validate, enrich then transform before the event is persisted and published. Middleware are registered
+// by name in code, see the ingestion package.
+type IngestionInfo struct {
+	Validate  []string
+	Enrich    []string
+	Transform []string
+}
+
+// ReadingRangeInfo configures the check of the numeric V2 readings against the minimum and maximum of their device
+// resource, in the profile of the originating device. The readings out of range are stored and published still, their
+// event being tagged with the resources out of range.
+type ReadingRangeInfo struct {
+	Enabled bool
+	// Notify sends a notification through support-notifications for each event holding readings out of range
+	Notify bool
+}
+
+// PublishOnlyInfo lists the devices and device profiles whose events are published to the message queue without
+// being persisted, nor recorded as the device state, even when PersistData is enabled
+type PublishOnlyInfo struct {
+	Devices  []string
+	Profiles []string
+}
+
+// Contains returns whether events of the device, or of the device profile, must not be persisted. The profile name
+// may be empty when it isn't known.
+func (p PublishOnlyInfo) Contains(deviceName string, profileName string) bool {
+	for _, d := range p.Devices {
+		if d == deviceName {
+			return true
+		}
+	}
+	if profileName == "" {
+		return false
+	}
+	for _, profile := range p.Profiles {
+		if profile == profileName {
+			return true
+		}
+	}
+	return false
 }
 
 // MessageQueueInfo provides parameters related to connecting to a message queue
@@ -50,12 +159,171 @@ type MessageQueueInfo struct {
 	Protocol string
 	// Indicates the message queue platform being used.
 	Type string
-	// Indicates the topic the data is published/subscribed
+	// Indicates the topic the data is published/subscribed. The {profileName}, {deviceName} and {sourceName}
+	// variables are replaced by those of each published event, i.e. 'events/{profileName}/{deviceName}/{sourceName}'.
 	Topic string
+	// ProfileTopics overrides the Topic template for the events of the listed device profiles, by profile name.
+	ProfileTopics map[string]string
 	// Provides additional configuration properties which do not fit within the existing field.
 	// Typically the key is the name of the configuration property and the value is a string representation of the
 	// desired value for the configuration property.
 	Optional map[string]string
+	// Buffer configures the store-and-forward buffer used while the message bus is unreachable.
+	Buffer PublishBufferInfo
+	// JetStream configures the stream the events are retained in when Type is 'jetstream'.
+	JetStream messagebus.JetStreamInfo
+}
+
+const (
+	ProfileNameTopicVariable = "{profileName}"
+	DeviceNameTopicVariable  = "{deviceName}"
+	SourceNameTopicVariable  = "{sourceName}"
+)
+
+// PublishTopic returns the topic the events of the device profile, device and source are published on. The source is
+// the device resource all the readings of the event come from, empty when they come from several resources. The
+// profile name may be empty when it isn't known.
+func (m MessageQueueInfo) PublishTopic(profileName string, deviceName string, sourceName string) string {
+	topic := m.Topic
+	if profileTopic, ok := m.ProfileTopics[profileName]; ok && profileName != "" {
+		topic = profileTopic
+	}
+	return strings.NewReplacer(
+		ProfileNameTopicVariable, profileName,
+		DeviceNameTopicVariable, deviceName,
+		SourceNameTopicVariable, sourceName).Replace(topic)
+}
+
+// UsesProfileName returns whether the publish topic depends on the device profile of the events
+func (m MessageQueueInfo) UsesProfileName() bool {
+	return len(m.ProfileTopics) > 0 || strings.Contains(m.Topic, ProfileNameTopicVariable)
+}
+
+// PublishBufferInfo provides parameters related to buffering events which could not be published to the message queue
+type PublishBufferInfo struct {
+	// Enabled indicates whether events are buffered and replayed when the message queue can't be reached.
+	Enabled bool
+	// MaxSize is the maximum number of messages held. The oldest message is dropped when the buffer is full.
+	MaxSize int
+	// PersistFile is the path of the file used to keep buffered messages across restarts. Leave blank to keep the
+	// buffer in memory only.
+	PersistFile string
+	// RetryInterval indicates how often replay of buffered messages is attempted, i.e. "5s".
+	RetryInterval string
+}
+
+// ExportInfo configures the built-in north-bound connector which forwards the events published on the message bus to
+// a cloud IoT hub over MQTT, for deployments without an application service doing so.
+type ExportInfo struct {
+	// Type is the cloud IoT hub events are exported to, AzureIoTHub or AWSIoTCore. Leave blank to disable export.
+	Type string
+	// Host is the MQTT endpoint of the hub, i.e. myhub.azure-devices.net or xxxxxxxx-ats.iot.us-east-1.amazonaws.com
+	Host string
+	// Port is the MQTT port of the hub, usually 8883
+	Port int
+	// SecretPath is the secret store path of the hub credentials. AzureIoTHub reads SharedAccessKey, signing the
+	// tokens of every device, and SharedAccessKeyName when the key is a shared access policy key. A secret named after
+	// a cloud device id holds the key of that device instead. AWSIoTCore reads the PEM encoded cert and key.
+	SecretPath string
+	// ClientId is the MQTT client id, the thing name for AWSIoTCore. AzureIoTHub uses the cloud device ids.
+	ClientId string
+	// Topic is the AWSIoTCore topic events are published to, where {deviceId} is replaced by the cloud device id
+	Topic string
+	// DeviceIds maps EdgeX device names to cloud device ids. Devices not listed keep their EdgeX device name.
+	DeviceIds map[string]string
+	// Qos is the MQTT quality of service, 0 or 1
+	Qos int
+	// TokenLifetime is how long an AzureIoTHub SAS token is valid, i.e. "1h". Connections are renewed before expiry.
+	TokenLifetime string
+	// QueueSize is the number of events waiting to be exported. Events are dropped while the queue is full.
+	QueueSize int
+}
+
+// RemoteWriteInfo configures the export of numeric readings to a Prometheus remote-write endpoint, i.e. Prometheus,
+// Grafana Mimir or Grafana Cloud, so that readings can be charted without an application service.
+type RemoteWriteInfo struct {
+	// Url is the remote-write endpoint, i.e. "http://localhost:9090/api/v1/write". Leave blank to disable the export.
+	Url string
+	// MetricPrefix is prepended to the resource name of the readings to make the metric name, i.e. "edgex_"
+	MetricPrefix string
+	// SecretPath is the secret store path of the endpoint credentials, the secrets username and password for basic
+	// authentication or token for a bearer token. Leave blank when the endpoint doesn't authenticate.
+	SecretPath string
+	// FlushInterval is how often the queued samples are pushed, i.e. "10s"
+	FlushInterval string
+	// Timeout is the timeout of a push, i.e. "10s"
+	Timeout string
+	// QueueSize is the number of samples waiting to be pushed. Samples are dropped while the queue is full.
+	QueueSize int
+}
+
+// InfluxDBInfo configures the mirroring of numeric readings to an InfluxDB v2 bucket, so that readings can be charted
+// in Grafana with EdgeX and InfluxDB alone.
+type InfluxDBInfo struct {
+	// Url is the base URL of InfluxDB, i.e. "http://localhost:8086". Leave blank to disable the mirroring.
+	Url string
+	// Org is the organization owning Bucket
+	Org string
+	// Bucket is the bucket the readings are written to
+	Bucket string
+	// SecretPath is the secret store path of the token secret, the API token allowed to write to Bucket. Leave blank
+	// when InfluxDB is reached through a proxy authorizing the writes.
+	SecretPath string
+	// BatchSize is the number of points written per request. A batch is written as soon as it is queued.
+	BatchSize int
+	// FlushInterval is how often the queued points are written when fewer than a batch, i.e. "10s"
+	FlushInterval string
+	// Timeout is the timeout of a write, i.e. "10s"
+	Timeout string
+	// QueueSize is the number of points waiting to be written. Points are dropped while the queue is full.
+	QueueSize int
+}
+
+// MemoryUsageInfo provides parameters related to estimating the database memory used per collection
+type MemoryUsageInfo struct {
+	// Collections lists the collections reported, i.e. "cd|evt" or "notification".
+	Collections []string
+	// Samples is the default number of entries per collection whose memory usage is measured.
+	Samples int
+}
+
+// WriteBehindInfo configures the asynchronous storage of the events, which trades durability for ingest latency: the
+// events are accepted and published before they are stored, and the events still queued in memory are lost when the
+// service crashes.
+type WriteBehindInfo struct {
+	// Enabled queues the events to be stored in batches instead of storing them before accepting them
+	Enabled bool
+	// QueueSize is the number of events queued in memory, the OverflowPolicy applies once the queue is full
+	QueueSize int
+	// BatchSize is the maximum number of events stored at once
+	BatchSize int
+	// FlushInterval is the longest an event is queued before it is stored, i.e. '1s'
+	FlushInterval string
+	// OverflowPolicy applies to the events received while the queue is full, one of block, reject, dropOldest or spill
+	OverflowPolicy string
+	// SpillDirectory holds the events which overflowed the queue or couldn't be stored, until they are stored once the
+	// queue is drained. Required by the spill policy, the events which couldn't be stored are dropped without it.
+	SpillDirectory string
+}
+
+// RetentionInfo configures what becomes of the readings of the events removed by age
+type RetentionInfo struct {
+	// Mode is delete to delete the readings, or downsample to keep the count, min, max and average of the numeric
+	// readings per device, reading name and RollupInterval before deleting them
+	Mode string
+	// RollupInterval is the interval the readings are downsampled by, i.e. '1h'
+	RollupInterval string
+}
+
+// ReadingDeletionInfo configures the jobs deleting the events and readings of a device chunk by chunk, so that deleting
+// the readings of a decommissioned device doesn't tie up the database
+type ReadingDeletionInfo struct {
+	// ChunkSize is the number of events deleted at once, along with their readings
+	ChunkSize int
+	// ChunkInterval is the pause between two chunks, i.e. '100ms'
+	ChunkInterval string
+	// JobRetention is how long a finished job is reported after it finished, i.e. '1h'
+	JobRetention string
 }
 
 // URL constructs a URL from the protocol, host and port and returns that as a string.
@@ -93,6 +361,16 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 	return ok
 }
 
+// GetFeatureFlags returns the feature flags of the Writable configuration, by name.
+func (c *ConfigurationStruct) GetFeatureFlags() map[string]bool {
+	return c.Writable.FeatureFlags
+}
+
+// GetConfigDriftInfo returns the configuration of the drift detection of the running configuration.
+func (c *ConfigurationStruct) GetConfigDriftInfo() configdrift.DriftInfo {
+	return c.ConfigDrift
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -117,11 +395,55 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 	return c.Registry
 }
 
+// GetDeviceMetricsInfo returns the device activity reporting configuration and the core-metadata client configuration.
+func (c *ConfigurationStruct) GetDeviceMetricsInfo() (devicemetrics.ReportingInfo, bootstrapConfig.ClientInfo) {
+	return c.DeviceMetrics, c.Clients["Metadata"]
+}
+
+// GetStandaloneInfo returns the configuration of the endpoints file the other services are resolved from.
+func (c *ConfigurationStruct) GetStandaloneInfo() endpoints.StandaloneInfo {
+	return c.Standalone
+}
+
+// GetShutdownInfo returns the configuration of the graceful shutdown of the service.
+func (c *ConfigurationStruct) GetShutdownInfo() shutdown.ShutdownInfo {
+	return c.Shutdown
+}
+
 // GetDatabaseInfo returns a database information map.
 func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Database {
 	return c.Databases
 }
 
+// GetDatabaseIndexes returns the collection written by the service and the secondary indexes declared on it.
+func (c *ConfigurationStruct) GetDatabaseIndexes() (string, map[string]db.IndexInfo) {
+	return db.IndexCollectionEvent, c.DatabaseIndexes
+}
+
+// GetDatabaseReplicationInfo returns the replication configuration.
+func (c *ConfigurationStruct) GetDatabaseReplicationInfo() db.ReplicationInfo {
+	return c.DatabaseReplication
+}
+
+// GetDependencyInfo returns the dependencies declared by the service.
+func (c *ConfigurationStruct) GetDependencyInfo() map[string]dependency.DependencyInfo {
+	return c.Dependencies
+}
+
+// GetMessageBusInfo returns the location of the message bus.
+func (c *ConfigurationStruct) GetMessageBusInfo() types.HostInfo {
+	return types.HostInfo{
+		Host:     c.MessageQueue.Host,
+		Port:     c.MessageQueue.Port,
+		Protocol: c.MessageQueue.Protocol,
+	}
+}
+
+// GetDatabaseEncryptionInfo returns the payload encryption configuration.
+func (c *ConfigurationStruct) GetDatabaseEncryptionInfo() db.EncryptionInfo {
+	return c.DatabaseEncryption
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
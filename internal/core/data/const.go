@@ -35,4 +35,7 @@ const (
 	NAMES          = "names"
 	DEVICE         = "device"
 	USAGE          = "usage"
+	BUFFER         = "buffer"
+	STREAM         = "stream"
+	ROLLUP         = "rollup"
 )
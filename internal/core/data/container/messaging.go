@@ -15,6 +15,8 @@
 package container
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/publisher"
+
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 
 	"github.com/edgexfoundry/go-mod-messaging/messaging"
@@ -27,3 +29,16 @@ var MessagingClientName = di.TypeInstanceToName((*messaging.MessageClient)(nil))
 func MessagingClientFrom(get di.Get) messaging.MessageClient {
 	return get(MessagingClientName).(messaging.MessageClient)
 }
+
+// PublishBufferName contains the name of the message bus publish buffer instance in the DIC.
+var PublishBufferName = di.TypeInstanceToName((*publisher.BufferedClient)(nil))
+
+// PublishBufferFrom helper function queries the DIC and returns the message bus publish buffer, or nil when buffering
+// is disabled.
+func PublishBufferFrom(get di.Get) *publisher.BufferedClient {
+	buffer, ok := get(PublishBufferName).(*publisher.BufferedClient)
+	if !ok {
+		return nil
+	}
+	return buffer
+}
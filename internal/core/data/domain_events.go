@@ -15,6 +15,8 @@ package data
 
 import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
@@ -35,6 +37,7 @@ func initEventHandlers(
 	chEvents <-chan interface{},
 	mdc metadata.DeviceClient,
 	msc metadata.DeviceServiceClient,
+	reporter *devicemetrics.Reporter,
 	configuration *config.ConfigurationStruct) {
 	go func() {
 		for {
@@ -44,6 +47,7 @@ func initEventHandlers(
 					switch e.(type) {
 					case DeviceLastReported:
 						dlr := e.(DeviceLastReported)
+						reporter.Count(dlr.DeviceName, devicemetrics.Counters{EventsReceived: 1, LastEventReceived: db.MakeTimestamp()})
 						updateDeviceLastReportedConnected(dlr.DeviceName, lc, mdc, configuration)
 						break
 					case DeviceServiceLastReported:
@@ -66,12 +66,22 @@ func countEventsByDevice(
 	return count, err
 }
 
-func deleteEventsByAge(age int64, lc logger.LoggingClient, dbClient interfaces.DBClient) (int, error) {
+func deleteEventsByAge(
+	age int64,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	configuration *config.ConfigurationStruct) (int, error) {
+
 	events, err := dbClient.EventsOlderThanAge(age)
 	if err != nil {
 		return -1, err
 	}
 
+	// The events are kept when what is retained of them can't be stored, so that nothing is lost
+	if err = retainEvents(events, configuration.Retention, dbClient); err != nil {
+		return -1, err
+	}
+
 	// Delete all the events
 	count := len(events)
 	for _, event := range events {
@@ -133,7 +143,7 @@ func addNewEvent(
 	}
 
 	// Add the event and readings to the database
-	if configuration.Writable.PersistData {
+	if configuration.Writable.PersistData && !configuration.Writable.PublishOnly.Contains(e.Device, "") {
 		if e.Created == 0 {
 			e.Created = db.MakeTimestamp()
 		}
@@ -144,9 +154,9 @@ func addNewEvent(
 		e.ID = id
 	}
 
-	putEventOnQueue(e, ctx, lc, msgClient, configuration) // Push event to message bus for App Services to consume
-	chEvents <- DeviceLastReported{e.Device}              // update last reported connected (device)
-	chEvents <- DeviceServiceLastReported{e.Device}       // update last reported connected (device service)
+	putEventOnQueue(e, ctx, lc, msgClient, mdc, configuration) // Push event to message bus for App Services to consume
+	chEvents <- DeviceLastReported{e.Device}                   // update last reported connected (device)
+	chEvents <- DeviceServiceLastReported{e.Device}            // update last reported connected (device service)
 
 	return e.ID, nil
 }
@@ -282,6 +292,7 @@ func putEventOnQueue(
 	ctx context.Context,
 	lc logger.LoggingClient,
 	msgClient messaging.MessageClient,
+	mdc metadata.DeviceClient,
 	configuration *config.ConfigurationStruct) {
 
 	lc.Debug("Putting event on message queue")
@@ -297,19 +308,46 @@ func putEventOnQueue(
 		evt.Bytes = data
 	}
 
+	// the profile of the device is only looked up when the topic depends on it
+	profileName := ""
+	if configuration.MessageQueue.UsesProfileName() {
+		device, err := mdc.CheckForDevice(ctx, evt.Device)
+		if err != nil {
+			lc.Warn(fmt.Sprintf("unable to resolve the device profile of device %s for the publish topic: %v", evt.Device, err))
+		} else {
+			profileName = device.Profile.Name
+		}
+	}
+	topic := configuration.MessageQueue.PublishTopic(profileName, evt.Device, eventSourceName(evt.Readings))
+
 	msgEnvelope := msgTypes.NewMessageEnvelope(evt.Bytes, ctx)
-	err := msgClient.Publish(msgEnvelope, configuration.MessageQueue.Topic)
+	err := msgClient.Publish(msgEnvelope, topic)
 	if err != nil {
 		lc.Error(fmt.Sprintf("Unable to send message for event: %s %v", evt.String(), err))
 	} else {
 		lc.Debug(fmt.Sprintf(
 			"Event Published on message queue. Topic: %s, Correlation-id: %s ",
-			configuration.MessageQueue.Topic,
+			topic,
 			msgEnvelope.CorrelationID,
 		))
 	}
 }
 
+// eventSourceName returns the name of the device resource all the readings come from, empty when they come from
+// several resources
+func eventSourceName(readings []contract.Reading) string {
+	if len(readings) == 0 {
+		return ""
+	}
+	name := readings[0].Name
+	for _, r := range readings[1:] {
+		if r.Name != name {
+			return ""
+		}
+	}
+	return name
+}
+
 func getEventsByDeviceIdLimit(
 	limit int,
 	deviceId string,
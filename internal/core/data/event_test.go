@@ -112,7 +112,7 @@ func newDeleteEventsOlderThanAgeMockDB() *dbMock.DBClient {
 func TestDeleteByAge(t *testing.T) {
 	reset()
 	dbClientMock := newDeleteEventsOlderThanAgeMockDB()
-	count, err := deleteEventsByAge(-1, logger.NewMockClient(), dbClientMock)
+	count, err := deleteEventsByAge(-1, logger.NewMockClient(), dbClientMock, &config.ConfigurationStruct{})
 	if err != nil {
 		t.Errorf(err.Error())
 	}
@@ -131,7 +131,7 @@ func TestDeleteEventByAgeErrorThrownByEventsOlderThanAge(t *testing.T) {
 		return age == -1
 	})).Return([]contract.Event{}, fmt.Errorf("some error"))
 
-	_, err := deleteEventsByAge(-1, logger.NewMockClient(), dbClientMock)
+	_, err := deleteEventsByAge(-1, logger.NewMockClient(), dbClientMock, &config.ConfigurationStruct{})
 
 	if err == nil {
 		t.Errorf("Should throw error")
@@ -0,0 +1,95 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	// AWSIoTCore is the export type of AWS IoT Core
+	AWSIoTCore = "AWSIoTCore"
+	// CertSecret is the secret holding the PEM encoded certificate of the thing
+	CertSecret = "cert"
+	// KeySecret is the secret holding the PEM encoded private key of the thing
+	KeySecret = "key"
+
+	deviceIdPlaceholder = "{deviceId}"
+)
+
+// awsConnector publishes messages to AWS IoT Core through a single connection authenticated with the certificate of
+// the thing. Cloud devices are told apart by the topic.
+type awsConnector struct {
+	host        string
+	port        int
+	clientId    string
+	topic       string
+	qos         byte
+	certificate tls.Certificate
+	client      mqtt.Client
+}
+
+func newAWSConnector(exportConfig config.ExportInfo, secrets map[string]string) (*awsConnector, error) {
+	if exportConfig.ClientId == "" {
+		return nil, fmt.Errorf("export ClientId must be set to the thing name for %s", AWSIoTCore)
+	}
+
+	certificate, err := tls.X509KeyPair([]byte(secrets[CertSecret]), []byte(secrets[KeySecret]))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the %s certificate from the secret store: %s", AWSIoTCore, err.Error())
+	}
+
+	return &awsConnector{
+		host:        exportConfig.Host,
+		port:        exportConfig.Port,
+		clientId:    exportConfig.ClientId,
+		topic:       exportConfig.Topic,
+		qos:         byte(exportConfig.Qos),
+		certificate: certificate,
+	}, nil
+}
+
+func (a *awsConnector) Publish(deviceId string, payload []byte, _ string) error {
+	if a.client == nil {
+		client, err := mqttConnect(
+			a.host,
+			a.port,
+			a.clientId,
+			"",
+			"",
+			&tls.Config{ServerName: a.host, Certificates: []tls.Certificate{a.certificate}, MinVersion: tls.VersionTLS12},
+			true)
+		if err != nil {
+			return err
+		}
+		a.client = client
+	}
+
+	topic := strings.ReplaceAll(a.topic, deviceIdPlaceholder, deviceId)
+	return waitForToken(a.client.Publish(topic, a.qos, false, payload))
+}
+
+func (a *awsConnector) Disconnect() {
+	if a.client != nil {
+		a.client.Disconnect(mqttQuiesce)
+		a.client = nil
+	}
+}
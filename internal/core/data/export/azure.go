@@ -0,0 +1,169 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	// AzureIoTHub is the export type of Azure IoT Hub
+	AzureIoTHub = "AzureIoTHub"
+	// SharedAccessKeySecret is the secret holding the key signing the SAS tokens of the devices
+	SharedAccessKeySecret = "SharedAccessKey"
+	// SharedAccessKeyNameSecret is the secret holding the shared access policy name when the key is a policy key
+	SharedAccessKeyNameSecret = "SharedAccessKeyName"
+
+	azureApiVersion = "2018-06-30"
+)
+
+// azureConnector publishes device-to-cloud messages to Azure IoT Hub. Every cloud device has its own connection,
+// authenticated with a SAS token which is renewed before it expires.
+type azureConnector struct {
+	host          string
+	port          int
+	qos           byte
+	tokenLifetime time.Duration
+	secrets       map[string]string
+	sessions      map[string]*azureSession
+}
+
+type azureSession struct {
+	client  mqtt.Client
+	renewAt time.Time
+}
+
+func newAzureConnector(exportConfig config.ExportInfo, secrets map[string]string) (*azureConnector, error) {
+	tokenLifetime, err := time.ParseDuration(exportConfig.TokenLifetime)
+	if err != nil || tokenLifetime <= 0 {
+		return nil, fmt.Errorf("invalid export TokenLifetime '%s'", exportConfig.TokenLifetime)
+	}
+
+	return &azureConnector{
+		host:          exportConfig.Host,
+		port:          exportConfig.Port,
+		qos:           byte(exportConfig.Qos),
+		tokenLifetime: tokenLifetime,
+		secrets:       secrets,
+		sessions:      make(map[string]*azureSession),
+	}, nil
+}
+
+func (a *azureConnector) Publish(deviceId string, payload []byte, contentType string) error {
+	session, err := a.session(deviceId)
+	if err != nil {
+		return err
+	}
+
+	topic := fmt.Sprintf("devices/%s/messages/events/%s", deviceId, azureMessageProperties(contentType))
+	return waitForToken(session.client.Publish(topic, a.qos, false, payload))
+}
+
+func (a *azureConnector) Disconnect() {
+	for deviceId, session := range a.sessions {
+		session.client.Disconnect(mqttQuiesce)
+		delete(a.sessions, deviceId)
+	}
+}
+
+// session returns the connection of the cloud device, connecting again when the connection was lost or its SAS
+// token is about to expire.
+func (a *azureConnector) session(deviceId string) (*azureSession, error) {
+	session, exists := a.sessions[deviceId]
+	if exists {
+		if session.client.IsConnected() && time.Now().Before(session.renewAt) {
+			return session, nil
+		}
+		session.client.Disconnect(mqttQuiesce)
+		delete(a.sessions, deviceId)
+	}
+
+	key, keyName := a.secrets[deviceId], ""
+	if key == "" {
+		key, keyName = a.secrets[SharedAccessKeySecret], a.secrets[SharedAccessKeyNameSecret]
+	}
+	if key == "" {
+		return nil, fmt.Errorf("no shared access key for device %s in the secret store", deviceId)
+	}
+
+	expiry := time.Now().Add(a.tokenLifetime)
+	token, err := sasToken(a.host+"/devices/"+deviceId, key, keyName, expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := mqttConnect(
+		a.host,
+		a.port,
+		deviceId,
+		fmt.Sprintf("%s/%s/?api-version=%s", a.host, deviceId, azureApiVersion),
+		token,
+		&tls.Config{ServerName: a.host, MinVersion: tls.VersionTLS12},
+		false)
+	if err != nil {
+		return nil, err
+	}
+
+	session = &azureSession{client: client, renewAt: expiry.Add(-a.tokenLifetime / 10)}
+	a.sessions[deviceId] = session
+	return session, nil
+}
+
+// sasToken creates the shared access signature granting access to the resource until expiry. keyName is the shared
+// access policy name, empty when key is the device key.
+func sasToken(resourceUri string, key string, keyName string, expiry time.Time) (string, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("shared access key is not base64 encoded: %s", err.Error())
+	}
+
+	encodedUri := url.QueryEscape(resourceUri)
+	se := strconv.FormatInt(expiry.Unix(), 10)
+	mac := hmac.New(sha256.New, decodedKey)
+	_, _ = mac.Write([]byte(encodedUri + "\n" + se))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	token := fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s", encodedUri, url.QueryEscape(signature), se)
+	if keyName != "" {
+		token += "&skn=" + url.QueryEscape(keyName)
+	}
+	return token, nil
+}
+
+// azureMessageProperties returns the topic property bag declaring the content type of the message, which lets IoT
+// Hub message routing query the body of JSON messages.
+func azureMessageProperties(contentType string) string {
+	switch contentType {
+	case "":
+		return ""
+	case clients.ContentTypeJSON:
+		return "$.ct=" + url.QueryEscape(contentType) + "&$.ce=utf-8"
+	default:
+		return "$.ct=" + url.QueryEscape(contentType)
+	}
+}
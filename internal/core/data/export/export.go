@@ -0,0 +1,160 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Connector publishes event payloads to a cloud IoT hub on behalf of a cloud device.
+type Connector interface {
+	// Publish sends the payload as a message of the cloud device.
+	Publish(deviceId string, payload []byte, contentType string) error
+	// Disconnect closes the connections to the hub.
+	Disconnect()
+}
+
+// NewConnector creates the Connector of the configured hub type. Connections are established on first use.
+func NewConnector(exportConfig config.ExportInfo, secrets map[string]string) (Connector, error) {
+	if exportConfig.Qos < 0 || exportConfig.Qos > 1 {
+		return nil, fmt.Errorf("export Qos must be 0 or 1, got %d", exportConfig.Qos)
+	}
+
+	switch exportConfig.Type {
+	case AzureIoTHub:
+		return newAzureConnector(exportConfig, secrets)
+	case AWSIoTCore:
+		return newAWSConnector(exportConfig, secrets)
+	default:
+		return nil, fmt.Errorf("unsupported export type '%s', expecting %s or %s", exportConfig.Type, AzureIoTHub, AWSIoTCore)
+	}
+}
+
+// Client wraps a messaging.MessageClient and exports every message it publishes to a cloud IoT hub. Messages are
+// queued and exported in the background by Run so that a slow or unreachable hub doesn't hold up event ingestion.
+type Client struct {
+	messaging.MessageClient
+	connector Connector
+	deviceIds map[string]string
+	lc        logger.LoggingClient
+	queue     chan types.MessageEnvelope
+}
+
+// NewClient creates a Client exporting the messages published through client with connector.
+func NewClient(
+	client messaging.MessageClient,
+	connector Connector,
+	exportConfig config.ExportInfo,
+	lc logger.LoggingClient) (*Client, error) {
+
+	if exportConfig.QueueSize <= 0 {
+		return nil, fmt.Errorf("export queue size must be greater than zero, got %d", exportConfig.QueueSize)
+	}
+
+	return &Client{
+		MessageClient: client,
+		connector:     connector,
+		deviceIds:     exportConfig.DeviceIds,
+		lc:            lc,
+		queue:         make(chan types.MessageEnvelope, exportConfig.QueueSize),
+	}, nil
+}
+
+// Publish sends the message to the message bus and queues it for export. The message is exported even when it
+// couldn't be published to the message bus.
+func (c *Client) Publish(message types.MessageEnvelope, topic string) error {
+	err := c.MessageClient.Publish(message, topic)
+
+	select {
+	case c.queue <- message:
+	default:
+		c.lc.Warn(fmt.Sprintf("export queue is full (%d), dropping event. Correlation-id: %s", cap(c.queue), message.CorrelationID))
+	}
+
+	return err
+}
+
+// Run exports the queued messages until ctx is done and then disconnects from the hub.
+func (c *Client) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			c.connector.Disconnect()
+			c.lc.Info(fmt.Sprintf("export stopped with %d event(s) queued", len(c.queue)))
+			return
+		case message := <-c.queue:
+			c.export(message)
+		}
+	}
+}
+
+func (c *Client) export(message types.MessageEnvelope) {
+	deviceName, err := deviceNameOf(message)
+	if err != nil {
+		c.lc.Error(fmt.Sprintf("unable to export event: %s. Correlation-id: %s", err.Error(), message.CorrelationID))
+		return
+	}
+
+	deviceId := c.deviceIds[deviceName]
+	if deviceId == "" {
+		deviceId = deviceName
+	}
+
+	if err := c.connector.Publish(deviceId, message.Payload, message.ContentType); err != nil {
+		c.lc.Error(fmt.Sprintf("unable to export event of device %s: %s. Correlation-id: %s", deviceName, err.Error(), message.CorrelationID))
+		return
+	}
+	c.lc.Debug(fmt.Sprintf("exported event of device %s as %s. Correlation-id: %s", deviceName, deviceId, message.CorrelationID))
+}
+
+// eventDevice decodes the device name of V1 events and V2 event DTOs alike.
+type eventDevice struct {
+	Device     string `json:"device"`
+	DeviceName string `json:"deviceName"`
+}
+
+// deviceNameOf returns the name of the device which sent the event published in the message.
+func deviceNameOf(message types.MessageEnvelope) (string, error) {
+	var event eventDevice
+	var err error
+	if message.ContentType == clients.ContentTypeCBOR {
+		err = cbor.Unmarshal(message.Payload, &event)
+	} else {
+		err = json.Unmarshal(message.Payload, &event)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to decode event: %s", err.Error())
+	}
+
+	if event.DeviceName != "" {
+		return event.DeviceName, nil
+	}
+	if event.Device != "" {
+		return event.Device, nil
+	}
+	return "", errors.New("event has no device name")
+}
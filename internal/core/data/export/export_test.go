@@ -0,0 +1,152 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMessageClient struct {
+	err       error
+	published int
+}
+
+func (c *fakeMessageClient) Connect() error { return nil }
+func (c *fakeMessageClient) Publish(types.MessageEnvelope, string) error {
+	c.published++
+	return c.err
+}
+func (c *fakeMessageClient) Subscribe([]types.TopicChannel, chan error) error { return nil }
+func (c *fakeMessageClient) Disconnect() error                                { return nil }
+
+type fakeConnector struct {
+	mutex        sync.Mutex
+	deviceIds    []string
+	disconnected bool
+}
+
+func (c *fakeConnector) Publish(deviceId string, _ []byte, _ string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.deviceIds = append(c.deviceIds, deviceId)
+	return nil
+}
+
+func (c *fakeConnector) Disconnect() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.disconnected = true
+}
+
+func TestClientExportsPublishedEvents(t *testing.T) {
+	inner := &fakeMessageClient{err: errors.New("message bus down")}
+	connector := &fakeConnector{}
+	exportConfig := config.ExportInfo{DeviceIds: map[string]string{"Thermostat": "thermostat-1"}, QueueSize: 1}
+	client, err := NewClient(inner, connector, exportConfig, logger.NewMockClient())
+	require.NoError(t, err)
+
+	// the event is queued for export even though the message bus publish fails
+	err = client.Publish(types.MessageEnvelope{Payload: []byte(`{"device":"Thermostat"}`), ContentType: clients.ContentTypeJSON}, "events")
+	assert.Error(t, err)
+	// the queue is full so the second event is dropped
+	err = client.Publish(types.MessageEnvelope{Payload: []byte(`{"device":"Fan"}`), ContentType: clients.ContentTypeJSON}, "events")
+	assert.Error(t, err)
+	assert.Equal(t, 2, inner.published)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		client.Run(ctx)
+		close(done)
+	}()
+	require.Eventually(t, func() bool { return len(client.queue) == 0 }, time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Equal(t, []string{"thermostat-1"}, connector.deviceIds)
+	assert.True(t, connector.disconnected)
+}
+
+func TestDeviceNameOf(t *testing.T) {
+	cborPayload, err := cbor.Marshal(map[string]string{"device": "Fan"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		message  types.MessageEnvelope
+		expected string
+		err      bool
+	}{
+		{"V1 JSON event", types.MessageEnvelope{Payload: []byte(`{"device":"Thermostat"}`), ContentType: clients.ContentTypeJSON}, "Thermostat", false},
+		{"V2 JSON event", types.MessageEnvelope{Payload: []byte(`{"deviceName":"Thermostat"}`), ContentType: clients.ContentTypeJSON}, "Thermostat", false},
+		{"V1 CBOR event", types.MessageEnvelope{Payload: cborPayload, ContentType: clients.ContentTypeCBOR}, "Fan", false},
+		{"No device name", types.MessageEnvelope{Payload: []byte(`{}`), ContentType: clients.ContentTypeJSON}, "", true},
+		{"Invalid payload", types.MessageEnvelope{Payload: []byte(`not json`), ContentType: clients.ContentTypeJSON}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deviceName, err := deviceNameOf(tt.message)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, deviceName)
+		})
+	}
+}
+
+func TestSasToken(t *testing.T) {
+	key := "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	expected := "SharedAccessSignature sr=myhub.azure-devices.net%2Fdevices%2Fthermostat-1&sig=Z5UHu9SkASFhDDyNaDKm5CwrMjKtf63eHT1sUj4ntRo%3D&se=1600000000"
+
+	token, err := sasToken("myhub.azure-devices.net/devices/thermostat-1", key, "", time.Unix(1600000000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, expected, token)
+
+	token, err = sasToken("myhub.azure-devices.net/devices/thermostat-1", key, "device", time.Unix(1600000000, 0))
+	require.NoError(t, err)
+	assert.Equal(t, expected+"&skn=device", token)
+
+	_, err = sasToken("myhub.azure-devices.net/devices/thermostat-1", "not base64!", "", time.Now())
+	assert.Error(t, err)
+}
+
+func TestNewConnector(t *testing.T) {
+	_, err := NewConnector(config.ExportInfo{Type: AzureIoTHub, TokenLifetime: "1h", Qos: 1}, nil)
+	assert.NoError(t, err)
+	_, err = NewConnector(config.ExportInfo{Type: AzureIoTHub, TokenLifetime: "bogus"}, nil)
+	assert.Error(t, err)
+	_, err = NewConnector(config.ExportInfo{Type: AzureIoTHub, TokenLifetime: "1h", Qos: 2}, nil)
+	assert.Error(t, err)
+	_, err = NewConnector(config.ExportInfo{Type: AWSIoTCore, ClientId: "core-data"}, map[string]string{})
+	assert.Error(t, err)
+	_, err = NewConnector(config.ExportInfo{Type: "GoogleIoTCore"}, nil)
+	assert.Error(t, err)
+}
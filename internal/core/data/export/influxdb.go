@@ -0,0 +1,292 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+const (
+	// InfluxDBTokenSecret is the secret holding the API token authorizing the writes to the InfluxDB bucket
+	InfluxDBTokenSecret = "token"
+
+	// InfluxDBValueField is the field of the points holding the reading values
+	InfluxDBValueField = "value"
+
+	influxDBWritePath = "/api/v2/write"
+)
+
+// InfluxDBClient wraps a messaging.MessageClient and mirrors the numeric readings of every event it publishes to an
+// InfluxDB v2 bucket. The measurement of a point is the resource name of the reading, its tags the device and device
+// profile names and the tags of the event, and its value field the reading value as a float. Points are queued in
+// line protocol and written in batches by Run.
+type InfluxDBClient struct {
+	messaging.MessageClient
+	writeUrl      string
+	token         string
+	httpClient    *http.Client
+	batchSize     int
+	flushInterval time.Duration
+	queueSize     int
+	lc            logger.LoggingClient
+
+	mutex sync.Mutex
+	// queue holds a line per reading
+	queue []string
+	// batchReady wakes Run up when a batch is queued before the flush interval elapses
+	batchReady chan struct{}
+}
+
+// NewInfluxDBClient creates an InfluxDBClient mirroring the readings published through client to the configured
+// bucket, authorizing the writes with the token secret read from the configured secret path.
+func NewInfluxDBClient(
+	client messaging.MessageClient,
+	influxDBConfig config.InfluxDBInfo,
+	secrets map[string]string,
+	lc logger.LoggingClient) (*InfluxDBClient, error) {
+
+	if influxDBConfig.Org == "" || influxDBConfig.Bucket == "" {
+		return nil, fmt.Errorf("InfluxDB Org and Bucket are required")
+	}
+	if influxDBConfig.BatchSize <= 0 {
+		return nil, fmt.Errorf("InfluxDB batch size must be greater than zero, got %d", influxDBConfig.BatchSize)
+	}
+	if influxDBConfig.QueueSize < influxDBConfig.BatchSize {
+		return nil, fmt.Errorf("InfluxDB queue size %d must be at least the batch size %d", influxDBConfig.QueueSize, influxDBConfig.BatchSize)
+	}
+	flushInterval, err := time.ParseDuration(influxDBConfig.FlushInterval)
+	if err != nil || flushInterval <= 0 {
+		return nil, fmt.Errorf("invalid InfluxDB FlushInterval '%s'", influxDBConfig.FlushInterval)
+	}
+	timeout, err := time.ParseDuration(influxDBConfig.Timeout)
+	if err != nil || timeout <= 0 {
+		return nil, fmt.Errorf("invalid InfluxDB Timeout '%s'", influxDBConfig.Timeout)
+	}
+	writeUrl, err := url.Parse(strings.TrimSuffix(influxDBConfig.Url, "/") + influxDBWritePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid InfluxDB Url '%s': %s", influxDBConfig.Url, err.Error())
+	}
+	writeUrl.RawQuery = url.Values{
+		"org":       {influxDBConfig.Org},
+		"bucket":    {influxDBConfig.Bucket},
+		"precision": {"ns"},
+	}.Encode()
+
+	return &InfluxDBClient{
+		MessageClient: client,
+		writeUrl:      writeUrl.String(),
+		token:         secrets[InfluxDBTokenSecret],
+		httpClient:    &http.Client{Timeout: timeout},
+		batchSize:     influxDBConfig.BatchSize,
+		flushInterval: flushInterval,
+		queueSize:     influxDBConfig.QueueSize,
+		lc:            lc,
+		batchReady:    make(chan struct{}, 1),
+	}, nil
+}
+
+// Publish sends the message to the message bus and queues the numeric readings of the event for InfluxDB. The
+// readings are mirrored even when the message couldn't be published to the message bus.
+func (c *InfluxDBClient) Publish(message types.MessageEnvelope, topic string) error {
+	err := c.MessageClient.Publish(message, topic)
+
+	readings, decodeErr := numericReadingsOf(message)
+	if decodeErr != nil {
+		c.lc.Error(fmt.Sprintf("unable to mirror readings to InfluxDB: %s. Correlation-id: %s", decodeErr.Error(), message.CorrelationID))
+		return err
+	}
+	var lines []string
+	for _, reading := range readings {
+		if line, ok := lineOf(reading); ok {
+			lines = append(lines, line)
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if free := c.queueSize - len(c.queue); len(lines) > free {
+		c.lc.Warn(fmt.Sprintf("InfluxDB queue is full (%d), dropping %d reading(s). Correlation-id: %s", c.queueSize, len(lines)-free, message.CorrelationID))
+		lines = lines[:free]
+	}
+	c.queue = append(c.queue, lines...)
+	if len(c.queue) >= c.batchSize {
+		select {
+		case c.batchReady <- struct{}{}:
+		default:
+		}
+	}
+	return err
+}
+
+// Run writes the queued points every flush interval, or as soon as a batch is queued, until ctx is done, then writes
+// them one last time.
+func (c *InfluxDBClient) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.Flush()
+			c.lc.Info("InfluxDB mirroring stopped")
+			return
+		case <-ticker.C:
+			c.Flush()
+		case <-c.batchReady:
+			c.Flush()
+		}
+	}
+}
+
+// Flush writes the queued points in batches. The batch failing with an error InfluxDB may recover from is queued again
+// with the batches following it, a batch InfluxDB rejects is dropped.
+func (c *InfluxDBClient) Flush() {
+	c.mutex.Lock()
+	pending := c.queue
+	c.queue = nil
+	c.mutex.Unlock()
+
+	for len(pending) > 0 {
+		size := c.batchSize
+		if size > len(pending) {
+			size = len(pending)
+		}
+
+		retry, err := c.write(pending[:size])
+		if err == nil {
+			c.lc.Debug(fmt.Sprintf("wrote %d point(s) to InfluxDB", size))
+		} else if !retry {
+			c.lc.Error(fmt.Sprintf("InfluxDB rejected %d point(s), dropping them: %s", size, err.Error()))
+		} else {
+			c.lc.Warn(fmt.Sprintf("unable to write %d point(s) to InfluxDB, retrying on next flush: %s", len(pending), err.Error()))
+
+			// the oldest points are dropped when the readings queued meanwhile don't leave room for all of them
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+			c.queue = append(pending, c.queue...)
+			if len(c.queue) > c.queueSize {
+				c.queue = c.queue[len(c.queue)-c.queueSize:]
+			}
+			return
+		}
+		pending = pending[size:]
+	}
+}
+
+// write posts the lines to InfluxDB and tells whether a failed write can be retried
+func (c *InfluxDBClient) write(lines []string) (bool, error) {
+	body := strings.Join(lines, "\n")
+	req, err := http.NewRequest(http.MethodPost, c.writeUrl, bytes.NewBufferString(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set(clients.ContentType, "text/plain; charset=utf-8")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Token "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return false, nil
+	}
+
+	message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(message)))
+	// malformed points, an unknown bucket or a token lacking permission won't be written on retry
+	return resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+// lineOf returns the reading as a point in line protocol. Values InfluxDB can't store, NaN and infinities, aren't
+// mirrored.
+func lineOf(reading numericReading) (string, bool) {
+	if math.IsNaN(reading.value) || math.IsInf(reading.value, 0) {
+		return "", false
+	}
+
+	tags := make(map[string]string, len(reading.tags)+2)
+	for tag, tagValue := range reading.tags {
+		tags[tag] = tagValue
+	}
+	if reading.deviceName != "" {
+		tags[DeviceLabel] = reading.deviceName
+	}
+	if reading.profileName != "" {
+		tags[ProfileLabel] = reading.profileName
+	}
+	// tags are sorted as InfluxDB recommends, those without value or with a reserved key are left out as InfluxDB
+	// rejects them
+	names := make([]string, 0, len(tags))
+	for tag, tagValue := range tags {
+		if tag != "" && tagValue != "" && tag != "time" && tag != "_field" && tag != "_measurement" {
+			names = append(names, tag)
+		}
+	}
+	sort.Strings(names)
+
+	var line strings.Builder
+	line.WriteString(escapeLineProtocol(reading.resourceName, ", "))
+	for _, tag := range names {
+		line.WriteByte(',')
+		line.WriteString(escapeLineProtocol(tag, ",= "))
+		line.WriteByte('=')
+		line.WriteString(escapeLineProtocol(tags[tag], ",= "))
+	}
+	line.WriteByte(' ')
+	line.WriteString(InfluxDBValueField)
+	line.WriteByte('=')
+	line.WriteString(strconv.FormatFloat(reading.value, 'g', -1, 64))
+	line.WriteByte(' ')
+	line.WriteString(strconv.FormatInt(toNanoseconds(reading.origin), 10))
+	return line.String(), true
+}
+
+// escapeLineProtocol escapes the special characters of a measurement, tag key or tag value. Line breaks, which can't
+// be escaped, are replaced by spaces.
+func escapeLineProtocol(s string, special string) string {
+	var escaped strings.Builder
+	for _, ch := range s {
+		if ch == '\n' || ch == '\r' {
+			ch = ' '
+		}
+		if ch == '\\' || strings.ContainsRune(special, ch) {
+			escaped.WriteByte('\\')
+		}
+		escaped.WriteRune(ch)
+	}
+	return escaped.String()
+}
@@ -0,0 +1,199 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		reading  numericReading
+		expected string
+		ok       bool
+	}{
+		{
+			"Tags sorted",
+			numericReading{deviceName: "Thermostat", profileName: "ThermostatProfile", resourceName: "Temperature", tags: map[string]string{"site": "north"}, value: 21.5, origin: 1604000000000000000},
+			"Temperature,device=Thermostat,profile=ThermostatProfile,site=north value=21.5 1604000000000000000",
+			true,
+		},
+		{
+			"Origin in milliseconds",
+			numericReading{resourceName: "Humidity", value: 40, origin: 1604000000000},
+			"Humidity value=40 1604000000000000000",
+			true,
+		},
+		{
+			"Special characters escaped",
+			numericReading{deviceName: "Room 1,A", resourceName: "Temp, C", tags: map[string]string{"a=b": "x\ny", "empty": "", "time": "now"}, value: -1, origin: 1},
+			`Temp\,\ C,a\=b=x\ y,device=Room\ 1\,A value=-1 1000000`,
+			true,
+		},
+		{"NaN not mirrored", numericReading{resourceName: "Temperature", value: math.NaN(), origin: 1}, "", false},
+		{"Infinity not mirrored", numericReading{resourceName: "Temperature", value: math.Inf(1), origin: 1}, "", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			line, ok := lineOf(testCase.reading)
+			assert.Equal(t, testCase.ok, ok)
+			assert.Equal(t, testCase.expected, line)
+		})
+	}
+}
+
+func TestInfluxDBClientWritesReadings(t *testing.T) {
+	var mutex sync.Mutex
+	var bodies []string
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/write", r.URL.Path)
+		assert.Equal(t, "edgex", r.URL.Query().Get("org"))
+		assert.Equal(t, "readings", r.URL.Query().Get("bucket"))
+		assert.Equal(t, "ns", r.URL.Query().Get("precision"))
+		assert.Equal(t, "Token secret", r.Header.Get("Authorization"))
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		mutex.Lock()
+		defer mutex.Unlock()
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	inner := &fakeMessageClient{}
+	influxDBConfig := config.InfluxDBInfo{
+		Url:           server.URL + "/",
+		Org:           "edgex",
+		Bucket:        "readings",
+		BatchSize:     2,
+		FlushInterval: "1h",
+		Timeout:       "5s",
+		QueueSize:     10,
+	}
+	client, err := NewInfluxDBClient(inner, influxDBConfig, map[string]string{InfluxDBTokenSecret: "secret"}, logger.NewMockClient())
+	require.NoError(t, err)
+
+	event := map[string]interface{}{
+		"deviceName":  "Thermostat",
+		"profileName": "ThermostatProfile",
+		"origin":      int64(1604000000000000000),
+		"readings": []map[string]interface{}{
+			{"resourceName": "Temperature", "valueType": "Float64", "value": "2.15e+01"},
+			{"resourceName": "Humidity", "valueType": "Uint8", "value": "40"},
+			{"resourceName": "Mode", "valueType": "String", "value": "heat"},
+		},
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	message := types.MessageEnvelope{Payload: payload, ContentType: clients.ContentTypeJSON}
+	require.NoError(t, client.Publish(message, "events"))
+	require.NoError(t, client.Publish(message, "events"))
+	assert.Equal(t, 2, inner.published)
+	require.Len(t, client.queue, 4, "only the numeric readings are queued")
+
+	client.Flush()
+	require.Len(t, bodies, 2, "the points are written in batches")
+	assert.Equal(t,
+		"Temperature,device=Thermostat,profile=ThermostatProfile value=21.5 1604000000000000000\n"+
+			"Humidity,device=Thermostat,profile=ThermostatProfile value=40 1604000000000000000",
+		bodies[0])
+	assert.Empty(t, client.queue)
+
+	// points failing to be written with a server error are written again on next flush, not those rejected
+	mutex.Lock()
+	status = http.StatusServiceUnavailable
+	mutex.Unlock()
+	require.NoError(t, client.Publish(message, "events"))
+	client.Flush()
+	assert.Len(t, client.queue, 2)
+
+	mutex.Lock()
+	status = http.StatusBadRequest
+	mutex.Unlock()
+	client.Flush()
+	assert.Empty(t, client.queue)
+	assert.Len(t, bodies, 4)
+}
+
+func TestInfluxDBClientWritesFullBatch(t *testing.T) {
+	written := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		written <- string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	influxDBConfig := config.InfluxDBInfo{Url: server.URL, Org: "edgex", Bucket: "readings", BatchSize: 2, FlushInterval: "1h", Timeout: "5s", QueueSize: 10}
+	client, err := NewInfluxDBClient(&fakeMessageClient{}, influxDBConfig, nil, logger.NewMockClient())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Run(ctx)
+
+	payload := []byte(`{"device":"Thermostat","origin":1,"readings":[{"name":"Temperature","valueType":"Int16","value":"21"},{"name":"Humidity","valueType":"Int16","value":"40"}]}`)
+	require.NoError(t, client.Publish(types.MessageEnvelope{Payload: payload, ContentType: clients.ContentTypeJSON}, "events"))
+	select {
+	case body := <-written:
+		assert.Len(t, strings.Split(body, "\n"), 2)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "a full batch must be written before the flush interval elapses")
+	}
+}
+
+func TestNewInfluxDBClientInvalidConfig(t *testing.T) {
+	valid := config.InfluxDBInfo{Url: "http://localhost:8086", Org: "edgex", Bucket: "readings", BatchSize: 10, FlushInterval: "10s", Timeout: "10s", QueueSize: 100}
+	_, err := NewInfluxDBClient(&fakeMessageClient{}, valid, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	missingBucket := valid
+	missingBucket.Bucket = ""
+	invalidBatchSize := valid
+	invalidBatchSize.BatchSize = 0
+	queueSmallerThanBatch := valid
+	queueSmallerThanBatch.QueueSize = 5
+	invalidFlushInterval := valid
+	invalidFlushInterval.FlushInterval = "often"
+	invalidTimeout := valid
+	invalidTimeout.Timeout = ""
+	invalidUrl := valid
+	invalidUrl.Url = "http://local host"
+
+	for _, influxDBConfig := range []config.InfluxDBInfo{missingBucket, invalidBatchSize, queueSmallerThanBatch, invalidFlushInterval, invalidTimeout, invalidUrl} {
+		_, err := NewInfluxDBClient(&fakeMessageClient{}, influxDBConfig, nil, logger.NewMockClient())
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,65 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	mqttTimeout = 10 * time.Second
+	// mqttQuiesce is how long in-flight messages are given to complete on disconnect, in milliseconds
+	mqttQuiesce = 250
+)
+
+// mqttConnect connects a MQTT client to the broker over TLS.
+func mqttConnect(
+	host string,
+	port int,
+	clientId string,
+	username string,
+	password string,
+	tlsConfig *tls.Config,
+	autoReconnect bool) (mqtt.Client, error) {
+
+	options := mqtt.NewClientOptions().
+		AddBroker(fmt.Sprintf("ssl://%s:%d", host, port)).
+		SetClientID(clientId).
+		SetUsername(username).
+		SetPassword(password).
+		SetTLSConfig(tlsConfig).
+		SetProtocolVersion(4). // the cloud hubs only speak MQTT 3.1.1
+		SetAutoReconnect(autoReconnect).
+		SetConnectTimeout(mqttTimeout)
+
+	client := mqtt.NewClient(options)
+	if err := waitForToken(client.Connect()); err != nil {
+		return nil, fmt.Errorf("unable to connect to %s:%d as %s: %s", host, port, clientId, err.Error())
+	}
+	return client, nil
+}
+
+// waitForToken waits for the MQTT operation to complete and returns its error.
+func waitForToken(token mqtt.Token) error {
+	if !token.WaitTimeout(mqttTimeout) {
+		return errors.New("timed out waiting for the MQTT broker")
+	}
+	return token.Error()
+}
@@ -0,0 +1,162 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// readingsEvent decodes the fields of V1 events and V2 event DTOs alike which the exported readings are made of.
+type readingsEvent struct {
+	Device      string            `json:"device"`
+	DeviceName  string            `json:"deviceName"`
+	ProfileName string            `json:"profileName"`
+	Origin      int64             `json:"origin"`
+	Tags        map[string]string `json:"tags"`
+	Readings    []struct {
+		Name          string `json:"name"`
+		ResourceName  string `json:"resourceName"`
+		ProfileName   string `json:"profileName"`
+		Origin        int64  `json:"origin"`
+		Value         string `json:"value"`
+		ValueType     string `json:"valueType"`
+		FloatEncoding string `json:"floatEncoding"`
+	} `json:"readings"`
+}
+
+// numericReading is a reading of an integer or float value type with the names and tags of its event
+type numericReading struct {
+	deviceName   string
+	profileName  string
+	resourceName string
+	tags         map[string]string
+	value        float64
+	// origin is in nanoseconds or milliseconds, as sent by older device services
+	origin int64
+}
+
+// numericReadingsOf returns the numeric readings of the event published in the message
+func numericReadingsOf(message types.MessageEnvelope) ([]numericReading, error) {
+	var event readingsEvent
+	var err error
+	if message.ContentType == clients.ContentTypeCBOR {
+		err = cbor.Unmarshal(message.Payload, &event)
+	} else {
+		err = json.Unmarshal(message.Payload, &event)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode event: %s", err.Error())
+	}
+
+	deviceName := event.DeviceName
+	if deviceName == "" {
+		deviceName = event.Device
+	}
+
+	var readings []numericReading
+	for _, reading := range event.Readings {
+		value, ok := NumericValue(reading.ValueType, reading.Value, reading.FloatEncoding)
+		if !ok {
+			continue
+		}
+		resourceName := reading.ResourceName
+		if resourceName == "" {
+			resourceName = reading.Name
+		}
+		if resourceName == "" {
+			continue
+		}
+		profileName := reading.ProfileName
+		if profileName == "" {
+			profileName = event.ProfileName
+		}
+		origin := reading.Origin
+		if origin == 0 {
+			origin = event.Origin
+		}
+		if origin == 0 {
+			origin = time.Now().UnixNano()
+		}
+
+		readings = append(readings, numericReading{
+			deviceName:   deviceName,
+			profileName:  profileName,
+			resourceName: resourceName,
+			tags:         event.Tags,
+			value:        value,
+			origin:       origin,
+		})
+	}
+	return readings, nil
+}
+
+// NumericValue parses the value of a reading of an integer or float value type. Floats are either in E notation or
+// the base64 encoding of their big endian binary representation.
+func NumericValue(valueType string, value string, floatEncoding string) (float64, bool) {
+	valueType = strings.ToLower(valueType)
+	switch {
+	case strings.HasSuffix(valueType, "array"):
+		return 0, false
+	case strings.HasPrefix(valueType, "int"), strings.HasPrefix(valueType, "uint"):
+		f, err := strconv.ParseFloat(value, 64)
+		return f, err == nil
+	case strings.HasPrefix(valueType, "float"):
+		if floatEncoding != models.Base64Encoding {
+			if f, err := strconv.ParseFloat(value, 64); err == nil {
+				return f, true
+			}
+		}
+		b, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return 0, false
+		}
+		switch len(b) {
+		case 4:
+			return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), true
+		case 8:
+			return math.Float64frombits(binary.BigEndian.Uint64(b)), true
+		}
+	}
+	return 0, false
+}
+
+// toMilliseconds converts an origin in nanoseconds or milliseconds, as sent by older device services, to milliseconds
+func toMilliseconds(origin int64) int64 {
+	if origin > 1e15 {
+		return origin / int64(time.Millisecond)
+	}
+	return origin
+}
+
+// toNanoseconds converts an origin in nanoseconds or milliseconds, as sent by older device services, to nanoseconds
+func toNanoseconds(origin int64) int64 {
+	if origin > 1e15 {
+		return origin
+	}
+	return origin * int64(time.Millisecond)
+}
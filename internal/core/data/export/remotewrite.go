@@ -0,0 +1,282 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+const (
+	// RemoteWriteUsernameSecret and RemoteWritePasswordSecret are the secrets holding the basic authentication
+	// credentials of the remote-write endpoint
+	RemoteWriteUsernameSecret = "username"
+	RemoteWritePasswordSecret = "password"
+	// RemoteWriteTokenSecret is the secret holding the bearer token of the remote-write endpoint
+	RemoteWriteTokenSecret = "token"
+
+	// DeviceLabel and ProfileLabel are the labels of the samples holding the device and device profile names
+	DeviceLabel  = "device"
+	ProfileLabel = "profile"
+
+	metricNameLabel    = "__name__"
+	remoteWriteVersion = "0.1.0"
+)
+
+// RemoteWriteClient wraps a messaging.MessageClient and exports the numeric readings of every event it publishes to a
+// Prometheus remote-write endpoint. The metric name of a sample is the resource name of the reading, its labels the
+// device and device profile names and the tags of the event. Samples are queued and pushed in batches by Run.
+type RemoteWriteClient struct {
+	messaging.MessageClient
+	url           string
+	metricPrefix  string
+	secrets       map[string]string
+	httpClient    *http.Client
+	flushInterval time.Duration
+	queueSize     int
+	lc            logger.LoggingClient
+
+	mutex sync.Mutex
+	// queue holds a series per reading, merged into series of several samples when pushed
+	queue []series
+}
+
+// NewRemoteWriteClient creates a RemoteWriteClient exporting the readings published through client to the configured
+// endpoint, authenticating with the secrets read from the configured secret path.
+func NewRemoteWriteClient(
+	client messaging.MessageClient,
+	remoteWriteConfig config.RemoteWriteInfo,
+	secrets map[string]string,
+	lc logger.LoggingClient) (*RemoteWriteClient, error) {
+
+	if remoteWriteConfig.QueueSize <= 0 {
+		return nil, fmt.Errorf("remote-write queue size must be greater than zero, got %d", remoteWriteConfig.QueueSize)
+	}
+	flushInterval, err := time.ParseDuration(remoteWriteConfig.FlushInterval)
+	if err != nil || flushInterval <= 0 {
+		return nil, fmt.Errorf("invalid remote-write FlushInterval '%s'", remoteWriteConfig.FlushInterval)
+	}
+	timeout, err := time.ParseDuration(remoteWriteConfig.Timeout)
+	if err != nil || timeout <= 0 {
+		return nil, fmt.Errorf("invalid remote-write Timeout '%s'", remoteWriteConfig.Timeout)
+	}
+
+	return &RemoteWriteClient{
+		MessageClient: client,
+		url:           remoteWriteConfig.Url,
+		metricPrefix:  remoteWriteConfig.MetricPrefix,
+		secrets:       secrets,
+		httpClient:    &http.Client{Timeout: timeout},
+		flushInterval: flushInterval,
+		queueSize:     remoteWriteConfig.QueueSize,
+		lc:            lc,
+	}, nil
+}
+
+// Publish sends the message to the message bus and queues the numeric readings of the event for export. The readings
+// are exported even when the message couldn't be published to the message bus.
+func (c *RemoteWriteClient) Publish(message types.MessageEnvelope, topic string) error {
+	err := c.MessageClient.Publish(message, topic)
+
+	queued, decodeErr := c.seriesOf(message)
+	if decodeErr != nil {
+		c.lc.Error(fmt.Sprintf("unable to export readings: %s. Correlation-id: %s", decodeErr.Error(), message.CorrelationID))
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if free := c.queueSize - len(c.queue); len(queued) > free {
+		c.lc.Warn(fmt.Sprintf("remote-write queue is full (%d), dropping %d reading(s). Correlation-id: %s", c.queueSize, len(queued)-free, message.CorrelationID))
+		queued = queued[:free]
+	}
+	c.queue = append(c.queue, queued...)
+	return err
+}
+
+// Run pushes the queued samples every flush interval until ctx is done, then pushes them one last time.
+func (c *RemoteWriteClient) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.Flush()
+			c.lc.Info("remote-write export stopped")
+			return
+		case <-ticker.C:
+			c.Flush()
+		}
+	}
+}
+
+// Flush pushes the queued samples. Samples which the endpoint may accept later are queued again, those it rejects are
+// dropped.
+func (c *RemoteWriteClient) Flush() {
+	c.mutex.Lock()
+	pending := c.queue
+	c.queue = nil
+	c.mutex.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+
+	retry, err := c.push(mergeSeries(pending))
+	if err == nil {
+		c.lc.Debug(fmt.Sprintf("pushed %d sample(s) to %s", len(pending), c.url))
+		return
+	}
+	if !retry {
+		c.lc.Error(fmt.Sprintf("remote-write endpoint rejected %d sample(s), dropping them: %s", len(pending), err.Error()))
+		return
+	}
+	c.lc.Warn(fmt.Sprintf("unable to push %d sample(s), retrying on next flush: %s", len(pending), err.Error()))
+
+	// the oldest samples are dropped when the readings queued meanwhile don't leave room for all of them
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.queue = append(pending, c.queue...)
+	if len(c.queue) > c.queueSize {
+		c.queue = c.queue[len(c.queue)-c.queueSize:]
+	}
+}
+
+// push posts the series to the endpoint and tells whether a failed push can be retried
+func (c *RemoteWriteClient) push(all []series) (bool, error) {
+	body := snappyEncode(encodeWriteRequest(all))
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set(clients.ContentType, "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+	if token := c.secrets[RemoteWriteTokenSecret]; token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if username := c.secrets[RemoteWriteUsernameSecret]; username != "" {
+		req.SetBasicAuth(username, c.secrets[RemoteWritePasswordSecret])
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return false, nil
+	}
+
+	message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(message)))
+	// as per the protocol, requests failing with a client error other than throttling must not be retried
+	return resp.StatusCode/100 == 5 || resp.StatusCode == http.StatusTooManyRequests, err
+}
+
+// mergeSeries merges the samples of the series with the same labels, sorted by name, in timestamp order
+func mergeSeries(all []series) []series {
+	var merged []series
+	byKey := make(map[string]int)
+	for _, s := range all {
+		key := seriesKey(s.labels)
+		i, ok := byKey[key]
+		if !ok {
+			byKey[key] = len(merged)
+			merged = append(merged, series{labels: s.labels, samples: append([]sample(nil), s.samples...)})
+			continue
+		}
+		merged[i].samples = append(merged[i].samples, s.samples...)
+	}
+	for _, s := range merged {
+		samples := s.samples
+		sort.SliceStable(samples, func(i, j int) bool {
+			return samples[i].timestamp < samples[j].timestamp
+		})
+	}
+	return merged
+}
+
+func seriesKey(labels []label) string {
+	var key strings.Builder
+	for _, l := range labels {
+		key.WriteString(l.name)
+		key.WriteByte(0)
+		key.WriteString(l.value)
+		key.WriteByte(0)
+	}
+	return key.String()
+}
+
+// seriesOf returns a series per numeric reading of the event published in the message
+func (c *RemoteWriteClient) seriesOf(message types.MessageEnvelope) ([]series, error) {
+	readings, err := numericReadingsOf(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []series
+	for _, reading := range readings {
+		labels := []label{{name: metricNameLabel, value: sanitizeName(c.metricPrefix+reading.resourceName, true)}}
+		if reading.deviceName != "" {
+			labels = append(labels, label{name: DeviceLabel, value: reading.deviceName})
+		}
+		if reading.profileName != "" {
+			labels = append(labels, label{name: ProfileLabel, value: reading.profileName})
+		}
+		for tag, tagValue := range reading.tags {
+			name := sanitizeName(tag, false)
+			if name == metricNameLabel || name == DeviceLabel || name == ProfileLabel || tagValue == "" {
+				continue
+			}
+			labels = append(labels, label{name: name, value: tagValue})
+		}
+
+		sort.Slice(labels, func(i, j int) bool {
+			return labels[i].name < labels[j].name
+		})
+		all = append(all, series{labels: labels, samples: []sample{{value: reading.value, timestamp: toMilliseconds(reading.origin)}}})
+	}
+	return all, nil
+}
+
+// sanitizeName replaces the characters which aren't valid in a metric or label name by underscores. Colons are only
+// valid in metric names.
+func sanitizeName(name string, metric bool) string {
+	b := []byte(name)
+	for i, ch := range b {
+		valid := ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') ||
+			(ch >= '0' && ch <= '9' && i > 0) || (ch == ':' && metric)
+		if !valid {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
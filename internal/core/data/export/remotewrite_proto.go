@@ -0,0 +1,129 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// The Prometheus remote-write protocol posts a snappy compressed protobuf WriteRequest. The messages are small and
+// stable, so they're encoded here rather than depending on the Prometheus and snappy modules:
+//
+//	message WriteRequest { repeated TimeSeries timeseries = 1; }
+//	message TimeSeries   { repeated Label labels = 1; repeated Sample samples = 2; }
+//	message Label        { string name = 1; string value = 2; }
+//	message Sample       { double value = 1; int64 timestamp = 2; }
+
+const (
+	protoWireVarint  = 0
+	protoWireFixed64 = 1
+	protoWireBytes   = 2
+)
+
+// label is a name and value identifying a time series
+type label struct {
+	name  string
+	value string
+}
+
+// sample is the value of a time series at a timestamp in milliseconds
+type sample struct {
+	value     float64
+	timestamp int64
+}
+
+// series is a time series and its samples, in timestamp order
+type series struct {
+	labels  []label
+	samples []sample
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+func appendTag(b []byte, field int, wireType int) []byte {
+	return appendVarint(b, uint64(field<<3|wireType))
+}
+
+func appendBytesField(b []byte, field int, value []byte) []byte {
+	b = appendTag(b, field, protoWireBytes)
+	b = appendVarint(b, uint64(len(value)))
+	return append(b, value...)
+}
+
+func encodeLabel(l label) []byte {
+	var b []byte
+	b = appendBytesField(b, 1, []byte(l.name))
+	return appendBytesField(b, 2, []byte(l.value))
+}
+
+func encodeSample(s sample) []byte {
+	var b []byte
+	b = appendTag(b, 1, protoWireFixed64)
+	var value [8]byte
+	binary.LittleEndian.PutUint64(value[:], math.Float64bits(s.value))
+	b = append(b, value[:]...)
+	b = appendTag(b, 2, protoWireVarint)
+	return appendVarint(b, uint64(s.timestamp))
+}
+
+// encodeWriteRequest encodes the series as a WriteRequest. The labels of each series must be sorted by name as the
+// protocol requires.
+func encodeWriteRequest(all []series) []byte {
+	var request []byte
+	for _, s := range all {
+		var timeSeries []byte
+		for _, l := range s.labels {
+			timeSeries = appendBytesField(timeSeries, 1, encodeLabel(l))
+		}
+		for _, smp := range s.samples {
+			timeSeries = appendBytesField(timeSeries, 2, encodeSample(smp))
+		}
+		request = appendBytesField(request, 1, timeSeries)
+	}
+	return request
+}
+
+// snappyMaxLiteral is the length of the literals the block is split into
+const snappyMaxLiteral = 1 << 16
+
+// snappyEncode encodes the data in the snappy block format as literals only. Every snappy decoder accepts the block,
+// it just isn't compressed, which is of little consequence for the batches of samples pushed over a local network.
+func snappyEncode(data []byte) []byte {
+	b := appendVarint(make([]byte, 0, len(data)+len(data)/snappyMaxLiteral*3+16), uint64(len(data)))
+	for len(data) > 0 {
+		n := len(data)
+		if n > snappyMaxLiteral {
+			n = snappyMaxLiteral
+		}
+		switch m := n - 1; {
+		case m < 60:
+			b = append(b, byte(m)<<2)
+		case m < 1<<8:
+			b = append(b, 60<<2, byte(m))
+		default:
+			b = append(b, 61<<2, byte(m), byte(m>>8))
+		}
+		b = append(b, data[:n]...)
+		data = data[n:]
+	}
+	return b
+}
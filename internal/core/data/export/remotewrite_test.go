@@ -0,0 +1,228 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// snappyDecodeLiterals decodes a snappy block made of literals only, as encoded by snappyEncode
+func snappyDecodeLiterals(t *testing.T, b []byte) []byte {
+	length, n := binary.Uvarint(b)
+	require.Greater(t, n, 0)
+	b = b[n:]
+	var data []byte
+	for len(b) > 0 {
+		tag := b[0]
+		require.Equal(t, byte(0), tag&3, "only literals are expected")
+		m := int(tag >> 2)
+		b = b[1:]
+		switch m {
+		case 60:
+			m = int(b[0])
+			b = b[1:]
+		case 61:
+			m = int(b[0]) | int(b[1])<<8
+			b = b[2:]
+		}
+		data = append(data, b[:m+1]...)
+		b = b[m+1:]
+	}
+	require.Equal(t, int(length), len(data))
+	return data
+}
+
+func TestSnappyEncode(t *testing.T) {
+	for _, size := range []int{0, 1, 60, 61, 256, 257, 70000} {
+		data := bytes.Repeat([]byte{'x'}, size)
+		assert.Equal(t, data, append([]byte{}, snappyDecodeLiterals(t, snappyEncode(data))...), "size %d", size)
+	}
+}
+
+func TestEncodeWriteRequest(t *testing.T) {
+	request := encodeWriteRequest([]series{{
+		labels:  []label{{name: "__name__", value: "t"}},
+		samples: []sample{{value: 1, timestamp: 2}},
+	}})
+
+	labelBytes := []byte{0x0a, 0x08, 0x5f, 0x5f, 'n', 'a', 'm', 'e', 0x5f, 0x5f, 0x12, 0x01, 't'}
+	sampleBytes := []byte{0x09, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f, 0x10, 0x02}
+	var timeSeries []byte
+	timeSeries = append(timeSeries, 0x0a, byte(len(labelBytes)))
+	timeSeries = append(timeSeries, labelBytes...)
+	timeSeries = append(timeSeries, 0x12, byte(len(sampleBytes)))
+	timeSeries = append(timeSeries, sampleBytes...)
+	expected := append([]byte{0x0a, byte(len(timeSeries))}, timeSeries...)
+	assert.Equal(t, expected, request)
+}
+
+func TestNumericValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		value         string
+		floatEncoding string
+		expected      float64
+		expectedOk    bool
+	}{
+		{"Int", "Int32", "-12", "", -12, true},
+		{"Uint", "Uint64", "42", "", 42, true},
+		{"Float in E notation", "Float64", "2.5e+01", "eNotation", 25, true},
+		{"Float32 in base64", "Float32", "QSAAAA==", "Base64", 10, true},
+		{"Float64 in base64", "Float64", "QCQAAAAAAAA=", "Base64", 10, true},
+		{"Not numeric - bool", "Bool", "true", "", 0, false},
+		{"Not numeric - string", "String", "12", "", 0, false},
+		{"Not numeric - array", "Int32Array", "[1,2]", "", 0, false},
+		{"Invalid value", "Int8", "twelve", "", 0, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			value, ok := NumericValue(testCase.valueType, testCase.value, testCase.floatEncoding)
+			assert.Equal(t, testCase.expectedOk, ok)
+			assert.Equal(t, testCase.expected, value)
+		})
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	assert.Equal(t, "edgex_Temperature_C", sanitizeName("edgex_Temperature-C", true))
+	assert.Equal(t, "job:rate", sanitizeName("job:rate", true))
+	assert.Equal(t, "_zone", sanitizeName("1zone", false))
+	assert.Equal(t, "job_rate", sanitizeName("job:rate", false))
+}
+
+func TestRemoteWriteClientPushesReadings(t *testing.T) {
+	var mutex sync.Mutex
+	var bodies [][]byte
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "application/x-protobuf", r.Header.Get(clients.ContentType))
+		assert.Equal(t, "0.1.0", r.Header.Get("X-Prometheus-Remote-Write-Version"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "grafana", username)
+		assert.Equal(t, "secret", password)
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		mutex.Lock()
+		defer mutex.Unlock()
+		bodies = append(bodies, body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	inner := &fakeMessageClient{}
+	remoteWriteConfig := config.RemoteWriteInfo{
+		Url:           server.URL,
+		MetricPrefix:  "edgex_",
+		FlushInterval: "1h",
+		Timeout:       "5s",
+		QueueSize:     10,
+	}
+	secrets := map[string]string{RemoteWriteUsernameSecret: "grafana", RemoteWritePasswordSecret: "secret"}
+	client, err := NewRemoteWriteClient(inner, remoteWriteConfig, secrets, logger.NewMockClient())
+	require.NoError(t, err)
+
+	event := map[string]interface{}{
+		"deviceName":  "Thermostat",
+		"profileName": "ThermostatProfile",
+		"origin":      int64(1604000000000000000),
+		"tags":        map[string]string{"site": "north"},
+		"readings": []map[string]interface{}{
+			{"resourceName": "Temperature", "valueType": "Float64", "value": "2.15e+01"},
+			{"resourceName": "Mode", "valueType": "String", "value": "heat"},
+		},
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.NoError(t, client.Publish(types.MessageEnvelope{Payload: payload, ContentType: clients.ContentTypeJSON}, "events"))
+	assert.Equal(t, 1, inner.published)
+	require.Len(t, client.queue, 1, "only the numeric reading is queued")
+
+	client.Flush()
+	require.Len(t, bodies, 1)
+	request := snappyDecodeLiterals(t, bodies[0])
+	for _, expected := range []string{"__name__", "edgex_Temperature", "device", "Thermostat", "profile", "ThermostatProfile", "site", "north"} {
+		assert.Contains(t, string(request), expected)
+	}
+	assert.True(t, bytes.Contains(request, binaryFloat(21.5)))
+	assert.Empty(t, client.queue)
+
+	// samples failing to be pushed with a server error are pushed again on next flush, not those rejected
+	mutex.Lock()
+	status = http.StatusServiceUnavailable
+	mutex.Unlock()
+	require.NoError(t, client.Publish(types.MessageEnvelope{Payload: payload, ContentType: clients.ContentTypeJSON}, "events"))
+	client.Flush()
+	assert.Len(t, client.queue, 1)
+
+	mutex.Lock()
+	status = http.StatusBadRequest
+	mutex.Unlock()
+	client.Flush()
+	assert.Empty(t, client.queue)
+	assert.Len(t, bodies, 3)
+}
+
+func TestRemoteWriteClientQueueFull(t *testing.T) {
+	remoteWriteConfig := config.RemoteWriteInfo{Url: "http://localhost", FlushInterval: "1h", Timeout: "5s", QueueSize: 1}
+	client, err := NewRemoteWriteClient(&fakeMessageClient{}, remoteWriteConfig, nil, logger.NewMockClient())
+	require.NoError(t, err)
+
+	payload := []byte(`{"device":"Thermostat","readings":[{"name":"Temperature","valueType":"Int16","value":"21"},{"name":"Humidity","valueType":"Int16","value":"40"}]}`)
+	require.NoError(t, client.Publish(types.MessageEnvelope{Payload: payload, ContentType: clients.ContentTypeJSON}, "events"))
+	require.Len(t, client.queue, 1)
+	assert.Equal(t, "Temperature", client.queue[0].labels[0].value)
+}
+
+func TestNewRemoteWriteClientInvalidConfig(t *testing.T) {
+	valid := config.RemoteWriteInfo{Url: "http://localhost", FlushInterval: "10s", Timeout: "10s", QueueSize: 10}
+	invalidQueueSize := valid
+	invalidQueueSize.QueueSize = 0
+	invalidFlushInterval := valid
+	invalidFlushInterval.FlushInterval = "often"
+	invalidTimeout := valid
+	invalidTimeout.Timeout = ""
+
+	for _, remoteWriteConfig := range []config.RemoteWriteInfo{invalidQueueSize, invalidFlushInterval, invalidTimeout} {
+		_, err := NewRemoteWriteClient(&fakeMessageClient{}, remoteWriteConfig, nil, logger.NewMockClient())
+		assert.Error(t, err)
+	}
+}
+
+func binaryFloat(f float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(f))
+	return b
+}
@@ -18,12 +18,21 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/export"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/publisher"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/deletion"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/ingestion"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/writebehind"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/messagebus"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/secret"
@@ -31,7 +40,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/metadata"
-	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
 	"github.com/edgexfoundry/go-mod-messaging/messaging"
 	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 
@@ -51,15 +60,21 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(_ context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
 	v2.LoadRestRoutes(b.router, dic)
 
 	configuration := dataContainer.ConfigurationFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
-	mdc := metadata.NewDeviceClient(local.New(configuration.Clients["Metadata"].Url() + clients.ApiDeviceRoute))
-	msc := metadata.NewDeviceServiceClient(local.New(configuration.Clients["Metadata"].Url() + clients.ApiDeviceRoute))
+	if err := ingestion.ValidateConfiguration(dic); err != nil {
+		lc.Error(fmt.Sprintf("invalid Writable.Ingestion configuration: %s", err.Error()))
+		return false
+	}
+
+	registry := pkgContainer.EndpointsRegistryFrom(dic.Get)
+	mdc := metadata.NewDeviceClient(endpoints.NewURLClient(registry, "Metadata", configuration.Clients["Metadata"], clients.ApiDeviceRoute))
+	msc := metadata.NewDeviceServiceClient(endpoints.NewURLClient(registry, "Metadata", configuration.Clients["Metadata"], clients.ApiDeviceRoute))
 
 	// For Redis Streams MessageBus, we reuse the Redis instance running for the DB, which may have a password,
 	// so we need to get and use the DB credentials for the MessageBus connection.
@@ -76,7 +91,7 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 	}
 
 	// Create the messaging client
-	msgClient, err := messaging.NewMessageClient(
+	msgClient, err := messagebus.NewMessageClient(
 		msgTypes.MessageBusConfig{
 			PublishHost: msgTypes.HostInfo{
 				Host:     configuration.MessageQueue.Host,
@@ -85,7 +100,8 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 			},
 			Type:     configuration.MessageQueue.Type,
 			Optional: configuration.MessageQueue.Optional,
-		})
+		},
+		configuration.MessageQueue.JetStream)
 
 	if err != nil {
 		lc.Error(fmt.Sprintf("failed to create messaging client: %s", err.Error()))
@@ -107,22 +123,15 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		return false
 	}
 
-	// Setup special "defer" go func that will disconnect from the message bus when the service is exiting
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for {
-			select {
-			case <-ctx.Done():
-				if err := msgClient.Disconnect(); err != nil {
-					lc.Error("failed to disconnect from the Message Bus")
-					return
-				}
-				lc.Info("Message Bus disconnected")
-				return
-			}
+	// Disconnect from the message bus once the requests publishing the events are drained when the service is exiting
+	coordinator := pkgContainer.ShutdownCoordinatorFrom(dic.Get)
+	coordinator.OnShutdown("message bus", func() {
+		if err := msgClient.Disconnect(); err != nil {
+			lc.Error("failed to disconnect from the Message Bus")
+			return
 		}
-	}()
+		lc.Info("Message Bus disconnected")
+	})
 
 	lc.Info(fmt.Sprintf(
 		"Connected to %s Message Bus @ %s://%s:%d publishing on '%s' topic",
@@ -132,16 +141,172 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		configuration.MessageQueue.Port,
 		configuration.MessageQueue.Topic))
 
+	var publishClient messaging.MessageClient = msgClient
+	var publishBuffer *publisher.BufferedClient
+	if configuration.MessageQueue.Buffer.Enabled {
+		bufferConfig := configuration.MessageQueue.Buffer
+		retryInterval, err := time.ParseDuration(bufferConfig.RetryInterval)
+		if err != nil {
+			lc.Error(fmt.Sprintf("invalid MessageQueue.Buffer.RetryInterval '%s': %s", bufferConfig.RetryInterval, err.Error()))
+			return false
+		}
+
+		publishBuffer, err = publisher.NewBufferedClient(msgClient, bufferConfig.MaxSize, bufferConfig.PersistFile, lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create message bus publish buffer: %s", err.Error()))
+			return false
+		}
+		publishClient = publishBuffer
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticker := time.NewTicker(retryInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-coordinator.Context().Done():
+					lc.Info(fmt.Sprintf("Message Bus publish buffer stopped with %d message(s) buffered", publishBuffer.Metrics().Depth))
+					return
+				case <-ticker.C:
+					publishBuffer.Replay()
+				}
+			}
+		}()
+
+		lc.Info(fmt.Sprintf("Message Bus publish buffering enabled with capacity of %d message(s)", bufferConfig.MaxSize))
+	}
+
+	if configuration.Export.Type != "" {
+		secrets, err := container.SecretProviderFrom(dic.Get).GetSecrets(configuration.Export.SecretPath)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to retrieve export secrets from '%s': %s", configuration.Export.SecretPath, err.Error()))
+			return false
+		}
+
+		connector, err := export.NewConnector(configuration.Export, secrets)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create %s export: %s", configuration.Export.Type, err.Error()))
+			return false
+		}
+
+		exportClient, err := export.NewClient(publishClient, connector, configuration.Export, lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create %s export: %s", configuration.Export.Type, err.Error()))
+			return false
+		}
+		publishClient = exportClient
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exportClient.Run(coordinator.Context())
+		}()
+
+		lc.Info(fmt.Sprintf("Exporting events to %s @ %s:%d", configuration.Export.Type, configuration.Export.Host, configuration.Export.Port))
+	}
+
+	if configuration.RemoteWrite.Url != "" {
+		var secrets map[string]string
+		if configuration.RemoteWrite.SecretPath != "" {
+			secrets, err = container.SecretProviderFrom(dic.Get).GetSecrets(configuration.RemoteWrite.SecretPath)
+			if err != nil {
+				lc.Error(fmt.Sprintf("failed to retrieve remote-write secrets from '%s': %s", configuration.RemoteWrite.SecretPath, err.Error()))
+				return false
+			}
+		}
+
+		remoteWriteClient, err := export.NewRemoteWriteClient(publishClient, configuration.RemoteWrite, secrets, lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create remote-write export: %s", err.Error()))
+			return false
+		}
+		publishClient = remoteWriteClient
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			remoteWriteClient.Run(coordinator.Context())
+		}()
+
+		lc.Info(fmt.Sprintf("Exporting numeric readings to Prometheus remote-write endpoint %s", configuration.RemoteWrite.Url))
+	}
+
+	if configuration.InfluxDB.Url != "" {
+		var secrets map[string]string
+		if configuration.InfluxDB.SecretPath != "" {
+			secrets, err = container.SecretProviderFrom(dic.Get).GetSecrets(configuration.InfluxDB.SecretPath)
+			if err != nil {
+				lc.Error(fmt.Sprintf("failed to retrieve InfluxDB secrets from '%s': %s", configuration.InfluxDB.SecretPath, err.Error()))
+				return false
+			}
+		}
+
+		influxDBClient, err := export.NewInfluxDBClient(publishClient, configuration.InfluxDB, secrets, lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create InfluxDB mirroring: %s", err.Error()))
+			return false
+		}
+		publishClient = influxDBClient
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			influxDBClient.Run(coordinator.Context())
+		}()
+
+		lc.Info(fmt.Sprintf("Mirroring numeric readings to InfluxDB bucket %s at %s", configuration.InfluxDB.Bucket, configuration.InfluxDB.Url))
+	}
+
+	if configuration.WriteBehind.Enabled {
+		writer, err := writebehind.NewWriter(configuration.WriteBehind, v2DataContainer.DBClientFrom(dic.Get), lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create the write-behind writer: %s", err.Error()))
+			return false
+		}
+		// The queued events are stored once the requests are drained, before the database is closed
+		var writerWg sync.WaitGroup
+		writer.Run(coordinator.Context(), &writerWg)
+		coordinator.OnShutdown("write-behind", writerWg.Wait)
+		dic.Update(di.ServiceConstructorMap{
+			v2DataContainer.WriteBehindWriterName: func(get di.Get) interface{} {
+				return writer
+			},
+		})
+
+		lc.Info(fmt.Sprintf("Storing the events in batches of %d every %s, %s when %d events are queued",
+			configuration.WriteBehind.BatchSize, configuration.WriteBehind.FlushInterval,
+			configuration.WriteBehind.OverflowPolicy, configuration.WriteBehind.QueueSize))
+	}
+
+	deleter, err := deletion.NewDeleter(configuration.ReadingDeletion, v2DataContainer.DBClientFrom(dic.Get), lc)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create the reading deleter: %s", err.Error()))
+		return false
+	}
+	// The chunk being deleted is deleted before the database is closed
+	var deleterWg sync.WaitGroup
+	deleter.Run(coordinator.Context(), &deleterWg)
+	coordinator.OnShutdown("reading deletion", deleterWg.Wait)
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.ReadingDeleterName: func(get di.Get) interface{} {
+			return deleter
+		},
+	})
+
 	chEvents := make(chan interface{}, 100)
 	// initialize event handlers
-	initEventHandlers(lc, chEvents, mdc, msc, configuration)
+	initEventHandlers(lc, chEvents, mdc, msc, pkgContainer.DeviceMetricsReporterFrom(dic.Get), configuration)
 
 	dic.Update(di.ServiceConstructorMap{
 		dataContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
 			return mdc
 		},
 		dataContainer.MessagingClientName: func(get di.Get) interface{} {
-			return msgClient
+			return publishClient
+		},
+		dataContainer.PublishBufferName: func(get di.Get) interface{} {
+			return publishBuffer
 		},
 		dataContainer.EventsChannelName: func(get di.Get) interface{} {
 			return chEvents
@@ -154,5 +319,16 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 		},
 	})
 
+	// The V2 readings out of range are notified through support-notifications
+	if notificationsInfo, ok := configuration.Clients["Notifications"]; ok {
+		notificationsClient := notifications.NewNotificationsClient(
+			endpoints.NewURLClient(registry, "Notifications", notificationsInfo, clients.ApiNotificationRoute))
+		dic.Update(di.ServiceConstructorMap{
+			v2DataContainer.NotificationsClientName: func(get di.Get) interface{} {
+				return notificationsClient
+			},
+		})
+	}
+
 	return true
 }
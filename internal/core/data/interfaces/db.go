@@ -14,6 +14,7 @@
 package interfaces
 
 import (
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -156,6 +157,22 @@ type DBClient interface {
 	// Return a list of readings whos created time is between the start and end times
 	ReadingsByCreationTime(start, end int64, limit int) ([]contract.Reading, error)
 
+	// Pass the readings whos created time is between the start and end times, of device when it isn't empty, to fn in
+	// batches of batchSize
+	ScanReadingsByCreationTime(start, end int64, device string, batchSize int, fn func(readings []contract.Reading) error) error
+
+	// ************************** READING ROLLUP FUNCTIONS ***************************
+	// Return the creation time of the newest event of the API (V1 or V2) whose readings were rolled up
+	RolledUpTo(events string) (int64, error)
+
+	// Store the rollups of the readings of the events of the API removed by age, created after from up to to, merged
+	// into the ones stored for the same interval. db.ErrRolledUpMeanwhile is returned when the events of the API
+	// weren't rolled up to from anymore.
+	AddReadingRollups(events string, rollups []dataModels.ReadingRollup, from int64, to int64) error
+
+	// Return the rollups of the readings with the name of the device starting between the start and end times
+	ReadingRollups(device string, name string, start int64, end int64) ([]dataModels.ReadingRollup, error)
+
 	// ************************** VALUE DESCRIPTOR FUNCTIONS ***************************
 	// Add a value descriptor
 	// 409 - Formatting is bad or it is not unique
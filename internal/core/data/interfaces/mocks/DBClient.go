@@ -7,6 +7,8 @@ import go_mod_core_contractsmodels "github.com/edgexfoundry/go-mod-core-contract
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 
+import datamodels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
 	mock.Mock
@@ -54,6 +56,20 @@ func (_m *DBClient) AddReading(r go_mod_core_contractsmodels.Reading) (string, e
 	return r0, r1
 }
 
+// AddReadingRollups provides a mock function with given fields: events, rollups, from, to
+func (_m *DBClient) AddReadingRollups(events string, rollups []datamodels.ReadingRollup, from int64, to int64) error {
+	ret := _m.Called(events, rollups, from, to)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []datamodels.ReadingRollup, int64, int64) error); ok {
+		r0 = rf(events, rollups, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddValueDescriptor provides a mock function with given fields: v
 func (_m *DBClient) AddValueDescriptor(v go_mod_core_contractsmodels.ValueDescriptor) (string, error) {
 	ret := _m.Called(v)
@@ -446,6 +462,29 @@ func (_m *DBClient) ReadingCount() (int, error) {
 	return r0, r1
 }
 
+// ReadingRollups provides a mock function with given fields: device, name, start, end
+func (_m *DBClient) ReadingRollups(device string, name string, start int64, end int64) ([]datamodels.ReadingRollup, error) {
+	ret := _m.Called(device, name, start, end)
+
+	var r0 []datamodels.ReadingRollup
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64) []datamodels.ReadingRollup); ok {
+		r0 = rf(device, name, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]datamodels.ReadingRollup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, int64, int64) error); ok {
+		r1 = rf(device, name, start, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Readings provides a mock function with given fields:
 func (_m *DBClient) Readings() ([]go_mod_core_contractsmodels.Reading, error) {
 	ret := _m.Called()
@@ -584,6 +623,41 @@ func (_m *DBClient) ReadingsByValueDescriptorNames(names []string, limit int) ([
 	return r0, r1
 }
 
+// RolledUpTo provides a mock function with given fields: events
+func (_m *DBClient) RolledUpTo(events string) (int64, error) {
+	ret := _m.Called(events)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(events)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(events)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScanReadingsByCreationTime provides a mock function with given fields: start, end, device, batchSize, fn
+func (_m *DBClient) ScanReadingsByCreationTime(start int64, end int64, device string, batchSize int, fn func([]go_mod_core_contractsmodels.Reading) error) error {
+	ret := _m.Called(start, end, device, batchSize, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, string, int, func([]go_mod_core_contractsmodels.Reading) error) error); ok {
+		r0 = rf(start, end, device, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScrubAllEvents provides a mock function with given fields:
 func (_m *DBClient) ScrubAllEvents() error {
 	ret := _m.Called()
@@ -0,0 +1,51 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// ReadingRollup aggregates the numeric readings with the same name of a device created during an interval, kept once
+// the readings were removed by age
+type ReadingRollup struct {
+	Device string `json:"device"`
+	Name   string `json:"name"`
+	// Start is when the interval starts and Interval how long it lasts, in milliseconds
+	Start    int64   `json:"start"`
+	Interval int64   `json:"interval"`
+	Count    int64   `json:"count"`
+	Min      float64 `json:"min"`
+	Max      float64 `json:"max"`
+	Sum      float64 `json:"sum"`
+	Avg      float64 `json:"avg"`
+}
+
+// Add aggregates the value of a reading into the rollup
+func (r *ReadingRollup) Add(value float64) {
+	r.Merge(ReadingRollup{Count: 1, Min: value, Max: value, Sum: value})
+}
+
+// Merge aggregates the readings of another rollup of the same interval into the rollup
+func (r *ReadingRollup) Merge(other ReadingRollup) {
+	if other.Count == 0 {
+		return
+	}
+	if r.Count == 0 || other.Min < r.Min {
+		r.Min = other.Min
+	}
+	if r.Count == 0 || other.Max > r.Max {
+		r.Max = other.Max
+	}
+	r.Count += other.Count
+	r.Sum += other.Sum
+	r.Avg = r.Sum / float64(r.Count)
+}
@@ -0,0 +1,338 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package publisher
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// bufferedMessage is a message which could not be published and is waiting to be replayed.
+type bufferedMessage struct {
+	Topic    string
+	Envelope types.MessageEnvelope
+	// seq identifies the message in the buffer, so that a replay can tell it wasn't dropped while being published
+	seq uint64
+}
+
+// logEntry is a line of the persist file: a message appended to the buffer, or the number of messages removed from the
+// head of the buffer, once published or dropped.
+type logEntry struct {
+	Message *bufferedMessage `json:"message,omitempty"`
+	Removed int              `json:"removed,omitempty"`
+}
+
+// Metrics describes the current state of the store-and-forward buffer.
+type Metrics struct {
+	Depth     int    `json:"depth"`
+	Capacity  int    `json:"capacity"`
+	Dropped   uint64 `json:"dropped"`
+	Replayed  uint64 `json:"replayed"`
+	Connected bool   `json:"connected"`
+}
+
+// BufferedClient wraps a messaging.MessageClient and stores messages which fail to publish in a bounded buffer.
+// Buffered messages are replayed in their original order once the message bus becomes reachable again. While the
+// buffer holds messages, new messages are appended to it rather than published directly so ordering is preserved.
+// The mutex isn't held while publishing, so that a slow message bus doesn't serialize the publishers, nor a replay
+// block them.
+type BufferedClient struct {
+	messaging.MessageClient
+	lc          logger.LoggingClient
+	capacity    int
+	persistFile string
+
+	mutex     sync.Mutex
+	queue     []bufferedMessage
+	nextSeq   uint64
+	replaying bool
+	dropped   uint64
+	replayed  uint64
+	connected bool
+	log       *os.File
+	logLength int
+}
+
+// NewBufferedClient creates a BufferedClient holding at most capacity messages. When persistFile is not empty the
+// changes of the buffer are appended to that file and reloaded on creation so that messages survive a service restart.
+func NewBufferedClient(
+	client messaging.MessageClient,
+	capacity int,
+	persistFile string,
+	lc logger.LoggingClient) (*BufferedClient, error) {
+
+	if capacity <= 0 {
+		return nil, fmt.Errorf("buffer capacity must be greater than zero, got %d", capacity)
+	}
+
+	b := &BufferedClient{
+		MessageClient: client,
+		lc:            lc,
+		capacity:      capacity,
+		persistFile:   persistFile,
+		connected:     true,
+	}
+
+	if err := b.load(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Publish sends the message to the message bus. If the message bus can't be reached, or older messages are still
+// waiting to be replayed, the message is buffered instead and nil is returned.
+func (b *BufferedClient) Publish(message types.MessageEnvelope, topic string) error {
+	b.mutex.Lock()
+	if len(b.queue) == 0 {
+		b.mutex.Unlock()
+		err := b.MessageClient.Publish(message, topic)
+		b.mutex.Lock()
+		if err == nil {
+			b.connected = true
+			b.mutex.Unlock()
+			return nil
+		}
+
+		b.connected = false
+		b.lc.Warn(fmt.Sprintf("unable to publish to message bus, buffering message: %s", err.Error()))
+	}
+	defer b.mutex.Unlock()
+
+	b.enqueue(bufferedMessage{Topic: topic, Envelope: message})
+	return nil
+}
+
+// Replay attempts to publish all buffered messages in order, including the ones buffered while replaying. It stops at
+// the first failure, leaving the remaining messages in the buffer, and returns the number of messages which were
+// published. A replay already in progress isn't joined, 0 is returned.
+func (b *BufferedClient) Replay() int {
+	b.mutex.Lock()
+	if b.replaying || len(b.queue) == 0 {
+		b.mutex.Unlock()
+		return 0
+	}
+	b.replaying = true
+	b.mutex.Unlock()
+
+	published := 0
+	for {
+		b.mutex.Lock()
+		if len(b.queue) == 0 {
+			b.mutex.Unlock()
+			break
+		}
+		m := b.queue[0]
+		b.mutex.Unlock()
+
+		err := b.MessageClient.Publish(m.Envelope, m.Topic)
+
+		b.mutex.Lock()
+		if err != nil {
+			b.connected = false
+			b.lc.Debug(fmt.Sprintf("message bus still unreachable, %d message(s) buffered: %s", len(b.queue), err.Error()))
+			b.mutex.Unlock()
+			break
+		}
+		// the message may have been dropped while it was published, the buffer being full
+		if len(b.queue) > 0 && b.queue[0].seq == m.seq {
+			b.remove(1)
+		}
+		b.connected = true
+		b.replayed++
+		published++
+		b.mutex.Unlock()
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.replaying = false
+	if published > 0 {
+		b.lc.Info(fmt.Sprintf("replayed %d buffered message(s), %d remaining", published, len(b.queue)))
+	}
+
+	return published
+}
+
+// Metrics returns a snapshot of the buffer state.
+func (b *BufferedClient) Metrics() Metrics {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return Metrics{
+		Depth:     len(b.queue),
+		Capacity:  b.capacity,
+		Dropped:   b.dropped,
+		Replayed:  b.replayed,
+		Connected: b.connected,
+	}
+}
+
+// enqueue appends the message to the buffer, dropping the oldest message when the buffer is full.
+// The caller must hold the mutex.
+func (b *BufferedClient) enqueue(m bufferedMessage) {
+	if len(b.queue) >= b.capacity {
+		b.remove(1)
+		b.dropped++
+		b.lc.Warn(fmt.Sprintf("message bus buffer is full (%d), dropping oldest message", b.capacity))
+	}
+	b.nextSeq++
+	m.seq = b.nextSeq
+	b.queue = append(b.queue, m)
+	b.append(logEntry{Message: &m})
+}
+
+// remove removes the count oldest messages from the buffer. The caller must hold the mutex.
+func (b *BufferedClient) remove(count int) {
+	b.queue = b.queue[count:]
+	b.append(logEntry{Removed: count})
+}
+
+// append appends the entry to the persist file, if one is configured. The file is rewritten with the buffered messages
+// only once it holds twice the capacity of the buffer, and truncated once the buffer is empty, so that each change is
+// written in constant time. The caller must hold the mutex.
+func (b *BufferedClient) append(entry logEntry) {
+	if b.log == nil {
+		return
+	}
+
+	if len(b.queue) == 0 {
+		if err := b.log.Truncate(0); err != nil {
+			b.lc.Error(fmt.Sprintf("unable to truncate message bus buffer file %s: %s", b.persistFile, err.Error()))
+		}
+		b.logLength = 0
+		return
+	}
+	if b.logLength >= 2*b.capacity {
+		b.compact()
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		b.lc.Error(fmt.Sprintf("unable to marshal message bus buffer entry: %s", err.Error()))
+		return
+	}
+	if _, err := b.log.Write(append(data, '\n')); err != nil {
+		b.lc.Error(fmt.Sprintf("unable to write message bus buffer to %s: %s", b.persistFile, err.Error()))
+		return
+	}
+	b.logLength++
+}
+
+// compact rewrites the persist file with the buffered messages. The file is written to a temporary file first so a
+// crash mid-write doesn't corrupt the existing buffer file. The caller must hold the mutex.
+func (b *BufferedClient) compact() {
+	tmp := b.persistFile + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		b.lc.Error(fmt.Sprintf("unable to write message bus buffer to %s: %s", tmp, err.Error()))
+		return
+	}
+	w := bufio.NewWriter(file)
+	encoder := json.NewEncoder(w)
+	for i := range b.queue {
+		if err = encoder.Encode(logEntry{Message: &b.queue[i]}); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		_ = file.Close()
+		b.lc.Error(fmt.Sprintf("unable to write message bus buffer to %s: %s", tmp, err.Error()))
+		return
+	}
+	if err = os.Rename(tmp, b.persistFile); err != nil {
+		_ = file.Close()
+		b.lc.Error(fmt.Sprintf("unable to replace message bus buffer file %s: %s", b.persistFile, err.Error()))
+		return
+	}
+	_ = b.log.Close()
+	b.log = file
+	b.logLength = len(b.queue)
+}
+
+// load reads previously persisted messages back into the buffer, and opens the persist file to append the changes. A
+// last line left incomplete by a crash mid-write is ignored.
+func (b *BufferedClient) load() error {
+	if b.persistFile == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(b.persistFile, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("unable to open message bus buffer file %s: %s", b.persistFile, err.Error())
+	}
+	b.log = file
+
+	var queue []bufferedMessage
+	incomplete := false
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			incomplete = len(line) > 0
+			break
+		} else if err != nil {
+			_ = file.Close()
+			return fmt.Errorf("unable to read message bus buffer file %s: %s", b.persistFile, err.Error())
+		}
+
+		var entry logEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			_ = file.Close()
+			return fmt.Errorf("unable to parse message bus buffer file %s: %s", b.persistFile, err.Error())
+		}
+		b.logLength++
+		switch {
+		case entry.Message != nil:
+			queue = append(queue, *entry.Message)
+		case entry.Removed >= len(queue):
+			queue = nil
+		default:
+			queue = queue[entry.Removed:]
+		}
+	}
+
+	if len(queue) > b.capacity {
+		b.dropped += uint64(len(queue) - b.capacity)
+		queue = queue[len(queue)-b.capacity:]
+	}
+	for i := range queue {
+		b.nextSeq++
+		queue[i].seq = b.nextSeq
+	}
+	b.queue = queue
+
+	if incomplete || b.logLength >= 2*b.capacity || (len(b.queue) == 0 && b.logLength > 0) {
+		b.compact()
+	}
+	if len(b.queue) > 0 {
+		b.connected = false
+		b.lc.Info(fmt.Sprintf("loaded %d buffered message(s) from %s", len(b.queue), b.persistFile))
+	}
+
+	return nil
+}
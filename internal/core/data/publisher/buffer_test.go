@@ -0,0 +1,272 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package publisher
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "events"
+
+// fakeMessageClient records published payloads and fails while offline is set.
+type fakeMessageClient struct {
+	offline   bool
+	published []string
+}
+
+func (f *fakeMessageClient) Connect() error { return nil }
+
+func (f *fakeMessageClient) Publish(message types.MessageEnvelope, _ string) error {
+	if f.offline {
+		return errors.New("broker unreachable")
+	}
+	f.published = append(f.published, string(message.Payload))
+	return nil
+}
+
+func (f *fakeMessageClient) Subscribe(_ []types.TopicChannel, _ chan error) error { return nil }
+
+func (f *fakeMessageClient) Disconnect() error { return nil }
+
+// blockingMessageClient records published payloads, the publishes of the blocked payloads waiting for release to be
+// closed
+type blockingMessageClient struct {
+	fakeMessageClient
+	mutex   sync.Mutex
+	blocked map[string]bool
+	started chan string
+	release chan struct{}
+}
+
+func newBlockingMessageClient(blocked ...string) *blockingMessageClient {
+	c := &blockingMessageClient{blocked: make(map[string]bool), started: make(chan string, 10), release: make(chan struct{})}
+	for _, payload := range blocked {
+		c.blocked[payload] = true
+	}
+	return c
+}
+
+func (c *blockingMessageClient) Publish(message types.MessageEnvelope, topic string) error {
+	if c.blocked[string(message.Payload)] {
+		c.started <- string(message.Payload)
+		<-c.release
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.fakeMessageClient.Publish(message, topic)
+}
+
+func (c *blockingMessageClient) setOffline(offline bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.offline = offline
+}
+
+func (c *blockingMessageClient) payloads() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]string(nil), c.published...)
+}
+
+// returnsWithin reports whether fn returns within a second
+func returnsWithin(fn func()) bool {
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+func envelope(payload string) types.MessageEnvelope {
+	return types.MessageEnvelope{Payload: []byte(payload)}
+}
+
+func TestNewBufferedClientInvalidCapacity(t *testing.T) {
+	_, err := NewBufferedClient(&fakeMessageClient{}, 0, "", logger.NewMockClient())
+	require.Error(t, err)
+}
+
+func TestPublishPreservesOrderWhileBuffering(t *testing.T) {
+	client := &fakeMessageClient{}
+	buffer, err := NewBufferedClient(client, 10, "", logger.NewMockClient())
+	require.NoError(t, err)
+
+	require.NoError(t, buffer.Publish(envelope("1"), testTopic))
+
+	client.offline = true
+	require.NoError(t, buffer.Publish(envelope("2"), testTopic))
+	require.NoError(t, buffer.Publish(envelope("3"), testTopic))
+	assert.Equal(t, 0, buffer.Replay())
+
+	metrics := buffer.Metrics()
+	assert.Equal(t, 2, metrics.Depth)
+	assert.False(t, metrics.Connected)
+
+	// The broker is back, but a new message must still queue behind the buffered ones.
+	client.offline = false
+	require.NoError(t, buffer.Publish(envelope("4"), testTopic))
+	assert.Equal(t, []string{"1"}, client.published)
+
+	assert.Equal(t, 3, buffer.Replay())
+	assert.Equal(t, []string{"1", "2", "3", "4"}, client.published)
+
+	metrics = buffer.Metrics()
+	assert.Equal(t, 0, metrics.Depth)
+	assert.Equal(t, uint64(3), metrics.Replayed)
+	assert.True(t, metrics.Connected)
+}
+
+func TestPublishDropsOldestWhenFull(t *testing.T) {
+	client := &fakeMessageClient{offline: true}
+	buffer, err := NewBufferedClient(client, 2, "", logger.NewMockClient())
+	require.NoError(t, err)
+
+	for _, payload := range []string{"1", "2", "3"} {
+		require.NoError(t, buffer.Publish(envelope(payload), testTopic))
+	}
+
+	client.offline = false
+	buffer.Replay()
+	assert.Equal(t, []string{"2", "3"}, client.published)
+	assert.Equal(t, uint64(1), buffer.Metrics().Dropped)
+}
+
+func TestBufferPersistsAcrossRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "publish-buffer")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	persistFile := filepath.Join(dir, "buffer.json")
+
+	client := &fakeMessageClient{offline: true}
+	buffer, err := NewBufferedClient(client, 10, persistFile, logger.NewMockClient())
+	require.NoError(t, err)
+	require.NoError(t, buffer.Publish(envelope("1"), testTopic))
+	require.NoError(t, buffer.Publish(envelope("2"), testTopic))
+
+	client = &fakeMessageClient{}
+	restarted, err := NewBufferedClient(client, 10, persistFile, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, 2, restarted.Metrics().Depth)
+
+	assert.Equal(t, 2, restarted.Replay())
+	assert.Equal(t, []string{"1", "2"}, client.published)
+
+	restarted, err = NewBufferedClient(client, 10, persistFile, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, 0, restarted.Metrics().Depth)
+}
+
+func TestPublishDoesNotBlockOnSlowBroker(t *testing.T) {
+	client := newBlockingMessageClient("slow")
+	buffer, err := NewBufferedClient(client, 10, "", logger.NewMockClient())
+	require.NoError(t, err)
+
+	slowDone := make(chan struct{})
+	go func() {
+		assert.NoError(t, buffer.Publish(envelope("slow"), testTopic))
+		close(slowDone)
+	}()
+	<-client.started
+
+	// The other publishers and the metrics don't wait for the slow publish.
+	assert.True(t, returnsWithin(func() { assert.NoError(t, buffer.Publish(envelope("fast"), testTopic)) }))
+	assert.True(t, returnsWithin(func() { buffer.Metrics() }))
+
+	close(client.release)
+	<-slowDone
+	assert.ElementsMatch(t, []string{"fast", "slow"}, client.payloads())
+}
+
+func TestReplayDoesNotBlockPublish(t *testing.T) {
+	client := newBlockingMessageClient("2")
+	client.setOffline(true)
+	buffer, err := NewBufferedClient(client, 10, "", logger.NewMockClient())
+	require.NoError(t, err)
+	require.NoError(t, buffer.Publish(envelope("1"), testTopic))
+	require.NoError(t, buffer.Publish(envelope("2"), testTopic))
+	client.setOffline(false)
+
+	replayed := make(chan int)
+	go func() { replayed <- buffer.Replay() }()
+	<-client.started
+
+	// A new message queues behind the ones being replayed, and is replayed with them.
+	assert.True(t, returnsWithin(func() { assert.NoError(t, buffer.Publish(envelope("3"), testTopic)) }))
+	assert.Equal(t, 0, buffer.Replay(), "a replay is already in progress")
+
+	close(client.release)
+	assert.Equal(t, 3, <-replayed)
+	assert.Equal(t, []string{"1", "2", "3"}, client.payloads())
+	assert.Equal(t, 0, buffer.Metrics().Depth)
+}
+
+func TestBufferAppendsToPersistFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "publish-buffer")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	persistFile := filepath.Join(dir, "buffer.log")
+	lines := func() int {
+		data, err := ioutil.ReadFile(persistFile)
+		require.NoError(t, err)
+		return bytes.Count(data, []byte("\n"))
+	}
+
+	client := &fakeMessageClient{offline: true}
+	buffer, err := NewBufferedClient(client, 3, persistFile, logger.NewMockClient())
+	require.NoError(t, err)
+
+	// Each message is appended, then the file is compacted once it holds twice the capacity.
+	for i, payload := range []string{"1", "2", "3", "4", "5"} {
+		require.NoError(t, buffer.Publish(envelope(payload), testTopic))
+		assert.Equal(t, []int{1, 2, 3, 5, 3}[i], lines(), "after message %s", payload)
+	}
+
+	restarted, err := NewBufferedClient(&fakeMessageClient{offline: true}, 3, persistFile, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, 3, restarted.Metrics().Depth)
+
+	// A line left incomplete by a crash is ignored.
+	f, err := os.OpenFile(persistFile, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"message":{"Topic":"ev`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	client = &fakeMessageClient{}
+	restarted, err = NewBufferedClient(client, 3, persistFile, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.Equal(t, 3, restarted.Replay())
+	assert.Equal(t, []string{"3", "4", "5"}, client.published)
+	assert.Equal(t, 0, lines())
+}
@@ -0,0 +1,52 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package data
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// streamReadingsByCreationTime writes the readings created between start and end, of device when it isn't empty,
+// to the response without holding more than a batch of them in memory. An error once the first batch is written
+// can't change the status of the response anymore, the response is then cut short instead.
+func streamReadingsByCreationTime(
+	w http.ResponseWriter,
+	r *http.Request,
+	start int64,
+	end int64,
+	device string,
+	dbClient interfaces.DBClient) (started bool, err error) {
+
+	stream := utils.NewStreamWriter(w, utils.AcceptsNDJSON(r))
+	err = dbClient.ScanReadingsByCreationTime(start, end, device, utils.StreamBatchSize, func(readings []contract.Reading) error {
+		started = true
+		for _, reading := range readings {
+			if err := stream.Write(reading); err != nil {
+				return err
+			}
+		}
+		stream.Flush()
+		return nil
+	})
+	if err != nil {
+		return started, err
+	}
+	return true, stream.Close()
+}
@@ -0,0 +1,141 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newReadingStreamMockDB returns a database passing the batches to the scan of the readings of device, then failing
+// with scanErr
+func newReadingStreamMockDB(batches [][]models.Reading, device string, scanErr error) *dbMock.DBClient {
+	dbClient := &dbMock.DBClient{}
+	dbClient.On("ScanReadingsByCreationTime", int64(100), int64(200), device, utils.StreamBatchSize, mock.Anything).
+		Return(func(_ int64, _ int64, _ string, _ int, fn func([]models.Reading) error) error {
+			for _, batch := range batches {
+				if err := fn(batch); err != nil {
+					return err
+				}
+			}
+			return scanErr
+		})
+	return dbClient
+}
+
+// streamedReading decodes the id of a streamed reading without validating the reading
+type streamedReading struct {
+	Id string `json:"id"`
+}
+
+func newReadingStreamRequest(query string, accept string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/reading/stream/100/200"+query, nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	return mux.SetURLVars(req, map[string]string{START: "100", END: "200"})
+}
+
+func TestReadingStreamByCreationTimeHandler(t *testing.T) {
+	batches := [][]models.Reading{
+		{{Id: "1", Device: "dev-a"}, {Id: "2", Device: "dev-b"}},
+		{{Id: "3", Device: "dev-a"}},
+	}
+	deviceBatches := [][]models.Reading{
+		{{Id: "1", Device: "dev-a"}},
+		{{Id: "3", Device: "dev-a"}},
+	}
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		name        string
+		batches     [][]models.Reading
+		query       string
+		device      string
+		expectedIds []string
+	}{
+		{"All readings", batches, "", "", []string{"1", "2", "3"}},
+		{"Readings of device", deviceBatches, "?device=dev-a", "dev-a", []string{"1", "3"}},
+		{"No reading", nil, "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" as JSON", func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			readingStreamByCreationTimeHandler(rr, newReadingStreamRequest(tt.query, ""), lc,
+				newReadingStreamMockDB(tt.batches, tt.device, nil), errorconcept.NewErrorHandler(lc))
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+			var readings []streamedReading
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &readings))
+			var ids []string
+			for _, reading := range readings {
+				ids = append(ids, reading.Id)
+			}
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+		t.Run(tt.name+" as NDJSON", func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			readingStreamByCreationTimeHandler(rr, newReadingStreamRequest(tt.query, "application/x-ndjson"), lc,
+				newReadingStreamMockDB(tt.batches, tt.device, nil), errorconcept.NewErrorHandler(lc))
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, utils.ContentTypeNDJSON, rr.Header().Get("Content-Type"))
+			var ids []string
+			for _, line := range strings.Split(strings.TrimSpace(rr.Body.String()), "\n") {
+				if line == "" {
+					continue
+				}
+				var reading streamedReading
+				require.NoError(t, json.Unmarshal([]byte(line), &reading))
+				ids = append(ids, reading.Id)
+			}
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
+
+func TestReadingStreamByCreationTimeHandlerError(t *testing.T) {
+	lc := logger.NewMockClient()
+	scanErr := errors.New("connection lost")
+
+	rr := httptest.NewRecorder()
+	readingStreamByCreationTimeHandler(rr, newReadingStreamRequest("", ""), lc,
+		newReadingStreamMockDB(nil, "", scanErr), errorconcept.NewErrorHandler(lc))
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+
+	// once readings are written, the status is kept and the response is cut short
+	rr = httptest.NewRecorder()
+	readingStreamByCreationTimeHandler(rr, newReadingStreamRequest("", ""), lc,
+		newReadingStreamMockDB([][]models.Reading{{{Id: "1"}}}, "", scanErr), errorconcept.NewErrorHandler(lc))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.False(t, json.Valid(rr.Body.Bytes()))
+}
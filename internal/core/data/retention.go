@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package data
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/retention"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// retainEvents keeps what the retention configuration retains of the events about to be removed by age, the rollups
+// of the numeric readings of the events not rolled up yet in downsample mode
+func retainEvents(events []contract.Event, info config.RetentionInfo, dbClient interfaces.DBClient) error {
+	interval, downsample, err := retention.Interval(info)
+	if err != nil || !downsample {
+		return err
+	}
+	return retention.Retain(dbClient, retention.EventsV1, interval, func(from int64, rollups *retention.Rollups) (int64, error) {
+		return downsampleReadings(events, from, rollups), nil
+	})
+}
+
+// downsampleReadings adds the readings of the events created after from to the rollups, and returns the creation time
+// of the newest of them, from when there is none
+func downsampleReadings(events []contract.Event, from int64, rollups *retention.Rollups) int64 {
+	to := from
+	for _, e := range events {
+		if e.Created <= from {
+			continue
+		}
+		if e.Created > to {
+			to = e.Created
+		}
+		for _, r := range e.Readings {
+			created := r.Created
+			if created == 0 {
+				created = e.Created
+			}
+			device := r.Device
+			if device == "" {
+				device = e.Device
+			}
+			rollups.Add(device, r.Name, created, r.ValueType, r.Value, r.FloatEncoding)
+		}
+	}
+	return to
+}
@@ -0,0 +1,140 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package retention downsamples the numeric readings of the events removed by age into rollups, for the V1 and V2
+// APIs alike. The events are rolled up in the order they were created: the rollups are stored along with the creation
+// time of the newest event rolled up, and the events created up to it are never rolled up again, even when their
+// deletion failed or another removal by age overlapped.
+package retention
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/export"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+)
+
+// Modes of the removal of the events by age
+const (
+	ModeDelete     = "delete"
+	ModeDownsample = "downsample"
+)
+
+// Events of the APIs, rolled up separately
+const (
+	EventsV1 = "v1"
+	EventsV2 = "v2"
+)
+
+// storeAttempts is how many times the rollups are computed and stored again when the events were rolled up meanwhile
+// by another removal by age
+const storeAttempts = 3
+
+// Store keeps the rollups of the readings, along with the creation time up to which the events of each API were
+// rolled up
+type Store interface {
+	// RolledUpTo returns the creation time of the newest event of the API rolled up, 0 when none was
+	RolledUpTo(events string) (int64, error)
+	// AddReadingRollups merges the rollups of the events of the API created after from up to to into the stored ones,
+	// returning db.ErrRolledUpMeanwhile when the events weren't rolled up to from anymore
+	AddReadingRollups(events string, rollups []models.ReadingRollup, from int64, to int64) error
+}
+
+// Interval returns the interval, in milliseconds, the readings are downsampled by, false when the events are only
+// deleted
+func Interval(retention config.RetentionInfo) (int64, bool, error) {
+	switch retention.Mode {
+	case ModeDelete, "":
+		return 0, false, nil
+	case ModeDownsample:
+	default:
+		return 0, false, fmt.Errorf("invalid Retention Mode '%s', %s or %s expected", retention.Mode, ModeDelete, ModeDownsample)
+	}
+
+	interval, err := time.ParseDuration(retention.RollupInterval)
+	if err != nil || interval < time.Millisecond {
+		return 0, false, fmt.Errorf("invalid Retention RollupInterval '%s'", retention.RollupInterval)
+	}
+	return int64(interval / time.Millisecond), true, nil
+}
+
+type series struct {
+	device string
+	name   string
+	start  int64
+}
+
+// Rollups aggregates the numeric readings of the events by device, reading name and interval. The intervals are
+// aligned on the epoch.
+type Rollups struct {
+	interval int64
+	rollups  map[series]*models.ReadingRollup
+}
+
+// NewRollups returns the rollups of the readings downsampled by the interval, in milliseconds
+func NewRollups(interval int64) *Rollups {
+	return &Rollups{interval: interval, rollups: make(map[series]*models.ReadingRollup)}
+}
+
+// Add aggregates the reading of the device created at the time, in milliseconds, unless its value isn't numeric
+func (r *Rollups) Add(device string, name string, created int64, valueType string, value string, floatEncoding string) {
+	v, ok := export.NumericValue(valueType, value, floatEncoding)
+	if !ok {
+		return
+	}
+	key := series{device: device, name: name, start: created - created%r.interval}
+	rollup, ok := r.rollups[key]
+	if !ok {
+		rollup = &models.ReadingRollup{Device: key.device, Name: key.name, Start: key.start, Interval: r.interval}
+		r.rollups[key] = rollup
+	}
+	rollup.Add(v)
+}
+
+// List returns the rollups ordered by start, device and reading name
+func (r *Rollups) List() []models.ReadingRollup {
+	result := make([]models.ReadingRollup, 0, len(r.rollups))
+	for _, rollup := range r.rollups {
+		result = append(result, *rollup)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Start != result[j].Start {
+			return result[i].Start < result[j].Start
+		}
+		if result[i].Device != result[j].Device {
+			return result[i].Device < result[j].Device
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// Retain stores the rollups of the events of the API which weren't rolled up yet. rollUp adds the readings of the
+// events created after from to the rollups, and returns the creation time of the newest of them, from when there is
+// none. The events are kept when their rollups can't be stored, so that nothing is lost.
+func Retain(store Store, events string, interval int64, rollUp func(from int64, rollups *Rollups) (int64, error)) error {
+	for attempt := 0; attempt < storeAttempts; attempt++ {
+		from, err := store.RolledUpTo(events)
+		if err != nil {
+			return err
+		}
+		rollups := NewRollups(interval)
+		to, err := rollUp(from, rollups)
+		if err != nil {
+			return err
+		}
+		if to <= from {
+			return nil
+		}
+		if err = store.AddReadingRollups(events, rollups.List(), from, to); err != db.ErrRolledUpMeanwhile {
+			return err
+		}
+	}
+	return fmt.Errorf("unable to store the rollups of the %s events, rolled up meanwhile %d times", events, storeAttempts)
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package retention
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStore keeps the rollups like the database, another removal by age rolling up the events before the first of the
+// given number of attempts to store the rollups
+type testStore struct {
+	to          map[string]int64
+	rollups     []models.ReadingRollup
+	overlapping int
+	err         error
+}
+
+func (s *testStore) RolledUpTo(events string) (int64, error) {
+	return s.to[events], nil
+}
+
+func (s *testStore) AddReadingRollups(events string, rollups []models.ReadingRollup, from int64, to int64) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.overlapping > 0 {
+		s.overlapping--
+		s.to[events] = to
+		s.rollups = append(s.rollups, rollups...)
+	}
+	if s.to[events] != from {
+		return db.ErrRolledUpMeanwhile
+	}
+	s.to[events] = to
+	s.rollups = append(s.rollups, rollups...)
+	return nil
+}
+
+func TestInterval(t *testing.T) {
+	tests := []struct {
+		name               string
+		info               config.RetentionInfo
+		expectedInterval   int64
+		expectedDownsample bool
+		expectedErr        bool
+	}{
+		{"Valid - default", config.RetentionInfo{}, 0, false, false},
+		{"Valid - delete", config.RetentionInfo{Mode: ModeDelete, RollupInterval: "1h"}, 0, false, false},
+		{"Valid - downsample", config.RetentionInfo{Mode: ModeDownsample, RollupInterval: "1h"}, 3600000, true, false},
+		{"Invalid - mode", config.RetentionInfo{Mode: "archive", RollupInterval: "1h"}, 0, false, true},
+		{"Invalid - rollup interval", config.RetentionInfo{Mode: ModeDownsample, RollupInterval: "hourly"}, 0, false, true},
+		{"Invalid - rollup interval under a millisecond", config.RetentionInfo{Mode: ModeDownsample, RollupInterval: "10us"}, 0, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			interval, downsample, err := Interval(testCase.info)
+			if testCase.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedInterval, interval)
+			assert.Equal(t, testCase.expectedDownsample, downsample)
+		})
+	}
+}
+
+func TestRollups(t *testing.T) {
+	rollups := NewRollups(1000)
+	rollups.Add("thermostat", "temperature", 1500, contract.ValueTypeInt16, "20", "")
+	rollups.Add("thermostat", "temperature", 1999, contract.ValueTypeFloat32, "2.4e+01", contract.ENotation)
+	rollups.Add("thermostat", "temperature", 500, contract.ValueTypeFloat64, "QDYAAAAAAAA=", contract.Base64Encoding)
+	rollups.Add("thermostat", "mode", 500, contract.ValueTypeString, "heat", "")
+	rollups.Add("thermostat", "enabled", 500, contract.ValueTypeBool, "true", "")
+	rollups.Add("thermostat", "history", 500, contract.ValueTypeInt16Array, "[1,2]", "")
+
+	assert.Equal(t, []models.ReadingRollup{
+		{Device: "thermostat", Name: "temperature", Start: 0, Interval: 1000, Count: 1, Min: 22, Max: 22, Sum: 22, Avg: 22},
+		{Device: "thermostat", Name: "temperature", Start: 1000, Interval: 1000, Count: 2, Min: 20, Max: 24, Sum: 44, Avg: 22},
+	}, rollups.List())
+}
+
+func TestRetain(t *testing.T) {
+	created := []int64{1000, 2000, 3000}
+	rollUp := func(from int64, rollups *Rollups) (int64, error) {
+		to := from
+		for _, c := range created {
+			if c > from {
+				rollups.Add("thermostat", "temperature", c, contract.ValueTypeInt16, "1", "")
+				to = c
+			}
+		}
+		return to, nil
+	}
+	rollup := func(count int64) models.ReadingRollup {
+		return models.ReadingRollup{Device: "thermostat", Name: "temperature", Interval: 3600000, Count: count, Min: 1, Max: 1, Sum: float64(count), Avg: 1}
+	}
+
+	tests := []struct {
+		name            string
+		store           *testStore
+		expectedRollups []models.ReadingRollup
+		expectedTo      int64
+		expectedErr     bool
+	}{
+		{"Valid - rolled up", &testStore{to: map[string]int64{}}, []models.ReadingRollup{rollup(3)}, 3000, false},
+		{"Valid - partly rolled up before", &testStore{to: map[string]int64{EventsV1: 2000}}, []models.ReadingRollup{rollup(1)}, 3000, false},
+		{"Valid - all rolled up before", &testStore{to: map[string]int64{EventsV1: 3000}}, nil, 3000, false},
+		{"Valid - rolled up meanwhile", &testStore{to: map[string]int64{}, overlapping: 1}, []models.ReadingRollup{rollup(3)}, 3000, false},
+		{"Valid - other API rolled up", &testStore{to: map[string]int64{EventsV2: 3000}}, []models.ReadingRollup{rollup(3)}, 3000, false},
+		{"Invalid - store failed", &testStore{to: map[string]int64{}, err: errors.New("some error")}, nil, 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := Retain(testCase.store, EventsV1, 3600000, rollUp)
+			if testCase.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedRollups, testCase.store.rollups)
+			assert.Equal(t, testCase.expectedTo, testCase.store.to[EventsV1])
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package data
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/interfaces/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/retention"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownsampleReadings(t *testing.T) {
+	events := []contract.Event{
+		{
+			Device:  "thermostat",
+			Created: 3600001,
+			Readings: []contract.Reading{
+				{Name: "temperature", Value: "20", ValueType: contract.ValueTypeInt16, Created: 3500000},
+				{Name: "temperature", Value: "2.4e+01", ValueType: contract.ValueTypeFloat32, FloatEncoding: contract.ENotation, Created: 3599999},
+				{Name: "temperature", Value: "QbAAAA==", ValueType: contract.ValueTypeFloat32, FloatEncoding: contract.Base64Encoding, Created: 3600000},
+				{Name: "mode", Value: "heat", ValueType: contract.ValueTypeString, Created: 3500000},
+			},
+		},
+		{
+			Device:  "boiler",
+			Created: 10,
+			Readings: []contract.Reading{
+				{Name: "temperature", Value: "60", ValueType: contract.ValueTypeUint8},
+			},
+		},
+		{
+			Device:  "boiler",
+			Created: 5,
+			Readings: []contract.Reading{
+				{Name: "temperature", Value: "80", ValueType: contract.ValueTypeUint8},
+			},
+		},
+	}
+
+	rollups := retention.NewRollups(3600000)
+	to := downsampleReadings(events, 5, rollups)
+
+	assert.Equal(t, int64(3600001), to)
+	assert.Equal(t, []dataModels.ReadingRollup{
+		{Device: "boiler", Name: "temperature", Start: 0, Interval: 3600000, Count: 1, Min: 60, Max: 60, Sum: 60, Avg: 60},
+		{Device: "thermostat", Name: "temperature", Start: 0, Interval: 3600000, Count: 2, Min: 20, Max: 24, Sum: 44, Avg: 22},
+		{Device: "thermostat", Name: "temperature", Start: 3600000, Interval: 3600000, Count: 1, Min: 22, Max: 22, Sum: 22, Avg: 22},
+	}, rollups.List())
+	assert.Equal(t, int64(3600001), downsampleReadings(events, 3600001, retention.NewRollups(3600000)))
+}
+
+func TestDeleteByAgeDownsample(t *testing.T) {
+	reset()
+	events := []contract.Event{{
+		ID:      "1",
+		Device:  testDeviceName,
+		Created: 2000,
+		Readings: []contract.Reading{
+			{Id: "1", Name: "temperature", Value: "20", ValueType: contract.ValueTypeInt16, Created: 1000},
+			{Id: "2", Name: "temperature", Value: "30", ValueType: contract.ValueTypeInt16, Created: 2000},
+		},
+	}}
+	downsample := &config.ConfigurationStruct{
+		Retention: config.RetentionInfo{Mode: retention.ModeDownsample, RollupInterval: "1h"},
+	}
+	expectedRollups := []dataModels.ReadingRollup{
+		{Device: testDeviceName, Name: "temperature", Start: 0, Interval: 3600000, Count: 2, Min: 20, Max: 30, Sum: 50, Avg: 25},
+	}
+
+	tests := []struct {
+		name           string
+		configuration  *config.ConfigurationStruct
+		rolledUpTo     int64
+		rollupErr      error
+		expectedRollUp bool
+		expectedErr    bool
+	}{
+		{"Valid - downsampled then deleted", downsample, 0, nil, true, false},
+		{"Valid - already rolled up, only deleted", downsample, 2000, nil, false, false},
+		{"Invalid - rollups not stored, nothing deleted", downsample, 0, fmt.Errorf("some error"), true, true},
+		{"Invalid - rolled up meanwhile on each attempt", downsample, 0, db.ErrRolledUpMeanwhile, true, true},
+		{"Invalid - unknown mode", &config.ConfigurationStruct{Retention: config.RetentionInfo{Mode: "archive"}}, 0, nil, false, true},
+		{"Invalid - rollup interval", &config.ConfigurationStruct{Retention: config.RetentionInfo{Mode: retention.ModeDownsample, RollupInterval: "hourly"}}, 0, nil, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("EventsOlderThanAge", int64(-1)).Return(events, nil)
+			dbClientMock.On("RolledUpTo", retention.EventsV1).Return(testCase.rolledUpTo, nil)
+			dbClientMock.On("AddReadingRollups", retention.EventsV1, expectedRollups, int64(0), int64(2000)).Return(testCase.rollupErr)
+			dbClientMock.On("DeleteReadingById", mock.Anything).Return(nil)
+			dbClientMock.On("DeleteEventById", "1").Return(nil)
+
+			count, err := deleteEventsByAge(-1, logger.NewMockClient(), dbClientMock, testCase.configuration)
+
+			if testCase.expectedRollUp {
+				dbClientMock.AssertCalled(t, "AddReadingRollups", retention.EventsV1, expectedRollups, int64(0), int64(2000))
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddReadingRollups", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			if testCase.expectedErr {
+				require.Error(t, err)
+				dbClientMock.AssertNotCalled(t, "DeleteEventById", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, count)
+			dbClientMock.AssertCalled(t, "DeleteEventById", "1")
+		})
+	}
+}
@@ -15,6 +15,7 @@ package data
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -26,13 +27,20 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/interfaces"
 	readingOperator "github.com/edgexfoundry/edgex-go/internal/core/data/operators/reading"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/operators/value_descriptor"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/publisher"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/usage"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -76,6 +84,27 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Usage
+	r.HandleFunc(
+		usage.ApiUsageRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			usage.DefaultRecorder.ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Feature flags
+	r.HandleFunc(
+		featureflag.ApiFeatureFlagsRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.FeatureFlagsFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Configuration drift
+	r.HandleFunc(
+		configdrift.ApiConfigDriftRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.ConfigDriftDetectorFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	// Events
 	r.HandleFunc(
 		clients.ApiEventRoute,
@@ -139,6 +168,17 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	e.HandleFunc(
+		"/"+BUFFER,
+		func(w http.ResponseWriter, r *http.Request) {
+			publishBufferHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				dataContainer.PublishBufferFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	e.HandleFunc(
 		"/"+COUNT+"/{"+DEVICEID_PARAM+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -220,7 +260,8 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get))
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				dataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 
 	e.HandleFunc(
@@ -357,6 +398,28 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				dataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	rd.HandleFunc(
+		"/"+ROLLUP+"/"+NAME+"/{"+NAME+"}/"+DEVICE+"/{"+DEVICE+"}/{"+START+":[0-9]+}/{"+END+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			readingRollupsHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	rd.HandleFunc(
+		"/"+STREAM+"/{"+START+":[0-9]+}/{"+END+":[0-9]+}",
+		func(w http.ResponseWriter, r *http.Request) {
+			readingStreamByCreationTimeHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	rd.HandleFunc(
 		"/"+NAME+"/{"+NAME+"}/"+DEVICE+"/{"+DEVICE+"}/{"+LIMIT+":[0-9]+}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -479,6 +542,12 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(usage.Middleware)
+	r.Use(bodylimit.NewMiddleware(dataContainer.ConfigurationFrom(dic.Get).RequestLimits, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(claims.NewMiddleware(dataContainer.ConfigurationFrom(dic.Get).TrustedHeaders, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(compression.NewMiddleware(func() compression.ResponsesInfo {
+		return dataContainer.ConfigurationFrom(dic.Get).Writable.Compression
+	}))
 }
 
 /*
@@ -545,14 +614,40 @@ func eventCountByDeviceIdHandler(
 	_, _ = w.Write([]byte(strconv.Itoa(count)))
 }
 
-// Remove all the old events and associated readings (by age)
+/*
+Return the state of the message bus publish buffer
+/api/v1/event/buffer
+*/
+func publishBufferHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	buffer *publisher.BufferedClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	if buffer == nil {
+		httpErrorHandler.Handle(
+			w,
+			fmt.Errorf("message bus publish buffering is not enabled"),
+			errorconcept.Default.NotFound)
+		return
+	}
+
+	pkg.Encode(buffer.Metrics(), w, lc)
+}
+
+// Remove all the old events and associated readings (by age), downsampling the readings first in downsample retention
+// mode
 // event/removeold/age/{age}
 func eventByAgeHandler(
 	w http.ResponseWriter,
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	httpErrorHandler errorconcept.ErrorHandler) {
+	httpErrorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
 
 	defer func() { _ = r.Body.Close() }()
 
@@ -566,7 +661,7 @@ func eventByAgeHandler(
 
 	lc.Info("Deleting events by age: " + vars["age"])
 
-	count, err := deleteEventsByAge(age, lc, dbClient)
+	count, err := deleteEventsByAge(age, lc, dbClient, configuration)
 	if err != nil {
 		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
 		return
@@ -1081,7 +1176,7 @@ func readingHandler(
 			}
 		}
 
-		if configuration.Writable.PersistData {
+		if configuration.Writable.PersistData && !configuration.Writable.PublishOnly.Contains(reading.Device, "") {
 			id, err := addReading(reading, lc, dbClient)
 			if err != nil {
 				httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
@@ -1518,6 +1613,83 @@ func readingByCreationTimeHandler(
 	}
 }
 
+// Stream all the readings between the start and end (creation time), optionally of a single device, without limit.
+// The readings are written as a JSON array, or as newline delimited JSON when the client accepts application/x-ndjson.
+// /reading/stream/{start}/{end}?device={device}
+func readingStreamByCreationTimeHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	vars := mux.Vars(r)
+	start, err := strconv.ParseInt(vars["start"], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	end, err := strconv.ParseInt(vars["end"], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	started, err := streamReadingsByCreationTime(w, r, start, end, r.URL.Query().Get(DEVICE), dbClient)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to stream the readings between %d and %d: %s", start, end, err.Error()))
+		if !started {
+			httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		}
+	}
+}
+
+// Return the rollups of the readings with the name of the device removed by age in downsample retention mode, whose
+// interval starts between start and end
+// api/v1/reading/rollup/name/{name}/device/{device}/{start}/{end}
+func readingRollupsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	httpErrorHandler errorconcept.ErrorHandler) {
+
+	defer func() { _ = r.Body.Close() }()
+
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	device, err := url.QueryUnescape(vars["device"])
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	start, err := strconv.ParseInt(vars["start"], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	end, err := strconv.ParseInt(vars["end"], 10, 64)
+	if err != nil {
+		httpErrorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	rollups, err := dbClient.ReadingRollups(device, name, start, end)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to get the reading rollups of %s of device %s: %s", name, device, err.Error()))
+		httpErrorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	pkg.Encode(rollups, w, lc)
+}
+
 // Return a list of redings associated with the device and value descriptor
 // Limit exceeded exception 413 if the limit exceeds the max limit
 // api/v1/readingOperator/name/{name}/device/{device}/{limit}
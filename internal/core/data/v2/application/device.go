@@ -7,12 +7,14 @@ package application
 
 import (
 	"context"
+	"strings"
 
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
 )
 
 // This function will be updated when CheckDevice in v2 core-metadata is available
@@ -28,3 +30,35 @@ func checkDevice(deviceName string, ctx context.Context, dic *di.Container) erro
 	}
 	return nil
 }
+
+// assetIdOfDevice returns the asset id declared by the device's labels. A device is attached to an asset with a label
+// made of the configured AssetLabelPrefix followed by the asset id, i.e. "asset:press-01". An empty string is
+// returned when the device isn't attached to an asset.
+func assetIdOfDevice(deviceName string, ctx context.Context, dic *di.Container) (string, errors.EdgeX) {
+	mdc := v2DataContainer.MetadataDeviceClientFrom(dic.Get)
+	prefix := dataContainer.ConfigurationFrom(dic.Get).Writable.AssetLabelPrefix
+
+	device, err := mdc.DeviceForName(ctx, deviceName)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "querying device failed", err)
+	}
+	for _, label := range device.Labels {
+		if strings.HasPrefix(label, prefix) && len(label) > len(prefix) {
+			return strings.TrimPrefix(label, prefix), nil
+		}
+	}
+	return "", nil
+}
+
+// DeviceState returns the latest reading of each resource of the device, sorted by resource name
+func DeviceState(deviceName string, dic *di.Container) (readings []dtos.BaseReading, err errors.EdgeX) {
+	if deviceName == "" {
+		return readings, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	readingModels, err := dbClient.DeviceState(deviceName)
+	if err != nil {
+		return readings, errors.NewCommonEdgeXWrapper(err)
+	}
+	return convertReadingModelsToDTOs(readingModels)
+}
@@ -11,10 +11,14 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
 	mocksV2 "github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,3 +61,32 @@ func TestCheckDevice(t *testing.T) {
 	// asserts the CheckForDevice method was NOT called when MetaDataCheck Config Writable is false
 	mdc.AssertNotCalled(t, "CheckForDevice", context.Background(), testDeviceName)
 }
+
+func TestAssetIdOfDevice(t *testing.T) {
+	mdc := &mocks.DeviceClient{}
+	mdc.On("DeviceForName", context.Background(), "Press Sensor").Return(contract.Device{Labels: []string{"vibration", "asset:press-01"}}, nil)
+	mdc.On("DeviceForName", context.Background(), "Loose Sensor").Return(contract.Device{Labels: []string{"asset:"}}, nil)
+
+	dic := mocksV2.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		dataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					AssetLabelPrefix: "asset:",
+				},
+			}
+		},
+		v2DataContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return mdc
+		},
+	})
+
+	assetId, err := assetIdOfDevice("Press Sensor", context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, "press-01", assetId)
+
+	// a label made of the prefix only doesn't attach the device to an asset
+	assetId, err = assetIdOfDevice("Loose Sensor", context.Background(), dic)
+	require.NoError(t, err)
+	assert.Empty(t, assetId)
+}
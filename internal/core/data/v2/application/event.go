@@ -12,8 +12,14 @@ import (
 	"strings"
 
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/retention"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/ingestion"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
@@ -38,22 +44,70 @@ func AddEvent(e models.Event, ctx context.Context, dic *di.Container) (id string
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 
+	// Attach the event to the asset of its device unless the device service already did
+	if configuration.Writable.AssetLabelPrefix != "" && e.Tags[pkgModels.AssetIdTag] == "" {
+		assetId, err := assetIdOfDevice(e.DeviceName, ctx, dic)
+		if err != nil {
+			lc.Warn(fmt.Sprintf("unable to resolve the asset of device %s: %s", e.DeviceName, err.Error()))
+		} else if assetId != "" {
+			if e.Tags == nil {
+				e.Tags = make(map[string]string)
+			}
+			e.Tags[pkgModels.AssetIdTag] = assetId
+		}
+	}
+
+	// Flag the readings outside the range of their device resource rather than storing them silently
+	if configuration.Writable.ReadingRange.Enabled {
+		e = flagOutOfRange(e, ctx, dic)
+	}
+
+	// Pass the event through the configured ingestion middleware, after the built-in device check and asset tagging
+	e, err = ingestion.Process(e, ctx, dic)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
 	// Add the event and readings to the database
-	if configuration.Writable.PersistData {
+	if configuration.Writable.PersistData && !configuration.Writable.PublishOnly.Contains(e.DeviceName, e.ProfileName) {
 		correlationId := correlation.FromContext(ctx)
-		addedEvent, err := dbClient.AddEvent(e)
-		if err != nil {
-			return "", errors.NewCommonEdgeXWrapper(err)
+		if writer := v2DataContainer.WriteBehindWriterFrom(dic.Get); writer != nil {
+			// Stored later in a batch, the event is created now
+			if e.Created == 0 {
+				e.Created = common.MakeTimestamp()
+			}
+			if err := writer.Enqueue(e); err != nil {
+				return "", errors.NewCommonEdgeXWrapper(err)
+			}
+
+			lc.Debug(fmt.Sprintf(
+				"Event queued to be stored. Event-id: %s, Correlation-id: %s ",
+				e.Id,
+				correlationId,
+			))
+		} else {
+			addedEvent, err := dbClient.AddEvent(e)
+			if err != nil {
+				return "", errors.NewCommonEdgeXWrapper(err)
+			}
+			e = addedEvent
+
+			lc.Debug(fmt.Sprintf(
+				"Event created on DB successfully. Event-id: %s, Correlation-id: %s ",
+				e.Id,
+				correlationId,
+			))
 		}
-		e = addedEvent
 
-		lc.Debug(fmt.Sprintf(
-			"Event created on DB successfully. Event-id: %s, Correlation-id: %s ",
-			e.Id,
-			correlationId,
-		))
+		// Record the readings as the latest state of the device. A failure doesn't reject the event.
+		if err := dbClient.UpdateDeviceState(e.Readings); err != nil {
+			lc.Error(fmt.Sprintf("unable to update the state of device %s: %s", e.DeviceName, err.Error()),
+				clients.CorrelationHeader, correlationId)
+		}
 	}
 
+	pkgContainer.DeviceMetricsReporterFrom(dic.Get).Count(e.DeviceName, devicemetrics.Counters{EventsReceived: 1, LastEventReceived: common.MakeTimestamp()})
+
 	//convert Event model to Event DTO
 	eventDTO := dtos.FromEventModelToDTO(e)
 	putEventOnQueue(eventDTO, ctx, dic) // Push event DTO to message bus for App Services to consume
@@ -83,16 +137,32 @@ func putEventOnQueue(evt dtos.Event, ctx context.Context, dic *di.Container) {
 		return
 	}
 
+	topic := configuration.MessageQueue.PublishTopic(evt.ProfileName, evt.DeviceName, eventSourceName(evt.Readings))
 	msgEnvelope := msgTypes.NewMessageEnvelope(data, ctx)
-	err = msgClient.Publish(msgEnvelope, configuration.MessageQueue.Topic)
+	err = msgClient.Publish(msgEnvelope, topic)
 	if err != nil {
 		lc.Error(fmt.Sprintf("Unable to send message for V2 API event. Correlation-id: %s, Device Name: %s, Error: %v",
 			correlationId, evt.DeviceName, err))
 	} else {
 		lc.Debug(fmt.Sprintf(
 			"Event Published on message queue. Topic: %s, Correlation-id: %s ",
-			configuration.MessageQueue.Topic, correlationId))
+			topic, correlationId))
+	}
+}
+
+// eventSourceName returns the name of the device resource all the readings come from, empty when they come from
+// several resources
+func eventSourceName(readings []dtos.BaseReading) string {
+	if len(readings) == 0 {
+		return ""
+	}
+	name := readings[0].ResourceName
+	for _, r := range readings[1:] {
+		if r.ResourceName != name {
+			return ""
+		}
 	}
+	return name
 }
 
 func EventById(id string, dic *di.Container) (dtos.Event, errors.EdgeX) {
@@ -209,6 +279,145 @@ func EventsByDeviceName(offset int, limit int, name string, dic *di.Container) (
 	return events, nil
 }
 
+// EventsByAssetId query events attached to the asset by offset, and limit
+func EventsByAssetId(offset int, limit int, assetId string, dic *di.Container) (events []dtos.Event, err errors.EdgeX) {
+	if assetId == "" {
+		return events, errors.NewCommonEdgeX(errors.KindContractInvalid, "asset id is empty", nil)
+	}
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByAssetId(offset, limit, assetId)
+	if err != nil {
+		return events, errors.NewCommonEdgeXWrapper(err)
+	}
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+	}
+	return events, nil
+}
+
+// EventsByIndex query the events holding the value of the named secondary index by offset, and limit
+func EventsByIndex(name string, value string, offset int, limit int, dic *di.Container) (events []dtos.Event, err errors.EdgeX) {
+	if name == "" || value == "" {
+		return events, errors.NewCommonEdgeX(errors.KindContractInvalid, "index name or value is empty", nil)
+	}
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByIndex(name, value, offset, limit)
+	if err != nil {
+		return events, errors.NewCommonEdgeXWrapper(err)
+	}
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+	}
+	return events, nil
+}
+
+// EventsByIds query the events of the ids in one call, up to maxCount distinct ids. The events are returned in the
+// order of the ids along with the ids of the events which don't exist.
+func EventsByIds(ids []string, maxCount int, dic *di.Container) (events []dtos.Event, notFound []string, err errors.EdgeX) {
+	unique, err := uniqueEventIds(ids, maxCount)
+	if err != nil {
+		return events, notFound, err
+	}
+
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	eventModels, err := dbClient.EventsByIds(unique)
+	if err != nil {
+		return events, notFound, errors.NewCommonEdgeXWrapper(err)
+	}
+	found := make(map[string]bool, len(eventModels))
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+		found[e.Id] = true
+	}
+	for _, id := range unique {
+		if !found[id] {
+			notFound = append(notFound, id)
+		}
+	}
+	return events, notFound, nil
+}
+
+// uniqueEventIds returns the distinct ids in their order, an error when an id is empty or there are none or more than
+// maxCount
+func uniqueEventIds(ids []string, maxCount int) ([]string, errors.EdgeX) {
+	var unique []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "event id is empty", nil)
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "no event id is specified", nil)
+	} else if len(unique) > maxCount {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("%d event ids exceed the maximum of %d", len(unique), maxCount), nil)
+	}
+	return unique, nil
+}
+
+// MarkEventsPushed marks the events of the ids, up to maxCount distinct ids, as pushed by the consumer group so that
+// they aren't returned anymore by its UnpushedEvents, and returns the ids of the events which don't exist
+func MarkEventsPushed(consumer string, ids []string, maxCount int, dic *di.Container) (notFound []string, err errors.EdgeX) {
+	if consumer == "" {
+		return notFound, errors.NewCommonEdgeX(errors.KindContractInvalid, "consumer group is empty", nil)
+	}
+	unique, err := uniqueEventIds(ids, maxCount)
+	if err != nil {
+		return notFound, err
+	}
+
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	notFound, err = dbClient.MarkEventsPushed(consumer, unique)
+	if err != nil {
+		return notFound, errors.NewCommonEdgeXWrapper(err)
+	}
+	return notFound, nil
+}
+
+// UnpushedEvents query the events the consumer group didn't mark as pushed with offset and limit, the oldest first.
+// A consumer group marks the events once it processed them so that each event is consumed at least once by every
+// group, or on query when mark is set so that each event is returned once.
+func UnpushedEvents(consumer string, offset int, limit int, mark bool, dic *di.Container) (events []dtos.Event, err errors.EdgeX) {
+	if consumer == "" {
+		return events, errors.NewCommonEdgeX(errors.KindContractInvalid, "consumer group is empty", nil)
+	}
+
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	eventModels, err := dbClient.UnpushedEvents(consumer, offset, limit)
+	if err != nil {
+		return events, errors.NewCommonEdgeXWrapper(err)
+	}
+	events = make([]dtos.Event, len(eventModels))
+	ids := make([]string, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+		ids[i] = e.Id
+	}
+	if mark && len(ids) > 0 {
+		if _, err = dbClient.MarkEventsPushed(consumer, ids); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return events, nil
+}
+
+// DeleteEventsPushed forgets the events the consumer group marked as pushed, so that it consumes all the events again
+func DeleteEventsPushed(consumer string, dic *di.Container) errors.EdgeX {
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	if err := dbClient.DeleteEventsPushed(consumer); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
 // EventsByTimeRange query events with offset, limit and time range
 func EventsByTimeRange(start int, end int, offset int, limit int, dic *di.Container) (events []dtos.Event, err errors.EdgeX) {
 	dbClient := v2DataContainer.DBClientFrom(dic.Get)
@@ -226,9 +435,23 @@ func EventsByTimeRange(start int, end int, offset int, limit int, dic *di.Contai
 // The DeleteEventsByAge function will be invoked by controller functions
 // and then invokes DeleteEventsByAge function in the infrastructure layer to remove
 // events that are older than age.  Age is supposed in milliseconds since created timestamp.
+// In downsample retention mode the readings are rolled up first, the events being kept when the rollups can't be stored.
 func DeleteEventsByAge(age int64, dic *di.Container) errors.EdgeX {
 	dbClient := v2DataContainer.DBClientFrom(dic.Get)
 
+	interval, downsample, retentionErr := retention.Interval(dataContainer.ConfigurationFrom(dic.Get).Retention)
+	if retentionErr != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "invalid retention configuration", retentionErr)
+	}
+	if downsample {
+		cutoff := common.MakeTimestamp() - age
+		if edgeXerr := downsampleEvents(cutoff, interval, dic); edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		// the events created after the cutoff while the readings were rolled up are left for the next removal
+		age = common.MakeTimestamp() - cutoff
+	}
+
 	err := dbClient.DeleteEventsByAge(age)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
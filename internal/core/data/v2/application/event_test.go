@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,7 @@ import (
 const (
 	testDeviceResourceName = "TestDeviceResource"
 	testDeviceName         = "TestDevice"
+	testProfileName        = "TestProfile"
 	testUUIDString         = "ca93c8fa-9919-4ec5-85d3-f81b2b6a7bc1"
 	testCreatedTime        = 1600666214495
 	testOriginTime         = 1600666185705354000
@@ -117,6 +119,7 @@ func newMockDB(persist bool) *dbMock.DBClient {
 
 	if persist {
 		myMock.On("AddEvent", mock.Anything).Return(persistedEvent, nil)
+		myMock.On("UpdateDeviceState", mock.Anything).Return(nil)
 		myMock.On("EventById", nonexistentEventID).Return(models.Event{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "event doesn't exist in the database", nil))
 		myMock.On("EventById", testUUIDString).Return(persistedEvent, nil)
 		myMock.On("DeleteEventById", nonexistentEventID).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "event doesn't exist in the database", nil))
@@ -132,18 +135,22 @@ func newMockDB(persist bool) *dbMock.DBClient {
 
 func TestAddEvent(t *testing.T) {
 	evt := models.Event{
-		Id:         testUUIDString,
-		DeviceName: testDeviceName,
-		Origin:     testOriginTime,
-		Readings:   buildReadings(),
+		Id:          testUUIDString,
+		DeviceName:  testDeviceName,
+		ProfileName: testProfileName,
+		Origin:      testOriginTime,
+		Readings:    buildReadings(),
 	}
 
 	tests := []struct {
 		Name        string
 		Persistence bool
+		PublishOnly config.PublishOnlyInfo
 	}{
 		{Name: "Add Event with persistence", Persistence: true},
 		{Name: "Add Event without persistence", Persistence: false},
+		{Name: "Add Event of publish only device", Persistence: true, PublishOnly: config.PublishOnlyInfo{Devices: []string{testDeviceName}}},
+		{Name: "Add Event of publish only profile", Persistence: true, PublishOnly: config.PublishOnlyInfo{Profiles: []string{testProfileName}}},
 	}
 
 	for _, testCase := range tests {
@@ -156,6 +163,7 @@ func TestAddEvent(t *testing.T) {
 					return &config.ConfigurationStruct{
 						Writable: config.WritableInfo{
 							PersistData: testCase.Persistence,
+							PublishOnly: testCase.PublishOnly,
 						},
 					}
 				},
@@ -171,6 +179,10 @@ func TestAddEvent(t *testing.T) {
 				// assert there is no db client function called
 				dbClientMock.AssertExpectations(t)
 			}
+			if len(testCase.PublishOnly.Devices) > 0 || len(testCase.PublishOnly.Profiles) > 0 {
+				// neither the event nor the device state is written
+				assert.Empty(t, dbClientMock.Calls)
+			}
 		})
 	}
 }
@@ -400,3 +412,34 @@ func TestDeleteEventsByAge(t *testing.T) {
 	err := DeleteEventsByAge(0, dic)
 	require.NoError(t, err)
 }
+
+func TestPublishTopic(t *testing.T) {
+	messageQueue := config.MessageQueueInfo{
+		Topic:         "events/{profileName}/{deviceName}/{sourceName}",
+		ProfileTopics: map[string]string{"camera": "images/{deviceName}"},
+	}
+	single := []dtos.BaseReading{{ResourceName: "temperature"}, {ResourceName: "temperature"}}
+	several := []dtos.BaseReading{{ResourceName: "temperature"}, {ResourceName: "humidity"}}
+
+	tests := []struct {
+		name          string
+		profileName   string
+		readings      []dtos.BaseReading
+		expectedTopic string
+	}{
+		{"Global template", "thermostat", single, "events/thermostat/device1/temperature"},
+		{"Several sources", "thermostat", several, "events/thermostat/device1/"},
+		{"Profile template", "camera", single, "images/device1"},
+		{"Unknown profile", "", single, "events//device1/temperature"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topic := messageQueue.PublishTopic(tt.profileName, "device1", eventSourceName(tt.readings))
+			assert.Equal(t, tt.expectedTopic, topic)
+		})
+	}
+
+	assert.Equal(t, "events", config.MessageQueueInfo{Topic: "events"}.PublishTopic("camera", "device1", "temperature"))
+	assert.True(t, messageQueue.UsesProfileName())
+	assert.False(t, config.MessageQueueInfo{Topic: "events/{deviceName}"}.UsesProfileName())
+}
@@ -0,0 +1,27 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// MemoryUsage returns the database memory and the approximate memory used by each configured collection, measured on
+// samples entries per collection
+func MemoryUsage(samples int, dic *di.Container) (pkgModels.MemoryUsage, errors.EdgeX) {
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	config := dataContainer.ConfigurationFrom(dic.Get)
+
+	usage, err := dbClient.MemoryUsage(config.MemoryUsage.Collections, samples)
+	if err != nil {
+		return usage, errors.NewCommonEdgeXWrapper(err)
+	}
+	return usage, nil
+}
@@ -1,7 +1,11 @@
 package application
 
 import (
+	"fmt"
+	"strings"
+
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
@@ -91,3 +95,41 @@ func ReadingCountByDeviceName(deviceName string, dic *di.Container) (uint32, err
 
 	return count, nil
 }
+
+// ReadingStats returns the reading statistics maintained per device and per resource and error if any
+func ReadingStats(dic *di.Container) (devices []dataDTOs.ReadingStats, resources []dataDTOs.ReadingStats, err errors.EdgeX) {
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+
+	deviceStats, err := dbClient.ReadingStatsByDeviceName()
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	resourceStats, err := dbClient.ReadingStatsByResourceName()
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return dataDTOs.FromReadingStatsModelsToDTOs(deviceStats), dataDTOs.FromReadingStatsModelsToDTOs(resourceStats), nil
+}
+
+// DeleteReadingsByDeviceName starts a job deleting the events and readings of the device in the background, and
+// returns it. The job of the device already pending or running is returned instead of starting another one.
+func DeleteReadingsByDeviceName(deviceName string, dic *di.Container) (dataDTOs.ReadingDeletionJob, errors.EdgeX) {
+	if len(strings.TrimSpace(deviceName)) <= 0 {
+		return dataDTOs.ReadingDeletionJob{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "blank device name is not allowed", nil)
+	}
+	job, err := v2DataContainer.ReadingDeleterFrom(dic.Get).Start(deviceName)
+	if err != nil {
+		return dataDTOs.ReadingDeletionJob{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dataDTOs.FromReadingDeletionJobModelToDTO(job), nil
+}
+
+// ReadingDeletionJob returns the progress of the reading deletion job of the id
+func ReadingDeletionJob(id string, dic *di.Container) (dataDTOs.ReadingDeletionJob, errors.EdgeX) {
+	job, ok := v2DataContainer.ReadingDeleterFrom(dic.Get).Job(id)
+	if !ok {
+		return dataDTOs.ReadingDeletionJob{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("reading deletion job %s doesn't exist", id), nil)
+	}
+	return dataDTOs.FromReadingDeletionJobModelToDTO(job), nil
+}
@@ -0,0 +1,196 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// Interpolation modes of the values missing within reading gaps
+const (
+	// InterpolationNone reports the gaps without values
+	InterpolationNone = ""
+	// InterpolationPrevious repeats the value of the reading preceding the gap
+	InterpolationPrevious = "previous"
+	// InterpolationLinear interpolates numeric values linearly between the readings around the gap
+	InterpolationLinear = "linear"
+)
+
+// ReadingGaps detects the gaps in the readings of the device resource created within the time range, against the
+// cadence of the resource's autoEvent unless interval overrides it. A gap is reported between two consecutive readings,
+// the bounds of the time range counting as readings, when they are more than one and a half intervals apart. The
+// expected interval in milliseconds is returned along with the gaps.
+func ReadingGaps(
+	deviceName string,
+	resourceName string,
+	start int,
+	end int,
+	interval string,
+	interpolation string,
+	ctx context.Context,
+	dic *di.Container) (expected int64, gaps []dataModels.ReadingGap, edgeXerr errors.EdgeX) {
+
+	if deviceName == "" || resourceName == "" {
+		return expected, gaps, errors.NewCommonEdgeX(errors.KindContractInvalid, "device and resource names are required", nil)
+	}
+	switch interpolation {
+	case InterpolationNone, InterpolationPrevious, InterpolationLinear:
+	default:
+		return expected, gaps, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown interpolation '%s', expecting %s or %s", interpolation, InterpolationPrevious, InterpolationLinear), nil)
+	}
+
+	if interval == "" {
+		interval, edgeXerr = autoEventFrequency(deviceName, resourceName, ctx, dic)
+		if edgeXerr != nil {
+			return expected, gaps, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	duration, err := time.ParseDuration(interval)
+	if err != nil || duration.Milliseconds() <= 0 {
+		return expected, gaps, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid interval '%s', expecting a duration of at least 1ms", interval), err)
+	}
+	expected = duration.Milliseconds()
+
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	readings, edgeXerr := dbClient.ReadingsByDeviceResourceAndTimeRange(deviceName, resourceName, start, end)
+	if edgeXerr != nil {
+		return expected, gaps, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	maxValues := dataContainer.ConfigurationFrom(dic.Get).Service.MaxResultCount
+	gaps, edgeXerr = findReadingGaps(readings, int64(start), int64(end), expected, interpolation, maxValues)
+	if edgeXerr != nil {
+		return expected, gaps, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return expected, gaps, nil
+}
+
+// autoEventFrequency returns the frequency of the autoEvent of the device resource, which must not be on change only
+func autoEventFrequency(deviceName string, resourceName string, ctx context.Context, dic *di.Container) (string, errors.EdgeX) {
+	mdc := v2DataContainer.MetadataDeviceClientFrom(dic.Get)
+
+	device, err := mdc.DeviceForName(ctx, deviceName)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "querying device failed", err)
+	}
+	for _, autoEvent := range device.AutoEvents {
+		if autoEvent.Resource == resourceName && !autoEvent.OnChange {
+			return autoEvent.Frequency, nil
+		}
+	}
+	return "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s has no periodic autoEvent of resource %s, the interval must be given", deviceName, resourceName), nil)
+}
+
+// gapBound is a reading, or a bound of the queried time range when reading is nil
+type gapBound struct {
+	timestamp int64
+	reading   models.Reading
+}
+
+// findReadingGaps detects the gaps in the readings against the expected interval, and interpolates the missing values
+// as requested. An error is returned when more than maxValues values would be interpolated.
+func findReadingGaps(
+	readings []models.Reading,
+	start int64,
+	end int64,
+	interval int64,
+	interpolation string,
+	maxValues int) (gaps []dataModels.ReadingGap, edgeXerr errors.EdgeX) {
+
+	bounds := make([]gapBound, 0, len(readings)+2)
+	bounds = append(bounds, gapBound{timestamp: start})
+	for _, r := range readings {
+		bounds = append(bounds, gapBound{timestamp: r.GetBaseReading().Created, reading: r})
+	}
+	bounds = append(bounds, gapBound{timestamp: end})
+	sort.SliceStable(bounds[1:len(bounds)-1], func(i, j int) bool {
+		return bounds[i+1].timestamp < bounds[j+1].timestamp
+	})
+
+	gaps = []dataModels.ReadingGap{}
+	values := 0
+	for i := 1; i < len(bounds); i++ {
+		before, after := bounds[i-1], bounds[i]
+		elapsed := after.timestamp - before.timestamp
+		if elapsed*2 <= interval*3 {
+			continue
+		}
+
+		gap := dataModels.ReadingGap{
+			Start:   before.timestamp,
+			End:     after.timestamp,
+			Missing: (elapsed+interval/2)/interval - 1,
+		}
+		if interpolation != InterpolationNone {
+			values += int(gap.Missing)
+			if values > maxValues {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("more than %d values to interpolate, narrow the time range", maxValues), nil)
+			}
+			gap.Interpolated = interpolate(before, after, gap.Missing, interval, interpolation)
+		}
+		gaps = append(gaps, gap)
+	}
+	return gaps, nil
+}
+
+// interpolate returns the values at the timestamps the missing readings between before and after were expected at.
+// No value is returned when they can't be interpolated, e.g. when a bound of the time range is involved or the values
+// aren't numeric.
+func interpolate(before gapBound, after gapBound, missing int64, interval int64, interpolation string) []dataModels.InterpolatedValue {
+	previous, ok := before.reading.(models.SimpleReading)
+	if !ok {
+		return nil
+	}
+
+	var values []dataModels.InterpolatedValue
+	switch interpolation {
+	case InterpolationPrevious:
+		for k := int64(1); k <= missing; k++ {
+			values = append(values, dataModels.InterpolatedValue{Timestamp: before.timestamp + k*interval, Value: previous.Value})
+		}
+	case InterpolationLinear:
+		next, ok := after.reading.(models.SimpleReading)
+		if !ok {
+			return nil
+		}
+		from, err := strconv.ParseFloat(previous.Value, 64)
+		if err != nil {
+			return nil
+		}
+		to, err := strconv.ParseFloat(next.Value, 64)
+		if err != nil {
+			return nil
+		}
+		elapsed := float64(after.timestamp - before.timestamp)
+		for k := int64(1); k <= missing; k++ {
+			v := from + (to-from)*float64(k*interval)/elapsed
+			values = append(values, dataModels.InterpolatedValue{Timestamp: before.timestamp + k*interval, Value: formatInterpolated(v, previous.ValueType)})
+		}
+	}
+	return values
+}
+
+func formatInterpolated(v float64, valueType string) string {
+	t := strings.ToLower(valueType)
+	if strings.HasPrefix(t, "int") || strings.HasPrefix(t, "uint") {
+		return strconv.FormatInt(int64(math.Round(v)), 10)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
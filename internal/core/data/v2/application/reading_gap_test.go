@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+	internalMocks "github.com/edgexfoundry/edgex-go/internal/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gapReading(created int64, value string) models.Reading {
+	return models.SimpleReading{
+		BaseReading: models.BaseReading{Created: created, ResourceName: "Temperature", ValueType: "Int64"},
+		Value:       value,
+	}
+}
+
+func TestFindReadingGaps(t *testing.T) {
+	// a reading every second but at 3s and 4s
+	readings := []models.Reading{
+		gapReading(2000, "20"),
+		gapReading(1000, "10"),
+		gapReading(5000, "50"),
+		gapReading(6000, "70"),
+	}
+
+	tests := []struct {
+		name          string
+		interpolation string
+		expected      []dataModels.ReadingGap
+	}{
+		{"No interpolation", InterpolationNone, []dataModels.ReadingGap{{Start: 2000, End: 5000, Missing: 2}}},
+		{"Previous", InterpolationPrevious, []dataModels.ReadingGap{{Start: 2000, End: 5000, Missing: 2, Interpolated: []dataModels.InterpolatedValue{{Timestamp: 3000, Value: "20"}, {Timestamp: 4000, Value: "20"}}}}},
+		{"Linear", InterpolationLinear, []dataModels.ReadingGap{{Start: 2000, End: 5000, Missing: 2, Interpolated: []dataModels.InterpolatedValue{{Timestamp: 3000, Value: "30"}, {Timestamp: 4000, Value: "40"}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gaps, err := findReadingGaps(readings, 500, 6500, 1000, tt.interpolation, 20)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, gaps)
+		})
+	}
+
+	// the bounds of the time range count as readings, without value to interpolate from
+	gaps, err := findReadingGaps(readings, 0, 9000, 1000, InterpolationPrevious, 20)
+	require.NoError(t, err)
+	require.Len(t, gaps, 2)
+	assert.Equal(t, dataModels.ReadingGap{Start: 2000, End: 5000, Missing: 2, Interpolated: []dataModels.InterpolatedValue{{Timestamp: 3000, Value: "20"}, {Timestamp: 4000, Value: "20"}}}, gaps[0])
+	assert.Equal(t, dataModels.ReadingGap{Start: 6000, End: 9000, Missing: 2, Interpolated: []dataModels.InterpolatedValue{{Timestamp: 7000, Value: "70"}, {Timestamp: 8000, Value: "70"}}}, gaps[1])
+
+	// no reading at all
+	gaps, err = findReadingGaps(nil, 0, 4000, 1000, InterpolationLinear, 20)
+	require.NoError(t, err)
+	assert.Equal(t, []dataModels.ReadingGap{{Start: 0, End: 4000, Missing: 3}}, gaps)
+
+	_, err = findReadingGaps(readings, 0, 9000, 1000, InterpolationLinear, 3)
+	require.Error(t, err)
+}
+
+func TestReadingGaps(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByDeviceResourceAndTimeRange", "Thermostat", "Temperature", 0, 3000).Return([]models.Reading{gapReading(1000, "10")}, nil)
+	mdc := &internalMocks.DeviceClient{}
+	mdc.On("DeviceForName", context.Background(), "Thermostat").Return(contract.Device{
+		Name: "Thermostat",
+		AutoEvents: []contract.AutoEvent{
+			{Resource: "Temperature", Frequency: "1s", OnChange: true},
+			{Resource: "Temperature", Frequency: "500ms"},
+		},
+	}, nil)
+
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		v2DataContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return mdc
+		},
+	})
+
+	// the cadence of the periodic autoEvent of the resource
+	expected, gaps, err := ReadingGaps("Thermostat", "Temperature", 0, 3000, "", InterpolationNone, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), expected)
+	assert.Equal(t, []dataModels.ReadingGap{{Start: 0, End: 1000, Missing: 1}, {Start: 1000, End: 3000, Missing: 3}}, gaps)
+
+	// an interval overriding the cadence
+	expected, gaps, err = ReadingGaps("Thermostat", "Temperature", 0, 3000, "2s", InterpolationNone, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), expected)
+	assert.Empty(t, gaps)
+
+	_, _, err = ReadingGaps("Thermostat", "Humidity", 0, 3000, "", InterpolationNone, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, _, err = ReadingGaps("Thermostat", "Temperature", 0, 3000, "soon", InterpolationNone, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, _, err = ReadingGaps("Thermostat", "Temperature", 0, 3000, "1s", "cubic", context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// numericValueTypes are the value types of the readings checked against the range of their device resource
+var numericValueTypes = map[string]bool{
+	v2.ValueTypeUint8: true, v2.ValueTypeUint16: true, v2.ValueTypeUint32: true, v2.ValueTypeUint64: true,
+	v2.ValueTypeInt8: true, v2.ValueTypeInt16: true, v2.ValueTypeInt32: true, v2.ValueTypeInt64: true,
+	v2.ValueTypeFloat32: true, v2.ValueTypeFloat64: true,
+}
+
+// outOfRangeResources returns the resources of the event whose reading is outside the minimum and maximum of the
+// device resource, in the profile of the event's device. A bound which is empty or not a number isn't checked.
+func outOfRangeResources(e models.Event, ctx context.Context, dic *di.Container) ([]string, errors.EdgeX) {
+	mdc := v2DataContainer.MetadataDeviceClientFrom(dic.Get)
+
+	device, err := mdc.DeviceForName(ctx, e.DeviceName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "querying device failed", err)
+	}
+	resources := make(map[string]contract.PropertyValue, len(device.Profile.DeviceResources))
+	for _, resource := range device.Profile.DeviceResources {
+		resources[resource.Name] = resource.Properties.Value
+	}
+
+	var outOfRange []string
+	for _, r := range e.Readings {
+		reading, ok := r.(models.SimpleReading)
+		if !ok || !numericValueTypes[reading.ValueType] {
+			continue
+		}
+		property, ok := resources[reading.ResourceName]
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(reading.Value, 64)
+		if err != nil {
+			continue
+		}
+		if min, err := strconv.ParseFloat(property.Minimum, 64); err == nil && value < min {
+			outOfRange = appendResource(outOfRange, reading.ResourceName)
+		} else if max, err := strconv.ParseFloat(property.Maximum, 64); err == nil && value > max {
+			outOfRange = appendResource(outOfRange, reading.ResourceName)
+		}
+	}
+	return outOfRange, nil
+}
+
+// appendResource appends the resource name unless it is listed already
+func appendResource(names []string, name string) []string {
+	for _, n := range names {
+		if n == name {
+			return names
+		}
+	}
+	return append(names, name)
+}
+
+// flagOutOfRange tags the event with the resources whose reading is out of range, and notifies them when configured.
+// The event is returned unchanged when the range can't be checked, the readings being accepted still.
+func flagOutOfRange(e models.Event, ctx context.Context, dic *di.Container) models.Event {
+	lc := container.LoggingClientFrom(dic.Get)
+	configuration := dataContainer.ConfigurationFrom(dic.Get)
+
+	outOfRange, err := outOfRangeResources(e, ctx, dic)
+	if err != nil {
+		lc.Warn(fmt.Sprintf("unable to check the range of the readings of device %s: %s", e.DeviceName, err.Error()))
+		return e
+	}
+	if len(outOfRange) == 0 {
+		return e
+	}
+
+	if e.Tags == nil {
+		e.Tags = make(map[string]string)
+	}
+	e.Tags[dataModels.OutOfRangeTag] = strings.Join(outOfRange, ",")
+	lc.Debug(fmt.Sprintf("readings of device %s out of range: %s", e.DeviceName, e.Tags[dataModels.OutOfRangeTag]))
+
+	notificationsClient := v2DataContainer.NotificationsClientFrom(dic.Get)
+	if !configuration.Writable.ReadingRange.Notify || notificationsClient == nil {
+		return e
+	}
+	n := notifications.Notification{
+		Slug:     fmt.Sprintf("out-of-range-%s-%d", e.DeviceName, time.Now().UnixNano()),
+		Sender:   clients.CoreDataServiceKey,
+		Category: notifications.HW_HEALTH,
+		Severity: notifications.NORMAL,
+		Content: fmt.Sprintf("Readings of device %s out of the range of their device resource: %s",
+			e.DeviceName, e.Tags[dataModels.OutOfRangeTag]),
+		Labels: []string{dataModels.OutOfRangeTag, e.DeviceName},
+	}
+	// The event isn't held up by the notification
+	go func() {
+		notifyCtx, cancel := context.WithTimeout(context.Background(), time.Duration(configuration.Service.Timeout)*time.Millisecond)
+		defer cancel()
+		if err := notificationsClient.SendNotification(notifyCtx, n); err != nil {
+			lc.Error(fmt.Sprintf("unable to send the notification about the readings of device %s: %s", e.DeviceName, err.Error()))
+		}
+	}()
+	return e
+}
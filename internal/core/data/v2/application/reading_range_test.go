@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	mocksV2 "github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotificationsClient struct {
+	sent chan notifications.Notification
+}
+
+func (c *recordingNotificationsClient) SendNotification(_ context.Context, n notifications.Notification) error {
+	c.sent <- n
+	return nil
+}
+
+func rangeReading(resourceName string, valueType string, value string) models.SimpleReading {
+	return models.SimpleReading{
+		BaseReading: models.BaseReading{DeviceName: "Thermometer", ResourceName: resourceName, ValueType: valueType},
+		Value:       value,
+	}
+}
+
+func newRangeDic(notify bool, notificationsClient notifications.NotificationsClient) *di.Container {
+	resource := func(name string, min string, max string) contract.DeviceResource {
+		return contract.DeviceResource{Name: name, Properties: contract.ProfileProperty{Value: contract.PropertyValue{Minimum: min, Maximum: max}}}
+	}
+	mdc := &mocks.DeviceClient{}
+	mdc.On("DeviceForName", context.Background(), "Thermometer").Return(contract.Device{Profile: contract.DeviceProfile{
+		DeviceResources: []contract.DeviceResource{
+			resource("Temperature", "-40", "85"),
+			resource("Humidity", "0", "100"),
+			resource("Status", "", ""),
+		},
+	}}, nil)
+
+	dic := mocksV2.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		dataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{
+					ReadingRange: config.ReadingRangeInfo{Enabled: true, Notify: notify},
+				},
+			}
+		},
+		v2DataContainer.MetadataDeviceClientName: func(get di.Get) interface{} {
+			return mdc
+		},
+		v2DataContainer.NotificationsClientName: func(get di.Get) interface{} {
+			return notificationsClient
+		},
+	})
+	return dic
+}
+
+func TestOutOfRangeResources(t *testing.T) {
+	dic := newRangeDic(false, nil)
+
+	tests := []struct {
+		name     string
+		readings []models.Reading
+		expected []string
+	}{
+		{"in range", []models.Reading{rangeReading("Temperature", v2.ValueTypeFloat64, "2.150000e+01"), rangeReading("Humidity", v2.ValueTypeUint8, "100")}, nil},
+		{"under the minimum", []models.Reading{rangeReading("Temperature", v2.ValueTypeFloat64, "-999")}, []string{"Temperature"}},
+		{"over the maximum", []models.Reading{rangeReading("Humidity", v2.ValueTypeUint8, "101"), rangeReading("Temperature", v2.ValueTypeInt32, "20")}, []string{"Humidity"}},
+		{"listed once", []models.Reading{rangeReading("Humidity", v2.ValueTypeUint8, "101"), rangeReading("Humidity", v2.ValueTypeUint8, "120")}, []string{"Humidity"}},
+		{"no bounds", []models.Reading{rangeReading("Status", v2.ValueTypeInt32, "-999")}, nil},
+		{"not numeric", []models.Reading{rangeReading("Temperature", v2.ValueTypeString, "-999")}, nil},
+		{"unknown resource", []models.Reading{rangeReading("Pressure", v2.ValueTypeInt32, "-999")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outOfRange, err := outOfRangeResources(models.Event{DeviceName: "Thermometer", Readings: tt.readings}, context.Background(), dic)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, outOfRange)
+		})
+	}
+}
+
+func TestFlagOutOfRange(t *testing.T) {
+	notificationsClient := &recordingNotificationsClient{sent: make(chan notifications.Notification, 1)}
+	dic := newRangeDic(true, notificationsClient)
+
+	e := flagOutOfRange(models.Event{
+		DeviceName: "Thermometer",
+		Readings: []models.Reading{
+			rangeReading("Temperature", v2.ValueTypeFloat64, "-999"),
+			rangeReading("Humidity", v2.ValueTypeUint8, "250"),
+		},
+	}, context.Background(), dic)
+	assert.Equal(t, "Temperature,Humidity", e.Tags[dataModels.OutOfRangeTag])
+	n := <-notificationsClient.sent
+	assert.Equal(t, notifications.HW_HEALTH, n.Category)
+	assert.Contains(t, n.Content, "Temperature,Humidity")
+
+	e = flagOutOfRange(models.Event{
+		DeviceName: "Thermometer",
+		Readings:   []models.Reading{rangeReading("Temperature", v2.ValueTypeFloat64, "20")},
+	}, context.Background(), dic)
+	assert.NotContains(t, e.Tags, dataModels.OutOfRangeTag)
+	assert.Empty(t, notificationsClient.sent)
+
+	// the readings are accepted untagged when the device can't be queried
+	e = flagOutOfRange(models.Event{
+		DeviceName: "Unknown",
+		Readings:   []models.Reading{rangeReading("Temperature", v2.ValueTypeFloat64, "-999")},
+	}, context.Background(), mocksV2.NewMockDIC())
+	assert.NotContains(t, e.Tags, dataModels.OutOfRangeTag)
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// StreamReadingsByTimeRange writes the readings created within the time range, of the device when its name isn't
+// empty, to the stream without holding more than a batch of them in memory. started tells whether the first batch
+// was written, after which an error can't change the status of the response anymore.
+func StreamReadingsByTimeRange(start int, end int, deviceName string, stream *utils.StreamWriter, dic *di.Container) (started bool, err errors.EdgeX) {
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	err = dbClient.ScanReadingsByTimeRange(start, end, deviceName, utils.StreamBatchSize, func(readings []models.Reading) errors.EdgeX {
+		started = true
+		for _, r := range readings {
+			if err := stream.Write(dtos.FromReadingModelToDTO(r)); err != nil {
+				return errors.NewCommonEdgeX(errors.KindIOError, "failed to write the reading to the stream", err)
+			}
+		}
+		stream.Flush()
+		return nil
+	})
+	if err != nil {
+		return started, errors.NewCommonEdgeXWrapper(err)
+	}
+	if closeErr := stream.Close(); closeErr != nil {
+		return true, errors.NewCommonEdgeX(errors.KindIOError, "failed to end the stream of readings", closeErr)
+	}
+	return true, nil
+}
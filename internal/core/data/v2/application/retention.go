@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/retention"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// downsampleEvents stores the rollups of the numeric readings of the events created up to the cutoff, in milliseconds,
+// which weren't rolled up yet. The rollups are kept with those of the V1 events, returned by the V1 API.
+func downsampleEvents(cutoff int64, interval int64, dic *di.Container) errors.EdgeX {
+	dbClient := v2DataContainer.DBClientFrom(dic.Get)
+	limit := dataContainer.ConfigurationFrom(dic.Get).Service.MaxResultCount
+
+	err := retention.Retain(pkgContainer.DBClientFrom(dic.Get), retention.EventsV2, interval, func(from int64, rollups *retention.Rollups) (int64, error) {
+		to := from
+		for offset := 0; ; offset += limit {
+			events, edgeXerr := dbClient.EventsByTimeRange(int(from+1), int(cutoff), offset, limit)
+			if errors.Kind(edgeXerr) == errors.KindRangeNotSatisfiable {
+				break
+			} else if edgeXerr != nil {
+				return from, edgeXerr
+			}
+			for _, e := range events {
+				if e.Created > to {
+					to = e.Created
+				}
+				for _, r := range e.Readings {
+					if simple, ok := r.(models.SimpleReading); ok {
+						rollups.Add(simple.DeviceName, simple.ResourceName, simple.Created, simple.ValueType, simple.Value, "")
+					}
+				}
+			}
+			if len(events) < limit {
+				break
+			}
+		}
+		return to, nil
+	})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to store the rollups of the events removed by age", err)
+	}
+	return nil
+}
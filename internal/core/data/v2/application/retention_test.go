@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/retention"
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// rollupDBClient keeps the rollups of the V1 database client, whose other functions aren't used
+type rollupDBClient struct {
+	interfaces.DBClient
+	to      map[string]int64
+	rollups []dataModels.ReadingRollup
+	err     error
+}
+
+func (c *rollupDBClient) RolledUpTo(events string) (int64, error) {
+	return c.to[events], nil
+}
+
+func (c *rollupDBClient) AddReadingRollups(events string, rollups []dataModels.ReadingRollup, from int64, to int64) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.to[events] = to
+	c.rollups = append(c.rollups, rollups...)
+	return nil
+}
+
+func rollupEvent(created int64, value string) models.Event {
+	return models.Event{
+		Id:         testUUIDString,
+		DeviceName: testDeviceName,
+		Created:    created,
+		Readings: []models.Reading{
+			models.SimpleReading{
+				BaseReading: models.BaseReading{DeviceName: testDeviceName, ResourceName: testDeviceResourceName, Created: created, ValueType: v2.ValueTypeUint16},
+				Value:       value,
+			},
+			models.BinaryReading{
+				BaseReading: models.BaseReading{DeviceName: testDeviceName, ResourceName: "image", Created: created, ValueType: v2.ValueTypeBinary},
+				BinaryValue: []byte("1010"),
+			},
+		},
+	}
+}
+
+func TestDeleteEventsByAgeDownsample(t *testing.T) {
+	downsample := config.RetentionInfo{Mode: retention.ModeDownsample, RollupInterval: "1h"}
+
+	tests := []struct {
+		name            string
+		retention       config.RetentionInfo
+		rolledUpTo      int64
+		storeErr        error
+		expectedRollups []dataModels.ReadingRollup
+		expectedDeleted bool
+	}{
+		{"Valid - downsampled then deleted", downsample, 0, nil, []dataModels.ReadingRollup{
+			{Device: testDeviceName, Name: testDeviceResourceName, Start: 0, Interval: 3600000, Count: 3, Min: 10, Max: 30, Sum: 60, Avg: 20},
+		}, true},
+		{"Valid - rolled up before", downsample, 3000, nil, nil, true},
+		{"Valid - delete mode", config.RetentionInfo{Mode: retention.ModeDelete}, 0, nil, nil, true},
+		{"Invalid - rollups not stored, nothing deleted", downsample, 0, errors.New("some error"), nil, false},
+		{"Invalid - unknown mode", config.RetentionInfo{Mode: "archive"}, 0, nil, nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("EventsByTimeRange", 1, mock.Anything, 0, 2).Return([]models.Event{rollupEvent(3000, "30"), rollupEvent(2000, "20")}, nil)
+			dbClientMock.On("EventsByTimeRange", 1, mock.Anything, 2, 2).Return([]models.Event{rollupEvent(1000, "10")}, nil)
+			dbClientMock.On("EventsByTimeRange", 3001, mock.Anything, 0, 2).Return(nil, nil)
+			dbClientMock.On("DeleteEventsByAge", mock.Anything).Return(nil)
+			rollupClient := &rollupDBClient{to: map[string]int64{retention.EventsV2: testCase.rolledUpTo}, err: testCase.storeErr}
+
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				dataContainer.ConfigurationName: func(get di.Get) interface{} {
+					return &config.ConfigurationStruct{
+						Service:   bootstrapConfig.ServiceInfo{MaxResultCount: 2},
+						Retention: testCase.retention,
+					}
+				},
+				v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				pkgContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+					return rollupClient
+				},
+			})
+
+			err := DeleteEventsByAge(100, dic)
+
+			assert.Equal(t, testCase.expectedRollups, rollupClient.rollups)
+			if !testCase.expectedDeleted {
+				require.Error(t, err)
+				dbClientMock.AssertNotCalled(t, "DeleteEventsByAge", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			dbClientMock.AssertCalled(t, "DeleteEventsByAge", mock.Anything)
+			if testCase.expectedRollups != nil {
+				assert.Equal(t, int64(3000), rollupClient.to[retention.EventsV2])
+			}
+		})
+	}
+}
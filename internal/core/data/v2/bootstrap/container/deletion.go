@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/deletion"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// ReadingDeleterName contains the name of the deletion.Deleter instance in the DIC.
+var ReadingDeleterName = di.TypeInstanceToName((*deletion.Deleter)(nil))
+
+// ReadingDeleterFrom helper function queries the DIC and returns the deletion.Deleter instance.
+func ReadingDeleterFrom(get di.Get) *deletion.Deleter {
+	return get(ReadingDeleterName).(*deletion.Deleter)
+}
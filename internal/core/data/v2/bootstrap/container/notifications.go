@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+)
+
+// NotificationsClientName contains the name of the support-notifications client instance in the DIC.
+var NotificationsClientName = "V2NotificationsClient"
+
+// NotificationsClientFrom helper function queries the DIC and returns the support-notifications client instance, nil
+// when no Notifications client is configured.
+func NotificationsClientFrom(get di.Get) notifications.NotificationsClient {
+	client, ok := get(NotificationsClientName).(notifications.NotificationsClient)
+	if !ok {
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/writebehind"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// WriteBehindWriterName contains the name of the writebehind.Writer instance in the DIC.
+var WriteBehindWriterName = di.TypeInstanceToName((*writebehind.Writer)(nil))
+
+// WriteBehindWriterFrom helper function queries the DIC and returns the writebehind.Writer instance, nil when the
+// events are stored before they are accepted.
+func WriteBehindWriterFrom(get di.Get) *writebehind.Writer {
+	writer, ok := get(WriteBehindWriterName).(*writebehind.Writer)
+	if !ok {
+		return nil
+	}
+	return writer
+}
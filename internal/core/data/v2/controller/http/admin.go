@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/application"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+// Samples is the query parameter setting the number of entries sampled per collection
+const Samples = "samples"
+
+// maxSamples bounds the number of entries sampled per collection so a single request can't scan the whole database
+const maxSamples = 1000
+
+type AdminController struct {
+	dic *di.Container
+}
+
+// NewAdminController creates and initializes an AdminController
+func NewAdminController(dic *di.Container) *AdminController {
+	return &AdminController{
+		dic: dic,
+	}
+}
+
+func (ac *AdminController) MemoryUsage(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ac.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ac.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	// parse URL query string for the number of entries sampled per collection
+	samples, err := utils.ParseQueryStringToInt(r, Samples, config.MemoryUsage.Samples, 1, maxSamples)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		usage, err := application.MemoryUsage(samples, ac.dic)
+		if err != nil {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = dataDTOs.NewMemoryUsageResponse("", "", http.StatusOK, usage)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryUsage(t *testing.T) {
+	collections := []string{"cd|evt", "cd|rd"}
+	usage := pkgModels.MemoryUsage{
+		UsedMemory: 1048576,
+		MaxMemory:  0,
+		Collections: []pkgModels.CollectionMemoryUsage{
+			{Name: "cd|evt", Count: 100, Sampled: 10, IndexBytes: 4096, AverageBytes: 200, EstimatedBytes: 24096},
+			{Name: "cd|rd", Count: 0, IndexBytes: 0, EstimatedBytes: 0},
+		},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MemoryUsage", collections, 10).Return(usage, nil)
+	dbClientMock.On("MemoryUsage", collections, 50).Return(usage, nil)
+
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	ac := NewAdminController(dic)
+
+	tests := []struct {
+		name               string
+		samples            string
+		expectedStatusCode int
+	}{
+		{"Valid - default samples", "", http.StatusOK},
+		{"Valid - with samples", "50", http.StatusOK},
+		{"Invalid - samples out of range", "0", http.StatusBadRequest},
+		{"Invalid - samples not a number", "ten", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/v2/admin/memory", http.NoBody)
+			require.NoError(t, err)
+			if testCase.samples != "" {
+				query := req.URL.Query()
+				query.Add(Samples, testCase.samples)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(ac.MemoryUsage)
+			handler.ServeHTTP(recorder, req)
+
+			var actualResponse dataDTOs.MemoryUsageResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, actualResponse.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, usage.UsedMemory, actualResponse.UsedMemory)
+				require.Len(t, actualResponse.Collections, 2)
+				assert.Equal(t, int64(24096), actualResponse.Collections[0].EstimatedBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/application"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/gorilla/mux"
+)
+
+type DeviceController struct {
+	dic *di.Container
+}
+
+// NewDeviceController creates and initializes a DeviceController
+func NewDeviceController(dic *di.Container) *DeviceController {
+	return &DeviceController{
+		dic: dic,
+	}
+}
+
+// DeviceState returns the latest reading of each resource of the device in one response
+func (dc *DeviceController) DeviceState(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	readings, err := application.DeviceState(name, dc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = dataDTOs.NewDeviceStateResponse("", "", http.StatusOK, name, readings)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceState(t *testing.T) {
+	state := []models.Reading{
+		models.SimpleReading{
+			BaseReading: models.BaseReading{DeviceName: "thermostat", ResourceName: "humidity", ValueType: v2.ValueTypeUint8, Origin: 100},
+			Value:       "40",
+		},
+		models.SimpleReading{
+			BaseReading: models.BaseReading{DeviceName: "thermostat", ResourceName: "temperature", ValueType: v2.ValueTypeFloat32, Origin: 120},
+			Value:       "21.5",
+		},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceState", "thermostat").Return(state, nil)
+	dbClientMock.On("DeviceState", "unknown").Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device unknown has no state", nil))
+
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	dc := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+		expectedCount      int
+	}{
+		{"Valid", "thermostat", http.StatusOK, 2},
+		{"Invalid - device without state", "unknown", http.StatusNotFound, 0},
+		{"Invalid - empty name", "", http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/v2/device/name/{name}/state", http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(dc.DeviceState)
+			handler.ServeHTTP(recorder, req)
+
+			var actualResponse dataDTOs.DeviceStateResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, actualResponse.StatusCode, "Response status code not as expected")
+			require.Len(t, actualResponse.Readings, testCase.expectedCount)
+			if testCase.expectedCount > 0 {
+				assert.Equal(t, "thermostat", actualResponse.DeviceName)
+				assert.Equal(t, "temperature", actualResponse.Readings[1].ResourceName)
+				assert.Equal(t, "21.5", actualResponse.Readings[1].Value)
+			}
+		})
+	}
+}
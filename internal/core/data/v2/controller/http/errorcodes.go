@@ -0,0 +1,11 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import "github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+
+// ErrorCodes maps the errors of the core-data V2 API to their error codes
+var ErrorCodes = errorcode.NewRegistry(errorcode.CoreData)
@@ -7,6 +7,7 @@ import (
 
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/application"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
@@ -18,6 +19,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
@@ -54,10 +56,7 @@ func (ec *EventController) AddEvent(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		errResponses := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		errResponses := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		// encode and send out the response
 		pkg.Encode(errResponses, w, lc)
@@ -76,10 +75,7 @@ func (ec *EventController) AddEvent(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			addEventResponse = commonDTO.NewBaseResponse(
-				reqId,
-				err.Message(),
-				err.Code())
+			addEventResponse = ErrorCodes.NewErrorResponse(reqId, err)
 		} else {
 			addEventResponse = commonDTO.NewBaseWithIdResponse(
 				reqId,
@@ -117,7 +113,7 @@ func (ec *EventController) EventById(w http.ResponseWriter, r *http.Request) {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		}
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		eventResponse = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		eventResponse = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		eventResponse = responseDTO.NewEventResponse("", "", http.StatusOK, e)
@@ -148,7 +144,7 @@ func (ec *EventController) DeleteEventById(w http.ResponseWriter, r *http.Reques
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
@@ -175,7 +171,7 @@ func (ec *EventController) EventTotalCount(w http.ResponseWriter, r *http.Reques
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		countResponse = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		countResponse = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		countResponse = commonDTO.NewCountResponse("", "", http.StatusOK, count)
@@ -205,7 +201,7 @@ func (ec *EventController) EventCountByDeviceName(w http.ResponseWriter, r *http
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		countResponse = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		countResponse = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		countResponse = commonDTO.NewCountResponse("", "", http.StatusOK, count)
@@ -230,7 +226,7 @@ func (ec *EventController) AllEvents(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		events, err := application.AllEvents(offset, limit, ec.dic)
@@ -239,7 +235,7 @@ func (ec *EventController) AllEvents(w http.ResponseWriter, r *http.Request) {
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiEventsResponse("", "", http.StatusOK, events)
@@ -268,7 +264,7 @@ func (ec *EventController) EventsByDeviceName(w http.ResponseWriter, r *http.Req
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		events, err := application.EventsByDeviceName(offset, limit, name, ec.dic)
@@ -277,7 +273,45 @@ func (ec *EventController) EventsByDeviceName(w http.ResponseWriter, r *http.Req
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiEventsResponse("", "", http.StatusOK, events)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+func (ec *EventController) EventsByAssetId(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	vars := mux.Vars(r)
+	assetId := vars[v2.Id]
+
+	var response interface{}
+	var statusCode int
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		events, err := application.EventsByAssetId(offset, limit, assetId, ec.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiEventsResponse("", "", http.StatusOK, events)
@@ -289,6 +323,52 @@ func (ec *EventController) EventsByDeviceName(w http.ResponseWriter, r *http.Req
 	pkg.Encode(response, w, lc)
 }
 
+// IndexVar and IndexValueVar are the route variables naming a secondary index and the value looked up
+const (
+	IndexVar      = "index"
+	IndexValueVar = "value"
+)
+
+// EventsByIndex returns the events holding the value of the secondary index declared in the configuration
+func (ec *EventController) EventsByIndex(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	vars := mux.Vars(r)
+	name := vars[IndexVar]
+	value := vars[IndexValueVar]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		results, err := application.EventsByIndex(name, value, offset, limit, ec.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiEventsResponse("", "", http.StatusOK, results)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
 func (ec *EventController) DeleteEventsByDeviceName(w http.ResponseWriter, r *http.Request) {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -306,7 +386,7 @@ func (ec *EventController) DeleteEventsByDeviceName(w http.ResponseWriter, r *ht
 	err := application.DeleteEventsByDeviceName(deviceName, ec.dic)
 	if err != nil {
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = commonDTO.NewBaseResponse("", "", http.StatusAccepted)
@@ -332,7 +412,7 @@ func (ec *EventController) EventsByTimeRange(w http.ResponseWriter, r *http.Requ
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		events, err := application.EventsByTimeRange(start, end, offset, limit, ec.dic)
@@ -341,7 +421,7 @@ func (ec *EventController) EventsByTimeRange(w http.ResponseWriter, r *http.Requ
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiEventsResponse("", "", http.StatusOK, events)
@@ -353,6 +433,154 @@ func (ec *EventController) EventsByTimeRange(w http.ResponseWriter, r *http.Requ
 	pkg.Encode(response, w, lc)
 }
 
+// EventsByIds returns the events of the ids of the request body in one call, up to the MaxResultCount of the
+// configuration, so that the clients correlating events don't request them one by one
+func (ec *EventController) EventsByIds(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	request, err := ec.reader.ReadEventIdsRequest(r.Body)
+	if err == nil {
+		var events []dtos.Event
+		var notFound []string
+		events, notFound, err = application.EventsByIds(request.Ids, config.Service.MaxResultCount, ec.dic)
+		if err == nil {
+			response = dataDTOs.NewEventsByIdsResponse("", "", http.StatusOK, events, notFound)
+			statusCode = http.StatusOK
+		}
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+const (
+	// ConsumerVar is the route variable naming the consumer group which marks the events it processed as pushed
+	ConsumerVar = "consumer"
+	// MarkQuery is the query parameter marking the unpushed events as pushed as they are returned
+	MarkQuery = "mark"
+)
+
+// MarkEventsPushed marks the events of the ids of the request body as pushed by the consumer group in the URL, up to
+// the MaxResultCount of the configuration, and returns the ids of the events which don't exist
+func (ec *EventController) MarkEventsPushed(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	consumer := mux.Vars(r)[ConsumerVar]
+	request, err := ec.reader.ReadEventIdsRequest(r.Body)
+	if err == nil {
+		var notFound []string
+		notFound, err = application.MarkEventsPushed(consumer, request.Ids, config.Service.MaxResultCount, ec.dic)
+		if err == nil {
+			response = dataDTOs.NewEventsPushedResponse("", "", http.StatusOK, notFound)
+			statusCode = http.StatusOK
+		}
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// UnpushedEvents returns the events the consumer group in the URL didn't mark as pushed with offset and limit, the
+// oldest first, marking them as pushed when the mark query parameter is true
+func (ec *EventController) UnpushedEvents(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	consumer := mux.Vars(r)[ConsumerVar]
+
+	// parse URL query string for offset, limit and mark
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	mark := false
+	if value := r.URL.Query().Get(MarkQuery); err == nil && value != "" {
+		var parsingErr error
+		if mark, parsingErr = strconv.ParseBool(value); parsingErr != nil {
+			err = errors.NewCommonEdgeX(errors.KindContractInvalid, "mark format parsing failed", parsingErr)
+		}
+	}
+	if err == nil {
+		var events []dtos.Event
+		events, err = application.UnpushedEvents(consumer, offset, limit, mark, ec.dic)
+		if err == nil {
+			response = responseDTO.NewMultiEventsResponse("", "", http.StatusOK, events)
+			statusCode = http.StatusOK
+		}
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// DeleteEventsPushed forgets the events the consumer group in the URL marked as pushed, so that it consumes all the
+// events again
+func (ec *EventController) DeleteEventsPushed(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	consumer := mux.Vars(r)[ConsumerVar]
+	err := application.DeleteEventsPushed(consumer, ec.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
 func (ec *EventController) DeleteEventsByAge(w http.ResponseWriter, r *http.Request) {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -370,13 +598,13 @@ func (ec *EventController) DeleteEventsByAge(w http.ResponseWriter, r *http.Requ
 		err := errors.NewCommonEdgeX(errors.KindContractInvalid, "age format parsing failed", parsingErr)
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		err := application.DeleteEventsByAge(age, ec.dic)
 		if err != nil {
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = commonDTO.NewBaseResponse("", "", http.StatusAccepted)
@@ -16,8 +16,10 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -29,6 +31,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -588,6 +591,134 @@ func TestAllEventsByDeviceName(t *testing.T) {
 	}
 }
 
+func TestAllEventsByAssetId(t *testing.T) {
+	testAssetId := "press-01"
+	eventWithAsset := persistedEvent
+	eventWithAsset.Tags = map[string]string{pkgModels.AssetIdTag: testAssetId}
+	events := []models.Event{eventWithAsset, eventWithAsset}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByAssetId", 0, 5, testAssetId).Return(events, nil)
+	dbClientMock.On("EventsByAssetId", 4, 1, testAssetId).Return([]models.Event{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "query objects bounds out of range.", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	ec := NewEventController(dic)
+	assert.NotNil(t, ec)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		assetId            string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get events with assetId", "0", "5", testAssetId, false, 2, http.StatusOK},
+		{"Invalid - offset out of range", "4", "1", testAssetId, true, 0, http.StatusNotFound},
+		{"Invalid - get events without assetId", "0", "10", "", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiEventRoute+"/asset/{id}", http.NoBody)
+			query := req.URL.Query()
+			query.Add(v2.Offset, testCase.offset)
+			query.Add(v2.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{v2.Id: testCase.assetId})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(ec.EventsByAssetId)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res common.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiEventsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedCount, len(res.Events), "Event count not as expected")
+				assert.Equal(t, testAssetId, res.Events[0].Tags[pkgModels.AssetIdTag], "Asset id not as expected")
+			}
+		})
+	}
+}
+
+func TestEventsByIndex(t *testing.T) {
+	testTag := "site"
+	taggedEvent := persistedEvent
+	taggedEvent.Tags = map[string]string{testTag: "plant-1"}
+	events := []models.Event{taggedEvent, taggedEvent}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByIndex", "Tag", testTag, 0, 5).Return(events, nil)
+	dbClientMock.On("EventsByIndex", "Unknown", testTag, 0, 5).Return([]models.Event{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "no event index named Unknown is declared", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	ec := NewEventController(dic)
+	assert.NotNil(t, ec)
+
+	tests := []struct {
+		name               string
+		index              string
+		value              string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get events by tag", "Tag", testTag, false, 2, http.StatusOK},
+		{"Invalid - undeclared index", "Unknown", testTag, true, 0, http.StatusBadRequest},
+		{"Invalid - empty value", "Tag", "", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiEventRoute+"/index/{index}/{value}", http.NoBody)
+			query := req.URL.Query()
+			query.Add(v2.Offset, "0")
+			query.Add(v2.Limit, "5")
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{IndexVar: testCase.index, IndexValueVar: testCase.value})
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(ec.EventsByIndex)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res common.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiEventsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedCount, len(res.Events), "Event count not as expected")
+			}
+		})
+	}
+}
+
 func TestAllEventsByTimeRange(t *testing.T) {
 	dic := mocks.NewMockDIC()
 	dbClientMock := &dbMock.DBClient{}
@@ -712,3 +843,159 @@ func TestDeleteEventsByAge(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsByIds(t *testing.T) {
+	missingEventId := uuid.New().String()
+	otherEvent := persistedEvent
+	otherEvent.Id = uuid.New().String()
+	tooManyIds := make([]string, 21)
+	for i := range tooManyIds {
+		tooManyIds[i] = uuid.New().String()
+	}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventsByIds", []string{otherEvent.Id, missingEventId, expectedEventId}).Return([]models.Event{otherEvent, persistedEvent}, nil)
+	dbClientMock.On("EventsByIds", []string{expectedEventId}).Return([]models.Event{persistedEvent}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		body               string
+		expectedEventIds   []string
+		expectedNotFound   []string
+		expectedStatusCode int
+	}{
+		{"Valid - events and missing ids", fmt.Sprintf(`{"ids":["%s","%s","%s"]}`, otherEvent.Id, missingEventId, expectedEventId),
+			[]string{otherEvent.Id, expectedEventId}, []string{missingEventId}, http.StatusOK},
+		{"Valid - duplicated ids", fmt.Sprintf(`{"ids":["%s","%s"]}`, expectedEventId, expectedEventId),
+			[]string{expectedEventId}, nil, http.StatusOK},
+		{"Invalid - no id", `{"ids":[]}`, nil, nil, http.StatusBadRequest},
+		{"Invalid - empty id", `{"ids":[""]}`, nil, nil, http.StatusBadRequest},
+		{"Invalid - too many ids", fmt.Sprintf(`{"ids":["%s"]}`, strings.Join(tooManyIds, `","`)), nil, nil, http.StatusBadRequest},
+		{"Invalid - bad JSON", `{"ids":`, nil, nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, "/api/v2/event/ids", strings.NewReader(testCase.body))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.EventsByIds)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res dataDTOs.EventsByIdsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var eventIds []string
+			for _, e := range res.Events {
+				eventIds = append(eventIds, e.Id)
+			}
+			assert.Equal(t, testCase.expectedEventIds, eventIds, "Events not as expected")
+			assert.Equal(t, testCase.expectedNotFound, res.NotFound, "Ids not found not as expected")
+		})
+	}
+}
+
+func TestMarkEventsPushed(t *testing.T) {
+	missingEventId := uuid.New().String()
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("MarkEventsPushed", "export", []string{expectedEventId, missingEventId}).Return([]string{missingEventId}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		body               string
+		expectedNotFound   []string
+		expectedStatusCode int
+	}{
+		{"Valid - events and missing ids", fmt.Sprintf(`{"ids":["%s","%s","%s"]}`, expectedEventId, missingEventId, expectedEventId),
+			[]string{missingEventId}, http.StatusOK},
+		{"Invalid - no id", `{"ids":[]}`, nil, http.StatusBadRequest},
+		{"Invalid - bad JSON", `{"ids":`, nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, "/api/v2/event/consumer/export/pushed", strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{ConsumerVar: "export"})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.MarkEventsPushed).ServeHTTP(recorder, req)
+
+			var res dataDTOs.EventsPushedResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Equal(t, testCase.expectedNotFound, res.NotFound, "Ids not found not as expected")
+		})
+	}
+}
+
+func TestUnpushedEvents(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UnpushedEvents", "export", 0, 20).Return([]models.Event{persistedEvent}, nil)
+	dbClientMock.On("MarkEventsPushed", "export", []string{expectedEventId}).Return(nil, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewEventController(dic)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedMarked     bool
+		expectedStatusCode int
+	}{
+		{"Valid - without mark", "", false, http.StatusOK},
+		{"Valid - with mark", "?mark=true", true, http.StatusOK},
+		{"Invalid - mark", "?mark=yes", false, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock.Calls = nil
+			req, err := http.NewRequest(http.MethodGet, "/api/v2/event/consumer/export/unpushed"+testCase.query, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{ConsumerVar: "export"})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.UnpushedEvents).ServeHTTP(recorder, req)
+
+			var res responseDTO.MultiEventsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				require.Len(t, res.Events, 1)
+				assert.Equal(t, expectedEventId, res.Events[0].Id)
+			}
+			if testCase.expectedMarked {
+				dbClientMock.AssertCalled(t, "MarkEventsPushed", "export", []string{expectedEventId})
+			} else {
+				dbClientMock.AssertNotCalled(t, "MarkEventsPushed", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/application"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
@@ -19,6 +21,17 @@ import (
 	"github.com/gorilla/mux"
 )
 
+// Query strings of the reading gaps request
+const (
+	ReadingGapsDevice        = "device"
+	ReadingGapsResource      = "resource"
+	ReadingGapsInterval      = "interval"
+	ReadingGapsInterpolation = "interpolation"
+)
+
+// ReadingStreamDevice is the query string restricting the streamed readings to those of a device
+const ReadingStreamDevice = "device"
+
 type ReadingController struct {
 	dic *di.Container
 }
@@ -45,7 +58,7 @@ func (rc *ReadingController) ReadingTotalCount(w http.ResponseWriter, r *http.Re
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		countResponse = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		countResponse = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		countResponse = commonDTO.NewCountResponse("", "", http.StatusOK, count)
@@ -70,7 +83,7 @@ func (rc *ReadingController) AllReadings(w http.ResponseWriter, r *http.Request)
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		readings, err := application.AllReadings(offset, limit, rc.dic)
@@ -79,7 +92,7 @@ func (rc *ReadingController) AllReadings(w http.ResponseWriter, r *http.Request)
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, readings)
@@ -105,7 +118,7 @@ func (rc *ReadingController) ReadingsByTimeRange(w http.ResponseWriter, r *http.
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		readings, err := application.ReadingsByTimeRange(start, end, offset, limit, rc.dic)
@@ -114,7 +127,7 @@ func (rc *ReadingController) ReadingsByTimeRange(w http.ResponseWriter, r *http.
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, readings)
@@ -126,6 +139,36 @@ func (rc *ReadingController) ReadingsByTimeRange(w http.ResponseWriter, r *http.
 	pkg.Encode(response, w, lc)
 }
 
+// ReadingStreamByTimeRange streams the readings created within the time range, of the device given by the device
+// query string when present, as a JSON array or as newline delimited JSON when the client accepts it. An error once
+// the stream started can't change the status of the response anymore, the response is then cut short instead.
+func (rc *ReadingController) ReadingStreamByTimeRange(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	start, end, err := utils.ParseTimeRangePathParams(r)
+	if err == nil {
+		w.Header().Set(clients.CorrelationHeader, correlationId)
+		stream := utils.NewStreamWriter(w, utils.AcceptsNDJSON(r))
+		var started bool
+		started, err = application.StreamReadingsByTimeRange(start, end, r.URL.Query().Get(ReadingStreamDevice), stream, rc.dic)
+		if started {
+			if err != nil {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+				lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			}
+			return
+		}
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		utils.WriteHttpHeader(w, ctx, err.Code())
+		pkg.Encode(ErrorCodes.NewErrorResponse("", err), w, lc)
+	}
+}
+
 func (rc *ReadingController) ReadingsByResourceName(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(rc.dic.Get)
 	ctx := r.Context()
@@ -143,7 +186,7 @@ func (rc *ReadingController) ReadingsByResourceName(w http.ResponseWriter, r *ht
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		readings, err := application.ReadingsByResourceName(offset, limit, resourceName, rc.dic)
@@ -152,7 +195,7 @@ func (rc *ReadingController) ReadingsByResourceName(w http.ResponseWriter, r *ht
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, readings)
@@ -181,7 +224,7 @@ func (rc *ReadingController) ReadingsByDeviceName(w http.ResponseWriter, r *http
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		readings, err := application.ReadingsByDeviceName(offset, limit, name, rc.dic)
@@ -190,7 +233,7 @@ func (rc *ReadingController) ReadingsByDeviceName(w http.ResponseWriter, r *http
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiReadingsResponse("", "", http.StatusOK, readings)
@@ -221,7 +264,7 @@ func (rc *ReadingController) ReadingCountByDeviceName(w http.ResponseWriter, r *
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		countResponse = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		countResponse = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		countResponse = commonDTO.NewCountResponse("", "", http.StatusOK, count)
@@ -231,3 +274,113 @@ func (rc *ReadingController) ReadingCountByDeviceName(w http.ResponseWriter, r *
 	utils.WriteHttpHeader(w, ctx, statusCode)
 	pkg.Encode(countResponse, w, lc) // encode and send out the response
 }
+
+func (rc *ReadingController) ReadingStats(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	devices, resources, err := application.ReadingStats(rc.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = dataDTOs.NewReadingStatsResponse("", "", http.StatusOK, devices, resources)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// DeleteReadingsByDeviceName starts a job deleting the events and readings of the device in the background, and
+// responds 202 Accepted with the job whose progress is then reported by ReadingDeletionJob
+func (rc *ReadingController) DeleteReadingsByDeviceName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	deviceName := mux.Vars(r)[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	job, err := application.DeleteReadingsByDeviceName(deviceName, rc.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = dataDTOs.NewReadingDeletionJobResponse("", "", http.StatusAccepted, job)
+		statusCode = http.StatusAccepted
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ReadingDeletionJob reports the progress of a reading deletion job
+func (rc *ReadingController) ReadingDeletionJob(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	id := mux.Vars(r)[v2.Id]
+
+	var response interface{}
+	var statusCode int
+
+	job, err := application.ReadingDeletionJob(id, rc.dic)
+	if err != nil {
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = dataDTOs.NewReadingDeletionJobResponse("", "", http.StatusOK, job)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+func (rc *ReadingController) ReadingGaps(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	query := r.URL.Query()
+	deviceName := query.Get(ReadingGapsDevice)
+	resourceName := query.Get(ReadingGapsResource)
+
+	var response interface{}
+	var statusCode int
+
+	start, end, err := utils.ParseTimeRangeQueryString(r)
+	if err == nil {
+		var expected int64
+		var gaps []dataModels.ReadingGap
+		expected, gaps, err = application.ReadingGaps(deviceName, resourceName, start, end, query.Get(ReadingGapsInterval), query.Get(ReadingGapsInterpolation), ctx, rc.dic)
+		if err == nil {
+			response = dataDTOs.NewReadingGapsResponse("", "", http.StatusOK, deviceName, resourceName, expected, dataDTOs.FromReadingGapModelsToDTOs(gaps))
+			statusCode = http.StatusOK
+		}
+	}
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -2,20 +2,29 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	v2DataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/v2/bootstrap/container"
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -183,6 +192,103 @@ func TestReadingsByTimeRange(t *testing.T) {
 	}
 }
 
+func TestReadingStreamByTimeRange(t *testing.T) {
+	reading := func(id string) models.Reading {
+		return models.SimpleReading{
+			BaseReading: models.BaseReading{Id: id, DeviceName: "dev-a", ResourceName: "temperature", Created: 150, ValueType: v2.ValueTypeInt32},
+			Value:       "21",
+		}
+	}
+	batches := [][]models.Reading{{reading("r1"), reading("r2")}, {reading("r3")}}
+	dbErr := errors.NewCommonEdgeX(errors.KindDatabaseError, "scan failed", nil)
+
+	tests := []struct {
+		name               string
+		start              string
+		end                string
+		device             string
+		ndjson             bool
+		failAfter          int
+		expectedStatusCode int
+	}{
+		{"Valid - JSON array", "100", "200", "", false, -1, http.StatusOK},
+		{"Valid - NDJSON of a device", "100", "200", "dev-a", true, -1, http.StatusOK},
+		{"Invalid - error before the stream started", "100", "200", "", false, 0, http.StatusInternalServerError},
+		{"Invalid - error once the stream started", "100", "200", "", false, 1, http.StatusOK},
+		{"Invalid - end before start", "200", "100", "", false, -1, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("ScanReadingsByTimeRange", 100, 200, testCase.device, utils.StreamBatchSize, mock.Anything).Return(
+				func(_ int, _ int, _ string, _ int, fn func([]models.Reading) errors.EdgeX) errors.EdgeX {
+					for i, batch := range batches {
+						if i == testCase.failAfter {
+							return dbErr
+						}
+						if err := fn(batch); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			dic := mocks.NewMockDIC()
+			dic.Update(di.ServiceConstructorMap{
+				v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+			rc := NewReadingController(dic)
+
+			req, err := http.NewRequest(http.MethodGet, v2.ApiReadingRoute+"/stream", http.NoBody)
+			require.NoError(t, err)
+			if testCase.device != "" {
+				query := req.URL.Query()
+				query.Add(ReadingStreamDevice, testCase.device)
+				req.URL.RawQuery = query.Encode()
+			}
+			if testCase.ndjson {
+				req.Header.Set("Accept", utils.ContentTypeNDJSON)
+			}
+			req = mux.SetURLVars(req, map[string]string{v2.Start: testCase.start, v2.End: testCase.end})
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.ReadingStreamByTimeRange)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			switch {
+			case testCase.expectedStatusCode != http.StatusOK:
+				var res common.BaseResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			case testCase.failAfter >= 0:
+				assert.False(t, json.Valid(recorder.Body.Bytes()), "Response not cut short")
+			case testCase.ndjson:
+				assert.Equal(t, utils.ContentTypeNDJSON, recorder.Header().Get(clients.ContentType))
+				lines := strings.Split(strings.TrimSuffix(recorder.Body.String(), "\n"), "\n")
+				require.Len(t, lines, 3)
+				for i, line := range lines {
+					var res dtos.BaseReading
+					require.NoError(t, json.Unmarshal([]byte(line), &res))
+					assert.Equal(t, fmt.Sprintf("r%d", i+1), res.Id, "Reading not streamed in order")
+				}
+			default:
+				assert.Equal(t, clients.ContentTypeJSON, recorder.Header().Get(clients.ContentType))
+				var res []dtos.BaseReading
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Len(t, res, 3)
+				for i, r := range res {
+					assert.Equal(t, fmt.Sprintf("r%d", i+1), r.Id, "Reading not streamed in order")
+				}
+			}
+		})
+	}
+}
+
 func TestReadingsByResourceName(t *testing.T) {
 	dic := mocks.NewMockDIC()
 	dbClientMock := &dbMock.DBClient{}
@@ -348,3 +454,85 @@ func TestReadingCountByDeviceName(t *testing.T) {
 	assert.Empty(t, actualResponse.Message, "Message should be empty when it is successful")
 	assert.Equal(t, expectedReadingCount, actualResponse.Count, "Reading count in the response body is not expected")
 }
+
+func TestReadingStats(t *testing.T) {
+	deviceStats := []pkgModels.ReadingStats{{Name: "Thermostat", Count: 12, Bytes: 2400, FirstReading: 1600000000000, LastReading: 1600000060000}}
+	resourceStats := []pkgModels.ReadingStats{{Name: "Temperature", Count: 12, Bytes: 2400, FirstReading: 1600000000000, LastReading: 1600000060000}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingStatsByDeviceName").Return(deviceStats, nil)
+	dbClientMock.On("ReadingStatsByResourceName").Return(resourceStats, nil)
+
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, v2.ApiReadingRoute+"/stats", http.NoBody)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(rc.ReadingStats)
+	handler.ServeHTTP(recorder, req)
+
+	var actualResponse dataDTOs.ReadingStatsResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, dataDTOs.FromReadingStatsModelsToDTOs(deviceStats), actualResponse.Devices, "Device stats not as expected")
+	assert.Equal(t, dataDTOs.FromReadingStatsModelsToDTOs(resourceStats), actualResponse.Resources, "Resource stats not as expected")
+}
+
+func TestReadingGaps(t *testing.T) {
+	readings := []models.Reading{
+		models.SimpleReading{BaseReading: models.BaseReading{Created: 1000, ResourceName: "Temperature"}, Value: "10"},
+		models.SimpleReading{BaseReading: models.BaseReading{Created: 4000, ResourceName: "Temperature"}, Value: "40"},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByDeviceResourceAndTimeRange", "Thermostat", "Temperature", 1000, 4000).Return(readings, nil)
+
+	dic := mocks.NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		v2DataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedStatusCode int
+		expectedGaps       []dataDTOs.ReadingGap
+	}{
+		{"Valid", "device=Thermostat&resource=Temperature&start=1000&end=4000&interval=1s", http.StatusOK, []dataDTOs.ReadingGap{{Start: 1000, End: 4000, Missing: 2}}},
+		{"Valid - linear interpolation", "device=Thermostat&resource=Temperature&start=1000&end=4000&interval=1s&interpolation=linear", http.StatusOK,
+			[]dataDTOs.ReadingGap{{Start: 1000, End: 4000, Missing: 2, Interpolated: []dataDTOs.InterpolatedValue{{Timestamp: 2000, Value: "20"}, {Timestamp: 3000, Value: "30"}}}}},
+		{"Invalid - missing end", "device=Thermostat&resource=Temperature&start=1000&interval=1s", http.StatusBadRequest, nil},
+		{"Invalid - end before start", "device=Thermostat&resource=Temperature&start=4000&end=1000&interval=1s", http.StatusBadRequest, nil},
+		{"Invalid - missing resource", "device=Thermostat&start=1000&end=4000&interval=1s", http.StatusBadRequest, nil},
+		{"Invalid - unknown interpolation", "device=Thermostat&resource=Temperature&start=1000&end=4000&interval=1s&interpolation=cubic", http.StatusBadRequest, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiReadingRoute+"/gaps?"+testCase.query, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(rc.ReadingGaps)
+			handler.ServeHTTP(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var actualResponse dataDTOs.ReadingGapsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1000), actualResponse.ExpectedInterval, "Expected interval not as expected")
+			assert.Equal(t, testCase.expectedGaps, actualResponse.Gaps, "Gaps not as expected")
+		})
+	}
+}
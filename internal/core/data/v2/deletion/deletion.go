@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deletion deletes the events and readings of devices in the background, chunk by chunk and one device at a
+// time, so that deleting the readings of a decommissioned device ties up neither the API nor the database.
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/google/uuid"
+)
+
+// Deleter runs the reading deletion jobs in the order they were started
+type Deleter struct {
+	dbClient  interfaces.DBClient
+	lc        logger.LoggingClient
+	chunkSize int
+	interval  time.Duration
+	retention time.Duration
+
+	mutex sync.Mutex
+	jobs  map[string]*models.ReadingDeletionJob
+	queue []*models.ReadingDeletionJob
+	wake  chan struct{}
+}
+
+// NewDeleter returns a deleter deleting the readings with the database client as configured
+func NewDeleter(info config.ReadingDeletionInfo, dbClient interfaces.DBClient, lc logger.LoggingClient) (*Deleter, error) {
+	if info.ChunkSize <= 0 {
+		return nil, fmt.Errorf("ReadingDeletion ChunkSize must be positive")
+	}
+	interval, err := time.ParseDuration(info.ChunkInterval)
+	if err != nil || interval < 0 {
+		return nil, fmt.Errorf("invalid ReadingDeletion ChunkInterval '%s'", info.ChunkInterval)
+	}
+	retention, err := time.ParseDuration(info.JobRetention)
+	if err != nil || retention < 0 {
+		return nil, fmt.Errorf("invalid ReadingDeletion JobRetention '%s'", info.JobRetention)
+	}
+
+	return &Deleter{
+		dbClient:  dbClient,
+		lc:        lc,
+		chunkSize: info.ChunkSize,
+		interval:  interval,
+		retention: retention,
+		jobs:      make(map[string]*models.ReadingDeletionJob),
+		wake:      make(chan struct{}, 1),
+	}, nil
+}
+
+// Start queues a job deleting the events and readings of the device, and returns it. The job of the device already
+// pending or running is returned instead of queuing another one.
+func (d *Deleter) Start(deviceName string) (models.ReadingDeletionJob, errors.EdgeX) {
+	total, edgeXerr := d.dbClient.ReadingCountByDeviceName(deviceName)
+	if edgeXerr != nil {
+		return models.ReadingDeletionJob{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.prune()
+	for _, job := range d.jobs {
+		if job.DeviceName == deviceName && (job.Status == models.ReadingDeletionPending || job.Status == models.ReadingDeletionRunning) {
+			return *job, nil
+		}
+	}
+
+	now := common.MakeTimestamp()
+	job := &models.ReadingDeletionJob{
+		Id:         uuid.New().String(),
+		DeviceName: deviceName,
+		Status:     models.ReadingDeletionPending,
+		Total:      total,
+		Created:    now,
+		Modified:   now,
+	}
+	d.jobs[job.Id] = job
+	d.queue = append(d.queue, job)
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return *job, nil
+}
+
+// Job returns the job of the id, false when there is no such job or it finished longer than the retention ago
+func (d *Deleter) Job(id string) (models.ReadingDeletionJob, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.prune()
+	job, ok := d.jobs[id]
+	if !ok {
+		return models.ReadingDeletionJob{}, false
+	}
+	return *job, true
+}
+
+// Run runs the queued jobs until the service is exiting, the running job being failed then
+func (d *Deleter) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			if job := d.next(); job != nil {
+				d.run(ctx, job)
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-d.wake:
+			}
+		}
+	}()
+}
+
+// next dequeues the next job to run, nil when there is none
+func (d *Deleter) next() *models.ReadingDeletionJob {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if len(d.queue) == 0 {
+		return nil
+	}
+	job := d.queue[0]
+	d.queue = d.queue[1:]
+	return job
+}
+
+// run deletes the events and readings of the job's device a chunk at a time, pausing between the chunks
+func (d *Deleter) run(ctx context.Context, job *models.ReadingDeletionJob) {
+	d.update(job, func(j *models.ReadingDeletionJob) { j.Status = models.ReadingDeletionRunning })
+	d.lc.Info(fmt.Sprintf("Deleting the %d readings of device %s, job %s", job.Total, job.DeviceName, job.Id))
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.update(job, func(j *models.ReadingDeletionJob) {
+				j.Status = models.ReadingDeletionFailed
+				j.Message = "the service stopped before the deletion completed"
+			})
+			return
+		default:
+		}
+
+		events, readings, edgeXerr := d.dbClient.DeleteEventsByDeviceNameChunk(job.DeviceName, d.chunkSize)
+		if edgeXerr != nil {
+			d.lc.Error(fmt.Sprintf("Deleting the readings of device %s failed, job %s: %s", job.DeviceName, job.Id, edgeXerr.Error()))
+			d.update(job, func(j *models.ReadingDeletionJob) {
+				j.Status = models.ReadingDeletionFailed
+				j.Message = edgeXerr.Error()
+			})
+			return
+		}
+		if events == 0 {
+			d.update(job, func(j *models.ReadingDeletionJob) { j.Status = models.ReadingDeletionCompleted })
+			d.lc.Info(fmt.Sprintf("Deleted the readings of device %s, job %s", job.DeviceName, job.Id))
+			return
+		}
+		d.update(job, func(j *models.ReadingDeletionJob) {
+			j.Events += events
+			j.Deleted += readings
+		})
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(d.interval):
+		}
+	}
+}
+
+func (d *Deleter) update(job *models.ReadingDeletionJob, update func(j *models.ReadingDeletionJob)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	update(job)
+	job.Modified = common.MakeTimestamp()
+}
+
+// prune forgets the jobs finished longer than the retention ago, the mutex being locked
+func (d *Deleter) prune() {
+	expired := common.MakeTimestamp() - d.retention.Milliseconds()
+	for id, job := range d.jobs {
+		if (job.Status == models.ReadingDeletionCompleted || job.Status == models.ReadingDeletionFailed) && job.Modified < expired {
+			delete(d.jobs, id)
+		}
+	}
+}
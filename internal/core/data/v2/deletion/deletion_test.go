@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletion
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInfo() config.ReadingDeletionInfo {
+	return config.ReadingDeletionInfo{ChunkSize: 2, ChunkInterval: "1ms", JobRetention: "1h"}
+}
+
+func waitFor(t *testing.T, d *Deleter, id string, status string) models.ReadingDeletionJob {
+	var job models.ReadingDeletionJob
+	require.Eventually(t, func() bool {
+		job, _ = d.Job(id)
+		return job.Status == status
+	}, time.Second, time.Millisecond)
+	return job
+}
+
+func TestNewDeleter(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(info *config.ReadingDeletionInfo)
+		valid  bool
+	}{
+		{"valid", func(info *config.ReadingDeletionInfo) {}, true},
+		{"no chunk", func(info *config.ReadingDeletionInfo) { info.ChunkSize = 0 }, false},
+		{"invalid chunk interval", func(info *config.ReadingDeletionInfo) { info.ChunkInterval = "soon" }, false},
+		{"invalid job retention", func(info *config.ReadingDeletionInfo) { info.JobRetention = "" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := testInfo()
+			tt.update(&info)
+			_, err := NewDeleter(info, &dbMock.DBClient{}, logger.NewMockClient())
+			assert.Equal(t, tt.valid, err == nil)
+		})
+	}
+}
+
+func TestDeleterRun(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingCountByDeviceName", "Device").Return(uint32(5), nil)
+	dbClientMock.On("DeleteEventsByDeviceNameChunk", "Device", 2).Return(uint32(2), uint32(2), nil).Twice()
+	dbClientMock.On("DeleteEventsByDeviceNameChunk", "Device", 2).Return(uint32(1), uint32(1), nil).Once()
+	dbClientMock.On("DeleteEventsByDeviceNameChunk", "Device", 2).Return(uint32(0), uint32(0), nil).Once()
+	deleter, err := NewDeleter(testInfo(), dbClientMock, logger.NewMockClient())
+	require.NoError(t, err)
+
+	job, edgeXerr := deleter.Start("Device")
+	require.NoError(t, edgeXerr)
+	assert.Equal(t, models.ReadingDeletionPending, job.Status)
+	assert.Equal(t, uint32(5), job.Total)
+	again, edgeXerr := deleter.Start("Device")
+	require.NoError(t, edgeXerr)
+	assert.Equal(t, job.Id, again.Id, "the pending job of the device is expected to be returned")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	deleter.Run(ctx, &wg)
+
+	job = waitFor(t, deleter, job.Id, models.ReadingDeletionCompleted)
+	assert.Equal(t, uint32(5), job.Deleted)
+	assert.Equal(t, uint32(5), job.Events)
+	dbClientMock.AssertNumberOfCalls(t, "DeleteEventsByDeviceNameChunk", 4)
+
+	cancel()
+	wg.Wait()
+
+	_, ok := deleter.Job("unknown")
+	assert.False(t, ok)
+}
+
+func TestDeleterRunFailed(t *testing.T) {
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingCountByDeviceName", "Device").Return(uint32(5), nil)
+	dbClientMock.On("DeleteEventsByDeviceNameChunk", "Device", 2).Return(uint32(2), uint32(2), nil).Once()
+	dbClientMock.On("DeleteEventsByDeviceNameChunk", "Device", 2).
+		Return(uint32(0), uint32(0), errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil)).Once()
+	dbClientMock.On("DeleteEventsByDeviceNameChunk", "Device", 2).Return(uint32(0), uint32(0), nil)
+	deleter, err := NewDeleter(testInfo(), dbClientMock, logger.NewMockClient())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var wg sync.WaitGroup
+	deleter.Run(ctx, &wg)
+
+	job, edgeXerr := deleter.Start("Device")
+	require.NoError(t, edgeXerr)
+	job = waitFor(t, deleter, job.Id, models.ReadingDeletionFailed)
+	assert.Equal(t, uint32(2), job.Deleted)
+	assert.NotEmpty(t, job.Message)
+
+	// a new job is started once the previous one failed
+	again, edgeXerr := deleter.Start("Device")
+	require.NoError(t, edgeXerr)
+	assert.NotEqual(t, job.Id, again.Id)
+}
@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeviceStateResponse defines the Response Content for GET device state DTOs, the latest reading of each resource of
+// the device.
+type DeviceStateResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceName          string             `json:"deviceName"`
+	Readings            []dtos.BaseReading `json:"readings"`
+}
+
+// NewDeviceStateResponse creates new DeviceStateResponse with all fields set appropriately
+func NewDeviceStateResponse(requestId string, message string, statusCode int, deviceName string, readings []dtos.BaseReading) DeviceStateResponse {
+	return DeviceStateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeviceName:   deviceName,
+		Readings:     readings,
+	}
+}
@@ -0,0 +1,33 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// EventIdsRequest defines the Request Content for POST event ids DTO, the ids of the events to query in one call.
+type EventIdsRequest struct {
+	Ids []string `json:"ids"`
+}
+
+// EventsByIdsResponse defines the Response Content for POST event ids DTOs, the events found in the order of the ids
+// and the ids of the events which don't exist.
+type EventsByIdsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Events              []dtos.Event `json:"events"`
+	NotFound            []string     `json:"notFound,omitempty"`
+}
+
+// NewEventsByIdsResponse creates new EventsByIdsResponse with all fields set appropriately
+func NewEventsByIdsResponse(requestId string, message string, statusCode int, events []dtos.Event, notFound []string) EventsByIdsResponse {
+	return EventsByIdsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Events:       events,
+		NotFound:     notFound,
+	}
+}
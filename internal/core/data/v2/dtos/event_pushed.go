@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// EventsPushedResponse defines the Response Content for PUT pushed event ids DTOs, the ids of the events which don't
+// exist and couldn't be marked as pushed.
+type EventsPushedResponse struct {
+	common.BaseResponse `json:",inline"`
+	NotFound            []string `json:"notFound,omitempty"`
+}
+
+// NewEventsPushedResponse creates new EventsPushedResponse with all fields set appropriately
+func NewEventsPushedResponse(requestId string, message string, statusCode int, notFound []string) EventsPushedResponse {
+	return EventsPushedResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		NotFound:     notFound,
+	}
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// CollectionMemoryUsage contains the approximate memory used by a single collection
+type CollectionMemoryUsage struct {
+	Name           string `json:"name"`
+	Count          int64  `json:"count"`
+	Sampled        int    `json:"sampled"`
+	IndexBytes     int64  `json:"indexBytes"`
+	AverageBytes   int64  `json:"averageBytes"`
+	EstimatedBytes int64  `json:"estimatedBytes"`
+}
+
+// MemoryUsageResponse defines the Response Content for GET memory usage DTOs.
+type MemoryUsageResponse struct {
+	common.BaseResponse `json:",inline"`
+	UsedMemory          int64                   `json:"usedMemory"`
+	MaxMemory           int64                   `json:"maxMemory"`
+	Collections         []CollectionMemoryUsage `json:"collections"`
+}
+
+// NewMemoryUsageResponse creates new MemoryUsageResponse with all fields set appropriately
+func NewMemoryUsageResponse(requestId string, message string, statusCode int, usage pkgModels.MemoryUsage) MemoryUsageResponse {
+	collections := make([]CollectionMemoryUsage, len(usage.Collections))
+	for i, c := range usage.Collections {
+		collections[i] = CollectionMemoryUsage{
+			Name:           c.Name,
+			Count:          c.Count,
+			Sampled:        c.Sampled,
+			IndexBytes:     c.IndexBytes,
+			AverageBytes:   c.AverageBytes,
+			EstimatedBytes: c.EstimatedBytes,
+		}
+	}
+	return MemoryUsageResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		UsedMemory:   usage.UsedMemory,
+		MaxMemory:    usage.MaxMemory,
+		Collections:  collections,
+	}
+}
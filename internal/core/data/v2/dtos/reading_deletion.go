@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"math"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ReadingDeletionJob contains the progress of the background deletion of the events and readings of a device, Progress
+// being the percentage of the readings deleted
+type ReadingDeletionJob struct {
+	Id         string  `json:"id"`
+	DeviceName string  `json:"deviceName"`
+	Status     string  `json:"status"`
+	Total      uint32  `json:"total"`
+	Deleted    uint32  `json:"deleted"`
+	Events     uint32  `json:"events"`
+	Progress   float64 `json:"progress"`
+	Created    int64   `json:"created"`
+	Modified   int64   `json:"modified"`
+	Message    string  `json:"message,omitempty"`
+}
+
+// ReadingDeletionJobResponse defines the Response Content for a reading deletion job
+type ReadingDeletionJobResponse struct {
+	common.BaseResponse `json:",inline"`
+	Job                 ReadingDeletionJob `json:"job"`
+}
+
+// NewReadingDeletionJobResponse creates new ReadingDeletionJobResponse with all fields set appropriately
+func NewReadingDeletionJobResponse(requestId string, message string, statusCode int, job ReadingDeletionJob) ReadingDeletionJobResponse {
+	return ReadingDeletionJobResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Job:          job,
+	}
+}
+
+// FromReadingDeletionJobModelToDTO transforms the ReadingDeletionJob model to the ReadingDeletionJob DTO
+func FromReadingDeletionJobModelToDTO(job models.ReadingDeletionJob) ReadingDeletionJob {
+	var progress float64
+	switch {
+	case job.Status == models.ReadingDeletionCompleted:
+		progress = 100
+	case job.Total > 0:
+		progress = math.Min(float64(job.Deleted)*100/float64(job.Total), 100)
+	}
+	return ReadingDeletionJob{
+		Id:         job.Id,
+		DeviceName: job.DeviceName,
+		Status:     job.Status,
+		Total:      job.Total,
+		Deleted:    job.Deleted,
+		Events:     job.Events,
+		Progress:   progress,
+		Created:    job.Created,
+		Modified:   job.Modified,
+		Message:    job.Message,
+	}
+}
@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ReadingGap contains a time window in which a device resource produced fewer readings than expected
+type ReadingGap struct {
+	Start        int64               `json:"start"`
+	End          int64               `json:"end"`
+	Missing      int64               `json:"missing"`
+	Interpolated []InterpolatedValue `json:"interpolated,omitempty"`
+}
+
+// InterpolatedValue contains the value of a device resource interpolated at a timestamp without reading
+type InterpolatedValue struct {
+	Timestamp int64  `json:"timestamp"`
+	Value     string `json:"value"`
+}
+
+// ReadingGapsResponse reports the gaps of the readings of a device resource, ExpectedInterval is the cadence in
+// milliseconds the gaps were detected against
+type ReadingGapsResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceName          string       `json:"deviceName"`
+	ResourceName        string       `json:"resourceName"`
+	ExpectedInterval    int64        `json:"expectedInterval"`
+	Gaps                []ReadingGap `json:"gaps"`
+}
+
+func NewReadingGapsResponse(requestId string, message string, statusCode int, deviceName string, resourceName string, expectedInterval int64, gaps []ReadingGap) ReadingGapsResponse {
+	return ReadingGapsResponse{
+		BaseResponse:     common.NewBaseResponse(requestId, message, statusCode),
+		DeviceName:       deviceName,
+		ResourceName:     resourceName,
+		ExpectedInterval: expectedInterval,
+		Gaps:             gaps,
+	}
+}
+
+func FromReadingGapModelsToDTOs(gaps []models.ReadingGap) []ReadingGap {
+	dtos := make([]ReadingGap, len(gaps))
+	for i, g := range gaps {
+		dtos[i] = ReadingGap{
+			Start:   g.Start,
+			End:     g.End,
+			Missing: g.Missing,
+		}
+		for _, v := range g.Interpolated {
+			dtos[i].Interpolated = append(dtos[i].Interpolated, InterpolatedValue{Timestamp: v.Timestamp, Value: v.Value})
+		}
+	}
+	return dtos
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ReadingStats contains the reading statistics of a single device or resource
+type ReadingStats struct {
+	Name         string `json:"name"`
+	Count        uint64 `json:"count"`
+	Bytes        uint64 `json:"bytes"`
+	FirstReading int64  `json:"firstReading,omitempty"`
+	LastReading  int64  `json:"lastReading,omitempty"`
+}
+
+// ReadingStatsResponse defines the Response Content for GET reading stats DTOs.
+type ReadingStatsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Devices             []ReadingStats `json:"devices"`
+	Resources           []ReadingStats `json:"resources"`
+}
+
+// NewReadingStatsResponse creates new ReadingStatsResponse with all fields set appropriately
+func NewReadingStatsResponse(requestId string, message string, statusCode int, devices []ReadingStats, resources []ReadingStats) ReadingStatsResponse {
+	return ReadingStatsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Devices:      devices,
+		Resources:    resources,
+	}
+}
+
+// FromReadingStatsModelsToDTOs transforms the ReadingStats models to the ReadingStats DTOs
+func FromReadingStatsModelsToDTOs(stats []pkgModels.ReadingStats) []ReadingStats {
+	dtos := make([]ReadingStats, len(stats))
+	for i, s := range stats {
+		dtos[i] = ReadingStats{
+			Name:         s.Name,
+			Count:        s.Count,
+			Bytes:        s.Bytes,
+			FirstReading: s.FirstReading,
+			LastReading:  s.LastReading,
+		}
+	}
+	return dtos
+}
@@ -6,6 +6,7 @@
 package interfaces
 
 import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 )
@@ -14,19 +15,34 @@ type DBClient interface {
 	CloseSession()
 
 	AddEvent(e model.Event) (model.Event, errors.EdgeX)
+	AddEvents(events []model.Event) ([]model.Event, errors.EdgeX)
 	EventById(id string) (model.Event, errors.EdgeX)
 	DeleteEventById(id string) errors.EdgeX
 	EventTotalCount() (uint32, errors.EdgeX)
 	EventCountByDeviceName(deviceName string) (uint32, errors.EdgeX)
 	AllEvents(offset int, limit int) ([]model.Event, errors.EdgeX)
 	EventsByDeviceName(offset int, limit int, name string) ([]model.Event, errors.EdgeX)
+	EventsByAssetId(offset int, limit int, assetId string) ([]model.Event, errors.EdgeX)
+	EventsByIndex(name string, value string, offset int, limit int) ([]model.Event, errors.EdgeX)
 	DeleteEventsByDeviceName(deviceName string) errors.EdgeX
+	DeleteEventsByDeviceNameChunk(deviceName string, limit int) (uint32, uint32, errors.EdgeX)
 	EventsByTimeRange(start int, end int, offset int, limit int) ([]model.Event, errors.EdgeX)
+	EventsByIds(ids []string) ([]model.Event, errors.EdgeX)
+	MarkEventsPushed(consumer string, ids []string) ([]string, errors.EdgeX)
+	UnpushedEvents(consumer string, offset int, limit int) ([]model.Event, errors.EdgeX)
+	DeleteEventsPushed(consumer string) errors.EdgeX
 	DeleteEventsByAge(age int64) errors.EdgeX
 	ReadingTotalCount() (uint32, errors.EdgeX)
 	AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByTimeRange(start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
+	ScanReadingsByTimeRange(start int, end int, deviceName string, batchSize int, fn func(readings []model.Reading) errors.EdgeX) errors.EdgeX
 	ReadingsByResourceName(offset int, limit int, resourceName string) ([]model.Reading, errors.EdgeX)
 	ReadingsByDeviceName(offset int, limit int, name string) ([]model.Reading, errors.EdgeX)
+	ReadingsByDeviceResourceAndTimeRange(deviceName string, resourceName string, start int, end int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX)
+	ReadingStatsByDeviceName() ([]pkgModels.ReadingStats, errors.EdgeX)
+	ReadingStatsByResourceName() ([]pkgModels.ReadingStats, errors.EdgeX)
+	UpdateDeviceState(readings []model.Reading) errors.EdgeX
+	DeviceState(deviceName string) ([]model.Reading, errors.EdgeX)
+	MemoryUsage(collections []string, samples int) (pkgModels.MemoryUsage, errors.EdgeX)
 }
@@ -5,6 +5,8 @@ package mocks
 import (
 	errors "github.com/edgexfoundry/go-mod-core-contracts/errors"
 
+	pkgmodels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
@@ -38,6 +40,31 @@ func (_m *DBClient) AddEvent(e models.Event) (models.Event, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddEvents provides a mock function with given fields: events
+func (_m *DBClient) AddEvents(events []models.Event) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(events)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func([]models.Event) []models.Event); ok {
+		r0 = rf(events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func([]models.Event) errors.EdgeX); ok {
+		r1 = rf(events)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllEvents provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -141,6 +168,77 @@ func (_m *DBClient) DeleteEventsByDeviceName(deviceName string) errors.EdgeX {
 	return r0
 }
 
+// DeleteEventsByDeviceNameChunk provides a mock function with given fields: deviceName, limit
+func (_m *DBClient) DeleteEventsByDeviceNameChunk(deviceName string, limit int) (uint32, uint32, errors.EdgeX) {
+	ret := _m.Called(deviceName, limit)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string, int) uint32); ok {
+		r0 = rf(deviceName, limit)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(string, int) uint32); ok {
+		r1 = rf(deviceName, limit)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(string, int) errors.EdgeX); ok {
+		r2 = rf(deviceName, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// DeleteEventsPushed provides a mock function with given fields: consumer
+func (_m *DBClient) DeleteEventsPushed(consumer string) errors.EdgeX {
+	ret := _m.Called(consumer)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(consumer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeviceState provides a mock function with given fields: deviceName
+func (_m *DBClient) DeviceState(deviceName string) ([]models.Reading, errors.EdgeX) {
+	ret := _m.Called(deviceName)
+
+	var r0 []models.Reading
+	if rf, ok := ret.Get(0).(func(string) []models.Reading); ok {
+		r0 = rf(deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(deviceName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventById provides a mock function with given fields: id
 func (_m *DBClient) EventById(id string) (models.Event, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -210,6 +308,31 @@ func (_m *DBClient) EventTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// EventsByAssetId provides a mock function with given fields: offset, limit, assetId
+func (_m *DBClient) EventsByAssetId(offset int, limit int, assetId string) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(offset, limit, assetId)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(int, int, string) []models.Event); ok {
+		r0 = rf(offset, limit, assetId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, assetId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventsByDeviceName provides a mock function with given fields: offset, limit, name
 func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
@@ -235,6 +358,56 @@ func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]mo
 	return r0, r1
 }
 
+// EventsByIds provides a mock function with given fields: ids
+func (_m *DBClient) EventsByIds(ids []string) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(ids)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func([]string) []models.Event); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func([]string) errors.EdgeX); ok {
+		r1 = rf(ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// EventsByIndex provides a mock function with given fields: name, value, offset, limit
+func (_m *DBClient) EventsByIndex(name string, value string, offset int, limit int) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(name, value, offset, limit)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []models.Event); ok {
+		r0 = rf(name, value, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(name, value, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // EventsByTimeRange provides a mock function with given fields: start, end, offset, limit
 func (_m *DBClient) EventsByTimeRange(start int, end int, offset int, limit int) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(start, end, offset, limit)
@@ -260,6 +433,54 @@ func (_m *DBClient) EventsByTimeRange(start int, end int, offset int, limit int)
 	return r0, r1
 }
 
+// MarkEventsPushed provides a mock function with given fields: consumer, ids
+func (_m *DBClient) MarkEventsPushed(consumer string, ids []string) ([]string, errors.EdgeX) {
+	ret := _m.Called(consumer, ids)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, []string) []string); ok {
+		r0 = rf(consumer, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, []string) errors.EdgeX); ok {
+		r1 = rf(consumer, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// MemoryUsage provides a mock function with given fields: collections, samples
+func (_m *DBClient) MemoryUsage(collections []string, samples int) (pkgmodels.MemoryUsage, errors.EdgeX) {
+	ret := _m.Called(collections, samples)
+
+	var r0 pkgmodels.MemoryUsage
+	if rf, ok := ret.Get(0).(func([]string, int) pkgmodels.MemoryUsage); ok {
+		r0 = rf(collections, samples)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.MemoryUsage)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func([]string, int) errors.EdgeX); ok {
+		r1 = rf(collections, samples)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(deviceName)
@@ -283,6 +504,56 @@ func (_m *DBClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.
 	return r0, r1
 }
 
+// ReadingStatsByDeviceName provides a mock function with given fields:
+func (_m *DBClient) ReadingStatsByDeviceName() ([]pkgmodels.ReadingStats, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 []pkgmodels.ReadingStats
+	if rf, ok := ret.Get(0).(func() []pkgmodels.ReadingStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.ReadingStats)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReadingStatsByResourceName provides a mock function with given fields:
+func (_m *DBClient) ReadingStatsByResourceName() ([]pkgmodels.ReadingStats, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 []pkgmodels.ReadingStats
+	if rf, ok := ret.Get(0).(func() []pkgmodels.ReadingStats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.ReadingStats)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingTotalCount provides a mock function with given fields:
 func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -331,6 +602,31 @@ func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]
 	return r0, r1
 }
 
+// ReadingsByDeviceResourceAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end
+func (_m *DBClient) ReadingsByDeviceResourceAndTimeRange(deviceName string, resourceName string, start int, end int) ([]models.Reading, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end)
+
+	var r0 []models.Reading
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []models.Reading); ok {
+		r0 = rf(deviceName, resourceName, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingsByResourceName provides a mock function with given fields: offset, limit, resourceName
 func (_m *DBClient) ReadingsByResourceName(offset int, limit int, resourceName string) ([]models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit, resourceName)
@@ -380,3 +676,60 @@ func (_m *DBClient) ReadingsByTimeRange(start int, end int, offset int, limit in
 
 	return r0, r1
 }
+
+// ScanReadingsByTimeRange provides a mock function with given fields: start, end, deviceName, batchSize, fn
+func (_m *DBClient) ScanReadingsByTimeRange(start int, end int, deviceName string, batchSize int, fn func([]models.Reading) errors.EdgeX) errors.EdgeX {
+	ret := _m.Called(start, end, deviceName, batchSize, fn)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int, string, int, func([]models.Reading) errors.EdgeX) errors.EdgeX); ok {
+		r0 = rf(start, end, deviceName, batchSize, fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UnpushedEvents provides a mock function with given fields: consumer, offset, limit
+func (_m *DBClient) UnpushedEvents(consumer string, offset int, limit int) ([]models.Event, errors.EdgeX) {
+	ret := _m.Called(consumer, offset, limit)
+
+	var r0 []models.Event
+	if rf, ok := ret.Get(0).(func(string, int, int) []models.Event); ok {
+		r0 = rf(consumer, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int) errors.EdgeX); ok {
+		r1 = rf(consumer, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateDeviceState provides a mock function with given fields: readings
+func (_m *DBClient) UpdateDeviceState(readings []models.Reading) errors.EdgeX {
+	ret := _m.Called(readings)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]models.Reading) errors.EdgeX); ok {
+		r0 = rf(readings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
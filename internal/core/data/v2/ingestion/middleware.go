@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ingestion runs the middleware chain V2 events pass through before core-data persists and publishes them.
+// Custom builds register their middleware by name from an init function, e.g. a unit normalization in the Transform
+// stage, and enable it by listing the name in the Writable.Ingestion configuration of the stage:
+//
+//	func init() {
+//		ingestion.Register(ingestion.Transform, "fahrenheit-to-celsius", toCelsius)
+//	}
+package ingestion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// Stage is a step of the ingestion path
+type Stage string
+
+const (
+	// Validate middleware reject events core-data must not accept
+	Validate Stage = "Validate"
+	// Enrich middleware add information to events, e.g. tags
+	Enrich Stage = "Enrich"
+	// Transform middleware change the readings of events, e.g. normalize units or scrub personal data
+	Transform Stage = "Transform"
+)
+
+// Stages lists the stages in the order events pass through them
+var Stages = []Stage{Validate, Enrich, Transform}
+
+// Middleware processes an event on its way to be persisted and published. It returns the event passed to the next
+// middleware, or an error rejecting the event.
+type Middleware func(e models.Event, ctx context.Context, dic *di.Container) (models.Event, errors.EdgeX)
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[Stage]map[string]Middleware)
+)
+
+// Register makes the middleware available to the stage by name. It panics when the stage is unknown or the name is
+// already registered to the stage, since registration happens from init functions.
+func Register(stage Stage, name string, m Middleware) {
+	if !knownStage(stage) {
+		panic(fmt.Sprintf("ingestion middleware %s registered to unknown stage %s", name, stage))
+	}
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if registry[stage] == nil {
+		registry[stage] = make(map[string]Middleware)
+	}
+	if _, ok := registry[stage][name]; ok {
+		panic(fmt.Sprintf("ingestion middleware %s registered twice to stage %s", name, stage))
+	}
+	registry[stage][name] = m
+}
+
+// Chain returns the middleware registered to the stage by the names, in order
+func Chain(stage Stage, names []string) ([]Middleware, errors.EdgeX) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	chain := make([]Middleware, len(names))
+	for i, name := range names {
+		m, ok := registry[stage][name]
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError,
+				fmt.Sprintf("ingestion middleware %s is not registered to stage %s", name, stage), nil)
+		}
+		chain[i] = m
+	}
+	return chain, nil
+}
+
+// Process passes the event through the middleware configured for every stage and returns the processed event
+func Process(e models.Event, ctx context.Context, dic *di.Container) (models.Event, errors.EdgeX) {
+	for _, stage := range Stages {
+		chain, err := Chain(stage, configuredNames(stage, dic))
+		if err != nil {
+			return e, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, m := range chain {
+			e, err = m(e, ctx, dic)
+			if err != nil {
+				return e, errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+	}
+	return e, nil
+}
+
+// ValidateConfiguration checks every middleware configured is registered to its stage
+func ValidateConfiguration(dic *di.Container) errors.EdgeX {
+	for _, stage := range Stages {
+		if _, err := Chain(stage, configuredNames(stage, dic)); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+func configuredNames(stage Stage, dic *di.Container) []string {
+	ingestion := dataContainer.ConfigurationFrom(dic.Get).Writable.Ingestion
+	switch stage {
+	case Validate:
+		return ingestion.Validate
+	case Enrich:
+		return ingestion.Enrich
+	case Transform:
+		return ingestion.Transform
+	}
+	return nil
+}
+
+func knownStage(stage Stage) bool {
+	for _, s := range Stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingestion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	Register(Validate, "reject-unknown", func(e models.Event, _ context.Context, _ *di.Container) (models.Event, errors.EdgeX) {
+		if e.DeviceName == "unknown" {
+			return e, errors.NewCommonEdgeX(errors.KindContractInvalid, "unknown device", nil)
+		}
+		return e, nil
+	})
+	Register(Enrich, "tag-site", func(e models.Event, _ context.Context, _ *di.Container) (models.Event, errors.EdgeX) {
+		e.Tags = map[string]string{"site": "plant-1"}
+		return e, nil
+	})
+	Register(Transform, "append-origin", func(e models.Event, _ context.Context, _ *di.Container) (models.Event, errors.EdgeX) {
+		e.Origin = e.Origin*10 + 1
+		return e, nil
+	})
+	Register(Transform, "append-two", func(e models.Event, _ context.Context, _ *di.Container) (models.Event, errors.EdgeX) {
+		e.Origin = e.Origin*10 + 2
+		return e, nil
+	})
+}
+
+func newDic(ingestion config.IngestionInfo) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		dataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Writable: config.WritableInfo{Ingestion: ingestion}}
+		},
+	})
+}
+
+func TestProcess(t *testing.T) {
+	dic := newDic(config.IngestionInfo{
+		Validate:  []string{"reject-unknown"},
+		Enrich:    []string{"tag-site"},
+		Transform: []string{"append-two", "append-origin"},
+	})
+
+	e, err := Process(models.Event{DeviceName: "thermostat"}, context.Background(), dic)
+	require.NoError(t, err)
+	assert.Equal(t, "plant-1", e.Tags["site"])
+	assert.Equal(t, int64(21), e.Origin, "transform middleware didn't run in the configured order")
+
+	_, err = Process(models.Event{DeviceName: "unknown"}, context.Background(), dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestProcessWithoutMiddleware(t *testing.T) {
+	e, err := Process(models.Event{DeviceName: "thermostat", Origin: 5}, context.Background(), newDic(config.IngestionInfo{}))
+	require.NoError(t, err)
+	assert.Equal(t, models.Event{DeviceName: "thermostat", Origin: 5}, e)
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	assert.NoError(t, ValidateConfiguration(newDic(config.IngestionInfo{Enrich: []string{"tag-site"}})))
+	// registered, but to another stage
+	assert.Error(t, ValidateConfiguration(newDic(config.IngestionInfo{Validate: []string{"tag-site"}})))
+	assert.Error(t, ValidateConfiguration(newDic(config.IngestionInfo{Transform: []string{"scrub-pii"}})))
+}
+
+func TestRegisterTwice(t *testing.T) {
+	assert.Panics(t, func() {
+		Register(Enrich, "tag-site", func(e models.Event, _ context.Context, _ *di.Container) (models.Event, errors.EdgeX) {
+			return e, nil
+		})
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"io"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	dto "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 )
@@ -16,6 +18,7 @@ import (
 // EventReader unmarshals a request body into an Event type
 type EventReader interface {
 	ReadAddEventRequest(reader io.Reader) ([]dto.AddEventRequest, errors.EdgeX)
+	ReadEventIdsRequest(reader io.Reader) (dtos.EventIdsRequest, errors.EdgeX)
 }
 
 // NewRequestReader returns a BodyReader capable of processing the request body
@@ -40,3 +43,13 @@ func (jsonEventReader) ReadAddEventRequest(reader io.Reader) ([]dto.AddEventRequ
 	}
 	return addEvents, nil
 }
+
+// ReadEventIdsRequest reads and converts the request's JSON data into the ids of the events to query
+func (jsonEventReader) ReadEventIdsRequest(reader io.Reader) (dtos.EventIdsRequest, errors.EdgeX) {
+	var request dtos.EventIdsRequest
+	err := json.NewDecoder(reader).Decode(&request)
+	if err != nil {
+		return request, errors.NewCommonEdgeX(errors.KindContractInvalid, "event ids json decoding failed", err)
+	}
+	return request, nil
+}
@@ -39,6 +39,10 @@ func NewMockDIC() *di.Container {
 				Service: bootstrapConfig.ServiceInfo{
 					MaxResultCount: 20,
 				},
+				MemoryUsage: config.MemoryUsageInfo{
+					Collections: []string{"cd|evt", "cd|rd"},
+					Samples:     10,
+				},
 			}
 		},
 		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
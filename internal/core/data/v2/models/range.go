@@ -0,0 +1,10 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// OutOfRangeTag is the event tag listing the resources, comma separated, whose reading is outside the minimum and
+// maximum of the device resource in the profile of the originating device
+const OutOfRangeTag = "outOfRange"
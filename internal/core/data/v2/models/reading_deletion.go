@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// Statuses of a reading deletion job
+const (
+	ReadingDeletionPending   = "pending"
+	ReadingDeletionRunning   = "running"
+	ReadingDeletionCompleted = "completed"
+	ReadingDeletionFailed    = "failed"
+)
+
+// ReadingDeletionJob is the background deletion of the events and readings of a device, chunk by chunk
+type ReadingDeletionJob struct {
+	Id         string
+	DeviceName string
+	Status     string
+	// Total is the number of readings of the device when the job was started, Deleted the number of readings deleted
+	// so far, which exceeds Total when the device kept sending readings
+	Total   uint32
+	Deleted uint32
+	// Events is the number of events deleted so far
+	Events   uint32
+	Created  int64
+	Modified int64
+	// Message is why the job failed
+	Message string
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ReadingGap is a time window in which a device resource produced fewer readings than the cadence of its autoEvent
+// requires.
+type ReadingGap struct {
+	// Start and End are the Created timestamps of the readings around the gap, or the bounds of the queried time range
+	Start int64
+	End   int64
+	// Missing is the number of readings expected within the gap
+	Missing int64
+	// Interpolated are the values interpolated at the timestamps the missing readings were expected at, if requested
+	Interpolated []InterpolatedValue
+}
+
+// InterpolatedValue is the value of a device resource interpolated at a timestamp without reading
+type InterpolatedValue struct {
+	Timestamp int64
+	Value     string
+}
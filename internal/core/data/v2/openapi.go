@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"net/http"
+
+	dataDTOs "github.com/edgexfoundry/edgex-go/internal/core/data/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/openapi"
+
+	v2Constant "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+)
+
+// contracts holds the DTOs exchanged by the core-data V2 routes, documented in the OpenAPI document
+var contracts = openapi.Contracts{
+	// Events
+	{Method: http.MethodPost, Path: v2Constant.ApiEventRoute}: {
+		Request:    []requests.AddEventRequest{},
+		Response:   []common.BaseWithIdResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodGet, Path: v2Constant.ApiEventIdRoute}:                {Response: responses.EventResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiEventIdRoute}:             {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiEventCountRoute}:             {Response: common.CountResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiEventCountByDeviceNameRoute}: {Response: common.CountResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiAllEventRoute}:               {Response: responses.MultiEventsResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiEventByDeviceNameRoute}:      {Response: responses.MultiEventsResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiEventByTimeRangeRoute}:       {Response: responses.MultiEventsResponse{}},
+	{Method: http.MethodGet, Path: ApiEventByAssetIdRoute}:                    {Response: responses.MultiEventsResponse{}},
+	{Method: http.MethodGet, Path: ApiEventByIndexRoute}:                      {Response: responses.MultiEventsResponse{}},
+	{Method: http.MethodPost, Path: ApiEventByIdsRoute}:                       {Request: dataDTOs.EventIdsRequest{}, Response: dataDTOs.EventsByIdsResponse{}},
+	{Method: http.MethodPut, Path: ApiEventPushedRoute}:                       {Request: dataDTOs.EventIdsRequest{}, Response: dataDTOs.EventsPushedResponse{}},
+	{Method: http.MethodDelete, Path: ApiEventPushedRoute}:                    {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: ApiEventUnpushedRoute}:                     {Response: responses.MultiEventsResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiEventByDeviceNameRoute}: {
+		Response:   common.BaseResponse{},
+		StatusCode: http.StatusAccepted,
+	},
+	{Method: http.MethodDelete, Path: v2Constant.ApiEventByAgeRoute}: {
+		Response:   common.BaseResponse{},
+		StatusCode: http.StatusAccepted,
+	},
+
+	// Readings
+	{Method: http.MethodGet, Path: v2Constant.ApiReadingCountRoute}:             {Response: common.CountResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiReadingCountByDeviceNameRoute}: {Response: common.CountResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiAllReadingRoute}:               {Response: responses.MultiReadingsResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiReadingByDeviceNameRoute}:      {Response: responses.MultiReadingsResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiReadingByTimeRangeRoute}:       {Response: responses.MultiReadingsResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiReadingByResourceNameRoute}:    {Response: responses.MultiReadingsResponse{}},
+	{Method: http.MethodGet, Path: ApiReadingStatsRoute}:                        {Response: dataDTOs.ReadingStatsResponse{}},
+	{Method: http.MethodGet, Path: ApiReadingStreamRoute}:                       {Response: []dtos.BaseReading{}},
+	{Method: http.MethodGet, Path: ApiReadingDeletionRoute}:                     {Response: dataDTOs.ReadingDeletionJobResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiReadingByDeviceNameRoute}: {
+		Response:   dataDTOs.ReadingDeletionJobResponse{},
+		StatusCode: http.StatusAccepted,
+	},
+
+	// Devices
+	{Method: http.MethodGet, Path: ApiDeviceStateRoute}: {Response: dataDTOs.DeviceStateResponse{}},
+
+	// Admin
+	{Method: http.MethodGet, Path: ApiMemoryUsageRoute}: {Response: dataDTOs.MemoryUsageResponse{}},
+}
@@ -3,16 +3,45 @@ package v2
 import (
 	"net/http"
 
+	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dataController "github.com/edgexfoundry/edgex-go/internal/core/data/v2/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/v2/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/openapi"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	v2Constant "github.com/edgexfoundry/go-mod-core-contracts/v2"
 
 	"github.com/gorilla/mux"
 )
 
+const (
+	// ApiReadingStatsRoute is the route of the per device and per resource reading statistics
+	ApiReadingStatsRoute = v2Constant.ApiReadingRoute + "/stats"
+	// ApiReadingGapsRoute is the route detecting the gaps in the readings of a device resource
+	ApiReadingGapsRoute = v2Constant.ApiReadingRoute + "/gaps"
+	// ApiReadingStreamRoute is the route streaming the readings created within a time range
+	ApiReadingStreamRoute = v2Constant.ApiReadingRoute + "/stream/" + v2Constant.Start + "/{" + v2Constant.Start + "}/" + v2Constant.End + "/{" + v2Constant.End + "}"
+	// ApiReadingDeletionRoute is the route of the progress of a job deleting the readings of a device
+	ApiReadingDeletionRoute = v2Constant.ApiReadingRoute + "/deletion/{" + v2Constant.Id + "}"
+	// ApiEventByAssetIdRoute is the route of the events of all devices attached to an asset
+	ApiEventByAssetIdRoute = v2Constant.ApiEventRoute + "/asset/{" + v2Constant.Id + "}"
+	// ApiEventByIndexRoute is the route of the events holding a value of a secondary index declared in the configuration
+	ApiEventByIndexRoute = v2Constant.ApiEventRoute + "/index/{" + dataController.IndexVar + "}/{" + dataController.IndexValueVar + "}"
+	// ApiEventByIdsRoute is the route of the events of a list of ids, posted in the request body
+	ApiEventByIdsRoute = v2Constant.ApiEventRoute + "/ids"
+	// ApiEventPushedRoute is the route of the events a consumer group marks as pushed once it processed them
+	ApiEventPushedRoute = v2Constant.ApiEventRoute + "/consumer/{" + dataController.ConsumerVar + "}/pushed"
+	// ApiEventUnpushedRoute is the route of the events a consumer group didn't mark as pushed yet
+	ApiEventUnpushedRoute = v2Constant.ApiEventRoute + "/consumer/{" + dataController.ConsumerVar + "}/unpushed"
+	// ApiMemoryUsageRoute is the route reporting the approximate database memory used per collection
+	ApiMemoryUsageRoute = v2Constant.ApiBase + "/admin/memory"
+	// ApiDeviceStateRoute is the route of the latest reading of each resource of a device
+	ApiDeviceStateRoute = v2Constant.ApiDeviceByNameRoute + "/state"
+)
+
 func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	// v2 API routes
 	// Common
@@ -21,6 +50,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiVersionRoute, cc.Version).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiConfigRoute, cc.Config).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiMetricsRoute, cc.Metrics).Methods(http.MethodGet)
+	r.HandleFunc(commonController.ApiReadyRoute, cc.Ready).Methods(http.MethodGet)
 
 	// Events
 	ec := dataController.NewEventController(dic)
@@ -33,6 +63,12 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiEventByDeviceNameRoute, ec.EventsByDeviceName).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiEventByDeviceNameRoute, ec.DeleteEventsByDeviceName).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiEventByTimeRangeRoute, ec.EventsByTimeRange).Methods(http.MethodGet)
+	r.HandleFunc(ApiEventByAssetIdRoute, ec.EventsByAssetId).Methods(http.MethodGet)
+	r.HandleFunc(ApiEventByIndexRoute, ec.EventsByIndex).Methods(http.MethodGet)
+	r.HandleFunc(ApiEventByIdsRoute, ec.EventsByIds).Methods(http.MethodPost)
+	r.HandleFunc(ApiEventPushedRoute, ec.MarkEventsPushed).Methods(http.MethodPut)
+	r.HandleFunc(ApiEventPushedRoute, ec.DeleteEventsPushed).Methods(http.MethodDelete)
+	r.HandleFunc(ApiEventUnpushedRoute, ec.UnpushedEvents).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiEventByAgeRoute, ec.DeleteEventsByAge).Methods(http.MethodDelete)
 
 	// Readings
@@ -40,9 +76,28 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiReadingCountRoute, rc.ReadingTotalCount).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiAllReadingRoute, rc.AllReadings).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiReadingByDeviceNameRoute, rc.ReadingsByDeviceName).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiReadingByDeviceNameRoute, rc.DeleteReadingsByDeviceName).Methods(http.MethodDelete)
+	r.HandleFunc(ApiReadingDeletionRoute, rc.ReadingDeletionJob).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiReadingByTimeRangeRoute, rc.ReadingsByTimeRange).Methods(http.MethodGet)
+	r.HandleFunc(ApiReadingStreamRoute, rc.ReadingStreamByTimeRange).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiReadingByResourceNameRoute, rc.ReadingsByResourceName).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiReadingCountByDeviceNameRoute, rc.ReadingCountByDeviceName).Methods(http.MethodGet)
+	r.HandleFunc(ApiReadingStatsRoute, rc.ReadingStats).Methods(http.MethodGet)
+	r.HandleFunc(ApiReadingGapsRoute, rc.ReadingGaps).Methods(http.MethodGet)
+
+	// Devices
+	dc := dataController.NewDeviceController(dic)
+	r.HandleFunc(ApiDeviceStateRoute, dc.DeviceState).Methods(http.MethodGet)
+
+	// Admin
+	ac := dataController.NewAdminController(dic)
+	r.HandleFunc(ApiMemoryUsageRoute, ac.MemoryUsage).Methods(http.MethodGet)
+
+	// Error codes
+	errorcode.LoadRestRoutes(r, dic, dataController.ErrorCodes)
+
+	// OpenAPI
+	openapi.LoadRestRoutes(r, dic, clients.CoreDataServiceKey, contracts, dataContainer.ConfigurationFrom(dic.Get).OpenAPI)
 
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
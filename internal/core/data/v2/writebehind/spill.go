@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package writebehind
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+const spillFileName = "events.jsonl"
+
+// spillFile keeps the events to store later in a file of JSON lines, one event DTO per line. The events spilled
+// before the service stopped are stored after it starts again.
+type spillFile struct {
+	mutex sync.Mutex
+	path  string
+}
+
+func newSpillFile(directory string) (*spillFile, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, err
+	}
+	return &spillFile{path: filepath.Join(directory, spillFileName)}, nil
+}
+
+// append appends the events to the file
+func (f *spillFile) append(events []models.Event) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	for _, e := range events {
+		if err = encoder.Encode(dtos.FromEventModelToDTO(e)); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+	return file.Close()
+}
+
+// take returns the events of the file and empties it, along with the number of lines which couldn't be decoded, i.e.
+// the last line when the service crashed while appending it
+func (f *spillFile) take() (events []models.Event, corrupted int, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	file, err := os.Open(f.path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var request requests.AddEventRequest
+		if err = json.Unmarshal(scanner.Bytes(), &request.Event); err != nil {
+			corrupted++
+			continue
+		}
+		e := requests.AddEventReqToEventModels([]requests.AddEventRequest{request})[0]
+		e.Created = request.Event.Created
+		events = append(events, e)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	return events, corrupted, os.Remove(f.path)
+}
@@ -0,0 +1,267 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package writebehind stores the events accepted by core-data asynchronously, in batches, so that the ingestion of
+// bursts of events isn't slowed down by the database.
+package writebehind
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// The overflow policies applied to the events received while the queue is full
+const (
+	// Block waits for room in the queue, slowing down the ingestion to the pace of the database
+	Block = "block"
+	// Reject rejects the event as the service is unavailable
+	Reject = "reject"
+	// DropOldest drops the oldest queued event to make room
+	DropOldest = "dropOldest"
+	// Spill appends the event to the spill file, stored once the queue is drained
+	Spill = "spill"
+)
+
+// Stats counts the events handled by the writer since the service started. The spilled events are counted again each
+// time they can't be stored.
+type Stats struct {
+	Queued  int `json:"queued"`
+	Written int `json:"written"`
+	Dropped int `json:"dropped"`
+	Spilled int `json:"spilled"`
+}
+
+// Writer queues the events and stores them in batches
+type Writer struct {
+	dbClient  interfaces.DBClient
+	lc        logger.LoggingClient
+	queue     chan models.Event
+	batchSize int
+	interval  time.Duration
+	policy    string
+	spill     *spillFile
+
+	// dropMutex serializes making room in the queue for the DropOldest policy
+	dropMutex  sync.Mutex
+	statsMutex sync.Mutex
+	stats      Stats
+}
+
+// NewWriter returns a writer storing the events with the database client as configured
+func NewWriter(info config.WriteBehindInfo, dbClient interfaces.DBClient, lc logger.LoggingClient) (*Writer, error) {
+	if info.QueueSize <= 0 || info.BatchSize <= 0 {
+		return nil, fmt.Errorf("WriteBehind QueueSize and BatchSize must be positive")
+	}
+	interval, err := time.ParseDuration(info.FlushInterval)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid WriteBehind FlushInterval '%s'", info.FlushInterval)
+	}
+	switch info.OverflowPolicy {
+	case Block, Reject, DropOldest:
+	case Spill:
+		if info.SpillDirectory == "" {
+			return nil, fmt.Errorf("the WriteBehind spill policy requires a SpillDirectory")
+		}
+	default:
+		return nil, fmt.Errorf("invalid WriteBehind OverflowPolicy '%s', one of %s, %s, %s or %s expected",
+			info.OverflowPolicy, Block, Reject, DropOldest, Spill)
+	}
+
+	w := &Writer{
+		dbClient:  dbClient,
+		lc:        lc,
+		queue:     make(chan models.Event, info.QueueSize),
+		batchSize: info.BatchSize,
+		interval:  interval,
+		policy:    info.OverflowPolicy,
+	}
+	if info.SpillDirectory != "" {
+		if w.spill, err = newSpillFile(info.SpillDirectory); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// Enqueue queues the event to be stored, applying the overflow policy when the queue is full
+func (w *Writer) Enqueue(e models.Event) errors.EdgeX {
+	select {
+	case w.queue <- e:
+		w.count(func(s *Stats) { s.Queued++ })
+		return nil
+	default:
+	}
+
+	switch w.policy {
+	case Reject:
+		w.count(func(s *Stats) { s.Dropped++ })
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the write-behind queue is full, retry later", nil)
+	case DropOldest:
+		w.dropMutex.Lock()
+		defer w.dropMutex.Unlock()
+		for {
+			select {
+			case w.queue <- e:
+				w.count(func(s *Stats) { s.Queued++ })
+				return nil
+			default:
+			}
+			select {
+			case dropped := <-w.queue:
+				w.count(func(s *Stats) { s.Dropped++ })
+				w.lc.Warn(fmt.Sprintf("the write-behind queue is full, event %s dropped", dropped.Id))
+			default:
+			}
+		}
+	case Spill:
+		if err := w.spill.append([]models.Event{e}); err != nil {
+			w.count(func(s *Stats) { s.Dropped++ })
+			return errors.NewCommonEdgeX(errors.KindServerError, "the write-behind queue is full and spilling the event failed", err)
+		}
+		w.count(func(s *Stats) { s.Spilled++ })
+		return nil
+	default:
+		w.queue <- e
+		w.count(func(s *Stats) { s.Queued++ })
+		return nil
+	}
+}
+
+// Stats returns the counts of the events handled by the writer
+func (w *Writer) Stats() Stats {
+	w.statsMutex.Lock()
+	defer w.statsMutex.Unlock()
+	return w.stats
+}
+
+func (w *Writer) count(update func(s *Stats)) {
+	w.statsMutex.Lock()
+	defer w.statsMutex.Unlock()
+	update(&w.stats)
+}
+
+// Run stores the queued events until the service is exiting, a batch as soon as it is full and the others every flush
+// interval. The spilled events are stored once the queue is drained. The events still queued are stored before Run
+// returns.
+func (w *Writer) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		batch := make([]models.Event, 0, w.batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				for drained := false; !drained; {
+					select {
+					case e := <-w.queue:
+						batch = append(batch, e)
+					default:
+						drained = true
+					}
+				}
+				for len(batch) > 0 {
+					n := w.batchSize
+					if n > len(batch) {
+						n = len(batch)
+					}
+					w.write(batch[:n])
+					batch = batch[n:]
+				}
+				stats := w.Stats()
+				w.lc.Info(fmt.Sprintf("Write-behind stopped: %d events written, %d dropped, %d spilled",
+					stats.Written, stats.Dropped, stats.Spilled))
+				return
+			case e := <-w.queue:
+				batch = append(batch, e)
+				if len(batch) >= w.batchSize {
+					w.write(batch)
+					batch = batch[:0]
+				}
+			case <-ticker.C:
+				if len(batch) > 0 {
+					w.write(batch)
+					batch = batch[:0]
+				}
+				if len(w.queue) == 0 {
+					w.replaySpill()
+				}
+			}
+		}
+	}()
+}
+
+// write stores the batch, the events which couldn't be stored are spilled when there is a spill directory and dropped
+// otherwise
+func (w *Writer) write(batch []models.Event) {
+	added, edgeXerr := w.dbClient.AddEvents(batch)
+	w.count(func(s *Stats) { s.Written += len(added) })
+	if edgeXerr == nil {
+		return
+	}
+
+	failed := batch[len(added)]
+	remaining := batch[len(added)+1:]
+	if errors.Kind(edgeXerr) == errors.KindDuplicateName || errors.Kind(edgeXerr) == errors.KindInvalidId {
+		// The failed event would never be stored, only the following ones are kept
+		w.lc.Error(fmt.Sprintf("write-behind dropped event %s: %s", failed.Id, edgeXerr.Error()))
+		w.count(func(s *Stats) { s.Dropped++ })
+		if len(remaining) > 0 {
+			w.write(remaining)
+		}
+		return
+	}
+
+	unwritten := batch[len(added):]
+	if w.spill != nil {
+		err := w.spill.append(unwritten)
+		if err == nil {
+			w.count(func(s *Stats) { s.Spilled += len(unwritten) })
+			w.lc.Warn(fmt.Sprintf("write-behind spilled %d events which couldn't be stored: %s", len(unwritten), edgeXerr.Error()))
+			return
+		}
+		w.lc.Error(fmt.Sprintf("write-behind failed to spill the events which couldn't be stored: %s", err.Error()))
+	}
+	w.count(func(s *Stats) { s.Dropped += len(unwritten) })
+	w.lc.Error(fmt.Sprintf("write-behind dropped %d events which couldn't be stored: %s", len(unwritten), edgeXerr.Error()))
+}
+
+// replaySpill stores the spilled events, in batches
+func (w *Writer) replaySpill() {
+	if w.spill == nil {
+		return
+	}
+	events, corrupted, err := w.spill.take()
+	if err != nil {
+		w.lc.Error(fmt.Sprintf("write-behind failed to read the spilled events: %s", err.Error()))
+		return
+	}
+	if corrupted > 0 {
+		w.count(func(s *Stats) { s.Dropped += corrupted })
+		w.lc.Error(fmt.Sprintf("write-behind dropped %d spilled events which couldn't be decoded", corrupted))
+	}
+	if len(events) > 0 {
+		w.lc.Debug(fmt.Sprintf("write-behind storing %d spilled events", len(events)))
+	}
+	for len(events) > 0 {
+		n := w.batchSize
+		if n > len(events) {
+			n = len(events)
+		}
+		w.write(events[:n])
+		events = events[n:]
+	}
+}
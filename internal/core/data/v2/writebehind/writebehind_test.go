@@ -0,0 +1,156 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package writebehind
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/v2/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvents(n int) []models.Event {
+	events := make([]models.Event, n)
+	for i := range events {
+		events[i] = models.Event{
+			Id:          uuid.New().String(),
+			DeviceName:  "Device",
+			ProfileName: "Profile",
+			Created:     int64(i + 1),
+			Tags:        map[string]string{},
+			Readings: []models.Reading{models.SimpleReading{
+				BaseReading: models.BaseReading{DeviceName: "Device", ResourceName: "Temperature", ValueType: "Int32"},
+				Value:       "21",
+			}},
+		}
+	}
+	return events
+}
+
+func testInfo(policy string) config.WriteBehindInfo {
+	return config.WriteBehindInfo{Enabled: true, QueueSize: 2, BatchSize: 2, FlushInterval: "10ms", OverflowPolicy: policy}
+}
+
+func TestNewWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(info *config.WriteBehindInfo)
+		valid  bool
+	}{
+		{"valid", func(info *config.WriteBehindInfo) {}, true},
+		{"no queue", func(info *config.WriteBehindInfo) { info.QueueSize = 0 }, false},
+		{"invalid flush interval", func(info *config.WriteBehindInfo) { info.FlushInterval = "soon" }, false},
+		{"invalid policy", func(info *config.WriteBehindInfo) { info.OverflowPolicy = "ignore" }, false},
+		{"spill without directory", func(info *config.WriteBehindInfo) { info.OverflowPolicy = Spill }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := testInfo(Block)
+			tt.update(&info)
+			_, err := NewWriter(info, &dbMock.DBClient{}, logger.NewMockClient())
+			assert.Equal(t, tt.valid, err == nil)
+		})
+	}
+}
+
+func TestOverflowPolicies(t *testing.T) {
+	events := testEvents(3)
+
+	writer, err := NewWriter(testInfo(Reject), &dbMock.DBClient{}, logger.NewMockClient())
+	require.NoError(t, err)
+	require.NoError(t, writer.Enqueue(events[0]))
+	require.NoError(t, writer.Enqueue(events[1]))
+	edgeXerr := writer.Enqueue(events[2])
+	require.Error(t, edgeXerr)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(edgeXerr))
+
+	writer, err = NewWriter(testInfo(DropOldest), &dbMock.DBClient{}, logger.NewMockClient())
+	require.NoError(t, err)
+	for _, e := range events {
+		require.NoError(t, writer.Enqueue(e))
+	}
+	assert.Equal(t, events[1].Id, (<-writer.queue).Id, "the oldest event is dropped")
+	assert.Equal(t, events[2].Id, (<-writer.queue).Id)
+	assert.Equal(t, Stats{Queued: 3, Dropped: 1}, writer.Stats())
+}
+
+func TestRunWritesBatches(t *testing.T) {
+	events := testEvents(3)
+	dbClient := &dbMock.DBClient{}
+	dbClient.On("AddEvents", events[:2]).Return(events[:2], nil)
+	dbClient.On("AddEvents", events[2:]).Return(events[2:], nil)
+
+	info := testInfo(Block)
+	info.QueueSize = 10
+	writer, err := NewWriter(info, dbClient, logger.NewMockClient())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	writer.Run(ctx, wg)
+	for _, e := range events {
+		require.NoError(t, writer.Enqueue(e))
+	}
+
+	// The full batch is written right away, the other event at the next flush
+	assert.Eventually(t, func() bool { return writer.Stats().Written == 3 }, time.Second, 5*time.Millisecond)
+	cancel()
+	wg.Wait()
+	dbClient.AssertExpectations(t)
+}
+
+func TestSpill(t *testing.T) {
+	events := testEvents(3)
+	dbClient := &dbMock.DBClient{}
+	// The database is unreachable at first, the event following the duplicated one is written
+	dbClient.On("AddEvents", events[:2]).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "unreachable", nil)).Once()
+	dbClient.On("AddEvents", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)).Once()
+	dbClient.On("AddEvents", mock.Anything).Return(events[1:2], nil).Once()
+	dbClient.On("AddEvents", mock.Anything).Return(events[2:], nil).Once()
+
+	info := testInfo(Spill)
+	info.SpillDirectory = t.TempDir()
+	writer, err := NewWriter(info, dbClient, logger.NewMockClient())
+	require.NoError(t, err)
+
+	writer.write(events[:2])
+	require.NoError(t, writer.Enqueue(testEvents(1)[0]))
+	require.NoError(t, writer.Enqueue(testEvents(1)[0]))
+	require.NoError(t, writer.Enqueue(events[2]), "the event is spilled while the queue is full")
+	assert.Equal(t, Stats{Queued: 2, Spilled: 3}, writer.Stats())
+
+	writer.replaySpill()
+	assert.Equal(t, Stats{Queued: 2, Written: 2, Dropped: 1, Spilled: 3}, writer.Stats())
+	spilled, corrupted, err := writer.spill.take()
+	require.NoError(t, err)
+	assert.Empty(t, spilled)
+	assert.Zero(t, corrupted)
+	dbClient.AssertExpectations(t)
+}
+
+func TestSpillFile(t *testing.T) {
+	events := testEvents(2)
+	spill, err := newSpillFile(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, spill.append(events[:1]))
+	require.NoError(t, spill.append(events[1:]))
+
+	taken, corrupted, err := spill.take()
+	require.NoError(t, err)
+	assert.Zero(t, corrupted)
+	assert.Equal(t, events, taken)
+}
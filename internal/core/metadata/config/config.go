@@ -15,6 +15,16 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/shutdown"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/openapi"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
@@ -24,15 +34,127 @@ type ConfigurationStruct struct {
 	Clients       map[string]bootstrapConfig.ClientInfo
 	Databases     map[string]bootstrapConfig.Database
 	Notifications NotificationInfo
+	OpenAPI       openapi.OpenAPIInfo
 	Registry      bootstrapConfig.RegistryInfo
 	Service       bootstrapConfig.ServiceInfo
 	SecretStore   bootstrapConfig.SecretStoreInfo
+
+	// Dependencies declares the dependencies gating the startup and readiness of the service, by name
+	Dependencies map[string]dependency.DependencyInfo
+
+	// ServiceRegistration controls which device services may register and update themselves, and add and update devices
+	ServiceRegistration ServiceRegistrationInfo
+
+	// DatabaseIndexes declares the secondary indexes of the stored devices, i.e. by protocol, by name
+	DatabaseIndexes map[string]db.IndexInfo
+
+	// DatabaseReplication routes the read-only queries to the read replicas declared in the Databases section
+	DatabaseReplication db.ReplicationInfo
+
+	// RequestLimits rejects the request bodies larger than the configured sizes with 413
+	RequestLimits bodylimit.LimitsInfo
+	// TrustedHeaders verifies the claims of the caller forwarded by the API gateway
+	TrustedHeaders claims.TrustedHeadersInfo
+
+	// DeviceMetrics configures the daily activity counters of the devices reported by core-data and core-command
+	DeviceMetrics DeviceMetricsInfo
+
+	// StateHistory keeps the transitions of the admin and operating states of the devices
+	StateHistory StateHistoryInfo
+
+	// Trash keeps the deleted devices and device profiles restorable until they are purged
+	Trash TrashInfo
+
+	// ProtocolSecrets keeps the secret protocol properties of the devices in the secret store
+	ProtocolSecrets ProtocolSecretsInfo
+
+	// ProfileCatalog pulls the device profiles published in a remote catalog, the updates being applied once approved
+	ProfileCatalog ProfileCatalogInfo
+
+	// Standalone resolves core-data and support-notifications from an endpoints file instead of the Clients
+	// configuration or the registry
+	Standalone endpoints.StandaloneInfo
+
+	// Shutdown drains the requests being handled when the service is exiting, before releasing its resources
+	Shutdown shutdown.ShutdownInfo
+
+	// ConfigDrift reports the drift of the running configuration, and reloads the sections drifted in the registry
+	ConfigDrift configdrift.DriftInfo
+}
+
+// DeviceMetricsInfo configures the daily activity counters of the devices
+type DeviceMetricsInfo struct {
+	// Retention is how long the counters of a day are kept, i.e. '720h'
+	Retention string
+}
+
+// StateHistoryInfo configures the history of the state transitions of the devices
+type StateHistoryInfo struct {
+	// Retention is how long the transitions are kept, i.e. '720h'
+	Retention string
+}
+
+// TrashInfo configures the trash the deleted devices and device profiles are moved to
+type TrashInfo struct {
+	// Enabled moves the deleted devices and device profiles to the trash instead of deleting them, including those
+	// deleted in bulk or through the v1 API
+	Enabled bool
+	// RetentionDays is how many days an object stays in the trash before it is purged
+	RetentionDays int
+	// PurgeInterval is how often the trash is checked for objects to purge, i.e. '1h'
+	PurgeInterval string
+}
+
+// ProfileCatalogInfo configures the remote catalog the device profiles are pulled from. The catalog is an index file
+// listing the name, version and path of each device profile, served over HTTP, i.e. by the raw file URLs of a Git
+// hosting service.
+type ProfileCatalogInfo struct {
+	// Url is the URL of the index file of the catalog, the paths of the device profiles are relative to it. The
+	// catalog is disabled when empty.
+	Url string
+	// Interval is how often the catalog is pulled, i.e. '24h'. It is only pulled on demand when empty.
+	Interval string
+	// Timeout is how long fetching a file of the catalog may take, i.e. '30s'
+	Timeout string
+}
+
+// ProtocolSecretsInfo configures the protocol properties of the devices kept in the secret store instead of the device
+type ProtocolSecretsInfo struct {
+	// Enabled stores the secret protocol properties of the devices added or updated through the V2 API in the secret
+	// store, the device only keeps a reference to the secret. Requires security to be enabled.
+	Enabled bool
+	// Properties are the names of the secret protocol properties, case insensitive, i.e. Password, Community
+	Properties []string
+	// RoleClaim is the name of the JWT claim holding the caller's role(s), either a string or an array of strings
+	RoleClaim string
+	// ResolverRole is the role required to resolve the secret protocol properties, any caller when empty
+	ResolverRole string
+}
+
+// ServiceRegistrationInfo configures the one-time registration tokens device services present to register. A device
+// service registered with a token keeps presenting it to update itself and to add and update its devices.
+type ServiceRegistrationInfo struct {
+	// RequireToken rejects the registration and update of device services, and the addition and update of devices,
+	// without a valid token, through both the V1 and V2 APIs
+	RequireToken bool
+	// TokenTTL is how long a minted token can be redeemed when its minter doesn't say, e.g. '24h'
+	TokenTTL string
 }
 
 type WritableInfo struct {
 	LogLevel                        string
 	EnableValueDescriptorManagement bool
-	InsecureSecrets                 bootstrapConfig.InsecureSecrets
+	DiscoverySessionDuration        string
+	// AllowedLabels restricts the labels of devices, device profiles and device services when not empty
+	AllowedLabels []string
+	// StrictReferentialIntegrity rejects with a conflict the devices referencing a missing device profile or device
+	// service, and the deletion of device profiles still referenced by devices
+	StrictReferentialIntegrity bool
+	InsecureSecrets            bootstrapConfig.InsecureSecrets
+	// Compression compresses the responses of the service
+	Compression compression.ResponsesInfo
+	// FeatureFlags enable the experimental behaviors of the service by name, toggled at runtime through the registry
+	FeatureFlags map[string]bool
 }
 
 // Notification Info provides properties related to the assembly of notification content
@@ -75,6 +197,16 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 	return ok
 }
 
+// GetFeatureFlags returns the feature flags of the Writable configuration, by name.
+func (c *ConfigurationStruct) GetFeatureFlags() map[string]bool {
+	return c.Writable.FeatureFlags
+}
+
+// GetConfigDriftInfo returns the configuration of the drift detection of the running configuration.
+func (c *ConfigurationStruct) GetConfigDriftInfo() configdrift.DriftInfo {
+	return c.ConfigDrift
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -104,6 +236,31 @@ func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Datab
 	return c.Databases
 }
 
+// GetStandaloneInfo returns the configuration of the endpoints file the other services are resolved from.
+func (c *ConfigurationStruct) GetStandaloneInfo() endpoints.StandaloneInfo {
+	return c.Standalone
+}
+
+// GetShutdownInfo returns the configuration of the graceful shutdown of the service.
+func (c *ConfigurationStruct) GetShutdownInfo() shutdown.ShutdownInfo {
+	return c.Shutdown
+}
+
+// GetDatabaseIndexes returns the collection written by the service and the secondary indexes declared on it.
+func (c *ConfigurationStruct) GetDatabaseIndexes() (string, map[string]db.IndexInfo) {
+	return db.IndexCollectionDevice, c.DatabaseIndexes
+}
+
+// GetDatabaseReplicationInfo returns the replication configuration.
+func (c *ConfigurationStruct) GetDatabaseReplicationInfo() db.ReplicationInfo {
+	return c.DatabaseReplication
+}
+
+// GetDependencyInfo returns the dependencies declared by the service.
+func (c *ConfigurationStruct) GetDependencyInfo() map[string]dependency.DependencyInfo {
+	return c.Dependencies
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
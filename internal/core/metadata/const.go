@@ -13,6 +13,8 @@
  *******************************************************************************/
 package metadata
 
+import "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+
 const (
 	/* ---------------- URL PARAM NAMES -----------------------*/
 	ID                  = "id"
@@ -46,6 +48,9 @@ const (
 	DEVICEREPORT        = "devicereport"
 	DEVICENAME          = "devicename"
 	DEVICESERVICE       = "deviceservice"
+	TOKEN               = "token"
+	CALLBACK            = "callback"
+	RETRY               = "retry"
 	TOPIC               = "topic"
 	PORT                = "port"
 	PUBLISHER           = "publisher"
@@ -57,6 +62,12 @@ const (
 	KEY                 = "key"
 	VALUE               = "value"
 	VALUEDESCRIPTORSFOR = "valueDescriptorsFor"
+	DEPRECATEDRESOURCES = "deprecatedresources"
+	AUTOEVENT           = "autoevent"
+	RESOURCE            = "resource"
 	UNLOCKED            = "UNLOCKED"
 	ENABLED             = "ENABLED"
+
+	// RegistrationTokenHeader carries the registration token of a device service, shared with the V2 API
+	RegistrationTokenHeader = application.RegistrationTokenHeader
 )
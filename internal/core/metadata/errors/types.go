@@ -195,3 +195,27 @@ func (e ErrDeviceProfileMarshalJson) Error() string {
 func NewErrDeviceProfileMarshalJson(message string) error {
 	return ErrDeviceProfileMarshalJson{msg: message}
 }
+
+type ErrRegistrationTokenInvalid struct {
+	reason string
+}
+
+func (e ErrRegistrationTokenInvalid) Error() string {
+	return "invalid device service registration token: " + e.reason
+}
+
+func NewErrRegistrationTokenInvalid(reason string) error {
+	return ErrRegistrationTokenInvalid{reason: reason}
+}
+
+type ErrRegistrationTokenForbidden struct {
+	service string
+}
+
+func (e ErrRegistrationTokenForbidden) Error() string {
+	return fmt.Sprintf("registration token doesn't grant access to device service '%s'", e.service)
+}
+
+func NewErrRegistrationTokenForbidden(service string) error {
+	return ErrRegistrationTokenForbidden{service: service}
+}
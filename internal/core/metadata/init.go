@@ -19,11 +19,12 @@ import (
 
 	"sync"
 
-	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
-
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -69,14 +70,32 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		},
 		container.CoreDataValueDescriptorClientName: func(get di.Get) interface{} {
 			return coredata.NewValueDescriptorClient(
-				local.New(configuration.Clients["CoreData"].Url() + clients.ApiValueDescriptorRoute))
+				endpoints.NewURLClient(
+					pkgContainer.EndpointsRegistryFrom(get), "CoreData", configuration.Clients["CoreData"], clients.ApiValueDescriptorRoute))
 		},
 		container.NotificationsClientName: func(get di.Get) interface{} {
 			return notifications.NewNotificationsClient(
-				local.New(configuration.Clients["Notifications"].Url() + clients.ApiNotificationRoute))
+				endpoints.NewURLClient(
+					pkgContainer.EndpointsRegistryFrom(get), "Notifications", configuration.Clients["Notifications"], clients.ApiNotificationRoute))
 
 		},
 	})
 
+	if configuration.Trash.Enabled {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			application.RunTrashPurge(ctx, dic)
+		}()
+	}
+
+	if configuration.ProfileCatalog.Url != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			application.RunProfileCatalogSync(ctx, dic)
+		}()
+	}
+
 	return true
 }
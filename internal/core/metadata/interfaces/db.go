@@ -14,6 +14,8 @@
 package interfaces
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -75,6 +77,19 @@ type DBClient interface {
 	AddDeviceService(ds contract.DeviceService) (string, error)
 	DeleteDeviceServiceById(id string) error
 
+	// Device service registration
+	AddRegistrationToken(hash string, token models.RegistrationToken) error
+	GetRegistrationToken(hash string) (models.RegistrationToken, error)
+	RedeemRegistrationToken(hash string, serviceId string) error
+	GetDeviceServiceCredential(serviceId string) (string, error)
+
+	// Device service callback failures
+	AddCallbackFailure(f models.CallbackFailure) (string, error)
+	GetCallbackFailures() ([]models.CallbackFailure, error)
+	GetCallbackFailureById(id string) (models.CallbackFailure, error)
+	UpdateCallbackFailure(f models.CallbackFailure) error
+	DeleteCallbackFailureById(id string) error
+
 	// Provision watcher
 	GetProvisionWatcherById(id string) (contract.ProvisionWatcher, error)
 	GetAllProvisionWatchers() ([]contract.ProvisionWatcher, error)
@@ -4,6 +4,7 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import metadatamodels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
@@ -31,6 +32,27 @@ func (_m *DBClient) AddAddressable(a models.Addressable) (string, error) {
 	return r0, r1
 }
 
+// AddCallbackFailure provides a mock function with given fields: f
+func (_m *DBClient) AddCallbackFailure(f metadatamodels.CallbackFailure) (string, error) {
+	ret := _m.Called(f)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(metadatamodels.CallbackFailure) string); ok {
+		r0 = rf(f)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(metadatamodels.CallbackFailure) error); ok {
+		r1 = rf(f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddDevice provides a mock function with given fields: d, commands
 func (_m *DBClient) AddDevice(d models.Device, commands []models.Command) (string, error) {
 	ret := _m.Called(d, commands)
@@ -136,6 +158,20 @@ func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (string, err
 	return r0, r1
 }
 
+// AddRegistrationToken provides a mock function with given fields: hash, token
+func (_m *DBClient) AddRegistrationToken(hash string, token metadatamodels.RegistrationToken) error {
+	ret := _m.Called(hash, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, metadatamodels.RegistrationToken) error); ok {
+		r0 = rf(hash, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
@@ -155,6 +191,20 @@ func (_m *DBClient) DeleteAddressableById(id string) error {
 	return r0
 }
 
+// DeleteCallbackFailureById provides a mock function with given fields: id
+func (_m *DBClient) DeleteCallbackFailureById(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteDeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceById(id string) error {
 	ret := _m.Called(id)
@@ -520,6 +570,50 @@ func (_m *DBClient) GetAllProvisionWatchers() ([]models.ProvisionWatcher, error)
 	return r0, r1
 }
 
+// GetCallbackFailureById provides a mock function with given fields: id
+func (_m *DBClient) GetCallbackFailureById(id string) (metadatamodels.CallbackFailure, error) {
+	ret := _m.Called(id)
+
+	var r0 metadatamodels.CallbackFailure
+	if rf, ok := ret.Get(0).(func(string) metadatamodels.CallbackFailure); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(metadatamodels.CallbackFailure)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCallbackFailures provides a mock function with given fields:
+func (_m *DBClient) GetCallbackFailures() ([]metadatamodels.CallbackFailure, error) {
+	ret := _m.Called()
+
+	var r0 []metadatamodels.CallbackFailure
+	if rf, ok := ret.Get(0).(func() []metadatamodels.CallbackFailure); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metadatamodels.CallbackFailure)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCommandById provides a mock function with given fields: id
 func (_m *DBClient) GetCommandById(id string) (models.Command, error) {
 	ret := _m.Called(id)
@@ -914,6 +1008,27 @@ func (_m *DBClient) GetDeviceServiceByName(n string) (models.DeviceService, erro
 	return r0, r1
 }
 
+// GetDeviceServiceCredential provides a mock function with given fields: serviceId
+func (_m *DBClient) GetDeviceServiceCredential(serviceId string) (string, error) {
+	ret := _m.Called(serviceId)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(serviceId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(serviceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDeviceServicesByAddressableId provides a mock function with given fields: id
 func (_m *DBClient) GetDeviceServicesByAddressableId(id string) ([]models.DeviceService, error) {
 	ret := _m.Called(id)
@@ -1140,6 +1255,41 @@ func (_m *DBClient) GetProvisionWatchersByServiceId(id string) ([]models.Provisi
 	return r0, r1
 }
 
+// GetRegistrationToken provides a mock function with given fields: hash
+func (_m *DBClient) GetRegistrationToken(hash string) (metadatamodels.RegistrationToken, error) {
+	ret := _m.Called(hash)
+
+	var r0 metadatamodels.RegistrationToken
+	if rf, ok := ret.Get(0).(func(string) metadatamodels.RegistrationToken); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(metadatamodels.RegistrationToken)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedeemRegistrationToken provides a mock function with given fields: hash, serviceId
+func (_m *DBClient) RedeemRegistrationToken(hash string, serviceId string) error {
+	ret := _m.Called(hash, serviceId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(hash, serviceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ScrubMetadata provides a mock function with given fields:
 func (_m *DBClient) ScrubMetadata() error {
 	ret := _m.Called()
@@ -1168,6 +1318,20 @@ func (_m *DBClient) UpdateAddressable(a models.Addressable) error {
 	return r0
 }
 
+// UpdateCallbackFailure provides a mock function with given fields: f
+func (_m *DBClient) UpdateCallbackFailure(f metadatamodels.CallbackFailure) error {
+	ret := _m.Called(f)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(metadatamodels.CallbackFailure) error); ok {
+		r0 = rf(f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDevice provides a mock function with given fields: d
 func (_m *DBClient) UpdateDevice(d models.Device) error {
 	ret := _m.Called(d)
@@ -23,7 +23,14 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/configdrift"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/featureflags"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
+	shutdownHandler "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/shutdown"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/shutdown"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 	v2Handlers "github.com/edgexfoundry/edgex-go/internal/pkg/v2/bootstrap/handlers"
 
@@ -58,6 +65,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 	})
 
 	httpServer := handlers.NewHttpServer(router, true)
+	coordinator := shutdown.NewCoordinator(httpServer)
+	router.Use(coordinator.Middleware)
 
 	bootstrap.Run(
 		ctx,
@@ -69,9 +78,18 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *mux.Router, re
 		startupTimer,
 		dic,
 		[]interfaces.BootstrapHandler{
-			handlers.SecureProviderBootstrapHandler,
-			database.NewDatabase(httpServer, configuration).BootstrapHandler,
-			v2Handlers.NewDatabase(httpServer, configuration, v2MetadataContainer.DBClientInterfaceName).BootstrapHandler, // add v2 db client bootstrap handler
+			shutdownHandler.NewGracefulShutdown(coordinator, configuration).BootstrapHandler,
+			secret.BootstrapHandler,
+			endpoints.NewStandalone(configuration).BootstrapHandler,
+			dependency.NewDependencies(configuration).BootstrapHandler,
+			database.NewDatabase(coordinator, configuration).BootstrapHandler,
+			v2Handlers.NewDatabase(coordinator, configuration, v2MetadataContainer.DBClientInterfaceName).BootstrapHandler, // add v2 db client bootstrap handler
+			featureflags.NewFeatureFlags(configuration).BootstrapHandler,
+			configdrift.NewConfigDrift(
+				f,
+				clients.CoreMetaDataServiceKey,
+				internal.ConfigStemCore+internal.ConfigMajorVersion,
+				configuration).BootstrapHandler,
 			NewBootstrap(router).BootstrapHandler,
 			telemetry.BootstrapHandler,
 			httpServer.BootstrapHandler,
@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+import "encoding/json"
+
+// CallbackFailure is a callback to a device service which failed, kept along with its payload until it is retried
+// successfully or deleted
+type CallbackFailure struct {
+	Id          string `json:"id"`
+	ServiceName string `json:"serviceName"`
+	Method      string `json:"method"`
+	URL         string `json:"url"`
+	// Payload is the body of the callback, the JSON encoded callback alert
+	Payload json.RawMessage `json:"payload"`
+	// Error is the reason the last attempt failed
+	Error    string `json:"error"`
+	Attempts int    `json:"attempts"`
+	Created  int64  `json:"created"`
+	// LastAttempt is the timestamp in milliseconds of the last attempt
+	LastAttempt int64 `json:"lastAttempt"`
+}
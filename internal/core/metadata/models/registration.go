@@ -0,0 +1,31 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// RegistrationToken grants a single registration of the named device service. The token itself is only handed to
+// whoever minted it, the database keeps its hash.
+type RegistrationToken struct {
+	ServiceName string `json:"serviceName"`
+	Created     int64  `json:"created"`
+	// Expires is the timestamp in milliseconds after which the token can't be redeemed anymore
+	Expires int64 `json:"expires"`
+}
+
+// MintedRegistrationToken is returned to the minter of a registration token
+type MintedRegistrationToken struct {
+	Token       string `json:"token"`
+	ServiceName string `json:"serviceName"`
+	Expires     int64  `json:"expires"`
+}
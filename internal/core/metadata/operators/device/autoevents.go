@@ -0,0 +1,166 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package device
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// autoEventFrequencyPattern is the format of the frequency of the AutoEvents, an unsigned integer followed by "ms",
+// "s", "m" or "h"
+var autoEventFrequencyPattern = regexp.MustCompile(`^[1-9][0-9]*(ms|s|m|h)$`)
+
+// AutoEventsExecutor changes the AutoEvents of a device.
+type AutoEventsExecutor interface {
+	// Execute changes the AutoEvents of the device and tells whether they changed. The device event is only sent when
+	// they did, so that the device service is called back only then.
+	Execute() (changed bool, err error)
+}
+
+type changeAutoEvents struct {
+	database   DeviceUpdater
+	events     chan DeviceEvent
+	deviceName string
+	// autoEvent is validated against the device profile, nil when removing an AutoEvent
+	autoEvent *contract.AutoEvent
+	change    func(autoEvents []contract.AutoEvent) ([]contract.AutoEvent, error)
+}
+
+// NewAddAutoEvent creates an AutoEventsExecutor adding the AutoEvent of a resource to the device, which has none yet.
+func NewAddAutoEvent(ch chan DeviceEvent, db DeviceUpdater, deviceName string, a contract.AutoEvent) AutoEventsExecutor {
+	return changeAutoEvents{
+		database:   db,
+		events:     ch,
+		deviceName: deviceName,
+		autoEvent:  &a,
+		change: func(autoEvents []contract.AutoEvent) ([]contract.AutoEvent, error) {
+			if indexOfAutoEvent(autoEvents, a.Resource) >= 0 {
+				return nil, errors.NewErrDuplicateName(
+					fmt.Sprintf("device %s already has an AutoEvent for resource %s", deviceName, a.Resource))
+			}
+			return append(append([]contract.AutoEvent{}, autoEvents...), a), nil
+		},
+	}
+}
+
+// NewUpdateAutoEvent creates an AutoEventsExecutor replacing the AutoEvent of a resource of the device.
+func NewUpdateAutoEvent(ch chan DeviceEvent, db DeviceUpdater, deviceName string, a contract.AutoEvent) AutoEventsExecutor {
+	return changeAutoEvents{
+		database:   db,
+		events:     ch,
+		deviceName: deviceName,
+		autoEvent:  &a,
+		change: func(autoEvents []contract.AutoEvent) ([]contract.AutoEvent, error) {
+			i := indexOfAutoEvent(autoEvents, a.Resource)
+			if i < 0 {
+				return nil, errors.NewErrItemNotFound(fmt.Sprintf("AutoEvent of device %s for resource %s", deviceName, a.Resource))
+			}
+			updated := append([]contract.AutoEvent{}, autoEvents...)
+			updated[i] = a
+			return updated, nil
+		},
+	}
+}
+
+// NewDeleteAutoEvent creates an AutoEventsExecutor removing the AutoEvent of a resource from the device.
+func NewDeleteAutoEvent(ch chan DeviceEvent, db DeviceUpdater, deviceName string, resource string) AutoEventsExecutor {
+	return changeAutoEvents{
+		database:   db,
+		events:     ch,
+		deviceName: deviceName,
+		change: func(autoEvents []contract.AutoEvent) ([]contract.AutoEvent, error) {
+			i := indexOfAutoEvent(autoEvents, resource)
+			if i < 0 {
+				return nil, errors.NewErrItemNotFound(fmt.Sprintf("AutoEvent of device %s for resource %s", deviceName, resource))
+			}
+			return append(append([]contract.AutoEvent{}, autoEvents[:i]...), autoEvents[i+1:]...), nil
+		},
+	}
+}
+
+func (op changeAutoEvents) Execute() (bool, error) {
+	d, err := op.database.GetDeviceByName(op.deviceName)
+	if err != nil {
+		if err == db.ErrNotFound {
+			err = errors.NewErrItemNotFound(fmt.Sprintf("device not found: %s", op.deviceName))
+		}
+		return false, err
+	}
+
+	if op.autoEvent != nil {
+		if err = validateAutoEvent(*op.autoEvent, d.Profile); err != nil {
+			return false, err
+		}
+	}
+
+	autoEvents, err := op.change(d.AutoEvents)
+	if err != nil {
+		return false, err
+	}
+	if reflect.DeepEqual(autoEvents, d.AutoEvents) {
+		return false, nil
+	}
+
+	d.AutoEvents = autoEvents
+	if err = op.database.UpdateDevice(d); err != nil {
+		return false, err
+	}
+
+	op.events <- DeviceEvent{
+		DeviceId:   d.Id,
+		DeviceName: d.Name,
+		HttpMethod: http.MethodPut,
+		ServiceId:  d.Service.Id,
+	}
+	return true, nil
+}
+
+// validateAutoEvent checks the frequency of the AutoEvent, and that its resource is a deviceResource or a
+// deviceCommand of the device profile
+func validateAutoEvent(a contract.AutoEvent, profile contract.DeviceProfile) error {
+	if !autoEventFrequencyPattern.MatchString(a.Frequency) {
+		return contract.NewErrContractInvalid(
+			fmt.Sprintf("invalid AutoEvent frequency '%s', expected i.e. '100ms', '30s', '5m' or '24h'", a.Frequency))
+	}
+	for _, r := range profile.DeviceResources {
+		if r.Name == a.Resource {
+			return nil
+		}
+	}
+	for _, c := range profile.DeviceCommands {
+		if c.Name == a.Resource {
+			return nil
+		}
+	}
+	return contract.NewErrContractInvalid(
+		fmt.Sprintf("AutoEvent resource '%s' not found in device profile %s", a.Resource, profile.Name))
+}
+
+// indexOfAutoEvent returns the index of the AutoEvent of the resource, -1 when there is none
+func indexOfAutoEvent(autoEvents []contract.AutoEvent, resource string) int {
+	for i, a := range autoEvents {
+		if a.Resource == resource {
+			return i
+		}
+	}
+	return -1
+}
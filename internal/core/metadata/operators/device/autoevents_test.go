@@ -0,0 +1,121 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package device
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeAutoEvents(t *testing.T) {
+	existing := testDevice.AutoEvents[0]
+	added := models.AutoEvent{Resource: testDeviceResource.Name, Frequency: "10s"}
+	command := models.AutoEvent{Resource: testProfileResource.Name, Frequency: "1h", OnChange: true}
+	updated := existing
+	updated.Frequency = "5m"
+
+	tests := []struct {
+		name               string
+		op                 func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor
+		expectedAutoEvents []models.AutoEvent
+		expectedError      interface{}
+	}{
+		{"Add", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewAddAutoEvent(ch, db, testDeviceName, added)
+		}, []models.AutoEvent{existing, added}, nil},
+		{"Add device command", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewAddAutoEvent(ch, db, testDeviceName, command)
+		}, []models.AutoEvent{existing, command}, nil},
+		{"Add duplicated", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewAddAutoEvent(ch, db, testDeviceName, models.AutoEvent{Resource: existing.Resource, Frequency: "1s"})
+		}, nil, errors.ErrDuplicateName{}},
+		{"Add invalid frequency", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewAddAutoEvent(ch, db, testDeviceName, models.AutoEvent{Resource: added.Resource, Frequency: "PT10S"})
+		}, nil, models.ErrContractInvalid{}},
+		{"Add unknown resource", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewAddAutoEvent(ch, db, testDeviceName, models.AutoEvent{Resource: "unknown", Frequency: "10s"})
+		}, nil, models.ErrContractInvalid{}},
+		{"Add device not found", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewAddAutoEvent(ch, db, "unknown", added)
+		}, nil, errors.ErrItemNotFound{}},
+		{"Update", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewUpdateAutoEvent(ch, db, testDeviceName, updated)
+		}, []models.AutoEvent{updated}, nil},
+		{"Update unchanged", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewUpdateAutoEvent(ch, db, testDeviceName, existing)
+		}, nil, nil},
+		{"Update not found", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewUpdateAutoEvent(ch, db, testDeviceName, added)
+		}, nil, errors.ErrItemNotFound{}},
+		{"Delete", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewDeleteAutoEvent(ch, db, testDeviceName, existing.Resource)
+		}, []models.AutoEvent{}, nil},
+		{"Delete not found", func(ch chan DeviceEvent, db DeviceUpdater) AutoEventsExecutor {
+			return NewDeleteAutoEvent(ch, db, testDeviceName, added.Resource)
+		}, nil, errors.ErrItemNotFound{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The existing AutoEvent refers to a resource outside the profile, only the changed one is validated
+			device := testDevice
+			device.Profile.DeviceResources = append([]models.DeviceResource{}, testDeviceResource)
+			device.Profile.DeviceResources = append(device.Profile.DeviceResources, models.DeviceResource{Name: existing.Resource})
+
+			dbMock := &mocks.DeviceUpdater{}
+			dbMock.On("GetDeviceByName", testDeviceName).Return(device, nil)
+			dbMock.On("GetDeviceByName", "unknown").Return(models.Device{}, db.ErrNotFound)
+			dbMock.On("UpdateDevice", mock.Anything).Return(nil)
+
+			ch := make(chan DeviceEvent, 1)
+			changed, err := tt.op(ch, dbMock).Execute()
+			close(ch)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.IsType(t, tt.expectedError, err)
+				assert.False(t, changed)
+				dbMock.AssertNotCalled(t, "UpdateDevice", mock.Anything)
+				assert.Len(t, ch, 0)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedAutoEvents == nil {
+				assert.False(t, changed)
+				dbMock.AssertNotCalled(t, "UpdateDevice", mock.Anything)
+				assert.Len(t, ch, 0, "the device service is only called back when the AutoEvents changed")
+				return
+			}
+
+			assert.True(t, changed)
+			dbMock.AssertCalled(t, "UpdateDevice", mock.MatchedBy(func(d models.Device) bool {
+				return assert.ObjectsAreEqual(tt.expectedAutoEvents, d.AutoEvents)
+			}))
+			evt := <-ch
+			assert.Equal(t, http.MethodPut, evt.HttpMethod)
+			assert.Equal(t, testDeviceServiceId, evt.ServiceId)
+			assert.Equal(t, []models.AutoEvent{existing}, testDevice.AutoEvents, "the stored AutoEvents aren't modified")
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package device
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -32,3 +34,7 @@ type DeviceProfileLoader interface {
 	GetDeviceProfileById(id string) (contract.DeviceProfile, error)
 	GetDeviceProfileByName(n string) (contract.DeviceProfile, error)
 }
+
+type CallbackFailureRecorder interface {
+	AddCallbackFailure(f models.CallbackFailure) (string, error)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	metaConfig "github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 )
 
@@ -41,18 +42,23 @@ type deviceNotifier struct {
 	ctx          context.Context
 	database     DeviceServiceLoader
 	events       chan DeviceEvent
+	failures     CallbackFailureRecorder
 	logger       logger.LoggingClient
 	notifyClient notifications.NotificationsClient
 	notifyConfig metaConfig.NotificationInfo
 	requester    Requester
 }
 
+// NewNotifier creates a Notifier of the device event received on evt. Failed callbacks to device services are
+// recorded by failures so they can be retried.
 func NewNotifier(evt chan DeviceEvent, nc notifications.NotificationsClient, cfg metaConfig.NotificationInfo,
-	db DeviceServiceLoader, requester Requester, logger logger.LoggingClient, ctx context.Context) Notifier {
+	db DeviceServiceLoader, failures CallbackFailureRecorder, requester Requester, logger logger.LoggingClient,
+	ctx context.Context) Notifier {
 	return deviceNotifier{
 		ctx:          ctx,
 		database:     db,
 		events:       evt,
+		failures:     failures,
 		logger:       logger,
 		notifyClient: nc,
 		notifyConfig: cfg,
@@ -63,7 +69,10 @@ func NewNotifier(evt chan DeviceEvent, nc notifications.NotificationsClient, cfg
 // Remember that this method is being invoked via a goroutine. The following logic is all async to the caller.
 func (op deviceNotifier) Execute() {
 	select {
-	case msg := <-op.events:
+	case msg, ok := <-op.events:
+		if !ok {
+			return // The operation changed nothing, the channel was closed without an event.
+		}
 		if msg.Error != nil {
 			op.logger.Error(fmt.Sprintf("dropping event due to error: %s", msg.Error.Error()))
 			return // Something happened during the upstream operation. Do nothing.
@@ -137,9 +146,33 @@ func (op deviceNotifier) callback(
 			return err
 		}
 		req.Header.Add(clients.ContentType, clients.ContentTypeJSON)
-		go op.requester.Execute(req)
+		go op.execute(req, service, body)
 	} else {
 		op.logger.Error("callback::no addressable for " + service.Name)
 	}
 	return nil
 }
+
+// execute sends the callback request and records it when it fails
+func (op deviceNotifier) execute(req *http.Request, service models.DeviceService, body []byte) {
+	err := op.requester.Execute(req)
+	if err == nil {
+		return
+	}
+	op.logger.Error(fmt.Sprintf("callback to device service %s failed: %s", service.Name, err.Error()))
+
+	now := db.MakeTimestamp()
+	failure := metadataModels.CallbackFailure{
+		ServiceName: service.Name,
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		Payload:     body,
+		Error:       err.Error(),
+		Attempts:    1,
+		Created:     now,
+		LastAttempt: now,
+	}
+	if _, err = op.failures.AddCallbackFailure(failure); err != nil {
+		op.logger.Error(fmt.Sprintf("unable to record the failed callback to device service %s: %s", service.Name, err.Error()))
+	}
+}
@@ -1,7 +1,9 @@
 package device
 
 import (
+	"bytes"
 	"context"
+	goErrors "errors"
 	"net/http"
 	"sync"
 	"testing"
@@ -10,9 +12,13 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	metaConfig "github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 )
@@ -59,6 +65,7 @@ func TestNotification(t *testing.T) {
 				nc,
 				testNotificationInfo,
 				tt.dbMock,
+				&mocks.CallbackFailureRecorder{},
 				requester,
 				newMockNotifyLogger(tt.expectError, t),
 				context.Background(),
@@ -74,6 +81,54 @@ func TestNotification(t *testing.T) {
 	}
 }
 
+func TestCallbackFailureRecorded(t *testing.T) {
+	body := []byte(`{"type":"DEVICE","id":"` + uuid.New().String() + `"}`)
+	req, err := http.NewRequest(http.MethodPost, testDeviceService.Addressable.GetCallbackURL(), bytes.NewReader(body))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		requestError error
+		expectRecord bool
+	}{
+		{"Callback succeeded", nil, false},
+		{"Callback failed", goErrors.New("connection refused"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorderMock := &mocks.CallbackFailureRecorder{}
+			recorderMock.On("AddCallbackFailure", mock.Anything).Return("failure-id", nil)
+			op := deviceNotifier{
+				failures:  recorderMock,
+				requester: mockFailingRequester{err: tt.requestError},
+				logger:    newMockNotifyLogger(tt.expectRecord, t),
+			}
+
+			op.execute(req, testDeviceService, body)
+
+			if !tt.expectRecord {
+				recorderMock.AssertNotCalled(t, "AddCallbackFailure", mock.Anything)
+				return
+			}
+			failure := recorderMock.Calls[0].Arguments.Get(0).(metadataModels.CallbackFailure)
+			assert.Equal(t, testDeviceService.Name, failure.ServiceName)
+			assert.Equal(t, http.MethodPost, failure.Method)
+			assert.Equal(t, testDeviceService.Addressable.GetCallbackURL(), failure.URL)
+			assert.JSONEq(t, string(body), string(failure.Payload))
+			assert.Equal(t, tt.requestError.Error(), failure.Error)
+			assert.Equal(t, 1, failure.Attempts)
+		})
+	}
+}
+
+type mockFailingRequester struct {
+	err error
+}
+
+func (r mockFailingRequester) Execute(_ *http.Request) error {
+	return r.err
+}
+
 func createNotifyDeviceServiceDbMock() DeviceServiceLoader {
 	dbMock := &mocks.DeviceServiceLoader{}
 	dbMock.On("GetDeviceServiceById", testDeviceServiceId).Return(testDeviceService, nil)
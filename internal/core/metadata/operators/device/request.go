@@ -33,8 +33,8 @@ type Requester interface {
 	// Execute will invoke the supplied request. I'm not thrilled about providing the extra parameter here, but it
 	// follows from the net/http/Client.Do(req *Request) from the Go std lib. That is, I don't know the actual request
 	// to be performed until runtime and if I am to mock this properly, I don't know the request at the time of
-	// injection.
-	Execute(req *http.Request)
+	// injection. An error is returned when the request can't be sent or isn't answered with a success status.
+	Execute(req *http.Request) error
 }
 
 type httpRequester struct {
@@ -54,19 +54,22 @@ func NewRequester(key RequestType, logger logger.LoggingClient, ctx context.Cont
 	}
 }
 
-func (op httpRequester) Execute(req *http.Request) {
+func (op httpRequester) Execute(req *http.Request) error {
 	resp, err := op.client.Do(req)
-	if err == nil {
-		defer resp.Body.Close()
-		return
+	if err != nil {
+		return err
 	}
-	op.logger.Error(err.Error())
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s %s returned status %d", req.Method, req.URL.String(), resp.StatusCode)
+	}
+	return nil
 }
 
 type mockRequester struct {
 	logger logger.LoggingClient
 }
 
-func (op mockRequester) Execute(req *http.Request) {
-
+func (op mockRequester) Execute(req *http.Request) error {
+	return nil
 }
@@ -52,6 +52,11 @@ type DeviceProfileDeleter interface {
 	ProvisionWatcherLoader
 }
 
+// DeviceProfileTrasher moves the deleted device profiles to the trash.
+type DeviceProfileTrasher interface {
+	TrashDeviceProfile(dp contract.DeviceProfile) error
+}
+
 // DeviceProfileUpdater updates device profiles.
 // Also provides other functionality for validating the device profile before deletion. Such as loading other entities
 // to ensure there are no other dependencies on the device profile before deletion.
@@ -16,8 +16,9 @@ type DeleteExecutor interface {
 }
 
 type deleteProfileById struct {
-	db  DeviceProfileDeleter
-	did string
+	db    DeviceProfileDeleter
+	did   string
+	trash DeviceProfileTrasher
 }
 
 // Execute performs the deletion of the device profile.
@@ -33,20 +34,23 @@ func (dpbi deleteProfileById) Execute() error {
 	}
 
 	// Delete the device profile
-	return deleteDeviceProfile(dpbi.db, dp)
+	return deleteDeviceProfile(dpbi.db, dp, dpbi.trash)
 }
 
 // NewDeleteByIDExecutor creates a new DeleteExecutor which deletes a device profile based on a device profile name.
-func NewDeleteByIDExecutor(db DeviceProfileDeleter, did string) DeleteExecutor {
+// The device profile is moved to the trash first unless trash is nil.
+func NewDeleteByIDExecutor(db DeviceProfileDeleter, did string, trash DeviceProfileTrasher) DeleteExecutor {
 	return deleteProfileById{
-		db:  db,
-		did: did,
+		db:    db,
+		did:   did,
+		trash: trash,
 	}
 }
 
 type deleteProfileByName struct {
-	db DeviceProfileDeleter
-	dn string
+	db    DeviceProfileDeleter
+	dn    string
+	trash DeviceProfileTrasher
 }
 
 // Execute performs the deletion of the device profile.
@@ -62,21 +66,23 @@ func (dpbn deleteProfileByName) Execute() error {
 	}
 
 	// Delete the device profile
-	return deleteDeviceProfile(dpbn.db, dp)
+	return deleteDeviceProfile(dpbn.db, dp, dpbn.trash)
 }
 
 // NewDeleteByNameExecutor creates a new DeleteExecutor which deletes a device profile based on a device profile ID.
-func NewDeleteByNameExecutor(db DeviceProfileDeleter, dn string) DeleteExecutor {
+// The device profile is moved to the trash first unless trash is nil.
+func NewDeleteByNameExecutor(db DeviceProfileDeleter, dn string, trash DeviceProfileTrasher) DeleteExecutor {
 	return deleteProfileByName{
-		db: db,
-		dn: dn,
+		db:    db,
+		dn:    dn,
+		trash: trash,
 	}
 }
 
 // Delete the device profile
 // Make sure there are no devices still using it
 // Delete the associated commands
-func deleteDeviceProfile(dpd DeviceProfileDeleter, dp contract.DeviceProfile) error {
+func deleteDeviceProfile(dpd DeviceProfileDeleter, dp contract.DeviceProfile, trash DeviceProfileTrasher) error {
 	// Check if the device profile is still in use by devices
 	d, err := dpd.GetDevicesByProfileId(dp.Id)
 	if err != nil {
@@ -97,6 +103,12 @@ func deleteDeviceProfile(dpd DeviceProfileDeleter, dp contract.DeviceProfile) er
 		return errors.NewErrDeviceProfileInvalidState(dp.Id, dp.Name, "Cant delete device profile, the profile is still in use by a provision watcher")
 
 	}
+	// Keep the profile restorable
+	if trash != nil {
+		if err := trash.TrashDeviceProfile(dp); err != nil {
+			return err
+		}
+	}
 	// Delete the profile
 	if err := dpd.DeleteDeviceProfileById(dp.Id); err != nil {
 		return err
@@ -71,7 +71,7 @@ func TestDeleteProfileById(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			op := NewDeleteByIDExecutor(test.database, TestDeviceProfile.Id)
+			op := NewDeleteByIDExecutor(test.database, TestDeviceProfile.Id, nil)
 			err := op.Execute()
 
 			if test.expectError && err == nil {
@@ -154,7 +154,7 @@ func TestDeleteProfileByName(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			op := NewDeleteByNameExecutor(test.database, TestDeviceProfile.Name)
+			op := NewDeleteByNameExecutor(test.database, TestDeviceProfile.Name, nil)
 			err := op.Execute()
 
 			if test.expectError && err == nil {
@@ -178,6 +178,38 @@ func TestDeleteProfileByName(t *testing.T) {
 	}
 }
 
+type fakeTrash struct {
+	trashed []contract.DeviceProfile
+	err     error
+}
+
+func (f *fakeTrash) TrashDeviceProfile(dp contract.DeviceProfile) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.trashed = append(f.trashed, dp)
+	return nil
+}
+
+func TestDeleteProfileMovesToTrash(t *testing.T) {
+	trash := &fakeTrash{}
+	if err := NewDeleteByNameExecutor(createDeviceDeleter(), TestDeviceProfile.Name, trash).Execute(); err != nil {
+		t.Fatalf("We do not expected an error but got one. %s", err.Error())
+	}
+	if !reflect.DeepEqual(trash.trashed, []contract.DeviceProfile{TestDeviceProfile}) {
+		t.Errorf("Expected the device profile in the trash, but got %v", trash.trashed)
+	}
+
+	database := &mocks.DeviceProfileDeleter{}
+	database.On("GetDeviceProfileById", TestDeviceProfile.Id).Return(TestDeviceProfile, nil)
+	database.On("GetDevicesByProfileId", TestDeviceProfile.Id).Return(make([]contract.Device, 0), nil)
+	database.On("GetProvisionWatchersByProfileId", TestDeviceProfile.Id).Return(make([]contract.ProvisionWatcher, 0), nil)
+	if err := NewDeleteByIDExecutor(database, TestDeviceProfile.Id, &fakeTrash{err: TestError}).Execute(); err != TestError {
+		t.Errorf("Expected the trash error, but got %v", err)
+	}
+	database.AssertNotCalled(t, "DeleteDeviceProfileById", TestDeviceProfile.Id)
+}
+
 func createDeviceDeleter() DeviceProfileDeleter {
 	d := mocks.DeviceProfileDeleter{}
 	d.On("GetDeviceProfileById", TestDeviceProfile.Id).Return(TestDeviceProfile, nil)
@@ -0,0 +1,171 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// Get the AutoEvents of the device
+func restGetAutoEventsByDeviceName(
+	w http.ResponseWriter,
+	r *http.Request,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler) {
+
+	dn, err := url.QueryUnescape(mux.Vars(r)[NAME])
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	d, err := dbClient.GetDeviceByName(dn)
+	if err != nil {
+		errorHandler.HandleOneVariant(w, err, errorconcept.Database.NotFound, errorconcept.Default.InternalServerError)
+		return
+	}
+	autoEvents := d.AutoEvents
+	if autoEvents == nil {
+		autoEvents = []models.AutoEvent{}
+	}
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	_ = json.NewEncoder(w).Encode(autoEvents)
+}
+
+// Add the AutoEvent of a resource to the device
+// 409 conflict if the device already has an AutoEvent for the resource
+func restAddAutoEventByDeviceName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	nc notifications.NotificationsClient,
+	configuration *config.ConfigurationStruct) {
+
+	changeAutoEventsByDeviceName(w, r, lc, dbClient, errorHandler, nc, configuration,
+		func(ch chan device.DeviceEvent, dn string, a models.AutoEvent) device.AutoEventsExecutor {
+			return device.NewAddAutoEvent(ch, dbClient, dn, a)
+		})
+}
+
+// Update the AutoEvent of a resource of the device
+// 404 not found if the device has no AutoEvent for the resource
+func restUpdateAutoEventByDeviceName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	nc notifications.NotificationsClient,
+	configuration *config.ConfigurationStruct) {
+
+	changeAutoEventsByDeviceName(w, r, lc, dbClient, errorHandler, nc, configuration,
+		func(ch chan device.DeviceEvent, dn string, a models.AutoEvent) device.AutoEventsExecutor {
+			return device.NewUpdateAutoEvent(ch, dbClient, dn, a)
+		})
+}
+
+// Remove the AutoEvent of a resource from the device
+func restDeleteAutoEventByDeviceName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	nc notifications.NotificationsClient,
+	configuration *config.ConfigurationStruct) {
+
+	resource, err := url.QueryUnescape(mux.Vars(r)[RESOURCE])
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	changeAutoEventsByDeviceName(w, r, lc, dbClient, errorHandler, nc, configuration,
+		func(ch chan device.DeviceEvent, dn string, _ models.AutoEvent) device.AutoEventsExecutor {
+			return device.NewDeleteAutoEvent(ch, dbClient, dn, resource)
+		})
+}
+
+// changeAutoEventsByDeviceName runs the operation changing the AutoEvents of the device named in the path, with the
+// AutoEvent of the body unless removing one. The device service is only called back when the AutoEvents changed.
+func changeAutoEventsByDeviceName(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	nc notifications.NotificationsClient,
+	configuration *config.ConfigurationStruct,
+	newOp func(ch chan device.DeviceEvent, dn string, a models.AutoEvent) device.AutoEventsExecutor) {
+
+	defer r.Body.Close()
+
+	dn, err := url.QueryUnescape(mux.Vars(r)[NAME])
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	var a models.AutoEvent
+	if r.Method != http.MethodDelete {
+		if err = json.NewDecoder(r.Body).Decode(&a); err != nil {
+			errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	requester, err := device.NewRequester(device.Http, lc, ctx)
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Device.RequesterError)
+		return
+	}
+
+	ch := make(chan device.DeviceEvent)
+	defer close(ch)
+
+	notifier := device.NewNotifier(ch, nc, configuration.Notifications, dbClient, dbClient, requester, lc, ctx)
+	go notifier.Execute()
+
+	op := newOp(ch, dn, a)
+	if _, err = op.Execute(); err != nil {
+		errorHandler.HandleManyVariants(
+			w,
+			err,
+			[]errorconcept.ErrorConceptType{
+				errorconcept.Common.ContractInvalid_StatusBadRequest,
+				errorconcept.Common.DuplicateName,
+				errorconcept.Common.ItemNotFound,
+			},
+			errorconcept.Default.InternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
@@ -0,0 +1,94 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metadataConfig "github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoEventsByDeviceName(t *testing.T) {
+	d := contract.Device{
+		Id:         "device-id",
+		Name:       "Thermostat",
+		Profile:    contract.DeviceProfile{Name: "Thermostat Profile", DeviceResources: []contract.DeviceResource{{Name: "Temperature"}}},
+		AutoEvents: []contract.AutoEvent{{Resource: "Temperature", Frequency: "10s"}},
+	}
+	dbMock := &mocks.DBClient{}
+	dbMock.On("GetDeviceByName", d.Name).Return(d, nil)
+	lc := logger.NewMockClient()
+	errorHandler := errorconcept.NewErrorHandler(lc)
+	configuration := &metadataConfig.ConfigurationStruct{}
+
+	t.Run("Get", func(t *testing.T) {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{NAME: d.Name})
+		rr := httptest.NewRecorder()
+		restGetAutoEventsByDeviceName(rr, req, dbMock, errorHandler)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		var autoEvents []contract.AutoEvent
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &autoEvents))
+		assert.Equal(t, d.AutoEvents, autoEvents)
+	})
+
+	tests := []struct {
+		name           string
+		handler        func(http.ResponseWriter, *http.Request)
+		autoEvent      contract.AutoEvent
+		expectedStatus int
+	}{
+		{"Add invalid frequency", func(w http.ResponseWriter, r *http.Request) {
+			restAddAutoEventByDeviceName(w, r, lc, dbMock, errorHandler, nil, configuration)
+		}, contract.AutoEvent{Resource: "Temperature", Frequency: "often"}, http.StatusBadRequest},
+		{"Add unknown resource", func(w http.ResponseWriter, r *http.Request) {
+			restAddAutoEventByDeviceName(w, r, lc, dbMock, errorHandler, nil, configuration)
+		}, contract.AutoEvent{Resource: "Humidity", Frequency: "10s"}, http.StatusBadRequest},
+		{"Add duplicated", func(w http.ResponseWriter, r *http.Request) {
+			restAddAutoEventByDeviceName(w, r, lc, dbMock, errorHandler, nil, configuration)
+		}, contract.AutoEvent{Resource: "Temperature", Frequency: "1m"}, http.StatusConflict},
+		{"Update unchanged", func(w http.ResponseWriter, r *http.Request) {
+			restUpdateAutoEventByDeviceName(w, r, lc, dbMock, errorHandler, nil, configuration)
+		}, d.AutoEvents[0], http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := json.Marshal(tt.autoEvent)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+			req = mux.SetURLVars(req, map[string]string{NAME: d.Name})
+			rr := httptest.NewRecorder()
+			tt.handler(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code, rr.Body.String())
+		})
+	}
+	dbMock.AssertNotCalled(t, "UpdateDevice", mock.Anything)
+	dbMock.AssertNotCalled(t, "GetDeviceServiceById", mock.Anything)
+}
@@ -0,0 +1,119 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/gorilla/mux"
+)
+
+// Get the failed callbacks to device services pending a retry
+// api/v1/deviceservice/callback
+func restGetCallbackFailures(
+	w http.ResponseWriter,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler) {
+
+	failures, err := dbClient.GetCallbackFailures()
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+	pkg.Encode(failures, w, lc)
+}
+
+// Retry a failed callback to a device service. The failure is deleted when the callback succeeds, otherwise its
+// attempts and error are updated.
+// api/v1/deviceservice/callback/{id}/retry
+func restRetryCallbackFailure(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	requester device.Requester) {
+
+	vars := mux.Vars(r)
+	id := vars[ID]
+
+	failure, err := dbClient.GetCallbackFailureById(id)
+	if err != nil {
+		errorHandler.HandleOneVariant(w, err, errorconcept.Database.NotFound, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	req, err := http.NewRequest(failure.Method, failure.URL, bytes.NewReader(failure.Payload))
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+	req.Header.Add(clients.ContentType, clients.ContentTypeJSON)
+
+	callbackErr := requester.Execute(req)
+	if callbackErr == nil {
+		if err = dbClient.DeleteCallbackFailureById(id); err != nil && err != db.ErrNotFound {
+			errorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+			return
+		}
+		lc.Info(fmt.Sprintf("retried the failed callback %s to device service %s", id, failure.ServiceName))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("true"))
+		return
+	}
+
+	failure.Attempts++
+	failure.Error = callbackErr.Error()
+	failure.LastAttempt = db.MakeTimestamp()
+	if err = dbClient.UpdateCallbackFailure(failure); err != nil {
+		errorHandler.HandleOneVariant(w, err, errorconcept.Database.NotFound, errorconcept.Default.InternalServerError)
+		return
+	}
+	errorHandler.Handle(
+		w,
+		fmt.Errorf("callback to device service %s failed again: %s", failure.ServiceName, callbackErr.Error()),
+		errorconcept.Default.ServiceUnavailable)
+}
+
+// Delete a failed callback to a device service without retrying it
+// api/v1/deviceservice/callback/{id}
+func restDeleteCallbackFailure(
+	w http.ResponseWriter,
+	r *http.Request,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler) {
+
+	vars := mux.Vars(r)
+	id := vars[ID]
+
+	if err := dbClient.DeleteCallbackFailureById(id); err != nil {
+		errorHandler.HandleOneVariant(w, err, errorconcept.Database.NotFound, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
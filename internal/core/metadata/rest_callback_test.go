@@ -0,0 +1,119 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCallbackFailureId = "callback-failure-id"
+
+var testCallbackFailure = models.CallbackFailure{
+	Id:          testCallbackFailureId,
+	ServiceName: testDeviceServiceName,
+	Method:      http.MethodPost,
+	URL:         "http://localhost:48082/api/v1/callback",
+	Payload:     json.RawMessage(`{"type":"DEVICE","id":"device-id"}`),
+	Error:       "connection refused",
+	Attempts:    1,
+}
+
+type mockCallbackRequester struct {
+	err      error
+	requests []*http.Request
+}
+
+func (r *mockCallbackRequester) Execute(req *http.Request) error {
+	r.requests = append(r.requests, req)
+	return r.err
+}
+
+func TestGetCallbackFailures(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("GetCallbackFailures").Return([]models.CallbackFailure{testCallbackFailure}, nil)
+
+	rr := httptest.NewRecorder()
+	lc := logger.NewMockClient()
+	restGetCallbackFailures(rr, lc, dbClientMock, errorconcept.NewErrorHandler(lc))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var failures []models.CallbackFailure
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &failures))
+	assert.Equal(t, []models.CallbackFailure{testCallbackFailure}, failures)
+}
+
+func TestRetryCallbackFailure(t *testing.T) {
+	tests := []struct {
+		name           string
+		getError       error
+		callbackError  error
+		expectedStatus int
+	}{
+		{"Callback succeeds", nil, nil, http.StatusOK},
+		{"Callback fails again", nil, errors.New("connection refused"), http.StatusServiceUnavailable},
+		{"Unknown failure", db.ErrNotFound, nil, http.StatusNotFound},
+		{"Database error", testError, nil, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("GetCallbackFailureById", testCallbackFailureId).Return(testCallbackFailure, tt.getError)
+			dbClientMock.On("DeleteCallbackFailureById", testCallbackFailureId).Return(nil)
+			dbClientMock.On("UpdateCallbackFailure", mock.Anything).Return(nil)
+			requester := &mockCallbackRequester{err: tt.callbackError}
+
+			req := httptest.NewRequest(http.MethodPost, "/"+DEVICESERVICE+"/"+CALLBACK+"/"+testCallbackFailureId+"/"+RETRY, nil)
+			req = mux.SetURLVars(req, map[string]string{ID: testCallbackFailureId})
+			rr := httptest.NewRecorder()
+			lc := logger.NewMockClient()
+			restRetryCallbackFailure(rr, req, lc, dbClientMock, errorconcept.NewErrorHandler(lc), requester)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			switch tt.expectedStatus {
+			case http.StatusOK:
+				require.Len(t, requester.requests, 1)
+				assert.Equal(t, testCallbackFailure.Method, requester.requests[0].Method)
+				assert.Equal(t, testCallbackFailure.URL, requester.requests[0].URL.String())
+				dbClientMock.AssertCalled(t, "DeleteCallbackFailureById", testCallbackFailureId)
+			case http.StatusServiceUnavailable:
+				updated := testCallbackFailure
+				updated.Attempts = 2
+				updated.Error = tt.callbackError.Error()
+				dbClientMock.AssertCalled(t, "UpdateCallbackFailure", mock.MatchedBy(func(f models.CallbackFailure) bool {
+					updated.LastAttempt = f.LastAttempt
+					return assert.ObjectsAreEqual(updated, f)
+				}))
+				dbClientMock.AssertNotCalled(t, "DeleteCallbackFailureById", testCallbackFailureId)
+			default:
+				assert.Empty(t, requester.requests)
+			}
+		})
+	}
+}
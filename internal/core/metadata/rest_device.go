@@ -28,8 +28,12 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	v2Interfaces "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/deprecation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
@@ -88,6 +92,13 @@ func restAddNewDevice(
 		return
 	}
 
+	if configuration.ServiceRegistration.RequireToken {
+		if err = checkDeviceServiceCredential(r, d.Service, dbClient); err != nil {
+			handleRegistrationTokenError(w, err, errorHandler)
+			return
+		}
+	}
+
 	ctx := r.Context()
 	// The following requester instance is necessary because we will be making an HTTP call to the device service
 	// associated with the new device in the Notifier below. There is no device service client. Additionally, the
@@ -101,7 +112,7 @@ func restAddNewDevice(
 	ch := make(chan device.DeviceEvent)
 	defer close(ch)
 
-	notifier := device.NewNotifier(ch, nc, configuration.Notifications, dbClient, requester, lc, ctx)
+	notifier := device.NewNotifier(ch, nc, configuration.Notifications, dbClient, dbClient, requester, lc, ctx)
 	go notifier.Execute()
 
 	op := device.NewAddDevice(ch, dbClient, d)
@@ -118,6 +129,11 @@ func restAddNewDevice(
 		return
 	}
 
+	// device services register the devices they discover through this endpoint
+	if added, err := dbClient.GetDeviceById(newId); err == nil {
+		application.DeviceAdded(added.Service.Name)
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(newId))
 }
@@ -142,6 +158,13 @@ func restUpdateDevice(
 		return
 	}
 
+	if configuration.ServiceRegistration.RequireToken {
+		if err = checkDeviceUpdateCredential(r, rd, dbClient); err != nil {
+			handleRegistrationTokenError(w, err, errorHandler)
+			return
+		}
+	}
+
 	ch := make(chan device.DeviceEvent)
 	defer close(ch)
 
@@ -153,7 +176,7 @@ func restUpdateDevice(
 		return
 	}
 
-	notifier := device.NewNotifier(ch, nc, configuration.Notifications, dbClient, requester, lc, ctx)
+	notifier := device.NewNotifier(ch, nc, configuration.Notifications, dbClient, dbClient, requester, lc, ctx)
 	go notifier.Execute()
 
 	op := device.NewUpdateDevice(ch, dbClient, rd, lc)
@@ -224,6 +247,40 @@ func restGetDeviceByProfileId(
 	_ = json.NewEncoder(w).Encode(res)
 }
 
+// restGetDevicesUsingDeprecatedResources reports every device whose profile still has deprecated deviceResources,
+// along with those resources and their replacements.
+func restGetDevicesUsingDeprecatedResources(
+	w http.ResponseWriter,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler) {
+
+	profiles, err := dbClient.GetAllDeviceProfiles()
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.RetrieveError_StatusInternalServer)
+		return
+	}
+
+	res := make([]deprecation.DeviceUsage, 0)
+	for _, p := range profiles {
+		resources := deprecation.ProfileResources(p)
+		if len(resources) == 0 {
+			continue
+		}
+
+		devices, err := dbClient.GetDevicesByProfileId(p.Id)
+		if err != nil {
+			errorHandler.Handle(w, err, errorconcept.Common.RetrieveError_StatusInternalServer)
+			return
+		}
+		for _, d := range devices {
+			res = append(res, deprecation.DeviceUsage{DeviceName: d.Name, ProfileName: p.Name, Resources: resources})
+		}
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
 func restGetDeviceByServiceId(
 	w http.ResponseWriter,
 	r *http.Request,
@@ -421,6 +478,7 @@ func restSetDeviceStateById(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	historyClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) {
@@ -444,6 +502,7 @@ func restSetDeviceStateById(
 		errorHandler.Handle(w, err, errorconcept.Common.UpdateError_StatusInternalServer)
 		return
 	}
+	recordDeviceStateTransition(r, updateMode, state, d, historyClient, configuration, lc)
 
 	// Notify
 	_ = notifyDeviceAssociates(d, http.MethodPut, r.Context(), lc, dbClient, nc, configuration)
@@ -456,6 +515,7 @@ func restSetDeviceStateByDeviceName(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	historyClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) {
@@ -484,6 +544,7 @@ func restSetDeviceStateByDeviceName(
 		errorHandler.Handle(w, err, errorconcept.Common.UpdateError_StatusInternalServer)
 		return
 	}
+	recordDeviceStateTransition(r, updateMode, state, d, historyClient, configuration, lc)
 
 	ctx := r.Context()
 	// Notify
@@ -502,11 +563,45 @@ func updateDeviceState(updateMode string, state string, d models.Device, dbClien
 	return dbClient.UpdateDevice(d)
 }
 
+// recordDeviceStateTransition records the change of the admin or operating state of the device in its state history,
+// with the reason told by the request
+func recordDeviceStateTransition(
+	r *http.Request,
+	updateMode string,
+	state string,
+	d models.Device,
+	historyClient v2Interfaces.DBClient,
+	configuration *config.ConfigurationStruct,
+	lc logger.LoggingClient) {
+
+	transition := pkgModels.DeviceStateTransition{
+		DeviceName: d.Name,
+		To:         strings.ToUpper(state),
+		Reason:     r.Header.Get(application.DeviceStateReasonHeader),
+		Timestamp:  db.MakeTimestamp(),
+	}
+	switch updateMode {
+	case ADMINSTATE:
+		transition.State, transition.From = pkgModels.DeviceStateAdmin, string(d.AdminState)
+	case OPSTATE:
+		transition.State, transition.From = pkgModels.DeviceStateOperating, string(d.OperatingState)
+	}
+	if transition.From == transition.To {
+		return
+	}
+	application.RecordDeviceStateTransitions(
+		[]pkgModels.DeviceStateTransition{transition},
+		historyClient,
+		configuration.StateHistory.Retention,
+		lc)
+}
+
 func restDeleteDeviceById(
 	w http.ResponseWriter,
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	trashClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) {
@@ -522,7 +617,7 @@ func restDeleteDeviceById(
 	}
 
 	ctx := r.Context()
-	if err := deleteDevice(d, w, ctx, lc, dbClient, errorHandler, nc, configuration); err != nil {
+	if err := deleteDevice(d, w, ctx, lc, dbClient, trashClient, errorHandler, nc, configuration); err != nil {
 		lc.Error(err.Error())
 		return
 	}
@@ -535,6 +630,7 @@ func restDeleteDeviceByName(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	trashClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) {
@@ -554,7 +650,7 @@ func restDeleteDeviceByName(
 	}
 
 	ctx := r.Context()
-	if err := deleteDevice(d, w, ctx, lc, dbClient, errorHandler, nc, configuration); err != nil {
+	if err := deleteDevice(d, w, ctx, lc, dbClient, trashClient, errorHandler, nc, configuration); err != nil {
 		lc.Error(err.Error())
 		return
 	}
@@ -570,6 +666,7 @@ func deleteDevice(
 	ctx context.Context,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	trashClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) error {
@@ -579,6 +676,14 @@ func deleteDevice(
 		return err
 	}
 
+	// Keep the device restorable
+	if configuration.Trash.Enabled {
+		if err := (trash{dbClient: trashClient}).TrashDevice(d); err != nil {
+			errorHandler.Handle(w, err, errorconcept.Common.DeleteError)
+			return err
+		}
+	}
+
 	if err := dbClient.DeleteDeviceById(d.Id); err != nil {
 		errorHandler.Handle(w, err, errorconcept.Common.DeleteError)
 		return err
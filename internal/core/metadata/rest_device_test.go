@@ -15,6 +15,7 @@
 package metadata
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -22,6 +23,7 @@ import (
 	metadataConfig "github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/deprecation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
@@ -30,6 +32,8 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetAllDevices(t *testing.T) {
@@ -82,3 +86,37 @@ func createGetDeviceLoaderMockFail() interfaces.DBClient {
 	dbMock.On("GetAllDevices").Return(nil, errors.New("unexpected error"))
 	return dbMock
 }
+
+func TestGetDevicesUsingDeprecatedResources(t *testing.T) {
+	deprecatedProfile := contract.DeviceProfile{
+		Id:   "deprecated",
+		Name: "Deprecated Profile",
+		DeviceResources: []contract.DeviceResource{
+			{Name: "Temperature", Attributes: map[string]string{"deprecated": "true", "replacement": "TemperatureCelsius"}},
+			{Name: "TemperatureCelsius"},
+		},
+	}
+	currentProfile := contract.DeviceProfile{
+		Id:              "current",
+		Name:            "Current Profile",
+		DeviceResources: []contract.DeviceResource{{Name: "Humidity"}},
+	}
+	profiles := []contract.DeviceProfile{deprecatedProfile, currentProfile}
+
+	dbMock := &mocks.DBClient{}
+	dbMock.On("GetAllDeviceProfiles").Return(profiles, nil)
+	dbMock.On("GetDevicesByProfileId", deprecatedProfile.Id).Return([]contract.Device{{Name: "Thermostat"}}, nil)
+
+	rr := httptest.NewRecorder()
+	var loggerMock = logger.NewMockClient()
+	restGetDevicesUsingDeprecatedResources(rr, dbMock, errorconcept.NewErrorHandler(loggerMock))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var res []deprecation.DeviceUsage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	require.Len(t, res, 1)
+	assert.Equal(t, "Thermostat", res[0].DeviceName)
+	assert.Equal(t, deprecatedProfile.Name, res[0].ProfileName)
+	assert.Equal(t, []deprecation.Resource{{Name: "Temperature", Replacement: "TemperatureCelsius"}}, res[0].Resources)
+	dbMock.AssertNotCalled(t, "GetDevicesByProfileId", currentProfile.Id)
+}
@@ -162,12 +162,13 @@ func restDeleteProfileByProfileId(
 	w http.ResponseWriter,
 	r *http.Request,
 	dbClient interfaces.DBClient,
+	trash device_profile.DeviceProfileTrasher,
 	errorHandler errorconcept.ErrorHandler) {
 
 	vars := mux.Vars(r)
 	var did = vars["id"]
 
-	op := device_profile.NewDeleteByIDExecutor(dbClient, did)
+	op := device_profile.NewDeleteByIDExecutor(dbClient, did, trash)
 	err := op.Execute()
 	if err != nil {
 		errorHandler.HandleManyVariants(
@@ -191,6 +192,7 @@ func restDeleteProfileByName(
 	w http.ResponseWriter,
 	r *http.Request,
 	dbClient interfaces.DBClient,
+	trash device_profile.DeviceProfileTrasher,
 	errorHandler errorconcept.ErrorHandler) {
 
 	vars := mux.Vars(r)
@@ -200,7 +202,7 @@ func restDeleteProfileByName(
 		return
 	}
 
-	op := device_profile.NewDeleteByNameExecutor(dbClient, n)
+	op := device_profile.NewDeleteByNameExecutor(dbClient, n, trash)
 	err = op.Execute()
 	if err != nil {
 		errorHandler.HandleManyVariants(
@@ -857,6 +857,7 @@ func TestDeleteDeviceProfileById(t *testing.T) {
 				rr,
 				tt.request,
 				tt.dbMock,
+				nil,
 				errorconcept.NewErrorHandler(logger.NewMockClient()))
 			response := rr.Result()
 			if response.StatusCode != tt.expectedStatus {
@@ -927,7 +928,7 @@ func TestDeleteDeviceProfileByName(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rr := httptest.NewRecorder()
-			restDeleteProfileByName(rr, tt.request, tt.dbMock, errorconcept.NewErrorHandler(logger.NewMockClient()))
+			restDeleteProfileByName(rr, tt.request, tt.dbMock, nil, errorconcept.NewErrorHandler(logger.NewMockClient()))
 			response := rr.Result()
 			if response.StatusCode != tt.expectedStatus {
 				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
@@ -24,8 +24,10 @@ import (
 	"strconv"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataErrors "github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device_service"
+	v2Interfaces "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
@@ -62,7 +64,8 @@ func restAddDeviceService(
 	w http.ResponseWriter,
 	r *http.Request,
 	dbClient interfaces.DBClient,
-	errorHandler errorconcept.ErrorHandler) {
+	errorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
 
 	defer r.Body.Close()
 	var ds models.DeviceService
@@ -72,6 +75,15 @@ func restAddDeviceService(
 		return
 	}
 
+	// Registration token check
+	var tokenHash string
+	if configuration.ServiceRegistration.RequireToken {
+		if tokenHash, err = checkRegistrationToken(r, ds.Name, dbClient); err != nil {
+			handleRegistrationTokenError(w, err, errorHandler)
+			return
+		}
+	}
+
 	// Addressable Check
 	// No ID or Name given for addressable
 	if ds.Addressable.Id == "" && ds.Addressable.Name == "" {
@@ -104,6 +116,19 @@ func restAddDeviceService(
 		return
 	}
 
+	// The token is redeemed last so a failed registration doesn't use it up
+	if tokenHash != "" {
+		if err = dbClient.RedeemRegistrationToken(tokenHash, ds.Id); err != nil {
+			// another registration redeemed the token first
+			_ = dbClient.DeleteDeviceServiceById(ds.Id)
+			if err == db.ErrNotFound {
+				err = metadataErrors.NewErrRegistrationTokenInvalid("unknown or already redeemed token")
+			}
+			handleRegistrationTokenError(w, err, errorHandler)
+			return
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(ds.Id))
 }
@@ -113,7 +138,8 @@ func restUpdateDeviceService(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	errorHandler errorconcept.ErrorHandler) {
+	errorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
 
 	defer r.Body.Close()
 	var from models.DeviceService
@@ -137,6 +163,13 @@ func restUpdateDeviceService(
 		}
 	}
 
+	if configuration.ServiceRegistration.RequireToken {
+		if err = checkDeviceServiceCredential(r, to, dbClient); err != nil {
+			handleRegistrationTokenError(w, err, errorHandler)
+			return
+		}
+	}
+
 	if err = updateDeviceServiceFields(from, &to, w, dbClient, errorHandler); err != nil {
 		lc.Error(err.Error())
 		return
@@ -318,6 +351,7 @@ func restDeleteServiceById(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	trashClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) {
@@ -337,7 +371,7 @@ func restDeleteServiceById(
 	}
 
 	ctx := r.Context()
-	if err = deleteDeviceService(ds, w, ctx, lc, dbClient, errorHandler, nc, configuration); err != nil {
+	if err = deleteDeviceService(ds, w, ctx, lc, dbClient, trashClient, errorHandler, nc, configuration); err != nil {
 		lc.Error(err.Error())
 		return
 	}
@@ -350,6 +384,7 @@ func restDeleteServiceByName(
 	r *http.Request,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	trashClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) {
@@ -374,7 +409,7 @@ func restDeleteServiceByName(
 
 	ctx := r.Context()
 	// Delete the device service
-	if err = deleteDeviceService(ds, w, ctx, lc, dbClient, errorHandler, nc, configuration); err != nil {
+	if err = deleteDeviceService(ds, w, ctx, lc, dbClient, trashClient, errorHandler, nc, configuration); err != nil {
 		lc.Error(err.Error())
 		return
 	}
@@ -392,6 +427,7 @@ func deleteDeviceService(
 	ctx context.Context,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
+	trashClient v2Interfaces.DBClient,
 	errorHandler errorconcept.ErrorHandler,
 	nc notifications.NotificationsClient,
 	configuration *config.ConfigurationStruct) error {
@@ -403,7 +439,7 @@ func deleteDeviceService(
 		return err
 	}
 	for _, device := range devices {
-		if err = deleteDevice(device, w, ctx, lc, dbClient, errorHandler, nc, configuration); err != nil {
+		if err = deleteDevice(device, w, ctx, lc, dbClient, trashClient, errorHandler, nc, configuration); err != nil {
 			return err
 		}
 	}
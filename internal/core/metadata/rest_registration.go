@@ -0,0 +1,194 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataErrors "github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// registrationTokenRequest is the body of a request minting a registration token
+type registrationTokenRequest struct {
+	ServiceName string `json:"serviceName"`
+	// TTL overrides the configured TokenTTL, e.g. '1h'
+	TTL string `json:"ttl"`
+}
+
+// Mint a one-time token granting the registration of the named device service
+// api/v1/deviceservice/token
+func restMintRegistrationToken(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	errorHandler errorconcept.ErrorHandler,
+	configuration *config.ConfigurationStruct) {
+
+	defer r.Body.Close()
+	var request registrationTokenRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		errorHandler.Handle(w, err, errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+	if request.ServiceName == "" {
+		errorHandler.Handle(
+			w,
+			goErrors.New("serviceName is required"),
+			errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	ttl := request.TTL
+	if ttl == "" {
+		ttl = configuration.ServiceRegistration.TokenTTL
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil || duration <= 0 {
+		errorHandler.Handle(
+			w,
+			fmt.Errorf("invalid registration token ttl '%s'", ttl),
+			errorconcept.Common.InvalidRequest_StatusBadRequest)
+		return
+	}
+
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		errorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+	minted := models.MintedRegistrationToken{
+		Token:       base64.RawURLEncoding.EncodeToString(secret),
+		ServiceName: request.ServiceName,
+	}
+	token := models.RegistrationToken{ServiceName: request.ServiceName, Created: db.MakeTimestamp()}
+	token.Expires = token.Created + duration.Milliseconds()
+	minted.Expires = token.Expires
+
+	if err = dbClient.AddRegistrationToken(application.RegistrationTokenHash(minted.Token), token); err != nil {
+		errorHandler.Handle(w, err, errorconcept.Default.InternalServerError)
+		return
+	}
+
+	lc.Info(fmt.Sprintf("minted a registration token for device service %s expiring in %s", request.ServiceName, ttl))
+	pkg.Encode(minted, w, lc)
+}
+
+// checkRegistrationToken checks the request carries a registration token granting the registration of the named
+// device service, and returns the hash of the token to redeem once the device service is registered
+func checkRegistrationToken(r *http.Request, serviceName string, dbClient interfaces.DBClient) (string, error) {
+	hash, edgeXerr := application.CheckRegistrationToken(r.Header.Get(RegistrationTokenHeader), serviceName, dbClient)
+	if edgeXerr != nil {
+		return "", registrationTokenError(edgeXerr, serviceName)
+	}
+	return hash, nil
+}
+
+// checkDeviceServiceCredential checks the request carries the registration token the device service was registered
+// with. Device services which can't be found are left to the caller to report.
+func checkDeviceServiceCredential(
+	r *http.Request,
+	service contract.DeviceService,
+	dbClient interfaces.DBClient) error {
+
+	var err error
+	if service.Id != "" {
+		service, err = dbClient.GetDeviceServiceById(service.Id)
+	} else {
+		service, err = dbClient.GetDeviceServiceByName(service.Name)
+	}
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	edgeXerr := application.CheckDeviceServiceCredential(r.Header.Get(RegistrationTokenHeader), service.Id, service.Name, dbClient)
+	if edgeXerr != nil {
+		return registrationTokenError(edgeXerr, service.Name)
+	}
+	return nil
+}
+
+// checkDeviceUpdateCredential checks the request carries the registration token of the device service of the updated
+// device, and of the device service the device is moved to if any. Devices which can't be found are left to the
+// caller to report.
+func checkDeviceUpdateCredential(r *http.Request, update contract.Device, dbClient interfaces.DBClient) error {
+	var d contract.Device
+	var err error
+	if update.Id != "" {
+		d, err = dbClient.GetDeviceById(update.Id)
+	} else {
+		d, err = dbClient.GetDeviceByName(update.Name)
+	}
+	if err != nil {
+		if err == db.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	if err = checkDeviceServiceCredential(r, d.Service, dbClient); err != nil {
+		return err
+	}
+	moved := (update.Service.Id != "" && update.Service.Id != d.Service.Id) ||
+		(update.Service.Id == "" && update.Service.Name != "" && update.Service.Name != d.Service.Name)
+	if moved {
+		return checkDeviceServiceCredential(r, update.Service, dbClient)
+	}
+	return nil
+}
+
+// registrationTokenError returns the error of a registration token check the v1 error handlers tell apart
+func registrationTokenError(edgeXerr errors.EdgeX, serviceName string) error {
+	switch {
+	case goErrors.Is(edgeXerr, application.ErrRegistrationTokenInvalid):
+		return metadataErrors.NewErrRegistrationTokenInvalid(edgeXerr.Message())
+	case goErrors.Is(edgeXerr, application.ErrRegistrationTokenForbidden):
+		return metadataErrors.NewErrRegistrationTokenForbidden(serviceName)
+	}
+	return edgeXerr
+}
+
+// handleRegistrationTokenError replies with the status of an error checking a registration token
+func handleRegistrationTokenError(w http.ResponseWriter, err error, errorHandler errorconcept.ErrorHandler) {
+	errorHandler.HandleManyVariants(
+		w,
+		err,
+		[]errorconcept.ErrorConceptType{
+			errorconcept.DeviceService.RegistrationTokenInvalid,
+			errorconcept.DeviceService.RegistrationTokenForbidden,
+		},
+		errorconcept.Default.InternalServerError)
+}
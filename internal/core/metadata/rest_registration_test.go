@@ -0,0 +1,215 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metadataConfig "github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegistrationToken = "registration-token"
+
+func registrationConfiguration() *metadataConfig.ConfigurationStruct {
+	return &metadataConfig.ConfigurationStruct{
+		ServiceRegistration: metadataConfig.ServiceRegistrationInfo{RequireToken: true, TokenTTL: "1h"},
+	}
+}
+
+func TestMintRegistrationToken(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		name           string
+		body           string
+		dbError        error
+		expectedStatus int
+	}{
+		{"OK", `{"serviceName":"device-virtual"}`, nil, http.StatusOK},
+		{"OK with TTL", `{"serviceName":"device-virtual","ttl":"5m"}`, nil, http.StatusOK},
+		{"Missing service name", `{"ttl":"5m"}`, nil, http.StatusBadRequest},
+		{"Invalid TTL", `{"serviceName":"device-virtual","ttl":"soon"}`, nil, http.StatusBadRequest},
+		{"Malformed body", `{`, nil, http.StatusBadRequest},
+		{"Database error", `{"serviceName":"device-virtual"}`, testError, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("AddRegistrationToken", mock.Anything, mock.Anything).Return(tt.dbError)
+
+			req := httptest.NewRequest(http.MethodPost, testDeviceServiceURI+"/"+TOKEN, strings.NewReader(tt.body))
+			rr := httptest.NewRecorder()
+			restMintRegistrationToken(rr, req, lc, dbClientMock, errorconcept.NewErrorHandler(lc), registrationConfiguration())
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var minted models.MintedRegistrationToken
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &minted))
+			assert.Equal(t, "device-virtual", minted.ServiceName)
+			assert.NotEmpty(t, minted.Token)
+
+			// only the hash of the token is stored
+			hash := dbClientMock.Calls[0].Arguments.String(0)
+			token := dbClientMock.Calls[0].Arguments.Get(1).(models.RegistrationToken)
+			assert.Equal(t, application.RegistrationTokenHash(minted.Token), hash)
+			assert.Equal(t, minted.Expires, token.Expires)
+			assert.Greater(t, token.Expires, token.Created)
+		})
+	}
+}
+
+func TestAddDeviceServiceWithRegistrationToken(t *testing.T) {
+	lc := logger.NewMockClient()
+	hash := application.RegistrationTokenHash(testRegistrationToken)
+	valid := models.RegistrationToken{ServiceName: testDeviceServiceName, Expires: db.MakeTimestamp() + 60000}
+
+	tests := []struct {
+		name           string
+		token          string
+		storedToken    models.RegistrationToken
+		getError       error
+		redeemError    error
+		expectedStatus int
+	}{
+		{"OK", testRegistrationToken, valid, nil, nil, http.StatusOK},
+		{"Missing token", "", valid, nil, nil, http.StatusUnauthorized},
+		{"Unknown token", "other-token", models.RegistrationToken{}, db.ErrNotFound, nil, http.StatusUnauthorized},
+		{
+			"Expired token",
+			testRegistrationToken,
+			models.RegistrationToken{ServiceName: testDeviceServiceName, Expires: db.MakeTimestamp() - 1},
+			nil, nil, http.StatusUnauthorized,
+		},
+		{
+			"Token of other service",
+			testRegistrationToken,
+			models.RegistrationToken{ServiceName: "other", Expires: valid.Expires},
+			nil, nil, http.StatusForbidden,
+		},
+		{"Token redeemed meanwhile", testRegistrationToken, valid, nil, db.ErrNotFound, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("GetRegistrationToken", mock.Anything).Return(tt.storedToken, tt.getError)
+			dbClientMock.On("GetAddressableByName", testAddressable.Name).Return(testAddressable, nil)
+			dbClientMock.On("AddDeviceService", mock.Anything).Return(testDeviceServiceId, nil)
+			dbClientMock.On("RedeemRegistrationToken", hash, testDeviceServiceId).Return(tt.redeemError)
+			dbClientMock.On("DeleteDeviceServiceById", testDeviceServiceId).Return(nil)
+
+			ds := contract.DeviceService{Name: testDeviceServiceName, Addressable: contract.Addressable{Name: testAddressable.Name}}
+			req := createDeviceServiceRequestWithBody(http.MethodPost, ds, nil)
+			if tt.token != "" {
+				req.Header.Set(RegistrationTokenHeader, tt.token)
+			}
+			rr := httptest.NewRecorder()
+			restAddDeviceService(rr, req, dbClientMock, errorconcept.NewErrorHandler(lc), registrationConfiguration())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.redeemError != nil {
+				dbClientMock.AssertCalled(t, "DeleteDeviceServiceById", testDeviceServiceId)
+			}
+			if tt.expectedStatus == http.StatusOK {
+				dbClientMock.AssertCalled(t, "RedeemRegistrationToken", hash, testDeviceServiceId)
+			}
+		})
+	}
+}
+
+func TestCheckDeviceServiceCredential(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		credential    string
+		credentialErr error
+		serviceErr    error
+		expectedErr   bool
+	}{
+		{"Registered token", testRegistrationToken, application.RegistrationTokenHash(testRegistrationToken), nil, nil, false},
+		{"Other token", "other-token", application.RegistrationTokenHash(testRegistrationToken), nil, nil, true},
+		{"Missing token", "", application.RegistrationTokenHash(testRegistrationToken), nil, nil, true},
+		{"Service registered without token", testRegistrationToken, "", db.ErrNotFound, nil, true},
+		{"Unknown service", "", "", nil, db.ErrNotFound, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("GetDeviceServiceByName", testDeviceServiceName).Return(testDeviceService, tt.serviceErr)
+			dbClientMock.On("GetDeviceServiceCredential", testDeviceServiceId).Return(tt.credential, tt.credentialErr)
+
+			req := httptest.NewRequest(http.MethodPost, "/"+DEVICE, nil)
+			if tt.token != "" {
+				req.Header.Set(RegistrationTokenHeader, tt.token)
+			}
+			err := checkDeviceServiceCredential(req, contract.DeviceService{Name: testDeviceServiceName}, dbClientMock)
+			assert.Equal(t, tt.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestCheckDeviceUpdateCredential(t *testing.T) {
+	otherService := contract.DeviceService{Id: "other-service-id", Name: "other service"}
+	device := contract.Device{Id: "device-id", Name: "device", Service: testDeviceService}
+	moved := device
+	moved.Service = contract.DeviceService{Name: otherService.Name}
+
+	tests := []struct {
+		name            string
+		token           string
+		update          contract.Device
+		otherCredential string
+		expectedErr     bool
+	}{
+		{"Token of the device service", testRegistrationToken, device, "", false},
+		{"Missing token", "", device, "", true},
+		{"Moved to a device service of another token", testRegistrationToken, moved, application.RegistrationTokenHash("other-token"), true},
+		{"Moved to a device service of the same token", testRegistrationToken, moved, application.RegistrationTokenHash(testRegistrationToken), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("GetDeviceById", device.Id).Return(device, nil)
+			dbClientMock.On("GetDeviceServiceById", testDeviceServiceId).Return(testDeviceService, nil)
+			dbClientMock.On("GetDeviceServiceByName", otherService.Name).Return(otherService, nil)
+			dbClientMock.On("GetDeviceServiceCredential", testDeviceServiceId).Return(application.RegistrationTokenHash(testRegistrationToken), nil)
+			dbClientMock.On("GetDeviceServiceCredential", otherService.Id).Return(tt.otherCredential, nil)
+
+			req := httptest.NewRequest(http.MethodPut, "/"+DEVICE, nil)
+			if tt.token != "" {
+				req.Header.Set(RegistrationTokenHeader, tt.token)
+			}
+			err := checkDeviceUpdateCredential(req, tt.update, dbClientMock)
+			assert.Equal(t, tt.expectedErr, err != nil)
+		})
+	}
+}
@@ -17,11 +17,20 @@ import (
 	"net/http"
 
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
 	errorContainer "github.com/edgexfoundry/edgex-go/internal/pkg/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/errorconcept"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/usage"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -57,6 +66,27 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Usage
+	r.HandleFunc(
+		usage.ApiUsageRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			usage.DefaultRecorder.ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Feature flags
+	r.HandleFunc(
+		featureflag.ApiFeatureFlagsRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.FeatureFlagsFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Configuration drift
+	r.HandleFunc(
+		configdrift.ApiConfigDriftRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.ConfigDriftDetectorFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	b := r.PathPrefix(clients.ApiBase).Subrouter()
 
 	loadDeviceRoutes(b, dic)
@@ -70,6 +100,12 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(usage.Middleware)
+	r.Use(bodylimit.NewMiddleware(metadataContainer.ConfigurationFrom(dic.Get).RequestLimits, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(claims.NewMiddleware(metadataContainer.ConfigurationFrom(dic.Get).TrustedHeaders, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(compression.NewMiddleware(func() compression.ResponsesInfo {
+		return metadataContainer.ConfigurationFrom(dic.Get).Writable.Compression
+	}))
 }
 
 func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
@@ -156,6 +192,15 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	d.HandleFunc(
+		"/"+DEPRECATEDRESOURCES,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDevicesUsingDeprecatedResources(
+				w,
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	// /api/v1/" + DEVICE" + ID + "
 	d.HandleFunc(
 		"/{"+ID+"}",
@@ -170,6 +215,7 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -182,6 +228,7 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -263,6 +310,7 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -275,6 +323,7 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -329,6 +378,53 @@ func loadDeviceRoutes(b *mux.Router, dic *di.Container) {
 				metadataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodPut)
 
+	// /api/v1/" + DEVICE/" + NAME + "/" + AUTOEVENT
+	n.HandleFunc(
+		"/{"+NAME+"}/"+AUTOEVENT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetAutoEventsByDeviceName(
+				w,
+				r,
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	n.HandleFunc(
+		"/{"+NAME+"}/"+AUTOEVENT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddAutoEventByDeviceName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.NotificationsClientFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	n.HandleFunc(
+		"/{"+NAME+"}/"+AUTOEVENT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restUpdateAutoEventByDeviceName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.NotificationsClientFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	n.HandleFunc(
+		"/{"+NAME+"}/"+AUTOEVENT+"/{"+RESOURCE+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteAutoEventByDeviceName(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.NotificationsClientFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
 }
 
 func loadDeviceProfileRoutes(b *mux.Router, dic *di.Container) {
@@ -385,6 +481,7 @@ func loadDeviceProfileRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				container.DBClientFrom(dic.Get),
+				profileTrasher(v2MetadataContainer.DBClientFrom(dic.Get), metadataContainer.ConfigurationFrom(dic.Get)),
 				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 	dp.HandleFunc(
@@ -469,6 +566,7 @@ func loadDeviceProfileRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				container.DBClientFrom(dic.Get),
+				profileTrasher(v2MetadataContainer.DBClientFrom(dic.Get), metadataContainer.ConfigurationFrom(dic.Get)),
 				errorContainer.ErrorHandlerFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 
@@ -611,7 +709,8 @@ func loadDeviceServiceRoutes(b *mux.Router, dic *di.Container) {
 				w,
 				r,
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get))
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodPost)
 
 	b.HandleFunc(
@@ -622,10 +721,52 @@ func loadDeviceServiceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
-				errorContainer.ErrorHandlerFrom(dic.Get))
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodPut)
 
 	ds := b.PathPrefix("/" + DEVICESERVICE).Subrouter()
+	ds.HandleFunc(
+		"/"+TOKEN,
+		func(w http.ResponseWriter, r *http.Request) {
+			restMintRegistrationToken(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get),
+				metadataContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	ds.HandleFunc(
+		"/"+CALLBACK,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetCallbackFailures(
+				w,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	ds.HandleFunc(
+		"/"+CALLBACK+"/{"+ID+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteCallbackFailure(
+				w,
+				r,
+				container.DBClientFrom(dic.Get),
+				errorContainer.ErrorHandlerFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+	ds.HandleFunc(
+		"/"+CALLBACK+"/{"+ID+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			errorHandler := errorContainer.ErrorHandlerFrom(dic.Get)
+			requester, err := device.NewRequester(device.Http, lc, r.Context())
+			if err != nil {
+				errorHandler.Handle(w, err, errorconcept.Device.RequesterError)
+				return
+			}
+			restRetryCallbackFailure(w, r, lc, container.DBClientFrom(dic.Get), errorHandler, requester)
+		}).Methods(http.MethodPost)
 	ds.HandleFunc(
 		"/"+ADDRESSABLENAME+"/{"+ADDRESSABLENAME+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -674,6 +815,7 @@ func loadDeviceServiceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -734,6 +876,7 @@ func loadDeviceServiceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -746,6 +889,7 @@ func loadDeviceServiceRoutes(b *mux.Router, dic *di.Container) {
 				r,
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get),
+				v2MetadataContainer.DBClientFrom(dic.Get),
 				errorContainer.ErrorHandlerFrom(dic.Get),
 				metadataContainer.NotificationsClientFrom(dic.Get),
 				metadataContainer.ConfigurationFrom(dic.Get))
@@ -0,0 +1,142 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package metadata
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/operators/device_profile"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	v2Interfaces "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// trash moves the devices and device profiles deleted through the v1 API to the trash, where they are restored from
+// as v2 objects
+type trash struct {
+	dbClient v2Interfaces.DBClient
+}
+
+// profileTrasher returns the trash the deleted device profiles are moved to, or nil when the trash is disabled
+func profileTrasher(trashClient v2Interfaces.DBClient, configuration *config.ConfigurationStruct) device_profile.DeviceProfileTrasher {
+	if !configuration.Trash.Enabled {
+		return nil
+	}
+	return trash{dbClient: trashClient}
+}
+
+// TrashDevice moves the device to the trash
+func (t trash) TrashDevice(d models.Device) error {
+	if err := application.TrashDevice(v2Device(d), t.dbClient); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TrashDeviceProfile moves the device profile to the trash
+func (t trash) TrashDeviceProfile(dp models.DeviceProfile) error {
+	if err := application.TrashDeviceProfile(v2DeviceProfile(dp), t.dbClient); err != nil {
+		return err
+	}
+	return nil
+}
+
+func v2Device(d models.Device) v2Models.Device {
+	protocols := make(map[string]v2Models.ProtocolProperties, len(d.Protocols))
+	for name, p := range d.Protocols {
+		protocols[name] = v2Models.ProtocolProperties(p)
+	}
+	autoEvents := make([]v2Models.AutoEvent, len(d.AutoEvents))
+	for i, a := range d.AutoEvents {
+		autoEvents[i] = v2Models.AutoEvent{Frequency: a.Frequency, OnChange: a.OnChange, Resource: a.Resource}
+	}
+	return v2Models.Device{
+		Timestamps:     v2Models.Timestamps{Created: d.Created, Modified: d.Modified},
+		Id:             d.Id,
+		Name:           d.Name,
+		Description:    d.Description,
+		AdminState:     v2Models.AdminState(d.AdminState),
+		OperatingState: v2Models.OperatingState(d.OperatingState),
+		Protocols:      protocols,
+		LastConnected:  d.LastConnected,
+		LastReported:   d.LastReported,
+		Labels:         d.Labels,
+		Location:       d.Location,
+		ServiceName:    d.Service.Name,
+		ProfileName:    d.Profile.Name,
+		AutoEvents:     autoEvents,
+	}
+}
+
+func v2DeviceProfile(dp models.DeviceProfile) v2Models.DeviceProfile {
+	resources := make([]v2Models.DeviceResource, len(dp.DeviceResources))
+	for i, r := range dp.DeviceResources {
+		v := r.Properties.Value
+		resources[i] = v2Models.DeviceResource{
+			Description: r.Description,
+			Name:        r.Name,
+			Tag:         r.Tag,
+			Properties: v2Models.PropertyValue{
+				Type:         v.Type,
+				ReadWrite:    v.ReadWrite,
+				Units:        r.Properties.Units.DefaultValue,
+				Minimum:      v.Minimum,
+				Maximum:      v.Maximum,
+				DefaultValue: v.DefaultValue,
+				Mask:         v.Mask,
+				Shift:        v.Shift,
+				Scale:        v.Scale,
+				Offset:       v.Offset,
+				Base:         v.Base,
+				Assertion:    v.Assertion,
+				MediaType:    v.MediaType,
+			},
+			Attributes: r.Attributes,
+		}
+	}
+	commands := make([]v2Models.ProfileResource, len(dp.DeviceCommands))
+	for i, c := range dp.DeviceCommands {
+		commands[i] = v2Models.ProfileResource{Name: c.Name, Get: v2ResourceOperations(c.Get), Set: v2ResourceOperations(c.Set)}
+	}
+	coreCommands := make([]v2Models.Command, len(dp.CoreCommands))
+	for i, c := range dp.CoreCommands {
+		coreCommands[i] = v2Models.Command{Name: c.Name, Get: c.Get.Path != "", Put: c.Put.Path != ""}
+	}
+	return v2Models.DeviceProfile{
+		Timestamps:      v2Models.Timestamps{Created: dp.Created, Modified: dp.Modified},
+		Description:     dp.Description,
+		Id:              dp.Id,
+		Name:            dp.Name,
+		Manufacturer:    dp.Manufacturer,
+		Model:           dp.Model,
+		Labels:          dp.Labels,
+		DeviceResources: resources,
+		DeviceCommands:  commands,
+		CoreCommands:    coreCommands,
+	}
+}
+
+func v2ResourceOperations(operations []models.ResourceOperation) []v2Models.ResourceOperation {
+	converted := make([]v2Models.ResourceOperation, len(operations))
+	for i, o := range operations {
+		deviceResource := o.DeviceResource
+		if deviceResource == "" {
+			deviceResource = o.Object
+		}
+		converted[i] = v2Models.ResourceOperation{DeviceResource: deviceResource, Parameter: o.Parameter, Mappings: o.Mappings}
+	}
+	return converted
+}
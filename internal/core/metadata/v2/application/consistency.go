@@ -0,0 +1,100 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	goErrors "errors"
+	"fmt"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// ErrMissingReference is wrapped in the error returned in strict referential integrity mode when a device references a
+// device profile or a device service which doesn't exist
+var ErrMissingReference = goErrors.New("referenced entity does not exist")
+
+// ErrProfileInUse is wrapped in the error returned in strict referential integrity mode when a device profile still
+// referenced by devices is deleted
+var ErrProfileInUse = goErrors.New("device profile is referenced by devices")
+
+func strictReferentialIntegrity(dic *di.Container) bool {
+	return metadataContainer.ConfigurationFrom(dic.Get).Writable.StrictReferentialIntegrity
+}
+
+// missingReference returns the error of a device referencing the missing entity, a conflict in strict referential
+// integrity mode and not found otherwise
+func missingReference(entity string, name string, dic *di.Container) errors.EdgeX {
+	if strictReferentialIntegrity(dic) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s '%s' does not exist", entity, name), ErrMissingReference)
+	}
+	return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s '%s' does not exists", entity, name), nil)
+}
+
+// validateProfileNotInUse rejects in strict referential integrity mode the deletion of a device profile referenced by
+// devices
+func validateProfileNotInUse(name string, dbClient interfaces.DBClient, dic *di.Container) errors.EdgeX {
+	if !strictReferentialIntegrity(dic) {
+		return nil
+	}
+	devices, edgeXerr := dbClient.DevicesByProfileName(0, -1, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(devices) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("device profile '%s' is referenced by %d device(s), e.g. '%s'", name, len(devices), devices[0].Name), ErrProfileInUse)
+	}
+	return nil
+}
+
+// DanglingReferences returns the devices referencing a device profile or a device service which doesn't exist
+func DanglingReferences(dic *di.Container) ([]metadataModels.DanglingReference, errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	devices, edgeXerr := dbClient.AllDevices(0, -1, nil)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	profiles := make(map[string]bool)
+	services := make(map[string]bool)
+	references := make([]metadataModels.DanglingReference, 0)
+	for _, d := range devices {
+		profileExists, ok := profiles[d.ProfileName]
+		if !ok {
+			profileExists, edgeXerr = dbClient.DeviceProfileNameExists(d.ProfileName)
+			if edgeXerr != nil {
+				return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+			profiles[d.ProfileName] = profileExists
+		}
+		serviceExists, ok := services[d.ServiceName]
+		if !ok {
+			serviceExists, edgeXerr = dbClient.DeviceServiceNameExists(d.ServiceName)
+			if edgeXerr != nil {
+				return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+			services[d.ServiceName] = serviceExists
+		}
+		if profileExists && serviceExists {
+			continue
+		}
+		reference := metadataModels.DanglingReference{DeviceName: d.Name}
+		if !profileExists {
+			reference.ProfileName = d.ProfileName
+		}
+		if !serviceExists {
+			reference.ServiceName = d.ServiceName
+		}
+		references = append(references, reference)
+	}
+	return references, nil
+}
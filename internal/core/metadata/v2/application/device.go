@@ -7,14 +7,24 @@ package application
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	goErrors "errors"
 	"fmt"
+	"sort"
+	"strings"
 
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
@@ -32,19 +42,36 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 	if edgeXerr != nil {
 		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
 	} else if !exists {
-		return id, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service '%s' does not exists", d.ServiceName), nil)
+		return id, missingReference("device service", d.ServiceName, dic)
+	}
+	edgeXerr = checkServiceOwnership(ctx, d.ServiceName, dic)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 	exists, edgeXerr = dbClient.DeviceProfileNameExists(d.ProfileName)
 	if edgeXerr != nil {
 		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
 	} else if !exists {
-		return id, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exists", d.ProfileName), nil)
+		return id, missingReference("device profile", d.ProfileName, dic)
+	}
+	edgeXerr = validateProtocols(d.Protocols, dbClient)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = validateLabels(d.Labels, dic)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = storeProtocolSecrets(&d, true, dic)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
 	addedDevice, err := dbClient.AddDevice(d)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
+	discoverySessions.deviceAdded(addedDevice.ServiceName)
 
 	lc.Debug(fmt.Sprintf(
 		"Device created on DB successfully. Device ID: %s, Correlation-ID: %s ",
@@ -55,7 +82,7 @@ func AddDevice(d models.Device, ctx context.Context, dic *di.Container) (id stri
 	return addedDevice.Id, nil
 }
 
-// DeleteDeviceById deletes the device by Id
+// DeleteDeviceById deletes the device by Id, or moves it to the trash when the trash is enabled
 func DeleteDeviceById(id string, dic *di.Container) errors.EdgeX {
 	if id == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
@@ -65,6 +92,13 @@ func DeleteDeviceById(id string, dic *di.Container) errors.EdgeX {
 		return errors.NewCommonEdgeX(errors.KindInvalidId, "fail to parse id as an UUID", err)
 	}
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	if trashEnabled(dic) {
+		d, err := dbClient.DeviceById(id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		return TrashDevice(d, dbClient)
+	}
 	err = dbClient.DeleteDeviceById(id)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -72,12 +106,19 @@ func DeleteDeviceById(id string, dic *di.Container) errors.EdgeX {
 	return nil
 }
 
-// DeleteDeviceByName deletes the device by name
+// DeleteDeviceByName deletes the device by name, or moves it to the trash when the trash is enabled
 func DeleteDeviceByName(name string, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	if trashEnabled(dic) {
+		d, err := dbClient.DeviceByName(name)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		return TrashDevice(d, dbClient)
+	}
 	err := dbClient.DeleteDeviceByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -132,8 +173,11 @@ func DeviceNameExists(name string, dic *di.Container) (exists bool, err errors.E
 	return exists, nil
 }
 
-// PatchDevice executes the PATCH operation with the device DTO to replace the old data
-func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container) errors.EdgeX {
+// PatchDevice executes the PATCH operation with the device DTO to replace the old data. When ifMatch is not empty, the
+// device is only patched if one of its entity tags matches the entity tag of the stored device. The patch fails with
+// ErrETagMismatch when the device is modified meanwhile. The changes of the admin and operating states are recorded in
+// the state history of the device, with the reason told by the context.
+func PatchDevice(dto dtos.UpdateDevice, ifMatch string, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
@@ -163,8 +207,17 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 	if dto.Name != nil && *dto.Name != device.Name {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device name '%s' not match the exsting '%s' ", *dto.Name, device.Name), nil)
 	}
+	edgeXerr = checkIfMatch(dtos.FromDeviceModelToDTO(device), ifMatch)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 
+	before := device
 	requests.ReplaceDeviceModelFieldsWithDTO(&device, dto)
+	edgeXerr = checkDeviceOwnership(ctx, before, device, dic)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 
 	exists, edgeXerr := dbClient.DeviceServiceNameExists(device.ServiceName)
 	if edgeXerr != nil {
@@ -178,17 +231,25 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 	} else if !exists {
 		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exists", device.ProfileName), nil)
 	}
-
-	edgeXerr = dbClient.DeleteDeviceById(device.Id)
+	edgeXerr = validateProtocols(device.Protocols, dbClient)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-
-	_, edgeXerr = dbClient.AddDevice(device)
+	edgeXerr = validateLabels(device.Labels, dic)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = storeProtocolSecrets(&device, false, dic)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
+	_, edgeXerr = dbClient.UpdateDevice(device, before.Modified)
+	if edgeXerr != nil {
+		return updateError(edgeXerr)
+	}
+	recordDeviceUpdateTransitions(before, device, ctx, dic)
+
 	lc.Debug(fmt.Sprintf(
 		"Device patched on DB successfully. Correlation-ID: %s ",
 		correlation.FromContext(ctx),
@@ -197,10 +258,15 @@ func PatchDevice(dto dtos.UpdateDevice, ctx context.Context, dic *di.Container)
 	return nil
 }
 
-// AllDevices query the devices with offset, limit, and labels
-func AllDevices(offset int, limit int, labels []string, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+// AllDevices query the devices with offset, limit, and labels, only those matching the filter expression when given
+func AllDevices(offset int, limit int, labels []string, expression filter.Expression, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
-	dps, err := dbClient.AllDevices(offset, limit, labels)
+	var dps []models.Device
+	if expression != nil {
+		dps, err = dbClient.DevicesByFilter(filter.AllOf(filter.Labels(labels), expression), offset, limit)
+	} else {
+		dps, err = dbClient.AllDevices(offset, limit, labels)
+	}
 	if err != nil {
 		return devices, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -211,6 +277,20 @@ func AllDevices(offset int, limit int, labels []string, dic *di.Container) (devi
 	return devices, nil
 }
 
+// DevicesModifiedSince query the devices modified at or after since, in milliseconds, with offset and limit
+func DevicesModifiedSince(since int, offset int, limit int, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	stored, err := dbClient.DevicesModifiedSince(since, offset, limit)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(stored))
+	for i, m := range stored {
+		devices[i] = dtos.FromDeviceModelToDTO(m)
+	}
+	return devices, nil
+}
+
 // DeviceByName query the device by name
 func DeviceByName(name string, dic *di.Container) (device dtos.Device, err errors.EdgeX) {
 	if name == "" {
@@ -241,3 +321,267 @@ func DevicesByProfileName(offset int, limit int, profileName string, dic *di.Con
 	}
 	return devices, nil
 }
+
+// DevicesByIndex query the devices holding the value of the named secondary index with offset and limit
+func DevicesByIndex(name string, value string, offset int, limit int, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+	if name == "" || value == "" {
+		return devices, errors.NewCommonEdgeX(errors.KindContractInvalid, "index name or value is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	deviceModels, err := dbClient.DevicesByIndex(name, value, offset, limit)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, nil
+}
+
+// protocolPropertyIndex returns the name of the secondary index declared on the property of the protocol, empty when
+// there is none
+func protocolPropertyIndex(protocol string, property string, dic *di.Container) string {
+	path := "Protocols." + protocol + "." + property
+	for name, index := range metadataContainer.ConfigurationFrom(dic.Get).DatabaseIndexes {
+		if strings.EqualFold(index.Path, path) {
+			return name
+		}
+	}
+	return ""
+}
+
+// DevicesByProtocolProperty query the devices whose property of the protocol holds the value with offset and limit,
+// i.e. the modbus-tcp devices with Address 10.0.0.5. The secondary index declared on the Protocols.<protocol>.<property>
+// path is used when there is one, all the devices are read otherwise.
+func DevicesByProtocolProperty(protocol string, property string, value string, offset int, limit int, dic *di.Container) (devices []dtos.Device, err errors.EdgeX) {
+	if protocol == "" || property == "" || value == "" {
+		return devices, errors.NewCommonEdgeX(errors.KindContractInvalid, "protocol, property or value is empty", nil)
+	}
+	if index := protocolPropertyIndex(protocol, property, dic); index != "" {
+		return DevicesByIndex(index, value, offset, limit, dic)
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	lc.Debug(fmt.Sprintf("no index declared on the %s property of protocol %s, reading all the devices", property, protocol))
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	deviceModels, err := dbClient.AllDevices(0, -1, nil)
+	if err != nil {
+		return devices, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = []dtos.Device{}
+	for _, d := range deviceModels {
+		if !protocolPropertyHolds(d.Protocols, protocol, property, value) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if limit >= 0 && len(devices) >= limit {
+			break
+		}
+		devices = append(devices, dtos.FromDeviceModelToDTO(d))
+	}
+	return devices, nil
+}
+
+// protocolPropertyHolds returns whether the property of the protocol holds the value, the protocol and property names
+// are matched regardless of their case like the paths of the secondary indexes
+func protocolPropertyHolds(protocols map[string]models.ProtocolProperties, protocol string, property string, value string) bool {
+	for name, properties := range protocols {
+		if !strings.EqualFold(name, protocol) {
+			continue
+		}
+		for key, v := range properties {
+			if strings.EqualFold(key, property) && v == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MergePatchDevice applies the JSON Merge Patch document to the device with the given name.  When ifMatch is not
+// empty, the patch is only applied if it matches the entity tag of the stored device.  The patch fails with
+// ErrETagMismatch when the device is modified meanwhile.  The entity tag of the patched device is returned. The changes
+// of the admin and operating states are recorded like those of PatchDevice.
+func MergePatchDevice(name string, patch []byte, ifMatch string, ctx context.Context, dic *di.Container) (etag string, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return etag, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	device, edgeXerr := dbClient.DeviceByName(name)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var dto dtos.Device
+	edgeXerr = applyMergePatch(dtos.FromDeviceModelToDTO(device), patch, ifMatch, &dto)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if dto.Id != device.Id || dto.Name != device.Name {
+		return etag, errors.NewCommonEdgeX(errors.KindContractInvalid, "device id and name can't be changed by a merge patch", nil)
+	}
+	edgeXerr = checkDeviceOwnership(ctx, device, dtos.ToDeviceModel(dto), dic)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	exists, edgeXerr := dbClient.DeviceServiceNameExists(dto.ServiceName)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("device service '%s' existence check failed", dto.ServiceName), edgeXerr)
+	} else if !exists {
+		return etag, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service '%s' does not exists", dto.ServiceName), nil)
+	}
+	exists, edgeXerr = dbClient.DeviceProfileNameExists(dto.ProfileName)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("device profile '%s' existence check failed", dto.ProfileName), edgeXerr)
+	} else if !exists {
+		return etag, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exists", dto.ProfileName), nil)
+	}
+	patchedDevice := dtos.ToDeviceModel(dto)
+	edgeXerr = validateProtocols(patchedDevice.Protocols, dbClient)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = validateLabels(patchedDevice.Labels, dic)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = storeProtocolSecrets(&patchedDevice, false, dic)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	patched, edgeXerr := dbClient.UpdateDevice(patchedDevice, device.Modified)
+	if edgeXerr != nil {
+		return etag, updateError(edgeXerr)
+	}
+	recordDeviceUpdateTransitions(device, patched, ctx, dic)
+
+	lc.Debug(fmt.Sprintf(
+		"Device merge patched on DB successfully. Correlation-ID: %s ",
+		correlation.FromContext(ctx),
+	))
+
+	return utils.ETag(dtos.FromDeviceModelToDTO(patched))
+}
+
+// CloneDevice adds a new device copying the profile, service, protocols, auto events, labels and other settings of the
+// device with the given name, overridden by the fields set in the request. The id of the new device is returned.
+func CloneDevice(name string, request metadataDTOs.CloneDeviceRequest, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return id, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if request.Id != nil {
+		return id, errors.NewCommonEdgeX(errors.KindContractInvalid, "the id of a cloned device can't be set", nil)
+	}
+	if request.Name == nil {
+		return id, errors.NewCommonEdgeX(errors.KindContractInvalid, "the name of the cloned device is required", nil)
+	}
+	if err := v2.Validate(request); err != nil {
+		return id, errors.NewCommonEdgeX(errors.KindContractInvalid, "clone device request validation failed", err)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+
+	device, edgeXerr := dbClient.DeviceByName(name)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	clone := device
+	clone.Id = ""
+	clone.Name = *request.Name
+	clone.Timestamps = models.Timestamps{}
+	clone.LastConnected = 0
+	clone.LastReported = 0
+	requests.ReplaceDeviceModelFieldsWithDTO(&clone, request.UpdateDevice)
+
+	id, edgeXerr = AddDevice(clone, ctx, dic)
+	if edgeXerr != nil {
+		return id, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return id, nil
+}
+
+// ErrDeleteNotConfirmed is wrapped in the error returned when a bulk device deletion lacks its confirm token
+var ErrDeleteNotConfirmed = goErrors.New("bulk device deletion requires a confirm token")
+
+// ErrDeleteConfirmMismatch is wrapped in the error returned when the confirm token of a bulk device deletion doesn't
+// match the devices it would delete
+var ErrDeleteConfirmMismatch = goErrors.New("confirm token doesn't match the devices to delete")
+
+// DeleteDevices deletes all the devices of the named device service or carrying the label, exactly one of which must
+// be given. The deletion must be confirmed by the token returned along with ErrDeleteNotConfirmed, which identifies
+// the matching devices, so that devices matching since the token was issued are never deleted unseen. It returns the
+// number of deleted devices, or on a confirmation error the number of matching devices and their confirm token. The
+// devices are moved to the trash when it is enabled.
+func DeleteDevices(serviceName string, label string, confirm string, dic *di.Container) (count int, confirmToken string, edgeXerr errors.EdgeX) {
+	if (serviceName == "") == (label == "") {
+		return 0, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "either serviceName or label must be specified", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	var filter string
+	var devices []models.Device
+	if serviceName != "" {
+		filter = v2.ServiceName + "=" + serviceName
+		devices, edgeXerr = dbClient.DevicesByServiceName(0, -1, serviceName)
+	} else {
+		filter = v2.Label + "=" + label
+		devices, edgeXerr = dbClient.AllDevices(0, -1, []string{label})
+	}
+	if edgeXerr != nil {
+		return 0, "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(devices) == 0 {
+		return 0, "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no device matches %s", filter), nil)
+	}
+
+	ids := make([]string, len(devices))
+	for i, d := range devices {
+		ids[i] = d.Id
+	}
+	sort.Strings(ids)
+	confirmToken = deleteConfirmToken(filter, ids)
+	if confirm == "" {
+		return len(ids), confirmToken, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("confirm the deletion of %d devices matching %s", len(ids), filter), ErrDeleteNotConfirmed)
+	} else if confirm != confirmToken {
+		return len(ids), confirmToken, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the devices matching %s changed since the deletion was confirmed", filter), ErrDeleteConfirmMismatch)
+	}
+
+	if trashEnabled(dic) {
+		for _, d := range devices {
+			if edgeXerr = TrashDevice(d, dbClient); edgeXerr != nil {
+				return count, "", errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+			count++
+		}
+		return count, "", nil
+	}
+	if serviceName != "" {
+		count, edgeXerr = dbClient.DeleteDevicesByServiceName(serviceName, ids)
+	} else {
+		count, edgeXerr = dbClient.DeleteDevicesByLabel(label, ids)
+	}
+	if edgeXerr != nil {
+		return 0, "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, "", nil
+}
+
+// deleteConfirmToken identifies the devices a bulk deletion matches by the filter and their sorted ids
+func deleteConfirmToken(filter string, ids []string) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(filter))
+	for _, id := range ids {
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(id))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
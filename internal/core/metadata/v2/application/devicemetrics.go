@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"time"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// AddDeviceMetrics adds the counters reported by core-data or core-command to the activity of the device today
+func AddDeviceMetrics(name string, counters devicemetrics.Counters, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if counters.CommandsIssued < 0 || counters.CommandFailures < 0 || counters.EventsReceived < 0 || counters.LastEventReceived < 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "counters can't be negative", nil)
+	}
+	config := metadataContainer.ConfigurationFrom(dic.Get)
+	retention, err := time.ParseDuration(config.DeviceMetrics.Retention)
+	if err != nil || retention <= 0 {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid DeviceMetrics.Retention '%s'", config.DeviceMetrics.Retention), err)
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	exists, edgeXerr := dbClient.DeviceNameExists(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s does not exist", name), nil)
+	}
+	if counters.IsZero() {
+		return nil
+	}
+	if edgeXerr = dbClient.AddDeviceMetrics(name, counters, time.Now(), retention); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// DeviceMetricsByName returns the daily activity counters of the device with offset and limit, newest day first
+func DeviceMetricsByName(name string, offset int, limit int, dic *di.Container) ([]devicemetrics.DailyCounters, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	exists, edgeXerr := dbClient.DeviceNameExists(name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !exists {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s does not exist", name), nil)
+	}
+	metrics, edgeXerr := dbClient.DeviceMetricsByName(name, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return metrics, nil
+}
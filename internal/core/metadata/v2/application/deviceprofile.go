@@ -11,6 +11,8 @@ import (
 
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -27,6 +29,11 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
+	err = validateLabels(d.Labels, dic)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
 	correlationId := correlation.FromContext(ctx)
 	addedDeviceProfile, err := dbClient.AddDeviceProfile(d)
 	if err != nil {
@@ -43,16 +50,36 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 }
 
 // The UpdateDeviceProfile function accepts the device profile model from the controller functions
-// and invokes updateDeviceProfile function in the infrastructure layer
-func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
+// and invokes updateDeviceProfile function in the infrastructure layer. When ifMatch is not empty, the device profile
+// is only updated if one of its entity tags matches the entity tag of the stored device profile, and fails with
+// ErrETagMismatch when the device profile is modified meanwhile.
+func UpdateDeviceProfile(d models.DeviceProfile, ifMatch string, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
-	err = dbClient.UpdateDeviceProfile(d)
+	err = validateLabels(d.Labels, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	var modified int64
+	if ifMatch != "" {
+		current, err := dbClient.DeviceProfileByName(d.Name)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = checkIfMatch(dtos.FromDeviceProfileModelToDTO(current), ifMatch)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		modified = current.Modified
+	}
+
+	err = dbClient.UpdateDeviceProfile(d, modified)
+	if err != nil {
+		return updateError(err)
+	}
+
 	lc.Debug(fmt.Sprintf(
 		"DeviceProfile updated on DB successfully. Correlation-id: %s ",
 		correlation.FromContext(ctx),
@@ -75,7 +102,7 @@ func DeviceProfileByName(name string, ctx context.Context, dic *di.Container) (d
 	return deviceProfile, nil
 }
 
-// DeleteDeviceProfileById delete the device profile by Id
+// DeleteDeviceProfileById delete the device profile by Id, or moves it to the trash when the trash is enabled
 func DeleteDeviceProfileById(id string, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if id == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
@@ -85,6 +112,19 @@ func DeleteDeviceProfileById(id string, ctx context.Context, dic *di.Container)
 		return errors.NewCommonEdgeX(errors.KindInvalidId, "fail to parse id as an UUID", err)
 	}
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	if strictReferentialIntegrity(dic) || trashEnabled(dic) {
+		dp, err := dbClient.DeviceProfileById(id)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		err = validateProfileNotInUse(dp.Name, dbClient, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if trashEnabled(dic) {
+			return TrashDeviceProfile(dp, dbClient)
+		}
+	}
 	err = dbClient.DeleteDeviceProfileById(id)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -92,23 +132,40 @@ func DeleteDeviceProfileById(id string, ctx context.Context, dic *di.Container)
 	return nil
 }
 
-// DeleteDeviceProfileByName delete the device profile by name
+// DeleteDeviceProfileByName delete the device profile by name, or moves it to the trash when the trash is enabled
 func DeleteDeviceProfileByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if name == "" {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
-	err := dbClient.DeleteDeviceProfileByName(name)
+	err := validateProfileNotInUse(name, dbClient, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if trashEnabled(dic) {
+		dp, err := dbClient.DeviceProfileByName(name)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		return TrashDeviceProfile(dp, dbClient)
+	}
+	err = dbClient.DeleteDeviceProfileByName(name)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	return nil
 }
 
-// AllDeviceProfiles query the device profiles with offset, and limit
-func AllDeviceProfiles(offset int, limit int, labels []string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, err errors.EdgeX) {
+// AllDeviceProfiles query the device profiles with offset, limit, and labels, only those matching the filter expression
+// when given
+func AllDeviceProfiles(offset int, limit int, labels []string, expression filter.Expression, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, err errors.EdgeX) {
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
-	dps, err := dbClient.AllDeviceProfiles(offset, limit, labels)
+	var dps []models.DeviceProfile
+	if expression != nil {
+		dps, err = dbClient.DeviceProfilesByFilter(filter.AllOf(filter.Labels(labels), expression), offset, limit)
+	} else {
+		dps, err = dbClient.AllDeviceProfiles(offset, limit, labels)
+	}
 	if err != nil {
 		return deviceProfiles, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -119,6 +176,20 @@ func AllDeviceProfiles(offset int, limit int, labels []string, dic *di.Container
 	return deviceProfiles, nil
 }
 
+// DeviceProfilesModifiedSince query the device profiles modified at or after since, in milliseconds, with offset and limit
+func DeviceProfilesModifiedSince(since int, offset int, limit int, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, err errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	stored, err := dbClient.DeviceProfilesModifiedSince(since, offset, limit)
+	if err != nil {
+		return deviceProfiles, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceProfiles = make([]dtos.DeviceProfile, len(stored))
+	for i, m := range stored {
+		deviceProfiles[i] = dtos.FromDeviceProfileModelToDTO(m)
+	}
+	return deviceProfiles, nil
+}
+
 // DeviceProfilesByModel query the device profiles with offset, limit and model
 func DeviceProfilesByModel(offset int, limit int, model string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, err errors.EdgeX) {
 	if model == "" {
@@ -172,3 +243,53 @@ func DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer st
 	}
 	return deviceProfiles, nil
 }
+
+// MergePatchDeviceProfile applies the JSON Merge Patch document to the device profile with the given name.  When
+// ifMatch is not empty, the patch is only applied if it matches the entity tag of the stored device profile.  The patch
+// fails with ErrETagMismatch when the device profile is modified meanwhile.  The entity tag of the patched device
+// profile is returned.
+func MergePatchDeviceProfile(name string, patch []byte, ifMatch string, ctx context.Context, dic *di.Container) (etag string, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return etag, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	dp, edgeXerr := dbClient.DeviceProfileByName(name)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var dto dtos.DeviceProfile
+	edgeXerr = applyMergePatch(dtos.FromDeviceProfileModelToDTO(dp), patch, ifMatch, &dto)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if dto.Id != dp.Id || dto.Name != dp.Name {
+		return etag, errors.NewCommonEdgeX(errors.KindContractInvalid, "device profile id and name can't be changed by a merge patch", nil)
+	}
+	if err := dtos.ValidateDeviceProfileDTO(dto); err != nil {
+		return etag, errors.NewCommonEdgeXWrapper(err)
+	}
+	edgeXerr = validateLabels(dto.Labels, dic)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	edgeXerr = dbClient.UpdateDeviceProfile(dtos.ToDeviceProfileModel(dto), dp.Modified)
+	if edgeXerr != nil {
+		return etag, updateError(edgeXerr)
+	}
+	// read the device profile back as the update sets the modified timestamp
+	patched, edgeXerr := dbClient.DeviceProfileByName(name)
+	if edgeXerr != nil {
+		return etag, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debug(fmt.Sprintf(
+		"DeviceProfile merge patched on DB successfully. Correlation-id: %s ",
+		correlation.FromContext(ctx),
+	))
+
+	return utils.ETag(dtos.FromDeviceProfileModelToDTO(patched))
+}
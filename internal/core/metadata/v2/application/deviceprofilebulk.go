@@ -0,0 +1,415 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"gopkg.in/yaml.v2"
+)
+
+// maxBulkDeviceProfileFileSize limits the size of the files read from the zip archive of a bulk upload
+const maxBulkDeviceProfileFileSize = 16 * 1024 * 1024
+
+// bulkDeviceProfile is a device profile of a bulk upload on its way to be applied
+type bulkDeviceProfile struct {
+	profile metadataDTOs.BulkDeviceProfile
+	file    string
+	// data is the YAML file the device profile was read from, kept once applied unless it extends another one
+	data []byte
+	// resolved is the device profile with what it inherits from its base, previous the stored one it updates
+	resolved models.DeviceProfile
+	previous *models.DeviceProfile
+	id       string
+	err      errors.EdgeX
+	// dependency is why the device profile wasn't applied although it is valid
+	dependency string
+}
+
+func (p *bulkDeviceProfile) result() metadataDTOs.BulkDeviceProfileResult {
+	result := metadataDTOs.BulkDeviceProfileResult{
+		Name:    p.profile.Name,
+		File:    p.file,
+		Extends: p.profile.Extends,
+		Id:      p.id,
+	}
+	if p.err == nil {
+		if p.previous != nil {
+			result.Action = metadataDTOs.BulkActionUpdate
+		} else {
+			result.Action = metadataDTOs.BulkActionAdd
+		}
+	}
+	switch {
+	case p.err != nil:
+		result.StatusCode = p.err.Code()
+		result.Message = p.err.Error()
+	case p.dependency != "":
+		result.StatusCode = http.StatusFailedDependency
+		result.Message = p.dependency
+	case p.previous != nil:
+		result.StatusCode = http.StatusOK
+	default:
+		result.StatusCode = http.StatusCreated
+	}
+	return result
+}
+
+// ApplyDeviceProfiles applies the device profiles of a bulk upload, see applyBulkDeviceProfiles
+func ApplyDeviceProfiles(dps []metadataDTOs.BulkDeviceProfile, ctx context.Context, dic *di.Container) ([]metadataDTOs.BulkDeviceProfileResult, errors.EdgeX) {
+	profiles := make([]*bulkDeviceProfile, len(dps))
+	for i, dp := range dps {
+		profiles[i] = &bulkDeviceProfile{profile: dp}
+	}
+	return applyBulkDeviceProfiles(profiles, ctx, dic)
+}
+
+// ApplyDeviceProfileBundle applies the device profiles of the YAML and JSON files of a zip archive, the other files
+// being ignored, see applyBulkDeviceProfiles
+func ApplyDeviceProfileBundle(data []byte, ctx context.Context, dic *di.Container) ([]metadataDTOs.BulkDeviceProfileResult, errors.EdgeX) {
+	profiles, edgeXerr := readDeviceProfileBundle(data)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	return applyBulkDeviceProfiles(profiles, ctx, dic)
+}
+
+// readDeviceProfileBundle reads the device profiles of the YAML and JSON files of a zip archive, a file which can't be
+// read or parsed being reported as a failed device profile
+func readDeviceProfileBundle(data []byte) ([]*bulkDeviceProfile, errors.EdgeX) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the zip archive", err)
+	}
+
+	var profiles []*bulkDeviceProfile
+	for _, f := range archive.File {
+		format := ""
+		switch strings.ToLower(path.Ext(f.Name)) {
+		case ".yaml", ".yml":
+			format = DeviceProfileFormatYaml
+		case ".json":
+			format = DeviceProfileFormatJson
+		}
+		if format == "" || f.FileInfo().IsDir() || strings.HasPrefix(path.Base(f.Name), ".") {
+			continue
+		}
+
+		p := &bulkDeviceProfile{file: f.Name}
+		profiles = append(profiles, p)
+		fileData, edgeXerr := readBundleFile(f)
+		if edgeXerr != nil {
+			p.err = edgeXerr
+			continue
+		}
+		if format == DeviceProfileFormatJson {
+			err = json.Unmarshal(fileData, &p.profile)
+		} else {
+			err = yaml.Unmarshal(fileData, &p.profile)
+			p.data = fileData
+		}
+		if err != nil {
+			p.err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s device profile", format), err)
+		}
+	}
+	return profiles, nil
+}
+
+func readBundleFile(f *zip.File) ([]byte, errors.EdgeX) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the file", err)
+	}
+	defer rc.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(rc, maxBulkDeviceProfileFileSize+1))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the file", err)
+	}
+	if len(data) > maxBulkDeviceProfileFileSize {
+		return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("file larger than %d bytes", maxBulkDeviceProfileFileSize), nil)
+	}
+	return data, nil
+}
+
+// applyBulkDeviceProfiles validates every device profile of a bulk upload, then adds or updates them all, or none.
+// A device profile extending another is applied after its base when the base is uploaded along, and inherits from
+// it, the base being otherwise looked up among the stored device profiles. Its deviceCommands must only reference
+// deviceResources it defines or inherits. A stored device profile modified since it was looked up fails to be updated.
+// When a device profile fails to be applied, the ones already applied are rolled back: the added ones are deleted and
+// the updated ones restored, without the YAML file they were uploaded from. The results are returned in the order the
+// device profiles were applied in, followed by the device profiles which couldn't be ordered, along with an error when
+// none was applied.
+func applyBulkDeviceProfiles(profiles []*bulkDeviceProfile, ctx context.Context, dic *di.Container) ([]metadataDTOs.BulkDeviceProfileResult, errors.EdgeX) {
+	if len(profiles) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "no device profile to apply", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	byName := make(map[string]*bulkDeviceProfile)
+	for _, p := range profiles {
+		if p.err != nil || p.profile.Name == "" {
+			continue
+		}
+		if _, ok := byName[p.profile.Name]; ok {
+			p.err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile %s uploaded more than once", p.profile.Name), nil)
+			continue
+		}
+		byName[p.profile.Name] = p
+	}
+
+	// The device profiles are placed once their base is, so that the bases are resolved and applied first.
+	ordered := make([]*bulkDeviceProfile, 0, len(profiles))
+	placed := make(map[*bulkDeviceProfile]bool)
+	for progress := true; progress; {
+		progress = false
+		for _, p := range profiles {
+			if placed[p] || p.err != nil {
+				continue
+			}
+			base, uploaded := byName[p.profile.Extends]
+			if uploaded && base != p && base.err != nil {
+				p.err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("base device profile %s is invalid", base.profile.Name), nil)
+				progress = true
+				continue
+			}
+			if uploaded && !placed[base] {
+				continue
+			}
+			var baseProfile *models.DeviceProfile
+			if uploaded {
+				baseProfile = &base.resolved
+			}
+			p.err = resolveBulkDeviceProfile(p, baseProfile, dbClient, dic)
+			placed[p] = true
+			ordered = append(ordered, p)
+			progress = true
+		}
+	}
+
+	failed := 0
+	for _, p := range profiles {
+		if !placed[p] && p.err == nil {
+			p.err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile %s extends itself through %s", p.profile.Name, p.profile.Extends), nil)
+		}
+		if p.err != nil {
+			failed++
+		}
+	}
+	var applyErr errors.EdgeX
+	if failed > 0 {
+		applyErr = firstBulkError(profiles, fmt.Sprintf("%d of %d device profiles are invalid, none was applied", failed, len(profiles)))
+		for _, p := range ordered {
+			if p.err == nil {
+				p.dependency = "not applied, other device profiles are invalid"
+			}
+		}
+	} else {
+		applyErr = applyOrderedDeviceProfiles(ordered, dbClient, lc)
+	}
+
+	results := make([]metadataDTOs.BulkDeviceProfileResult, 0, len(profiles))
+	for _, p := range ordered {
+		results = append(results, p.result())
+	}
+	for _, p := range profiles {
+		if !placed[p] {
+			results = append(results, p.result())
+		}
+	}
+	if applyErr != nil {
+		return results, applyErr
+	}
+
+	for _, p := range ordered {
+		if p.data != nil && p.profile.Extends == "" {
+			KeepDeviceProfileYaml(p.profile.Name, p.data, ctx, dic)
+		}
+	}
+	lc.Info(fmt.Sprintf(
+		"%d DeviceProfiles applied from a bulk upload. Correlation-id: %s ",
+		len(ordered),
+		correlation.FromContext(ctx),
+	))
+	return results, nil
+}
+
+// resolveBulkDeviceProfile resolves the device profile with what it inherits from its base, which is looked up among
+// the stored device profiles when not given, validates it and looks up the stored device profile it updates
+func resolveBulkDeviceProfile(p *bulkDeviceProfile, base *models.DeviceProfile, dbClient interfaces.DBClient, dic *di.Container) errors.EdgeX {
+	dto := p.profile.DeviceProfile
+	if extends := p.profile.Extends; extends != "" {
+		if base == nil {
+			stored, edgeXerr := dbClient.DeviceProfileByName(extends)
+			if edgeXerr != nil {
+				return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("base device profile %s not found", extends), edgeXerr)
+			}
+			base = &stored
+		}
+		dto = extendDeviceProfile(dtos.FromDeviceProfileModelToDTO(*base), dto)
+	}
+	if err := v2.Validate(dto); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if edgeXerr := validateResourceReferences(dto); edgeXerr != nil {
+		return edgeXerr
+	}
+	if edgeXerr := validateLabels(dto.Labels, dic); edgeXerr != nil {
+		return edgeXerr
+	}
+	p.resolved = dtos.ToDeviceProfileModel(dto)
+	p.resolved.Id = ""
+
+	previous, edgeXerr := dbClient.DeviceProfileByName(dto.Name)
+	if edgeXerr == nil {
+		p.previous = &previous
+	} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// extendDeviceProfile returns the device profile with the deviceResources, deviceCommands and coreCommands of its base
+// it doesn't define itself, and the manufacturer, model and description of its base when it leaves them empty
+func extendDeviceProfile(base dtos.DeviceProfile, dp dtos.DeviceProfile) dtos.DeviceProfile {
+	if dp.Manufacturer == "" {
+		dp.Manufacturer = base.Manufacturer
+	}
+	if dp.Model == "" {
+		dp.Model = base.Model
+	}
+	if dp.Description == "" {
+		dp.Description = base.Description
+	}
+
+	resources := make([]dtos.DeviceResource, 0, len(base.DeviceResources)+len(dp.DeviceResources))
+	defined := make(map[string]bool)
+	for _, r := range dp.DeviceResources {
+		defined[r.Name] = true
+	}
+	for _, r := range base.DeviceResources {
+		if !defined[r.Name] {
+			resources = append(resources, r)
+		}
+	}
+	dp.DeviceResources = append(resources, dp.DeviceResources...)
+
+	commands := make([]dtos.ProfileResource, 0, len(base.DeviceCommands)+len(dp.DeviceCommands))
+	defined = make(map[string]bool)
+	for _, c := range dp.DeviceCommands {
+		defined[c.Name] = true
+	}
+	for _, c := range base.DeviceCommands {
+		if !defined[c.Name] {
+			commands = append(commands, c)
+		}
+	}
+	dp.DeviceCommands = append(commands, dp.DeviceCommands...)
+
+	coreCommands := make([]dtos.Command, 0, len(base.CoreCommands)+len(dp.CoreCommands))
+	defined = make(map[string]bool)
+	for _, c := range dp.CoreCommands {
+		defined[c.Name] = true
+	}
+	for _, c := range base.CoreCommands {
+		if !defined[c.Name] {
+			coreCommands = append(coreCommands, c)
+		}
+	}
+	dp.CoreCommands = append(coreCommands, dp.CoreCommands...)
+	return dp
+}
+
+// validateResourceReferences checks that the resource operations of the deviceCommands reference deviceResources of
+// the device profile
+func validateResourceReferences(dp dtos.DeviceProfile) errors.EdgeX {
+	resources := make(map[string]bool)
+	for _, r := range dp.DeviceResources {
+		resources[r.Name] = true
+	}
+	for _, c := range dp.DeviceCommands {
+		for _, ro := range append(append([]dtos.ResourceOperation(nil), c.Get...), c.Set...) {
+			if !resources[ro.DeviceResource] {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("deviceCommand %s references unknown deviceResource %s", c.Name, ro.DeviceResource), nil)
+			}
+		}
+	}
+	return nil
+}
+
+// applyOrderedDeviceProfiles adds or updates the device profiles in order, rolling back the ones applied when one
+// fails
+func applyOrderedDeviceProfiles(ordered []*bulkDeviceProfile, dbClient interfaces.DBClient, lc logger.LoggingClient) errors.EdgeX {
+	for i, p := range ordered {
+		var edgeXerr errors.EdgeX
+		if p.previous != nil {
+			p.resolved.Id = p.previous.Id
+			edgeXerr = dbClient.UpdateDeviceProfile(p.resolved, p.previous.Modified)
+			p.id = p.previous.Id
+		} else {
+			var added models.DeviceProfile
+			added, edgeXerr = dbClient.AddDeviceProfile(p.resolved)
+			p.id = added.Id
+		}
+		if edgeXerr == nil {
+			continue
+		}
+
+		p.err = updateError(edgeXerr)
+		p.id = ""
+		for j := i - 1; j >= 0; j-- {
+			applied := ordered[j]
+			var rollbackErr errors.EdgeX
+			if applied.previous != nil {
+				rollbackErr = dbClient.UpdateDeviceProfile(*applied.previous, 0)
+			} else {
+				rollbackErr = dbClient.DeleteDeviceProfileByName(applied.profile.Name)
+				applied.id = ""
+			}
+			if rollbackErr != nil {
+				lc.Error(fmt.Sprintf("failed to roll back DeviceProfile %s of a bulk upload: %s", applied.profile.Name, rollbackErr.Error()))
+			}
+			applied.dependency = fmt.Sprintf("rolled back, device profile %s failed to be applied", p.profile.Name)
+		}
+		for _, next := range ordered[i+1:] {
+			next.dependency = fmt.Sprintf("not applied, device profile %s failed to be applied", p.profile.Name)
+		}
+		return firstBulkError(ordered, fmt.Sprintf("device profile %s failed to be applied, none was applied", p.profile.Name))
+	}
+	return nil
+}
+
+// firstBulkError returns the error of the bulk upload, of the kind of the first device profile which failed
+func firstBulkError(profiles []*bulkDeviceProfile, message string) errors.EdgeX {
+	for _, p := range profiles {
+		if p.err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(p.err), message, nil)
+		}
+	}
+	return errors.NewCommonEdgeX(errors.KindServerError, message, nil)
+}
@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Formats of the device profiles of a bundle
+const (
+	DeviceProfileFormatYaml = "yaml"
+	DeviceProfileFormatJson = "json"
+)
+
+// KeepDeviceProfileYaml keeps the YAML file the device profile was uploaded from, so that it is downloaded as
+// authored until it is modified. The device profile is downloaded re-encoded when the file can't be kept.
+func KeepDeviceProfileYaml(name string, data []byte, ctx context.Context, dic *di.Container) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	err := dbClient.SetDeviceProfileYaml(name, data)
+	if err != nil {
+		lc.Warn(fmt.Sprintf(
+			"YAML file of DeviceProfile %s not kept, it will be downloaded re-encoded: %s. Correlation-id: %s ",
+			name,
+			err.Error(),
+			correlation.FromContext(ctx),
+		))
+	}
+}
+
+// DeviceProfileYamlByName returns the YAML file the device profile was uploaded from, or the device profile encoded
+// as YAML when it wasn't uploaded as a YAML file or was modified since
+func DeviceProfileYamlByName(name string, dic *di.Container) ([]byte, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	dp, err := dbClient.DeviceProfileByName(name)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceProfileYaml(dp, dbClient)
+}
+
+// DeviceProfilesBundle returns a zip archive of the named device profiles, or of the device profiles carrying the
+// labels with offset and limit when no name is given, holding a <name>.yaml or <name>.json file per device profile
+func DeviceProfilesBundle(names []string, offset int, limit int, labels []string, format string, dic *di.Container) ([]byte, errors.EdgeX) {
+	if format != DeviceProfileFormatYaml && format != DeviceProfileFormatJson {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("format '%s' is not %s or %s", format, DeviceProfileFormatYaml, DeviceProfileFormatJson), nil)
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	var dps []models.DeviceProfile
+	if len(names) > 0 {
+		seen := make(map[string]bool)
+		for _, name := range names {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			dp, err := dbClient.DeviceProfileByName(name)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			dps = append(dps, dp)
+		}
+	} else {
+		var err errors.EdgeX
+		dps, err = dbClient.AllDeviceProfiles(offset, limit, labels)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, dp := range dps {
+		var data []byte
+		var err errors.EdgeX
+		if format == DeviceProfileFormatYaml {
+			data, err = deviceProfileYaml(dp, dbClient)
+		} else {
+			data, err = deviceProfileJson(dp)
+		}
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		f, writeErr := archive.Create(dp.Name + "." + format)
+		if writeErr == nil {
+			_, writeErr = f.Write(data)
+		}
+		if writeErr != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to write the device profile bundle", writeErr)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to write the device profile bundle", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// deviceProfileYaml returns the YAML file the device profile was uploaded from, or the device profile encoded as YAML
+func deviceProfileYaml(dp models.DeviceProfile, dbClient interfaces.DBClient) ([]byte, errors.EdgeX) {
+	data, err := dbClient.DeviceProfileYamlByName(dp.Name)
+	if err == nil {
+		return data, nil
+	} else if errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the DTO isn't tagged for YAML beyond the profile itself, its version and id are left out like in authored files
+	encoded, marshalErr := yaml.Marshal(dtos.FromDeviceProfileModelToDTO(dp))
+	if marshalErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile as YAML", marshalErr)
+	}
+	var document yaml.MapSlice
+	if marshalErr = yaml.Unmarshal(encoded, &document); marshalErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile as YAML", marshalErr)
+	}
+	profile := make(yaml.MapSlice, 0, len(document))
+	for _, item := range document {
+		if item.Key != "versionable" && item.Key != "id" {
+			profile = append(profile, item)
+		}
+	}
+	encoded, marshalErr = yaml.Marshal(profile)
+	if marshalErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile as YAML", marshalErr)
+	}
+	return encoded, nil
+}
+
+// deviceProfileJson returns the device profile encoded as indented JSON, without id like the profiles to add
+func deviceProfileJson(dp models.DeviceProfile) ([]byte, errors.EdgeX) {
+	dto := dtos.FromDeviceProfileModelToDTO(dp)
+	dto.Id = ""
+	data, err := json.MarshalIndent(dto, "", "  ")
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the device profile as JSON", err)
+	}
+	return data, nil
+}
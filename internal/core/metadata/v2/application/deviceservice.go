@@ -10,7 +10,9 @@ import (
 	"fmt"
 
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -28,12 +30,34 @@ func AddDeviceService(d models.DeviceService, ctx context.Context, dic *di.Conta
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
 	lc := container.LoggingClientFrom(dic.Get)
 
+	err = validateLabels(d.Labels, dic)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	var tokenHash string
+	if registrationRequired(dic) {
+		tokenHash, err = CheckRegistrationToken(registrationTokenFrom(ctx), d.Name, pkgContainer.DBClientFrom(dic.Get))
+		if err != nil {
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
 	correlationId := correlation.FromContext(ctx)
 	addedDeviceService, err := dbClient.AddDeviceService(d)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 
+	// The token is redeemed last so a failed registration doesn't use it up
+	if tokenHash != "" {
+		err = RedeemRegistrationToken(tokenHash, addedDeviceService.Id, pkgContainer.DBClientFrom(dic.Get))
+		if err != nil {
+			// another registration redeemed the token first
+			_ = dbClient.DeleteDeviceServiceById(addedDeviceService.Id)
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
 	lc.Debug(fmt.Sprintf(
 		"DeviceService created on DB successfully. DeviceService ID: %s, Correlation-ID: %s ",
 		addedDeviceService.Id,
@@ -78,8 +102,16 @@ func PatchDeviceService(dto dtos.UpdateDeviceService, ctx context.Context, dic *
 	if dto.Name != nil && *dto.Name != deviceService.Name {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device service name '%s' not match the exsting '%s' ", *dto.Name, deviceService.Name), nil)
 	}
+	edgeXerr = checkServiceCredential(ctx, deviceService, dic)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 
 	requests.ReplaceDeviceServiceModelFieldsWithDTO(&deviceService, dto)
+	edgeXerr = validateLabels(deviceService.Labels, dic)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 
 	edgeXerr = dbClient.DeleteDeviceServiceById(deviceService.Id)
 	if edgeXerr != nil {
@@ -129,10 +161,16 @@ func DeleteDeviceServiceByName(name string, ctx context.Context, dic *di.Contain
 	return nil
 }
 
-// AllDeviceServices query the device services with labels, offset, and limit
-func AllDeviceServices(offset int, limit int, labels []string, ctx context.Context, dic *di.Container) (deviceServices []dtos.DeviceService, err errors.EdgeX) {
+// AllDeviceServices query the device services with labels, offset, and limit, only those matching the filter expression
+// when given
+func AllDeviceServices(offset int, limit int, labels []string, expression filter.Expression, ctx context.Context, dic *di.Container) (deviceServices []dtos.DeviceService, err errors.EdgeX) {
 	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
-	services, err := dbClient.AllDeviceServices(offset, limit, labels)
+	var services []models.DeviceService
+	if expression != nil {
+		services, err = dbClient.DeviceServicesByFilter(filter.AllOf(filter.Labels(labels), expression), offset, limit)
+	} else {
+		services, err = dbClient.AllDeviceServices(offset, limit, labels)
+	}
 	if err != nil {
 		return deviceServices, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -143,3 +181,17 @@ func AllDeviceServices(offset int, limit int, labels []string, ctx context.Conte
 	}
 	return deviceServices, nil
 }
+
+// DeviceServicesModifiedSince query the device services modified at or after since, in milliseconds, with offset and limit
+func DeviceServicesModifiedSince(since int, offset int, limit int, dic *di.Container) (deviceServices []dtos.DeviceService, err errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	stored, err := dbClient.DeviceServicesModifiedSince(since, offset, limit)
+	if err != nil {
+		return deviceServices, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceServices = make([]dtos.DeviceService, len(stored))
+	for i, m := range stored {
+		deviceServices[i] = dtos.FromDeviceServiceModelToDTO(m)
+	}
+	return deviceServices, nil
+}
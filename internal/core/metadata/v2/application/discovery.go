@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/google/uuid"
+)
+
+// Status of a device service within a discovery session
+const (
+	DiscoveryTriggered   = "TRIGGERED"
+	DiscoveryUnsupported = "UNSUPPORTED"
+	DiscoverySkipped     = "SKIPPED"
+	DiscoveryFailed      = "FAILED"
+)
+
+// maxDiscoverySessions is the number of discovery sessions kept in memory, the oldest session is dropped first
+const maxDiscoverySessions = 100
+
+// DiscoverySessionsInMemory is the message of the discovery session responses, telling the sessions aren't persisted
+const DiscoverySessionsInMemory = "discovery sessions are kept in memory only, and are lost when core-metadata restarts"
+
+// discoverySessions holds the discovery sessions triggered since the service started
+var discoverySessions = &discoverySessionStore{sessions: make(map[string]*metadataDTOs.DiscoverySession)}
+
+type discoverySessionStore struct {
+	mutex    sync.Mutex
+	sessions map[string]*metadataDTOs.DiscoverySession
+	order    []string
+}
+
+func (s *discoverySessionStore) add(session metadataDTOs.DiscoverySession) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.order) >= maxDiscoverySessions {
+		delete(s.sessions, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.sessions[session.Id] = &session
+	s.order = append(s.order, session.Id)
+}
+
+func (s *discoverySessionStore) get(id string) (metadataDTOs.DiscoverySession, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return metadataDTOs.DiscoverySession{}, false
+	}
+	result := *session
+	result.Services = append([]metadataDTOs.DiscoveryService(nil), session.Services...)
+	result.Completed = time.Now().UnixNano()/int64(time.Millisecond) >= session.Ends
+	return result, true
+}
+
+// deviceAdded counts a device added by the device service in every open session the service was triggered in
+func (s *discoverySessionStore) deviceAdded(serviceName string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	for _, session := range s.sessions {
+		if now >= session.Ends {
+			continue
+		}
+		for i := range session.Services {
+			if session.Services[i].ServiceName == serviceName && session.Services[i].Status == DiscoveryTriggered {
+				session.Services[i].Discovered++
+			}
+		}
+	}
+}
+
+// DeviceAdded counts a device added by the device service through the V1 API, as the device services of this release
+// do, in the open discovery sessions the device service was triggered in
+func DeviceAdded(serviceName string) {
+	discoverySessions.deviceAdded(serviceName)
+}
+
+// TriggerDiscovery requests discovery from every unlocked device service and opens a discovery session. Devices the
+// triggered device services add while the session is open are counted per device service.
+func TriggerDiscovery(ctx context.Context, dic *di.Container) (session metadataDTOs.DiscoverySession, edgeXerr errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+	config := metadataContainer.ConfigurationFrom(dic.Get)
+
+	duration, err := time.ParseDuration(config.Writable.DiscoverySessionDuration)
+	if err != nil {
+		return session, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid DiscoverySessionDuration '%s'", config.Writable.DiscoverySessionDuration), err)
+	}
+
+	deviceServices, edgeXerr := dbClient.AllDeviceServices(0, -1, nil)
+	if edgeXerr != nil {
+		return session, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	correlationId := correlation.FromContext(ctx)
+	client := &http.Client{Timeout: time.Duration(config.Service.Timeout) * time.Millisecond}
+	started := time.Now()
+	session = metadataDTOs.DiscoverySession{
+		Id:       uuid.New().String(),
+		Started:  started.UnixNano() / int64(time.Millisecond),
+		Ends:     started.Add(duration).UnixNano() / int64(time.Millisecond),
+		Services: make([]metadataDTOs.DiscoveryService, len(deviceServices)),
+	}
+
+	var wg sync.WaitGroup
+	for i, ds := range deviceServices {
+		session.Services[i].ServiceName = ds.Name
+		if ds.AdminState == models.Locked {
+			session.Services[i].Status = DiscoverySkipped
+			session.Services[i].Message = "device service is locked"
+			continue
+		}
+		wg.Add(1)
+		go func(ds models.DeviceService, result *metadataDTOs.DiscoveryService) {
+			defer wg.Done()
+			result.Status, result.Message = requestDiscovery(client, ds, correlationId)
+		}(ds, &session.Services[i])
+	}
+	wg.Wait()
+
+	discoverySessions.add(session)
+	lc.Debug(fmt.Sprintf(
+		"Discovery triggered on %d device service(s). Session ID: %s, Correlation-ID: %s ",
+		len(deviceServices),
+		session.Id,
+		correlationId,
+	))
+
+	return session, nil
+}
+
+// requestDiscovery calls the discovery endpoint of the device service and returns the resulting session status
+func requestDiscovery(client *http.Client, ds models.DeviceService, correlationId string) (status string, message string) {
+	req, err := http.NewRequest(http.MethodPost, ds.BaseAddress+contractsV2.ApiDiscoveryRoute, http.NoBody)
+	if err != nil {
+		return DiscoveryFailed, err.Error()
+	}
+	req.Header.Set(clients.CorrelationHeader, correlationId)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return DiscoveryFailed, err.Error()
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		return DiscoveryTriggered, ""
+	case resp.StatusCode == http.StatusNotFound,
+		resp.StatusCode == http.StatusMethodNotAllowed,
+		resp.StatusCode == http.StatusNotImplemented,
+		resp.StatusCode == http.StatusServiceUnavailable:
+		// device services without discovery, or with discovery disabled, answer with one of these
+		return DiscoveryUnsupported, fmt.Sprintf("device service responded with status %d", resp.StatusCode)
+	default:
+		return DiscoveryFailed, fmt.Sprintf("device service responded with status %d", resp.StatusCode)
+	}
+}
+
+// DiscoverySessionById returns the discovery session with the discovered device counts of each device service
+func DiscoverySessionById(id string) (metadataDTOs.DiscoverySession, errors.EdgeX) {
+	if id == "" {
+		return metadataDTOs.DiscoverySession{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	session, ok := discoverySessions.get(id)
+	if !ok {
+		return session, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("discovery session '%s' does not exist", id), nil)
+	}
+	return session, nil
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// AllLabels returns every label in use with the number of devices, device profiles and device services carrying it
+func AllLabels(dic *di.Container) ([]pkgModels.LabelUsage, errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	usage, edgeXerr := dbClient.LabelUsage()
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return usage, nil
+}
+
+// RenameLabel replaces the label on every device, device profile and device service and returns the number of
+// renamed objects
+func RenameLabel(from string, to string, ctx context.Context, dic *di.Container) (int, errors.EdgeX) {
+	if from == "" || to == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "label is empty", nil)
+	} else if from == to {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("label '%s' can't be renamed to itself", from), nil)
+	}
+	edgeXerr := validateLabels([]string{to}, dic)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	renamed, edgeXerr := dbClient.RenameLabel(from, to)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debug(fmt.Sprintf(
+		"Label '%s' renamed to '%s' on %d object(s). Correlation-ID: %s ",
+		from,
+		to,
+		renamed,
+		correlation.FromContext(ctx),
+	))
+
+	return renamed, nil
+}
+
+// validateLabels rejects labels which aren't in the configured AllowedLabels. Any label is allowed when AllowedLabels
+// is empty.
+func validateLabels(labels []string, dic *di.Container) errors.EdgeX {
+	allowed := metadataContainer.ConfigurationFrom(dic.Get).Writable.AllowedLabels
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, label := range labels {
+		if !containsLabel(allowed, label) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("label '%s' is not in the allowed labels", label), nil)
+		}
+	}
+	return nil
+}
+
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
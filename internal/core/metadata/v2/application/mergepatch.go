@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	goErrors "errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+)
+
+// ErrETagMismatch is wrapped in the error returned when the If-Match precondition of an update fails
+var ErrETagMismatch = goErrors.New("entity tag doesn't match the If-Match header")
+
+// checkIfMatch fails with ErrETagMismatch unless ifMatch is empty or matches the entity tag of current, i.e. the
+// entity wasn't modified since the client retrieved it
+func checkIfMatch(current interface{}, ifMatch string) errors.EdgeX {
+	etag, edgeXerr := utils.ETag(current)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !utils.ETagMatches(ifMatch, etag) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the entity has been modified since it was retrieved", ErrETagMismatch)
+	}
+	return nil
+}
+
+// updateError returns the error of the update of an entity. The database only updates the devices and device profiles
+// which weren't modified since the update was made from them, as any of the replicas of the service may update them,
+// so an entity modified meanwhile fails the update like an If-Match precondition.
+func updateError(edgeXerr errors.EdgeX) errors.EdgeX {
+	if goErrors.Is(edgeXerr, db.ErrModifiedMeanwhile) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the entity has been modified since it was retrieved", ErrETagMismatch)
+	}
+	return errors.NewCommonEdgeXWrapper(edgeXerr)
+}
+
+// applyMergePatch checks ifMatch against the entity tag of current, then applies the JSON Merge Patch to current and
+// decodes the result into patched, which is validated before being returned.
+func applyMergePatch(current interface{}, patch []byte, ifMatch string, patched interface{}) errors.EdgeX {
+	if edgeXerr := checkIfMatch(current, ifMatch); edgeXerr != nil {
+		return edgeXerr
+	}
+
+	original, err := json.Marshal(current)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the entity", err)
+	}
+	document, edgeXerr := utils.MergePatch(original, patch)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if err = json.Unmarshal(document, patched); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the patched entity is invalid", err)
+	}
+	if err = v2.Validate(patched); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the patched entity is invalid", err)
+	}
+	return nil
+}
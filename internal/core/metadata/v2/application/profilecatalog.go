@@ -0,0 +1,414 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"gopkg.in/yaml.v2"
+)
+
+// maxProfileCatalogFileSize limits the size of the files read from the profile catalog
+const maxProfileCatalogFileSize = 16 * 1024 * 1024
+
+// profileCatalogMutex serializes the pulls of the profile catalog and the reviews of its updates
+var profileCatalogMutex sync.Mutex
+
+// ProfileCatalogIndex is the index file of the profile catalog
+type ProfileCatalogIndex struct {
+	Profiles []ProfileCatalogEntry `json:"profiles" yaml:"profiles"`
+}
+
+// ProfileCatalogEntry is a device profile published in the profile catalog, Path is the path of its YAML or JSON file
+// relative to the index file
+type ProfileCatalogEntry struct {
+	Name    string `json:"name" yaml:"name"`
+	Version string `json:"version" yaml:"version"`
+	Path    string `json:"path" yaml:"path"`
+}
+
+// ProfileCatalogSyncResult reports a pull of the profile catalog: the device profiles with a new pending update, the
+// number of device profiles up to date or whose version is already pending or rejected, and why the others failed to
+// be pulled by name
+type ProfileCatalogSyncResult struct {
+	Updated  []string
+	UpToDate int
+	Failed   map[string]string
+}
+
+// compareProfileVersions compares the versions made of dot separated numbers, i.e. 1.2.0 or v1.10, returning a
+// negative number when a is older than b, 0 when they are equal and a positive number when a is newer. The parts
+// which aren't numbers are compared as strings.
+func compareProfileVersions(a string, b string) int {
+	as := strings.Split(strings.TrimPrefix(strings.ToLower(a), "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(strings.ToLower(b), "v"), ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var ap, bp string
+		if i < len(as) {
+			ap = as[i]
+		}
+		if i < len(bs) {
+			bp = bs[i]
+		}
+		an, aErr := strconv.Atoi(emptyAsZero(ap))
+		bn, bErr := strconv.Atoi(emptyAsZero(bp))
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return an - bn
+			}
+		case ap != bp:
+			return strings.Compare(ap, bp)
+		}
+	}
+	return 0
+}
+
+func emptyAsZero(s string) string {
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+// profileCatalogClient returns the URL of the index file of the profile catalog and the HTTP client fetching its files
+func profileCatalogClient(dic *di.Container) (*url.URL, *http.Client, errors.EdgeX) {
+	info := metadataContainer.ConfigurationFrom(dic.Get).ProfileCatalog
+	if info.Url == "" {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "no profile catalog is configured", nil)
+	}
+	index, err := url.Parse(info.Url)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid ProfileCatalog Url '%s'", info.Url), err)
+	}
+	client := &http.Client{}
+	if info.Timeout != "" {
+		timeout, err := time.ParseDuration(info.Timeout)
+		if err != nil || timeout <= 0 {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid ProfileCatalog Timeout '%s'", info.Timeout), err)
+		}
+		client.Timeout = timeout
+	}
+	return index, client, nil
+}
+
+// fetchProfileCatalogFile returns the content of the file of the profile catalog
+func fetchProfileCatalogFile(client *http.Client, fileUrl string) ([]byte, errors.EdgeX) {
+	response, err := client.Get(fileUrl)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("failed to fetch %s from the profile catalog", fileUrl), err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("failed to fetch %s from the profile catalog: %s", fileUrl, response.Status), nil)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(response.Body, maxProfileCatalogFileSize+1))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("failed to fetch %s from the profile catalog", fileUrl), err)
+	}
+	if len(data) > maxProfileCatalogFileSize {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s of the profile catalog is larger than %d bytes", fileUrl, maxProfileCatalogFileSize), nil)
+	}
+	return data, nil
+}
+
+// parseCatalogProfile parses and validates the device profile file of the format, yaml or json
+func parseCatalogProfile(data []byte, format string) (dtos.DeviceProfile, errors.EdgeX) {
+	var dp dtos.DeviceProfile
+	var err error
+	if format == DeviceProfileFormatJson {
+		err = json.Unmarshal(data, &dp)
+	} else {
+		err = yaml.Unmarshal(data, &dp)
+	}
+	if err != nil {
+		return dp, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s device profile", format), err)
+	}
+	if err = v2.Validate(dp); err != nil {
+		return dp, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dp, nil
+}
+
+// SyncProfileCatalog pulls the profile catalog, and keeps an update pending approval for every device profile whose
+// version in the catalog is newer than the one applied from it, or which was never applied from it. The versions which
+// were rejected aren't pulled again.
+func SyncProfileCatalog(ctx context.Context, dic *di.Container) (ProfileCatalogSyncResult, errors.EdgeX) {
+	result := ProfileCatalogSyncResult{Updated: []string{}, Failed: map[string]string{}}
+	indexUrl, client, edgeXerr := profileCatalogClient(dic)
+	if edgeXerr != nil {
+		return result, edgeXerr
+	}
+
+	profileCatalogMutex.Lock()
+	defer profileCatalogMutex.Unlock()
+
+	data, edgeXerr := fetchProfileCatalogFile(client, indexUrl.String())
+	if edgeXerr != nil {
+		return result, edgeXerr
+	}
+	var index ProfileCatalogIndex
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return result, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the index of the profile catalog", err)
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+	applied, edgeXerr := dbClient.ProfileCatalogVersions()
+	if edgeXerr != nil {
+		return result, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	for _, entry := range index.Profiles {
+		if entry.Name == "" || entry.Version == "" || entry.Path == "" {
+			key := entry.Name
+			if key == "" {
+				key = entry.Path
+			}
+			result.Failed[key] = "name, version and path are required in the index of the profile catalog"
+			continue
+		}
+		if applied[entry.Name] != "" && compareProfileVersions(entry.Version, applied[entry.Name]) <= 0 {
+			result.UpToDate++
+			continue
+		}
+		pending, edgeXerr := dbClient.ProfileCatalogUpdateByName(entry.Name)
+		if edgeXerr == nil && compareProfileVersions(entry.Version, pending.Version) == 0 {
+			result.UpToDate++
+			continue
+		} else if edgeXerr != nil && errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			result.Failed[entry.Name] = edgeXerr.Error()
+			continue
+		}
+
+		update, edgeXerr := pullCatalogProfile(entry, applied[entry.Name], indexUrl, client, dic)
+		if edgeXerr == nil {
+			edgeXerr = dbClient.SetProfileCatalogUpdate(update)
+		}
+		if edgeXerr != nil {
+			result.Failed[entry.Name] = edgeXerr.Error()
+			continue
+		}
+		result.Updated = append(result.Updated, entry.Name)
+	}
+
+	lc.Debug(fmt.Sprintf(
+		"Profile catalog pulled, %d update(s) pending approval, %d profile(s) up to date, %d failed. Correlation-id: %s ",
+		len(result.Updated),
+		result.UpToDate,
+		len(result.Failed),
+		correlation.FromContext(ctx),
+	))
+	return result, nil
+}
+
+// pullCatalogProfile fetches the device profile of the catalog entry and returns its update pending approval
+func pullCatalogProfile(
+	entry ProfileCatalogEntry,
+	appliedVersion string,
+	indexUrl *url.URL,
+	client *http.Client,
+	dic *di.Container) (pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+
+	update := pkgModels.ProfileCatalogUpdate{
+		ProfileName:    entry.Name,
+		Version:        entry.Version,
+		AppliedVersion: appliedVersion,
+		Status:         pkgModels.ProfileCatalogUpdatePending,
+		Format:         DeviceProfileFormatYaml,
+		Fetched:        common.MakeTimestamp(),
+	}
+	if strings.EqualFold(path.Ext(entry.Path), "."+DeviceProfileFormatJson) {
+		update.Format = DeviceProfileFormatJson
+	}
+
+	fileUrl, err := indexUrl.Parse(entry.Path)
+	if err != nil {
+		return update, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid path '%s' in the index of the profile catalog", entry.Path), err)
+	}
+	data, edgeXerr := fetchProfileCatalogFile(client, fileUrl.String())
+	if edgeXerr != nil {
+		return update, edgeXerr
+	}
+	dp, edgeXerr := parseCatalogProfile(data, update.Format)
+	if edgeXerr != nil {
+		return update, edgeXerr
+	}
+	if dp.Name != entry.Name {
+		return update, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the device profile of %s is named %s", fileUrl.String(), dp.Name), nil)
+	}
+	update.Profile = data
+
+	exists, edgeXerr := v2MetadataContainer.DBClientFrom(dic.Get).DeviceProfileNameExists(entry.Name)
+	if edgeXerr != nil {
+		return update, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	update.Action = pkgModels.ProfileCatalogActionAdd
+	if exists {
+		update.Action = pkgModels.ProfileCatalogActionUpdate
+	}
+	return update, nil
+}
+
+// AllProfileCatalogUpdates returns the device profile updates pulled from the profile catalog, sorted by profile name
+func AllProfileCatalogUpdates(dic *di.Container) ([]pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	updates, err := dbClient.AllProfileCatalogUpdates()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return updates, nil
+}
+
+// ProfileCatalogUpdateByName returns the update of the device profile pulled from the profile catalog
+func ProfileCatalogUpdateByName(name string, dic *di.Container) (pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+	if name == "" {
+		return pkgModels.ProfileCatalogUpdate{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	update, err := dbClient.ProfileCatalogUpdateByName(name)
+	if err != nil {
+		return update, errors.NewCommonEdgeXWrapper(err)
+	}
+	return update, nil
+}
+
+// ApproveProfileCatalogUpdate applies the update of the device profile pulled from the profile catalog, adding or
+// updating the device profile, then records its version as applied and removes the update. A rejected update may
+// still be approved.
+func ApproveProfileCatalogUpdate(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	profileCatalogMutex.Lock()
+	defer profileCatalogMutex.Unlock()
+
+	update, edgeXerr := ProfileCatalogUpdateByName(name, dic)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+	dto, edgeXerr := parseCatalogProfile(update.Profile, update.Format)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+	exists, edgeXerr := dbClient.DeviceProfileNameExists(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	deviceProfile := dtos.ToDeviceProfileModel(dto)
+	if exists {
+		edgeXerr = UpdateDeviceProfile(deviceProfile, "", ctx, dic)
+	} else {
+		_, edgeXerr = AddDeviceProfile(deviceProfile, ctx, dic)
+	}
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if update.Format == DeviceProfileFormatYaml {
+		KeepDeviceProfileYaml(name, update.Profile, ctx, dic)
+	}
+
+	if edgeXerr = dbClient.SetProfileCatalogVersion(name, update.Version); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	// The update is applied even when it can't be removed, it is replaced by the next version pulled then.
+	if edgeXerr = dbClient.DeleteProfileCatalogUpdateByName(name); edgeXerr != nil {
+		lc.Warn(fmt.Sprintf("profile catalog update of %s applied but not removed: %s", name, edgeXerr.Error()))
+	}
+
+	lc.Info(fmt.Sprintf(
+		"DeviceProfile %s version %s applied from the profile catalog. Correlation-id: %s ",
+		name,
+		update.Version,
+		correlation.FromContext(ctx),
+	))
+	return nil
+}
+
+// RejectProfileCatalogUpdate rejects the update of the device profile pulled from the profile catalog, its version
+// isn't pulled again
+func RejectProfileCatalogUpdate(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	profileCatalogMutex.Lock()
+	defer profileCatalogMutex.Unlock()
+
+	update, edgeXerr := ProfileCatalogUpdateByName(name, dic)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+	update.Status = pkgModels.ProfileCatalogUpdateRejected
+	if edgeXerr = v2MetadataContainer.DBClientFrom(dic.Get).SetProfileCatalogUpdate(update); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	container.LoggingClientFrom(dic.Get).Debug(fmt.Sprintf(
+		"DeviceProfile %s version %s of the profile catalog rejected. Correlation-id: %s ",
+		name,
+		update.Version,
+		correlation.FromContext(ctx),
+	))
+	return nil
+}
+
+// RunProfileCatalogSync pulls the profile catalog every configured interval until ctx is done. The catalog is only
+// pulled on demand when no interval is configured.
+func RunProfileCatalogSync(ctx context.Context, dic *di.Container) {
+	lc := container.LoggingClientFrom(dic.Get)
+	catalog := metadataContainer.ConfigurationFrom(dic.Get).ProfileCatalog
+	if catalog.Interval == "" {
+		lc.Info("ProfileCatalog Interval is empty, the profile catalog will only be pulled on demand")
+		return
+	}
+	interval, err := time.ParseDuration(catalog.Interval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid ProfileCatalog Interval '%s', the profile catalog will only be pulled on demand", catalog.Interval))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := SyncProfileCatalog(ctx, dic)
+			if err != nil {
+				lc.Error(fmt.Sprintf("profile catalog pull failed: %s", err.Error()))
+				continue
+			}
+			if len(result.Updated) > 0 {
+				lc.Info(fmt.Sprintf("%d device profile update(s) pulled from the profile catalog pending approval: %s",
+					len(result.Updated), strings.Join(result.Updated, ", ")))
+			}
+			for name, reason := range result.Failed {
+				lc.Warn(fmt.Sprintf("device profile %s not pulled from the profile catalog: %s", name, reason))
+			}
+		}
+	}
+}
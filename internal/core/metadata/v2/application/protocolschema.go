@@ -0,0 +1,239 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// protocolSchema is the subset of JSON Schema supported for protocol properties. Protocol property values are always
+// strings, so the type of a property describes what the string must parse as.
+type protocolSchema struct {
+	Type                 string                    `json:"type,omitempty"`
+	Properties           map[string]propertySchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties *bool                     `json:"additionalProperties,omitempty"`
+}
+
+type propertySchema struct {
+	Type    string   `json:"type,omitempty"`
+	Enum    []string `json:"enum,omitempty"`
+	Pattern string   `json:"pattern,omitempty"`
+	Minimum *float64 `json:"minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// parseProtocolSchema parses and checks the schema, rejecting keywords and types which aren't supported rather than
+// silently ignoring them.
+func parseProtocolSchema(raw []byte) (protocolSchema, error) {
+	var schema protocolSchema
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&schema); err != nil {
+		return schema, fmt.Errorf("unsupported or invalid schema: %s", err.Error())
+	}
+	if schema.Type != "" && schema.Type != "object" {
+		return schema, fmt.Errorf("schema type must be object, got '%s'", schema.Type)
+	}
+
+	for name, property := range schema.Properties {
+		switch property.Type {
+		case "", "string", "integer", "number", "boolean":
+		default:
+			return schema, fmt.Errorf("property '%s' has unsupported type '%s'", name, property.Type)
+		}
+		if property.Pattern != "" {
+			pattern, err := regexp.Compile(property.Pattern)
+			if err != nil {
+				return schema, fmt.Errorf("property '%s' has invalid pattern: %s", name, err.Error())
+			}
+			property.pattern = pattern
+			schema.Properties[name] = property
+		}
+	}
+	return schema, nil
+}
+
+// validate returns a description of every violation of the schema by the protocol properties
+func (s protocolSchema) validate(properties models.ProtocolProperties) []string {
+	var violations []string
+	for _, name := range s.Required {
+		if _, ok := properties[name]; !ok {
+			violations = append(violations, fmt.Sprintf("required property '%s' is missing", name))
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property, ok := s.Properties[name]
+		if !ok {
+			if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				violations = append(violations, fmt.Sprintf("property '%s' is not allowed", name))
+			}
+			continue
+		}
+		if violation := property.validate(properties[name]); violation != "" {
+			violations = append(violations, fmt.Sprintf("property '%s' %s", name, violation))
+		}
+	}
+	return violations
+}
+
+func (p propertySchema) validate(value string) string {
+	if len(p.Enum) > 0 {
+		found := false
+		for _, allowed := range p.Enum {
+			if value == allowed {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Sprintf("must be one of %s", strings.Join(p.Enum, ", "))
+		}
+	}
+	if p.pattern != nil && !p.pattern.MatchString(value) {
+		return fmt.Sprintf("must match pattern %s", p.Pattern)
+	}
+
+	var number float64
+	var err error
+	switch p.Type {
+	case "integer":
+		var i int64
+		i, err = strconv.ParseInt(value, 10, 64)
+		number = float64(i)
+	case "number":
+		number, err = strconv.ParseFloat(value, 64)
+	case "boolean":
+		if _, err = strconv.ParseBool(value); err != nil {
+			return "must be a boolean"
+		}
+		return ""
+	default:
+		return ""
+	}
+	if err != nil {
+		return fmt.Sprintf("must be of type %s", p.Type)
+	}
+	if p.Minimum != nil && number < *p.Minimum {
+		return fmt.Sprintf("must be at least %v", *p.Minimum)
+	}
+	if p.Maximum != nil && number > *p.Maximum {
+		return fmt.Sprintf("must be at most %v", *p.Maximum)
+	}
+	return ""
+}
+
+// validateProtocols validates the properties of each protocol against the schema registered for the protocol.
+// Protocols without a registered schema aren't validated.
+func validateProtocols(protocols map[string]models.ProtocolProperties, dbClient interfaces.DBClient) errors.EdgeX {
+	names := make([]string, 0, len(protocols))
+	for name := range protocols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var violations []string
+	for _, name := range names {
+		raw, edgeXerr := dbClient.ProtocolSchemaByName(name)
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			continue
+		} else if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+
+		schema, err := parseProtocolSchema(raw)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("registered schema of protocol '%s' is invalid", name), err)
+		}
+		for _, violation := range schema.validate(protocols[name]) {
+			violations = append(violations, fmt.Sprintf("protocol '%s': %s", name, violation))
+		}
+	}
+
+	if len(violations) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "protocol properties don't match the registered schema: "+strings.Join(violations, "; "), nil)
+	}
+	return nil
+}
+
+// SetProtocolSchema registers the JSON Schema the properties of the protocol are validated against when devices are
+// added or updated, replacing the schema registered before
+func SetProtocolSchema(name string, schema []byte, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if _, err := parseProtocolSchema(schema); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("schema of protocol '%s' is invalid", name), err)
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	edgeXerr := dbClient.SetProtocolSchema(name, schema)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// ProtocolSchemaByName query the JSON Schema registered for the protocol
+func ProtocolSchemaByName(name string, dic *di.Container) (json.RawMessage, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	schema, edgeXerr := dbClient.ProtocolSchemaByName(name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return schema, nil
+}
+
+// AllProtocolSchemas query the JSON Schemas registered for all protocols by protocol name
+func AllProtocolSchemas(dic *di.Container) (map[string]json.RawMessage, errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	stored, edgeXerr := dbClient.AllProtocolSchemas()
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	schemas := make(map[string]json.RawMessage, len(stored))
+	for name, schema := range stored {
+		schemas[name] = schema
+	}
+	return schemas, nil
+}
+
+// DeleteProtocolSchemaByName deletes the JSON Schema registered for the protocol
+func DeleteProtocolSchemaByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	edgeXerr := dbClient.DeleteProtocolSchemaByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	goErrors "errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+const (
+	// SecretReferencePrefix prefixes the path in the secret store of a protocol property kept in the secret store,
+	// which replaces the value of the property in the device
+	SecretReferencePrefix = "secret:"
+	// protocolSecretKey is the key of the value of a protocol property in its secret
+	protocolSecretKey = "value"
+)
+
+// ErrProtocolSecretsForbidden is wrapped in the error returned when the caller doesn't hold the role required to
+// resolve the secret protocol properties
+var ErrProtocolSecretsForbidden = goErrors.New("caller isn't allowed to resolve the secret protocol properties")
+
+// protocolSecretPath returns the path in the secret store of the protocol property of the device, each property is
+// a secret of its own so that updating one doesn't overwrite the others
+func protocolSecretPath(deviceName string, protocol string, property string) string {
+	return strings.Join([]string{"devices", url.PathEscape(deviceName), url.PathEscape(protocol), url.PathEscape(property)}, "/")
+}
+
+func isSecretProperty(name string, secretProperties []string) bool {
+	for _, p := range secretProperties {
+		if strings.EqualFold(p, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// storeProtocolSecrets stores the values of the secret protocol properties of the device in the secret store and
+// replaces them with their reference. The properties already holding a reference are left as is, so the devices
+// cloned from another device share its secrets. A new device must not be named as an existing one, whose secrets would
+// be overwritten otherwise.
+func storeProtocolSecrets(d *models.Device, isNew bool, dic *di.Container) errors.EdgeX {
+	config := metadataContainer.ConfigurationFrom(dic.Get).ProtocolSecrets
+	if !config.Enabled {
+		return nil
+	}
+	if isNew {
+		exists, edgeXerr := v2MetadataContainer.DBClientFrom(dic.Get).DeviceNameExists(d.Name)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if exists {
+			return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s already exists", d.Name), nil)
+		}
+	}
+
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	for protocol, properties := range d.Protocols {
+		for name, value := range properties {
+			if !isSecretProperty(name, config.Properties) || strings.HasPrefix(value, SecretReferencePrefix) {
+				continue
+			}
+			path := protocolSecretPath(d.Name, protocol, name)
+			if err := secretProvider.StoreSecrets(path, map[string]string{protocolSecretKey: value}); err != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError,
+					fmt.Sprintf("storing the protocol property %s of protocol %s in the secret store failed", name, protocol), err)
+			}
+			properties[name] = SecretReferencePrefix + path
+		}
+	}
+	return nil
+}
+
+// DeviceProtocolSecretsByName returns the protocols of the device with the secret protocol properties resolved from
+// the secret store. The caller must hold the ResolverRole of the configuration when one is set.
+func DeviceProtocolSecretsByName(name string, roles []string, dic *di.Container) (map[string]models.ProtocolProperties, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	config := metadataContainer.ConfigurationFrom(dic.Get).ProtocolSecrets
+	if config.ResolverRole != "" && !containsRole(roles, config.ResolverRole) {
+		return nil, errors.NewCommonEdgeX(errors.KindNotAllowed,
+			fmt.Sprintf("resolving the secret protocol properties requires the role %s", config.ResolverRole), ErrProtocolSecretsForbidden)
+	}
+
+	device, edgeXerr := v2MetadataContainer.DBClientFrom(dic.Get).DeviceByName(name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	secretProvider := container.SecretProviderFrom(dic.Get)
+	protocols := make(map[string]models.ProtocolProperties, len(device.Protocols))
+	for protocol, properties := range device.Protocols {
+		resolved := make(models.ProtocolProperties, len(properties))
+		for property, value := range properties {
+			if strings.HasPrefix(value, SecretReferencePrefix) {
+				path := strings.TrimPrefix(value, SecretReferencePrefix)
+				secrets, err := secretProvider.GetSecrets(path, protocolSecretKey)
+				if err != nil {
+					return nil, errors.NewCommonEdgeX(errors.KindServerError,
+						fmt.Sprintf("reading the protocol property %s of protocol %s from the secret store failed", property, protocol), err)
+				}
+				value = secrets[protocolSecretKey]
+			}
+			resolved[property] = value
+		}
+		protocols[protocol] = resolved
+	}
+	return protocols, nil
+}
+
+func containsRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	goErrors "errors"
+	"fmt"
+	"net/http"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// RegistrationTokenHeader carries the registration token of a device service registering itself, updating itself or
+// adding and updating its devices
+const RegistrationTokenHeader = "X-Registration-Token"
+
+// ErrRegistrationTokenInvalid is wrapped in the error returned when the registration token is missing, unknown,
+// already redeemed or expired
+var ErrRegistrationTokenInvalid = goErrors.New("invalid device service registration token")
+
+// ErrRegistrationTokenForbidden is wrapped in the error returned when the registration token doesn't grant access to
+// the device service
+var ErrRegistrationTokenForbidden = goErrors.New("registration token doesn't grant access to the device service")
+
+// RegistrationTokenStore keeps the registration tokens by their hash, and the hash of the token each device service
+// was registered with
+type RegistrationTokenStore interface {
+	GetRegistrationToken(hash string) (metadataModels.RegistrationToken, error)
+	RedeemRegistrationToken(hash string, serviceId string) error
+	GetDeviceServiceCredential(serviceId string) (string, error)
+}
+
+type registrationTokenKey struct{}
+
+// RegistrationTokenMiddleware keeps the registration token presented by the request in its context, where the V2
+// device service and device updates check it from
+func RegistrationTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get(RegistrationTokenHeader); token != "" {
+			r = r.WithContext(context.WithValue(r.Context(), registrationTokenKey{}, token))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func registrationTokenFrom(ctx context.Context) string {
+	token, _ := ctx.Value(registrationTokenKey{}).(string)
+	return token
+}
+
+// RegistrationTokenHash returns the hash registration tokens are stored and compared by
+func RegistrationTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckRegistrationToken checks the presented registration token grants the registration of the named device service,
+// and returns the hash of the token to redeem once the device service is registered
+func CheckRegistrationToken(presented string, serviceName string, store RegistrationTokenStore) (string, errors.EdgeX) {
+	if presented == "" {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "missing "+RegistrationTokenHeader+" header", ErrRegistrationTokenInvalid)
+	}
+	hash := RegistrationTokenHash(presented)
+	token, err := store.GetRegistrationToken(hash)
+	if err != nil {
+		if err == db.ErrNotFound {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "unknown or already redeemed token", ErrRegistrationTokenInvalid)
+		}
+		return "", errors.NewCommonEdgeX(errors.KindDatabaseError, "registration token query failed", err)
+	}
+	if common.MakeTimestamp() > token.Expires {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "token expired", ErrRegistrationTokenInvalid)
+	}
+	if token.ServiceName != serviceName {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("registration token doesn't grant access to device service '%s'", serviceName), ErrRegistrationTokenForbidden)
+	}
+	return hash, nil
+}
+
+// RedeemRegistrationToken redeems the registration token of the hash for the registered device service, so it can't
+// be used again and becomes the credential of the device service
+func RedeemRegistrationToken(hash string, serviceId string, store RegistrationTokenStore) errors.EdgeX {
+	if err := store.RedeemRegistrationToken(hash, serviceId); err != nil {
+		if err == db.ErrNotFound {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unknown or already redeemed token", ErrRegistrationTokenInvalid)
+		}
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "registration token redemption failed", err)
+	}
+	return nil
+}
+
+// CheckDeviceServiceCredential checks the presented registration token is the one the device service was registered
+// with
+func CheckDeviceServiceCredential(presented string, serviceId string, serviceName string, store RegistrationTokenStore) errors.EdgeX {
+	if presented == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "missing "+RegistrationTokenHeader+" header", ErrRegistrationTokenInvalid)
+	}
+	credential, err := store.GetDeviceServiceCredential(serviceId)
+	if err != nil && err != db.ErrNotFound {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device service credential query failed", err)
+	}
+	if err == db.ErrNotFound ||
+		subtle.ConstantTimeCompare([]byte(credential), []byte(RegistrationTokenHash(presented))) != 1 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("registration token doesn't grant access to device service '%s'", serviceName), ErrRegistrationTokenForbidden)
+	}
+	return nil
+}
+
+// registrationRequired reports whether the device services and devices are only added and updated with a registration
+// token
+func registrationRequired(dic *di.Container) bool {
+	return metadataContainer.ConfigurationFrom(dic.Get).ServiceRegistration.RequireToken
+}
+
+// checkServiceCredential checks the request of the context carries the credential of the device service when
+// registration tokens are required
+func checkServiceCredential(ctx context.Context, ds models.DeviceService, dic *di.Container) errors.EdgeX {
+	if !registrationRequired(dic) {
+		return nil
+	}
+	return CheckDeviceServiceCredential(registrationTokenFrom(ctx), ds.Id, ds.Name, pkgContainer.DBClientFrom(dic.Get))
+}
+
+// checkServiceOwnership checks the request of the context carries the credential of the named device service when
+// registration tokens are required. Device services which can't be found are left to the caller to report.
+func checkServiceOwnership(ctx context.Context, serviceName string, dic *di.Container) errors.EdgeX {
+	if !registrationRequired(dic) {
+		return nil
+	}
+	ds, edgeXerr := v2MetadataContainer.DBClientFrom(dic.Get).DeviceServiceByName(serviceName)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return nil
+		}
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return checkServiceCredential(ctx, ds, dic)
+}
+
+// checkDeviceOwnership checks the request of the context carries the credential of the device service of the device
+// before its update, and of the device service it is moved to if any
+func checkDeviceOwnership(ctx context.Context, before models.Device, after models.Device, dic *di.Container) errors.EdgeX {
+	if edgeXerr := checkServiceOwnership(ctx, before.ServiceName, dic); edgeXerr != nil {
+		return edgeXerr
+	}
+	if after.ServiceName != before.ServiceName {
+		return checkServiceOwnership(ctx, after.ServiceName, dic)
+	}
+	return nil
+}
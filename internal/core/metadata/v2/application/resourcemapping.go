@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// The protocols the deviceResources can be mapped to
+const (
+	MappingProtocolOPCUA  = "opcua"
+	MappingProtocolModbus = "modbus"
+)
+
+// resourceMappingSchemas are the schemas the mapping attributes of each protocol are validated against, in the
+// subset of JSON Schema supported for protocol properties.
+var resourceMappingSchemas = map[string]string{
+	MappingProtocolOPCUA: `{
+	"type": "object",
+	"properties": {
+		"nodeId": {"type": "string", "pattern": "^((ns=[0-9]+|nsu=[^;]+);)?[isgb]=.+$"},
+		"browsePath": {"type": "string"},
+		"samplingInterval": {"type": "integer", "minimum": 0}
+	},
+	"required": ["nodeId"],
+	"additionalProperties": false
+}`,
+	MappingProtocolModbus: `{
+	"type": "object",
+	"properties": {
+		"primaryTable": {"type": "string", "enum": ["COILS", "DISCRETE_INPUTS", "INPUT_REGISTERS", "HOLDING_REGISTERS"]},
+		"startingAddress": {"type": "integer", "minimum": 0, "maximum": 65535},
+		"rawType": {"type": "string", "enum": ["BOOL", "INT16", "UINT16", "INT32", "UINT32", "INT64", "UINT64", "FLOAT32", "FLOAT64"]},
+		"isByteSwap": {"type": "boolean"},
+		"isWordSwap": {"type": "boolean"},
+		"stringRegisterSize": {"type": "integer", "minimum": 1, "maximum": 123}
+	},
+	"required": ["primaryTable", "startingAddress"],
+	"additionalProperties": false
+}`,
+}
+
+// mappingProtocol returns the protocol in lower case, so that 'OPCUA' and 'opcua' name the same protocol
+func mappingProtocol(protocol string) string {
+	return strings.ToLower(protocol)
+}
+
+// resourceMappingSchema returns the parsed schema of the mapping attributes of the protocol
+func resourceMappingSchema(protocol string) (protocolSchema, errors.EdgeX) {
+	raw, ok := resourceMappingSchemas[protocol]
+	if !ok {
+		return protocolSchema{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("deviceResources can't be mapped to protocol '%s'", protocol), nil)
+	}
+	schema, err := parseProtocolSchema([]byte(raw))
+	if err != nil {
+		return schema, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("mapping schema of protocol '%s' is invalid", protocol), err)
+	}
+	return schema, nil
+}
+
+func validateResourceMappingKey(profileName string, resourceName string, protocol string) errors.EdgeX {
+	switch {
+	case profileName == "":
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	case resourceName == "":
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	case protocol == "":
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "protocol is empty", nil)
+	}
+	return nil
+}
+
+// SetResourceMapping maps the deviceResource of the profile to the protocol through the attributes, replacing the
+// mapping set before. The attributes are validated against the mapping schema of the protocol.
+func SetResourceMapping(profileName string, resourceName string, protocol string, attributes map[string]string, dic *di.Container) errors.EdgeX {
+	protocol = mappingProtocol(protocol)
+	if err := validateResourceMappingKey(profileName, resourceName, protocol); err != nil {
+		return err
+	}
+
+	schema, edgeXerr := resourceMappingSchema(protocol)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if violations := schema.validate(attributes); len(violations) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("attributes don't match the mapping schema of protocol '%s': %s", protocol, strings.Join(violations, "; ")), nil)
+	}
+
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	dp, edgeXerr := dbClient.DeviceProfileByName(profileName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	found := false
+	for _, r := range dp.DeviceResources {
+		if r.Name == resourceName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("deviceResource %s doesn't exist in device profile %s", resourceName, profileName), nil)
+	}
+
+	edgeXerr = dbClient.SetResourceMapping(pkgModels.ResourceMapping{
+		ProfileName:  profileName,
+		ResourceName: resourceName,
+		Protocol:     protocol,
+		Attributes:   attributes,
+		Modified:     common.MakeTimestamp(),
+	})
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// ResourceMapping query the mapping of the deviceResource of the profile to the protocol
+func ResourceMapping(profileName string, resourceName string, protocol string, dic *di.Container) (pkgModels.ResourceMapping, errors.EdgeX) {
+	protocol = mappingProtocol(protocol)
+	if err := validateResourceMappingKey(profileName, resourceName, protocol); err != nil {
+		return pkgModels.ResourceMapping{}, err
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	m, edgeXerr := dbClient.ResourceMapping(profileName, resourceName, protocol)
+	if edgeXerr != nil {
+		return m, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return m, nil
+}
+
+// ResourceMappingsByProfileName query the mappings of the deviceResources of the profile, to the protocol when not
+// empty
+func ResourceMappingsByProfileName(profileName string, protocol string, dic *di.Container) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	if profileName == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	mappings, edgeXerr := dbClient.ResourceMappingsByProfileName(profileName)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if protocol == "" {
+		return mappings, nil
+	}
+
+	protocol = mappingProtocol(protocol)
+	filtered := make([]pkgModels.ResourceMapping, 0, len(mappings))
+	for _, m := range mappings {
+		if m.Protocol == protocol {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered, nil
+}
+
+// ResourceMappingsByProtocol query the mappings of the deviceResources of all profiles to the protocol with offset and
+// limit, only those whose attribute holds the value when the attribute isn't empty, i.e. to find the deviceResource
+// of an OPC UA node id
+func ResourceMappingsByProtocol(protocol string, attribute string, value string, offset int, limit int, dic *di.Container) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	protocol = mappingProtocol(protocol)
+	if protocol == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "protocol is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	mappings, edgeXerr := dbClient.ResourceMappingsByProtocol(protocol)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	if attribute != "" {
+		filtered := make([]pkgModels.ResourceMapping, 0, len(mappings))
+		for _, m := range mappings {
+			if v, ok := m.Attributes[attribute]; ok && v == value {
+				filtered = append(filtered, m)
+			}
+		}
+		mappings = filtered
+	}
+
+	if offset >= len(mappings) {
+		return []pkgModels.ResourceMapping{}, nil
+	}
+	mappings = mappings[offset:]
+	if limit >= 0 && limit < len(mappings) {
+		mappings = mappings[:limit]
+	}
+	return mappings, nil
+}
+
+// DeleteResourceMapping deletes the mapping of the deviceResource of the profile to the protocol
+func DeleteResourceMapping(profileName string, resourceName string, protocol string, dic *di.Container) errors.EdgeX {
+	protocol = mappingProtocol(protocol)
+	if err := validateResourceMappingKey(profileName, resourceName, protocol); err != nil {
+		return err
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	edgeXerr := dbClient.DeleteResourceMapping(profileName, resourceName, protocol)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// ResourceMappingSchema returns the schema the mapping attributes of the protocol are validated against
+func ResourceMappingSchema(protocol string) (json.RawMessage, errors.EdgeX) {
+	raw, ok := resourceMappingSchemas[mappingProtocol(protocol)]
+	if !ok {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no mapping schema for protocol '%s'", protocol), nil)
+	}
+	return json.RawMessage(raw), nil
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// DeviceStateReasonHeader is the request header telling why the request changes the admin or operating state of a
+// device, i.e. 'connection lost', recorded in the state history of the device
+const DeviceStateReasonHeader = "X-State-Reason"
+
+type stateReasonContextKey struct{}
+
+// WithDeviceStateReason returns the context telling why the devices updated with it change state
+func WithDeviceStateReason(ctx context.Context, reason string) context.Context {
+	if reason == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, stateReasonContextKey{}, reason)
+}
+
+func deviceStateReason(ctx context.Context) string {
+	reason, _ := ctx.Value(stateReasonContextKey{}).(string)
+	return reason
+}
+
+// deviceStateTransitions returns the transitions of the admin and operating states between the device before and after
+// its update
+func deviceStateTransitions(before models.Device, after models.Device, reason string, timestamp int64) []pkgModels.DeviceStateTransition {
+	var transitions []pkgModels.DeviceStateTransition
+	if before.AdminState != after.AdminState {
+		transitions = append(transitions, pkgModels.DeviceStateTransition{
+			DeviceName: after.Name,
+			State:      pkgModels.DeviceStateAdmin,
+			From:       string(before.AdminState),
+			To:         string(after.AdminState),
+			Reason:     reason,
+			Timestamp:  timestamp,
+		})
+	}
+	if before.OperatingState != after.OperatingState {
+		transitions = append(transitions, pkgModels.DeviceStateTransition{
+			DeviceName: after.Name,
+			State:      pkgModels.DeviceStateOperating,
+			From:       string(before.OperatingState),
+			To:         string(after.OperatingState),
+			Reason:     reason,
+			Timestamp:  timestamp,
+		})
+	}
+	return transitions
+}
+
+// recordDeviceUpdateTransitions records the state transitions of the device update in the state history of the device
+func recordDeviceUpdateTransitions(before models.Device, after models.Device, ctx context.Context, dic *di.Container) {
+	transitions := deviceStateTransitions(before, after, deviceStateReason(ctx), time.Now().UnixNano()/int64(time.Millisecond))
+	RecordDeviceStateTransitions(
+		transitions,
+		v2MetadataContainer.DBClientFrom(dic.Get),
+		metadataContainer.ConfigurationFrom(dic.Get).StateHistory.Retention,
+		container.LoggingClientFrom(dic.Get))
+}
+
+// RecordDeviceStateTransitions records the transitions in the state history of their device. A failure is only logged,
+// since the device is already updated when its transitions are recorded.
+func RecordDeviceStateTransitions(
+	transitions []pkgModels.DeviceStateTransition,
+	dbClient interfaces.DBClient,
+	retention string,
+	lc logger.LoggingClient) {
+
+	if len(transitions) == 0 {
+		return
+	}
+	duration, err := time.ParseDuration(retention)
+	if err != nil || duration <= 0 {
+		lc.Error(fmt.Sprintf("invalid StateHistory.Retention '%s', the device state transitions aren't recorded", retention))
+		return
+	}
+	if edgeXerr := dbClient.AddDeviceStateTransitions(transitions, duration); edgeXerr != nil {
+		lc.Error(fmt.Sprintf("recording the state transitions of device %s failed: %s", transitions[0].DeviceName, edgeXerr.Error()))
+	}
+}
+
+// DeviceStateHistory returns the state transitions of the device timestamped from start to end, in milliseconds, with
+// offset and limit, the latest first. The history of a deleted device is returned until it expires.
+func DeviceStateHistory(name string, start int64, end int64, offset int, limit int, dic *di.Container) ([]pkgModels.DeviceStateTransition, errors.EdgeX) {
+	if name == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if end < start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end %d is before start %d", end, start), nil)
+	}
+	transitions, edgeXerr := v2MetadataContainer.DBClientFrom(dic.Get).DeviceStateHistory(name, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return transitions, nil
+}
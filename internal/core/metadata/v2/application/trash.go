@@ -0,0 +1,209 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// trashTypes are the types of the objects moved to the trash when deleted
+var trashTypes = []string{pkgModels.TrashTypeDevice, pkgModels.TrashTypeDeviceProfile}
+
+// trashEnabled reports whether the deleted devices and device profiles are moved to the trash
+func trashEnabled(dic *di.Container) bool {
+	return metadataContainer.ConfigurationFrom(dic.Get).Trash.Enabled
+}
+
+func validateTrashType(objectType string) errors.EdgeX {
+	for _, t := range trashTypes {
+		if objectType == t {
+			return nil
+		}
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown trashed object type '%s', expected one of %v", objectType, trashTypes), nil)
+}
+
+// TrashDevice moves the device to the trash
+func TrashDevice(d models.Device, dbClient interfaces.DBClient) errors.EdgeX {
+	if err := dbClient.TrashDevice(d, common.MakeTimestamp()); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// TrashDeviceProfile moves the device profile to the trash
+func TrashDeviceProfile(dp models.DeviceProfile, dbClient interfaces.DBClient) errors.EdgeX {
+	if err := dbClient.TrashDeviceProfile(dp, common.MakeTimestamp()); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// TrashedObjects returns the trashed objects of the type with offset and limit, the last deleted first
+func TrashedObjects(objectType string, offset int, limit int, dic *di.Container) ([]pkgModels.TrashedObject, errors.EdgeX) {
+	if err := validateTrashType(objectType); err != nil {
+		return nil, err
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	objects, err := dbClient.TrashedObjects(objectType, offset, limit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return objects, nil
+}
+
+// TrashedObjectByName returns the trashed object of the type by name
+func TrashedObjectByName(objectType string, name string, dic *di.Container) (pkgModels.TrashedObject, errors.EdgeX) {
+	if err := validateTrashType(objectType); err != nil {
+		return pkgModels.TrashedObject{}, err
+	}
+	if name == "" {
+		return pkgModels.TrashedObject{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	object, err := dbClient.TrashedObjectByName(objectType, name)
+	if err != nil {
+		return object, errors.NewCommonEdgeXWrapper(err)
+	}
+	return object, nil
+}
+
+// DeleteTrashedObjectByName purges the trashed object of the type by name
+func DeleteTrashedObjectByName(objectType string, name string, dic *di.Container) errors.EdgeX {
+	if err := validateTrashType(objectType); err != nil {
+		return err
+	}
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	if err := dbClient.DeleteTrashedObjectByName(objectType, name); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// RestoreTrashedObjectByName adds the trashed object of the type back with its id and removes it from the trash. A
+// device is only restored while its device profile and device service exist, and an object only while no other
+// object of the same type has taken its name.
+func RestoreTrashedObjectByName(objectType string, name string, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	object, edgeXerr := TrashedObjectByName(objectType, name, dic)
+	if edgeXerr != nil {
+		return "", edgeXerr
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	switch objectType {
+	case pkgModels.TrashTypeDevice:
+		var d models.Device
+		if err := json.Unmarshal(object.Object, &d); err != nil {
+			return "", errors.NewCommonEdgeX(errors.KindServerError, "trashed device parsing failed", err)
+		}
+		exists, edgeXerr := dbClient.DeviceServiceNameExists(d.ServiceName)
+		if edgeXerr != nil {
+			return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if !exists {
+			return "", missingReference("device service", d.ServiceName, dic)
+		}
+		exists, edgeXerr = dbClient.DeviceProfileNameExists(d.ProfileName)
+		if edgeXerr != nil {
+			return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+		} else if !exists {
+			return "", missingReference("device profile", d.ProfileName, dic)
+		}
+		if _, edgeXerr = dbClient.AddDevice(d); edgeXerr != nil {
+			return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	case pkgModels.TrashTypeDeviceProfile:
+		var dp models.DeviceProfile
+		if err := json.Unmarshal(object.Object, &dp); err != nil {
+			return "", errors.NewCommonEdgeX(errors.KindServerError, "trashed device profile parsing failed", err)
+		}
+		if _, edgeXerr = dbClient.AddDeviceProfile(dp); edgeXerr != nil {
+			return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+
+	// The object is restored even when it can't be removed from the trash, it is purged later on then.
+	if edgeXerr = dbClient.DeleteTrashedObjectByName(objectType, name); edgeXerr != nil {
+		lc.Warn(fmt.Sprintf("%s %s restored but not removed from the trash: %s", objectType, name, edgeXerr.Error()))
+	}
+
+	lc.Debug(fmt.Sprintf(
+		"%s %s restored from the trash. Id: %s, Correlation-ID: %s ",
+		objectType,
+		name,
+		object.Id,
+		correlation.FromContext(ctx),
+	))
+
+	return object.Id, nil
+}
+
+// PurgeTrash removes the objects trashed for longer than the configured retention and returns their number
+func PurgeTrash(now time.Time, dic *di.Container) (int, errors.EdgeX) {
+	retentionDays := metadataContainer.ConfigurationFrom(dic.Get).Trash.RetentionDays
+	deletedBefore := now.AddDate(0, 0, -retentionDays).UnixNano() / int64(time.Millisecond)
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+
+	total := 0
+	for _, objectType := range trashTypes {
+		purged, err := dbClient.PurgeTrashedObjects(objectType, deletedBefore)
+		if err != nil {
+			return total, errors.NewCommonEdgeXWrapper(err)
+		}
+		total += purged
+	}
+	return total, nil
+}
+
+// RunTrashPurge purges the trash every configured interval until ctx is done. The trash isn't purged when the
+// retention isn't positive.
+func RunTrashPurge(ctx context.Context, dic *di.Container) {
+	lc := container.LoggingClientFrom(dic.Get)
+	trash := metadataContainer.ConfigurationFrom(dic.Get).Trash
+	if trash.RetentionDays <= 0 {
+		lc.Info("Trash RetentionDays isn't positive, the trash won't be purged")
+		return
+	}
+	interval, err := time.ParseDuration(trash.PurgeInterval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid Trash PurgeInterval '%s', the trash won't be purged", trash.PurgeInterval))
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := PurgeTrash(now, dic)
+			if err != nil {
+				lc.Error(fmt.Sprintf("trash purge failed: %s", err.Error()))
+			} else if purged > 0 {
+				lc.Info(fmt.Sprintf("purged %d object(s) from the trash", purged))
+			}
+		}
+	}
+}
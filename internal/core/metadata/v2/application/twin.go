@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// SetDeviceTwinDesired replaces the desired state of the device, which must only hold writable deviceResources of its
+// device profile. The twin is pending until core-command reconciles the device against it.
+func SetDeviceTwinDesired(name string, desired map[string]string, dic *di.Container) (twin.Twin, errors.EdgeX) {
+	if name == "" {
+		return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	if len(desired) == 0 {
+		return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "desired state is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	device, edgeXerr := dbClient.DeviceByName(name)
+	if edgeXerr != nil {
+		return twin.Twin{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	profile, edgeXerr := dbClient.DeviceProfileByName(device.ProfileName)
+	if edgeXerr != nil {
+		return twin.Twin{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	writable := make(map[string]bool, len(profile.DeviceResources))
+	for _, resource := range profile.DeviceResources {
+		writable[resource.Name] = strings.Contains(resource.Properties.ReadWrite, "W")
+	}
+	for resourceName := range desired {
+		if w, ok := writable[resourceName]; !ok {
+			return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("deviceResource %s isn't defined by device profile %s", resourceName, profile.Name), nil)
+		} else if !w {
+			return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("deviceResource %s of device profile %s isn't writable", resourceName, profile.Name), nil)
+		}
+	}
+
+	t, edgeXerr := dbClient.UpdateDeviceTwinDesired(name, desired, utils.MakeTimestamp())
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return t, nil
+}
+
+// ReportDeviceTwin keeps the values read from the device by core-command and the outcome of its reconciliation
+func ReportDeviceTwin(name string, report twin.Report, dic *di.Container) (twin.Twin, errors.EdgeX) {
+	if name == "" {
+		return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	switch report.State {
+	case twin.StateInSync, twin.StateFailed:
+	default:
+		return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("reported state must be %s or %s", twin.StateInSync, twin.StateFailed), nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	t, edgeXerr := dbClient.ReportDeviceTwin(name, report, utils.MakeTimestamp())
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return t, nil
+}
+
+// DeviceTwinByName returns the twin of the device
+func DeviceTwinByName(name string, dic *di.Container) (twin.Twin, errors.EdgeX) {
+	if name == "" {
+		return twin.Twin{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	t, edgeXerr := dbClient.DeviceTwinByName(name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return t, nil
+}
+
+// DeleteDeviceTwinByName deletes the twin of the device, core-command stops reconciling the device
+func DeleteDeviceTwinByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	if edgeXerr := dbClient.DeleteDeviceTwinByName(name); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
+// AllDeviceTwins returns the twins of the devices with offset and limit, the desired state last changed first
+func AllDeviceTwins(offset int, limit int, dic *di.Container) ([]twin.Twin, errors.EdgeX) {
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	twins, edgeXerr := dbClient.AllDeviceTwins(offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return twins, nil
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sort"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// DeviceProfileUsage returns how the device profile is used: the devices using it by device service with when their
+// last event was received, and the deviceCommands neither exposed by a coreCommand of the profile nor read by an
+// AutoEvent of the devices. The devices are sorted by name.
+func DeviceProfileUsage(name string, dic *di.Container) (metadataModels.DeviceProfileUsage, errors.EdgeX) {
+	usage := metadataModels.DeviceProfileUsage{ProfileName: name}
+	if name == "" {
+		return usage, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := v2MetadataContainer.DBClientFrom(dic.Get)
+	profile, edgeXerr := dbClient.DeviceProfileByName(name)
+	if edgeXerr != nil {
+		return usage, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	devices, edgeXerr := dbClient.DevicesByProfileName(0, -1, name)
+	if edgeXerr != nil {
+		return usage, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	sort.Slice(devices, func(i, j int) bool {
+		return devices[i].Name < devices[j].Name
+	})
+
+	names := make([]string, len(devices))
+	used := make(map[string]bool)
+	for i, d := range devices {
+		names[i] = d.Name
+		for _, a := range d.AutoEvents {
+			used[a.Resource] = true
+		}
+	}
+	for _, c := range profile.CoreCommands {
+		used[c.Name] = true
+	}
+	lastEvents, edgeXerr := dbClient.DevicesLastEventReceived(names)
+	if edgeXerr != nil {
+		return usage, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	usage.DeviceCounts = make(map[string]int)
+	usage.Devices = make([]metadataModels.DeviceUsage, len(devices))
+	for i, d := range devices {
+		usage.DeviceCounts[d.ServiceName]++
+		usage.Devices[i] = metadataModels.DeviceUsage{
+			DeviceName:        d.Name,
+			ServiceName:       d.ServiceName,
+			LastEventReceived: lastEvents[d.Name],
+		}
+		if lastEvents[d.Name] > usage.LastEventReceived {
+			usage.LastEventReceived = lastEvents[d.Name]
+		}
+	}
+	usage.UnusedDeviceCommands = make([]string, 0)
+	for _, c := range profile.DeviceCommands {
+		if !used[c.Name] {
+			usage.UnusedDeviceCommands = append(usage.UnusedDeviceCommands, c.Name)
+		}
+	}
+	return usage, nil
+}
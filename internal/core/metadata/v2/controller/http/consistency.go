@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+type ConsistencyController struct {
+	dic *di.Container
+}
+
+// NewConsistencyController creates and initializes an ConsistencyController
+func NewConsistencyController(dic *di.Container) *ConsistencyController {
+	return &ConsistencyController{
+		dic: dic,
+	}
+}
+
+// DanglingReferences reports the devices referencing a device profile or a device service which doesn't exist
+func (cc *ConsistencyController) DanglingReferences(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	danglingReferences, err := application.DanglingReferences(cc.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		references := make([]metadataDTOs.DanglingReference, len(danglingReferences))
+		for i, reference := range danglingReferences {
+			references[i] = metadataDTOs.FromDanglingReferenceModelToDTO(reference)
+		}
+		response = metadataDTOs.NewConsistencyResponse("", "", http.StatusOK, references)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,196 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func strictDic(dbClientMock *dbMock.DBClient) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{StrictReferentialIntegrity: true},
+			}
+		},
+	})
+	return dic
+}
+
+func TestAddDeviceStrictReferentialIntegrity(t *testing.T) {
+	testDevice := buildTestDeviceRequest()
+	notFoundService := testDevice
+	notFoundService.Device.ServiceName = "notFoundService"
+	notFoundProfile := testDevice
+	notFoundProfile.Device.ProfileName = "notFoundProfile"
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", testDevice.Device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceServiceNameExists", notFoundService.Device.ServiceName).Return(false, nil)
+	dbClientMock.On("DeviceProfileNameExists", notFoundProfile.Device.ProfileName).Return(false, nil)
+	controller := NewDeviceController(strictDic(dbClientMock))
+
+	tests := []struct {
+		name              string
+		request           requests.AddDeviceRequest
+		expectedErrorCode string
+		expectedMessage   string
+	}{
+		{"Invalid - not found service", notFoundService, "EDGEX-MD-2004", "device service 'notFoundService' does not exist"},
+		{"Invalid - not found profile", notFoundProfile, "EDGEX-MD-2004", "device profile 'notFoundProfile' does not exist"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]requests.AddDeviceRequest{testCase.request})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, v2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.AddDevice).ServeHTTP(recorder, req)
+
+			var res []errorcode.ErrorResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			require.Len(t, res, 1)
+			assert.Equal(t, http.StatusConflict, res[0].StatusCode, "BaseResponse status code not as expected")
+			assert.Equal(t, testCase.expectedErrorCode, res[0].ErrorCode, "Error code not as expected")
+			assert.Contains(t, res[0].Message, testCase.expectedMessage)
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+}
+
+func TestDeleteDeviceProfileStrictReferentialIntegrity(t *testing.T) {
+	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	unusedProfile := deviceProfile
+	unusedProfile.Id = "b7b2ecc5-2a13-4b33-9e5c-4a0d6a2b4bd2"
+	unusedProfile.Name = "unusedProfile"
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileById", deviceProfile.Id).Return(deviceProfile, nil)
+	dbClientMock.On("DeviceProfileById", unusedProfile.Id).Return(unusedProfile, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, deviceProfile.Name).Return([]models.Device{device}, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, unusedProfile.Name).Return([]models.Device{}, nil)
+	dbClientMock.On("DeleteDeviceProfileById", unusedProfile.Id).Return(nil)
+	dbClientMock.On("DeleteDeviceProfileByName", unusedProfile.Name).Return(nil)
+	controller := NewDeviceProfileController(strictDic(dbClientMock))
+
+	tests := []struct {
+		name               string
+		handler            http.HandlerFunc
+		vars               map[string]string
+		expectedStatusCode int
+		expectedErrorCode  string
+	}{
+		{"Valid - delete unused profile by id", controller.DeleteDeviceProfileById, map[string]string{v2.Id: unusedProfile.Id}, http.StatusOK, ""},
+		{"Valid - delete unused profile by name", controller.DeleteDeviceProfileByName, map[string]string{v2.Name: unusedProfile.Name}, http.StatusOK, ""},
+		{"Invalid - delete profile in use by id", controller.DeleteDeviceProfileById, map[string]string{v2.Id: deviceProfile.Id}, http.StatusConflict, "EDGEX-MD-2005"},
+		{"Invalid - delete profile in use by name", controller.DeleteDeviceProfileByName, map[string]string{v2.Name: deviceProfile.Name}, http.StatusConflict, "EDGEX-MD-2005"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, v2.ApiDeviceProfileRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, testCase.vars)
+
+			recorder := httptest.NewRecorder()
+			testCase.handler.ServeHTTP(recorder, req)
+
+			var res errorcode.ErrorResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Equal(t, testCase.expectedErrorCode, res.ErrorCode, "Error code not as expected")
+		})
+	}
+	dbClientMock.AssertNotCalled(t, "DeleteDeviceProfileById", deviceProfile.Id)
+	dbClientMock.AssertNotCalled(t, "DeleteDeviceProfileByName", deviceProfile.Name)
+}
+
+func TestDanglingReferences(t *testing.T) {
+	devices := []models.Device{
+		{Name: "Thermostat", ProfileName: "Thermostat", ServiceName: "device-modbus"},
+		{Name: "Camera", ProfileName: "Camera", ServiceName: "device-onvif"},
+		{Name: "Meter", ProfileName: "Meter", ServiceName: "device-onvif"},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return(devices, nil)
+	dbClientMock.On("DeviceProfileNameExists", "Thermostat").Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "Camera").Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", "Meter").Return(false, nil)
+	dbClientMock.On("DeviceServiceNameExists", "device-modbus").Return(true, nil)
+	dbClientMock.On("DeviceServiceNameExists", "device-onvif").Return(false, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewConsistencyController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, v2.ApiBase+"/consistency", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.DanglingReferences).ServeHTTP(recorder, req)
+
+	var res metadataDTOs.ConsistencyResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.False(t, res.Consistent)
+	assert.Equal(t, []metadataDTOs.DanglingReference{
+		{DeviceName: "Camera", ServiceName: "device-onvif"},
+		{DeviceName: "Meter", ProfileName: "Meter", ServiceName: "device-onvif"},
+	}, res.DanglingReferences)
+	dbClientMock.AssertNumberOfCalls(t, "DeviceServiceNameExists", 2)
+}
+
+func TestDanglingReferencesDatabaseError(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return(nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "connection refused", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewConsistencyController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, v2.ApiBase+"/consistency", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.DanglingReferences).ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Result().StatusCode)
+}
@@ -6,14 +6,18 @@
 package http
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 	"net/http"
 
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -24,6 +28,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 
 	"github.com/gorilla/mux"
 )
@@ -55,10 +60,7 @@ func (dc *DeviceController) AddDevice(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		errResponses := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		errResponses := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		pkg.Encode(errResponses, w, lc)
 		return
@@ -73,10 +75,7 @@ func (dc *DeviceController) AddDevice(w http.ResponseWriter, r *http.Request) {
 		if err != nil {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse(
-				reqId,
-				err.Message(),
-				err.Code())
+			response = ErrorCodes.NewErrorResponse(reqId, err)
 		} else {
 			response = commonDTO.NewBaseWithIdResponse(
 				reqId,
@@ -110,7 +109,7 @@ func (dc *DeviceController) DeleteDeviceById(w http.ResponseWriter, r *http.Requ
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = commonDTO.NewBaseResponse(
@@ -140,7 +139,7 @@ func (dc *DeviceController) DeleteDeviceByName(w http.ResponseWriter, r *http.Re
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = commonDTO.NewBaseResponse(
@@ -171,7 +170,7 @@ func (dc *DeviceController) DevicesByServiceName(w http.ResponseWriter, r *http.
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		devices, err := application.DevicesByServiceName(offset, limit, name, ctx, dc.dic)
@@ -180,7 +179,7 @@ func (dc *DeviceController) DevicesByServiceName(w http.ResponseWriter, r *http.
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, devices)
@@ -208,7 +207,7 @@ func (dc *DeviceController) DeviceIdExists(w http.ResponseWriter, r *http.Reques
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else if exists {
 		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
@@ -238,7 +237,7 @@ func (dc *DeviceController) DeviceNameExists(w http.ResponseWriter, r *http.Requ
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else if exists {
 		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
@@ -259,17 +258,23 @@ func (dc *DeviceController) PatchDevice(w http.ResponseWriter, r *http.Request)
 
 	lc := container.LoggingClientFrom(dc.dic.Get)
 
-	ctx := r.Context()
+	ctx := application.WithDeviceStateReason(r.Context(), r.Header.Get(application.DeviceStateReasonHeader))
 	correlationId := correlation.FromContext(ctx)
 
 	updateDeviceDTOs, err := dc.reader.ReadUpdateDeviceRequest(r.Body)
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		errResponses := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		errResponses := ErrorCodes.NewErrorResponse("", err)
+		utils.WriteHttpHeader(w, ctx, err.Code())
+		pkg.Encode(errResponses, w, lc)
+		return
+	}
+
+	ifMatch, err := batchIfMatch(r, len(updateDeviceDTOs))
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		errResponses := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		pkg.Encode(errResponses, w, lc)
 		return
@@ -279,14 +284,11 @@ func (dc *DeviceController) PatchDevice(w http.ResponseWriter, r *http.Request)
 	for _, dto := range updateDeviceDTOs {
 		var response interface{}
 		reqId := dto.RequestId
-		err := application.PatchDevice(dto.Device, ctx, dc.dic)
+		err := application.PatchDevice(dto.Device, ifMatch, ctx, dc.dic)
 		if err != nil {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse(
-				reqId,
-				err.Message(),
-				err.Code())
+			response = ErrorCodes.NewErrorResponse(reqId, err)
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -311,19 +313,59 @@ func (dc *DeviceController) AllDevices(w http.ResponseWriter, r *http.Request) {
 
 	// parse URL query string for offset, limit, and labels
 	offset, limit, labels, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	var expression filter.Expression
+	if err == nil {
+		// parse URL query string for the filter expression
+		expression, err = utils.ParseFilterQueryString(r, models.Device{})
+	}
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
-		devices, err := application.AllDevices(offset, limit, labels, dc.dic)
+		devices, err := application.AllDevices(offset, limit, labels, expression, dc.dic)
 		if err != nil {
 			if errors.Kind(err) != errors.KindEntityDoesNotExist {
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, devices)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceController) DevicesModifiedSince(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	// parse URL query string for modifiedSince, offset, and limit
+	since, offset, limit, err := utils.ParseModifiedSinceOffsetLimit(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		devices, err := application.DevicesModifiedSince(since, offset, limit, dc.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, devices)
@@ -353,9 +395,10 @@ func (dc *DeviceController) DeviceByName(w http.ResponseWriter, r *http.Request)
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		}
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
+		setETagHeader(w, device)
 		response = responseDTO.NewDeviceResponse("", "", http.StatusOK, device)
 		statusCode = http.StatusOK
 	}
@@ -381,7 +424,7 @@ func (dc *DeviceController) DevicesByProfileName(w http.ResponseWriter, r *http.
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		devices, err := application.DevicesByProfileName(offset, limit, name, dc.dic)
@@ -390,7 +433,7 @@ func (dc *DeviceController) DevicesByProfileName(w http.ResponseWriter, r *http.
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, devices)
@@ -401,3 +444,179 @@ func (dc *DeviceController) DevicesByProfileName(w http.ResponseWriter, r *http.
 	utils.WriteHttpHeader(w, ctx, statusCode)
 	pkg.Encode(response, w, lc)
 }
+
+// IndexVar and IndexValueVar are the route variables naming a secondary index and the value looked up
+const (
+	IndexVar      = "index"
+	IndexValueVar = "value"
+)
+
+// DevicesByIndex returns the devices holding the value of the secondary index declared in the configuration
+func (dc *DeviceController) DevicesByIndex(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	vars := mux.Vars(r)
+	name := vars[IndexVar]
+	value := vars[IndexValueVar]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		results, err := application.DevicesByIndex(name, value, offset, limit, dc.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, results)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// PropertyVar is the route variable naming a protocol property
+const PropertyVar = "property"
+
+// DevicesByProtocolProperty returns the devices whose protocol property holds the value in the URL
+func (dc *DeviceController) DevicesByProtocolProperty(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	vars := mux.Vars(r)
+	protocol := vars[ProtocolVar]
+	property := vars[PropertyVar]
+	value := vars[IndexValueVar]
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		results, err := application.DevicesByProtocolProperty(protocol, property, value, offset, limit, dc.dic)
+		if err != nil {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, results)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// MergePatchDeviceByName updates the device named in the URL with the JSON Merge Patch document in the request body
+func (dc *DeviceController) MergePatchDeviceByName(w http.ResponseWriter, r *http.Request) {
+	ctx := application.WithDeviceStateReason(r.Context(), r.Header.Get(application.DeviceStateReasonHeader))
+	mergePatch(w, r.WithContext(ctx), dc.dic, application.MergePatchDevice)
+}
+
+// CloneDeviceByName adds a new device copying the device named in the URL, with the overrides in the request body
+func (dc *DeviceController) CloneDeviceByName(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	var request metadataDTOs.CloneDeviceRequest
+	var newId string
+	var err errors.EdgeX
+	if decodeErr := json.NewDecoder(r.Body).Decode(&request); decodeErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, "clone device request decoding failed", decodeErr)
+	} else {
+		newId, err = application.CloneDevice(name, request, ctx, dc.dic)
+	}
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = commonDTO.NewBaseWithIdResponse("", "", http.StatusCreated, newId)
+		statusCode = http.StatusCreated
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// deleteDevicesConfirm is the query parameter carrying the confirm token of a bulk device deletion
+const deleteDevicesConfirm = "confirm"
+
+// DeleteDevices deletes all the devices of a device service or carrying a label, given by the serviceName or label
+// query parameter. The deletion is only performed when the confirm query parameter carries the token returned by a
+// request without it, which reports the number of devices the deletion would delete.
+func (dc *DeviceController) DeleteDevices(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	query := r.URL.Query()
+	serviceName := query.Get(v2.ServiceName)
+	label := query.Get(v2.Label)
+	confirm := query.Get(deleteDevicesConfirm)
+
+	var response interface{}
+	var statusCode int
+
+	count, confirmToken, err := application.DeleteDevices(serviceName, label, confirm, dc.dic)
+	if err != nil {
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		errorResponse := ErrorCodes.NewErrorResponse("", err)
+		response = metadataDTOs.DeleteDevicesResponse{
+			BaseResponse: errorResponse.BaseResponse,
+			ErrorCode:    errorResponse.ErrorCode,
+			Count:        count,
+			ConfirmToken: confirmToken,
+		}
+		statusCode = errorResponse.StatusCode
+	} else {
+		lc.Info(fmt.Sprintf("deleted %d devices", count), clients.CorrelationHeader, correlationId)
+		response = metadataDTOs.NewDeleteDevicesResponse("", "", http.StatusOK, count)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
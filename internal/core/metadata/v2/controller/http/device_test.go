@@ -13,10 +13,17 @@ import (
 	"strings"
 	"testing"
 
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
@@ -117,6 +124,7 @@ func TestAddDevice(t *testing.T) {
 	expectedRequestId := ExampleUUID
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
 
 	valid := testDevice
 	dbClientMock.On("DeviceServiceNameExists", deviceModel.ServiceName).Return(true, nil)
@@ -294,10 +302,11 @@ func TestDeleteDeviceByName(t *testing.T) {
 		name               string
 		deviceName         string
 		expectedStatusCode int
+		expectedErrorCode  string
 	}{
-		{"Valid - delete device by name", device.Name, http.StatusOK},
-		{"Invalid - name parameter is empty", noName, http.StatusBadRequest},
-		{"Invalid - device not found by name", notFoundName, http.StatusNotFound},
+		{"Valid - delete device by name", device.Name, http.StatusOK, ""},
+		{"Invalid - name parameter is empty", noName, http.StatusBadRequest, "EDGEX-MD-1003"},
+		{"Invalid - device not found by name", notFoundName, http.StatusNotFound, "EDGEX-MD-1002"},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -310,7 +319,7 @@ func TestDeleteDeviceByName(t *testing.T) {
 			recorder := httptest.NewRecorder()
 			handler := http.HandlerFunc(controller.DeleteDeviceByName)
 			handler.ServeHTTP(recorder, req)
-			var res common.BaseResponse
+			var res errorcode.ErrorResponse
 			err = json.Unmarshal(recorder.Body.Bytes(), &res)
 			require.NoError(t, err)
 
@@ -322,11 +331,91 @@ func TestDeleteDeviceByName(t *testing.T) {
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
 			} else {
 				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				assert.Equal(t, testCase.expectedErrorCode, res.ErrorCode, "Error code not as expected")
 			}
 		})
 	}
 }
 
+func TestDeleteDevices(t *testing.T) {
+	device1 := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device1.Id = "2c1e3bd2-6a3a-4e33-8b9f-2dfa44e7a8b1"
+	device2 := device1
+	device2.Id = "1f9e1c4b-0c5a-4b7e-9a7f-3b2a6e8d9c10"
+	device2.Name = "testDevice2"
+	devices := []models.Device{device1, device2}
+	ids := []string{device2.Id, device1.Id}
+	notFoundService := "notFoundService"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByServiceName", 0, -1, device1.ServiceName).Return(devices, nil)
+	dbClientMock.On("DevicesByServiceName", 0, -1, notFoundService).Return([]models.Device{}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string{testDeviceLabels[0]}).Return(devices, nil)
+	dbClientMock.On("DeleteDevicesByServiceName", device1.ServiceName, ids).Return(len(ids), nil)
+	dbClientMock.On("DeleteDevicesByLabel", testDeviceLabels[0], ids).Return(len(ids), nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	deleteDevices := func(query string) (*httptest.ResponseRecorder, metadataDTOs.DeleteDevicesResponse) {
+		req, err := http.NewRequest(http.MethodDelete, v2.ApiDeviceRoute+"?"+query, http.NoBody)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler := http.HandlerFunc(controller.DeleteDevices)
+		handler.ServeHTTP(recorder, req)
+		var res metadataDTOs.DeleteDevicesResponse
+		err = json.Unmarshal(recorder.Body.Bytes(), &res)
+		require.NoError(t, err)
+		return recorder, res
+	}
+
+	// a request without confirm token reports the devices to delete and their confirm token
+	serviceQuery := fmt.Sprintf("%s=%s", v2.ServiceName, device1.ServiceName)
+	recorder, res := deleteDevices(serviceQuery)
+	assert.Equal(t, http.StatusPreconditionRequired, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, "EDGEX-MD-2002", res.ErrorCode, "Error code not as expected")
+	assert.Equal(t, len(devices), res.Count, "Count not as expected")
+	require.NotEmpty(t, res.ConfirmToken, "Confirm token not returned")
+	dbClientMock.AssertNotCalled(t, "DeleteDevicesByServiceName", mock.Anything, mock.Anything)
+	serviceToken := res.ConfirmToken
+
+	_, res = deleteDevices(fmt.Sprintf("%s=%s", v2.Label, testDeviceLabels[0]))
+	labelToken := res.ConfirmToken
+	assert.NotEqual(t, serviceToken, labelToken, "Confirm token should depend on the filter")
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedStatusCode int
+		expectedErrorCode  string
+		expectedCount      int
+	}{
+		{"Valid - delete devices by service name", serviceQuery + "&" + deleteDevicesConfirm + "=" + serviceToken, http.StatusOK, "", len(devices)},
+		{"Valid - delete devices by label", fmt.Sprintf("%s=%s&%s=%s", v2.Label, testDeviceLabels[0], deleteDevicesConfirm, labelToken), http.StatusOK, "", len(devices)},
+		{"Invalid - confirm token of other filter", serviceQuery + "&" + deleteDevicesConfirm + "=" + labelToken, http.StatusConflict, "EDGEX-MD-2003", len(devices)},
+		{"Invalid - no filter", deleteDevicesConfirm + "=" + serviceToken, http.StatusBadRequest, "EDGEX-MD-1003", 0},
+		{"Invalid - both filters", serviceQuery + "&" + v2.Label + "=" + testDeviceLabels[0], http.StatusBadRequest, "EDGEX-MD-1003", 0},
+		{"Invalid - no matching device", v2.ServiceName + "=" + notFoundService, http.StatusNotFound, "EDGEX-MD-1002", 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder, res := deleteDevices(testCase.query)
+
+			assert.Equal(t, v2.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Equal(t, testCase.expectedErrorCode, res.ErrorCode, "Error code not as expected")
+			assert.Equal(t, testCase.expectedCount, res.Count, "Count not as expected")
+		})
+	}
+}
+
 func TestAllDeviceByServiceName(t *testing.T) {
 	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
 	testServiceA := "testServiceA"
@@ -523,6 +612,7 @@ func TestPatchDevice(t *testing.T) {
 	expectedRequestId := ExampleUUID
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
 	testReq := buildTestUpdateDeviceRequest()
 	dsModels := models.Device{
 		Id:             *testReq.Device.Id,
@@ -545,8 +635,7 @@ func TestPatchDevice(t *testing.T) {
 	dbClientMock.On("DeviceServiceNameExists", *valid.Device.ServiceName).Return(true, nil)
 	dbClientMock.On("DeviceProfileNameExists", *valid.Device.ProfileName).Return(true, nil)
 	dbClientMock.On("DeviceById", *valid.Device.Id).Return(dsModels, nil)
-	dbClientMock.On("DeleteDeviceById", *valid.Device.Id).Return(nil)
-	dbClientMock.On("AddDevice", mock.Anything).Return(dsModels, nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, dsModels.Modified).Return(dsModels, nil)
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
 	validWithNoId := testReq
@@ -667,6 +756,133 @@ func TestPatchDevice(t *testing.T) {
 
 }
 
+func TestPatchDeviceIfMatch(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
+	testReq := buildTestUpdateDeviceRequest()
+	stored := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	stored.Id = *testReq.Device.Id
+	etag, err := utils.ETag(dtos.FromDeviceModelToDTO(stored))
+	require.NoError(t, err)
+
+	dbClientMock.On("DeviceById", *testReq.Device.Id).Return(stored, nil)
+	dbClientMock.On("DeviceServiceNameExists", *testReq.Device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", *testReq.Device.ProfileName).Return(true, nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, stored.Modified).Return(stored, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name                 string
+		ifMatch              string
+		expectedResponseCode int
+	}{
+		{"Valid - no If-Match", "", http.StatusOK},
+		{"Valid - matching If-Match", etag, http.StatusOK},
+		{"Valid - one of the If-Match entity tags matches", `"other", ` + etag, http.StatusOK},
+		{"Invalid - stale If-Match", `"stale"`, http.StatusPreconditionFailed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]requests.UpdateDeviceRequest{testReq})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPatch, v2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			if testCase.ifMatch != "" {
+				req.Header.Set(ifMatchHeader, testCase.ifMatch)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.PatchDevice)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res []common.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedResponseCode, res[0].StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDevice", 3)
+}
+
+func TestPatchDeviceModifiedMeanwhile(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
+	testReq := buildTestUpdateDeviceRequest()
+	stored := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	stored.Id = *testReq.Device.Id
+	stored.Modified = 1600000000000
+
+	dbClientMock.On("DeviceById", *testReq.Device.Id).Return(stored, nil)
+	dbClientMock.On("DeviceServiceNameExists", *testReq.Device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", *testReq.Device.ProfileName).Return(true, nil)
+	dbClientMock.On("UpdateDevice", mock.Anything, stored.Modified).Return(stored,
+		errors.NewCommonEdgeX(errors.KindContractInvalid, "device was modified meanwhile", db.ErrModifiedMeanwhile))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	jsonData, err := json.Marshal([]requests.UpdateDeviceRequest{testReq})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPatch, v2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.PatchDevice)
+	handler.ServeHTTP(recorder, req)
+
+	// Assert
+	var res []common.BaseResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, res[0].StatusCode, "BaseResponse status code not as expected")
+}
+
+func TestPatchDeviceBatchIfMatch(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	testReq := buildTestUpdateDeviceRequest()
+
+	jsonData, err := json.Marshal([]requests.UpdateDeviceRequest{testReq, testReq})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPatch, v2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	req.Header.Set(ifMatchHeader, `"etag"`)
+
+	// Act
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.PatchDevice)
+	handler.ServeHTTP(recorder, req)
+
+	// Assert
+	var res common.BaseResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode, "BaseResponse status code not as expected")
+	assert.Contains(t, res.Message, ifMatchHeader)
+	dbClientMock.AssertNotCalled(t, "UpdateDevice", mock.Anything, mock.Anything)
+}
+
 func TestAllDevices(t *testing.T) {
 	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
 	devices := []models.Device{device, device, device}
@@ -739,6 +955,127 @@ func TestAllDevices(t *testing.T) {
 	}
 }
 
+func TestAllDevicesWithFilter(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByFilter", mock.MatchedBy(func(e filter.Expression) bool {
+		return e.String() == `operatingState == "UP"`
+	}), 0, v2.DefaultLimit).Return([]models.Device{device, device}, nil)
+	dbClientMock.On("DevicesByFilter", mock.MatchedBy(func(e filter.Expression) bool {
+		return e.String() == `(labels contains "MODBUS") and (labels contains "TEMP") and (serviceName == "test-device-service")`
+	}), 0, v2.DefaultLimit).Return([]models.Device{device}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		filter             string
+		labels             string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - filter", "operatingState=='UP'", "", 2, http.StatusOK},
+		{"Valid - filter and labels", "serviceName=='test-device-service'", strings.Join(testDeviceLabels, ","), 1, http.StatusOK},
+		{"Invalid - syntax", "operatingState='UP'", "", 0, http.StatusBadRequest},
+		{"Invalid - unknown field", "state=='UP'", "", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiAllDeviceRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(utils.Filter, testCase.filter)
+			if len(testCase.labels) > 0 {
+				query.Add(v2.Labels, testCase.labels)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.AllDevices).ServeHTTP(recorder, req)
+
+			var res responseDTO.MultiDevicesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+		})
+	}
+}
+
+func TestDevicesModifiedSince(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	devices := []models.Device{device, device, device}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesModifiedSince", 1600000000000, 0, 10).Return(devices, nil)
+	dbClientMock.On("DevicesModifiedSince", 1600000000000, 1, 1).Return([]models.Device{devices[1]}, nil)
+	dbClientMock.On("DevicesModifiedSince", 1600000000000, 4, 1).Return([]models.Device{}, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, "query objects bounds out of range.", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		modifiedSince      string
+		offset             string
+		limit              string
+		errorExpected      bool
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get devices modified since", "1600000000000", "0", "10", false, 3, http.StatusOK},
+		{"Valid - get devices modified since with offset", "1600000000000", "1", "1", false, 1, http.StatusOK},
+		{"Invalid - offset out of range", "1600000000000", "4", "1", true, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - modifiedSince missing", "", "0", "10", true, 0, http.StatusBadRequest},
+		{"Invalid - modifiedSince not a timestamp", "yesterday", "0", "10", true, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiDeviceRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if len(testCase.modifiedSince) > 0 {
+				query.Add(utils.ModifiedSince, testCase.modifiedSince)
+			}
+			query.Add(v2.Offset, testCase.offset)
+			query.Add(v2.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DevicesModifiedSince)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			if testCase.errorExpected {
+				var res common.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res responseDTO.MultiDevicesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+			}
+		})
+	}
+}
+
 func TestDeviceByName(t *testing.T) {
 	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
 	emptyName := ""
@@ -879,3 +1216,200 @@ func TestDevicesByProfileName(t *testing.T) {
 		})
 	}
 }
+
+func TestMergePatchDeviceByName(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	etag, edgeXerr := utils.ETag(dtos.FromDeviceModelToDTO(device))
+	require.NoError(t, edgeXerr)
+
+	patchedDevice := device
+	patchedDevice.Protocols = map[string]models.ProtocolProperties{
+		"modbus-ip": {"Address": "localhost", "Port": "1503", "UnitID": "1"},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceServiceNameExists", device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", device.ProfileName).Return(true, nil)
+	dbClientMock.On("UpdateDevice", patchedDevice, device.Modified).Return(patchedDevice, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	validPatch := `{"protocols":{"modbus-ip":{"Port":"1503"}}}`
+	tests := []struct {
+		name               string
+		contentType        string
+		ifMatch            string
+		patch              string
+		expectedStatusCode int
+	}{
+		{"Valid - patch a protocol property", utils.ContentTypeMergePatchJSON, "", validPatch, http.StatusOK},
+		{"Valid - patch with matching If-Match", utils.ContentTypeMergePatchJSON, etag, validPatch, http.StatusOK},
+		{"Invalid - stale If-Match", utils.ContentTypeMergePatchJSON, `"stale"`, validPatch, http.StatusPreconditionFailed},
+		{"Invalid - unsupported content type", clients.ContentTypeJSON, "", validPatch, http.StatusUnsupportedMediaType},
+		{"Invalid - rename device", utils.ContentTypeMergePatchJSON, "", `{"name":"renamed"}`, http.StatusBadRequest},
+		{"Invalid - remove required field", utils.ContentTypeMergePatchJSON, "", `{"serviceName":null}`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s", v2.ApiDeviceByNameRoute, device.Name)
+			req, err := http.NewRequest(http.MethodPatch, reqPath, strings.NewReader(testCase.patch))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: device.Name})
+			req.Header.Set(clients.ContentType, testCase.contentType)
+			if testCase.ifMatch != "" {
+				req.Header.Set(ifMatchHeader, testCase.ifMatch)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.MergePatchDeviceByName)
+			handler.ServeHTTP(recorder, req)
+
+			var res common.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.NotEmpty(t, recorder.Header().Get(eTagHeader), "ETag header not set")
+			}
+		})
+	}
+}
+
+func TestCloneDeviceByName(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device.Created = 123546789
+	device.LastReported = 123546789
+	notFoundName := "notFoundName"
+
+	clone := device
+	clone.Id = ""
+	clone.Name = "cloned-device"
+	clone.Created = 0
+	clone.LastReported = 0
+	clone.Protocols = map[string]models.ProtocolProperties{
+		"modbus-ip": {"Address": "localhost", "Port": "1503", "UnitID": "2"},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceByName", notFoundName).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceServiceNameExists", device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", device.ProfileName).Return(true, nil)
+	dbClientMock.On("AddDevice", clone).Return(clone, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceController(dic)
+	require.NotNil(t, controller)
+
+	validRequest := `{"name":"cloned-device","protocols":{"modbus-ip":{"Address":"localhost","Port":"1503","UnitID":"2"}}}`
+	tests := []struct {
+		name               string
+		deviceName         string
+		request            string
+		expectedStatusCode int
+	}{
+		{"Valid - clone with overridden protocols", device.Name, validRequest, http.StatusCreated},
+		{"Invalid - device not found", notFoundName, validRequest, http.StatusNotFound},
+		{"Invalid - missing name", device.Name, `{"description":"clone"}`, http.StatusBadRequest},
+		{"Invalid - id set", device.Name, `{"id":"` + ExampleUUID + `","name":"cloned-device"}`, http.StatusBadRequest},
+		{"Invalid - invalid admin state", device.Name, `{"name":"cloned-device","adminState":"OPEN"}`, http.StatusBadRequest},
+		{"Invalid - malformed request", device.Name, `{`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/clone", v2.ApiDeviceByNameRoute, testCase.deviceName)
+			req, err := http.NewRequest(http.MethodPost, reqPath, strings.NewReader(testCase.request))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.CloneDeviceByName)
+			handler.ServeHTTP(recorder, req)
+
+			var res common.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertCalled(t, "AddDevice", clone)
+}
+
+func TestDevicesByProtocolProperty(t *testing.T) {
+	device := func(name string, address string, port string) models.Device {
+		return models.Device{Name: name, Protocols: map[string]models.ProtocolProperties{
+			"modbus-tcp": {"Address": address, "Port": port},
+		}}
+	}
+	devices := []models.Device{
+		device("Pump1", "10.0.0.5", "502"),
+		device("Pump2", "10.0.0.6", "502"),
+		device("Pump3", "10.0.0.5", "503"),
+		device("Pump4", "10.0.0.5", "502"),
+	}
+
+	dic := mockDic()
+	metadataContainer.ConfigurationFrom(dic.Get).DatabaseIndexes = map[string]db.IndexInfo{
+		"ModbusAddress": {Path: "protocols.modbus-tcp.Address"},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByIndex", "ModbusAddress", "10.0.0.5", 0, 20).Return([]models.Device{devices[0], devices[2], devices[3]}, nil)
+	dbClientMock.On("AllDevices", 0, -1, []string(nil)).Return(devices, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name            string
+		protocol        string
+		property        string
+		value           string
+		query           string
+		expectedDevices []string
+	}{
+		{"Valid - indexed property", "modbus-tcp", "Address", "10.0.0.5", "", []string{"Pump1", "Pump3", "Pump4"}},
+		{"Valid - property without index", "modbus-tcp", "Port", "502", "", []string{"Pump1", "Pump2", "Pump4"}},
+		{"Valid - property name case", "modbus-tcp", "port", "503", "", []string{"Pump3"}},
+		{"Valid - offset and limit without index", "modbus-tcp", "Port", "502", "?offset=1&limit=1", []string{"Pump2"}},
+		{"Valid - no match", "modbus-rtu", "Port", "502", "", []string{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiDeviceRoute+testCase.query, nil)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{ProtocolVar: testCase.protocol, PropertyVar: testCase.property, IndexValueVar: testCase.value})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.DevicesByProtocolProperty).ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+			var res responseDTO.MultiDevicesResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			names := []string{}
+			for _, d := range res.Devices {
+				names = append(names, d.Name)
+			}
+			assert.Equal(t, testCase.expectedDevices, names)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDeviceMetrics(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceNameExists", TestDeviceName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", "unknown").Return(false, nil)
+	dbClientMock.On("AddDeviceMetrics", TestDeviceName, devicemetrics.Counters{CommandsIssued: 3, CommandFailures: 1},
+		mock.Anything, 720*time.Hour).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				DeviceMetrics: config.DeviceMetricsInfo{Retention: "720h"},
+			}
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, `{"commandsIssued":3,"commandFailures":1}`, http.StatusOK},
+		{"Valid - nothing counted", TestDeviceName, `{}`, http.StatusOK},
+		{"Invalid - unknown device", "unknown", `{"eventsReceived":1}`, http.StatusNotFound},
+		{"Invalid - negative counter", TestDeviceName, `{"eventsReceived":-1}`, http.StatusBadRequest},
+		{"Invalid - malformed body", TestDeviceName, `{"eventsReceived":`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, devicemetrics.ApiDeviceMetricsByNameRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.AddDeviceMetrics).ServeHTTP(recorder, req)
+
+			var res common.BaseResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDeviceMetrics", 1)
+}
+
+func TestDeviceMetricsByName(t *testing.T) {
+	metrics := []devicemetrics.DailyCounters{
+		{Day: "2020-11-02", Counters: devicemetrics.Counters{EventsReceived: 120}},
+		{Day: "2020-11-01", Counters: devicemetrics.Counters{CommandsIssued: 4, CommandFailures: 2}},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceNameExists", TestDeviceName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", "unknown").Return(false, nil)
+	dbClientMock.On("DeviceMetricsByName", TestDeviceName, 0, 20).Return(metrics, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service: bootstrapConfig.ServiceInfo{MaxResultCount: 30},
+			}
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		expectedStatusCode int
+		expectedCount      int
+	}{
+		{"Valid", TestDeviceName, http.StatusOK, 2},
+		{"Invalid - unknown device", "unknown", http.StatusNotFound, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, devicemetrics.ApiDeviceMetricsByNameRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.DeviceMetricsByName).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DeviceMetricsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Len(t, res.Metrics, testCase.expectedCount)
+			if testCase.expectedCount > 0 {
+				assert.Equal(t, metrics, res.Metrics)
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ import (
 
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -20,6 +22,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 
 	"github.com/gorilla/mux"
 )
@@ -51,10 +54,7 @@ func (dc *DeviceProfileController) AddDeviceProfile(w http.ResponseWriter, r *ht
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		response := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		pkg.Encode(response, w, lc)
 		return
@@ -70,10 +70,7 @@ func (dc *DeviceProfileController) AddDeviceProfile(w http.ResponseWriter, r *ht
 		if err != nil {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			addDeviceProfileResponse = commonDTO.NewBaseResponse(
-				reqId,
-				err.Message(),
-				err.Code())
+			addDeviceProfileResponse = ErrorCodes.NewErrorResponse(reqId, err)
 		} else {
 			addDeviceProfileResponse = commonDTO.NewBaseWithIdResponse(
 				reqId,
@@ -102,10 +99,15 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(w http.ResponseWriter, r
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		response := ErrorCodes.NewErrorResponse("", err)
+		utils.WriteHttpHeader(w, ctx, err.Code())
+		pkg.Encode(response, w, lc)
+		return
+	}
+	ifMatch, err := batchIfMatch(r, len(updateDeviceProfileReq))
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		response := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		pkg.Encode(response, w, lc)
 		return
@@ -116,14 +118,11 @@ func (dc *DeviceProfileController) UpdateDeviceProfile(w http.ResponseWriter, r
 	for i, d := range deviceProfiles {
 		var response interface{}
 		reqId := updateDeviceProfileReq[i].RequestId
-		err := application.UpdateDeviceProfile(d, ctx, dc.dic)
+		err := application.UpdateDeviceProfile(d, ifMatch, ctx, dc.dic)
 		if err != nil {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse(
-				reqId,
-				err.Message(),
-				err.Code())
+			response = ErrorCodes.NewErrorResponse(reqId, err)
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -148,12 +147,9 @@ func (dc *DeviceProfileController) AddDeviceProfileByYaml(w http.ResponseWriter,
 	var addDeviceProfileResponse interface{}
 	var statusCode int
 
-	deviceProfileDTO, err := dc.reader.ReadDeviceProfileYaml(r)
+	deviceProfileDTO, data, err := dc.reader.ReadDeviceProfileYaml(r)
 	if err != nil {
-		addDeviceProfileResponse = commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		addDeviceProfileResponse = ErrorCodes.NewErrorResponse("", err)
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
 		utils.WriteHttpHeader(w, ctx, err.Code())
@@ -164,14 +160,12 @@ func (dc *DeviceProfileController) AddDeviceProfileByYaml(w http.ResponseWriter,
 
 	newId, err := application.AddDeviceProfile(deviceProfile, ctx, dc.dic)
 	if err != nil {
-		addDeviceProfileResponse = commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		addDeviceProfileResponse = ErrorCodes.NewErrorResponse("", err)
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
 		statusCode = err.Code()
 	} else {
+		application.KeepDeviceProfileYaml(deviceProfile.Name, data, ctx, dc.dic)
 		addDeviceProfileResponse = commonDTO.NewBaseWithIdResponse(
 			"",
 			"",
@@ -196,12 +190,9 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 	var response interface{}
 	var statusCode int
 
-	deviceProfileDTO, err := dc.reader.ReadDeviceProfileYaml(r)
+	deviceProfileDTO, data, err := dc.reader.ReadDeviceProfileYaml(r)
 	if err != nil {
-		response = commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
 		utils.WriteHttpHeader(w, ctx, err.Code())
@@ -210,16 +201,15 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 	}
 
 	deviceProfile := dtos.ToDeviceProfileModel(deviceProfileDTO)
-	err = application.UpdateDeviceProfile(deviceProfile, ctx, dc.dic)
+	err = application.UpdateDeviceProfile(deviceProfile, r.Header.Get(ifMatchHeader), ctx, dc.dic)
 	if err != nil {
-		response = commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		errResponse := ErrorCodes.NewErrorResponse("", err)
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		statusCode = err.Code()
+		response = errResponse
+		statusCode = errResponse.StatusCode
 	} else {
+		application.KeepDeviceProfileYaml(deviceProfile.Name, data, ctx, dc.dic)
 		response = commonDTO.NewBaseResponse(
 			"",
 			"",
@@ -232,6 +222,11 @@ func (dc *DeviceProfileController) UpdateDeviceProfileByYaml(w http.ResponseWrit
 }
 
 func (dc *DeviceProfileController) DeviceProfileByName(w http.ResponseWriter, r *http.Request) {
+	if acceptsYaml(r) {
+		dc.deviceProfileYamlByName(w, r)
+		return
+	}
+
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
@@ -249,9 +244,10 @@ func (dc *DeviceProfileController) DeviceProfileByName(w http.ResponseWriter, r
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		}
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
+		setETagHeader(w, deviceProfile)
 		response = responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, deviceProfile)
 		statusCode = http.StatusOK
 	}
@@ -260,6 +256,37 @@ func (dc *DeviceProfileController) DeviceProfileByName(w http.ResponseWriter, r
 	pkg.Encode(response, w, lc) // encode and send out the response
 }
 
+// DeviceProfileUsageByName returns how the device profile named in the URL is used by the devices, to help clean up the
+// stale profiles
+func (dc *DeviceProfileController) DeviceProfileUsageByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	usage, err := application.DeviceProfileUsage(name, dc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewDeviceProfileUsageResponse("", "", http.StatusOK, metadataDTOs.FromDeviceProfileUsageModelToDTO(usage))
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
 func (dc *DeviceProfileController) DeleteDeviceProfileById(w http.ResponseWriter, r *http.Request) {
 	lc := container.LoggingClientFrom(dc.dic.Get)
 	ctx := r.Context()
@@ -276,8 +303,9 @@ func (dc *DeviceProfileController) DeleteDeviceProfileById(w http.ResponseWriter
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
-		statusCode = err.Code()
+		errorResponse := ErrorCodes.NewErrorResponse("", err)
+		response = errorResponse
+		statusCode = errorResponse.StatusCode
 	} else {
 		response = commonDTO.NewBaseResponse(
 			"",
@@ -306,8 +334,9 @@ func (dc *DeviceProfileController) DeleteDeviceProfileByName(w http.ResponseWrit
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
-		statusCode = err.Code()
+		errorResponse := ErrorCodes.NewErrorResponse("", err)
+		response = errorResponse
+		statusCode = errorResponse.StatusCode
 	} else {
 		response = commonDTO.NewBaseResponse(
 			"",
@@ -331,19 +360,59 @@ func (dc *DeviceProfileController) AllDeviceProfiles(w http.ResponseWriter, r *h
 
 	// parse URL query string for offset, limit, and labels
 	offset, limit, labels, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	var expression filter.Expression
+	if err == nil {
+		// parse URL query string for the filter expression
+		expression, err = utils.ParseFilterQueryString(r, models.DeviceProfile{})
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		deviceProfiles, err := application.AllDeviceProfiles(offset, limit, labels, expression, dc.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiDeviceProfilesResponse("", "", http.StatusOK, deviceProfiles)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+func (dc *DeviceProfileController) DeviceProfilesModifiedSince(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	// parse URL query string for modifiedSince, offset, and limit
+	since, offset, limit, err := utils.ParseModifiedSinceOffsetLimit(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
-		deviceProfiles, err := application.AllDeviceProfiles(offset, limit, labels, dc.dic)
+		deviceProfiles, err := application.DeviceProfilesModifiedSince(since, offset, limit, dc.dic)
 		if err != nil {
 			if errors.Kind(err) != errors.KindEntityDoesNotExist {
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDeviceProfilesResponse("", "", http.StatusOK, deviceProfiles)
@@ -372,7 +441,7 @@ func (dc *DeviceProfileController) DeviceProfilesByModel(w http.ResponseWriter,
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		deviceProfiles, err := application.DeviceProfilesByModel(offset, limit, model, dc.dic)
@@ -381,7 +450,7 @@ func (dc *DeviceProfileController) DeviceProfilesByModel(w http.ResponseWriter,
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDeviceProfilesResponse("", "", http.StatusOK, deviceProfiles)
@@ -410,7 +479,7 @@ func (dc *DeviceProfileController) DeviceProfilesByManufacturer(w http.ResponseW
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		deviceProfiles, err := application.DeviceProfilesByManufacturer(offset, limit, manufacturer, dc.dic)
@@ -419,7 +488,7 @@ func (dc *DeviceProfileController) DeviceProfilesByManufacturer(w http.ResponseW
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDeviceProfilesResponse("", "", http.StatusOK, deviceProfiles)
@@ -449,7 +518,7 @@ func (dc *DeviceProfileController) DeviceProfilesByManufacturerAndModel(w http.R
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		deviceProfiles, err := application.DeviceProfilesByManufacturerAndModel(offset, limit, manufacturer, model, dc.dic)
@@ -458,7 +527,7 @@ func (dc *DeviceProfileController) DeviceProfilesByManufacturerAndModel(w http.R
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDeviceProfilesResponse("", "", http.StatusOK, deviceProfiles)
@@ -469,3 +538,9 @@ func (dc *DeviceProfileController) DeviceProfilesByManufacturerAndModel(w http.R
 	utils.WriteHttpHeader(w, ctx, statusCode)
 	pkg.Encode(response, w, lc)
 }
+
+// MergePatchDeviceProfileByName updates the device profile named in the URL with the JSON Merge Patch document in the
+// request body
+func (dc *DeviceProfileController) MergePatchDeviceProfileByName(w http.ResponseWriter, r *http.Request) {
+	mergePatch(w, r, dc.dic, application.MergePatchDeviceProfile)
+}
@@ -19,7 +19,9 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
@@ -36,6 +38,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -386,8 +389,8 @@ func TestUpdateDeviceProfile(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel, int64(0)).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel, int64(0)).Return(notFoundDBError)
 	dic.Update(di.ServiceConstructorMap{
 		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -461,6 +464,7 @@ func TestAddDeviceProfileByYaml_Created(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
+	dbClientMock.On("SetDeviceProfileYaml", deviceProfileModel.Name, mock.Anything).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
 		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -488,6 +492,7 @@ func TestAddDeviceProfileByYaml_Created(t *testing.T) {
 	assert.Equal(t, contractsV2.ApiVersion, res.ApiVersion, "API Version not as expected")
 	assert.Equal(t, http.StatusCreated, res.StatusCode, "BaseResponse status code not as expected")
 	assert.Empty(t, res.Message, "Message should be empty when it is successful")
+	dbClientMock.AssertCalled(t, "SetDeviceProfileYaml", deviceProfileModel.Name, valid)
 }
 
 func TestAddDeviceProfileByYaml_BadRequest(t *testing.T) {
@@ -638,6 +643,104 @@ func TestAddDeviceProfileByYaml_MissingFile(t *testing.T) {
 	assert.Contains(t, res.Message, "missing yaml file")
 }
 
+func TestUpdateDeviceProfileIfMatch(t *testing.T) {
+	deviceProfileRequest := buildTestDeviceProfileRequest()
+	deviceProfileModel := requests.DeviceProfileReqToDeviceProfileModel(deviceProfileRequest)
+	stored := deviceProfileModel
+	stored.Description = "stored description"
+	stored.Modified = 1600000000000
+	etag, err := utils.ETag(dtos.FromDeviceProfileModelToDTO(stored))
+	require.NoError(t, err)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", deviceProfileModel.Name).Return(stored, nil)
+	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel, int64(0)).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel, stored.Modified).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	tests := []struct {
+		name                 string
+		ifMatch              string
+		expectedResponseCode int
+	}{
+		{"Valid - no If-Match", "", http.StatusOK},
+		{"Valid - matching If-Match", etag, http.StatusOK},
+		{"Invalid - stale If-Match", `"stale"`, http.StatusPreconditionFailed},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]requests.DeviceProfileRequest{deviceProfileRequest})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPut, contractsV2.ApiDeviceProfileRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			if testCase.ifMatch != "" {
+				req.Header.Set(ifMatchHeader, testCase.ifMatch)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.UpdateDeviceProfile)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			var res []common.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedResponseCode, res[0].StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", 2)
+}
+
+func TestUpdateDeviceProfileBatchIfMatch(t *testing.T) {
+	deviceProfileRequest := buildTestDeviceProfileRequest()
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	tests := []struct {
+		name               string
+		ifMatch            string
+		expectedStatusCode int
+	}{
+		{"Valid - no If-Match", "", http.StatusMultiStatus},
+		{"Invalid - If-Match on more than one device profile", `"etag"`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock.On("UpdateDeviceProfile", mock.Anything, int64(0)).Return(nil)
+			jsonData, err := json.Marshal([]requests.DeviceProfileRequest{deviceProfileRequest, deviceProfileRequest})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPut, contractsV2.ApiDeviceProfileRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			if testCase.ifMatch != "" {
+				req.Header.Set(ifMatchHeader, testCase.ifMatch)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.UpdateDeviceProfile)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceProfile", 2)
+}
+
 func TestUpdateDeviceProfileByYaml(t *testing.T) {
 	deviceProfile := buildTestDeviceProfileRequest().Profile
 
@@ -689,8 +792,9 @@ func TestUpdateDeviceProfileByYaml(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel, int64(0)).Return(nil)
+	dbClientMock.On("SetDeviceProfileYaml", validDeviceProfileModel.Name, mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel, int64(0)).Return(notFoundDBError)
 	dic.Update(di.ServiceConstructorMap{
 		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -801,6 +905,81 @@ func TestDeviceProfileByName(t *testing.T) {
 	}
 }
 
+func TestDeviceProfileUsageByName(t *testing.T) {
+	profile := models.DeviceProfile{
+		Name:           "Boiler",
+		DeviceCommands: []models.ProfileResource{{Name: "Temperature"}, {Name: "Pressure"}, {Name: "Reset"}, {Name: "Calibrate"}},
+		CoreCommands:   []models.Command{{Name: "Reset", Put: true}},
+	}
+	devices := []models.Device{
+		{Name: "boiler-2", ServiceName: "device-modbus", ProfileName: "Boiler", AutoEvents: []models.AutoEvent{{Resource: "Temperature"}}},
+		{Name: "boiler-1", ServiceName: "device-modbus", ProfileName: "Boiler"},
+		{Name: "boiler-3", ServiceName: "device-opcua", ProfileName: "Boiler"},
+	}
+	unusedProfile := models.DeviceProfile{Name: "Pump", DeviceCommands: []models.ProfileResource{{Name: "Speed"}}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", "Boiler").Return(profile, nil)
+	dbClientMock.On("DeviceProfileByName", "Pump").Return(unusedProfile, nil)
+	dbClientMock.On("DeviceProfileByName", "Unknown").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile doesn't exist in the database", nil))
+	dbClientMock.On("DevicesByProfileName", 0, -1, "Boiler").Return(devices, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, "Pump").Return([]models.Device{}, nil)
+	dbClientMock.On("DevicesLastEventReceived", []string{"boiler-1", "boiler-2", "boiler-3"}).Return(map[string]int64{"boiler-1": 1000, "boiler-3": 3000}, nil)
+	dbClientMock.On("DevicesLastEventReceived", []string{}).Return(map[string]int64{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceProfileController(dic)
+
+	tests := []struct {
+		name               string
+		profileName        string
+		expectedStatusCode int
+		expectedUsage      metadataDTOs.DeviceProfileUsage
+	}{
+		{"Valid - used profile", "Boiler", http.StatusOK, metadataDTOs.DeviceProfileUsage{
+			ProfileName:  "Boiler",
+			DeviceCount:  3,
+			DeviceCounts: map[string]int{"device-modbus": 2, "device-opcua": 1},
+			Devices: []metadataDTOs.DeviceUsage{
+				{DeviceName: "boiler-1", ServiceName: "device-modbus", LastEventReceived: 1000},
+				{DeviceName: "boiler-2", ServiceName: "device-modbus"},
+				{DeviceName: "boiler-3", ServiceName: "device-opcua", LastEventReceived: 3000},
+			},
+			LastEventReceived:    3000,
+			UnusedDeviceCommands: []string{"Pressure", "Calibrate"},
+		}},
+		{"Valid - unused profile", "Pump", http.StatusOK, metadataDTOs.DeviceProfileUsage{
+			ProfileName:          "Pump",
+			DeviceCounts:         map[string]int{},
+			Devices:              []metadataDTOs.DeviceUsage{},
+			UnusedDeviceCommands: []string{"Speed"},
+		}},
+		{"Invalid - device profile not found by name", "Unknown", http.StatusNotFound, metadataDTOs.DeviceProfileUsage{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, contractsV2.ApiDeviceProfileRoute+"/name/"+testCase.profileName+"/usage", http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{contractsV2.Name: testCase.profileName})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.DeviceProfileUsageByName).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DeviceProfileUsageResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testCase.expectedUsage, res.Usage)
+			}
+		})
+	}
+}
+
 func TestDeleteDeviceProfileById(t *testing.T) {
 	deviceProfile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
 	noId := ""
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// UploadDeviceProfiles adds or updates several device profiles at once, all or none of them. The device profiles are
+// either a JSON array, or the YAML and JSON files of a zip archive uploaded as the file form field. Each may extend
+// another device profile, uploaded along or stored. The response holds the result of every device profile, and is 200
+// OK once they were all applied.
+func (dc *DeviceProfileController) UploadDeviceProfiles(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var results []metadataDTOs.BulkDeviceProfileResult
+	var edgeXerr errors.EdgeX
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get(clients.ContentType)); mediaType == "multipart/form-data" {
+		var data []byte
+		data, edgeXerr = readDeviceProfileBundleFile(r)
+		if edgeXerr == nil {
+			results, edgeXerr = application.ApplyDeviceProfileBundle(data, ctx, dc.dic)
+		}
+	} else {
+		var dps []metadataDTOs.BulkDeviceProfile
+		if err := json.NewDecoder(r.Body).Decode(&dps); err != nil {
+			edgeXerr = errors.NewCommonEdgeX(errors.KindContractInvalid, "device profile json decoding failed", err)
+		} else {
+			results, edgeXerr = application.ApplyDeviceProfiles(dps, ctx, dc.dic)
+		}
+	}
+
+	var response interface{}
+	statusCode := http.StatusOK
+	if edgeXerr != nil {
+		lc.Error(edgeXerr.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(edgeXerr.DebugMessages(), clients.CorrelationHeader, correlationId)
+		statusCode = edgeXerr.Code()
+		if results == nil {
+			response = ErrorCodes.NewErrorResponse("", edgeXerr)
+		} else {
+			response = metadataDTOs.NewBulkDeviceProfileResponse("", edgeXerr.Error(), statusCode, false, results)
+		}
+	} else {
+		response = metadataDTOs.NewBulkDeviceProfileResponse("", "", statusCode, true, results)
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// readDeviceProfileBundleFile reads the zip archive uploaded as the file form field
+func readDeviceProfileBundleFile(r *http.Request) ([]byte, errors.EdgeX) {
+	f, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing zip file", err)
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to read zip file", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,192 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func buildTestBulkDeviceProfile(name string, extends string) metadataDTOs.BulkDeviceProfile {
+	dp := buildTestDeviceProfileRequest().Profile
+	dp.Id = ""
+	dp.Name = name
+	if extends != "" {
+		dp.Manufacturer = ""
+		dp.DeviceResources = nil
+		dp.CoreCommands = nil
+	}
+	return metadataDTOs.BulkDeviceProfile{DeviceProfile: dp, Extends: extends}
+}
+
+func profileNamed(name string) interface{} {
+	return mock.MatchedBy(func(dp models.DeviceProfile) bool { return dp.Name == name })
+}
+
+func TestUploadDeviceProfiles(t *testing.T) {
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	base := buildTestBulkDeviceProfile("base", "")
+	derived := buildTestBulkDeviceProfile("derived", "base")
+	stored := dtos.ToDeviceProfileModel(buildTestBulkDeviceProfile("stored", "").DeviceProfile)
+	stored.Id = ExampleUUID
+	stored.Modified = 1600000000000
+	unresolved := buildTestBulkDeviceProfile("unresolved", "")
+	unresolved.DeviceCommands[0].Get[0].DeviceResource = "unknown"
+	cycleA := buildTestBulkDeviceProfile("cycleA", "cycleB")
+	cycleB := buildTestBulkDeviceProfile("cycleB", "cycleA")
+	failing := buildTestBulkDeviceProfile("failing", "")
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	for _, name := range []string{"base", "derived", "unresolved", "cycleA", "cycleB", "failing", "missing"} {
+		dbClientMock.On("DeviceProfileByName", name).Return(models.DeviceProfile{}, notFound)
+	}
+	dbClientMock.On("DeviceProfileByName", "stored").Return(stored, nil)
+	dbClientMock.On("AddDeviceProfile", profileNamed("base")).Return(models.DeviceProfile{Id: "base-id"}, nil)
+	dbClientMock.On("AddDeviceProfile", profileNamed("derived")).Return(models.DeviceProfile{Id: "derived-id"}, nil)
+	dbClientMock.On("AddDeviceProfile", profileNamed("failing")).Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed", nil))
+	dbClientMock.On("UpdateDeviceProfile", mock.Anything, mock.Anything).Return(nil)
+	dbClientMock.On("DeleteDeviceProfileByName", "base").Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		profiles           []metadataDTOs.BulkDeviceProfile
+		expectedStatusCode int
+		expectedResults    []string
+		expectedCodes      []int
+	}{
+		{"Valid - base applied first", []metadataDTOs.BulkDeviceProfile{derived, base}, http.StatusOK, []string{"base", "derived"}, []int{http.StatusCreated, http.StatusCreated}},
+		{"Valid - stored base updated", []metadataDTOs.BulkDeviceProfile{buildTestBulkDeviceProfile("stored", "")}, http.StatusOK, []string{"stored"}, []int{http.StatusOK}},
+		{"Invalid - unknown deviceResource", []metadataDTOs.BulkDeviceProfile{base, unresolved}, http.StatusBadRequest, []string{"base", "unresolved"}, []int{http.StatusFailedDependency, http.StatusBadRequest}},
+		{"Invalid - base not found", []metadataDTOs.BulkDeviceProfile{buildTestBulkDeviceProfile("derived", "missing")}, http.StatusNotFound, []string{"derived"}, []int{http.StatusNotFound}},
+		{"Invalid - cycle", []metadataDTOs.BulkDeviceProfile{cycleA, cycleB}, http.StatusBadRequest, []string{"cycleA", "cycleB"}, []int{http.StatusBadRequest, http.StatusBadRequest}},
+		{"Invalid - duplicated", []metadataDTOs.BulkDeviceProfile{base, base}, http.StatusBadRequest, []string{"base", "base"}, []int{http.StatusFailedDependency, http.StatusBadRequest}},
+		{"Invalid - rolled back", []metadataDTOs.BulkDeviceProfile{base, failing}, http.StatusInternalServerError, []string{"base", "failing"}, []int{http.StatusFailedDependency, http.StatusInternalServerError}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.profiles)
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, contractsV2.ApiDeviceProfileRoute+"/bulk", bytes.NewReader(jsonData))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.UploadDeviceProfiles)
+			handler.ServeHTTP(recorder, req)
+
+			var res metadataDTOs.BulkDeviceProfileResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode == http.StatusOK, res.Applied)
+			require.Len(t, res.Results, len(testCase.expectedResults))
+			for i, result := range res.Results {
+				assert.Equal(t, testCase.expectedResults[i], result.Name)
+				assert.Equal(t, testCase.expectedCodes[i], result.StatusCode, result.Message)
+			}
+		})
+	}
+
+	dbClientMock.AssertCalled(t, "AddDeviceProfile", mock.MatchedBy(func(dp models.DeviceProfile) bool {
+		return dp.Name == "derived" && dp.Manufacturer == TestManufacturer && len(dp.DeviceResources) == 1
+	}))
+	dbClientMock.AssertCalled(t, "UpdateDeviceProfile", profileNamed("stored"), stored.Modified)
+	dbClientMock.AssertCalled(t, "DeleteDeviceProfileByName", "base")
+	dbClientMock.AssertNotCalled(t, "AddDeviceProfile", profileNamed("unresolved"))
+}
+
+func TestUploadDeviceProfiles_Zip(t *testing.T) {
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	baseYaml, err := yaml.Marshal(buildTestBulkDeviceProfile("base", ""))
+	require.NoError(t, err)
+	derivedJson, err := json.Marshal(buildTestBulkDeviceProfile("derived", "base"))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	zipWriter := zip.NewWriter(&archive)
+	for name, data := range map[string][]byte{
+		"profiles/derived.json": derivedJson,
+		"profiles/base.yaml":    baseYaml,
+		"README.md":             []byte("# profiles"),
+	} {
+		f, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", mock.Anything).Return(models.DeviceProfile{}, notFound)
+	dbClientMock.On("AddDeviceProfile", mock.Anything).Return(models.DeviceProfile{Id: ExampleUUID}, nil)
+	dbClientMock.On("SetDeviceProfileYaml", "base", baseYaml).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	require.NotNil(t, controller)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "profiles.zip")
+	require.NoError(t, err)
+	_, err = part.Write(archive.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	req, err := http.NewRequest(http.MethodPost, contractsV2.ApiDeviceProfileRoute+"/bulk", body)
+	require.NoError(t, err)
+	req.Header.Set(clients.ContentType, writer.FormDataContentType())
+
+	recorder := httptest.NewRecorder()
+	handler := http.HandlerFunc(controller.UploadDeviceProfiles)
+	handler.ServeHTTP(recorder, req)
+
+	var res metadataDTOs.BulkDeviceProfileResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.True(t, res.Applied)
+	require.Len(t, res.Results, 2)
+	assert.Equal(t, "profiles/base.yaml", res.Results[0].File)
+	assert.Equal(t, "profiles/derived.json", res.Results[1].File)
+	assert.Equal(t, metadataDTOs.BulkActionAdd, res.Results[1].Action)
+	dbClientMock.AssertCalled(t, "SetDeviceProfileYaml", "base", baseYaml)
+	dbClientMock.AssertNumberOfCalls(t, "SetDeviceProfileYaml", 1)
+}
@@ -0,0 +1,114 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+)
+
+// Query parameters of the device profile bundle download, the comma separated names of the device profiles and the
+// format of their files
+const (
+	downloadNames  = "names"
+	downloadFormat = "format"
+)
+
+// ContentTypeZip is the content type of the device profile bundles
+const ContentTypeZip = "application/zip"
+
+// acceptsYaml tells whether the client asked for YAML
+func acceptsYaml(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		switch strings.TrimSpace(strings.Split(accept, ";")[0]) {
+		case clients.ContentTypeYAML, "application/yaml", "text/yaml":
+			return true
+		}
+	}
+	return false
+}
+
+// deviceProfileYamlByName writes the device profile in the YAML format it was uploaded in
+func (dc *DeviceProfileController) deviceProfileYamlByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	name := mux.Vars(r)[v2.Name]
+	data, err := application.DeviceProfileYamlByName(name, dc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		utils.WriteHttpHeader(w, ctx, err.Code())
+		pkg.Encode(ErrorCodes.NewErrorResponse("", err), w, lc)
+		return
+	}
+
+	writeFile(w, ctx, clients.ContentTypeYAML, data)
+}
+
+// DownloadDeviceProfiles writes a zip archive of the device profiles named by the names query parameter, or of all
+// the device profiles carrying the labels with offset and limit, as YAML files unless the format query parameter is
+// json
+func (dc *DeviceProfileController) DownloadDeviceProfiles(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	query := r.URL.Query()
+	var names []string
+	if query.Get(downloadNames) != "" {
+		names = strings.Split(query.Get(downloadNames), v2.CommaSeparator)
+	}
+	format := query.Get(downloadFormat)
+	if format == "" {
+		format = application.DeviceProfileFormatYaml
+	}
+
+	var data []byte
+	offset, limit, labels, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err == nil {
+		data, err = application.DeviceProfilesBundle(names, offset, limit, labels, format, dc.dic)
+	}
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		utils.WriteHttpHeader(w, ctx, err.Code())
+		pkg.Encode(ErrorCodes.NewErrorResponse("", err), w, lc)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="deviceprofiles.zip"`)
+	writeFile(w, ctx, ContentTypeZip, data)
+}
+
+// writeFile writes the successful response of a file, which unlike the other responses isn't JSON
+func writeFile(w http.ResponseWriter, ctx context.Context, contentType string, data []byte) {
+	w.Header().Set(clients.CorrelationHeader, correlation.FromContext(ctx))
+	w.Header().Set(clients.ContentType, contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,189 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestDeviceProfileByName_Yaml(t *testing.T) {
+	uploaded := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	uploaded.Name = "uploaded"
+	uploadedYaml := []byte("# authored\nname: uploaded\n")
+	encoded := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	encoded.Id = ExampleUUID
+	notFoundName := "notFoundName"
+	notFoundErr := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", uploaded.Name).Return(uploaded, nil)
+	dbClientMock.On("DeviceProfileYamlByName", uploaded.Name).Return(uploadedYaml, nil)
+	dbClientMock.On("DeviceProfileByName", encoded.Name).Return(encoded, nil)
+	dbClientMock.On("DeviceProfileYamlByName", encoded.Name).Return(nil, notFoundErr)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, notFoundErr)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceProfileName  string
+		accept             string
+		expectedStatusCode int
+	}{
+		{"Valid - uploaded YAML file", uploaded.Name, clients.ContentTypeYAML, http.StatusOK},
+		{"Valid - re-encoded device profile", encoded.Name, "text/yaml, application/json;q=0.5", http.StatusOK},
+		{"Invalid - device profile not found by name", notFoundName, "application/yaml", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reqPath := fmt.Sprintf("%s/%s/%s", contractsV2.ApiDeviceProfileRoute, contractsV2.Name, testCase.deviceProfileName)
+			req, err := http.NewRequest(http.MethodGet, reqPath, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{contractsV2.Name: testCase.deviceProfileName})
+			req.Header.Set("Accept", testCase.accept)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DeviceProfileByName)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res common.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, clients.ContentTypeYAML, recorder.Header().Get(clients.ContentType))
+			if testCase.deviceProfileName == uploaded.Name {
+				assert.Equal(t, uploadedYaml, recorder.Body.Bytes(), "Uploaded YAML file not returned as authored")
+				return
+			}
+			var document map[string]interface{}
+			err = yaml.Unmarshal(recorder.Body.Bytes(), &document)
+			require.NoError(t, err)
+			assert.Equal(t, encoded.Name, document["name"])
+			assert.NotContains(t, document, "id")
+			assert.NotContains(t, document, "versionable")
+			assert.Contains(t, document, "deviceResources")
+		})
+	}
+}
+
+func TestDownloadDeviceProfiles(t *testing.T) {
+	profile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	profile.Id = ExampleUUID
+	uploaded := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	uploaded.Name = "uploaded"
+	uploadedYaml := []byte("# authored\nname: uploaded\n")
+	notFoundName := "notFoundName"
+	notFoundErr := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", profile.Name).Return(profile, nil)
+	dbClientMock.On("DeviceProfileYamlByName", profile.Name).Return(nil, notFoundErr)
+	dbClientMock.On("DeviceProfileByName", uploaded.Name).Return(uploaded, nil)
+	dbClientMock.On("DeviceProfileYamlByName", uploaded.Name).Return(uploadedYaml, nil)
+	dbClientMock.On("DeviceProfileByName", notFoundName).Return(models.DeviceProfile{}, notFoundErr)
+	dbClientMock.On("AllDeviceProfiles", 0, 10, []string{testDeviceProfileLabels[0]}).Return([]models.DeviceProfile{uploaded}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedStatusCode int
+		expectedFiles      []string
+	}{
+		{"Valid - YAML files by names", fmt.Sprintf("names=%s,%s,%s", profile.Name, uploaded.Name, profile.Name), http.StatusOK, []string{profile.Name + ".yaml", uploaded.Name + ".yaml"}},
+		{"Valid - JSON files by names", fmt.Sprintf("names=%s&format=json", profile.Name), http.StatusOK, []string{profile.Name + ".json"}},
+		{"Valid - YAML files by labels", fmt.Sprintf("labels=%s&limit=10", testDeviceProfileLabels[0]), http.StatusOK, []string{uploaded.Name + ".yaml"}},
+		{"Invalid - unsupported format", fmt.Sprintf("names=%s&format=xml", profile.Name), http.StatusBadRequest, nil},
+		{"Invalid - device profile not found by name", fmt.Sprintf("names=%s,%s", profile.Name, notFoundName), http.StatusNotFound, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, contractsV2.ApiDeviceProfileRoute+"/download?"+testCase.query, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := http.HandlerFunc(controller.DownloadDeviceProfiles)
+			handler.ServeHTTP(recorder, req)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res common.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, ContentTypeZip, recorder.Header().Get(clients.ContentType))
+			archive, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+			require.NoError(t, err)
+			var files []string
+			for _, f := range archive.File {
+				files = append(files, f.Name)
+				rc, err := f.Open()
+				require.NoError(t, err)
+				data, err := ioutil.ReadAll(rc)
+				require.NoError(t, err)
+				rc.Close()
+				switch f.Name {
+				case uploaded.Name + ".yaml":
+					assert.Equal(t, uploadedYaml, data, "Uploaded YAML file not bundled as authored")
+				case profile.Name + ".json":
+					var dto dtos.DeviceProfile
+					require.NoError(t, json.Unmarshal(data, &dto))
+					assert.Equal(t, profile.Name, dto.Name)
+					assert.Empty(t, dto.Id)
+				}
+			}
+			assert.Equal(t, testCase.expectedFiles, files, "Bundled files not as expected")
+		})
+	}
+}
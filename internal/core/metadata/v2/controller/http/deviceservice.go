@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -24,6 +25,7 @@ import (
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
 
 	"github.com/gorilla/mux"
 )
@@ -55,10 +57,7 @@ func (dc *DeviceServiceController) AddDeviceService(w http.ResponseWriter, r *ht
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		errResponses := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		errResponses := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		// Encode and send the resp body as JSON format
 		pkg.Encode(errResponses, w, lc)
@@ -82,10 +81,11 @@ func (dc *DeviceServiceController) AddDeviceService(w http.ResponseWriter, r *ht
 		} else {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			_, statusCode := ErrorCodes.Code(err)
 			addDeviceServiceResponse = commonDTO.NewBaseResponse(
 				reqId,
 				err.Error(),
-				err.Code())
+				statusCode)
 		}
 		addResponses = append(addResponses, addDeviceServiceResponse)
 	}
@@ -113,7 +113,7 @@ func (dc *DeviceServiceController) DeviceServiceByName(w http.ResponseWriter, r
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		}
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = responseDTO.NewDeviceServiceResponse("", "", http.StatusOK, deviceService)
@@ -138,10 +138,7 @@ func (dc *DeviceServiceController) PatchDeviceService(w http.ResponseWriter, r *
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		errResponses := commonDTO.NewBaseResponse(
-			"",
-			err.Message(),
-			err.Code())
+		errResponses := ErrorCodes.NewErrorResponse("", err)
 		utils.WriteHttpHeader(w, ctx, err.Code())
 		pkg.Encode(errResponses, w, lc)
 		return
@@ -155,10 +152,7 @@ func (dc *DeviceServiceController) PatchDeviceService(w http.ResponseWriter, r *
 		if err != nil {
 			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse(
-				reqId,
-				err.Message(),
-				err.Code())
+			response = ErrorCodes.NewErrorResponse(reqId, err)
 		} else {
 			response = commonDTO.NewBaseResponse(
 				reqId,
@@ -188,7 +182,7 @@ func (dc *DeviceServiceController) DeleteDeviceServiceById(w http.ResponseWriter
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = commonDTO.NewBaseResponse(
@@ -218,7 +212,7 @@ func (dc *DeviceServiceController) DeleteDeviceServiceByName(w http.ResponseWrit
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
 		response = commonDTO.NewBaseResponse(
@@ -244,19 +238,24 @@ func (dc *DeviceServiceController) AllDeviceServices(w http.ResponseWriter, r *h
 
 	// parse URL query string for offset, limit, and labels
 	offset, limit, labels, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	var expression filter.Expression
+	if err == nil {
+		// parse URL query string for the filter expression
+		expression, err = utils.ParseFilterQueryString(r, models.DeviceService{})
+	}
 	if err != nil {
 		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-		response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		response = ErrorCodes.NewErrorResponse("", err)
 		statusCode = err.Code()
 	} else {
-		deviceServices, err := application.AllDeviceServices(offset, limit, labels, ctx, dc.dic)
+		deviceServices, err := application.AllDeviceServices(offset, limit, labels, expression, ctx, dc.dic)
 		if err != nil {
 			if errors.Kind(err) != errors.KindEntityDoesNotExist {
 				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
 			}
 			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
-			response = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+			response = ErrorCodes.NewErrorResponse("", err)
 			statusCode = err.Code()
 		} else {
 			response = responseDTO.NewMultiDeviceServicesResponse("", "", http.StatusOK, deviceServices)
@@ -268,3 +267,38 @@ func (dc *DeviceServiceController) AllDeviceServices(w http.ResponseWriter, r *h
 	// encode and send out the response
 	pkg.Encode(response, w, lc)
 }
+
+func (dc *DeviceServiceController) DeviceServicesModifiedSince(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	// parse URL query string for modifiedSince, offset, and limit
+	since, offset, limit, err := utils.ParseModifiedSinceOffsetLimit(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		deviceServices, err := application.DeviceServicesModifiedSince(since, offset, limit, dc.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = responseDTO.NewMultiDeviceServicesResponse("", "", http.StatusOK, deviceServices)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggerDiscovery(t *testing.T) {
+	discoveryServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, v2.ApiDiscoveryRoute, r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer discoveryServer.Close()
+	disabledServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer disabledServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	deviceServices := []models.DeviceService{
+		{Name: TestDeviceServiceName, BaseAddress: discoveryServer.URL, AdminState: models.Unlocked},
+		{Name: "disabled", BaseAddress: disabledServer.URL, AdminState: models.Unlocked},
+		{Name: "failing", BaseAddress: failingServer.URL, AdminState: models.Unlocked},
+		{Name: "locked", BaseAddress: discoveryServer.URL, AdminState: models.Locked},
+	}
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
+	dbClientMock.On("AllDeviceServices", 0, -1, []string(nil)).Return(deviceServices, nil)
+	dbClientMock.On("DeviceServiceNameExists", device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", device.ProfileName).Return(true, nil)
+	dbClientMock.On("AddDevice", device).Return(device, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{DiscoverySessionDuration: "1m"},
+				Service:  bootstrapConfig.ServiceInfo{Timeout: 5000},
+			}
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	req, err := http.NewRequest(http.MethodPost, v2.ApiDeviceRoute+"/discovery/all", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.TriggerDiscovery).ServeHTTP(recorder, req)
+
+	var res metadataDTOs.DiscoverySessionResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	require.Equal(t, http.StatusAccepted, recorder.Result().StatusCode)
+	assert.Equal(t, application.DiscoverySessionsInMemory, res.Message)
+	session := res.Session
+	assert.NotEmpty(t, session.Id)
+	assert.False(t, session.Completed)
+	require.Len(t, session.Services, len(deviceServices))
+	assert.Equal(t, application.DiscoveryTriggered, session.Services[0].Status)
+	assert.Equal(t, application.DiscoveryUnsupported, session.Services[1].Status)
+	assert.Equal(t, application.DiscoveryFailed, session.Services[2].Status)
+	assert.Equal(t, application.DiscoverySkipped, session.Services[3].Status)
+
+	// the triggered device service adds two devices through the V2 API and one through the V1 API
+	for i := 0; i < 2; i++ {
+		_, edgeXerr := application.AddDevice(device, context.Background(), dic)
+		require.NoError(t, edgeXerr)
+	}
+	application.DeviceAdded(TestDeviceServiceName)
+
+	tests := []struct {
+		name               string
+		sessionId          string
+		expectedStatusCode int
+	}{
+		{"Valid - session found", session.Id, http.StatusOK},
+		{"Invalid - session not found", ExampleUUID, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiDeviceRoute+"/discovery/session/{id}", http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Id: testCase.sessionId})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.DiscoverySessionById).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DiscoverySessionResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, application.DiscoverySessionsInMemory, res.Message)
+				assert.Equal(t, uint64(3), res.Session.Services[0].Discovered)
+				assert.Equal(t, uint64(0), res.Session.Services[1].Discovered)
+				assert.Equal(t, uint64(0), res.Session.Services[3].Discovered)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+)
+
+// ErrorCodes maps the errors of the core-metadata V2 API to their error codes
+var ErrorCodes = errorcode.NewRegistry(errorcode.CoreMetadata).
+	Register(2001, application.ErrETagMismatch, http.StatusPreconditionFailed, "entity modified since it was retrieved, If-Match precondition failed").
+	Register(2002, application.ErrDeleteNotConfirmed, http.StatusPreconditionRequired, "bulk device deletion not confirmed, confirm with the returned token").
+	Register(2003, application.ErrDeleteConfirmMismatch, http.StatusConflict, "matching devices changed since the confirm token was issued").
+	Register(2004, application.ErrMissingReference, http.StatusConflict, "referenced device profile or device service does not exist, in strict referential integrity mode").
+	Register(2005, application.ErrProfileInUse, http.StatusConflict, "device profile is referenced by devices, in strict referential integrity mode").
+	Register(2006, application.ErrProtocolSecretsForbidden, http.StatusForbidden, "caller doesn't hold the role required to resolve the secret protocol properties").
+	Register(2007, application.ErrRegistrationTokenInvalid, http.StatusUnauthorized, "device service registration token missing, unknown, already redeemed or expired").
+	Register(2008, application.ErrRegistrationTokenForbidden, http.StatusForbidden, "registration token doesn't grant access to the device service")
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+)
+
+type LabelController struct {
+	dic *di.Container
+}
+
+// NewLabelController creates and initializes an LabelController
+func NewLabelController(dic *di.Container) *LabelController {
+	return &LabelController{
+		dic: dic,
+	}
+}
+
+// AllLabels returns every label in use with the number of devices, device profiles and device services carrying it
+func (lb *LabelController) AllLabels(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(lb.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	usage, err := application.AllLabels(lb.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		labels := make([]metadataDTOs.LabelUsage, len(usage))
+		for i, u := range usage {
+			labels[i] = metadataDTOs.FromLabelUsageModelToDTO(u)
+		}
+		response = metadataDTOs.NewMultiLabelUsageResponse("", "", http.StatusOK, labels)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// RenameLabel renames the label in the URL to the name in the request body on all devices, device profiles and device
+// services carrying it
+func (lb *LabelController) RenameLabel(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(lb.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	var request metadataDTOs.RenameLabelRequest
+	var renamed int
+	var err errors.EdgeX
+	if decodeErr := json.NewDecoder(r.Body).Decode(&request); decodeErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, "label rename request decoding failed", decodeErr)
+	} else {
+		renamed, err = application.RenameLabel(name, request.Name, ctx, lb.dic)
+	}
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewRenameLabelResponse("", "", http.StatusOK, renamed)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllLabels(t *testing.T) {
+	usage := []pkgModels.LabelUsage{
+		{Label: "HVAC", Devices: 3, DeviceProfiles: 1},
+		{Label: "MODBUS", DeviceServices: 1},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("LabelUsage").Return(usage, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewLabelController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, v2.ApiBase+"/label/all", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.AllLabels).ServeHTTP(recorder, req)
+
+	var res metadataDTOs.MultiLabelUsageResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	require.Len(t, res.Labels, 2)
+	assert.Equal(t, metadataDTOs.LabelUsage{Label: "HVAC", Devices: 3, DeviceProfiles: 1}, res.Labels[0])
+	assert.Equal(t, int64(1), res.Labels[1].DeviceServices)
+}
+
+func TestRenameLabel(t *testing.T) {
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "label is not in use", nil)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("RenameLabel", "hvac", "HVAC").Return(4, nil)
+	dbClientMock.On("RenameLabel", "unused", "HVAC").Return(0, notFound)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Writable: config.WritableInfo{AllowedLabels: []string{"HVAC", "MODBUS"}},
+			}
+		},
+	})
+	controller := NewLabelController(dic)
+
+	tests := []struct {
+		name               string
+		label              string
+		body               string
+		expectedStatusCode int
+		expectedRenamed    int
+	}{
+		{"Valid", "hvac", `{"name":"HVAC"}`, http.StatusOK, 4},
+		{"Invalid - label not in use", "unused", `{"name":"HVAC"}`, http.StatusNotFound, 0},
+		{"Invalid - new label not allowed", "hvac", `{"name":"hvac-units"}`, http.StatusBadRequest, 0},
+		{"Invalid - renamed to itself", "HVAC", `{"name":"HVAC"}`, http.StatusBadRequest, 0},
+		{"Invalid - empty new label", "hvac", `{}`, http.StatusBadRequest, 0},
+		{"Invalid - malformed body", "hvac", `{"name":`, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPatch, v2.ApiBase+"/label/name/{name}", strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.label})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.RenameLabel).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.RenameLabelResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Equal(t, testCase.expectedRenamed, res.Renamed)
+		})
+	}
+}
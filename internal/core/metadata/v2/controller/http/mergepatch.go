@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	eTagHeader    = "ETag"
+	ifMatchHeader = "If-Match"
+)
+
+// batchIfMatch returns the If-Match header of a batch update of count entities, which is rejected when the batch
+// updates more than one entity as a single entity tag can't match several entities
+func batchIfMatch(r *http.Request, count int) (string, errors.EdgeX) {
+	ifMatch := r.Header.Get(ifMatchHeader)
+	if ifMatch != "" && count > 1 {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the %s header only applies to a single entity, %d are updated", ifMatchHeader, count), nil)
+	}
+	return ifMatch, nil
+}
+
+// mergePatchFunc applies a JSON Merge Patch document to the named entity and returns the patched entity's tag
+type mergePatchFunc func(name string, patch []byte, ifMatch string, ctx context.Context, dic *di.Container) (string, errors.EdgeX)
+
+// mergePatch handles a PATCH request carrying a JSON Merge Patch document for the entity named in the URL
+func mergePatch(w http.ResponseWriter, r *http.Request, dic *di.Container, patchEntity mergePatchFunc) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	name := mux.Vars(r)[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(clients.ContentType))
+	if err != nil || mediaType != utils.ContentTypeMergePatchJSON {
+		statusCode = http.StatusUnsupportedMediaType
+		response = commonDTO.NewBaseResponse("", fmt.Sprintf("content type must be %s", utils.ContentTypeMergePatchJSON), statusCode)
+		utils.WriteHttpHeader(w, ctx, statusCode)
+		pkg.Encode(response, w, lc)
+		return
+	}
+
+	patch, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		statusCode = http.StatusBadRequest
+		response = commonDTO.NewBaseResponse("", "failed to read the request body", statusCode)
+		utils.WriteHttpHeader(w, ctx, statusCode)
+		pkg.Encode(response, w, lc)
+		return
+	}
+
+	etag, edgeXerr := patchEntity(name, patch, r.Header.Get(ifMatchHeader), ctx, dic)
+	if edgeXerr != nil {
+		statusCode = edgeXerr.Code()
+		if goErrors.Is(edgeXerr, application.ErrETagMismatch) {
+			statusCode = http.StatusPreconditionFailed
+		} else if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			lc.Error(edgeXerr.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(edgeXerr.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", edgeXerr)
+	} else {
+		w.Header().Set(eTagHeader, etag)
+		statusCode = http.StatusOK
+		response = commonDTO.NewBaseResponse("", "", statusCode)
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// setETagHeader sets the ETag header to the entity tag of v, which is used with If-Match on merge patch requests
+func setETagHeader(w http.ResponseWriter, v interface{}) {
+	if etag, err := utils.ETag(v); err == nil {
+		w.Header().Set(eTagHeader, etag)
+	}
+}
@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+type ProfileCatalogController struct {
+	dic *di.Container
+}
+
+// NewProfileCatalogController creates and initializes an ProfileCatalogController
+func NewProfileCatalogController(dic *di.Container) *ProfileCatalogController {
+	return &ProfileCatalogController{
+		dic: dic,
+	}
+}
+
+// SyncProfileCatalog pulls the profile catalog on demand, keeping the newer device profiles pending approval
+func (pc *ProfileCatalogController) SyncProfileCatalog(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	result, err := application.SyncProfileCatalog(ctx, pc.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewProfileCatalogSyncResponse("", "", http.StatusOK, result.Updated, result.UpToDate, result.Failed)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// AllProfileCatalogUpdates returns the device profile updates pulled from the profile catalog, pending or rejected
+func (pc *ProfileCatalogController) AllProfileCatalogUpdates(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var response interface{}
+	var statusCode int
+
+	updates, err := application.AllProfileCatalogUpdates(pc.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		dtos := make([]metadataDTOs.ProfileCatalogUpdate, len(updates))
+		for i, u := range updates {
+			dtos[i] = metadataDTOs.FromProfileCatalogUpdateModelToDTO(u)
+		}
+		response = metadataDTOs.NewMultiProfileCatalogUpdatesResponse("", "", http.StatusOK, dtos)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ProfileCatalogUpdateByName returns the update of the device profile in the URL pulled from the profile catalog
+func (pc *ProfileCatalogController) ProfileCatalogUpdateByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	update, err := application.ProfileCatalogUpdateByName(name, pc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewProfileCatalogUpdateResponse("", "", http.StatusOK, metadataDTOs.FromProfileCatalogUpdateModelToDTO(update))
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ApproveProfileCatalogUpdate applies the update of the device profile in the URL pulled from the profile catalog
+func (pc *ProfileCatalogController) ApproveProfileCatalogUpdate(w http.ResponseWriter, r *http.Request) {
+	pc.reviewProfileCatalogUpdate(w, r, application.ApproveProfileCatalogUpdate)
+}
+
+// RejectProfileCatalogUpdate rejects the update of the device profile in the URL pulled from the profile catalog
+func (pc *ProfileCatalogController) RejectProfileCatalogUpdate(w http.ResponseWriter, r *http.Request) {
+	pc.reviewProfileCatalogUpdate(w, r, application.RejectProfileCatalogUpdate)
+}
+
+func (pc *ProfileCatalogController) reviewProfileCatalogUpdate(
+	w http.ResponseWriter,
+	r *http.Request,
+	review func(name string, ctx context.Context, dic *di.Container) errors.EdgeX) {
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	err := review(name, ctx, pc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func mockProfileCatalogDic(dbClientMock *dbMock.DBClient, catalogUrl string) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service:        bootstrapConfig.ServiceInfo{MaxResultCount: 30},
+				ProfileCatalog: config.ProfileCatalogInfo{Url: catalogUrl, Timeout: "5s"},
+			}
+		},
+	})
+	return dic
+}
+
+// profileCatalogServer serves an index listing the test device profile at version 1.10.0, and the profiles at version
+// 1.2.0 and 1.9.0
+func profileCatalogServer(profileYaml []byte) *httptest.Server {
+	index := `{"profiles": [
+		{"name": "` + TestDeviceProfileName + `", "version": "1.10.0", "path": "profiles/test.yaml"},
+		{"name": "Boiler", "version": "1.2.0", "path": "profiles/boiler.yaml"},
+		{"name": "Pump", "version": "1.9.0", "path": "profiles/pump.yaml"}
+	]}`
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/catalog/index.json":
+			_, _ = w.Write([]byte(index))
+		case "/catalog/profiles/test.yaml":
+			_, _ = w.Write(profileYaml)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestSyncProfileCatalog(t *testing.T) {
+	profileYaml, err := yaml.Marshal(buildTestDeviceProfileRequest().Profile)
+	require.NoError(t, err)
+	catalog := profileCatalogServer(profileYaml)
+	defer catalog.Close()
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProfileCatalogVersions").Return(map[string]string{TestDeviceProfileName: "1.9.0", "Pump": "1.9.0"}, nil)
+	dbClientMock.On("ProfileCatalogUpdateByName", TestDeviceProfileName).Return(pkgModels.ProfileCatalogUpdate{}, notFound)
+	dbClientMock.On("ProfileCatalogUpdateByName", "Boiler").Return(pkgModels.ProfileCatalogUpdate{}, notFound)
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(true, nil)
+	dbClientMock.On("SetProfileCatalogUpdate", mock.MatchedBy(func(u pkgModels.ProfileCatalogUpdate) bool {
+		return u.ProfileName == TestDeviceProfileName && u.Version == "1.10.0" && u.AppliedVersion == "1.9.0" &&
+			u.Action == pkgModels.ProfileCatalogActionUpdate && u.Status == pkgModels.ProfileCatalogUpdatePending &&
+			u.Format == "yaml" && string(u.Profile) == string(profileYaml)
+	})).Return(nil)
+	controller := NewProfileCatalogController(mockProfileCatalogDic(dbClientMock, catalog.URL+"/catalog/index.json"))
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v2/profilecatalog/sync", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.SyncProfileCatalog).ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+	var res metadataDTOs.ProfileCatalogSyncResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, []string{TestDeviceProfileName}, res.Updated, "the newer version is pending approval")
+	assert.Equal(t, 1, res.UpToDate, "Pump is up to date")
+	assert.Contains(t, res.Failed, "Boiler", "the profile of Boiler isn't served")
+	dbClientMock.AssertNumberOfCalls(t, "SetProfileCatalogUpdate", 1)
+}
+
+func TestSyncProfileCatalogNotConfigured(t *testing.T) {
+	controller := NewProfileCatalogController(mockProfileCatalogDic(&dbMock.DBClient{}, ""))
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v2/profilecatalog/sync", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.SyncProfileCatalog).ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Result().StatusCode)
+}
+
+func TestApproveProfileCatalogUpdate(t *testing.T) {
+	profile := buildTestDeviceProfileRequest().Profile
+	profile.Id = ""
+	profileYaml, err := yaml.Marshal(profile)
+	require.NoError(t, err)
+	update := pkgModels.ProfileCatalogUpdate{
+		ProfileName: TestDeviceProfileName,
+		Version:     "1.10.0",
+		Action:      pkgModels.ProfileCatalogActionAdd,
+		Status:      pkgModels.ProfileCatalogUpdatePending,
+		Format:      "yaml",
+		Profile:     profileYaml,
+	}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProfileCatalogUpdateByName", TestDeviceProfileName).Return(update, nil)
+	dbClientMock.On("ProfileCatalogUpdateByName", "Unknown").Return(pkgModels.ProfileCatalogUpdate{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeviceProfileNameExists", TestDeviceProfileName).Return(false, nil)
+	dbClientMock.On("AddDeviceProfile", dtos.ToDeviceProfileModel(profile)).Return(dtos.ToDeviceProfileModel(profile), nil)
+	dbClientMock.On("SetDeviceProfileYaml", TestDeviceProfileName, profileYaml).Return(nil)
+	dbClientMock.On("SetProfileCatalogVersion", TestDeviceProfileName, "1.10.0").Return(nil)
+	dbClientMock.On("DeleteProfileCatalogUpdateByName", TestDeviceProfileName).Return(nil)
+	controller := NewProfileCatalogController(mockProfileCatalogDic(dbClientMock, "http://catalog/index.json"))
+
+	for name, expectedStatusCode := range map[string]int{TestDeviceProfileName: http.StatusOK, "Unknown": http.StatusNotFound} {
+		req, err := http.NewRequest(http.MethodPost, "/api/v2/profilecatalog/update/name/"+name+"/approve", http.NoBody)
+		require.NoError(t, err)
+		req = mux.SetURLVars(req, map[string]string{v2.Name: name})
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(controller.ApproveProfileCatalogUpdate).ServeHTTP(recorder, req)
+
+		var res commonDTO.BaseResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+		assert.Equal(t, expectedStatusCode, recorder.Result().StatusCode, name)
+	}
+	dbClientMock.AssertCalled(t, "SetProfileCatalogVersion", TestDeviceProfileName, "1.10.0")
+}
+
+func TestRejectProfileCatalogUpdate(t *testing.T) {
+	update := pkgModels.ProfileCatalogUpdate{ProfileName: TestDeviceProfileName, Version: "1.10.0", Status: pkgModels.ProfileCatalogUpdatePending}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProfileCatalogUpdateByName", TestDeviceProfileName).Return(update, nil)
+	dbClientMock.On("SetProfileCatalogUpdate", mock.MatchedBy(func(u pkgModels.ProfileCatalogUpdate) bool {
+		return u.Version == "1.10.0" && u.Status == pkgModels.ProfileCatalogUpdateRejected
+	})).Return(nil)
+	controller := NewProfileCatalogController(mockProfileCatalogDic(dbClientMock, "http://catalog/index.json"))
+
+	req, err := http.NewRequest(http.MethodPost, "/api/v2/profilecatalog/update/name/"+TestDeviceProfileName+"/reject", http.NoBody)
+	require.NoError(t, err)
+	req = mux.SetURLVars(req, map[string]string{v2.Name: TestDeviceProfileName})
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.RejectProfileCatalogUpdate).ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	dbClientMock.AssertExpectations(t)
+}
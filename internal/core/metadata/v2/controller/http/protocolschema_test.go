@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testModbusSchema = `{
+	"type": "object",
+	"properties": {
+		"Address": {"type": "string"},
+		"Port": {"type": "integer", "minimum": 1, "maximum": 65535},
+		"UnitID": {"type": "integer"}
+	},
+	"required": ["Address", "Port"],
+	"additionalProperties": false
+}`
+
+func TestSetProtocolSchema(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SetProtocolSchema", "modbus-ip", []byte(testModbusSchema)).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewProtocolSchemaController(dic)
+
+	tests := []struct {
+		name               string
+		protocol           string
+		schema             string
+		expectedStatusCode int
+	}{
+		{"Valid", "modbus-ip", testModbusSchema, http.StatusOK},
+		{"Invalid - not JSON", "modbus-ip", `{"type":`, http.StatusBadRequest},
+		{"Invalid - unsupported keyword", "modbus-ip", `{"oneOf": []}`, http.StatusBadRequest},
+		{"Invalid - unsupported property type", "modbus-ip", `{"properties": {"Port": {"type": "array"}}}`, http.StatusBadRequest},
+		{"Invalid - bad pattern", "modbus-ip", `{"properties": {"Address": {"pattern": "("}}}`, http.StatusBadRequest},
+		{"Invalid - no name", "", testModbusSchema, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, v2.ApiBase+"/protocolschema/name/{name}", strings.NewReader(testCase.schema))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.protocol})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.SetProtocolSchema).ServeHTTP(recorder, req)
+
+			var res common.BaseResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
+
+func TestAddDeviceValidatesProtocolSchema(t *testing.T) {
+	testDevice := buildTestDeviceRequest()
+	deviceModel := requests.AddDeviceReqToDeviceModels([]requests.AddDeviceRequest{testDevice})[0]
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceServiceNameExists", deviceModel.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", deviceModel.ProfileName).Return(true, nil)
+	dbClientMock.On("ProtocolSchemaByName", "modbus-ip").Return([]byte(testModbusSchema), nil)
+	dbClientMock.On("AddDevice", deviceModel).Return(deviceModel, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	typo := testDevice
+	typo.Device.Protocols = map[string]dtos.ProtocolProperties{"modbus-ip": {"Address": "localhost", "Prot": "1502"}}
+	outOfRange := testDevice
+	outOfRange.Device.Protocols = map[string]dtos.ProtocolProperties{"modbus-ip": {"Address": "localhost", "Port": "70000"}}
+
+	tests := []struct {
+		name               string
+		request            requests.AddDeviceRequest
+		expectedStatusCode int
+		expectedMessages   []string
+	}{
+		{"Valid", testDevice, http.StatusCreated, nil},
+		{"Invalid - property name typo", typo, http.StatusBadRequest, []string{"required property 'Port' is missing", "property 'Prot' is not allowed"}},
+		{"Invalid - value out of range", outOfRange, http.StatusBadRequest, []string{"property 'Port' must be at most 65535"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal([]requests.AddDeviceRequest{testCase.request})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, v2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.AddDevice).ServeHTTP(recorder, req)
+
+			var res []common.BaseResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			for _, message := range testCase.expectedMessages {
+				assert.Contains(t, res[0].Message, message)
+			}
+		})
+	}
+}
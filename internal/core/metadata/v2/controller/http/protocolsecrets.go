@@ -0,0 +1,94 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gorilla/mux"
+)
+
+const bearerPrefix = "Bearer "
+
+// DeviceProtocolSecretsByName returns the protocols of the device named in the URL with the secret protocol properties
+// resolved from the secret store, to the callers holding the resolver role in their JWT
+func (dc *DeviceController) DeviceProtocolSecretsByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	var response interface{}
+	var statusCode int
+
+	protocols, err := application.DeviceProtocolSecretsByName(name, rolesFromRequest(r, config.ProtocolSecrets.RoleClaim), dc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		errorResponse := ErrorCodes.NewErrorResponse("", err)
+		response = errorResponse
+		statusCode = errorResponse.StatusCode
+	} else {
+		protocolDTOs := make(map[string]dtos.ProtocolProperties, len(protocols))
+		for protocol, properties := range protocols {
+			protocolDTOs[protocol] = dtos.ProtocolProperties(properties)
+		}
+		response = metadataDTOs.NewDeviceProtocolsResponse("", "", http.StatusOK, name, protocolDTOs)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// rolesFromRequest returns the roles held in the claim of the request's bearer token, a single role or an array of
+// roles. The token's signature is not verified here since the API gateway has already validated it.
+func rolesFromRequest(r *http.Request, claim string) []string {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return nil
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(strings.TrimPrefix(header, bearerPrefix), claims); err != nil {
+		return nil
+	}
+
+	switch value := claims[claim].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var roles []string
+		for _, v := range value {
+			if role, ok := v.(string); ok {
+				roles = append(roles, role)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
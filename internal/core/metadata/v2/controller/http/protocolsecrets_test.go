@@ -0,0 +1,143 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	secretMock "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretPath = "devices/" + TestDeviceName + "/snmp/Community"
+
+func mockProtocolSecretsDic(dbClientMock *dbMock.DBClient, secretProviderMock *secretMock.SecretProvider) *di.Container {
+	dic := mockDic()
+	configuration := metadataContainer.ConfigurationFrom(dic.Get)
+	configuration.ProtocolSecrets.Enabled = true
+	configuration.ProtocolSecrets.Properties = []string{"community"}
+	configuration.ProtocolSecrets.RoleClaim = "roles"
+	configuration.ProtocolSecrets.ResolverRole = "device-service"
+	dic.Update(di.ServiceConstructorMap{
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.SecretProviderName: func(get di.Get) interface{} {
+			return secretProviderMock
+		},
+	})
+	return dic
+}
+
+func TestAddDeviceStoresProtocolSecrets(t *testing.T) {
+	testDevice := buildTestDeviceRequest()
+	testDevice.Device.Protocols = map[string]dtos.ProtocolProperties{
+		"snmp": {"Address": "10.0.0.7", "Community": "private"},
+	}
+	deviceModel := requests.AddDeviceReqToDeviceModels([]requests.AddDeviceRequest{testDevice})[0]
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ProtocolSchemaByName", mock.Anything).Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "protocol schema not found", nil))
+	dbClientMock.On("DeviceServiceNameExists", deviceModel.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", deviceModel.ProfileName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", deviceModel.Name).Return(false, nil)
+	dbClientMock.On("AddDevice", mock.MatchedBy(func(d models.Device) bool {
+		snmp := d.Protocols["snmp"]
+		return snmp["Community"] == "secret:"+testSecretPath && snmp["Address"] == "10.0.0.7"
+	})).Return(deviceModel, nil)
+	secretProviderMock := &secretMock.SecretProvider{}
+	secretProviderMock.On("StoreSecrets", testSecretPath, map[string]string{"value": "private"}).Return(nil)
+
+	controller := NewDeviceController(mockProtocolSecretsDic(dbClientMock, secretProviderMock))
+	jsonData, err := json.Marshal([]requests.AddDeviceRequest{testDevice})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, v2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.AddDevice).ServeHTTP(recorder, req)
+
+	var res []common.BaseResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode, "BaseResponse status code not as expected")
+	secretProviderMock.AssertExpectations(t)
+	dbClientMock.AssertCalled(t, "AddDevice", mock.Anything)
+}
+
+func TestDeviceProtocolSecretsByName(t *testing.T) {
+	device := models.Device{
+		Name: TestDeviceName,
+		Protocols: map[string]models.ProtocolProperties{
+			"snmp": {"Address": "10.0.0.7", "Community": "secret:" + testSecretPath},
+		},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", TestDeviceName).Return(device, nil)
+	secretProviderMock := &secretMock.SecretProvider{}
+	secretProviderMock.On("GetSecrets", testSecretPath, "value").Return(map[string]string{"value": "private"}, nil)
+	controller := NewDeviceController(mockProtocolSecretsDic(dbClientMock, secretProviderMock))
+
+	bearer := func(roles ...string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"roles": roles}).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		return bearerPrefix + token
+	}
+	tests := []struct {
+		name               string
+		authorization      string
+		expectedStatusCode int
+	}{
+		{"Valid - resolver role", bearer("admin", "device-service"), http.StatusOK},
+		{"Forbidden - other role", bearer("admin"), http.StatusForbidden},
+		{"Forbidden - no token", "", http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/v2/device/name/{name}/protocols/secrets", http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: TestDeviceName})
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.DeviceProtocolSecretsByName).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DeviceProtocolsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, dtos.ProtocolProperties{"Address": "10.0.0.7", "Community": "private"}, res.Protocols["snmp"])
+			}
+		})
+	}
+}
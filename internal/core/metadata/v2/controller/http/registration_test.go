@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	pkgContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/interfaces"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegistrationToken = "registration-token"
+
+// fakeRegistrationStore keeps the registration tokens and the device service credentials in memory, the other
+// methods of the database client aren't used by the registration checks
+type fakeRegistrationStore struct {
+	interfaces.DBClient
+	tokens      map[string]metadataModels.RegistrationToken
+	credentials map[string]string
+}
+
+func (f *fakeRegistrationStore) GetRegistrationToken(hash string) (metadataModels.RegistrationToken, error) {
+	token, ok := f.tokens[hash]
+	if !ok {
+		return token, db.ErrNotFound
+	}
+	return token, nil
+}
+
+func (f *fakeRegistrationStore) RedeemRegistrationToken(hash string, serviceId string) error {
+	if _, ok := f.tokens[hash]; !ok {
+		return db.ErrNotFound
+	}
+	delete(f.tokens, hash)
+	f.credentials[serviceId] = hash
+	return nil
+}
+
+func (f *fakeRegistrationStore) GetDeviceServiceCredential(serviceId string) (string, error) {
+	credential, ok := f.credentials[serviceId]
+	if !ok {
+		return "", db.ErrNotFound
+	}
+	return credential, nil
+}
+
+func mockRegistrationDic(dbClientMock *dbMock.DBClient, store *fakeRegistrationStore) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		pkgContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return store
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service:             bootstrapConfig.ServiceInfo{MaxResultCount: 30},
+				ServiceRegistration: config.ServiceRegistrationInfo{RequireToken: true, TokenTTL: "1h"},
+			}
+		},
+	})
+	return dic
+}
+
+// serveWithRegistrationToken serves the request through the registration token middleware, presenting the token
+// unless it is empty
+func serveWithRegistrationToken(handler http.HandlerFunc, req *http.Request, token string) *httptest.ResponseRecorder {
+	if token != "" {
+		req.Header.Set(application.RegistrationTokenHeader, token)
+	}
+	recorder := httptest.NewRecorder()
+	application.RegistrationTokenMiddleware(handler).ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestAddDeviceServiceRequiresRegistrationToken(t *testing.T) {
+	addReq := buildTestDeviceServiceRequest()
+	ds := requests.AddDeviceServiceReqToDeviceServiceModels([]requests.AddDeviceServiceRequest{addReq})[0]
+	added := ds
+	added.Id = ExampleUUID
+	hash := application.RegistrationTokenHash(testRegistrationToken)
+
+	tests := []struct {
+		name               string
+		token              string
+		tokenService       string
+		expectedStatusCode int
+	}{
+		{"Valid - token of the device service", testRegistrationToken, ds.Name, http.StatusCreated},
+		{"Invalid - no token", "", ds.Name, http.StatusUnauthorized},
+		{"Invalid - unknown token", "other-token", ds.Name, http.StatusUnauthorized},
+		{"Invalid - token of another device service", testRegistrationToken, "otherService", http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("AddDeviceService", ds).Return(added, nil)
+			store := &fakeRegistrationStore{
+				tokens:      map[string]metadataModels.RegistrationToken{hash: {ServiceName: testCase.tokenService, Expires: db.MakeTimestamp() + 60000}},
+				credentials: map[string]string{},
+			}
+			controller := NewDeviceServiceController(mockRegistrationDic(dbClientMock, store))
+
+			jsonData, err := json.Marshal([]requests.AddDeviceServiceRequest{addReq})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, contractsV2.ApiDeviceServiceRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			recorder := serveWithRegistrationToken(controller.AddDeviceService, req, testCase.token)
+
+			var res []common.BaseWithIdResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, hash, store.credentials[added.Id], "Token not redeemed as the credential of the device service")
+				assert.Empty(t, store.tokens, "Token not redeemed")
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddDeviceService", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestAddDeviceRequiresRegistrationToken(t *testing.T) {
+	addReq := buildTestDeviceRequest()
+	ds := models.DeviceService{Id: ExampleUUID, Name: addReq.Device.ServiceName}
+
+	tests := []struct {
+		name               string
+		token              string
+		expectedStatusCode int
+	}{
+		{"Invalid - no token", "", http.StatusUnauthorized},
+		{"Invalid - token the device service wasn't registered with", "other-token", http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceServiceNameExists", ds.Name).Return(true, nil)
+			dbClientMock.On("DeviceServiceByName", ds.Name).Return(ds, nil)
+			store := &fakeRegistrationStore{
+				tokens:      map[string]metadataModels.RegistrationToken{},
+				credentials: map[string]string{ds.Id: application.RegistrationTokenHash(testRegistrationToken)},
+			}
+			controller := NewDeviceController(mockRegistrationDic(dbClientMock, store))
+
+			jsonData, err := json.Marshal([]requests.AddDeviceRequest{addReq})
+			require.NoError(t, err)
+			req, err := http.NewRequest(http.MethodPost, contractsV2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			recorder := serveWithRegistrationToken(controller.AddDevice, req, testCase.token)
+
+			var res []common.BaseWithIdResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+		})
+	}
+}
+
+func TestPatchDeviceRequiresRegistrationToken(t *testing.T) {
+	updateReq := buildTestUpdateDeviceRequest()
+	device := models.Device{Id: *updateReq.Device.Id, Name: *updateReq.Device.Name, ServiceName: *updateReq.Device.ServiceName}
+	ds := models.DeviceService{Id: ExampleUUID, Name: device.ServiceName}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceById", device.Id).Return(device, nil)
+	dbClientMock.On("DeviceServiceByName", ds.Name).Return(ds, nil)
+	store := &fakeRegistrationStore{
+		tokens:      map[string]metadataModels.RegistrationToken{},
+		credentials: map[string]string{ds.Id: application.RegistrationTokenHash(testRegistrationToken)},
+	}
+	controller := NewDeviceController(mockRegistrationDic(dbClientMock, store))
+
+	jsonData, err := json.Marshal([]requests.UpdateDeviceRequest{updateReq})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPatch, contractsV2.ApiDeviceRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	recorder := serveWithRegistrationToken(controller.PatchDevice, req, "")
+
+	var res []common.BaseResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	require.Len(t, res, 1)
+	assert.Equal(t, http.StatusUnauthorized, res[0].StatusCode, "BaseResponse status code not as expected")
+	dbClientMock.AssertNotCalled(t, "DeleteDeviceById", mock.Anything)
+	dbClientMock.AssertNotCalled(t, "AddDevice", mock.Anything)
+}
+
+func TestRegistrationTokenErrorCodes(t *testing.T) {
+	code, statusCode := ErrorCodes.Code(errors.NewCommonEdgeX(errors.KindContractInvalid, "missing token", application.ErrRegistrationTokenInvalid))
+	assert.Equal(t, "EDGEX-MD-2007", code)
+	assert.Equal(t, http.StatusUnauthorized, statusCode)
+	code, statusCode = ErrorCodes.Code(errors.NewCommonEdgeX(errors.KindContractInvalid, "other service", application.ErrRegistrationTokenForbidden))
+	assert.Equal(t, "EDGEX-MD-2008", code)
+	assert.Equal(t, http.StatusForbidden, statusCode)
+}
@@ -0,0 +1,262 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// ProtocolVar is the route variable holding the protocol the deviceResources are mapped to, i.e. opcua or modbus
+	ProtocolVar = "protocol"
+	// AttributeQuery and ValueQuery are the query parameters selecting the mappings whose attribute holds the value
+	AttributeQuery = "attribute"
+	ValueQuery     = "value"
+)
+
+type ResourceMappingController struct {
+	dic *di.Container
+}
+
+// NewResourceMappingController creates and initializes an ResourceMappingController
+func NewResourceMappingController(dic *di.Container) *ResourceMappingController {
+	return &ResourceMappingController{
+		dic: dic,
+	}
+}
+
+func resourceMappingDTOs(mappings []pkgModels.ResourceMapping) []metadataDTOs.ResourceMapping {
+	dtos := make([]metadataDTOs.ResourceMapping, len(mappings))
+	for i, m := range mappings {
+		dtos[i] = metadataDTOs.FromResourceMappingModelToDTO(m)
+	}
+	return dtos
+}
+
+// SetResourceMapping maps the deviceResource of the profile in the URL to the protocol in the URL through the
+// attributes in the request body
+func (rc *ResourceMappingController) SetResourceMapping(w http.ResponseWriter, r *http.Request) {
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	profileName := vars[v2.Name]
+	resourceName := vars[v2.ResourceName]
+	protocol := vars[ProtocolVar]
+
+	var response interface{}
+	var statusCode int
+
+	var request metadataDTOs.SetResourceMappingRequest
+	var err errors.EdgeX
+	if decodeErr := json.NewDecoder(r.Body).Decode(&request); decodeErr != nil {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, "resource mapping decoding failed", decodeErr)
+	} else {
+		err = application.SetResourceMapping(profileName, resourceName, protocol, request.Attributes, rc.dic)
+	}
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ResourceMapping returns the mapping of the deviceResource of the profile in the URL to the protocol in the URL
+func (rc *ResourceMappingController) ResourceMapping(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	profileName := vars[v2.Name]
+	resourceName := vars[v2.ResourceName]
+	protocol := vars[ProtocolVar]
+
+	var response interface{}
+	var statusCode int
+
+	mapping, err := application.ResourceMapping(profileName, resourceName, protocol, rc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewResourceMappingResponse("", "", http.StatusOK, metadataDTOs.FromResourceMappingModelToDTO(mapping))
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ResourceMappingsByProfileName returns the mappings of the deviceResources of the profile in the URL, to the protocol
+// of the query string when given
+func (rc *ResourceMappingController) ResourceMappingsByProfileName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	profileName := vars[v2.Name]
+	protocol := r.URL.Query().Get(ProtocolVar)
+
+	var response interface{}
+	var statusCode int
+
+	mappings, err := application.ResourceMappingsByProfileName(profileName, protocol, rc.dic)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewMultiResourceMappingsResponse("", "", http.StatusOK, resourceMappingDTOs(mappings))
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ResourceMappingsByProtocol returns the mappings of the deviceResources of all profiles to the protocol in the URL
+// with offset and limit, only those whose attribute of the query string holds the value of the query string when
+// given
+func (rc *ResourceMappingController) ResourceMappingsByProtocol(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(rc.dic.Get)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	protocol := vars[ProtocolVar]
+	attribute := r.URL.Query().Get(AttributeQuery)
+	value := r.URL.Query().Get(ValueQuery)
+
+	var response interface{}
+	var statusCode int
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		mappings, err := application.ResourceMappingsByProtocol(protocol, attribute, value, offset, limit, rc.dic)
+		if err != nil {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			response = metadataDTOs.NewMultiResourceMappingsResponse("", "", http.StatusOK, resourceMappingDTOs(mappings))
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// DeleteResourceMapping deletes the mapping of the deviceResource of the profile in the URL to the protocol in the URL
+func (rc *ResourceMappingController) DeleteResourceMapping(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	profileName := vars[v2.Name]
+	resourceName := vars[v2.ResourceName]
+	protocol := vars[ProtocolVar]
+
+	var response interface{}
+	var statusCode int
+
+	err := application.DeleteResourceMapping(profileName, resourceName, protocol, rc.dic)
+	if err != nil {
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		}
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = commonDTO.NewBaseResponse("", "", http.StatusOK)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
+
+// ResourceMappingSchema returns the schema the mapping attributes of the protocol in the URL are validated against
+func (rc *ResourceMappingController) ResourceMappingSchema(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	// URL parameters
+	vars := mux.Vars(r)
+	protocol := vars[ProtocolVar]
+
+	var response interface{}
+	var statusCode int
+
+	schema, err := application.ResourceMappingSchema(protocol)
+	if err != nil {
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		response = metadataDTOs.NewResourceMappingSchemaResponse("", "", http.StatusOK, protocol, schema)
+		statusCode = http.StatusOK
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
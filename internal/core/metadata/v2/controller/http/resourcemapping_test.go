@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetResourceMapping(t *testing.T) {
+	profile := models.DeviceProfile{Name: "Boiler", DeviceResources: []models.DeviceResource{{Name: "Temperature"}}}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceProfileByName", "Boiler").Return(profile, nil)
+	dbClientMock.On("DeviceProfileByName", "Unknown").Return(models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("SetResourceMapping", mock.MatchedBy(func(m pkgModels.ResourceMapping) bool {
+		return m.ProfileName == "Boiler" && m.ResourceName == "Temperature" && m.Modified > 0
+	})).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewResourceMappingController(dic)
+
+	tests := []struct {
+		name               string
+		profile            string
+		resource           string
+		protocol           string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid - OPC UA", "Boiler", "Temperature", "opcua", `{"attributes": {"nodeId": "ns=2;s=Boiler.Temperature"}}`, http.StatusOK},
+		{"Valid - OPC UA namespace URI", "Boiler", "Temperature", "OPCUA", `{"attributes": {"nodeId": "nsu=http://boiler;i=1001"}}`, http.StatusOK},
+		{"Valid - Modbus", "Boiler", "Temperature", "modbus", `{"attributes": {"primaryTable": "HOLDING_REGISTERS", "startingAddress": "40", "rawType": "FLOAT32", "isWordSwap": "true"}}`, http.StatusOK},
+		{"Invalid - OPC UA node id", "Boiler", "Temperature", "opcua", `{"attributes": {"nodeId": "Boiler.Temperature"}}`, http.StatusBadRequest},
+		{"Invalid - Modbus table", "Boiler", "Temperature", "modbus", `{"attributes": {"primaryTable": "REGISTERS", "startingAddress": "40"}}`, http.StatusBadRequest},
+		{"Invalid - Modbus address", "Boiler", "Temperature", "modbus", `{"attributes": {"primaryTable": "COILS", "startingAddress": "70000"}}`, http.StatusBadRequest},
+		{"Invalid - missing attribute", "Boiler", "Temperature", "modbus", `{"attributes": {"primaryTable": "COILS"}}`, http.StatusBadRequest},
+		{"Invalid - unknown attribute", "Boiler", "Temperature", "opcua", `{"attributes": {"nodeId": "i=1001", "node": "i=1001"}}`, http.StatusBadRequest},
+		{"Invalid - unknown protocol", "Boiler", "Temperature", "bacnet", `{"attributes": {"objectId": "1"}}`, http.StatusBadRequest},
+		{"Invalid - not JSON", "Boiler", "Temperature", "opcua", `{"attributes":`, http.StatusBadRequest},
+		{"Not found - profile", "Unknown", "Temperature", "opcua", `{"attributes": {"nodeId": "i=1001"}}`, http.StatusNotFound},
+		{"Not found - resource", "Boiler", "Pressure", "opcua", `{"attributes": {"nodeId": "i=1001"}}`, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, v2.ApiBase+"/resourcemapping", strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.profile, v2.ResourceName: testCase.resource, ProtocolVar: testCase.protocol})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.SetResourceMapping).ServeHTTP(recorder, req)
+
+			var res common.BaseResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
+
+func TestResourceMappingsByProtocol(t *testing.T) {
+	mappings := []pkgModels.ResourceMapping{
+		{ProfileName: "Boiler", ResourceName: "Pressure", Protocol: "opcua", Attributes: map[string]string{"nodeId": "ns=2;s=Boiler.Pressure"}},
+		{ProfileName: "Boiler", ResourceName: "Temperature", Protocol: "opcua", Attributes: map[string]string{"nodeId": "ns=2;s=Boiler.Temperature"}},
+		{ProfileName: "Pump", ResourceName: "Temperature", Protocol: "opcua", Attributes: map[string]string{"nodeId": "ns=3;s=Pump.Temperature"}},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ResourceMappingsByProtocol", "opcua").Return(mappings, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewResourceMappingController(dic)
+
+	tests := []struct {
+		name              string
+		query             string
+		expectedResources []string
+	}{
+		{"All", "", []string{"Boiler/Pressure", "Boiler/Temperature", "Pump/Temperature"}},
+		{"Offset and limit", "?offset=1&limit=1", []string{"Boiler/Temperature"}},
+		{"By attribute", "?attribute=nodeId&value=ns%3D3%3Bs%3DPump.Temperature", []string{"Pump/Temperature"}},
+		{"By attribute without match", "?attribute=nodeId&value=i%3D1", []string{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiBase+"/resourcemapping/protocol/OPCUA"+testCase.query, nil)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{ProtocolVar: "OPCUA"})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.ResourceMappingsByProtocol).ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+			var res metadataDTOs.MultiResourceMappingsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			resources := []string{}
+			for _, m := range res.ResourceMappings {
+				resources = append(resources, m.ProfileName+"/"+m.ResourceName)
+			}
+			assert.Equal(t, testCase.expectedResources, resources)
+		})
+	}
+}
+
+func TestResourceMappingSchema(t *testing.T) {
+	controller := NewResourceMappingController(mockDic())
+
+	for protocol, expectedStatusCode := range map[string]int{"opcua": http.StatusOK, "Modbus": http.StatusOK, "bacnet": http.StatusNotFound} {
+		req, err := http.NewRequest(http.MethodGet, v2.ApiBase+"/resourcemapping/schema/protocol/"+protocol, nil)
+		require.NoError(t, err)
+		req = mux.SetURLVars(req, map[string]string{ProtocolVar: protocol})
+
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(controller.ResourceMappingSchema).ServeHTTP(recorder, req)
+		assert.Equal(t, expectedStatusCode, recorder.Result().StatusCode, protocol)
+	}
+}
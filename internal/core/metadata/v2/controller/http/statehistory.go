@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+)
+
+// DeviceStateHistoryByName returns the state transitions of the device named in the URL, the latest first. The
+// optional start and end query strings, in milliseconds, bound the time range of the transitions, which defaults to the
+// whole history.
+func (dc *DeviceController) DeviceStateHistoryByName(w http.ResponseWriter, r *http.Request) {
+	lc := container.LoggingClientFrom(dc.dic.Get)
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+	config := metadataContainer.ConfigurationFrom(dc.dic.Get)
+
+	var response interface{}
+	var statusCode int
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name := vars[v2.Name]
+
+	// parse URL query string for start, end, offset and limit
+	var start, end, offset, limit int
+	start, err := utils.ParseQueryStringToInt(r, v2.Start, 0, 0, math.MaxInt64)
+	if err == nil {
+		end, err = utils.ParseQueryStringToInt(r, v2.End, int(time.Now().UnixNano()/int64(time.Millisecond)), 0, math.MaxInt64)
+	}
+	if err == nil {
+		offset, limit, _, err = utils.ParseGetAllObjectsRequestQueryString(r, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	}
+	if err != nil {
+		lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+		lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+		response = ErrorCodes.NewErrorResponse("", err)
+		statusCode = err.Code()
+	} else {
+		transitions, err := application.DeviceStateHistory(name, int64(start), int64(end), offset, limit, dc.dic)
+		if err != nil {
+			if errors.Kind(err) != errors.KindEntityDoesNotExist {
+				lc.Error(err.Error(), clients.CorrelationHeader, correlationId)
+			}
+			lc.Debug(err.DebugMessages(), clients.CorrelationHeader, correlationId)
+			response = ErrorCodes.NewErrorResponse("", err)
+			statusCode = err.Code()
+		} else {
+			dtos := make([]metadataDTOs.DeviceStateTransition, len(transitions))
+			for i, t := range transitions {
+				dtos[i] = metadataDTOs.FromDeviceStateTransitionModelToDTO(t)
+			}
+			response = metadataDTOs.NewDeviceStateHistoryResponse("", "", http.StatusOK, name, dtos)
+			statusCode = http.StatusOK
+		}
+	}
+
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	pkg.Encode(response, w, lc)
+}
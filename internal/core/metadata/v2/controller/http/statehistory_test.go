@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceStateHistoryByName(t *testing.T) {
+	transitions := []pkgModels.DeviceStateTransition{
+		{DeviceName: TestDeviceName, State: pkgModels.DeviceStateOperating, From: "UP", To: "DOWN", Reason: "maintenance", Timestamp: 2000},
+		{DeviceName: TestDeviceName, State: pkgModels.DeviceStateAdmin, From: "UNLOCKED", To: "LOCKED", Timestamp: 1000},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceStateHistory", TestDeviceName, int64(1000), int64(3000), 0, 20).Return(transitions, nil)
+	dbClientMock.On("DeviceStateHistory", TestDeviceName, int64(0), mock.Anything, 0, 20).Return(transitions[1:], nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service: bootstrapConfig.ServiceInfo{MaxResultCount: 30},
+			}
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		start              string
+		end                string
+		expectedStatusCode int
+		expectedCount      int
+	}{
+		{"Valid - time range", "1000", "3000", http.StatusOK, 2},
+		{"Valid - default time range", "", "", http.StatusOK, 1},
+		{"Invalid - end before start", "3000", "1000", http.StatusBadRequest, 0},
+		{"Invalid - start", "yesterday", "", http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiDeviceByNameRoute+"/statehistory", http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.start != "" {
+				query.Add(v2.Start, testCase.start)
+			}
+			if testCase.end != "" {
+				query.Add(v2.End, testCase.end)
+			}
+			req.URL.RawQuery = query.Encode()
+			req = mux.SetURLVars(req, map[string]string{v2.Name: TestDeviceName})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.DeviceStateHistoryByName).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DeviceStateHistoryResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Len(t, res.Transitions, testCase.expectedCount)
+			if testCase.expectedCount > 0 {
+				assert.Equal(t, metadataDTOs.FromDeviceStateTransitionModelToDTO(transitions[len(transitions)-testCase.expectedCount]), res.Transitions[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func mockTrashDic(dbClientMock *dbMock.DBClient) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		metadataContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				Service: bootstrapConfig.ServiceInfo{MaxResultCount: 30},
+				Trash:   config.TrashInfo{Enabled: true, RetentionDays: 30, PurgeInterval: "1h"},
+			}
+		},
+	})
+	return dic
+}
+
+func TestDeleteDeviceByNameMovesToTrash(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("TrashDevice", device, mock.AnythingOfType("int64")).Return(nil)
+	controller := NewDeviceController(mockTrashDic(dbClientMock))
+
+	req, err := http.NewRequest(http.MethodDelete, v2.ApiDeviceByNameRoute, http.NoBody)
+	require.NoError(t, err)
+	req = mux.SetURLVars(req, map[string]string{v2.Name: device.Name})
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.DeleteDeviceByName).ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	dbClientMock.AssertCalled(t, "TrashDevice", device, mock.AnythingOfType("int64"))
+	dbClientMock.AssertNotCalled(t, "DeleteDeviceByName", mock.Anything)
+}
+
+func TestDeleteDevicesMovesToTrash(t *testing.T) {
+	device1 := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	device1.Id = "2c1e3bd2-6a3a-4e33-8b9f-2dfa44e7a8b1"
+	device2 := device1
+	device2.Id = "1f9e1c4b-0c5a-4b7e-9a7f-3b2a6e8d9c10"
+	device2.Name = "testDevice2"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByServiceName", 0, -1, device1.ServiceName).Return([]models.Device{device1, device2}, nil)
+	dbClientMock.On("TrashDevice", device1, mock.AnythingOfType("int64")).Return(nil)
+	dbClientMock.On("TrashDevice", device2, mock.AnythingOfType("int64")).Return(nil)
+	controller := NewDeviceController(mockTrashDic(dbClientMock))
+
+	deleteDevices := func(query string) metadataDTOs.DeleteDevicesResponse {
+		req, err := http.NewRequest(http.MethodDelete, v2.ApiDeviceRoute+"?"+query, http.NoBody)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		http.HandlerFunc(controller.DeleteDevices).ServeHTTP(recorder, req)
+		var res metadataDTOs.DeleteDevicesResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+		return res
+	}
+	query := v2.ServiceName + "=" + device1.ServiceName
+	res := deleteDevices(query)
+	require.NotEmpty(t, res.ConfirmToken)
+	res = deleteDevices(query + "&" + deleteDevicesConfirm + "=" + res.ConfirmToken)
+
+	assert.Equal(t, http.StatusOK, int(res.StatusCode))
+	assert.Equal(t, 2, res.Count)
+	dbClientMock.AssertCalled(t, "TrashDevice", device1, mock.AnythingOfType("int64"))
+	dbClientMock.AssertCalled(t, "TrashDevice", device2, mock.AnythingOfType("int64"))
+	dbClientMock.AssertNotCalled(t, "DeleteDevicesByServiceName", mock.Anything, mock.Anything)
+}
+
+func TestAllTrashedObjects(t *testing.T) {
+	trashed := []pkgModels.TrashedObject{
+		{Type: pkgModels.TrashTypeDevice, Id: ExampleUUID, Name: TestDeviceName, Deleted: 1604000000000, Object: []byte(`{"name":"TestDevice"}`)},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TrashedObjects", pkgModels.TrashTypeDevice, 0, 20).Return(trashed, nil)
+	controller := NewTrashController(mockTrashDic(dbClientMock))
+
+	tests := []struct {
+		name               string
+		objectType         string
+		expectedStatusCode int
+	}{
+		{"Valid", pkgModels.TrashTypeDevice, http.StatusOK},
+		{"Invalid - unknown type", "deviceservice", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, v2.ApiBase+"/trash/{type}/all", http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{TrashTypeVar: testCase.objectType})
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.AllTrashedObjects).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.MultiTrashedObjectsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			require.Len(t, res.TrashedObjects, 1)
+			assert.Equal(t, TestDeviceName, res.TrashedObjects[0].Name)
+			assert.JSONEq(t, `{"name":"TestDevice"}`, string(res.TrashedObjects[0].Object))
+		})
+	}
+}
+
+func TestRestoreTrashedObjectByName(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	object, err := json.Marshal(device)
+	require.NoError(t, err)
+	trashedDevice := pkgModels.TrashedObject{Type: pkgModels.TrashTypeDevice, Id: device.Id, Name: device.Name, Deleted: 1, Object: object}
+	orphan := device
+	orphan.Name = "orphan"
+	orphan.ProfileName = "deleted-profile"
+	orphanObject, err := json.Marshal(orphan)
+	require.NoError(t, err)
+	taken := device
+	taken.Name = "taken"
+	takenObject, err := json.Marshal(taken)
+	require.NoError(t, err)
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not in the trash", nil)
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TrashedObjectByName", pkgModels.TrashTypeDevice, device.Name).Return(trashedDevice, nil)
+	dbClientMock.On("TrashedObjectByName", pkgModels.TrashTypeDevice, orphan.Name).Return(pkgModels.TrashedObject{Object: orphanObject}, nil)
+	dbClientMock.On("TrashedObjectByName", pkgModels.TrashTypeDevice, taken.Name).Return(pkgModels.TrashedObject{Object: takenObject}, nil)
+	dbClientMock.On("TrashedObjectByName", pkgModels.TrashTypeDevice, "unknown").Return(pkgModels.TrashedObject{}, notFound)
+	dbClientMock.On("DeviceServiceNameExists", device.ServiceName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", device.ProfileName).Return(true, nil)
+	dbClientMock.On("DeviceProfileNameExists", orphan.ProfileName).Return(false, nil)
+	dbClientMock.On("AddDevice", device).Return(device, nil)
+	dbClientMock.On("AddDevice", taken).Return(taken, errors.NewCommonEdgeX(errors.KindDuplicateName, "device name taken already exists", nil))
+	dbClientMock.On("DeleteTrashedObjectByName", pkgModels.TrashTypeDevice, device.Name).Return(nil)
+	controller := NewTrashController(mockTrashDic(dbClientMock))
+
+	tests := []struct {
+		name               string
+		objectName         string
+		expectedStatusCode int
+	}{
+		{"Valid", device.Name, http.StatusCreated},
+		{"Invalid - device profile deleted", orphan.Name, http.StatusNotFound},
+		{"Invalid - name taken", taken.Name, http.StatusConflict},
+		{"Invalid - not in the trash", "unknown", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPost, v2.ApiBase+"/trash/{type}/name/{name}/restore", http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{TrashTypeVar: pkgModels.TrashTypeDevice, v2.Name: testCase.objectName})
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.RestoreTrashedObjectByName).ServeHTTP(recorder, req)
+
+			var res commonDTO.BaseWithIdResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, device.Id, res.Id)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteTrashedObjectByName", 1)
+}
@@ -0,0 +1,154 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	v2MetadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/bootstrap/container"
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetDeviceTwinDesired(t *testing.T) {
+	profile := models.DeviceProfile{
+		Name: TestDeviceProfileName,
+		DeviceResources: []models.DeviceResource{
+			{Name: "SetPoint", Properties: models.PropertyValue{ReadWrite: "RW"}},
+			{Name: "Temperature", Properties: models.PropertyValue{ReadWrite: "R"}},
+		},
+	}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceByName", TestDeviceName).Return(models.Device{Name: TestDeviceName, ProfileName: TestDeviceProfileName}, nil)
+	dbClientMock.On("DeviceByName", "unknown").Return(models.Device{}, notFound)
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(profile, nil)
+	dbClientMock.On("UpdateDeviceTwinDesired", TestDeviceName, map[string]string{"SetPoint": "21"}, mock.Anything).
+		Return(twin.Twin{DeviceName: TestDeviceName, Desired: map[string]string{"SetPoint": "21"}, Version: 1,
+			Status: twin.Status{State: twin.StatePending, Version: 1}}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, `{"desired":{"SetPoint":"21"}}`, http.StatusOK},
+		{"Invalid - unknown device", "unknown", `{"desired":{"SetPoint":"21"}}`, http.StatusNotFound},
+		{"Invalid - empty desired state", TestDeviceName, `{"desired":{}}`, http.StatusBadRequest},
+		{"Invalid - unknown deviceResource", TestDeviceName, `{"desired":{"Pressure":"1"}}`, http.StatusBadRequest},
+		{"Invalid - read only deviceResource", TestDeviceName, `{"desired":{"Temperature":"20"}}`, http.StatusBadRequest},
+		{"Invalid - malformed body", TestDeviceName, `{"desired":`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, twin.ApiDeviceTwinDesiredByNameRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.SetDeviceTwinDesired).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DeviceTwinResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, twin.StatePending, res.Twin.Status.State)
+				assert.Equal(t, int64(1), res.Twin.Version)
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "UpdateDeviceTwinDesired", 1)
+}
+
+func TestReportDeviceTwin(t *testing.T) {
+	report := twin.Report{Version: 1, Reported: map[string]string{"SetPoint": "21"}, State: twin.StateInSync}
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReportDeviceTwin", TestDeviceName, report, mock.Anything).
+		Return(twin.Twin{DeviceName: TestDeviceName, Version: 1, Reported: report.Reported, Status: twin.Status{State: twin.StateInSync, Version: 1}}, nil)
+	dbClientMock.On("ReportDeviceTwin", "unknown", report, mock.Anything).
+		Return(twin.Twin{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device unknown has no twin", nil))
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		body               string
+		expectedStatusCode int
+	}{
+		{"Valid", TestDeviceName, `{"version":1,"reported":{"SetPoint":"21"},"state":"InSync"}`, http.StatusOK},
+		{"Invalid - no twin", "unknown", `{"version":1,"reported":{"SetPoint":"21"},"state":"InSync"}`, http.StatusNotFound},
+		{"Invalid - pending state", TestDeviceName, `{"version":1,"state":"Pending"}`, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, twin.ApiDeviceTwinReportedByNameRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{v2.Name: testCase.deviceName})
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(controller.ReportDeviceTwin).ServeHTTP(recorder, req)
+
+			var res metadataDTOs.DeviceTwinResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
+
+func TestAllDeviceTwins(t *testing.T) {
+	twins := []twin.Twin{{DeviceName: TestDeviceName, Desired: map[string]string{"SetPoint": "21"}, Version: 2}}
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AllDeviceTwins", 0, 10).Return(twins, nil)
+	dic.Update(di.ServiceConstructorMap{
+		v2MetadataContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, twin.ApiAllDeviceTwinsRoute+"?offset=0&limit=10", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(controller.AllDeviceTwins).ServeHTTP(recorder, req)
+
+	var res metadataDTOs.MultiDeviceTwinsResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Equal(t, twins, res.Twins)
+}
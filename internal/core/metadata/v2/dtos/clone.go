@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+)
+
+// CloneDeviceRequest defines the Request Content for cloning a device. Name is the name of the new device, the other
+// fields override those of the cloned device when set.
+type CloneDeviceRequest struct {
+	dtos.UpdateDevice `json:",inline"`
+}
@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DanglingReference is a device referencing a device profile or a device service which doesn't exist, only the
+// missing references are set
+type DanglingReference struct {
+	DeviceName  string `json:"deviceName"`
+	ProfileName string `json:"profileName,omitempty"`
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// FromDanglingReferenceModelToDTO transforms the DanglingReference Model to the DanglingReference DTO
+func FromDanglingReferenceModelToDTO(r metadataModels.DanglingReference) DanglingReference {
+	return DanglingReference{
+		DeviceName:  r.DeviceName,
+		ProfileName: r.ProfileName,
+		ServiceName: r.ServiceName,
+	}
+}
+
+// ConsistencyResponse defines the Response Content for the consistency check of the references between devices,
+// device profiles and device services.
+type ConsistencyResponse struct {
+	common.BaseResponse `json:",inline"`
+	Consistent          bool                `json:"consistent"`
+	DanglingReferences  []DanglingReference `json:"danglingReferences"`
+}
+
+// NewConsistencyResponse creates new ConsistencyResponse with all fields set appropriately
+func NewConsistencyResponse(requestId string, message string, statusCode int, references []DanglingReference) ConsistencyResponse {
+	return ConsistencyResponse{
+		BaseResponse:       common.NewBaseResponse(requestId, message, statusCode),
+		Consistent:         len(references) == 0,
+		DanglingReferences: references,
+	}
+}
@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeleteDevicesResponse defines the Response Content for deleting the devices of a device service or carrying a
+// label. Count is the number of deleted devices, or of the devices a deletion would delete when it isn't confirmed,
+// in which case ConfirmToken is the token confirming their deletion.
+type DeleteDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	ErrorCode           string `json:"errorCode,omitempty"`
+	Count               int    `json:"count"`
+	ConfirmToken        string `json:"confirmToken,omitempty"`
+}
+
+// NewDeleteDevicesResponse creates new DeleteDevicesResponse with all fields set appropriately
+func NewDeleteDevicesResponse(requestId string, message string, statusCode int, count int) DeleteDevicesResponse {
+	return DeleteDevicesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Count:        count,
+	}
+}
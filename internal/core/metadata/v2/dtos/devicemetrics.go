@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeviceMetricsResponse defines the Response Content for the daily activity counters of a device, newest day first.
+type DeviceMetricsResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceName          string                        `json:"deviceName"`
+	Metrics             []devicemetrics.DailyCounters `json:"metrics"`
+}
+
+// NewDeviceMetricsResponse creates new DeviceMetricsResponse with all fields set appropriately
+func NewDeviceMetricsResponse(requestId string, message string, statusCode int, deviceName string, metrics []devicemetrics.DailyCounters) DeviceMetricsResponse {
+	return DeviceMetricsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeviceName:   deviceName,
+		Metrics:      metrics,
+	}
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// Actions of the device profiles of a bulk upload
+const (
+	BulkActionAdd    = "add"
+	BulkActionUpdate = "update"
+)
+
+// BulkDeviceProfile is a device profile of a bulk upload. Extends names the base device profile, uploaded along or
+// already stored, whose deviceResources, deviceCommands and coreCommands the device profile inherits unless it defines
+// them itself, as well as its manufacturer, model and description when it leaves them empty.
+type BulkDeviceProfile struct {
+	dtos.DeviceProfile `yaml:",inline"`
+	Extends            string `json:"extends,omitempty" yaml:"extends,omitempty"`
+}
+
+// BulkDeviceProfileResult is the result of a device profile of a bulk upload. File is the file of the zip archive the
+// device profile was read from, Action tells whether it was added or updated, and StatusCode is 424 Failed Dependency
+// when the device profile is valid but wasn't applied because another one failed.
+type BulkDeviceProfileResult struct {
+	Name       string `json:"name,omitempty"`
+	File       string `json:"file,omitempty"`
+	Extends    string `json:"extends,omitempty"`
+	Action     string `json:"action,omitempty"`
+	Id         string `json:"id,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message,omitempty"`
+}
+
+// BulkDeviceProfileResponse defines the Response Content for a bulk upload of device profiles, with the results of
+// the device profiles in the order they were applied in
+type BulkDeviceProfileResponse struct {
+	common.BaseResponse `json:",inline"`
+	Applied             bool                      `json:"applied"`
+	Results             []BulkDeviceProfileResult `json:"results"`
+}
+
+// NewBulkDeviceProfileResponse creates new BulkDeviceProfileResponse with all fields set appropriately
+func NewBulkDeviceProfileResponse(requestId string, message string, statusCode int, applied bool, results []BulkDeviceProfileResult) BulkDeviceProfileResponse {
+	return BulkDeviceProfileResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Applied:      applied,
+		Results:      results,
+	}
+}
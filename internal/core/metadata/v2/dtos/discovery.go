@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DiscoveryService reports how a single device service responded to a discovery request and how many devices it
+// has added since discovery was triggered
+type DiscoveryService struct {
+	ServiceName string `json:"serviceName"`
+	Status      string `json:"status"`
+	Message     string `json:"message,omitempty"`
+	Discovered  uint64 `json:"discovered"`
+}
+
+// DiscoverySession describes a discovery triggered on all registered device services
+type DiscoverySession struct {
+	Id        string             `json:"id"`
+	Started   int64              `json:"started"`
+	Ends      int64              `json:"ends"`
+	Completed bool               `json:"completed"`
+	Services  []DiscoveryService `json:"services"`
+}
+
+// DiscoverySessionResponse defines the Response Content for discovery session DTOs.
+type DiscoverySessionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Session             DiscoverySession `json:"session"`
+}
+
+// NewDiscoverySessionResponse creates new DiscoverySessionResponse with all fields set appropriately
+func NewDiscoverySessionResponse(requestId string, message string, statusCode int, session DiscoverySession) DiscoverySessionResponse {
+	return DiscoverySessionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Session:      session,
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// LabelUsage reports how many devices, device profiles and device services carry a label
+type LabelUsage struct {
+	Label          string `json:"label"`
+	Devices        int64  `json:"devices"`
+	DeviceProfiles int64  `json:"deviceProfiles"`
+	DeviceServices int64  `json:"deviceServices"`
+}
+
+// FromLabelUsageModelToDTO transforms the LabelUsage Model to the LabelUsage DTO
+func FromLabelUsageModelToDTO(u pkgModels.LabelUsage) LabelUsage {
+	return LabelUsage{
+		Label:          u.Label,
+		Devices:        u.Devices,
+		DeviceProfiles: u.DeviceProfiles,
+		DeviceServices: u.DeviceServices,
+	}
+}
+
+// MultiLabelUsageResponse defines the Response Content for the usage of all labels.
+type MultiLabelUsageResponse struct {
+	common.BaseResponse `json:",inline"`
+	Labels              []LabelUsage `json:"labels"`
+}
+
+// NewMultiLabelUsageResponse creates new MultiLabelUsageResponse with all fields set appropriately
+func NewMultiLabelUsageResponse(requestId string, message string, statusCode int, labels []LabelUsage) MultiLabelUsageResponse {
+	return MultiLabelUsageResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Labels:       labels,
+	}
+}
+
+// RenameLabelRequest defines the Request Content for renaming a label on all objects carrying it.
+type RenameLabelRequest struct {
+	Name string `json:"name"`
+}
+
+// RenameLabelResponse defines the Response Content for renaming a label, Renamed is the number of renamed objects.
+type RenameLabelResponse struct {
+	common.BaseResponse `json:",inline"`
+	Renamed             int `json:"renamed"`
+}
+
+// NewRenameLabelResponse creates new RenameLabelResponse with all fields set appropriately
+func NewRenameLabelResponse(requestId string, message string, statusCode int, renamed int) RenameLabelResponse {
+	return RenameLabelResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Renamed:      renamed,
+	}
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ProfileCatalogUpdate is a version of a device profile pulled from the profile catalog waiting to be approved,
+// Profile is the device profile file as published in the catalog
+type ProfileCatalogUpdate struct {
+	ProfileName    string `json:"profileName"`
+	Version        string `json:"version"`
+	AppliedVersion string `json:"appliedVersion,omitempty"`
+	Action         string `json:"action"`
+	Status         string `json:"status"`
+	Format         string `json:"format"`
+	Profile        string `json:"profile"`
+	Fetched        int64  `json:"fetched"`
+}
+
+// FromProfileCatalogUpdateModelToDTO transforms the ProfileCatalogUpdate Model to the ProfileCatalogUpdate DTO
+func FromProfileCatalogUpdateModelToDTO(u pkgModels.ProfileCatalogUpdate) ProfileCatalogUpdate {
+	return ProfileCatalogUpdate{
+		ProfileName:    u.ProfileName,
+		Version:        u.Version,
+		AppliedVersion: u.AppliedVersion,
+		Action:         u.Action,
+		Status:         u.Status,
+		Format:         u.Format,
+		Profile:        string(u.Profile),
+		Fetched:        u.Fetched,
+	}
+}
+
+// ProfileCatalogUpdateResponse defines the Response Content for the update of a device profile.
+type ProfileCatalogUpdateResponse struct {
+	common.BaseResponse `json:",inline"`
+	Update              ProfileCatalogUpdate `json:"update"`
+}
+
+// NewProfileCatalogUpdateResponse creates new ProfileCatalogUpdateResponse with all fields set appropriately
+func NewProfileCatalogUpdateResponse(requestId string, message string, statusCode int, update ProfileCatalogUpdate) ProfileCatalogUpdateResponse {
+	return ProfileCatalogUpdateResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Update:       update,
+	}
+}
+
+// MultiProfileCatalogUpdatesResponse defines the Response Content for the updates of several device profiles.
+type MultiProfileCatalogUpdatesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Updates             []ProfileCatalogUpdate `json:"updates"`
+}
+
+// NewMultiProfileCatalogUpdatesResponse creates new MultiProfileCatalogUpdatesResponse with all fields set appropriately
+func NewMultiProfileCatalogUpdatesResponse(requestId string, message string, statusCode int, updates []ProfileCatalogUpdate) MultiProfileCatalogUpdatesResponse {
+	return MultiProfileCatalogUpdatesResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Updates:      updates,
+	}
+}
+
+// ProfileCatalogSyncResponse defines the Response Content for a pull of the profile catalog: the device profiles with
+// a new update pending approval, the number of the ones up to date, and why the others failed to be pulled by name.
+type ProfileCatalogSyncResponse struct {
+	common.BaseResponse `json:",inline"`
+	Updated             []string          `json:"updated"`
+	UpToDate            int               `json:"upToDate"`
+	Failed              map[string]string `json:"failed"`
+}
+
+// NewProfileCatalogSyncResponse creates new ProfileCatalogSyncResponse with all fields set appropriately
+func NewProfileCatalogSyncResponse(requestId string, message string, statusCode int, updated []string, upToDate int, failed map[string]string) ProfileCatalogSyncResponse {
+	return ProfileCatalogSyncResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Updated:      updated,
+		UpToDate:     upToDate,
+		Failed:       failed,
+	}
+}
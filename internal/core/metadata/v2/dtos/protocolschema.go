@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ProtocolSchemaResponse defines the Response Content for the JSON Schema registered for a protocol.
+type ProtocolSchemaResponse struct {
+	common.BaseResponse `json:",inline"`
+	Name                string          `json:"name"`
+	Schema              json.RawMessage `json:"schema"`
+}
+
+// NewProtocolSchemaResponse creates new ProtocolSchemaResponse with all fields set appropriately
+func NewProtocolSchemaResponse(requestId string, message string, statusCode int, name string, schema json.RawMessage) ProtocolSchemaResponse {
+	return ProtocolSchemaResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Name:         name,
+		Schema:       schema,
+	}
+}
+
+// MultiProtocolSchemasResponse defines the Response Content for the JSON Schemas registered for all protocols.
+type MultiProtocolSchemasResponse struct {
+	common.BaseResponse `json:",inline"`
+	Schemas             map[string]json.RawMessage `json:"schemas"`
+}
+
+// NewMultiProtocolSchemasResponse creates new MultiProtocolSchemasResponse with all fields set appropriately
+func NewMultiProtocolSchemasResponse(requestId string, message string, statusCode int, schemas map[string]json.RawMessage) MultiProtocolSchemasResponse {
+	return MultiProtocolSchemasResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Schemas:      schemas,
+	}
+}
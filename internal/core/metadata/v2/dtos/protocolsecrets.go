@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeviceProtocolsResponse defines the Response Content for the protocols of a device with the secret protocol
+// properties resolved from the secret store.
+type DeviceProtocolsResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceName          string                             `json:"deviceName"`
+	Protocols           map[string]dtos.ProtocolProperties `json:"protocols"`
+}
+
+// NewDeviceProtocolsResponse creates new DeviceProtocolsResponse with all fields set appropriately
+func NewDeviceProtocolsResponse(requestId string, message string, statusCode int, deviceName string, protocols map[string]dtos.ProtocolProperties) DeviceProtocolsResponse {
+	return DeviceProtocolsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeviceName:   deviceName,
+		Protocols:    protocols,
+	}
+}
@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// ResourceMapping maps the deviceResource of a device profile to the model of an external protocol through the
+// attributes of the protocol
+type ResourceMapping struct {
+	ProfileName  string            `json:"profileName"`
+	ResourceName string            `json:"resourceName"`
+	Protocol     string            `json:"protocol"`
+	Attributes   map[string]string `json:"attributes"`
+	Modified     int64             `json:"modified,omitempty"`
+}
+
+// SetResourceMappingRequest defines the Request Content for setting the mapping of a deviceResource to a protocol
+type SetResourceMappingRequest struct {
+	Attributes map[string]string `json:"attributes"`
+}
+
+// FromResourceMappingModelToDTO transforms the ResourceMapping Model to the ResourceMapping DTO
+func FromResourceMappingModelToDTO(m pkgModels.ResourceMapping) ResourceMapping {
+	return ResourceMapping{
+		ProfileName:  m.ProfileName,
+		ResourceName: m.ResourceName,
+		Protocol:     m.Protocol,
+		Attributes:   m.Attributes,
+		Modified:     m.Modified,
+	}
+}
+
+// ResourceMappingResponse defines the Response Content for the mapping of a deviceResource to a protocol.
+type ResourceMappingResponse struct {
+	common.BaseResponse `json:",inline"`
+	ResourceMapping     ResourceMapping `json:"resourceMapping"`
+}
+
+// NewResourceMappingResponse creates new ResourceMappingResponse with all fields set appropriately
+func NewResourceMappingResponse(requestId string, message string, statusCode int, mapping ResourceMapping) ResourceMappingResponse {
+	return ResourceMappingResponse{
+		BaseResponse:    common.NewBaseResponse(requestId, message, statusCode),
+		ResourceMapping: mapping,
+	}
+}
+
+// MultiResourceMappingsResponse defines the Response Content for the mappings of several deviceResources.
+type MultiResourceMappingsResponse struct {
+	common.BaseResponse `json:",inline"`
+	ResourceMappings    []ResourceMapping `json:"resourceMappings"`
+}
+
+// NewMultiResourceMappingsResponse creates new MultiResourceMappingsResponse with all fields set appropriately
+func NewMultiResourceMappingsResponse(requestId string, message string, statusCode int, mappings []ResourceMapping) MultiResourceMappingsResponse {
+	return MultiResourceMappingsResponse{
+		BaseResponse:     common.NewBaseResponse(requestId, message, statusCode),
+		ResourceMappings: mappings,
+	}
+}
+
+// ResourceMappingSchemaResponse defines the Response Content for the schema the mapping attributes of a protocol are
+// validated against.
+type ResourceMappingSchemaResponse struct {
+	common.BaseResponse `json:",inline"`
+	Protocol            string          `json:"protocol"`
+	Schema              json.RawMessage `json:"schema"`
+}
+
+// NewResourceMappingSchemaResponse creates new ResourceMappingSchemaResponse with all fields set appropriately
+func NewResourceMappingSchemaResponse(requestId string, message string, statusCode int, protocol string, schema json.RawMessage) ResourceMappingSchemaResponse {
+	return ResourceMappingSchemaResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Protocol:     protocol,
+		Schema:       schema,
+	}
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeviceStateTransition is a change of the admin or operating state of a device
+type DeviceStateTransition struct {
+	State     string `json:"state"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Reason    string `json:"reason,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func FromDeviceStateTransitionModelToDTO(t pkgModels.DeviceStateTransition) DeviceStateTransition {
+	return DeviceStateTransition{
+		State:     t.State,
+		From:      t.From,
+		To:        t.To,
+		Reason:    t.Reason,
+		Timestamp: t.Timestamp,
+	}
+}
+
+// DeviceStateHistoryResponse defines the Response Content for the state transitions of a device, the latest first
+type DeviceStateHistoryResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceName          string                  `json:"deviceName"`
+	Transitions         []DeviceStateTransition `json:"transitions"`
+}
+
+// NewDeviceStateHistoryResponse creates new DeviceStateHistoryResponse with all fields set appropriately
+func NewDeviceStateHistoryResponse(requestId string, message string, statusCode int, deviceName string, transitions []DeviceStateTransition) DeviceStateHistoryResponse {
+	return DeviceStateHistoryResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeviceName:   deviceName,
+		Transitions:  transitions,
+	}
+}
@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// TrashedObject is a deleted device or device profile kept in the trash, Deleted is when it was moved to the trash and
+// Object is the device or device profile
+type TrashedObject struct {
+	Type    string          `json:"type"`
+	Id      string          `json:"id"`
+	Name    string          `json:"name"`
+	Deleted int64           `json:"deleted"`
+	Object  json.RawMessage `json:"object"`
+}
+
+// FromTrashedObjectModelToDTO transforms the TrashedObject Model to the TrashedObject DTO
+func FromTrashedObjectModelToDTO(o pkgModels.TrashedObject) TrashedObject {
+	return TrashedObject{
+		Type:    o.Type,
+		Id:      o.Id,
+		Name:    o.Name,
+		Deleted: o.Deleted,
+		Object:  o.Object,
+	}
+}
+
+// TrashedObjectResponse defines the Response Content for a trashed object.
+type TrashedObjectResponse struct {
+	common.BaseResponse `json:",inline"`
+	TrashedObject       TrashedObject `json:"trashedObject"`
+}
+
+// NewTrashedObjectResponse creates new TrashedObjectResponse with all fields set appropriately
+func NewTrashedObjectResponse(requestId string, message string, statusCode int, object TrashedObject) TrashedObjectResponse {
+	return TrashedObjectResponse{
+		BaseResponse:  common.NewBaseResponse(requestId, message, statusCode),
+		TrashedObject: object,
+	}
+}
+
+// MultiTrashedObjectsResponse defines the Response Content for the trashed objects of a type.
+type MultiTrashedObjectsResponse struct {
+	common.BaseResponse `json:",inline"`
+	TrashedObjects      []TrashedObject `json:"trashedObjects"`
+}
+
+// NewMultiTrashedObjectsResponse creates new MultiTrashedObjectsResponse with all fields set appropriately
+func NewMultiTrashedObjectsResponse(requestId string, message string, statusCode int, objects []TrashedObject) MultiTrashedObjectsResponse {
+	return MultiTrashedObjectsResponse{
+		BaseResponse:   common.NewBaseResponse(requestId, message, statusCode),
+		TrashedObjects: objects,
+	}
+}
@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeviceTwinResponse defines the Response Content for the twin of a device.
+type DeviceTwinResponse struct {
+	common.BaseResponse `json:",inline"`
+	Twin                twin.Twin `json:"twin"`
+}
+
+// NewDeviceTwinResponse creates new DeviceTwinResponse with all fields set appropriately
+func NewDeviceTwinResponse(requestId string, message string, statusCode int, t twin.Twin) DeviceTwinResponse {
+	return DeviceTwinResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Twin:         t,
+	}
+}
+
+// MultiDeviceTwinsResponse defines the Response Content for the twins of the devices, the desired state last changed first.
+type MultiDeviceTwinsResponse struct {
+	common.BaseResponse `json:",inline"`
+	Twins               []twin.Twin `json:"twins"`
+}
+
+// NewMultiDeviceTwinsResponse creates new MultiDeviceTwinsResponse with all fields set appropriately
+func NewMultiDeviceTwinsResponse(requestId string, message string, statusCode int, twins []twin.Twin) MultiDeviceTwinsResponse {
+	return MultiDeviceTwinsResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Twins:        twins,
+	}
+}
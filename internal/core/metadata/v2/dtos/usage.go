@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// DeviceProfileUsage is how a device profile is used by the devices. The last event times are in milliseconds and
+// left out when no event was received.
+type DeviceProfileUsage struct {
+	ProfileName          string         `json:"profileName"`
+	DeviceCount          int            `json:"deviceCount"`
+	DeviceCounts         map[string]int `json:"deviceCounts"`
+	Devices              []DeviceUsage  `json:"devices"`
+	LastEventReceived    int64          `json:"lastEventReceived,omitempty"`
+	UnusedDeviceCommands []string       `json:"unusedDeviceCommands"`
+}
+
+// DeviceUsage is a device using a device profile
+type DeviceUsage struct {
+	DeviceName        string `json:"deviceName"`
+	ServiceName       string `json:"serviceName"`
+	LastEventReceived int64  `json:"lastEventReceived,omitempty"`
+}
+
+// FromDeviceProfileUsageModelToDTO transforms the DeviceProfileUsage Model to the DeviceProfileUsage DTO
+func FromDeviceProfileUsageModelToDTO(u metadataModels.DeviceProfileUsage) DeviceProfileUsage {
+	devices := make([]DeviceUsage, len(u.Devices))
+	for i, d := range u.Devices {
+		devices[i] = DeviceUsage{
+			DeviceName:        d.DeviceName,
+			ServiceName:       d.ServiceName,
+			LastEventReceived: d.LastEventReceived,
+		}
+	}
+	return DeviceProfileUsage{
+		ProfileName:          u.ProfileName,
+		DeviceCount:          len(u.Devices),
+		DeviceCounts:         u.DeviceCounts,
+		Devices:              devices,
+		LastEventReceived:    u.LastEventReceived,
+		UnusedDeviceCommands: u.UnusedDeviceCommands,
+	}
+}
+
+// DeviceProfileUsageResponse defines the Response Content for the usage of a device profile by the devices.
+type DeviceProfileUsageResponse struct {
+	common.BaseResponse `json:",inline"`
+	Usage               DeviceProfileUsage `json:"usage"`
+}
+
+// NewDeviceProfileUsageResponse creates new DeviceProfileUsageResponse with all fields set appropriately
+func NewDeviceProfileUsageResponse(requestId string, message string, statusCode int, usage DeviceProfileUsage) DeviceProfileUsageResponse {
+	return DeviceProfileUsageResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Usage:        usage,
+	}
+}
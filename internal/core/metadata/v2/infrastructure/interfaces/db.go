@@ -6,23 +6,35 @@
 package interfaces
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
 	AddDeviceProfile(e model.DeviceProfile) (model.DeviceProfile, errors.EdgeX)
-	UpdateDeviceProfile(e model.DeviceProfile) errors.EdgeX
+	UpdateDeviceProfile(e model.DeviceProfile, modified int64) errors.EdgeX
+	DeviceProfileById(id string) (model.DeviceProfile, errors.EdgeX)
 	DeviceProfileByName(name string) (model.DeviceProfile, errors.EdgeX)
 	DeleteDeviceProfileById(id string) errors.EdgeX
 	DeleteDeviceProfileByName(name string) errors.EdgeX
 	DeviceProfileNameExists(name string) (bool, errors.EdgeX)
+	SetDeviceProfileYaml(name string, data []byte) errors.EdgeX
+	DeviceProfileYamlByName(name string) ([]byte, errors.EdgeX)
 	AllDeviceProfiles(offset int, limit int, labels []string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]model.DeviceProfile, errors.EdgeX)
+	DeviceProfilesModifiedSince(since int, offset int, limit int) ([]model.DeviceProfile, errors.EdgeX)
+	DeviceProfilesByFilter(expression filter.Expression, offset int, limit int) ([]model.DeviceProfile, errors.EdgeX)
 
 	AddDeviceService(e model.DeviceService) (model.DeviceService, errors.EdgeX)
 	DeviceServiceById(id string) (model.DeviceService, errors.EdgeX)
@@ -31,10 +43,16 @@ type DBClient interface {
 	DeleteDeviceServiceByName(name string) errors.EdgeX
 	DeviceServiceNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceServices(offset int, limit int, labels []string) ([]model.DeviceService, errors.EdgeX)
+	DeviceServicesModifiedSince(since int, offset int, limit int) ([]model.DeviceService, errors.EdgeX)
+	DeviceServicesByFilter(expression filter.Expression, offset int, limit int) ([]model.DeviceService, errors.EdgeX)
 
 	AddDevice(d model.Device) (model.Device, errors.EdgeX)
+	UpdateDevice(d model.Device, modified int64) (model.Device, errors.EdgeX)
 	DeleteDeviceById(id string) errors.EdgeX
 	DeleteDeviceByName(name string) errors.EdgeX
+	DeleteDevicesByServiceName(name string, ids []string) (int, errors.EdgeX)
+	DeleteDevicesByLabel(label string, ids []string) (int, errors.EdgeX)
+	DevicesByIndex(name string, value string, offset int, limit int) ([]model.Device, errors.EdgeX)
 	DevicesByServiceName(offset int, limit int, name string) ([]model.Device, errors.EdgeX)
 	DeviceIdExists(id string) (bool, errors.EdgeX)
 	DeviceNameExists(id string) (bool, errors.EdgeX)
@@ -42,4 +60,44 @@ type DBClient interface {
 	DeviceByName(name string) (model.Device, errors.EdgeX)
 	AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX)
 	DevicesByProfileName(offset int, limit int, profileName string) ([]model.Device, errors.EdgeX)
+	DevicesModifiedSince(since int, offset int, limit int) ([]model.Device, errors.EdgeX)
+	DevicesByFilter(expression filter.Expression, offset int, limit int) ([]model.Device, errors.EdgeX)
+	AddDeviceMetrics(name string, counters devicemetrics.Counters, at time.Time, retention time.Duration) errors.EdgeX
+	DeviceMetricsByName(name string, offset int, limit int) ([]devicemetrics.DailyCounters, errors.EdgeX)
+	AddDeviceStateTransitions(transitions []pkgModels.DeviceStateTransition, retention time.Duration) errors.EdgeX
+	DeviceStateHistory(name string, start int64, end int64, offset int, limit int) ([]pkgModels.DeviceStateTransition, errors.EdgeX)
+	DevicesLastEventReceived(names []string) (map[string]int64, errors.EdgeX)
+	UpdateDeviceTwinDesired(name string, desired map[string]string, updated int64) (twin.Twin, errors.EdgeX)
+	ReportDeviceTwin(name string, report twin.Report, reconciled int64) (twin.Twin, errors.EdgeX)
+	DeviceTwinByName(name string) (twin.Twin, errors.EdgeX)
+	DeleteDeviceTwinByName(name string) errors.EdgeX
+	AllDeviceTwins(offset int, limit int) ([]twin.Twin, errors.EdgeX)
+
+	SetProtocolSchema(name string, schema []byte) errors.EdgeX
+	ProtocolSchemaByName(name string) ([]byte, errors.EdgeX)
+	AllProtocolSchemas() (map[string][]byte, errors.EdgeX)
+	DeleteProtocolSchemaByName(name string) errors.EdgeX
+
+	SetResourceMapping(m pkgModels.ResourceMapping) errors.EdgeX
+	ResourceMapping(profileName string, resourceName string, protocol string) (pkgModels.ResourceMapping, errors.EdgeX)
+	ResourceMappingsByProfileName(profileName string) ([]pkgModels.ResourceMapping, errors.EdgeX)
+	ResourceMappingsByProtocol(protocol string) ([]pkgModels.ResourceMapping, errors.EdgeX)
+	DeleteResourceMapping(profileName string, resourceName string, protocol string) errors.EdgeX
+
+	LabelUsage() ([]pkgModels.LabelUsage, errors.EdgeX)
+	RenameLabel(from string, to string) (int, errors.EdgeX)
+
+	TrashDevice(d model.Device, deleted int64) errors.EdgeX
+	TrashDeviceProfile(dp model.DeviceProfile, deleted int64) errors.EdgeX
+	TrashedObjects(objectType string, offset int, limit int) ([]pkgModels.TrashedObject, errors.EdgeX)
+	TrashedObjectByName(objectType string, name string) (pkgModels.TrashedObject, errors.EdgeX)
+	DeleteTrashedObjectByName(objectType string, name string) errors.EdgeX
+	PurgeTrashedObjects(objectType string, deletedBefore int64) (int, errors.EdgeX)
+
+	SetProfileCatalogUpdate(u pkgModels.ProfileCatalogUpdate) errors.EdgeX
+	ProfileCatalogUpdateByName(name string) (pkgModels.ProfileCatalogUpdate, errors.EdgeX)
+	AllProfileCatalogUpdates() ([]pkgModels.ProfileCatalogUpdate, errors.EdgeX)
+	DeleteProfileCatalogUpdateByName(name string) errors.EdgeX
+	SetProfileCatalogVersion(name string, version string) errors.EdgeX
+	ProfileCatalogVersions() (map[string]string, errors.EdgeX)
 }
@@ -3,11 +3,20 @@
 package mocks
 
 import (
+	devicemetrics "github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
 	errors "github.com/edgexfoundry/go-mod-core-contracts/errors"
 
+	filter "github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+
+	pkgmodels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	time "time"
+
+	twin "github.com/edgexfoundry/edgex-go/internal/pkg/twin"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -38,6 +47,22 @@ func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddDeviceMetrics provides a mock function with given fields: name, counters, at, retention
+func (_m *DBClient) AddDeviceMetrics(name string, counters devicemetrics.Counters, at time.Time, retention time.Duration) errors.EdgeX {
+	ret := _m.Called(name, counters, at, retention)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, devicemetrics.Counters, time.Time, time.Duration) errors.EdgeX); ok {
+		r0 = rf(name, counters, at, retention)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // AddDeviceProfile provides a mock function with given fields: e
 func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(e)
@@ -84,6 +109,22 @@ func (_m *DBClient) AddDeviceService(e models.DeviceService) (models.DeviceServi
 	return r0, r1
 }
 
+// AddDeviceStateTransitions provides a mock function with given fields: transitions, retention
+func (_m *DBClient) AddDeviceStateTransitions(transitions []pkgmodels.DeviceStateTransition, retention time.Duration) errors.EdgeX {
+	ret := _m.Called(transitions, retention)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]pkgmodels.DeviceStateTransition, time.Duration) errors.EdgeX); ok {
+		r0 = rf(transitions, retention)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // AllDeviceProfiles provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -134,6 +175,31 @@ func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([
 	return r0, r1
 }
 
+// AllDeviceTwins provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceTwins(offset int, limit int) ([]twin.Twin, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []twin.Twin
+	if rf, ok := ret.Get(0).(func(int, int) []twin.Twin); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]twin.Twin)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDevices provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -159,6 +225,56 @@ func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models
 	return r0, r1
 }
 
+// AllProfileCatalogUpdates provides a mock function with given fields:
+func (_m *DBClient) AllProfileCatalogUpdates() ([]pkgmodels.ProfileCatalogUpdate, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 []pkgmodels.ProfileCatalogUpdate
+	if rf, ok := ret.Get(0).(func() []pkgmodels.ProfileCatalogUpdate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.ProfileCatalogUpdate)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllProtocolSchemas provides a mock function with given fields:
+func (_m *DBClient) AllProtocolSchemas() (map[string][]byte, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 map[string][]byte
+	if rf, ok := ret.Get(0).(func() map[string][]byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
@@ -260,6 +376,132 @@ func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDeviceTwinByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceTwinByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDevicesByLabel provides a mock function with given fields: label, ids
+func (_m *DBClient) DeleteDevicesByLabel(label string, ids []string) (int, errors.EdgeX) {
+	ret := _m.Called(label, ids)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, []string) int); ok {
+		r0 = rf(label, ids)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, []string) errors.EdgeX); ok {
+		r1 = rf(label, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteDevicesByServiceName provides a mock function with given fields: name, ids
+func (_m *DBClient) DeleteDevicesByServiceName(name string, ids []string) (int, errors.EdgeX) {
+	ret := _m.Called(name, ids)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, []string) int); ok {
+		r0 = rf(name, ids)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, []string) errors.EdgeX); ok {
+		r1 = rf(name, ids)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteProfileCatalogUpdateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteProfileCatalogUpdateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProtocolSchemaByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteProtocolSchemaByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteResourceMapping provides a mock function with given fields: profileName, resourceName, protocol
+func (_m *DBClient) DeleteResourceMapping(profileName string, resourceName string, protocol string) errors.EdgeX {
+	ret := _m.Called(profileName, resourceName, protocol)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, string) errors.EdgeX); ok {
+		r0 = rf(profileName, resourceName, protocol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteTrashedObjectByName provides a mock function with given fields: objectType, name
+func (_m *DBClient) DeleteTrashedObjectByName(objectType string, name string) errors.EdgeX {
+	ret := _m.Called(objectType, name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(objectType, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeviceById provides a mock function with given fields: id
 func (_m *DBClient) DeviceById(id string) (models.Device, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -329,6 +571,31 @@ func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceMetricsByName provides a mock function with given fields: name, offset, limit
+func (_m *DBClient) DeviceMetricsByName(name string, offset int, limit int) ([]devicemetrics.DailyCounters, errors.EdgeX) {
+	ret := _m.Called(name, offset, limit)
+
+	var r0 []devicemetrics.DailyCounters
+	if rf, ok := ret.Get(0).(func(string, int, int) []devicemetrics.DailyCounters); ok {
+		r0 = rf(name, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]devicemetrics.DailyCounters)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int) errors.EdgeX); ok {
+		r1 = rf(name, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceNameExists provides a mock function with given fields: id
 func (_m *DBClient) DeviceNameExists(id string) (bool, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -352,6 +619,29 @@ func (_m *DBClient) DeviceNameExists(id string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceProfileById provides a mock function with given fields: id
+func (_m *DBClient) DeviceProfileById(id string) (models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(string) models.DeviceProfile); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.DeviceProfile)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfileByName provides a mock function with given fields: name
 func (_m *DBClient) DeviceProfileByName(name string) (models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(name)
@@ -398,6 +688,56 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceProfileYamlByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceProfileYamlByName(name string) ([]byte, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfilesByFilter provides a mock function with given fields: expression, offset, limit
+func (_m *DBClient) DeviceProfilesByFilter(expression filter.Expression, offset int, limit int) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(expression, offset, limit)
+
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(filter.Expression, int, int) []models.DeviceProfile); ok {
+		r0 = rf(expression, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(filter.Expression, int, int) errors.EdgeX); ok {
+		r1 = rf(expression, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
 func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, manufacturer)
@@ -473,20 +813,22 @@ func (_m *DBClient) DeviceProfilesByModel(offset int, limit int, model string) (
 	return r0, r1
 }
 
-// DeviceServiceById provides a mock function with given fields: id
-func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.EdgeX) {
-	ret := _m.Called(id)
+// DeviceProfilesModifiedSince provides a mock function with given fields: since, offset, limit
+func (_m *DBClient) DeviceProfilesModifiedSince(since int, offset int, limit int) ([]models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(since, offset, limit)
 
-	var r0 models.DeviceService
-	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
-		r0 = rf(id)
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(int, int, int) []models.DeviceProfile); ok {
+		r0 = rf(since, offset, limit)
 	} else {
-		r0 = ret.Get(0).(models.DeviceService)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
 	}
 
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(int, int, int) errors.EdgeX); ok {
+		r1 = rf(since, offset, limit)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -496,8 +838,31 @@ func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.E
 	return r0, r1
 }
 
-// DeviceServiceByName provides a mock function with given fields: name
-func (_m *DBClient) DeviceServiceByName(name string) (models.DeviceService, errors.EdgeX) {
+// DeviceServiceById provides a mock function with given fields: id
+func (_m *DBClient) DeviceServiceById(id string) (models.DeviceService, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 models.DeviceService
+	if rf, ok := ret.Get(0).(func(string) models.DeviceService); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.DeviceService)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServiceByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceServiceByName(name string) (models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(name)
 
 	var r0 models.DeviceService
@@ -542,6 +907,154 @@ func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceServicesByFilter provides a mock function with given fields: expression, offset, limit
+func (_m *DBClient) DeviceServicesByFilter(expression filter.Expression, offset int, limit int) ([]models.DeviceService, errors.EdgeX) {
+	ret := _m.Called(expression, offset, limit)
+
+	var r0 []models.DeviceService
+	if rf, ok := ret.Get(0).(func(filter.Expression, int, int) []models.DeviceService); ok {
+		r0 = rf(expression, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceService)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(filter.Expression, int, int) errors.EdgeX); ok {
+		r1 = rf(expression, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceServicesModifiedSince provides a mock function with given fields: since, offset, limit
+func (_m *DBClient) DeviceServicesModifiedSince(since int, offset int, limit int) ([]models.DeviceService, errors.EdgeX) {
+	ret := _m.Called(since, offset, limit)
+
+	var r0 []models.DeviceService
+	if rf, ok := ret.Get(0).(func(int, int, int) []models.DeviceService); ok {
+		r0 = rf(since, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceService)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, int) errors.EdgeX); ok {
+		r1 = rf(since, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceStateHistory provides a mock function with given fields: name, start, end, offset, limit
+func (_m *DBClient) DeviceStateHistory(name string, start int64, end int64, offset int, limit int) ([]pkgmodels.DeviceStateTransition, errors.EdgeX) {
+	ret := _m.Called(name, start, end, offset, limit)
+
+	var r0 []pkgmodels.DeviceStateTransition
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []pkgmodels.DeviceStateTransition); ok {
+		r0 = rf(name, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.DeviceStateTransition)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(name, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceTwinByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceTwinByName(name string) (twin.Twin, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 twin.Twin
+	if rf, ok := ret.Get(0).(func(string) twin.Twin); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(twin.Twin)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesByFilter provides a mock function with given fields: expression, offset, limit
+func (_m *DBClient) DevicesByFilter(expression filter.Expression, offset int, limit int) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(expression, offset, limit)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(filter.Expression, int, int) []models.Device); ok {
+		r0 = rf(expression, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(filter.Expression, int, int) errors.EdgeX); ok {
+		r1 = rf(expression, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesByIndex provides a mock function with given fields: name, value, offset, limit
+func (_m *DBClient) DevicesByIndex(name string, value string, offset int, limit int) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(name, value, offset, limit)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []models.Device); ok {
+		r0 = rf(name, value, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, int, int) errors.EdgeX); ok {
+		r1 = rf(name, value, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DevicesByProfileName provides a mock function with given fields: offset, limit, profileName
 func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, profileName)
@@ -592,13 +1105,486 @@ func (_m *DBClient) DevicesByServiceName(offset int, limit int, name string) ([]
 	return r0, r1
 }
 
-// UpdateDeviceProfile provides a mock function with given fields: e
-func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
-	ret := _m.Called(e)
+// DevicesLastEventReceived provides a mock function with given fields: names
+func (_m *DBClient) DevicesLastEventReceived(names []string) (map[string]int64, errors.EdgeX) {
+	ret := _m.Called(names)
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func([]string) map[string]int64); ok {
+		r0 = rf(names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func([]string) errors.EdgeX); ok {
+		r1 = rf(names)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesModifiedSince provides a mock function with given fields: since, offset, limit
+func (_m *DBClient) DevicesModifiedSince(since int, offset int, limit int) ([]models.Device, errors.EdgeX) {
+	ret := _m.Called(since, offset, limit)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, int) []models.Device); ok {
+		r0 = rf(since, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, int) errors.EdgeX); ok {
+		r1 = rf(since, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// LabelUsage provides a mock function with given fields:
+func (_m *DBClient) LabelUsage() ([]pkgmodels.LabelUsage, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 []pkgmodels.LabelUsage
+	if rf, ok := ret.Get(0).(func() []pkgmodels.LabelUsage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.LabelUsage)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ProfileCatalogUpdateByName provides a mock function with given fields: name
+func (_m *DBClient) ProfileCatalogUpdateByName(name string) (pkgmodels.ProfileCatalogUpdate, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 pkgmodels.ProfileCatalogUpdate
+	if rf, ok := ret.Get(0).(func(string) pkgmodels.ProfileCatalogUpdate); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.ProfileCatalogUpdate)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ProfileCatalogVersions provides a mock function with given fields:
+func (_m *DBClient) ProfileCatalogVersions() (map[string]string, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ProtocolSchemaByName provides a mock function with given fields: name
+func (_m *DBClient) ProtocolSchemaByName(name string) ([]byte, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// PurgeTrashedObjects provides a mock function with given fields: objectType, deletedBefore
+func (_m *DBClient) PurgeTrashedObjects(objectType string, deletedBefore int64) (int, errors.EdgeX) {
+	ret := _m.Called(objectType, deletedBefore)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int64) int); ok {
+		r0 = rf(objectType, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int64) errors.EdgeX); ok {
+		r1 = rf(objectType, deletedBefore)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// RenameLabel provides a mock function with given fields: from, to
+func (_m *DBClient) RenameLabel(from string, to string) (int, errors.EdgeX) {
+	ret := _m.Called(from, to)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(from, to)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReportDeviceTwin provides a mock function with given fields: name, report, reconciled
+func (_m *DBClient) ReportDeviceTwin(name string, report twin.Report, reconciled int64) (twin.Twin, errors.EdgeX) {
+	ret := _m.Called(name, report, reconciled)
+
+	var r0 twin.Twin
+	if rf, ok := ret.Get(0).(func(string, twin.Report, int64) twin.Twin); ok {
+		r0 = rf(name, report, reconciled)
+	} else {
+		r0 = ret.Get(0).(twin.Twin)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, twin.Report, int64) errors.EdgeX); ok {
+		r1 = rf(name, report, reconciled)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResourceMapping provides a mock function with given fields: profileName, resourceName, protocol
+func (_m *DBClient) ResourceMapping(profileName string, resourceName string, protocol string) (pkgmodels.ResourceMapping, errors.EdgeX) {
+	ret := _m.Called(profileName, resourceName, protocol)
+
+	var r0 pkgmodels.ResourceMapping
+	if rf, ok := ret.Get(0).(func(string, string, string) pkgmodels.ResourceMapping); ok {
+		r0 = rf(profileName, resourceName, protocol)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.ResourceMapping)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, string) errors.EdgeX); ok {
+		r1 = rf(profileName, resourceName, protocol)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResourceMappingsByProfileName provides a mock function with given fields: profileName
+func (_m *DBClient) ResourceMappingsByProfileName(profileName string) ([]pkgmodels.ResourceMapping, errors.EdgeX) {
+	ret := _m.Called(profileName)
+
+	var r0 []pkgmodels.ResourceMapping
+	if rf, ok := ret.Get(0).(func(string) []pkgmodels.ResourceMapping); ok {
+		r0 = rf(profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.ResourceMapping)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResourceMappingsByProtocol provides a mock function with given fields: protocol
+func (_m *DBClient) ResourceMappingsByProtocol(protocol string) ([]pkgmodels.ResourceMapping, errors.EdgeX) {
+	ret := _m.Called(protocol)
+
+	var r0 []pkgmodels.ResourceMapping
+	if rf, ok := ret.Get(0).(func(string) []pkgmodels.ResourceMapping); ok {
+		r0 = rf(protocol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.ResourceMapping)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(protocol)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetDeviceProfileYaml provides a mock function with given fields: name, data
+func (_m *DBClient) SetDeviceProfileYaml(name string, data []byte) errors.EdgeX {
+	ret := _m.Called(name, data)
 
 	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.DeviceProfile) errors.EdgeX); ok {
-		r0 = rf(e)
+	if rf, ok := ret.Get(0).(func(string, []byte) errors.EdgeX); ok {
+		r0 = rf(name, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// SetProfileCatalogUpdate provides a mock function with given fields: u
+func (_m *DBClient) SetProfileCatalogUpdate(u pkgmodels.ProfileCatalogUpdate) errors.EdgeX {
+	ret := _m.Called(u)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgmodels.ProfileCatalogUpdate) errors.EdgeX); ok {
+		r0 = rf(u)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// SetProfileCatalogVersion provides a mock function with given fields: name, version
+func (_m *DBClient) SetProfileCatalogVersion(name string, version string) errors.EdgeX {
+	ret := _m.Called(name, version)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(name, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// SetProtocolSchema provides a mock function with given fields: name, schema
+func (_m *DBClient) SetProtocolSchema(name string, schema []byte) errors.EdgeX {
+	ret := _m.Called(name, schema)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, []byte) errors.EdgeX); ok {
+		r0 = rf(name, schema)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// SetResourceMapping provides a mock function with given fields: m
+func (_m *DBClient) SetResourceMapping(m pkgmodels.ResourceMapping) errors.EdgeX {
+	ret := _m.Called(m)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgmodels.ResourceMapping) errors.EdgeX); ok {
+		r0 = rf(m)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// TrashDevice provides a mock function with given fields: d, deleted
+func (_m *DBClient) TrashDevice(d models.Device, deleted int64) errors.EdgeX {
+	ret := _m.Called(d, deleted)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.Device, int64) errors.EdgeX); ok {
+		r0 = rf(d, deleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// TrashDeviceProfile provides a mock function with given fields: dp, deleted
+func (_m *DBClient) TrashDeviceProfile(dp models.DeviceProfile, deleted int64) errors.EdgeX {
+	ret := _m.Called(dp, deleted)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.DeviceProfile, int64) errors.EdgeX); ok {
+		r0 = rf(dp, deleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// TrashedObjectByName provides a mock function with given fields: objectType, name
+func (_m *DBClient) TrashedObjectByName(objectType string, name string) (pkgmodels.TrashedObject, errors.EdgeX) {
+	ret := _m.Called(objectType, name)
+
+	var r0 pkgmodels.TrashedObject
+	if rf, ok := ret.Get(0).(func(string, string) pkgmodels.TrashedObject); ok {
+		r0 = rf(objectType, name)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.TrashedObject)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string) errors.EdgeX); ok {
+		r1 = rf(objectType, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// TrashedObjects provides a mock function with given fields: objectType, offset, limit
+func (_m *DBClient) TrashedObjects(objectType string, offset int, limit int) ([]pkgmodels.TrashedObject, errors.EdgeX) {
+	ret := _m.Called(objectType, offset, limit)
+
+	var r0 []pkgmodels.TrashedObject
+	if rf, ok := ret.Get(0).(func(string, int, int) []pkgmodels.TrashedObject); ok {
+		r0 = rf(objectType, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.TrashedObject)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int, int) errors.EdgeX); ok {
+		r1 = rf(objectType, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateDevice provides a mock function with given fields: d, modified
+func (_m *DBClient) UpdateDevice(d models.Device, modified int64) (models.Device, errors.EdgeX) {
+	ret := _m.Called(d, modified)
+
+	var r0 models.Device
+	if rf, ok := ret.Get(0).(func(models.Device, int64) models.Device); ok {
+		r0 = rf(d, modified)
+	} else {
+		r0 = ret.Get(0).(models.Device)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.Device, int64) errors.EdgeX); ok {
+		r1 = rf(d, modified)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateDeviceProfile provides a mock function with given fields: e, modified
+func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile, modified int64) errors.EdgeX {
+	ret := _m.Called(e, modified)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.DeviceProfile, int64) errors.EdgeX); ok {
+		r0 = rf(e, modified)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(errors.EdgeX)
@@ -607,3 +1593,26 @@ func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 
 	return r0
 }
+
+// UpdateDeviceTwinDesired provides a mock function with given fields: name, desired, updated
+func (_m *DBClient) UpdateDeviceTwinDesired(name string, desired map[string]string, updated int64) (twin.Twin, errors.EdgeX) {
+	ret := _m.Called(name, desired, updated)
+
+	var r0 twin.Twin
+	if rf, ok := ret.Get(0).(func(string, map[string]string, int64) twin.Twin); ok {
+		r0 = rf(name, desired, updated)
+	} else {
+		r0 = ret.Get(0).(twin.Twin)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, map[string]string, int64) errors.EdgeX); ok {
+		r1 = rf(name, desired, updated)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
@@ -22,7 +22,7 @@ import (
 // DeviceProfileReader unmarshals a request body into an DeviceProfile type
 type DeviceProfileReader interface {
 	ReadDeviceProfileRequest(reader io.Reader) ([]dto.DeviceProfileRequest, errors.EdgeX)
-	ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX)
+	ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, []byte, errors.EdgeX)
 }
 
 // NewRequestReader returns a BodyReader capable of processing the request body
@@ -48,32 +48,33 @@ func (jsonDeviceProfileReader) ReadDeviceProfileRequest(reader io.Reader) ([]dto
 	return addDeviceProfiles, nil
 }
 
-// ReadDeviceProfileYaml reads and converts the request's YAML file into an DeviceProfile struct
-func (jsonDeviceProfileReader) ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX) {
+// ReadDeviceProfileYaml reads and converts the request's YAML file into an DeviceProfile struct, also returning the
+// YAML file as read
+func (jsonDeviceProfileReader) ReadDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, []byte, errors.EdgeX) {
 	var f multipart.File
 	f, _, err := r.FormFile("file")
 	if err != nil {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing yaml file", err)
+		return dtos.DeviceProfile{}, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "missing yaml file", err)
 	}
 
 	data, err := ioutil.ReadAll(f)
 	if err != nil {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindServerError, "failed to read yaml file", err)
+		return dtos.DeviceProfile{}, nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to read yaml file", err)
 	}
 	if len(data) == 0 {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "yaml file is empty", err)
+		return dtos.DeviceProfile{}, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "yaml file is empty", err)
 	}
 
 	var dp dtos.DeviceProfile
 
 	err = yaml.Unmarshal(data, &dp)
 	if err != nil {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal yaml file", err)
+		return dtos.DeviceProfile{}, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal yaml file", err)
 	}
 	err = v2.Validate(dp)
 	if err != nil {
-		return dtos.DeviceProfile{}, errors.NewCommonEdgeXWrapper(err)
+		return dtos.DeviceProfile{}, nil, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	return dp, nil
+	return dp, data, nil
 }
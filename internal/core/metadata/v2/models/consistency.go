@@ -0,0 +1,14 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DanglingReference is a device referencing a device profile or a device service which doesn't exist. The name of the
+// reference which does exist is left empty.
+type DanglingReference struct {
+	DeviceName  string
+	ProfileName string
+	ServiceName string
+}
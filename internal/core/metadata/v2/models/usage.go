@@ -0,0 +1,27 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceProfileUsage is how a device profile is used by the devices, to find the stale profiles
+type DeviceProfileUsage struct {
+	ProfileName string
+	// DeviceCounts is the number of devices using the profile by device service
+	DeviceCounts map[string]int
+	Devices      []DeviceUsage
+	// LastEventReceived is when the last event of any of the devices was received in milliseconds, 0 when none was
+	LastEventReceived int64
+	// UnusedDeviceCommands are the deviceCommands neither exposed by a coreCommand of the profile nor read by an
+	// AutoEvent of the devices
+	UnusedDeviceCommands []string
+}
+
+// DeviceUsage is a device using a device profile
+type DeviceUsage struct {
+	DeviceName  string
+	ServiceName string
+	// LastEventReceived is when the last event of the device was received in milliseconds, 0 when none was
+	LastEventReceived int64
+}
@@ -0,0 +1,159 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"net/http"
+
+	metadataDTOs "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/openapi"
+
+	v2Constant "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+)
+
+// contracts holds the DTOs exchanged by the core-metadata V2 routes, documented in the OpenAPI document. The YAML
+// upload and JSON Merge Patch routes don't accept a JSON DTO, so only their responses are documented, and the device
+// profile download route responds with a zip archive. The device profile bulk upload route also accepts a zip archive
+// of device profile files as the file form field.
+var contracts = openapi.Contracts{
+	// Device Profile
+	{Method: http.MethodPost, Path: v2Constant.ApiDeviceProfileRoute}: {
+		Request:    []requests.DeviceProfileRequest{},
+		Response:   []common.BaseWithIdResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodPut, Path: v2Constant.ApiDeviceProfileRoute}: {
+		Request:    []requests.DeviceProfileRequest{},
+		Response:   []common.BaseResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodPost, Path: v2Constant.ApiDeviceProfileUploadFileRoute}: {
+		Response:   common.BaseWithIdResponse{},
+		StatusCode: http.StatusCreated,
+	},
+	{Method: http.MethodPost, Path: ApiDeviceProfileBulkRoute}: {
+		Request:  []metadataDTOs.BulkDeviceProfile{},
+		Response: metadataDTOs.BulkDeviceProfileResponse{},
+	},
+	{Method: http.MethodPut, Path: v2Constant.ApiDeviceProfileUploadFileRoute}:     {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceProfileByNameRoute}:         {Response: responses.DeviceProfileResponse{}},
+	{Method: http.MethodPatch, Path: v2Constant.ApiDeviceProfileByNameRoute}:       {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: ApiDeviceProfileDownloadRoute}:                  {},
+	{Method: http.MethodGet, Path: ApiDeviceProfileUsageByNameRoute}:               {Response: metadataDTOs.DeviceProfileUsageResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceProfileByIdRoute}:        {Response: common.BaseResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceProfileByNameRoute}:      {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiAllDeviceProfileRoute}:            {Response: responses.MultiDeviceProfilesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceProfileRoute}:               {Response: responses.MultiDeviceProfilesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceProfileByModelRoute}:        {Response: responses.MultiDeviceProfilesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceProfileByManufacturerRoute}: {Response: responses.MultiDeviceProfilesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceProfileByManufacturerAndModelRoute}: {
+		Response: responses.MultiDeviceProfilesResponse{},
+	},
+
+	// Device Service
+	{Method: http.MethodPost, Path: v2Constant.ApiDeviceServiceRoute}: {
+		Request:    []requests.AddDeviceServiceRequest{},
+		Response:   []common.BaseWithIdResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodPatch, Path: v2Constant.ApiDeviceServiceRoute}: {
+		Request:    []requests.UpdateDeviceServiceRequest{},
+		Response:   []common.BaseResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceServiceByNameRoute}:    {Response: responses.DeviceServiceResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceServiceByIdRoute}:   {Response: common.BaseResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceServiceByNameRoute}: {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiAllDeviceServiceRoute}:       {Response: responses.MultiDeviceServicesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceServiceRoute}:          {Response: responses.MultiDeviceServicesResponse{}},
+
+	// Device
+	{Method: http.MethodPost, Path: v2Constant.ApiDeviceRoute}: {
+		Request:    []requests.AddDeviceRequest{},
+		Response:   []common.BaseWithIdResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodPatch, Path: v2Constant.ApiDeviceRoute}: {
+		Request:    []requests.UpdateDeviceRequest{},
+		Response:   []common.BaseResponse{},
+		StatusCode: http.StatusMultiStatus,
+	},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceByIdRoute}:       {Response: common.BaseResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceByNameRoute}:     {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceByServiceNameRoute}: {Response: responses.MultiDevicesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceIdExistsRoute}:      {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceNameExistsRoute}:    {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiAllDeviceRoute}:           {Response: responses.MultiDevicesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceRoute}:              {Response: responses.MultiDevicesResponse{}},
+	{Method: http.MethodDelete, Path: v2Constant.ApiDeviceRoute}:           {Response: metadataDTOs.DeleteDevicesResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceByNameRoute}:        {Response: responses.DeviceResponse{}},
+	{Method: http.MethodPatch, Path: v2Constant.ApiDeviceByNameRoute}:      {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: v2Constant.ApiDeviceByProfileNameRoute}: {Response: responses.MultiDevicesResponse{}},
+	{Method: http.MethodGet, Path: ApiDeviceByIndexRoute}:                  {Response: responses.MultiDevicesResponse{}},
+	{Method: http.MethodGet, Path: ApiDeviceByProtocolPropertyRoute}:       {Response: responses.MultiDevicesResponse{}},
+	{Method: http.MethodGet, Path: ApiDeviceProtocolSecretsByNameRoute}:    {Response: metadataDTOs.DeviceProtocolsResponse{}},
+	{Method: http.MethodPost, Path: devicemetrics.ApiDeviceMetricsByNameRoute}: {
+		Request:  devicemetrics.Counters{},
+		Response: common.BaseResponse{},
+	},
+	{Method: http.MethodGet, Path: devicemetrics.ApiDeviceMetricsByNameRoute}: {Response: metadataDTOs.DeviceMetricsResponse{}},
+	{Method: http.MethodGet, Path: ApiDeviceStateHistoryByNameRoute}:          {Response: metadataDTOs.DeviceStateHistoryResponse{}},
+	{Method: http.MethodGet, Path: twin.ApiDeviceTwinByNameRoute}:             {Response: metadataDTOs.DeviceTwinResponse{}},
+	{Method: http.MethodDelete, Path: twin.ApiDeviceTwinByNameRoute}:          {Response: common.BaseResponse{}},
+	{Method: http.MethodPut, Path: twin.ApiDeviceTwinDesiredByNameRoute}: {
+		Request:  twin.DesiredRequest{},
+		Response: metadataDTOs.DeviceTwinResponse{},
+	},
+	{Method: http.MethodPut, Path: twin.ApiDeviceTwinReportedByNameRoute}: {
+		Request:  twin.Report{},
+		Response: metadataDTOs.DeviceTwinResponse{},
+	},
+	{Method: http.MethodGet, Path: twin.ApiAllDeviceTwinsRoute}: {Response: metadataDTOs.MultiDeviceTwinsResponse{}},
+	{Method: http.MethodPost, Path: ApiDeviceDiscoveryRoute}: {
+		Response:   metadataDTOs.DiscoverySessionResponse{},
+		StatusCode: http.StatusAccepted,
+	},
+	{Method: http.MethodGet, Path: ApiDeviceDiscoverySessionByIdRoute}: {Response: metadataDTOs.DiscoverySessionResponse{}},
+
+	// Protocol Schema
+	{Method: http.MethodPut, Path: ApiProtocolSchemaByNameRoute}:    {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: ApiProtocolSchemaByNameRoute}:    {Response: metadataDTOs.ProtocolSchemaResponse{}},
+	{Method: http.MethodDelete, Path: ApiProtocolSchemaByNameRoute}: {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: ApiAllProtocolSchemaRoute}:       {Response: metadataDTOs.MultiProtocolSchemasResponse{}},
+
+	// Resource Mapping
+	{Method: http.MethodPut, Path: ApiResourceMappingRoute}: {
+		Request:  metadataDTOs.SetResourceMappingRequest{},
+		Response: common.BaseResponse{},
+	},
+	{Method: http.MethodGet, Path: ApiResourceMappingRoute}:              {Response: metadataDTOs.ResourceMappingResponse{}},
+	{Method: http.MethodDelete, Path: ApiResourceMappingRoute}:           {Response: common.BaseResponse{}},
+	{Method: http.MethodGet, Path: ApiResourceMappingByProfileNameRoute}: {Response: metadataDTOs.MultiResourceMappingsResponse{}},
+	{Method: http.MethodGet, Path: ApiResourceMappingByProtocolRoute}:    {Response: metadataDTOs.MultiResourceMappingsResponse{}},
+	{Method: http.MethodGet, Path: ApiResourceMappingSchemaRoute}:        {Response: metadataDTOs.ResourceMappingSchemaResponse{}},
+
+	// Label
+	{Method: http.MethodGet, Path: ApiAllLabelRoute}: {Response: metadataDTOs.MultiLabelUsageResponse{}},
+	{Method: http.MethodPatch, Path: ApiLabelByNameRoute}: {
+		Request:  metadataDTOs.RenameLabelRequest{},
+		Response: metadataDTOs.RenameLabelResponse{},
+	},
+
+	// Consistency
+	{Method: http.MethodGet, Path: ApiConsistencyRoute}: {Response: metadataDTOs.ConsistencyResponse{}},
+
+	// Profile Catalog
+	{Method: http.MethodPost, Path: ApiProfileCatalogSyncRoute}:                {Response: metadataDTOs.ProfileCatalogSyncResponse{}},
+	{Method: http.MethodGet, Path: ApiAllProfileCatalogUpdateRoute}:            {Response: metadataDTOs.MultiProfileCatalogUpdatesResponse{}},
+	{Method: http.MethodGet, Path: ApiProfileCatalogUpdateByNameRoute}:         {Response: metadataDTOs.ProfileCatalogUpdateResponse{}},
+	{Method: http.MethodPost, Path: ApiProfileCatalogUpdateApproveByNameRoute}: {Response: common.BaseResponse{}},
+	{Method: http.MethodPost, Path: ApiProfileCatalogUpdateRejectByNameRoute}:  {Response: common.BaseResponse{}},
+}
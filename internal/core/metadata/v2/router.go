@@ -3,16 +3,109 @@ package v2
 import (
 	"net/http"
 
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/application"
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/v2/controller/http"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
 	commonController "github.com/edgexfoundry/edgex-go/internal/pkg/v2/controller/http"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/openapi"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	v2Constant "github.com/edgexfoundry/go-mod-core-contracts/v2"
 
 	"github.com/gorilla/mux"
 )
 
+// ApiDeviceDiscoveryRoute triggers discovery on all device services, ApiDeviceDiscoverySessionByIdRoute reports the
+// devices discovered by each device service during the session
+const (
+	ApiDeviceDiscoveryRoute            = v2Constant.ApiDeviceRoute + "/discovery/" + v2Constant.All
+	ApiDeviceDiscoverySessionByIdRoute = v2Constant.ApiDeviceRoute + "/discovery/session/{" + v2Constant.Id + "}"
+)
+
+// ApiDeviceProfileDownloadRoute returns a zip archive of device profiles
+const ApiDeviceProfileDownloadRoute = v2Constant.ApiDeviceProfileRoute + "/download"
+
+// ApiDeviceProfileBulkRoute adds or updates several device profiles at once, all or none of them
+const ApiDeviceProfileBulkRoute = v2Constant.ApiDeviceProfileRoute + "/bulk"
+
+// ApiDeviceProfileUsageByNameRoute returns how the named device profile is used by the devices
+const ApiDeviceProfileUsageByNameRoute = v2Constant.ApiDeviceProfileByNameRoute + "/usage"
+
+// ApiDeviceCloneByNameRoute adds a new device copying the named device
+const ApiDeviceCloneByNameRoute = v2Constant.ApiDeviceByNameRoute + "/clone"
+
+// ApiDeviceProtocolSecretsByNameRoute returns the protocols of the named device with the secret protocol properties
+// resolved from the secret store
+const ApiDeviceProtocolSecretsByNameRoute = v2Constant.ApiDeviceByNameRoute + "/protocols/secrets"
+
+// ApiDeviceStateHistoryByNameRoute returns the transitions of the admin and operating states of the named device
+const ApiDeviceStateHistoryByNameRoute = v2Constant.ApiDeviceByNameRoute + "/statehistory"
+
+// ApiDeviceByIndexRoute returns the devices holding a value of a secondary index declared in the configuration
+const ApiDeviceByIndexRoute = v2Constant.ApiDeviceRoute + "/index/{" + metadataController.IndexVar + "}/{" + metadataController.IndexValueVar + "}"
+
+// ApiDeviceByProtocolPropertyRoute returns the devices whose property of a protocol holds a value, through the
+// secondary index declared on the property when there is one
+const ApiDeviceByProtocolPropertyRoute = v2Constant.ApiDeviceRoute + "/" + metadataController.ProtocolVar + "/{" + metadataController.ProtocolVar + "}/" +
+	metadataController.PropertyVar + "/{" + metadataController.PropertyVar + "}/" + metadataController.IndexValueVar + "/{" + metadataController.IndexValueVar + "}"
+
+// ApiProtocolSchemaByNameRoute registers, returns or deletes the JSON Schema the properties of a protocol are validated
+// against when a device is added or updated, ApiAllProtocolSchemaRoute returns the schemas of all protocols
+const (
+	ApiProtocolSchemaRoute       = v2Constant.ApiBase + "/protocolschema"
+	ApiProtocolSchemaByNameRoute = ApiProtocolSchemaRoute + "/" + v2Constant.Name + "/{" + v2Constant.Name + "}"
+	ApiAllProtocolSchemaRoute    = ApiProtocolSchemaRoute + "/" + v2Constant.All
+)
+
+// ApiAllLabelRoute returns every label in use with its usage counts, ApiLabelByNameRoute renames a label on all devices,
+// device profiles and device services carrying it
+const (
+	ApiLabelRoute       = v2Constant.ApiBase + "/" + v2Constant.Label
+	ApiAllLabelRoute    = ApiLabelRoute + "/" + v2Constant.All
+	ApiLabelByNameRoute = ApiLabelRoute + "/" + v2Constant.Name + "/{" + v2Constant.Name + "}"
+)
+
+// ApiAllTrashRoute returns the trashed devices or device profiles, ApiTrashByNameRoute returns or purges a trashed
+// object and ApiTrashRestoreByNameRoute restores it
+const (
+	ApiTrashRoute              = v2Constant.ApiBase + "/trash/{" + metadataController.TrashTypeVar + "}"
+	ApiAllTrashRoute           = ApiTrashRoute + "/" + v2Constant.All
+	ApiTrashByNameRoute        = ApiTrashRoute + "/" + v2Constant.Name + "/{" + v2Constant.Name + "}"
+	ApiTrashRestoreByNameRoute = ApiTrashByNameRoute + "/restore"
+)
+
+// ApiResourceMappingRoute sets, returns or deletes the mapping of a deviceResource of a device profile to the model of
+// a protocol, ApiResourceMappingByProfileNameRoute returns the mappings of a device profile and
+// ApiResourceMappingByProtocolRoute those of all device profiles to a protocol, optionally by attribute value.
+// ApiResourceMappingSchemaRoute returns the schema the mapping attributes of a protocol are validated against.
+const (
+	ApiResourceMappingBaseRoute          = v2Constant.ApiBase + "/resourcemapping"
+	ApiResourceMappingByProfileNameRoute = ApiResourceMappingBaseRoute + "/" + v2Constant.Profile + "/" + v2Constant.Name + "/{" + v2Constant.Name + "}"
+	ApiResourceMappingRoute              = ApiResourceMappingByProfileNameRoute + "/resource/{" + v2Constant.ResourceName + "}/" + metadataController.ProtocolVar + "/{" + metadataController.ProtocolVar + "}"
+	ApiResourceMappingByProtocolRoute    = ApiResourceMappingBaseRoute + "/" + metadataController.ProtocolVar + "/{" + metadataController.ProtocolVar + "}"
+	ApiResourceMappingSchemaRoute        = ApiResourceMappingBaseRoute + "/schema/" + metadataController.ProtocolVar + "/{" + metadataController.ProtocolVar + "}"
+)
+
+// ApiConsistencyRoute reports the devices referencing a device profile or a device service which doesn't exist
+const ApiConsistencyRoute = v2Constant.ApiBase + "/consistency"
+
+// ApiProfileCatalogSyncRoute pulls the profile catalog on demand, ApiAllProfileCatalogUpdateRoute returns the device
+// profile updates pulled from the catalog and ApiProfileCatalogUpdateByNameRoute the update of a device profile, which
+// is applied by ApiProfileCatalogUpdateApproveByNameRoute or rejected by ApiProfileCatalogUpdateRejectByNameRoute
+const (
+	ApiProfileCatalogRoute                    = v2Constant.ApiBase + "/profilecatalog"
+	ApiProfileCatalogSyncRoute                = ApiProfileCatalogRoute + "/sync"
+	ApiAllProfileCatalogUpdateRoute           = ApiProfileCatalogRoute + "/update/" + v2Constant.All
+	ApiProfileCatalogUpdateByNameRoute        = ApiProfileCatalogRoute + "/update/" + v2Constant.Name + "/{" + v2Constant.Name + "}"
+	ApiProfileCatalogUpdateApproveByNameRoute = ApiProfileCatalogUpdateByNameRoute + "/approve"
+	ApiProfileCatalogUpdateRejectByNameRoute  = ApiProfileCatalogUpdateByNameRoute + "/reject"
+)
+
 func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	// v2 API routes
 	// Common
@@ -21,6 +114,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiVersionRoute, cc.Version).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiConfigRoute, cc.Config).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiMetricsRoute, cc.Metrics).Methods(http.MethodGet)
+	r.HandleFunc(commonController.ApiReadyRoute, cc.Ready).Methods(http.MethodGet)
 
 	// Device Profile
 	dc := metadataController.NewDeviceProfileController(dic)
@@ -29,9 +123,14 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiDeviceProfileUploadFileRoute, dc.AddDeviceProfileByYaml).Methods(http.MethodPost)
 	r.HandleFunc(v2Constant.ApiDeviceProfileUploadFileRoute, dc.UpdateDeviceProfileByYaml).Methods(http.MethodPut)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByNameRoute, dc.DeviceProfileByName).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiDeviceProfileByNameRoute, dc.MergePatchDeviceProfileByName).Methods(http.MethodPatch)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByIdRoute, dc.DeleteDeviceProfileById).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByNameRoute, dc.DeleteDeviceProfileByName).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiAllDeviceProfileRoute, dc.AllDeviceProfiles).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceProfileDownloadRoute, dc.DownloadDeviceProfiles).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceProfileBulkRoute, dc.UploadDeviceProfiles).Methods(http.MethodPost)
+	r.HandleFunc(ApiDeviceProfileUsageByNameRoute, dc.DeviceProfileUsageByName).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiDeviceProfileRoute, dc.DeviceProfilesModifiedSince).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByModelRoute, dc.DeviceProfilesByModel).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByManufacturerRoute, dc.DeviceProfilesByManufacturer).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceProfileByManufacturerAndModelRoute, dc.DeviceProfilesByManufacturerAndModel).Methods(http.MethodGet)
@@ -44,6 +143,7 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiDeviceServiceByIdRoute, ds.DeleteDeviceServiceById).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiDeviceServiceByNameRoute, ds.DeleteDeviceServiceByName).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiAllDeviceServiceRoute, ds.AllDeviceServices).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiDeviceServiceRoute, ds.DeviceServicesModifiedSince).Methods(http.MethodGet)
 
 	// Device
 	d := metadataController.NewDeviceController(dic)
@@ -55,10 +155,74 @@ func LoadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(v2Constant.ApiDeviceNameExistsRoute, d.DeviceNameExists).Methods(http.MethodGet)
 	r.HandleFunc(v2Constant.ApiDeviceRoute, d.PatchDevice).Methods(http.MethodPatch)
 	r.HandleFunc(v2Constant.ApiAllDeviceRoute, d.AllDevices).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiDeviceRoute, d.DevicesModifiedSince).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiDeviceRoute, d.DeleteDevices).Methods(http.MethodDelete)
 	r.HandleFunc(v2Constant.ApiDeviceByNameRoute, d.DeviceByName).Methods(http.MethodGet)
+	r.HandleFunc(v2Constant.ApiDeviceByNameRoute, d.MergePatchDeviceByName).Methods(http.MethodPatch)
 	r.HandleFunc(v2Constant.ApiDeviceByProfileNameRoute, d.DevicesByProfileName).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceByIndexRoute, d.DevicesByIndex).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceByProtocolPropertyRoute, d.DevicesByProtocolProperty).Methods(http.MethodGet)
+	r.HandleFunc(devicemetrics.ApiDeviceMetricsByNameRoute, d.AddDeviceMetrics).Methods(http.MethodPost)
+	r.HandleFunc(devicemetrics.ApiDeviceMetricsByNameRoute, d.DeviceMetricsByName).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceStateHistoryByNameRoute, d.DeviceStateHistoryByName).Methods(http.MethodGet)
+	r.HandleFunc(twin.ApiDeviceTwinByNameRoute, d.DeviceTwinByName).Methods(http.MethodGet)
+	r.HandleFunc(twin.ApiDeviceTwinByNameRoute, d.DeleteDeviceTwinByName).Methods(http.MethodDelete)
+	r.HandleFunc(twin.ApiDeviceTwinDesiredByNameRoute, d.SetDeviceTwinDesired).Methods(http.MethodPut)
+	r.HandleFunc(twin.ApiDeviceTwinReportedByNameRoute, d.ReportDeviceTwin).Methods(http.MethodPut)
+	r.HandleFunc(twin.ApiAllDeviceTwinsRoute, d.AllDeviceTwins).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceCloneByNameRoute, d.CloneDeviceByName).Methods(http.MethodPost)
+	r.HandleFunc(ApiDeviceProtocolSecretsByNameRoute, d.DeviceProtocolSecretsByName).Methods(http.MethodGet)
+	r.HandleFunc(ApiDeviceDiscoveryRoute, d.TriggerDiscovery).Methods(http.MethodPost)
+	r.HandleFunc(ApiDeviceDiscoverySessionByIdRoute, d.DiscoverySessionById).Methods(http.MethodGet)
+
+	// Protocol Schema
+	ps := metadataController.NewProtocolSchemaController(dic)
+	r.HandleFunc(ApiProtocolSchemaByNameRoute, ps.SetProtocolSchema).Methods(http.MethodPut)
+	r.HandleFunc(ApiProtocolSchemaByNameRoute, ps.ProtocolSchemaByName).Methods(http.MethodGet)
+	r.HandleFunc(ApiProtocolSchemaByNameRoute, ps.DeleteProtocolSchemaByName).Methods(http.MethodDelete)
+	r.HandleFunc(ApiAllProtocolSchemaRoute, ps.AllProtocolSchemas).Methods(http.MethodGet)
+
+	// Resource Mapping
+	rm := metadataController.NewResourceMappingController(dic)
+	r.HandleFunc(ApiResourceMappingRoute, rm.SetResourceMapping).Methods(http.MethodPut)
+	r.HandleFunc(ApiResourceMappingRoute, rm.ResourceMapping).Methods(http.MethodGet)
+	r.HandleFunc(ApiResourceMappingRoute, rm.DeleteResourceMapping).Methods(http.MethodDelete)
+	r.HandleFunc(ApiResourceMappingByProfileNameRoute, rm.ResourceMappingsByProfileName).Methods(http.MethodGet)
+	r.HandleFunc(ApiResourceMappingByProtocolRoute, rm.ResourceMappingsByProtocol).Methods(http.MethodGet)
+	r.HandleFunc(ApiResourceMappingSchemaRoute, rm.ResourceMappingSchema).Methods(http.MethodGet)
+
+	// Label
+	lb := metadataController.NewLabelController(dic)
+	r.HandleFunc(ApiAllLabelRoute, lb.AllLabels).Methods(http.MethodGet)
+	r.HandleFunc(ApiLabelByNameRoute, lb.RenameLabel).Methods(http.MethodPatch)
+
+	// Trash
+	tr := metadataController.NewTrashController(dic)
+	r.HandleFunc(ApiAllTrashRoute, tr.AllTrashedObjects).Methods(http.MethodGet)
+	r.HandleFunc(ApiTrashByNameRoute, tr.TrashedObjectByName).Methods(http.MethodGet)
+	r.HandleFunc(ApiTrashByNameRoute, tr.DeleteTrashedObjectByName).Methods(http.MethodDelete)
+	r.HandleFunc(ApiTrashRestoreByNameRoute, tr.RestoreTrashedObjectByName).Methods(http.MethodPost)
+
+	// Consistency
+	cs := metadataController.NewConsistencyController(dic)
+	r.HandleFunc(ApiConsistencyRoute, cs.DanglingReferences).Methods(http.MethodGet)
+
+	// Profile Catalog
+	pc := metadataController.NewProfileCatalogController(dic)
+	r.HandleFunc(ApiProfileCatalogSyncRoute, pc.SyncProfileCatalog).Methods(http.MethodPost)
+	r.HandleFunc(ApiAllProfileCatalogUpdateRoute, pc.AllProfileCatalogUpdates).Methods(http.MethodGet)
+	r.HandleFunc(ApiProfileCatalogUpdateByNameRoute, pc.ProfileCatalogUpdateByName).Methods(http.MethodGet)
+	r.HandleFunc(ApiProfileCatalogUpdateApproveByNameRoute, pc.ApproveProfileCatalogUpdate).Methods(http.MethodPost)
+	r.HandleFunc(ApiProfileCatalogUpdateRejectByNameRoute, pc.RejectProfileCatalogUpdate).Methods(http.MethodPost)
+
+	// Error codes
+	errorcode.LoadRestRoutes(r, dic, metadataController.ErrorCodes)
+
+	// OpenAPI
+	openapi.LoadRestRoutes(r, dic, clients.CoreMetaDataServiceKey, contracts, metadataContainer.ConfigurationFrom(dic.Get).OpenAPI)
 
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(application.RegistrationTokenMiddleware)
 }
@@ -0,0 +1,145 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package bodylimit rejects the requests whose body exceeds the size configured for the service or the route with
+// 413 Request Entity Too Large, before the handlers read the body.
+package bodylimit
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// LimitsInfo configures the maximum size, in bytes, of the request bodies of a service. A size of 0 leaves the bodies
+// unlimited.
+type LimitsInfo struct {
+	// MaxBodySize applies to the request bodies of every route without a limit of its own
+	MaxBodySize int64
+	// MaxBinaryBodySize applies instead of MaxBodySize to the binary request bodies, i.e. CBOR events
+	MaxBinaryBodySize int64
+	// Routes overrides the limits of the service for some routes, by name
+	Routes map[string]RouteLimitsInfo
+}
+
+// RouteLimitsInfo configures the maximum size, in bytes, of the request bodies of a route. A size of 0 falls back on
+// the limit of the service.
+type RouteLimitsInfo struct {
+	// Route is the template of the route, i.e. "/api/v1/event"
+	Route             string
+	MaxBodySize       int64
+	MaxBinaryBodySize int64
+}
+
+// binaryContentTypes are the media types of the request bodies limited by MaxBinaryBodySize
+var binaryContentTypes = map[string]bool{
+	clients.ContentTypeCBOR:    true,
+	"application/octet-stream": true,
+}
+
+// Limit returns the maximum size of the body of the request to the route template with the content type, 0 when
+// unlimited
+func (l LimitsInfo) Limit(route string, contentType string) int64 {
+	binary := isBinary(contentType)
+	for _, routeLimits := range l.Routes {
+		if routeLimits.Route != route {
+			continue
+		}
+		if binary && routeLimits.MaxBinaryBodySize > 0 {
+			return routeLimits.MaxBinaryBodySize
+		}
+		if !binary && routeLimits.MaxBodySize > 0 {
+			return routeLimits.MaxBodySize
+		}
+		break
+	}
+	if binary && l.MaxBinaryBodySize > 0 {
+		return l.MaxBinaryBodySize
+	}
+	return l.MaxBodySize
+}
+
+func isBinary(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && binaryContentTypes[mediaType]
+}
+
+// NewMiddleware returns a middleware rejecting the requests whose body exceeds the limits with 413 Request Entity Too
+// Large. The body of a request of unknown length is read up to the limit before the request is passed on.
+func NewMiddleware(limits LimitsInfo, lc logger.LoggingClient) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := routeOf(r)
+			limit := limits.Limit(route, r.Header.Get(clients.ContentType))
+			if limit <= 0 || r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			size := r.ContentLength
+			if size > limit {
+				tooLarge(w, r, route, size, limit, lc)
+				return
+			}
+			if size < 0 {
+				// Chunked bodies don't declare their length, so read one byte over the limit to tell.
+				body, err := ioutil.ReadAll(io.LimitReader(r.Body, limit+1))
+				if err != nil {
+					http.Error(w, "unable to read the request body", http.StatusBadRequest)
+					return
+				}
+				if int64(len(body)) > limit {
+					tooLarge(w, r, route, size, limit, lc)
+					return
+				}
+				_ = r.Body.Close()
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				r.ContentLength = int64(len(body))
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// tooLarge responds 413 Request Entity Too Large naming the limit of the route
+func tooLarge(w http.ResponseWriter, r *http.Request, route string, size int64, limit int64, lc logger.LoggingClient) {
+	message := fmt.Sprintf("request body exceeds the limit of %d bytes for %s %s", limit, r.Method, route)
+	if size > 0 {
+		message = fmt.Sprintf("request body of %d bytes exceeds the limit of %d bytes for %s %s", size, limit, r.Method, route)
+	}
+	lc.Warn(message, clients.CorrelationHeader, r.Header.Get(clients.CorrelationHeader))
+	// Don't let the server read the rest of the body to reuse the connection.
+	w.Header().Set("Connection", "close")
+	http.Error(w, message, http.StatusRequestEntityTooLarge)
+}
+
+// routeOf returns the template of the route the request matched, so that a limit applies to the route whatever its
+// path variables
+func routeOf(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
+}
@@ -0,0 +1,107 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package bodylimit
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLimits = LimitsInfo{
+	MaxBodySize:       16,
+	MaxBinaryBodySize: 64,
+	Routes: map[string]RouteLimitsInfo{
+		"Event": {Route: "/api/v1/event/{id}", MaxBinaryBodySize: 32},
+	},
+}
+
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		route       string
+		contentType string
+		expected    int64
+	}{
+		{"service limit", "/api/v1/device", clients.ContentTypeJSON, 16},
+		{"service binary limit", "/api/v1/device", clients.ContentTypeCBOR, 64},
+		{"octet-stream is binary", "/api/v1/device", "application/octet-stream", 64},
+		{"route binary limit", "/api/v1/event/{id}", clients.ContentTypeCBOR + "; charset=binary", 32},
+		{"route falls back on service limit", "/api/v1/event/{id}", clients.ContentTypeJSON, 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, testLimits.Limit(tt.route, tt.contentType))
+		})
+	}
+	assert.Equal(t, int64(0), LimitsInfo{}.Limit("/api/v1/device", clients.ContentTypeJSON), "no limit configured")
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		path           string
+		contentType    string
+		body           string
+		chunked        bool
+		expectedStatus int
+	}{
+		{"under the limit", "/api/v1/device", clients.ContentTypeJSON, strings.Repeat("a", 16), false, http.StatusOK},
+		{"over the limit", "/api/v1/device", clients.ContentTypeJSON, strings.Repeat("a", 17), false, http.StatusRequestEntityTooLarge},
+		{"chunked under the limit", "/api/v1/device", clients.ContentTypeJSON, strings.Repeat("a", 16), true, http.StatusOK},
+		{"chunked over the limit", "/api/v1/device", clients.ContentTypeJSON, strings.Repeat("a", 17), true, http.StatusRequestEntityTooLarge},
+		{"binary under the route limit", "/api/v1/event/1", clients.ContentTypeCBOR, strings.Repeat("a", 32), false, http.StatusOK},
+		{"binary over the route limit", "/api/v1/event/1", clients.ContentTypeCBOR, strings.Repeat("a", 33), false, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received string
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				received = string(body)
+			}
+			router := mux.NewRouter()
+			router.HandleFunc("/api/v1/device", handler).Methods(http.MethodPost)
+			router.HandleFunc("/api/v1/event/{id}", handler).Methods(http.MethodPost)
+			router.Use(NewMiddleware(testLimits, logger.NewMockClient()))
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(clients.ContentType, tt.contentType)
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, req)
+
+			require.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.body, received)
+				return
+			}
+			assert.Empty(t, received, "the handler must not be called")
+			assert.Contains(t, recorder.Body.String(), "exceeds the limit")
+		})
+	}
+}
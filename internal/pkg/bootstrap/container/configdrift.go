@@ -0,0 +1,29 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// ConfigDriftDetectorName contains the name of the configdrift.Detector implementation in the DIC.
+var ConfigDriftDetectorName = di.TypeInstanceToName((*configdrift.Detector)(nil))
+
+// ConfigDriftDetectorFrom helper function queries the DIC and returns the configdrift.Detector implementation.
+func ConfigDriftDetectorFrom(get di.Get) *configdrift.Detector {
+	return get(ConfigDriftDetectorName).(*configdrift.Detector)
+}
@@ -0,0 +1,35 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// FeatureFlagsName contains the name of the featureflag.Flags implementation in the DIC.
+var FeatureFlagsName = di.TypeInstanceToName((*featureflag.Flags)(nil))
+
+// FeatureFlagsFrom helper function queries the DIC and returns the featureflag.Flags implementation, nil when the
+// service has no feature flags, which are all disabled then.
+func FeatureFlagsFrom(get di.Get) *featureflag.Flags {
+	flags, ok := get(FeatureFlagsName).(*featureflag.Flags)
+	if !ok {
+		return nil
+	}
+	return flags
+}
@@ -0,0 +1,99 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package dependency
+
+import (
+	"sync"
+	"time"
+)
+
+// Names of the dependencies a service may declare, in the order they are checked at startup
+const (
+	SecretStore = "SecretStore"
+	Registry    = "Registry"
+	Database    = "Database"
+	MessageBus  = "MessageBus"
+)
+
+// Order lists the dependencies in the order they are checked at startup: the secret store holds the credentials of
+// the database and message bus, and the registry may hold their location.
+var Order = []string{SecretStore, Registry, Database, MessageBus}
+
+// DependencyInfo declares a dependency of a service and its retry budget
+type DependencyInfo struct {
+	// Hard dependencies must be reachable for the service to start and to report ready. Soft dependencies are only
+	// reported.
+	Hard bool
+	// Attempts is the number of checks of a hard dependency at startup before the service gives up, 0 relies on the
+	// startup timer only
+	Attempts int
+	// RetryInterval is the time between checks of the dependency, at startup and while running, i.e. "1s"
+	RetryInterval string
+}
+
+// Status is the last known status of a dependency
+type Status struct {
+	Name      string `json:"name"`
+	Hard      bool   `json:"hard"`
+	Satisfied bool   `json:"satisfied"`
+	// Checked is the time in milliseconds of the last check
+	Checked   int64  `json:"checked"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Tracker keeps the status of the dependencies of a service
+type Tracker struct {
+	mutex    sync.RWMutex
+	statuses []Status
+}
+
+// NewTracker returns a tracker of no dependencies, which is always ready
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// Update records the result of a check of the named dependency
+func (t *Tracker) Update(name string, hard bool, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	status := Status{Name: name, Hard: hard, Satisfied: err == nil, Checked: time.Now().UnixNano() / int64(time.Millisecond)}
+	if err != nil {
+		status.LastError = err.Error()
+	}
+	for i := range t.statuses {
+		if t.statuses[i].Name == name {
+			t.statuses[i] = status
+			return
+		}
+	}
+	t.statuses = append(t.statuses, status)
+}
+
+// Ready returns whether all the hard dependencies are satisfied, along with the status of every dependency
+func (t *Tracker) Ready() (bool, []Status) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	ready := true
+	statuses := make([]Status, len(t.statuses))
+	for i, status := range t.statuses {
+		statuses[i] = status
+		if status.Hard && !status.Satisfied {
+			ready = false
+		}
+	}
+	return ready, statuses
+}
@@ -0,0 +1,149 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package configdrift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/config"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/environment"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-configuration/configuration"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// ConfigDrift contains references to dependencies required by the configuration drift bootstrap implementation.
+type ConfigDrift struct {
+	flags         flags.Common
+	serviceKey    string
+	configStem    string
+	configuration interfaces.ConfigDrift
+}
+
+// NewConfigDrift is a factory method that returns an initialized ConfigDrift receiver struct. The flags, service key
+// and configuration stem are those the configuration was loaded with.
+func NewConfigDrift(
+	flags flags.Common,
+	serviceKey string,
+	configStem string,
+	configuration interfaces.ConfigDrift) ConfigDrift {
+
+	return ConfigDrift{
+		flags:         flags,
+		serviceKey:    serviceKey,
+		configStem:    configStem,
+		configuration: configuration,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract and adds the drift detector of the configuration to the
+// DIC. The detector reads the configuration file and the registry the configuration was loaded from, and applies the
+// environment variables the same way. When a check interval is configured the reload sections are reloaded from the
+// registry periodically.
+func (c ConfigDrift) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	info := c.configuration.GetConfigDriftInfo()
+
+	// The environment variables were logged already when the configuration was loaded
+	envVars := environment.NewVariables(logger.NewMockClient())
+	file := environment.GetConfDir(lc, c.flags.ConfigDirectory()) + "/" +
+		environment.GetProfileDir(lc, c.flags.Profile()) +
+		environment.GetConfigFileName(lc, c.flags.ConfigFileName())
+
+	var registryPath string
+	var registry configdrift.Source
+	providerInfo, err := bootstrapConfig.NewProviderInfo(envVars, c.flags.ConfigProviderUrl())
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	if providerInfo.UseProvider() {
+		providerConfig := providerInfo.ServiceConfig()
+		providerConfig.BasePath = c.configStem + c.serviceKey
+		client, err := configuration.NewConfigurationClient(providerConfig)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to create the configuration provider client: %s", err.Error()))
+			return false
+		}
+		registryPath = providerConfig.BasePath
+		registry = client.GetConfiguration
+	}
+
+	detector, err := configdrift.NewDetector(
+		c.configuration,
+		file,
+		registryPath,
+		registry,
+		envVars.OverrideConfiguration,
+		info.ReloadSections)
+	if err != nil {
+		lc.Error(fmt.Sprintf("invalid ConfigDrift ReloadSections: %s", err.Error()))
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigDriftDetectorName: func(get di.Get) interface{} {
+			return detector
+		},
+	})
+
+	if info.CheckInterval == "" || registry == nil || len(info.ReloadSections) == 0 {
+		return true
+	}
+	interval, err := time.ParseDuration(info.CheckInterval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid ConfigDrift CheckInterval '%s'", info.CheckInterval))
+		return false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reloaded, err := detector.Reload()
+				if err != nil {
+					lc.Warn(fmt.Sprintf("failed to reload the drifted configuration: %s", err.Error()))
+				}
+				if len(reloaded) > 0 {
+					lc.Info(fmt.Sprintf("Reloaded configuration sections %s from the registry", strings.Join(reloaded, ", ")))
+				}
+			}
+		}
+	}()
+
+	return true
+}
@@ -67,15 +67,19 @@ func NewDatabaseForCoreData(httpServer httpServer, database interfaces.Database)
 // Return the dbClient interface
 func (d Database) newDBClient(
 	lc logger.LoggingClient,
-	credentials bootstrapConfig.Credentials) (dbInterfaces.DBClient, error) {
+	credentials bootstrapConfig.Credentials,
+	encryptionKeys map[string][]byte,
+	encryptionKeyId string) (dbInterfaces.DBClient, error) {
 
 	databaseInfo := d.database.GetDatabaseInfo()["Primary"]
 	switch databaseInfo.Type {
 	case db.RedisDB:
 		conf := db.Configuration{
-			Host:     databaseInfo.Host,
-			Port:     databaseInfo.Port,
-			Password: credentials.Password,
+			Host:            databaseInfo.Host,
+			Port:            databaseInfo.Port,
+			Password:        credentials.Password,
+			EncryptionKeys:  encryptionKeys,
+			EncryptionKeyId: encryptionKeyId,
 		}
 
 		if d.isCoreData {
@@ -116,11 +120,16 @@ func (d Database) BootstrapHandler(
 		startupTimer.SleepForInterval()
 	}
 
+	encryptionKeys, encryptionKeyId, ok := EncryptionKeys(d.database, secretProvider, startupTimer, lc)
+	if !ok {
+		return false
+	}
+
 	// initialize database.
 	var dbClient dbInterfaces.DBClient
 	for startupTimer.HasNotElapsed() {
 		var err error
-		dbClient, err = d.newDBClient(lc, credentials)
+		dbClient, err = d.newDBClient(lc, credentials, encryptionKeys, encryptionKeyId)
 		if err == nil {
 			break
 		}
@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package database
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// EncryptionKeys retrieves the payload encryption keys from the secret provider when the service's configuration
+// enables the encryption. It returns nil keys when the encryption isn't enabled and false when the keys couldn't be
+// retrieved in the allotted time or are invalid.
+func EncryptionKeys(
+	database interfaces.Database,
+	secretProvider bootstrapInterfaces.SecretProvider,
+	startupTimer startup.Timer,
+	lc logger.LoggingClient) (map[string][]byte, string, bool) {
+
+	encryption, ok := database.(interfaces.DatabaseEncryption)
+	if !ok || !encryption.GetDatabaseEncryptionInfo().Enabled {
+		return nil, "", true
+	}
+
+	info := encryption.GetDatabaseEncryptionInfo()
+	for startupTimer.HasNotElapsed() {
+		secrets, err := secretProvider.GetSecrets(info.SecretPath)
+		if err == nil {
+			keys, err := db.EncryptionKeys(info, secrets)
+			if err != nil {
+				lc.Error(fmt.Sprintf("invalid database encryption keys: %v", err.Error()))
+				return nil, "", false
+			}
+			lc.Info(fmt.Sprintf("Database payloads encrypted with key '%s'", info.KeyId))
+			return keys, info.KeyId, true
+		}
+
+		lc.Warn(fmt.Sprintf("couldn't retrieve database encryption keys: %v", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+
+	lc.Error("failed to retrieve database encryption keys in allotted time")
+	return nil, "", false
+}
@@ -0,0 +1,227 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package dependency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/dependency"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// dialTimeout bounds the time a dependency is given to accept a connection
+const dialTimeout = 2 * time.Second
+
+// checkFunc checks a dependency is reachable
+type checkFunc func() error
+
+// Dependencies contains references to dependencies required by the dependency bootstrap implementation.
+type Dependencies struct {
+	configuration interfaces.Dependencies
+}
+
+// NewDependencies is a factory method that returns an initialized Dependencies receiver struct.
+func NewDependencies(configuration interfaces.Dependencies) Dependencies {
+	return Dependencies{configuration: configuration}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It waits for the hard dependencies declared by the
+// service, in dependency.Order, within their retry budget and fails the startup when one can't be reached. Soft
+// dependencies are checked once. All the dependencies are then monitored to report the readiness of the service.
+func (d Dependencies) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	declared := d.configuration.GetDependencyInfo()
+	for name := range declared {
+		if !isKnown(name) {
+			lc.Error(fmt.Sprintf("unknown dependency '%s', expected one of %v", name, dependency.Order))
+			return false
+		}
+	}
+
+	tracker := dependency.NewTracker()
+	for _, name := range dependency.Order {
+		info, ok := declared[name]
+		if !ok {
+			continue
+		}
+		interval, err := time.ParseDuration(info.RetryInterval)
+		if err != nil || interval <= 0 {
+			lc.Error(fmt.Sprintf("invalid RetryInterval '%s' of dependency %s", info.RetryInterval, name))
+			return false
+		}
+		check := d.check(name, dic)
+		if check == nil {
+			lc.Info(fmt.Sprintf("dependency %s isn't used by this deployment, skipping it", name))
+			continue
+		}
+
+		if !waitFor(name, info, interval, check, tracker, startupTimer, lc) {
+			return false
+		}
+
+		wg.Add(1)
+		go func(name string, hard bool, interval time.Duration, check checkFunc) {
+			defer wg.Done()
+			monitor(ctx, name, hard, interval, check, tracker, lc)
+		}(name, info.Hard, interval, check)
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		container.DependencyTrackerName: func(get di.Get) interface{} {
+			return tracker
+		},
+	})
+	return true
+}
+
+// waitFor checks the dependency until it is reachable. It returns false when a hard dependency can't be reached
+// within its retry budget.
+func waitFor(
+	name string,
+	info dependency.DependencyInfo,
+	interval time.Duration,
+	check checkFunc,
+	tracker *dependency.Tracker,
+	startupTimer startup.Timer,
+	lc logger.LoggingClient) bool {
+
+	for attempt := 1; ; attempt++ {
+		err := check()
+		tracker.Update(name, info.Hard, err)
+		if err == nil {
+			lc.Info(fmt.Sprintf("dependency %s is reachable", name))
+			return true
+		}
+		if !info.Hard {
+			lc.Warn(fmt.Sprintf("soft dependency %s isn't reachable, starting without it: %s", name, err.Error()))
+			return true
+		}
+		if (info.Attempts > 0 && attempt >= info.Attempts) || !startupTimer.HasNotElapsed() {
+			lc.Error(fmt.Sprintf("hard dependency %s isn't reachable after %d attempt(s): %s", name, attempt, err.Error()))
+			return false
+		}
+		lc.Warn(fmt.Sprintf("waiting for hard dependency %s: %s", name, err.Error()))
+		time.Sleep(interval)
+	}
+}
+
+// monitor re-checks the dependency every interval until the service stops, logging its changes of status
+func monitor(
+	ctx context.Context,
+	name string,
+	hard bool,
+	interval time.Duration,
+	check checkFunc,
+	tracker *dependency.Tracker,
+	lc logger.LoggingClient) {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	satisfied := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := check()
+			tracker.Update(name, hard, err)
+			if err != nil && satisfied {
+				lc.Warn(fmt.Sprintf("dependency %s became unreachable: %s", name, err.Error()))
+			} else if err == nil && !satisfied {
+				lc.Info(fmt.Sprintf("dependency %s is reachable again", name))
+			}
+			satisfied = err == nil
+		}
+	}
+}
+
+// check returns the check of the named dependency, nil when the deployment doesn't use it
+func (d Dependencies) check(name string, dic *di.Container) checkFunc {
+	switch name {
+	case dependency.SecretStore:
+		if !secret.IsSecurityEnabled() {
+			return nil
+		}
+		secretStore := d.configuration.GetBootstrap().SecretStore
+		return dialCheck(secretStore.Host, secretStore.Port)
+	case dependency.Registry:
+		registryClient := bootstrapContainer.RegistryFrom(dic.Get)
+		if registryClient == nil {
+			return nil
+		}
+		return func() error {
+			if !registryClient.IsAlive() {
+				return errors.New("registry isn't alive")
+			}
+			return nil
+		}
+	case dependency.Database:
+		database, ok := d.configuration.(interfaces.Database)
+		if !ok {
+			return nil
+		}
+		primary := database.GetDatabaseInfo()["Primary"]
+		return dialCheck(primary.Host, primary.Port)
+	case dependency.MessageBus:
+		messageBus, ok := d.configuration.(interfaces.MessageBus)
+		// a service binding the message bus, i.e. a ZeroMQ publisher, doesn't depend on a broker
+		if !ok || messageBus.GetMessageBusInfo().Host == "*" {
+			return nil
+		}
+		host := messageBus.GetMessageBusInfo()
+		return dialCheck(host.Host, host.Port)
+	}
+	return nil
+}
+
+// dialCheck checks a TCP connection to the host and port can be opened
+func dialCheck(host string, port int) checkFunc {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	return func() error {
+		conn, err := net.DialTimeout("tcp", address, dialTimeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
+
+func isKnown(name string) bool {
+	for _, known := range dependency.Order {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
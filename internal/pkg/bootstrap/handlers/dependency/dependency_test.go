@@ -0,0 +1,155 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package dependency
+
+import (
+	"context"
+	"net"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/dependency"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unreachablePort returns a local port nothing listens on
+func unreachablePort(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+	return port
+}
+
+func TestBootstrapHandler(t *testing.T) {
+	_ = os.Setenv(secret.EnvSecretStore, "false")
+	defer os.Unsetenv(secret.EnvSecretStore)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	reachable := listener.Addr().(*net.TCPAddr).Port
+	unreachable := unreachablePort(t)
+
+	tests := []struct {
+		name          string
+		dependencies  map[string]dependency.DependencyInfo
+		databasePort  int
+		messageBus    string
+		expectedOk    bool
+		expectedReady bool
+	}{
+		{
+			"Hard dependency reachable",
+			map[string]dependency.DependencyInfo{dependency.Database: {Hard: true, Attempts: 2, RetryInterval: "10ms"}},
+			reachable, "*", true, true,
+		},
+		{
+			"Hard dependency unreachable",
+			map[string]dependency.DependencyInfo{dependency.Database: {Hard: true, Attempts: 2, RetryInterval: "10ms"}},
+			unreachable, "*", false, false,
+		},
+		{
+			"Soft dependency unreachable",
+			map[string]dependency.DependencyInfo{
+				dependency.Database:   {Hard: true, Attempts: 2, RetryInterval: "10ms"},
+				dependency.MessageBus: {Hard: false, RetryInterval: "10ms"},
+			},
+			reachable, "127.0.0.1", true, true,
+		},
+		{
+			"Dependencies not used by the deployment",
+			map[string]dependency.DependencyInfo{
+				dependency.SecretStore: {Hard: true, RetryInterval: "10ms"},
+				dependency.Registry:    {Hard: true, RetryInterval: "10ms"},
+				dependency.MessageBus:  {Hard: true, RetryInterval: "10ms"},
+			},
+			unreachable, "*", true, true,
+		},
+		{
+			"Unknown dependency",
+			map[string]dependency.DependencyInfo{"Cache": {Hard: true, RetryInterval: "10ms"}},
+			reachable, "*", false, false,
+		},
+		{
+			"Invalid retry interval",
+			map[string]dependency.DependencyInfo{dependency.Database: {Hard: true, RetryInterval: "soon"}},
+			reachable, "*", false, false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configuration := &config.ConfigurationStruct{
+				Dependencies: tt.dependencies,
+				Databases:    map[string]bootstrapConfig.Database{"Primary": {Host: "127.0.0.1", Port: tt.databasePort}},
+				MessageQueue: config.MessageQueueInfo{Host: tt.messageBus, Port: unreachable},
+			}
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			wg := &sync.WaitGroup{}
+			ok := NewDependencies(configuration).BootstrapHandler(ctx, wg, startup.NewTimer(1, 1), dic)
+			cancel()
+			wg.Wait()
+
+			require.Equal(t, tt.expectedOk, ok)
+			if !ok {
+				return
+			}
+			ready, statuses := container.DependencyTrackerFrom(dic.Get).Ready()
+			assert.Equal(t, tt.expectedReady, ready)
+			for _, status := range statuses {
+				if status.Name == dependency.MessageBus {
+					assert.False(t, status.Satisfied)
+					assert.NotEmpty(t, status.LastError)
+				}
+			}
+		})
+	}
+}
+
+func TestTrackerReady(t *testing.T) {
+	tracker := dependency.NewTracker()
+	ready, _ := tracker.Ready()
+	assert.True(t, ready, "a service without dependencies is ready")
+
+	tracker.Update(dependency.Database, true, nil)
+	tracker.Update(dependency.MessageBus, false, net.UnknownNetworkError("down"))
+	ready, statuses := tracker.Ready()
+	assert.True(t, ready, "soft dependencies don't gate readiness")
+	assert.Len(t, statuses, 2)
+
+	tracker.Update(dependency.Database, true, net.UnknownNetworkError("down"))
+	ready, statuses = tracker.Ready()
+	assert.False(t, ready)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "unknown network down", statuses[0].LastError)
+}
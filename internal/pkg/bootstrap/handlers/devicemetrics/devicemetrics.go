@@ -0,0 +1,75 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package devicemetrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// Reporting contains references to dependencies required by the device metrics bootstrap implementation.
+type Reporting struct {
+	configuration interfaces.DeviceMetrics
+}
+
+// NewReporting is a factory method that returns an initialized Reporting receiver struct.
+func NewReporting(configuration interfaces.DeviceMetrics) Reporting {
+	return Reporting{configuration: configuration}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When the reporting of device activity is enabled, it adds
+// the reporter counting the activity to the DIC and reports the activity to core-metadata every flush interval until
+// the service is exiting.
+func (r Reporting) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	info, metadata := r.configuration.GetDeviceMetricsInfo()
+	if !info.Enabled {
+		return true
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	interval, err := time.ParseDuration(info.FlushInterval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid DeviceMetrics FlushInterval '%s'", info.FlushInterval))
+		return false
+	}
+
+	urlClient := endpoints.NewURLClient(container.EndpointsRegistryFrom(dic.Get), "Metadata", metadata, "")
+	reporter := devicemetrics.NewReporter(urlClient, lc)
+	dic.Update(di.ServiceConstructorMap{
+		container.DeviceMetricsReporterName: func(get di.Get) interface{} {
+			return reporter
+		},
+	})
+	reporter.Run(ctx, wg, interval)
+
+	lc.Info(fmt.Sprintf("Reporting device activity to core-metadata every %s", info.FlushInterval))
+	return true
+}
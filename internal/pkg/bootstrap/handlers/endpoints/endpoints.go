@@ -0,0 +1,84 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// Standalone contains references to dependencies required by the standalone bootstrap implementation.
+type Standalone struct {
+	configuration interfaces.Standalone
+}
+
+// NewStandalone is a factory method that returns an initialized Standalone receiver struct.
+func NewStandalone(configuration interfaces.Standalone) Standalone {
+	return Standalone{configuration: configuration}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. When an endpoints file is configured, it adds the registry
+// resolving the services from the file to the DIC, as the registry client too, and reloads the file when it changes
+// until the service is exiting. The endpoints file can't be combined with the registry.
+func (s Standalone) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	info := s.configuration.GetStandaloneInfo()
+	if info.EndpointsFile == "" {
+		return true
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	if bootstrapContainer.RegistryFrom(dic.Get) != nil {
+		lc.Error("the endpoints file can't be used along with the registry, either drop the registry flag or Standalone.EndpointsFile")
+		return false
+	}
+	interval, err := time.ParseDuration(info.WatchInterval)
+	if err != nil || interval <= 0 {
+		lc.Error(fmt.Sprintf("invalid Standalone WatchInterval '%s'", info.WatchInterval))
+		return false
+	}
+
+	registry, err := endpoints.NewRegistry(info.EndpointsFile, lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.EndpointsRegistryName: func(get di.Get) interface{} {
+			return registry
+		},
+		bootstrapContainer.RegistryClientInterfaceName: func(get di.Get) interface{} {
+			return registry
+		},
+	})
+	registry.Watch(ctx, wg, interval)
+
+	lc.Info(fmt.Sprintf("Resolving services from endpoints file %s, checked for changes every %s", info.EndpointsFile, info.WatchInterval))
+	return true
+}
@@ -0,0 +1,64 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+
+package featureflags
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// FeatureFlags contains references to dependencies required by the feature flags bootstrap implementation.
+type FeatureFlags struct {
+	configuration interfaces.FeatureFlags
+}
+
+// NewFeatureFlags is a factory method that returns an initialized FeatureFlags receiver struct.
+func NewFeatureFlags(configuration interfaces.FeatureFlags) FeatureFlags {
+	return FeatureFlags{configuration: configuration}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract and adds the feature flags read from the configuration to
+// the DIC. The flags are read again on each query, so the flags toggled in the registry apply right away.
+func (f FeatureFlags) BootstrapHandler(
+	_ context.Context,
+	_ *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	flags := featureflag.NewFlags(f.configuration.GetFeatureFlags)
+	dic.Update(di.ServiceConstructorMap{
+		container.FeatureFlagsName: func(get di.Get) interface{} {
+			return flags
+		},
+	})
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	for _, state := range flags.States() {
+		if state.Enabled {
+			lc.Info(fmt.Sprintf("Feature flag %s is enabled", state.Name))
+		}
+	}
+	return true
+}
@@ -0,0 +1,337 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-secrets/pkg"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/providers/vault"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/authtokenloader"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/types"
+)
+
+const (
+	renewSelfAPI = "/v1/auth/token/renew-self"
+
+	// defaultRetryWait is how long to wait after the secret store couldn't be reached when RetryWaitPeriod isn't set
+	defaultRetryWait = 5 * time.Second
+	// maxRetryWait caps the wait doubled after each failure to reach the secret store
+	maxRetryWait = time.Minute
+	// maxCheckInterval is how often a token without expiry is looked up, to notice it was revoked
+	maxCheckInterval = time.Hour
+)
+
+// Provider is the SecretProvider of the services when security is enabled. It renews its Vault token before the TTL
+// expires and, when Vault rejects the token, e.g. after Vault was restarted and the tokens were issued again, reloads
+// the token from the token file instead of losing access to the secrets until the service is restarted.
+type Provider struct {
+	config    types.SecretConfig
+	tokenFile string
+	loader    authtokenloader.AuthTokenLoader
+	caller    pkg.Caller
+	lc        logger.LoggingClient
+	retryWait time.Duration
+
+	// authMutex serializes the authentications, client is replaced under mutex
+	authMutex   sync.Mutex
+	mutex       sync.RWMutex
+	client      vault.Client
+	cache       map[string]map[string]string
+	lastUpdated time.Time
+}
+
+// NewProvider returns a provider of the secrets of the secret store of the configuration, authenticated with the
+// token of the token file, or of the configuration when there is no token file. The provider must be authenticated
+// before use.
+func NewProvider(
+	config types.SecretConfig,
+	tokenFile string,
+	loader authtokenloader.AuthTokenLoader,
+	lc logger.LoggingClient) (*Provider, error) {
+
+	caller, err := vault.CreateHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+	retryWait := defaultRetryWait
+	if config.RetryWaitPeriod != "" {
+		if retryWait, err = time.ParseDuration(config.RetryWaitPeriod); err != nil || retryWait <= 0 {
+			return nil, fmt.Errorf("invalid SecretStore RetryWaitPeriod '%s'", config.RetryWaitPeriod)
+		}
+	}
+	config.RetryWaitPeriodTime = retryWait
+
+	return &Provider{
+		config:      config,
+		tokenFile:   tokenFile,
+		loader:      loader,
+		caller:      caller,
+		lc:          lc,
+		retryWait:   retryWait,
+		cache:       make(map[string]map[string]string),
+		lastUpdated: time.Now(),
+	}, nil
+}
+
+// Authenticate loads the token and checks it with the secret store, the clients of the provider use it from then on
+func (p *Provider) Authenticate() error {
+	_, err := p.authenticate()
+	return err
+}
+
+func (p *Provider) authenticate() (*vault.TokenLookupResponse, error) {
+	p.authMutex.Lock()
+	defer p.authMutex.Unlock()
+
+	config := p.config
+	if p.tokenFile != "" {
+		token, err := p.loader.Load(p.tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading the secret store token from %s failed: %s", p.tokenFile, err.Error())
+		}
+		config.Authentication.AuthToken = token
+	}
+	client := vault.NewClient(config, p.caller, p.lc)
+	lookup, err := client.GetTokenLookupResponseData()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.client.HttpConfig.Authentication.AuthToken != "" {
+		// The secrets may have been seeded again along with the token, the callers read them again
+		p.cache = make(map[string]map[string]string)
+		p.lastUpdated = time.Now()
+	}
+	p.client = client
+	return lookup, nil
+}
+
+func (p *Provider) current() vault.Client {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.client
+}
+
+// GetSecrets retrieves secrets from the secret store, from the cache when all the keys were retrieved before. The
+// provider authenticates again when the secret store rejects its token.
+func (p *Provider) GetSecrets(path string, keys ...string) (map[string]string, error) {
+	if cached := p.cached(path, keys); cached != nil {
+		return cached, nil
+	}
+
+	secrets, err := p.current().GetSecrets(path, keys...)
+	if err != nil && p.tokenRejected() {
+		p.lc.Warn(fmt.Sprintf("the secret store rejected the token reading secrets from %s, authenticating again", path))
+		if _, err = p.authenticate(); err != nil {
+			return nil, err
+		}
+		secrets, err = p.current().GetSecrets(path, keys...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, exists := p.cache[path]; !exists {
+		p.cache[path] = make(map[string]string, len(secrets))
+	}
+	for key, value := range secrets {
+		p.cache[path][key] = value
+	}
+	return secrets, nil
+}
+
+func (p *Provider) cached(path string, keys []string) map[string]string {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	cached, exists := p.cache[path]
+	if !exists {
+		return nil
+	}
+	secrets := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, ok := cached[key]
+		if !ok {
+			return nil
+		}
+		secrets[key] = value
+	}
+	return secrets
+}
+
+// StoreSecrets stores the secrets to the secret store and clears the cache. The provider authenticates again when the
+// secret store rejects its token.
+func (p *Provider) StoreSecrets(path string, secrets map[string]string) error {
+	err := p.current().StoreSecrets(path, secrets)
+	if err != nil && p.tokenRejected() {
+		p.lc.Warn(fmt.Sprintf("the secret store rejected the token storing secrets to %s, authenticating again", path))
+		if _, err = p.authenticate(); err != nil {
+			return err
+		}
+		err = p.current().StoreSecrets(path, secrets)
+	}
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.cache = make(map[string]map[string]string)
+	p.lastUpdated = time.Now()
+	return nil
+}
+
+// SecretsUpdated isn't needed for secure secrets as this is handled when secrets are stored.
+func (p *Provider) SecretsUpdated() {
+	// Do nothing
+}
+
+// SecretsLastUpdated returns the last time the secrets were stored, or read again after authenticating again
+func (p *Provider) SecretsLastUpdated() time.Time {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.lastUpdated
+}
+
+// Maintain keeps the token of the provider valid until the service is exiting. The token is renewed halfway through
+// its TTL, and loaded again from the token file when the secret store rejects it. The secret store is retried with a
+// growing wait while it can't be reached, i.e. while Vault is restarting or sealed.
+func (p *Provider) Maintain(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		failures := 0
+		for {
+			next, err := p.maintain()
+			if err != nil {
+				failures++
+				next = p.backoff(failures)
+				p.lc.Error(fmt.Sprintf("maintaining the secret store token failed, retrying in %s: %s", next, err.Error()))
+			} else if failures > 0 {
+				failures = 0
+				p.lc.Info("the secret store token is valid again")
+			}
+
+			timer := time.NewTimer(next)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+}
+
+// maintain renews the token when it is renewable and returns when to check it again
+func (p *Provider) maintain() (time.Duration, error) {
+	lookup, err := p.current().GetTokenLookupResponseData()
+	if isForbidden(err) {
+		p.lc.Warn("the secret store rejected the token, authenticating again")
+		lookup, err = p.authenticate()
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	ttl := time.Duration(lookup.Data.Ttl) * time.Second
+	if ttl <= 0 {
+		return maxCheckInterval, nil
+	}
+	if lookup.Data.Renewable {
+		if ttl, err = p.renew(); err != nil {
+			return 0, err
+		}
+		p.lc.Debug(fmt.Sprintf("the secret store token was renewed for %s", ttl))
+	}
+	next := ttl / 2
+	if next > maxCheckInterval {
+		next = maxCheckInterval
+	}
+	return next, nil
+}
+
+// renew renews the token and returns its new TTL
+func (p *Provider) renew() (time.Duration, error) {
+	client := p.current()
+	url, err := client.HttpConfig.BuildURL(renewSelfAPI)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set(vault.AuthTypeHeader, client.HttpConfig.Authentication.AuthToken)
+	if client.HttpConfig.Namespace != "" {
+		req.Header.Set(vault.NamespaceHeader, client.HttpConfig.Namespace)
+	}
+
+	resp, err := p.caller.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, vault.ErrHTTPResponse{StatusCode: resp.StatusCode, ErrMsg: "failed to renew token"}
+	}
+
+	var result struct {
+		Auth struct {
+			LeaseDuration int `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	return time.Duration(result.Auth.LeaseDuration) * time.Second, nil
+}
+
+func (p *Provider) backoff(failures int) time.Duration {
+	wait := p.retryWait
+	for i := 1; i < failures && wait < maxRetryWait; i++ {
+		wait *= 2
+	}
+	if wait > maxRetryWait {
+		wait = maxRetryWait
+	}
+	return wait
+}
+
+// tokenRejected tells whether the secret store rejects the token, the errors of the client don't tell the rejections
+// of the token apart from the other failures
+func (p *Provider) tokenRejected() bool {
+	_, err := p.current().GetTokenLookupResponseData()
+	return isForbidden(err)
+}
+
+func isForbidden(err error) bool {
+	httpErr, ok := err.(vault.ErrHTTPResponse)
+	return ok && httpErr.StatusCode == http.StatusForbidden
+}
@@ -0,0 +1,187 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/providers/vault"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault accepts the valid token only, as Vault does once the tokens were issued again after a restart
+type fakeVault struct {
+	mutex     sync.Mutex
+	token     string
+	renewable bool
+	ttl       int
+	renewals  int
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	if r.Header.Get(vault.AuthTypeHeader) != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch r.URL.Path {
+	case "/v1/auth/token/lookup-self":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"renewable": v.renewable, "ttl": v.ttl, "period": v.ttl},
+		})
+	case renewSelfAPI:
+		v.renewals++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"auth": map[string]interface{}{"lease_duration": 3600}})
+	case "/v1/secret/edgex/core-data/mongodb":
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"username": "core", "password": "p"}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (v *fakeVault) setToken(token string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.token = token
+}
+
+type fileLoader struct {
+	mutex sync.Mutex
+	token string
+}
+
+func (l *fileLoader) Load(string) (string, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.token, nil
+}
+
+func (l *fileLoader) set(token string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.token = token
+}
+
+func newTestProvider(t *testing.T, server *httptest.Server, loader *fileLoader) *Provider {
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	provider, err := NewProvider(
+		types.SecretConfig{
+			Host:           u.Hostname(),
+			Port:           port,
+			Path:           "/v1/secret/edgex/core-data/",
+			Protocol:       "http",
+			Authentication: types.AuthenticationInfo{AuthType: vault.AuthTypeHeader},
+		},
+		"/vault/config/assets/core-data-token.json",
+		loader,
+		logger.NewMockClient())
+	require.NoError(t, err)
+	return provider
+}
+
+func TestProviderAuthenticatesAgain(t *testing.T) {
+	fake := &fakeVault{token: "first"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	loader := &fileLoader{token: "first"}
+	provider := newTestProvider(t, server, loader)
+	require.NoError(t, provider.Authenticate())
+	before := provider.SecretsLastUpdated()
+
+	secrets, err := provider.GetSecrets("mongodb", "username")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"username": "core"}, secrets)
+
+	// Vault restarted and the token file holds the token issued again
+	fake.setToken("second")
+	loader.set("second")
+	secrets, err = provider.GetSecrets("mongodb", "password")
+	require.NoError(t, err, "the provider must authenticate with the token of the token file again")
+	assert.Equal(t, map[string]string{"password": "p"}, secrets)
+	assert.True(t, provider.SecretsLastUpdated().After(before), "the secrets are read again after authenticating again")
+
+	// The token file wasn't replaced yet
+	fake.setToken("third")
+	_, err = provider.GetSecrets("mongodb", "username", "password")
+	require.Error(t, err)
+}
+
+func TestProviderMaintain(t *testing.T) {
+	tests := []struct {
+		name             string
+		renewable        bool
+		ttl              int
+		expectedNext     time.Duration
+		expectedRenewals int
+	}{
+		{"renewable token", true, 600, 30 * time.Minute, 1},
+		{"token not renewable", false, 600, 5 * time.Minute, 0},
+		{"token without expiry", false, 0, maxCheckInterval, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeVault{token: "first", renewable: tt.renewable, ttl: tt.ttl}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+			provider := newTestProvider(t, server, &fileLoader{token: "first"})
+			require.NoError(t, provider.Authenticate())
+
+			next, err := provider.maintain()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNext, next)
+			assert.Equal(t, tt.expectedRenewals, fake.renewals)
+		})
+	}
+}
+
+func TestProviderMaintainAfterRestart(t *testing.T) {
+	fake := &fakeVault{token: "first", renewable: true, ttl: 600}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	loader := &fileLoader{token: "first"}
+	provider := newTestProvider(t, server, loader)
+	require.NoError(t, provider.Authenticate())
+
+	fake.setToken("second")
+	_, err := provider.maintain()
+	require.Error(t, err, "the token file wasn't replaced yet")
+
+	loader.set("second")
+	_, err = provider.maintain()
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.renewals, "the token loaded again is renewed")
+}
+
+func TestBackoff(t *testing.T) {
+	provider := &Provider{retryWait: 10 * time.Second}
+	assert.Equal(t, 10*time.Second, provider.backoff(1))
+	assert.Equal(t, 40*time.Second, provider.backoff(3))
+	assert.Equal(t, maxRetryWait, provider.backoff(10))
+}
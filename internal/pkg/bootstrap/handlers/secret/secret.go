@@ -0,0 +1,100 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	bootstrapSecret "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/authtokenloader"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/fileioperformer"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/types"
+)
+
+// BootstrapHandler fulfills the BootstrapHandler contract and adds the SecretProvider to the DIC. When security is
+// enabled, the provider authenticates to the secret store before the startup timer elapses, then keeps its token
+// valid until the service is exiting, see Provider.
+func BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := bootstrapContainer.ConfigurationFrom(dic.Get)
+
+	var provider interfaces.SecretProvider
+	if !bootstrapSecret.IsSecurityEnabled() {
+		provider = bootstrapSecret.NewInsecureProvider(configuration, lc)
+	} else {
+		secretStore := configuration.GetBootstrap().SecretStore
+		tokenLoader := bootstrapContainer.AuthTokenLoaderFrom(dic.Get)
+		if tokenLoader == nil {
+			tokenLoader = authtokenloader.NewAuthTokenLoader(fileioperformer.NewDefaultFileIoPerformer())
+		}
+
+		secureProvider, err := NewProvider(
+			types.SecretConfig{
+				Host:                    secretStore.Host,
+				Port:                    secretStore.Port,
+				Path:                    secretStore.Path,
+				Protocol:                secretStore.Protocol,
+				Namespace:               secretStore.Namespace,
+				RootCaCertPath:          secretStore.RootCaCertPath,
+				ServerName:              secretStore.ServerName,
+				Authentication:          secretStore.Authentication,
+				AdditionalRetryAttempts: secretStore.AdditionalRetryAttempts,
+				RetryWaitPeriod:         secretStore.RetryWaitPeriod,
+			},
+			secretStore.TokenFile,
+			tokenLoader,
+			lc)
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to create the secret provider: %s", err.Error()))
+			return false
+		}
+
+		lc.Info("Authenticating to the secret store")
+		for startupTimer.HasNotElapsed() {
+			if err = secureProvider.Authenticate(); err == nil {
+				break
+			}
+			lc.Warn(fmt.Sprintf("Retryable failure while authenticating to the secret store: %s", err.Error()))
+			startupTimer.SleepForInterval()
+		}
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to authenticate to the secret store: %s", err.Error()))
+			return false
+		}
+		lc.Info("Authenticated to the secret store")
+
+		secureProvider.Maintain(ctx, wg)
+		provider = secureProvider
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return provider
+		},
+	})
+
+	return true
+}
@@ -0,0 +1,78 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package shutdown
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/shutdown"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// GracefulShutdown contains references to dependencies required by the graceful shutdown bootstrap implementation.
+type GracefulShutdown struct {
+	coordinator   *shutdown.Coordinator
+	configuration interfaces.Shutdown
+}
+
+// NewGracefulShutdown is a factory method that returns an initialized GracefulShutdown receiver struct.
+func NewGracefulShutdown(coordinator *shutdown.Coordinator, configuration interfaces.Shutdown) GracefulShutdown {
+	return GracefulShutdown{
+		coordinator:   coordinator,
+		configuration: configuration,
+	}
+}
+
+// BootstrapHandler fulfills the BootstrapHandler contract. It adds the shutdown coordinator to the DIC, and once the
+// service is exiting drains the requests and messages being handled before running the shutdown hooks. It is to be
+// the first handler, so that the coordinator shuts down whichever handlers fail.
+func (g GracefulShutdown) BootstrapHandler(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	_ startup.Timer,
+	dic *di.Container) bool {
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	info := g.configuration.GetShutdownInfo()
+	timeout, err := time.ParseDuration(info.DrainTimeout)
+	if err != nil || timeout < 0 {
+		lc.Error(fmt.Sprintf("invalid Shutdown DrainTimeout '%s'", info.DrainTimeout))
+		return false
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		container.ShutdownCoordinatorName: func(get di.Get) interface{} {
+			return g.coordinator
+		},
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		g.coordinator.Shutdown(timeout, lc)
+	}()
+
+	return true
+}
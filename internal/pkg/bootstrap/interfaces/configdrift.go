@@ -0,0 +1,26 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+)
+
+// ConfigDrift interface is implemented by the configuration of the services reporting the drift of their running
+// configuration.
+type ConfigDrift interface {
+	// GetConfigDriftInfo returns the configuration of the drift detection.
+	GetConfigDriftInfo() configdrift.DriftInfo
+}
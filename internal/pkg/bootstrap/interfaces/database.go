@@ -14,10 +14,36 @@
 
 package interfaces
 
-import "github.com/edgexfoundry/go-mod-bootstrap/config"
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/config"
+)
 
 // Database interface provides an abstraction for obtaining the database configuration information.
 type Database interface {
 	// GetDatabaseInfo returns a database information map.
 	GetDatabaseInfo() map[string]config.Database
 }
+
+// DatabaseEncryption interface is implemented by the configuration of the services which encrypt the payloads they
+// persist.
+type DatabaseEncryption interface {
+	// GetDatabaseEncryptionInfo returns the payload encryption configuration.
+	GetDatabaseEncryptionInfo() db.EncryptionInfo
+}
+
+// DatabaseIndexes interface is implemented by the configuration of the services which declare secondary indexes on
+// the objects they persist.
+type DatabaseIndexes interface {
+	// GetDatabaseIndexes returns the collection written by the service and the secondary indexes declared on it, by
+	// name.
+	GetDatabaseIndexes() (string, map[string]db.IndexInfo)
+}
+
+// DatabaseReplication interface is implemented by the configuration of the services which route their read-only
+// queries to the read replicas of the database.
+type DatabaseReplication interface {
+	// GetDatabaseReplicationInfo returns the replication configuration.
+	GetDatabaseReplicationInfo() db.ReplicationInfo
+}
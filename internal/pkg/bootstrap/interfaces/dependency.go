@@ -0,0 +1,36 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/dependency"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// Dependencies interface is implemented by the configuration of the services which declare the dependencies gating
+// their startup and readiness.
+type Dependencies interface {
+	interfaces.Configuration
+	// GetDependencyInfo returns the declared dependencies by name, see the dependency package for the names.
+	GetDependencyInfo() map[string]dependency.DependencyInfo
+}
+
+// MessageBus interface is implemented by the configuration of the services which depend on a message bus broker.
+type MessageBus interface {
+	// GetMessageBusInfo returns the location of the message bus broker.
+	GetMessageBusInfo() types.HostInfo
+}
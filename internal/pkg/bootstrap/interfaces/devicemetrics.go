@@ -0,0 +1,28 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// DeviceMetrics interface is implemented by the configuration of the services which report the activity of devices
+// to core-metadata.
+type DeviceMetrics interface {
+	// GetDeviceMetricsInfo returns the reporting configuration and the core-metadata client configuration.
+	GetDeviceMetricsInfo() (devicemetrics.ReportingInfo, bootstrapConfig.ClientInfo)
+}
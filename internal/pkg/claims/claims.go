@@ -0,0 +1,201 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package claims passes the claims of the caller, validated by the API gateway from its JWT, to the handlers of the
+// services. The gateway forwards the subject, roles and tenant of the caller in trusted headers signed with a key
+// shared with the services, along with the method and path of the request so that the headers can't be replayed
+// against another endpoint, and the middleware verifies the signature before adding the claims to the request context.
+package claims
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	SubjectHeader   = "X-EdgeX-Subject"
+	RolesHeader     = "X-EdgeX-Roles"
+	TenantHeader    = "X-EdgeX-Tenant"
+	TimestampHeader = "X-EdgeX-Claims-Timestamp"
+	SignatureHeader = "X-EdgeX-Claims-Signature"
+)
+
+// Headers are the trusted headers, which the gateway clears from the requests of the callers before setting them
+var Headers = []string{SubjectHeader, RolesHeader, TenantHeader, TimestampHeader, SignatureHeader}
+
+// TrustedHeadersInfo configures the verification of the trusted headers forwarded by the API gateway
+type TrustedHeadersInfo struct {
+	// KeyPath is the file holding the key the gateway signs the trusted headers with. The trusted headers are ignored
+	// when empty.
+	KeyPath string
+	// MaxAge is how long after the gateway signed them the trusted headers are accepted, e.g. '5m'
+	MaxAge string
+}
+
+// Claims are the claims of the caller
+type Claims struct {
+	Subject string
+	Roles   []string
+	Tenant  string
+}
+
+// HasRole returns whether the caller holds the role
+func (c Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type contextKey struct{}
+
+// trustedOnlyKey marks the requests of the services verifying the trusted headers
+type trustedOnlyKey struct{}
+
+// NewContext returns a copy of the context holding the claims
+func NewContext(ctx context.Context, c Claims) context.Context {
+	return context.WithValue(ctx, contextKey{}, c)
+}
+
+// FromContext returns the claims of the caller, false when the request didn't come through the gateway with them
+func FromContext(ctx context.Context) (Claims, bool) {
+	c, ok := ctx.Value(contextKey{}).(Claims)
+	return c, ok
+}
+
+// TrustedOnly returns whether the service verifies the trusted headers, the callers' claims being then only those of
+// the trusted headers: a bearer token reaching the service is not to be trusted, even once parsed.
+func TrustedOnly(ctx context.Context) bool {
+	trustedOnly, _ := ctx.Value(trustedOnlyKey{}).(bool)
+	return trustedOnly
+}
+
+// Sign returns the signature of the claims signed at the timestamp, in seconds, for the request of the method to the
+// escaped path, as set by the gateway in the SignatureHeader: the base64 encoded HMAC-SHA1 of the values of the other
+// trusted headers, the method and the path separated by newlines
+func Sign(key []byte, c Claims, timestamp int64, method string, path string) string {
+	return sign(key, c.Subject, strings.Join(c.Roles, ","), c.Tenant, strconv.FormatInt(timestamp, 10), method, path)
+}
+
+func sign(key []byte, subject string, roles string, tenant string, timestamp string, method string, path string) string {
+	mac := hmac.New(sha1.New, key)
+	mac.Write([]byte(strings.Join([]string{subject, roles, tenant, timestamp, method, path}, "\n")))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// LoadKey reads the key the gateway signs the trusted headers with. Surrounding whitespace is trimmed so that the file
+// may end with a newline.
+func LoadKey(path string) ([]byte, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key := []byte(strings.TrimSpace(string(b)))
+	if len(key) == 0 {
+		return nil, fmt.Errorf("empty key in %s", path)
+	}
+	return key, nil
+}
+
+// fromHeaders returns the claims of the trusted headers of the request, false when there is none. An error is returned
+// when they aren't signed with the key for the request's method and path, or were signed longer than maxAge ago.
+func fromHeaders(r *http.Request, key []byte, maxAge time.Duration, now time.Time) (Claims, bool, error) {
+	header := r.Header
+	signature := header.Get(SignatureHeader)
+	if signature == "" {
+		return Claims{}, false, nil
+	}
+
+	roles := header.Get(RolesHeader)
+	expected := sign(key, header.Get(SubjectHeader), roles, header.Get(TenantHeader), header.Get(TimestampHeader),
+		r.Method, r.URL.EscapedPath())
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return Claims{}, false, fmt.Errorf("invalid %s header", SignatureHeader)
+	}
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return Claims{}, false, fmt.Errorf("invalid %s header", TimestampHeader)
+	}
+	if age := now.Sub(time.Unix(timestamp, 0)); maxAge > 0 && (age > maxAge || age < -maxAge) {
+		return Claims{}, false, fmt.Errorf("trusted headers signed %s ago, longer than %s", age, maxAge)
+	}
+
+	c := Claims{
+		Subject: header.Get(SubjectHeader),
+		Tenant:  header.Get(TenantHeader),
+	}
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			c.Roles = append(c.Roles, role)
+		}
+	}
+	return c, true, nil
+}
+
+// NewMiddleware returns a middleware adding the claims of the trusted headers to the request context. A request whose
+// trusted headers aren't signed with the key, or are too old, is rejected with 401 Unauthorized, while a request
+// without them, i.e. from another service, is passed on without claims. Once a key is loaded the requests are marked
+// TrustedOnly, so that the handlers don't fall back on the callers' bearer tokens. The trusted headers are ignored
+// when no key is configured, or when it can't be loaded.
+func NewMiddleware(info TrustedHeadersInfo, lc logger.LoggingClient) mux.MiddlewareFunc {
+	var key []byte
+	var maxAge time.Duration
+	if info.KeyPath != "" {
+		var err error
+		if key, err = LoadKey(info.KeyPath); err != nil {
+			lc.Error(fmt.Sprintf("trusted headers ignored, unable to load their key: %s", err.Error()))
+		}
+		if info.MaxAge != "" {
+			if maxAge, err = time.ParseDuration(info.MaxAge); err != nil {
+				lc.Error(fmt.Sprintf("invalid TrustedHeaders MaxAge '%s', the age of the trusted headers isn't checked", info.MaxAge))
+			}
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if key == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			r = r.WithContext(context.WithValue(r.Context(), trustedOnlyKey{}, true))
+			c, ok, err := fromHeaders(r, key, maxAge, time.Now())
+			if err != nil {
+				lc.Warn(fmt.Sprintf("rejected trusted headers of %s %s: %s", r.Method, r.URL.Path, err.Error()),
+					clients.CorrelationHeader, r.Header.Get(clients.CorrelationHeader))
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			if ok {
+				r = r.WithContext(NewContext(r.Context(), c))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package claims
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef")
+
+func signedRequest(c Claims, key []byte, timestamp int64) *http.Request {
+	return signedRequestTo(http.MethodGet, "/api/v2/device/all", c, key, timestamp, http.MethodGet, "/api/v2/device/all")
+}
+
+// signedRequestTo returns a request of the method to the path, holding trusted headers signed for the signed method
+// and path
+func signedRequestTo(method string, path string, c Claims, key []byte, timestamp int64, signedMethod string, signedPath string) *http.Request {
+	r := httptest.NewRequest(method, path, http.NoBody)
+	r.Header.Set(SubjectHeader, c.Subject)
+	r.Header.Set(RolesHeader, strings.Join(c.Roles, ","))
+	r.Header.Set(TenantHeader, c.Tenant)
+	r.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	r.Header.Set(SignatureHeader, Sign(key, c, timestamp, signedMethod, signedPath))
+	return r
+}
+
+func TestMiddleware(t *testing.T) {
+	dir, err := ioutil.TempDir("", "claims")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	keyPath := filepath.Join(dir, "claims.key")
+	require.NoError(t, ioutil.WriteFile(keyPath, append(testKey, '\n'), 0600))
+
+	expected := Claims{Subject: "alice", Roles: []string{"admin", "operator"}, Tenant: "plant-1"}
+	now := time.Now().Unix()
+	tampered := signedRequest(expected, testKey, now)
+	tampered.Header.Set(TenantHeader, "plant-2")
+
+	tests := []struct {
+		name               string
+		info               TrustedHeadersInfo
+		request            *http.Request
+		expectedStatusCode int
+		expectedClaims     bool
+		expectedTrusted    bool
+	}{
+		{"Valid", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, signedRequest(expected, testKey, now), http.StatusOK, true, true},
+		{"Valid - escaped path", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, signedRequestTo(http.MethodGet, "/api/v2/device/name/a%20b", expected, testKey, now, http.MethodGet, "/api/v2/device/name/a%20b"), http.StatusOK, true, true},
+		{"Valid - no trusted headers", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, httptest.NewRequest(http.MethodGet, "/", http.NoBody), http.StatusOK, false, true},
+		{"Valid - no key", TrustedHeadersInfo{}, signedRequest(expected, testKey, now), http.StatusOK, false, false},
+		{"Invalid - signed with another key", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, signedRequest(expected, []byte("other"), now), http.StatusUnauthorized, false, false},
+		{"Invalid - tampered header", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, tampered, http.StatusUnauthorized, false, false},
+		{"Invalid - too old", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, signedRequest(expected, testKey, now-600), http.StatusUnauthorized, false, false},
+		{"Invalid - replayed to another path", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, signedRequestTo(http.MethodGet, "/api/v2/device/name/valve", expected, testKey, now, http.MethodGet, "/api/v2/device/all"), http.StatusUnauthorized, false, false},
+		{"Invalid - replayed with another method", TrustedHeadersInfo{KeyPath: keyPath, MaxAge: "5m"}, signedRequestTo(http.MethodDelete, "/api/v2/device/all", expected, testKey, now, http.MethodGet, "/api/v2/device/all"), http.StatusUnauthorized, false, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var c Claims
+			var ok, trusted bool
+			handler := NewMiddleware(testCase.info, logger.NewMockClient())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c, ok = FromContext(r.Context())
+				trusted = TrustedOnly(r.Context())
+			}))
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, testCase.request)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Code)
+			assert.Equal(t, testCase.expectedClaims, ok)
+			assert.Equal(t, testCase.expectedTrusted, trusted)
+			if testCase.expectedClaims {
+				assert.Equal(t, expected, c)
+				assert.True(t, c.HasRole("operator"))
+			}
+		})
+	}
+}
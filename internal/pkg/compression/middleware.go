@@ -0,0 +1,221 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package compression
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	gzipEncoding    = "gzip"
+	deflateEncoding = "deflate"
+
+	// DefaultMinSize is the response size, in bytes, below which responses are sent uncompressed since the
+	// compression overhead outweighs the saving.
+	DefaultMinSize = 1024
+)
+
+// ResponsesInfo configures the compression of the responses of a service
+type ResponsesInfo struct {
+	// Enabled compresses the responses with gzip or deflate when the client accepts them
+	Enabled bool
+	// MinSize is the response size, in bytes, below which responses are sent uncompressed, DefaultMinSize when 0
+	MinSize int
+}
+
+// NewMiddleware returns a middleware which compresses responses of at least the configured size with gzip or deflate,
+// as negotiated with the client's Accept-Encoding header. Responses already carrying a Content-Encoding are left as
+// is. The settings are read on every request so that changes to the writable configuration apply right away.
+func NewMiddleware(settings func() ResponsesInfo) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The middleware may be registered more than once on a router shared by the v1 and v2 APIs, so make sure
+			// the response is only compressed once.
+			if _, ok := w.(*responseWriter); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			info := settings()
+			encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+			if !info.Enabled || encoding == "" || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			minSize := info.MinSize
+			if minSize <= 0 {
+				minSize = DefaultMinSize
+			}
+			cw := &responseWriter{ResponseWriter: w, encoding: encoding, minSize: minSize, statusCode: http.StatusOK}
+			defer cw.Close()
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// negotiateEncoding returns the preferred supported encoding listed in acceptEncoding, or an empty string if the
+// client doesn't accept any of them. gzip is preferred over deflate when both are accepted with the same weight.
+func negotiateEncoding(acceptEncoding string) string {
+	var selected string
+	var selectedWeight float64
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name != gzipEncoding && name != deflateEncoding && name != "*" {
+			continue
+		}
+
+		weight := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					weight = q
+				}
+			}
+		}
+		if weight <= 0 {
+			continue
+		}
+
+		if name == "*" {
+			name = gzipEncoding
+		}
+		if weight > selectedWeight || (weight == selectedWeight && name == gzipEncoding) {
+			selected, selectedWeight = name, weight
+		}
+	}
+	return selected
+}
+
+// responseWriter buffers the response until minSize bytes have been written, then switches to writing compressed
+// output. Responses which never reach minSize are written uncompressed when the writer is closed.
+type responseWriter struct {
+	http.ResponseWriter
+	encoding    string
+	minSize     int
+	statusCode  int
+	buffer      bytes.Buffer
+	compressor  io.WriteCloser
+	passthrough bool
+	wroteHeader bool
+}
+
+func (cw *responseWriter) WriteHeader(statusCode int) {
+	cw.statusCode = statusCode
+}
+
+func (cw *responseWriter) Write(b []byte) (int, error) {
+	if cw.compressor != nil {
+		return cw.compressor.Write(b)
+	}
+	if cw.passthrough {
+		return cw.ResponseWriter.Write(b)
+	}
+
+	if cw.Header().Get("Content-Encoding") != "" {
+		cw.startPassthrough()
+		return cw.ResponseWriter.Write(b)
+	}
+
+	cw.buffer.Write(b)
+	if cw.buffer.Len() < cw.minSize {
+		return len(b), nil
+	}
+
+	if err := cw.startCompression(); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// startCompression writes the response header for the negotiated encoding and flushes the buffered output through
+// the compressor.
+func (cw *responseWriter) startCompression() error {
+	header := cw.Header()
+	header.Set("Content-Encoding", cw.encoding)
+	header.Add("Vary", "Accept-Encoding")
+	header.Del("Content-Length")
+	cw.writeHeader()
+
+	var err error
+	if cw.encoding == gzipEncoding {
+		cw.compressor = gzip.NewWriter(cw.ResponseWriter)
+	} else {
+		cw.compressor, err = flate.NewWriter(cw.ResponseWriter, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = cw.compressor.Write(cw.buffer.Bytes())
+	cw.buffer.Reset()
+	return err
+}
+
+// startPassthrough writes the response header and any buffered output uncompressed.
+func (cw *responseWriter) startPassthrough() {
+	cw.passthrough = true
+	cw.writeHeader()
+	if cw.buffer.Len() > 0 {
+		_, _ = cw.ResponseWriter.Write(cw.buffer.Bytes())
+		cw.buffer.Reset()
+	}
+}
+
+// Flush sends the output written so far to the client, so streamed responses aren't held back until minSize bytes
+// have been written or the response completes.
+func (cw *responseWriter) Flush() {
+	if cw.compressor == nil && !cw.passthrough {
+		if cw.Header().Get("Content-Encoding") != "" {
+			cw.startPassthrough()
+		} else if err := cw.startCompression(); err != nil {
+			return
+		}
+	}
+	if flusher, ok := cw.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := cw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (cw *responseWriter) writeHeader() {
+	if !cw.wroteHeader {
+		cw.wroteHeader = true
+		cw.ResponseWriter.WriteHeader(cw.statusCode)
+	}
+}
+
+// Close completes the response, either by flushing the compressor or by writing the buffered output uncompressed.
+func (cw *responseWriter) Close() error {
+	if cw.compressor != nil {
+		return cw.compressor.Close()
+	}
+	if !cw.passthrough {
+		cw.startPassthrough()
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package compression
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		expected       string
+	}{
+		{"none", "", ""},
+		{"identity only", "identity", ""},
+		{"gzip", "gzip", gzipEncoding},
+		{"deflate", "deflate", deflateEncoding},
+		{"gzip preferred on tie", "deflate, gzip", gzipEncoding},
+		{"weighted deflate", "gzip;q=0.5, deflate", deflateEncoding},
+		{"gzip refused", "gzip;q=0, deflate;q=0.1", deflateEncoding},
+		{"wildcard", "*", gzipEncoding},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, negotiateEncoding(tt.acceptEncoding))
+		})
+	}
+}
+
+// enabled compresses the responses of at least DefaultMinSize bytes
+var enabled = NewMiddleware(func() ResponsesInfo { return ResponsesInfo{Enabled: true} })
+
+func serve(t *testing.T, body string, acceptEncoding string) *http.Response {
+	return serveWith(t, ResponsesInfo{Enabled: true, MinSize: 16}, body, acceptEncoding)
+}
+
+func serveWith(t *testing.T, info ResponsesInfo, body string, acceptEncoding string) *http.Response {
+	handler := NewMiddleware(func() ResponsesInfo { return info })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder.Result()
+}
+
+func TestMiddleware(t *testing.T) {
+	large := strings.Repeat("edgex ", 100)
+
+	t.Run("gzip", func(t *testing.T) {
+		res := serve(t, large, "gzip")
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+		require.Equal(t, gzipEncoding, res.Header.Get("Content-Encoding"))
+		reader, err := gzip.NewReader(res.Body)
+		require.NoError(t, err)
+		actual, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, large, string(actual))
+	})
+
+	t.Run("deflate", func(t *testing.T) {
+		res := serve(t, large, "deflate")
+		require.Equal(t, deflateEncoding, res.Header.Get("Content-Encoding"))
+		actual, err := ioutil.ReadAll(flate.NewReader(res.Body))
+		require.NoError(t, err)
+		assert.Equal(t, large, string(actual))
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		res := serve(t, "small", "gzip")
+		assert.Equal(t, http.StatusCreated, res.StatusCode)
+		assert.Empty(t, res.Header.Get("Content-Encoding"))
+		actual, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "small", string(actual))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		res := serveWith(t, ResponsesInfo{MinSize: 16}, large, "gzip")
+		assert.Empty(t, res.Header.Get("Content-Encoding"))
+		actual, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, large, string(actual))
+	})
+
+	t.Run("below default threshold", func(t *testing.T) {
+		res := serveWith(t, ResponsesInfo{Enabled: true}, large, "gzip")
+		assert.Empty(t, res.Header.Get("Content-Encoding"))
+	})
+
+	t.Run("not accepted", func(t *testing.T) {
+		res := serve(t, large, "")
+		assert.Empty(t, res.Header.Get("Content-Encoding"))
+		actual, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		assert.Equal(t, large, string(actual))
+	})
+}
+
+func TestMiddlewareRegisteredTwice(t *testing.T) {
+	large := strings.Repeat("edgex ", 100)
+	handler := enabled(enabled(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat(large, 10)))
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	reader, err := gzip.NewReader(recorder.Result().Body)
+	require.NoError(t, err)
+	actual, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat(large, 10), string(actual))
+}
+
+func TestMiddlewareFlush(t *testing.T) {
+	handler := enabled(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("first line\n"))
+		flusher, ok := w.(http.Flusher)
+		require.True(t, ok)
+		flusher.Flush()
+		assert.True(t, w.(*responseWriter).ResponseWriter.(*httptest.ResponseRecorder).Flushed)
+		_, _ = w.Write([]byte("second line\n"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	require.Equal(t, gzipEncoding, recorder.Result().Header.Get("Content-Encoding"))
+	reader, err := gzip.NewReader(recorder.Result().Body)
+	require.NoError(t, err)
+	actual, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "first line\nsecond line\n", string(actual))
+}
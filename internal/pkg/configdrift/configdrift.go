@@ -0,0 +1,337 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package configdrift compares the running configuration of a service with its configuration file and the registry.
+// It tells the values overridden at startup, by the registry or the environment variables, from the values which
+// drifted, i.e. changed in the registry or the file since but not applied. The sections listed to be reloaded are
+// applied once they drift in the registry, beyond the Writable section the bootstrap already watches.
+package configdrift
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/BurntSushi/toml"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// ApiConfigDriftRoute reports the differences between the running configuration of a service and its sources
+const ApiConfigDriftRoute = clients.ApiBase + "/config/drift"
+
+// Origins of the values overridden at startup
+const (
+	OverrideRegistry    = "registry"
+	OverrideEnvironment = "environment"
+)
+
+// writablePrefix prefixes the keys of the Writable section, applied at runtime by the bootstrap
+const writablePrefix = "Writable."
+
+// DriftInfo configures the drift detection of the service
+type DriftInfo struct {
+	// CheckInterval is how often the ReloadSections are compared with the registry, i.e. '30s'. Empty disables the
+	// check, the drift is then only reported.
+	CheckInterval string
+	// ReloadSections are the top level sections of the configuration applied once they drift in the registry, with
+	// the environment variables overriding them still, i.e. ['RequestLimits']
+	ReloadSections []string
+}
+
+// Difference is a configuration value whose running value isn't the one of the file, keyed by its dotted path
+type Difference struct {
+	Key      string      `json:"key"`
+	Writable bool        `json:"writable"`
+	File     interface{} `json:"file"`
+	Registry interface{} `json:"registry,omitempty"`
+	Running  interface{} `json:"running"`
+	// Override is where the running value came from at startup, OverrideRegistry or OverrideEnvironment
+	Override string `json:"override,omitempty"`
+	// Drift tells the registry, or the file when there is no registry, holds a value the service isn't running with,
+	// and RestartRequired that the value isn't applied before the service restarts
+	Drift           bool `json:"drift"`
+	RestartRequired bool `json:"restartRequired,omitempty"`
+}
+
+// Report holds the differences between the running configuration and its sources, sorted by key
+type Report struct {
+	File string `json:"file"`
+	// Registry is the path of the configuration in the registry, empty when the service doesn't use the registry
+	Registry string `json:"registry,omitempty"`
+	// Error is why a source couldn't be read, the drift isn't reported then
+	Error       string       `json:"error,omitempty"`
+	Differences []Difference `json:"differences"`
+}
+
+// Source reads the configuration into the empty configuration struct, and returns it
+type Source func(configuration interface{}) (interface{}, error)
+
+// Override applies the environment variables to the configuration struct
+type Override func(configuration interface{}) (int, error)
+
+// Detector compares the running configuration of a service with its sources
+type Detector struct {
+	mutex          sync.Mutex
+	running        interface{}
+	file           string
+	registryPath   string
+	registry       Source
+	override       Override
+	reloadSections []string
+}
+
+// NewDetector returns the detector of the running configuration, a pointer to the configuration struct of the
+// service. The registry is nil when the service doesn't use the registry. The reload sections must be top level
+// sections of the configuration.
+func NewDetector(
+	running interface{},
+	file string,
+	registryPath string,
+	registry Source,
+	override Override,
+	reloadSections []string) (*Detector, error) {
+
+	for _, section := range reloadSections {
+		if !reflect.ValueOf(running).Elem().FieldByName(section).IsValid() {
+			return nil, fmt.Errorf("unknown configuration section '%s'", section)
+		}
+	}
+	return &Detector{
+		running:        running,
+		file:           file,
+		registryPath:   registryPath,
+		registry:       registry,
+		override:       override,
+		reloadSections: reloadSections,
+	}, nil
+}
+
+// Report compares the running configuration with the file and the registry
+func (d *Detector) Report() Report {
+	report := Report{File: d.file, Registry: d.registryPath, Differences: []Difference{}}
+
+	file, fileExpected, err := d.read(d.readFile)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	var registry, expected map[string]interface{}
+	if d.registry != nil {
+		registry, expected, err = d.read(d.registry)
+		if err != nil {
+			report.Error = err.Error()
+		}
+	} else {
+		expected = fileExpected
+	}
+
+	d.mutex.Lock()
+	running, err := flatten(d.running)
+	d.mutex.Unlock()
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	for _, key := range keys(file, registry, running) {
+		difference := Difference{
+			Key:      key,
+			Writable: strings.HasPrefix(key, writablePrefix),
+			File:     file[key],
+			Running:  running[key],
+		}
+		if registry != nil {
+			difference.Registry = registry[key]
+		}
+		if !equal(file[key], running[key]) {
+			switch {
+			case !equal(fileExpected[key], file[key]) && equal(fileExpected[key], running[key]):
+				difference.Override = OverrideEnvironment
+			case registry != nil && equal(registry[key], running[key]):
+				difference.Override = OverrideRegistry
+			}
+		}
+		if expected != nil && !equal(expected[key], running[key]) {
+			difference.Drift = true
+			difference.RestartRequired = !difference.Writable && !d.reloaded(key)
+		}
+		if difference.Override != "" || difference.Drift {
+			report.Differences = append(report.Differences, difference)
+		}
+	}
+	return report
+}
+
+// Reload applies the reload sections which drifted in the registry to the running configuration, and returns the
+// sections reloaded
+func (d *Detector) Reload() ([]string, error) {
+	if d.registry == nil || len(d.reloadSections) == 0 {
+		return nil, nil
+	}
+
+	latest, err := d.registry(d.empty())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.override(latest); err != nil {
+		return nil, err
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	var reloaded []string
+	running := reflect.ValueOf(d.running).Elem()
+	for _, section := range d.reloadSections {
+		current := running.FieldByName(section)
+		updated := reflect.Indirect(reflect.ValueOf(latest)).FieldByName(section)
+		currentValues, err := flatten(current.Interface())
+		if err != nil {
+			return reloaded, err
+		}
+		updatedValues, err := flatten(updated.Interface())
+		if err != nil {
+			return reloaded, err
+		}
+		if sameValues(currentValues, updatedValues) {
+			continue
+		}
+		current.Set(updated)
+		reloaded = append(reloaded, section)
+	}
+	return reloaded, nil
+}
+
+// ReportHandler reports the differences of the running configuration, the handler of ApiConfigDriftRoute
+func (d *Detector) ReportHandler(w http.ResponseWriter, _ *http.Request, lc logger.LoggingClient) {
+	pkg.Encode(d.Report(), w, lc)
+}
+
+// read reads the configuration from the source, and returns its values as read and once the environment variables
+// are applied
+func (d *Detector) read(source Source) (map[string]interface{}, map[string]interface{}, error) {
+	configuration, err := source(d.empty())
+	if err != nil {
+		return nil, nil, err
+	}
+	values, err := flatten(configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := d.override(configuration); err != nil {
+		return nil, nil, err
+	}
+	overridden, err := flatten(configuration)
+	if err != nil {
+		return nil, nil, err
+	}
+	return values, overridden, nil
+}
+
+// readFile reads the configuration file of the service
+func (d *Detector) readFile(configuration interface{}) (interface{}, error) {
+	if _, err := toml.DecodeFile(d.file, configuration); err != nil {
+		return nil, fmt.Errorf("could not read configuration file (%s): %s", d.file, err.Error())
+	}
+	return configuration, nil
+}
+
+// empty returns a pointer to an empty configuration struct of the service
+func (d *Detector) empty() interface{} {
+	return reflect.New(reflect.TypeOf(d.running).Elem()).Interface()
+}
+
+// reloaded tells whether the key is in one of the reload sections
+func (d *Detector) reloaded(key string) bool {
+	for _, section := range d.reloadSections {
+		if strings.HasPrefix(key, section+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// flatten returns the values of the configuration keyed by their dotted path. The empty values are left out, so
+// that a missing value equals an empty one.
+func flatten(configuration interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{})
+	flattenInto(values, "", tree)
+	return values, nil
+}
+
+// flattenInto adds the values of the JSON tree to values, prefixing their path with key
+func flattenInto(values map[string]interface{}, key string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+	case map[string]interface{}:
+		for name, child := range v {
+			if key != "" {
+				name = key + "." + name
+			}
+			flattenInto(values, name, child)
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			values[key] = v
+		}
+	default:
+		values[key] = v
+	}
+}
+
+// keys returns the keys of the values, sorted
+func keys(values ...map[string]interface{}) []string {
+	set := make(map[string]bool)
+	for _, v := range values {
+		for key := range v {
+			set[key] = true
+		}
+	}
+	sorted := make([]string, 0, len(set))
+	for key := range set {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// sameValues tells whether the flattened values are all equal
+func sameValues(a map[string]interface{}, b map[string]interface{}) bool {
+	for _, key := range keys(a, b) {
+		if !equal(a[key], b[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// equal tells whether the flattened values are equal, the numbers all being float64 once flattened
+func equal(a interface{}, b interface{}) bool {
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,169 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package configdrift
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfiguration struct {
+	Writable struct {
+		LogLevel string
+	}
+	Service struct {
+		Host string
+		Port int
+	}
+	RequestLimits struct {
+		MaxBodySize int
+	}
+}
+
+const testFile = `
+[Writable]
+LogLevel = 'INFO'
+
+[Service]
+Host = 'localhost'
+Port = 48080
+
+[RequestLimits]
+MaxBodySize = 1024
+`
+
+func newTestDetector(t *testing.T, running *testConfiguration, registry *testConfiguration) *Detector {
+	dir, err := ioutil.TempDir("", "configdrift")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	file := filepath.Join(dir, "configuration.toml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(testFile), 0644))
+
+	var registryPath string
+	var source Source
+	if registry != nil {
+		registryPath = "edgex/core/1.0/edgex-core-data"
+		source = func(configuration interface{}) (interface{}, error) {
+			*configuration.(*testConfiguration) = *registry
+			return configuration, nil
+		}
+	}
+	// The environment overrides the host, i.e. Service_Host=edgex-core-data
+	override := func(configuration interface{}) (int, error) {
+		configuration.(*testConfiguration).Service.Host = "edgex-core-data"
+		return 1, nil
+	}
+	detector, err := NewDetector(running, file, registryPath, source, override, []string{"RequestLimits"})
+	require.NoError(t, err)
+	return detector
+}
+
+func newTestConfiguration(logLevel string, host string, port int, maxBodySize int) *testConfiguration {
+	configuration := &testConfiguration{}
+	configuration.Writable.LogLevel = logLevel
+	configuration.Service.Host = host
+	configuration.Service.Port = port
+	configuration.RequestLimits.MaxBodySize = maxBodySize
+	return configuration
+}
+
+func TestReport(t *testing.T) {
+	registry := newTestConfiguration("DEBUG", "localhost", 48081, 2048)
+	running := newTestConfiguration("DEBUG", "edgex-core-data", 48080, 1024)
+	detector := newTestDetector(t, running, registry)
+
+	report := detector.Report()
+	assert.Empty(t, report.Error)
+	assert.Equal(t, "edgex/core/1.0/edgex-core-data", report.Registry)
+	require.Len(t, report.Differences, 4)
+
+	assert.Equal(t, "RequestLimits.MaxBodySize", report.Differences[0].Key)
+	assert.True(t, report.Differences[0].Drift)
+	assert.False(t, report.Differences[0].RestartRequired)
+
+	assert.Equal(t, "Service.Host", report.Differences[1].Key)
+	assert.Equal(t, OverrideEnvironment, report.Differences[1].Override)
+	assert.False(t, report.Differences[1].Drift)
+
+	assert.Equal(t, "Service.Port", report.Differences[2].Key)
+	assert.Empty(t, report.Differences[2].Override)
+	assert.True(t, report.Differences[2].Drift)
+	assert.True(t, report.Differences[2].RestartRequired)
+
+	assert.Equal(t, "Writable.LogLevel", report.Differences[3].Key)
+	assert.Equal(t, OverrideRegistry, report.Differences[3].Override)
+	assert.True(t, report.Differences[3].Writable)
+	assert.Equal(t, "INFO", report.Differences[3].File)
+	assert.Equal(t, "DEBUG", report.Differences[3].Running)
+	assert.False(t, report.Differences[3].Drift)
+}
+
+func TestReportWithoutRegistry(t *testing.T) {
+	running := newTestConfiguration("INFO", "edgex-core-data", 48080, 512)
+	detector := newTestDetector(t, running, nil)
+
+	report := detector.Report()
+	assert.Empty(t, report.Registry)
+	require.Len(t, report.Differences, 2)
+	assert.Equal(t, "RequestLimits.MaxBodySize", report.Differences[0].Key)
+	assert.True(t, report.Differences[0].Drift)
+	assert.Nil(t, report.Differences[0].Registry)
+	assert.Equal(t, "Service.Host", report.Differences[1].Key)
+	assert.Equal(t, OverrideEnvironment, report.Differences[1].Override)
+}
+
+func TestReload(t *testing.T) {
+	registry := newTestConfiguration("DEBUG", "localhost", 48081, 2048)
+	running := newTestConfiguration("DEBUG", "edgex-core-data", 48080, 1024)
+	detector := newTestDetector(t, running, registry)
+
+	reloaded, err := detector.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"RequestLimits"}, reloaded)
+	assert.Equal(t, 2048, running.RequestLimits.MaxBodySize)
+	assert.Equal(t, 48080, running.Service.Port, "only the reload sections are reloaded")
+
+	reloaded, err = detector.Reload()
+	require.NoError(t, err)
+	assert.Empty(t, reloaded)
+}
+
+func TestUnknownReloadSection(t *testing.T) {
+	_, err := NewDetector(&testConfiguration{}, "", "", nil, nil, []string{"Unknown"})
+	assert.Error(t, err)
+}
+
+func TestReportHandler(t *testing.T) {
+	detector := newTestDetector(t, newTestConfiguration("INFO", "edgex-core-data", 48080, 1024), nil)
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, ApiConfigDriftRoute, http.NoBody)
+	detector.ReportHandler(recorder, req, logger.NewMockClient())
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+	require.Len(t, report.Differences, 1)
+	assert.Equal(t, "Service.Host", report.Differences[0].Key)
+}
@@ -28,6 +28,7 @@ const (
 	EventsCollection          = "event"
 	ReadingsCollection        = "reading"
 	ValueDescriptorCollection = "valueDescriptor"
+	ReadingRollupCollection   = "readingRollup"
 
 	//Export
 	ExportCollection = "exportConfiguration"
@@ -50,6 +51,9 @@ const (
 	Notification = "notification"
 	Subscription = "subscription"
 	Transmission = "transmission"
+
+	// Command
+	CommandAudit = "commandAudit"
 )
 
 var (
@@ -60,6 +64,8 @@ var (
 	ErrCommandStillInUse   = errors.New("Command is still in use by device profiles")
 	ErrSlugEmpty           = errors.New("Slug is nil or empty")
 	ErrNameEmpty           = errors.New("Name is required")
+	ErrRolledUpMeanwhile   = errors.New("Events rolled up meanwhile")
+	ErrModifiedMeanwhile   = errors.New("Object modified meanwhile")
 )
 
 type Configuration struct {
@@ -71,6 +77,21 @@ type Configuration struct {
 	Username     string
 	Password     string
 	BatchSize    int
+	// EncryptionKeys are the AES keys, by key id, the event, reading and notification payloads are encrypted with.
+	// The payloads are stored in clear when there are no keys.
+	EncryptionKeys map[string][]byte
+	// EncryptionKeyId is the id of the key new payloads are encrypted with
+	EncryptionKeyId string
+	// IndexCollection is the collection written by the service, IndexCollectionDevice or IndexCollectionEvent, the
+	// Indexes are declared on
+	IndexCollection string
+	// Indexes are the secondary indexes declared by the service, by name
+	Indexes map[string]IndexInfo
+	// Replicas are the read replicas the read-only queries are routed to while their staleness is at most
+	// MaxStaleness, checked every ReplicaCheckInterval
+	Replicas             []Replica
+	MaxStaleness         time.Duration
+	ReplicaCheckInterval time.Duration
 }
 
 func MakeTimestamp() int64 {
@@ -0,0 +1,57 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package db
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// EncryptionInfo configures the encryption of the payloads the service persists, for deployments where the database
+// files land on media which can't be trusted. The keys are read from the secret at SecretPath, each secret key being
+// a key id and each value a base64 encoded 128, 192 or 256 bits AES key. New payloads are encrypted with the key
+// KeyId names, the other keys of the secret only decrypt the payloads written before the keys were rotated.
+type EncryptionInfo struct {
+	Enabled    bool
+	SecretPath string
+	KeyId      string
+}
+
+// EncryptionKeys decodes the encryption keys held by the secrets and checks the key new payloads are encrypted with
+// is one of them
+func EncryptionKeys(info EncryptionInfo, secrets map[string]string) (map[string][]byte, error) {
+	keys := make(map[string][]byte, len(secrets))
+	for id, secret := range secrets {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("invalid encryption key id '%s'", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(secret)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key '%s' is not base64 encoded: %v", id, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("encryption key '%s' must be 16, 24 or 32 bytes long, not %d", id, len(key))
+		}
+		keys[id] = key
+	}
+
+	if _, ok := keys[info.KeyId]; !ok {
+		return nil, fmt.Errorf("encryption key '%s' not found in secret '%s'", info.KeyId, info.SecretPath)
+	}
+	return keys, nil
+}
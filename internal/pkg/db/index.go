@@ -0,0 +1,55 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package db
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// IndexCollectionDevice is the collection of the indexes of devices
+	IndexCollectionDevice = "device"
+	// IndexCollectionEvent is the collection of the indexes of events
+	IndexCollectionEvent = "event"
+)
+
+// IndexInfo declares a secondary index the database maintains whenever an object of the collection written by the
+// service is written, so that deployments can query objects by a field the service doesn't index, i.e. devices by
+// protocol name or events by tag. Path is the dot separated path of the indexed field in the JSON representation of
+// the object. A '*' segment indexes the keys of a map, i.e. 'protocols.*' or 'tags.*', and every element of an array
+// is indexed, i.e. 'labels'.
+type IndexInfo struct {
+	Path string
+}
+
+// ValidateIndexes checks the indexes, by name, are declared with a valid path
+func ValidateIndexes(indexes map[string]IndexInfo) error {
+	for name, index := range indexes {
+		if name == "" || strings.ContainsAny(name, ":*?[] ") {
+			return fmt.Errorf("invalid index name '%s'", name)
+		}
+		segments := strings.Split(index.Path, ".")
+		for i, segment := range segments {
+			if segment == "" {
+				return fmt.Errorf("index %s has invalid path '%s'", name, index.Path)
+			}
+			if segment == "*" && i != len(segments)-1 {
+				return fmt.Errorf("index %s path '%s' may only end with '*'", name, index.Path)
+			}
+		}
+	}
+	return nil
+}
@@ -15,7 +15,14 @@
 package interfaces
 
 import (
+	"time"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	dataModels "github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	metadataModels "github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
 	correlation "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
@@ -59,6 +66,10 @@ type DBClient interface {
 	ReadingsByValueDescriptor(name string, limit int) ([]contract.Reading, error)
 	ReadingsByValueDescriptorNames(names []string, limit int) ([]contract.Reading, error)
 	ReadingsByCreationTime(start, end int64, limit int) ([]contract.Reading, error)
+	ScanReadingsByCreationTime(start, end int64, device string, batchSize int, fn func(readings []contract.Reading) error) error
+	RolledUpTo(events string) (int64, error)
+	AddReadingRollups(events string, rollups []dataModels.ReadingRollup, from int64, to int64) error
+	ReadingRollups(device string, name string, start int64, end int64) ([]dataModels.ReadingRollup, error)
 	ReadingsByDeviceAndValueDescriptor(deviceId, valueDescriptor string, limit int) ([]contract.Reading, error)
 
 	/*
@@ -141,6 +152,23 @@ type DBClient interface {
 	UpdateDeviceService(ds contract.DeviceService) error
 	DeleteDeviceServiceById(id string) error
 
+	/*
+		Device Service Registration
+	*/
+	AddRegistrationToken(hash string, token metadataModels.RegistrationToken) error
+	GetRegistrationToken(hash string) (metadataModels.RegistrationToken, error)
+	RedeemRegistrationToken(hash string, serviceId string) error
+	GetDeviceServiceCredential(serviceId string) (string, error)
+
+	/*
+		Device Service Callback Failures
+	*/
+	AddCallbackFailure(f metadataModels.CallbackFailure) (string, error)
+	GetCallbackFailures() ([]metadataModels.CallbackFailure, error)
+	GetCallbackFailureById(id string) (metadataModels.CallbackFailure, error)
+	UpdateCallbackFailure(f metadataModels.CallbackFailure) error
+	DeleteCallbackFailureById(id string) error
+
 	/*
 		Provision Watchers
 	*/
@@ -163,6 +191,28 @@ type DBClient interface {
 	GetCommandsByDeviceId(did string) ([]contract.Command, error)
 	GetCommandByNameAndDeviceId(cname string, did string) (contract.Command, error)
 
+	/*
+		Command Audit
+	*/
+	AddCommandAudit(a commandModels.CommandAudit) (string, error)
+	GetCommandAudits(start int64, end int64, limit int) ([]commandModels.CommandAudit, error)
+	GetCommandAuditsByDevice(deviceName string, start int64, end int64, limit int) ([]commandModels.CommandAudit, error)
+	DeleteCommandAuditsOld(age int64) (int, error)
+
+	/*
+		Device Value Transforms
+	*/
+	SetDeviceValueTransforms(deviceId string, transforms map[string]commandModels.ValueTransform) error
+	GetDeviceValueTransforms(deviceId string) (map[string]commandModels.ValueTransform, error)
+	DeleteDeviceValueTransforms(deviceId string) error
+
+	/*
+		Device Simulations
+	*/
+	SetDeviceSimulation(deviceId string, simulation commandModels.DeviceSimulation) error
+	GetDeviceSimulation(deviceId string) (commandModels.DeviceSimulation, error)
+	DeleteDeviceSimulation(deviceId string) error
+
 	ScrubMetadata() error
 
 	/*
@@ -199,6 +249,43 @@ type DBClient interface {
 	UpdateSubscription(sub contract.Subscription) error
 	DeleteSubscriptionBySlug(slug string) error
 	GetSubscriptions() ([]contract.Subscription, error)
+	SetSubscriptionCallback(id string, url string) error
+	GetSubscriptionCallback(id string) (string, error)
+	DeleteSubscriptionCallback(id string) error
+	SetSubscriptionRetryPolicy(id string, policy notificationsModels.RetryPolicy) error
+	GetSubscriptionRetryPolicy(id string) (notificationsModels.RetryPolicy, error)
+	DeleteSubscriptionRetryPolicy(id string) error
+	SetSubscriptionLocale(id string, locale string) error
+	GetSubscriptionLocale(id string) (string, error)
+	DeleteSubscriptionLocale(id string) error
+
+	/*
+		Allowed senders
+	*/
+	AddAllowedSender(s notificationsModels.AllowedSender) error
+	GetAllowedSenders() ([]notificationsModels.AllowedSender, error)
+	GetAllowedSender(sender string) (notificationsModels.AllowedSender, error)
+	GetAllowedSenderByToken(hash string) (notificationsModels.AllowedSender, error)
+	DeleteAllowedSender(sender string) error
+
+	/*
+		Notification occurrences
+	*/
+	AddNotificationOccurrences(id string, o notificationsModels.NotificationOccurrences, window time.Duration) error
+	CoalesceNotification(hash string, at int64) (string, notificationsModels.NotificationOccurrences, error)
+	GetNotificationOccurrences(id string) (notificationsModels.NotificationOccurrences, error)
+
+	/*
+		Notification attachments
+	*/
+	SetNotificationAttachments(id string, attachments []notificationsModels.NotificationAttachment) error
+	GetNotificationAttachments(id string) ([]notificationsModels.NotificationAttachment, error)
+
+	/*
+		Notification distributions
+	*/
+	SetNotificationDistribution(d notificationsModels.NotificationDistribution) error
+	GetNotificationDistribution(id string) (notificationsModels.NotificationDistribution, error)
 
 	/*
 		Transmissions
@@ -216,6 +303,7 @@ type DBClient interface {
 
 	Cleanup() error
 	CleanupOld(age int) error
+	CleanupByCriteria(criteria notificationsModels.CleanupCriteria) (notificationsModels.CleanupResult, error)
 
 	/*
 		Intervals
@@ -240,6 +328,27 @@ type DBClient interface {
 	AddIntervalAction(action contract.IntervalAction) (string, error)
 	UpdateIntervalAction(action contract.IntervalAction) error
 	DeleteIntervalActionById(id string) error
+	SetIntervalActionOverlapPolicy(id string, policy schedulerModels.OverlapPolicy) error
+	GetIntervalActionOverlapPolicy(id string) (schedulerModels.OverlapPolicy, error)
+	DeleteIntervalActionOverlapPolicy(id string) error
+
+	/*
+		Blackout Calendars
+	*/
+	BlackoutCalendars() ([]schedulerModels.BlackoutCalendar, error)
+	BlackoutCalendarByName(name string) (schedulerModels.BlackoutCalendar, error)
+	SetBlackoutCalendar(calendar schedulerModels.BlackoutCalendar) error
+	DeleteBlackoutCalendarByName(name string) error
+	SetIntervalBlackoutCalendars(intervalId string, names []string) error
+	IntervalBlackoutCalendars(intervalId string) ([]string, error)
+
+	/*
+		Delayed Actions
+	*/
+	DelayedActions() ([]schedulerModels.DelayedAction, error)
+	DelayedActionByName(name string) (schedulerModels.DelayedAction, error)
+	AddDelayedAction(action schedulerModels.DelayedAction) error
+	DeleteDelayedActionByName(name string) error
 
 	ScrubAllIntervalActions() (int, error)
 	ScrubAllIntervals() (int, error)
@@ -0,0 +1,135 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"sort"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// AllowedSenderKey holds the senders allowed to post notifications, by sender
+	AllowedSenderKey = db.Notification + ":allowedSender"
+	// AllowedSenderTokenKey holds the sender each internal token was minted for, by token hash
+	AllowedSenderTokenKey = db.Notification + ":allowedSender:token"
+)
+
+// ******************************* ALLOWED SENDERS **********************************
+
+// AddAllowedSender adds the sender to the allow-list, replacing it and revoking its internal token when it is already
+// allowed
+func (c Client) AddAllowedSender(s notificationsModels.AllowedSender) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	previous, err := getAllowedSender(conn, s.Sender)
+	if err != nil && err != db.ErrNotFound {
+		return err
+	}
+	m, err := marshalObject(s)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	if previous.TokenHash != "" {
+		_ = conn.Send("HDEL", AllowedSenderTokenKey, previous.TokenHash)
+	}
+	_ = conn.Send("HSET", AllowedSenderKey, s.Sender, m)
+	if s.TokenHash != "" {
+		_ = conn.Send("HSET", AllowedSenderTokenKey, s.TokenHash, s.Sender)
+	}
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// GetAllowedSenders returns the allow-list, sorted by sender
+func (c Client) GetAllowedSenders() ([]notificationsModels.AllowedSender, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do("HVALS", AllowedSenderKey))
+	if err != nil {
+		return nil, err
+	}
+	senders := make([]notificationsModels.AllowedSender, len(objects))
+	for i, object := range objects {
+		if err = unmarshalObject(object, &senders[i]); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return senders[i].Sender < senders[j].Sender
+	})
+	return senders, nil
+}
+
+// GetAllowedSender returns the sender of the allow-list, db.ErrNotFound when it isn't allowed
+func (c Client) GetAllowedSender(sender string) (notificationsModels.AllowedSender, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return getAllowedSender(conn, sender)
+}
+
+// GetAllowedSenderByToken returns the sender of the allow-list the internal token of the hash was minted for
+func (c Client) GetAllowedSenderByToken(hash string) (notificationsModels.AllowedSender, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	sender, err := redis.String(conn.Do("HGET", AllowedSenderTokenKey, hash))
+	if err != nil {
+		if err == redis.ErrNil {
+			return notificationsModels.AllowedSender{}, db.ErrNotFound
+		}
+		return notificationsModels.AllowedSender{}, err
+	}
+	return getAllowedSender(conn, sender)
+}
+
+// DeleteAllowedSender removes the sender from the allow-list along with its internal token
+func (c Client) DeleteAllowedSender(sender string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	s, err := getAllowedSender(conn, sender)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("HDEL", AllowedSenderKey, sender)
+	if s.TokenHash != "" {
+		_ = conn.Send("HDEL", AllowedSenderTokenKey, s.TokenHash)
+	}
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+func getAllowedSender(conn redis.Conn, sender string) (s notificationsModels.AllowedSender, err error) {
+	object, err := redis.Bytes(conn.Do("HGET", AllowedSenderKey, sender))
+	if err != nil {
+		if err == redis.ErrNil {
+			return s, db.ErrNotFound
+		}
+		return s, err
+	}
+	err = unmarshalObject(object, &s)
+	return s, err
+}
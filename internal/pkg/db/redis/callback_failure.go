@@ -0,0 +1,122 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"sort"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// CallbackFailureKey holds the failed callbacks to device services, by id
+const CallbackFailureKey = db.DeviceService + ":callbackFailure"
+
+// ******************************* CALLBACK FAILURES **********************************
+
+// AddCallbackFailure stores a failed callback and returns its id
+func (c *Client) AddCallbackFailure(f models.CallbackFailure) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if f.Id == "" {
+		f.Id = uuid.New().String()
+	}
+	m, err := marshalObject(f)
+	if err != nil {
+		return "", err
+	}
+	_, err = conn.Do("HSET", CallbackFailureKey, f.Id, m)
+	if err != nil {
+		return "", err
+	}
+	return f.Id, nil
+}
+
+// GetCallbackFailures returns the failed callbacks, oldest first
+func (c *Client) GetCallbackFailures() ([]models.CallbackFailure, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do("HVALS", CallbackFailureKey))
+	if err != nil {
+		return nil, err
+	}
+
+	failures := make([]models.CallbackFailure, len(objects))
+	for i, object := range objects {
+		if err = unmarshalObject(object, &failures[i]); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(failures, func(i, j int) bool { return failures[i].Created < failures[j].Created })
+	return failures, nil
+}
+
+// GetCallbackFailureById returns the failed callback with the id
+func (c *Client) GetCallbackFailureById(id string) (models.CallbackFailure, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var f models.CallbackFailure
+	object, err := redis.Bytes(conn.Do("HGET", CallbackFailureKey, id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return f, db.ErrNotFound
+		}
+		return f, err
+	}
+
+	err = unmarshalObject(object, &f)
+	return f, err
+}
+
+// UpdateCallbackFailure replaces the stored failed callback with the same id
+func (c *Client) UpdateCallbackFailure(f models.CallbackFailure) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	exists, err := redis.Bool(conn.Do("HEXISTS", CallbackFailureKey, f.Id))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return db.ErrNotFound
+	}
+	m, err := marshalObject(f)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", CallbackFailureKey, f.Id, m)
+	return err
+}
+
+// DeleteCallbackFailureById removes the failed callback with the id
+func (c *Client) DeleteCallbackFailureById(id string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", CallbackFailureKey, id))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
@@ -35,6 +35,7 @@ type Client struct {
 	Pool          *redis.Pool // A thread-safe pool of connections to Redis
 	BatchSize     int
 	loggingClient logger.LoggingClient
+	cipher        *payloadCipher // encrypts the payloads, nil when they are stored in clear
 }
 
 type CoreDataClient struct {
@@ -61,46 +62,30 @@ func NewCoreDataClient(config db.Configuration, logger logger.LoggingClient) (*C
 
 // Return a pointer to the Redis client
 func NewClient(config db.Configuration, lc logger.LoggingClient) (*Client, error) {
+	var cipherErr error
 	once.Do(func() {
-		connectionString := fmt.Sprintf("%s:%d", config.Host, config.Port)
-		opts := []redis.DialOption{
-			redis.DialConnectTimeout(time.Duration(config.Timeout) * time.Millisecond),
-		}
-		if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
-			opts = append(opts, redis.DialPassword(config.Password))
+		pc, err := newPayloadCipher(config.EncryptionKeys, config.EncryptionKeyId)
+		if err != nil {
+			cipherErr = err
+			return
 		}
 
-		dialFunc := func() (redis.Conn, error) {
-			conn, err := redis.Dial(
-				"tcp", connectionString, opts...,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("Could not dial Redis: %s", err)
-			}
-			return conn, nil
-		}
 		// Default the batch size to 1,000 if not set
 		batchSize := 1000
 		if config.BatchSize != 0 {
 			batchSize = config.BatchSize
 		}
 		currClient = &Client{
-			Pool: &redis.Pool{
-				IdleTimeout: 0,
-				/* The current implementation processes nested structs using concurrent connections.
-				 * With the deepest nesting level being 3, three shall be the number of maximum open
-				 * idle connections in the pool, to allow reuse.
-				 * TODO: Once we have a concurrent benchmark, this should be revisited.
-				 * TODO: Longer term, once the objects are clean of external dependencies, the use
-				 * of another serializer should make this moot.
-				 */
-				MaxIdle: 10,
-				Dial:    dialFunc,
-			},
+			Pool:          NewPool(config.Host, config.Port, config.Timeout, config.Password),
 			BatchSize:     batchSize,
 			loggingClient: lc,
+			cipher:        pc,
 		}
 	})
+	if cipherErr != nil {
+		once = sync.Once{}
+		return nil, cipherErr
+	}
 
 	// Test connectivity now so don't have failures later when doing lazy connect.
 	if _, err := currClient.Pool.Dial(); err != nil {
@@ -110,6 +95,40 @@ func NewClient(config db.Configuration, lc logger.LoggingClient) (*Client, error
 	return currClient, nil
 }
 
+// NewPool returns a pool of connections to the Redis server at the host and port, the connection timeout being in
+// milliseconds
+func NewPool(host string, port int, timeout int, password string) *redis.Pool {
+	connectionString := fmt.Sprintf("%s:%d", host, port)
+	opts := []redis.DialOption{
+		redis.DialConnectTimeout(time.Duration(timeout) * time.Millisecond),
+	}
+	if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
+		opts = append(opts, redis.DialPassword(password))
+	}
+
+	dialFunc := func() (redis.Conn, error) {
+		conn, err := redis.Dial(
+			"tcp", connectionString, opts...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Could not dial Redis: %s", err)
+		}
+		return conn, nil
+	}
+	return &redis.Pool{
+		IdleTimeout: 0,
+		/* The current implementation processes nested structs using concurrent connections.
+		 * With the deepest nesting level being 3, three shall be the number of maximum open
+		 * idle connections in the pool, to allow reuse.
+		 * TODO: Once we have a concurrent benchmark, this should be revisited.
+		 * TODO: Longer term, once the objects are clean of external dependencies, the use
+		 * of another serializer should make this moot.
+		 */
+		MaxIdle: 10,
+		Dial:    dialFunc,
+	}
+}
+
 // Connect connects to Redis
 func (c *Client) Connect() error {
 	return nil
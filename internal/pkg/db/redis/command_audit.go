@@ -0,0 +1,124 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+// ******************************* COMMAND AUDIT **********************************
+
+// AddCommandAudit stores the audit record of a command, indexed by timestamp and by device name
+func (c *Client) AddCommandAudit(a models.CommandAudit) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if a.Id == "" {
+		a.Id = uuid.New().String()
+	}
+	if a.Timestamp == 0 {
+		a.Timestamp = db.MakeTimestamp()
+	}
+
+	m, err := marshalObject(a)
+	if err != nil {
+		return "", err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("SET", a.Id, m)
+	_ = conn.Send("ZADD", db.CommandAudit, a.Timestamp, a.Id)
+	if a.DeviceName != "" {
+		_ = conn.Send("ZADD", db.CommandAudit+":device:"+a.DeviceName, a.Timestamp, a.Id)
+	}
+	_, err = conn.Do("EXEC")
+	if err != nil {
+		return "", err
+	}
+	return a.Id, nil
+}
+
+// GetCommandAudits returns up to limit audit records of commands issued between start and end, oldest first
+func (c *Client) GetCommandAudits(start int64, end int64, limit int) ([]models.CommandAudit, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, db.CommandAudit, start, end, limit)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalCommandAudits(objects)
+}
+
+// GetCommandAuditsByDevice returns up to limit audit records of commands issued to the device between start and end,
+// oldest first
+func (c *Client) GetCommandAuditsByDevice(deviceName string, start int64, end int64, limit int) ([]models.CommandAudit, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, db.CommandAudit+":device:"+deviceName, start, end, limit)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalCommandAudits(objects)
+}
+
+// DeleteCommandAuditsOld deletes the audit records older than age milliseconds and returns the number deleted
+func (c *Client) DeleteCommandAuditsOld(age int64) (int, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := getObjectsByScore(conn, db.CommandAudit, 0, db.MakeTimestamp()-age, 0)
+	if err != nil {
+		return 0, err
+	}
+	audits, err := unmarshalCommandAudits(objects)
+	if err != nil || len(audits) == 0 {
+		return 0, err
+	}
+
+	_ = conn.Send("MULTI")
+	for _, a := range audits {
+		_ = conn.Send("DEL", a.Id)
+		_ = conn.Send("ZREM", db.CommandAudit, a.Id)
+		if a.DeviceName != "" {
+			_ = conn.Send("ZREM", db.CommandAudit+":device:"+a.DeviceName, a.Id)
+		}
+	}
+	_, err = redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		return 0, err
+	}
+	return len(audits), nil
+}
+
+func unmarshalCommandAudits(objects [][]byte) ([]models.CommandAudit, error) {
+	audits := make([]models.CommandAudit, 0, len(objects))
+	for _, o := range objects {
+		if len(o) == 0 { // the record was deleted meanwhile
+			continue
+		}
+		var a models.CommandAudit
+		if err := unmarshalObject(o, &a); err != nil {
+			return nil, err
+		}
+		audits = append(audits, a)
+	}
+	return audits, nil
+}
@@ -0,0 +1,72 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// DeviceSimulationKey holds the simulation of each simulated device, by device id
+const DeviceSimulationKey = db.Device + ":simulation"
+
+// ******************************* DEVICE SIMULATIONS **********************************
+
+// SetDeviceSimulation stores the simulation of the device, marking it simulated
+func (c *Client) SetDeviceSimulation(deviceId string, simulation models.DeviceSimulation) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := marshalObject(simulation)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", DeviceSimulationKey, deviceId, m)
+	return err
+}
+
+// GetDeviceSimulation returns the simulation of the device, db.ErrNotFound when the device isn't simulated
+func (c *Client) GetDeviceSimulation(deviceId string) (models.DeviceSimulation, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var simulation models.DeviceSimulation
+	object, err := redis.Bytes(conn.Do("HGET", DeviceSimulationKey, deviceId))
+	if err != nil {
+		if err == redis.ErrNil {
+			return simulation, db.ErrNotFound
+		}
+		return simulation, err
+	}
+
+	err = unmarshalObject(object, &simulation)
+	return simulation, err
+}
+
+// DeleteDeviceSimulation removes the simulation of the device, its commands are sent to its device service again
+func (c *Client) DeleteDeviceSimulation(deviceId string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", DeviceSimulationKey, deviceId))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// DeviceValueTransformKey holds the value transformation overrides of each device, by device id
+const DeviceValueTransformKey = db.Device + ":valueTransform"
+
+// ******************************* DEVICE VALUE TRANSFORMS **********************************
+
+// SetDeviceValueTransforms stores the value transformation overrides of the device, by device resource name
+func (c *Client) SetDeviceValueTransforms(deviceId string, transforms map[string]models.ValueTransform) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := marshalObject(transforms)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", DeviceValueTransformKey, deviceId, m)
+	return err
+}
+
+// GetDeviceValueTransforms returns the value transformation overrides of the device, by device resource name
+func (c *Client) GetDeviceValueTransforms(deviceId string) (map[string]models.ValueTransform, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	object, err := redis.Bytes(conn.Do("HGET", DeviceValueTransformKey, deviceId))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, db.ErrNotFound
+		}
+		return nil, err
+	}
+
+	var transforms map[string]models.ValueTransform
+	err = unmarshalObject(object, &transforms)
+	return transforms, err
+}
+
+// DeleteDeviceValueTransforms removes the value transformation overrides of the device
+func (c *Client) DeleteDeviceValueTransforms(deviceId string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", DeviceValueTransformKey, deviceId))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
@@ -810,6 +810,62 @@ func (c *Client) ReadingsByCreationTime(start, end int64, limit int) (readings [
 	return readings, nil
 }
 
+// ScanReadingsByCreationTime passes the readings created between start and end, of device when it isn't empty, in
+// order of creation, to fn in batches of at most batchSize readings, fetching the next batch only once fn returns.
+// The readings of a device are scanned from its own index rather than from all the readings. The scan stops at the
+// first error returned by fn.
+func (c *Client) ScanReadingsByCreationTime(
+	start, end int64,
+	device string,
+	batchSize int,
+	fn func(readings []contract.Reading) error) error {
+
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d", batchSize)
+	}
+
+	key := db.ReadingsCollection + ":created"
+	if device != "" {
+		key = db.ReadingsCollection + ":device:" + device
+	}
+	for offset := 0; ; offset += batchSize {
+		ids, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, start, end, "LIMIT", offset, batchSize))
+		if err != nil && err != redis.ErrNil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		objects, err := redis.ByteSlices(conn.Do("MGET", ids...))
+		if err != nil {
+			return err
+		}
+		readings := make([]contract.Reading, 0, len(objects))
+		for _, in := range objects {
+			// the reading was deleted since its id was fetched
+			if in == nil {
+				continue
+			}
+			var reading contract.Reading
+			if err = unmarshalObject(in, &reading); err != nil {
+				return err
+			}
+			readings = append(readings, reading)
+		}
+		if err = fn(readings); err != nil {
+			return err
+		}
+
+		if len(ids) < batchSize {
+			return nil
+		}
+	}
+}
+
 // ************************** VALUE DESCRIPTOR FUNCTIONS ***************************
 // Add a value descriptor
 // 409 - Formatting is bad or it is not unique
@@ -1199,7 +1255,7 @@ func addReading(conn redis.Conn, tx bool, r contract.Reading) (id string, err er
 		r.Id = uuid.New().String()
 	}
 
-	m, err := marshalObject(r)
+	m, err := marshalPayload(r)
 	if err != nil {
 		return r.Id, err
 	}
@@ -0,0 +1,120 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package redis
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+// encryptedPrefix starts the encrypted payloads, followed by the id of the key, a colon, the nonce and the sealed
+// payload. JSON payloads stored in clear never start with it so both can be read side by side.
+var encryptedPrefix = []byte("enc:")
+
+// payloadCipher encrypts the payloads with AES-GCM. Payloads are encrypted with the current key and decrypted with
+// the key they were encrypted with, so that keys can be rotated without re-encrypting the stored payloads.
+type payloadCipher struct {
+	keyId string
+	aeads map[string]cipher.AEAD
+}
+
+func newPayloadCipher(keys map[string][]byte, keyId string) (*payloadCipher, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	if _, ok := keys[keyId]; !ok {
+		return nil, fmt.Errorf("encryption key '%s' not found", keyId)
+	}
+
+	c := &payloadCipher{keyId: keyId, aeads: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key '%s': %v", id, err)
+		}
+		c.aeads[id], err = cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *payloadCipher) encrypt(in []byte) ([]byte, error) {
+	aead := c.aeads[c.keyId]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(encryptedPrefix)+len(c.keyId)+1+len(nonce)+len(in)+aead.Overhead())
+	out = append(out, encryptedPrefix...)
+	out = append(out, c.keyId...)
+	out = append(out, ':')
+	out = append(out, nonce...)
+	// the key id is authenticated so that a payload can't be passed off as encrypted with another key
+	return aead.Seal(out, nonce, in, []byte(c.keyId)), nil
+}
+
+func (c *payloadCipher) decrypt(in []byte) ([]byte, error) {
+	in = in[len(encryptedPrefix):]
+	i := bytes.IndexByte(in, ':')
+	if i < 0 {
+		return nil, fmt.Errorf("malformed encrypted payload")
+	}
+	keyId := string(in[:i])
+	aead, ok := c.aeads[keyId]
+	if !ok {
+		return nil, fmt.Errorf("payload encrypted with unknown key '%s'", keyId)
+	}
+
+	in = in[i+1:]
+	if len(in) < aead.NonceSize() {
+		return nil, fmt.Errorf("malformed encrypted payload")
+	}
+	return aead.Open(nil, in[:aead.NonceSize()], in[aead.NonceSize():], []byte(keyId))
+}
+
+// EncryptPayload encrypts the payload when the client is configured with encryption keys, otherwise the payload is
+// returned as is
+func EncryptPayload(in []byte) ([]byte, error) {
+	if currClient == nil || currClient.cipher == nil {
+		return in, nil
+	}
+	return currClient.cipher.encrypt(in)
+}
+
+// DecryptPayload decrypts the payload when it was stored encrypted, otherwise the payload is returned as is
+func DecryptPayload(in []byte) ([]byte, error) {
+	if !bytes.HasPrefix(in, encryptedPrefix) {
+		return in, nil
+	}
+	if currClient == nil || currClient.cipher == nil {
+		return nil, fmt.Errorf("payload is encrypted but no encryption key is configured")
+	}
+	return currClient.cipher.decrypt(in)
+}
+
+// marshalPayload marshals the event, reading or notification and encrypts it when encryption is configured
+func marshalPayload(in interface{}) (out []byte, err error) {
+	out, err = marshalObject(in)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptPayload(out)
+}
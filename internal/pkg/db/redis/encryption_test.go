@@ -0,0 +1,121 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package redis
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testKey1 = bytes.Repeat([]byte{1}, 32)
+	testKey2 = bytes.Repeat([]byte{2}, 16)
+)
+
+func withCipher(t *testing.T, keys map[string][]byte, keyId string) {
+	c, err := newPayloadCipher(keys, keyId)
+	require.NoError(t, err)
+	previous := currClient
+	currClient = &Client{cipher: c}
+	t.Cleanup(func() { currClient = previous })
+}
+
+func TestPayloadEncryption(t *testing.T) {
+	event := redisEvent{ID: "id", Device: "thermostat", Tags: map[string]string{"site": "basement"}}
+
+	withCipher(t, map[string][]byte{"1": testKey1}, "1")
+	encrypted, err := marshalPayload(event)
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(encrypted, []byte("enc:1:")))
+	assert.NotContains(t, string(encrypted), "thermostat")
+
+	var decrypted redisEvent
+	require.NoError(t, unmarshalObject(encrypted, &decrypted))
+	assert.Equal(t, event, decrypted)
+
+	// payloads stored in clear remain readable
+	clear, err := marshalObject(event)
+	require.NoError(t, err)
+	decrypted = redisEvent{}
+	require.NoError(t, unmarshalObject(clear, &decrypted))
+	assert.Equal(t, event, decrypted)
+
+	// tampered payloads are rejected
+	encrypted[len(encrypted)-1] ^= 1
+	assert.Error(t, unmarshalObject(encrypted, &decrypted))
+}
+
+func TestPayloadEncryptionKeyRotation(t *testing.T) {
+	withCipher(t, map[string][]byte{"1": testKey1}, "1")
+	before, err := EncryptPayload([]byte(`{"id":"before"}`))
+	require.NoError(t, err)
+
+	withCipher(t, map[string][]byte{"1": testKey1, "2": testKey2}, "2")
+	after, err := EncryptPayload([]byte(`{"id":"after"}`))
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(after, []byte("enc:2:")))
+
+	out, err := DecryptPayload(before)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"before"}`, string(out))
+	out, err = DecryptPayload(after)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"after"}`, string(out))
+
+	// the retired key is needed for as long as payloads encrypted with it are stored
+	withCipher(t, map[string][]byte{"2": testKey2}, "2")
+	_, err = DecryptPayload(before)
+	assert.Error(t, err)
+}
+
+func TestPayloadEncryptionDisabled(t *testing.T) {
+	withCipher(t, nil, "")
+	out, err := EncryptPayload([]byte(`{"id":"clear"}`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"clear"}`, string(out))
+
+	_, err = DecryptPayload([]byte("enc:1:payload"))
+	assert.Error(t, err, "encrypted payload decrypted without key")
+}
+
+func TestEncryptionKeys(t *testing.T) {
+	info := db.EncryptionInfo{Enabled: true, SecretPath: "coredata-encryption", KeyId: "2"}
+	encode := base64.StdEncoding.EncodeToString
+
+	keys, err := db.EncryptionKeys(info, map[string]string{"1": encode(testKey1), "2": encode(testKey2)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"1": testKey1, "2": testKey2}, keys)
+
+	tests := []struct {
+		name    string
+		secrets map[string]string
+	}{
+		{"current key missing", map[string]string{"1": encode(testKey1)}},
+		{"not base64", map[string]string{"2": "not base64!"}},
+		{"invalid key length", map[string]string{"2": encode([]byte("short"))}},
+		{"invalid key id", map[string]string{"2": encode(testKey2), "a:b": encode(testKey1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := db.EncryptionKeys(info, tt.secrets)
+			assert.Error(t, err)
+		})
+	}
+}
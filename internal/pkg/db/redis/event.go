@@ -14,8 +14,6 @@
 package redis
 
 import (
-	"encoding/json"
-
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	correlation "github.com/edgexfoundry/edgex-go/internal/pkg/correlation/models"
@@ -47,7 +45,7 @@ func marshalEvent(event correlation.Event) (out []byte, err error) {
 		Tags:     event.Tags,
 	}
 
-	return marshalObject(s)
+	return marshalPayload(s)
 }
 
 func unmarshalEvents(objects [][]byte, events []contract.Event) (err error) {
@@ -108,7 +106,7 @@ func unmarshalEvent(o []byte) (contract.Event, error) {
 func unmarshalRedisEvent(o []byte) (redisEvent, error) {
 	var event redisEvent
 
-	err := json.Unmarshal(o, &event)
+	err := unmarshalObject(o, &event)
 	if err != nil {
 		return redisEvent{}, err
 	}
@@ -205,7 +205,12 @@ func (c *Client) DeleteDeviceById(id string) error {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return deleteDevice(conn, id)
+	if err := deleteDevice(conn, id); err != nil {
+		return err
+	}
+	// the overrides are kept when the device is updated, which deletes and adds the device again
+	_, err := conn.Do("HDEL", DeviceValueTransformKey, id)
+	return err
 }
 
 func (c *Client) GetAllDevices() ([]contract.Device, error) {
@@ -803,7 +808,11 @@ func (c *Client) DeleteDeviceServiceById(id string) error {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return deleteDeviceService(conn, id)
+	if err := deleteDeviceService(conn, id); err != nil {
+		return err
+	}
+	_, err := conn.Do("HDEL", DeviceServiceCredentialKey, id)
+	return err
 }
 
 func addDeviceService(conn redis.Conn, ds contract.DeviceService) (string, error) {
@@ -22,6 +22,13 @@ import (
 const (
 	IntervalKey     = db.Interval
 	IntervalNameKey = db.Interval + ":name"
+	IntervalLockKey = db.Interval + ":lock"
+	// IntervalBlackoutKey holds the names of the blackout calendars attached to intervals by ID
+	IntervalBlackoutKey = db.Interval + ":blackout"
+	// BlackoutCalendarKey holds the blackout calendars by name
+	BlackoutCalendarKey = db.Interval + ":blackoutCalendar"
+	// DelayedActionKey holds the delayed actions by name
+	DelayedActionKey = db.IntervalAction + ":delayed"
 )
 
 var intervalKeys = []string{IntervalKey, IntervalNameKey}
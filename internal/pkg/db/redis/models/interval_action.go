@@ -24,6 +24,8 @@ const (
 	IntervalActionNameKey   = db.IntervalAction + ":name"
 	IntervalActionParentKey = db.IntervalAction + ":parent"
 	IntervalActionTargetKey = db.IntervalAction + ":target"
+	// IntervalActionOverlapKey holds the overlap policy of interval actions by ID
+	IntervalActionOverlapKey = db.IntervalAction + ":overlap"
 )
 
 var intervalActionKeys = []string{IntervalActionKey, IntervalActionNameKey, IntervalActionParentKey, IntervalActionTargetKey}
@@ -0,0 +1,58 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// NotificationAttachmentsKey holds the attachments of the notifications, by notification id
+const NotificationAttachmentsKey = db.Notification + ":attachments"
+
+// ******************************* NOTIFICATION ATTACHMENTS **********************************
+
+// SetNotificationAttachments sets the attachments the emails sending the notification carry, encrypted like the
+// notification when encryption is configured
+func (c Client) SetNotificationAttachments(id string, attachments []notificationsModels.NotificationAttachment) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := marshalPayload(attachments)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", NotificationAttachmentsKey, id, m)
+	return err
+}
+
+// GetNotificationAttachments returns the attachments of the notification, db.ErrNotFound when it has none
+func (c Client) GetNotificationAttachments(id string) ([]notificationsModels.NotificationAttachment, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	object, err := redis.Bytes(conn.Do("HGET", NotificationAttachmentsKey, id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, db.ErrNotFound
+		}
+		return nil, err
+	}
+	var attachments []notificationsModels.NotificationAttachment
+	err = unmarshalObject(object, &attachments)
+	return attachments, err
+}
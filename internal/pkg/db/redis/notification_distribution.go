@@ -0,0 +1,58 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// NotificationDistributionsKey holds the distribution records of the notifications, by notification id
+const NotificationDistributionsKey = db.Notification + ":distribution"
+
+// ******************************* NOTIFICATION DISTRIBUTIONS **********************************
+
+// SetNotificationDistribution sets the record of the distribution of the notification, replacing the previous one
+func (c Client) SetNotificationDistribution(d notificationsModels.NotificationDistribution) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := marshalPayload(d)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", NotificationDistributionsKey, d.NotificationId, m)
+	return err
+}
+
+// GetNotificationDistribution returns the record of the distribution of the notification, db.ErrNotFound when it
+// wasn't distributed yet
+func (c Client) GetNotificationDistribution(id string) (notificationsModels.NotificationDistribution, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var d notificationsModels.NotificationDistribution
+	object, err := redis.Bytes(conn.Do("HGET", NotificationDistributionsKey, id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return d, db.ErrNotFound
+		}
+		return d, err
+	}
+	err = unmarshalObject(object, &d)
+	return d, err
+}
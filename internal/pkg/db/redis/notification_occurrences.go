@@ -0,0 +1,102 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// NotificationOccurrencesKey holds the occurrences of the notifications identical notifications were coalesced
+	// into, by notification id
+	NotificationOccurrencesKey = db.Notification + ":occurrences"
+	// NotificationSuppressionKey prefixes the keys holding the id of the notification identical notifications are
+	// coalesced into, by hash. The keys expire at the end of the suppression window.
+	NotificationSuppressionKey = db.Notification + ":suppression:"
+)
+
+// ******************************* NOTIFICATION OCCURRENCES **********************************
+
+// AddNotificationOccurrences starts coalescing the notifications identical to the notification into it for the window
+func (c Client) AddNotificationOccurrences(id string, o notificationsModels.NotificationOccurrences, window time.Duration) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := marshalObject(o)
+	if err != nil {
+		return err
+	}
+
+	_ = conn.Send("MULTI")
+	_ = conn.Send("HSET", NotificationOccurrencesKey, id, m)
+	_ = conn.Send("SET", NotificationSuppressionKey+o.Hash, id, "PX", window.Milliseconds())
+	_, err = conn.Do("EXEC")
+	return err
+}
+
+// CoalesceNotification counts an occurrence of the notifications identified by the hash at the given time, returning
+// the id of the notification it is coalesced into and its occurrences. It returns db.ErrNotFound when no notification
+// is coalescing the hash, i.e. its window is over or the notification was deleted.
+func (c Client) CoalesceNotification(hash string, at int64) (string, notificationsModels.NotificationOccurrences, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var o notificationsModels.NotificationOccurrences
+	id, err := redis.String(conn.Do("GET", NotificationSuppressionKey+hash))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", o, db.ErrNotFound
+		}
+		return "", o, err
+	}
+	o, err = getNotificationOccurrences(conn, id)
+	if err != nil {
+		return "", o, err
+	}
+
+	o.Count++
+	o.Last = at
+	m, err := marshalObject(o)
+	if err != nil {
+		return "", o, err
+	}
+	_, err = conn.Do("HSET", NotificationOccurrencesKey, id, m)
+	return id, o, err
+}
+
+// GetNotificationOccurrences returns the occurrences of the notification identical notifications were coalesced into
+func (c Client) GetNotificationOccurrences(id string) (notificationsModels.NotificationOccurrences, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return getNotificationOccurrences(conn, id)
+}
+
+func getNotificationOccurrences(conn redis.Conn, id string) (o notificationsModels.NotificationOccurrences, err error) {
+	object, err := redis.Bytes(conn.Do("HGET", NotificationOccurrencesKey, id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return o, db.ErrNotFound
+		}
+		return o, err
+	}
+	err = unmarshalObject(object, &o)
+	return o, err
+}
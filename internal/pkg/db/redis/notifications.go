@@ -16,6 +16,7 @@ package redis
 import (
 	"fmt"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
@@ -350,7 +351,12 @@ func (c *Client) DeleteSubscriptionById(id string) error {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	return deleteSubscription(conn, id)
+	err := deleteSubscription(conn, id)
+	if err != nil {
+		return err
+	}
+
+	return deleteSubscriptionSettings(conn, id)
 }
 
 func (c Client) GetSubscriptionBySlug(slug string) (s contract.Subscription, err error) {
@@ -459,8 +465,141 @@ func (c Client) DeleteSubscriptionBySlug(slug string) error {
 		return err
 	}
 
-	return deleteSubscription(conn, s.ID)
+	err = deleteSubscription(conn, s.ID)
+	if err != nil {
+		return err
+	}
+
+	return deleteSubscriptionSettings(conn, s.ID)
+}
+
+// deleteSubscriptionSettings removes the settings kept by the ID of a deleted subscription
+func deleteSubscriptionSettings(conn redis.Conn, id string) error {
+	_ = conn.Send("MULTI")
+	_ = conn.Send("HDEL", db.Subscription+":callback", id)
+	_ = conn.Send("HDEL", db.Subscription+":retry", id)
+	_ = conn.Send("HDEL", db.Subscription+":locale", id)
+	_, err := conn.Do("EXEC")
+	return err
+}
+
+// SetSubscriptionCallback sets the URL the status of transmissions to the subscription is posted to. The callback is
+// kept by the subscription's ID so it survives updates of the subscription and is removed along with it.
+func (c Client) SetSubscriptionCallback(id string, url string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", db.Subscription+":callback", id, url)
+	return err
+}
+
+func (c Client) GetSubscriptionCallback(id string) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	url, err := redis.String(conn.Do("HGET", db.Subscription+":callback", id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", db.ErrNotFound
+		}
+		return "", err
+	}
+	return url, nil
+}
+
+func (c Client) DeleteSubscriptionCallback(id string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", db.Subscription+":callback", id))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
+// SetSubscriptionRetryPolicy sets the policy failed transmissions to the subscription are resent by, overriding the
+// policies of its channel types. Like the callback, it is kept by the subscription's ID.
+func (c Client) SetSubscriptionRetryPolicy(id string, policy notificationsModels.RetryPolicy) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
 
+	m, err := marshalObject(policy)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", db.Subscription+":retry", id, m)
+	return err
+}
+
+func (c Client) GetSubscriptionRetryPolicy(id string) (policy notificationsModels.RetryPolicy, err error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	object, err := redis.Bytes(conn.Do("HGET", db.Subscription+":retry", id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return policy, db.ErrNotFound
+		}
+		return policy, err
+	}
+	err = unmarshalObject(object, &policy)
+	return policy, err
+}
+
+func (c Client) DeleteSubscriptionRetryPolicy(id string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", db.Subscription+":retry", id))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
+// SetSubscriptionLocale sets the locale the emails to the subscription are rendered in, i.e. fr-CA. Like the callback,
+// it is kept by the subscription's ID.
+func (c Client) SetSubscriptionLocale(id string, locale string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", db.Subscription+":locale", id, locale)
+	return err
+}
+
+func (c Client) GetSubscriptionLocale(id string) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	locale, err := redis.String(conn.Do("HGET", db.Subscription+":locale", id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", db.ErrNotFound
+		}
+		return "", err
+	}
+	return locale, nil
+}
+
+func (c Client) DeleteSubscriptionLocale(id string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", db.Subscription+":locale", id))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
 }
 
 // ******************************* TRANSMISSIONS **********************************
@@ -632,39 +771,51 @@ func (c Client) Cleanup() error {
 
 // Cleanup delete old notifications and associated transmissions
 func (c Client) CleanupOld(age int) error {
+	_, err := c.CleanupByCriteria(notificationsModels.CleanupCriteria{Age: int64(age)})
+	return err
+}
+
+// CleanupByCriteria deletes the notifications selected by the criteria and their transmissions, and counts them
+func (c Client) CleanupByCriteria(criteria notificationsModels.CleanupCriteria) (notificationsModels.CleanupResult, error) {
+	var result notificationsModels.CleanupResult
+
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	currentTime := db.MakeTimestamp()
-	end := currentTime - int64(age)
+	end := db.MakeTimestamp() - criteria.Age
 	objects, err := getObjectsByScore(conn, db.Notification+":created", 0, end, -1)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	notifications, err := unmarshalNotifications(objects)
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	for _, notification := range notifications {
+		if !criteria.Matches(notification) {
+			continue
+		}
 		err = c.DeleteNotificationById(notification.ID)
 		if err != nil {
-			return err
+			return result, err
 		}
+		result.Notifications++
 		transmissions, err := c.GetTransmissionsByNotificationSlug(notification.Slug, -1)
 		if err != nil {
-			return err
+			return result, err
 		}
 		for _, transmission := range transmissions {
 			err = deleteTransmission(conn, transmission.ID)
 			if err != nil {
-				return err
+				return result, err
 			}
+			result.Transmissions++
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
 // ************************** HELPER FUNCTIONS ***************************
@@ -685,7 +836,7 @@ func addNotification(conn redis.Conn, n *contract.Notification) error {
 		n.ID = uuid.New().String()
 	}
 
-	m, err := marshalObject(n)
+	m, err := marshalPayload(n)
 	if err != nil {
 		return err
 	}
@@ -722,6 +873,9 @@ func deleteNotification(conn redis.Conn, id string) error {
 	_ = conn.Send("DEL", id)
 	_ = conn.Send("ZREM", db.Notification, id)
 	_ = conn.Send("HDEL", db.Notification+":slug", n.Slug)
+	_ = conn.Send("HDEL", NotificationOccurrencesKey, id)
+	_ = conn.Send("HDEL", NotificationAttachmentsKey, id)
+	_ = conn.Send("HDEL", NotificationDistributionsKey, id)
 	_ = conn.Send("ZREM", db.Notification+":sender:"+n.Sender, id)
 	_ = conn.Send("ZREM", db.Notification+":status:"+n.Status, id)
 	_ = conn.Send("ZREM", db.Notification+":severity:"+n.Severity, id)
@@ -809,7 +963,7 @@ func addTransmission(conn redis.Conn, t *contract.Transmission) error {
 		t.ID = uuid.New().String()
 	}
 
-	m, err := marshalObject(t)
+	m, err := marshalPayload(t)
 	if err != nil {
 		return err
 	}
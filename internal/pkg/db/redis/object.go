@@ -24,6 +24,11 @@ func marshalObject(in interface{}) (out []byte, err error) {
 	return json.Marshal(in)
 }
 
+// unmarshalObject unmarshals the object, decrypting it first when it was stored encrypted
 func unmarshalObject(in []byte, out interface{}) (err error) {
+	in, err = DecryptPayload(in)
+	if err != nil {
+		return err
+	}
 	return json.Unmarshal(in, out)
 }
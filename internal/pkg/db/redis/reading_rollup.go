@@ -0,0 +1,129 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/data/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ******************************* READING ROLLUPS **********************************
+
+// readingRollupKey is the sorted set of the rollups of the readings with the name of the device, scored by start
+func readingRollupKey(device string, name string) string {
+	return db.ReadingRollupCollection + ":" + device + ":" + name
+}
+
+// readingRollupToKey is the creation time of the newest event of the API whose readings were rolled up
+func readingRollupToKey(events string) string {
+	return db.ReadingRollupCollection + ":rolledUpTo:" + events
+}
+
+// RolledUpTo returns the creation time of the newest event of the API whose readings were rolled up, 0 when none was
+func (c *Client) RolledUpTo(events string) (int64, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	to, err := redis.Int64(conn.Do("GET", readingRollupToKey(events)))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return to, err
+}
+
+// AddReadingRollups stores the rollups of the events of the API created after from up to to, merging them into the
+// rollups already stored for the same device, reading name and start. The rollups given must be of distinct device,
+// reading name or start. The rollups are stored along with to in one transaction, aborted with db.ErrRolledUpMeanwhile
+// when the events aren't rolled up to from anymore or the stored rollups changed meanwhile, so that no event is rolled
+// up twice.
+func (c *Client) AddReadingRollups(events string, rollups []models.ReadingRollup, from int64, to int64) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	toKey := readingRollupToKey(events)
+	watched := []interface{}{toKey}
+	for _, r := range rollups {
+		watched = append(watched, readingRollupKey(r.Device, r.Name))
+	}
+	if _, err := conn.Do("WATCH", watched...); err != nil {
+		return err
+	}
+
+	stored, err := redis.Int64(conn.Do("GET", toKey))
+	if err != nil && err != redis.ErrNil {
+		return err
+	}
+	if stored != from {
+		return db.ErrRolledUpMeanwhile
+	}
+
+	members := make([][]byte, len(rollups))
+	for i, r := range rollups {
+		objects, err := redis.ByteSlices(conn.Do("ZRANGEBYSCORE", readingRollupKey(r.Device, r.Name), r.Start, r.Start))
+		if err != nil && err != redis.ErrNil {
+			return err
+		}
+		stored, err := unmarshalReadingRollups(objects)
+		if err != nil {
+			return err
+		}
+		for _, s := range stored {
+			r.Merge(s)
+		}
+		if members[i], err = marshalObject(r); err != nil {
+			return err
+		}
+	}
+
+	_ = conn.Send("MULTI")
+	for i, r := range rollups {
+		key := readingRollupKey(r.Device, r.Name)
+		_ = conn.Send("ZREMRANGEBYSCORE", key, r.Start, r.Start)
+		_ = conn.Send("ZADD", key, r.Start, members[i])
+	}
+	_ = conn.Send("SET", toKey, to)
+	_, err = redis.Values(conn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return db.ErrRolledUpMeanwhile
+	}
+	return err
+}
+
+// ReadingRollups returns the rollups of the readings with the name of the device starting between start and end,
+// oldest first
+func (c *Client) ReadingRollups(device string, name string, start int64, end int64) ([]models.ReadingRollup, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do("ZRANGEBYSCORE", readingRollupKey(device, name), start, end))
+	if err != nil && err != redis.ErrNil {
+		return nil, err
+	}
+	return unmarshalReadingRollups(objects)
+}
+
+func unmarshalReadingRollups(objects [][]byte) ([]models.ReadingRollup, error) {
+	rollups := make([]models.ReadingRollup, 0, len(objects))
+	for _, o := range objects {
+		var r models.ReadingRollup
+		if err := unmarshalObject(o, &r); err != nil {
+			return nil, err
+		}
+		rollups = append(rollups, r)
+	}
+	return rollups, nil
+}
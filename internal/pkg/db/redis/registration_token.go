@@ -0,0 +1,93 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package redis
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// RegistrationTokenKey holds the registration tokens which weren't redeemed yet, by token hash
+	RegistrationTokenKey = db.DeviceService + ":registrationToken"
+	// DeviceServiceCredentialKey holds the hash of the registration token each device service was registered with,
+	// by device service id
+	DeviceServiceCredentialKey = db.DeviceService + ":credential"
+)
+
+// ******************************* REGISTRATION TOKENS **********************************
+
+// AddRegistrationToken stores a registration token by its hash
+func (c *Client) AddRegistrationToken(hash string, token models.RegistrationToken) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := marshalObject(token)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", RegistrationTokenKey, hash, m)
+	return err
+}
+
+// GetRegistrationToken returns the registration token of the hash, unless it was already redeemed
+func (c *Client) GetRegistrationToken(hash string) (models.RegistrationToken, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var token models.RegistrationToken
+	object, err := redis.Bytes(conn.Do("HGET", RegistrationTokenKey, hash))
+	if err != nil {
+		if err == redis.ErrNil {
+			return token, db.ErrNotFound
+		}
+		return token, err
+	}
+
+	err = unmarshalObject(object, &token)
+	return token, err
+}
+
+// RedeemRegistrationToken removes the registration token of the hash so it can't be used again, and keeps the hash as
+// the credential of the device service registered with it. db.ErrNotFound is returned when the token was already
+// redeemed.
+func (c *Client) RedeemRegistrationToken(hash string, serviceId string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", RegistrationTokenKey, hash))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	_, err = conn.Do("HSET", DeviceServiceCredentialKey, serviceId, hash)
+	return err
+}
+
+// GetDeviceServiceCredential returns the hash of the registration token the device service was registered with
+func (c *Client) GetDeviceServiceCredential(serviceId string) (string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	hash, err := redis.String(conn.Do("HGET", DeviceServiceCredentialKey, serviceId))
+	if err == redis.ErrNil {
+		return "", db.ErrNotFound
+	}
+	return hash, err
+}
@@ -16,9 +16,13 @@ package redis
 
 import (
 	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db/redis/models"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
@@ -181,12 +185,174 @@ func (c *Client) DeleteIntervalById(id string) (err error) {
 
 	_ = conn.Send("MULTI")
 	deleteObject(interval, id, conn)
+	_ = conn.Send("HDEL", models.IntervalBlackoutKey, id)
 
 	_, err = conn.Do("EXEC")
 
 	return err
 }
 
+// Return all the blackout calendars
+func (c *Client) BlackoutCalendars() ([]schedulerModels.BlackoutCalendar, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do("HVALS", models.BlackoutCalendarKey))
+	if err != nil {
+		return nil, err
+	}
+
+	calendars := make([]schedulerModels.BlackoutCalendar, len(objects))
+	for i, object := range objects {
+		if err = json.Unmarshal(object, &calendars[i]); err != nil {
+			return nil, err
+		}
+	}
+	return calendars, nil
+}
+
+func (c *Client) BlackoutCalendarByName(name string) (schedulerModels.BlackoutCalendar, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var calendar schedulerModels.BlackoutCalendar
+	object, err := redis.Bytes(conn.Do("HGET", models.BlackoutCalendarKey, name))
+	if err != nil {
+		if err == redis.ErrNil {
+			return calendar, db.ErrNotFound
+		}
+		return calendar, err
+	}
+	err = json.Unmarshal(object, &calendar)
+	return calendar, err
+}
+
+// SetBlackoutCalendar adds the blackout calendar or replaces the calendar with the same name
+func (c *Client) SetBlackoutCalendar(calendar schedulerModels.BlackoutCalendar) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := json.Marshal(calendar)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", models.BlackoutCalendarKey, calendar.Name, m)
+	return err
+}
+
+func (c *Client) DeleteBlackoutCalendarByName(name string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", models.BlackoutCalendarKey, name))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
+// SetIntervalBlackoutCalendars attaches the named blackout calendars to the interval, detaching them all when names is
+// empty
+func (c *Client) SetIntervalBlackoutCalendars(intervalId string, names []string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(names) == 0 {
+		_, err := conn.Do("HDEL", models.IntervalBlackoutKey, intervalId)
+		return err
+	}
+
+	m, err := json.Marshal(names)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", models.IntervalBlackoutKey, intervalId, m)
+	return err
+}
+
+// IntervalBlackoutCalendars returns the names of the blackout calendars attached to the interval, db.ErrNotFound when
+// it has none
+func (c *Client) IntervalBlackoutCalendars(intervalId string) ([]string, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	object, err := redis.Bytes(conn.Do("HGET", models.IntervalBlackoutKey, intervalId))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, db.ErrNotFound
+		}
+		return nil, err
+	}
+	var names []string
+	err = json.Unmarshal(object, &names)
+	return names, err
+}
+
+func (c *Client) DelayedActions() ([]schedulerModels.DelayedAction, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, err := redis.ByteSlices(conn.Do("HVALS", models.DelayedActionKey))
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]schedulerModels.DelayedAction, len(objects))
+	for i, object := range objects {
+		if err = json.Unmarshal(object, &actions[i]); err != nil {
+			return nil, err
+		}
+	}
+	return actions, nil
+}
+
+func (c *Client) DelayedActionByName(name string) (schedulerModels.DelayedAction, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	var action schedulerModels.DelayedAction
+	object, err := redis.Bytes(conn.Do("HGET", models.DelayedActionKey, name))
+	if err != nil {
+		if err == redis.ErrNil {
+			return action, db.ErrNotFound
+		}
+		return action, err
+	}
+	err = json.Unmarshal(object, &action)
+	return action, err
+}
+
+func (c *Client) AddDelayedAction(action schedulerModels.DelayedAction) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	m, err := json.Marshal(action)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", models.DelayedActionKey, action.Name, m)
+	return err
+}
+
+// DeleteDelayedActionByName removes the delayed action, db.ErrNotFound when it was already removed so that only one of
+// the scheduler instances removing it at once succeeds
+func (c *Client) DeleteDelayedActionByName(name string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", models.DelayedActionKey, name))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
 // Scrub all scheduler intervals from the database (only used in test)
 func (c *Client) ScrubAllIntervals() (count int, err error) {
 	conn := c.Pool.Get()
@@ -404,12 +570,51 @@ func (c *Client) DeleteIntervalActionById(id string) (err error) {
 
 	_ = conn.Send("MULTI")
 	deleteObject(action, id, conn)
+	_ = conn.Send("HDEL", models.IntervalActionOverlapKey, id)
 
 	_, err = conn.Do("EXEC")
 
 	return err
 }
 
+// SetIntervalActionOverlapPolicy sets the policy applied when the interval action is due while its previous execution is
+// still in flight
+func (c *Client) SetIntervalActionOverlapPolicy(id string, policy schedulerModels.OverlapPolicy) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	_, err := conn.Do("HSET", models.IntervalActionOverlapKey, id, string(policy))
+	return err
+}
+
+func (c *Client) GetIntervalActionOverlapPolicy(id string) (schedulerModels.OverlapPolicy, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	policy, err := redis.String(conn.Do("HGET", models.IntervalActionOverlapKey, id))
+	if err != nil {
+		if err == redis.ErrNil {
+			return "", db.ErrNotFound
+		}
+		return "", err
+	}
+	return schedulerModels.OverlapPolicy(policy), nil
+}
+
+func (c *Client) DeleteIntervalActionOverlapPolicy(id string) error {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, err := redis.Int(conn.Do("HDEL", models.IntervalActionOverlapKey, id))
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return db.ErrNotFound
+	}
+	return nil
+}
+
 // Scrub all scheduler interval actions from the database data (only used in test)
 func (c *Client) ScrubAllIntervalActions() (count int, err error) {
 	conn := c.Pool.Get()
@@ -426,3 +631,19 @@ func (c *Client) ScrubAllIntervalActions() (count int, err error) {
 
 	return 0, nil
 }
+
+// TryIntervalLock acquires the execution lock of the interval's slot unless another scheduler instance holds it. The
+// lock expires after ttl, once the other instances are past the slot.
+func (c *Client) TryIntervalLock(intervalId string, slot int64, owner string, ttl time.Duration) (bool, error) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	key := models.IntervalLockKey + ":" + intervalId + ":" + strconv.FormatInt(slot, 10)
+	_, err := redis.String(conn.Do("SET", key, owner, "NX", "PX", ttl.Milliseconds()))
+	if err == redis.ErrNil {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,92 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/config"
+)
+
+// ReplicaDatabasePrefix prefixes the names of the Databases entries declaring the read replicas of the Primary
+// database, i.e. [Databases.Replica1]
+const ReplicaDatabasePrefix = "Replica"
+
+const (
+	defaultMaxStaleness         = 5 * time.Second
+	defaultReplicaCheckInterval = time.Second
+)
+
+// ReplicationInfo configures the routing of the read-only queries, the device lists and the reading ranges, to the
+// read replicas of the database while the writes go to the primary
+type ReplicationInfo struct {
+	// MaxStaleness is how long a replica lagging behind the replication offset of the primary keeps serving the
+	// queries, i.e. '5s'. A replica isn't queried while it synchronizes with the primary.
+	MaxStaleness string
+	// CheckInterval is how often the replication status of each replica is checked, i.e. '1s'
+	CheckInterval string
+}
+
+// Replica is the endpoint of a read replica of the database
+type Replica struct {
+	Name    string
+	Host    string
+	Port    int
+	Timeout int
+}
+
+// Replicas returns the read replicas declared in the Databases section, sorted by name. The replicas must be of the
+// type of the Primary database.
+func Replicas(databases map[string]config.Database) ([]Replica, error) {
+	primary := databases["Primary"]
+	var replicas []Replica
+	for name, database := range databases {
+		if !strings.HasPrefix(name, ReplicaDatabasePrefix) {
+			continue
+		}
+		if database.Type != "" && database.Type != primary.Type {
+			return nil, fmt.Errorf("database replica %s is of type '%s', '%s' expected", name, database.Type, primary.Type)
+		}
+		if database.Host == "" || database.Port <= 0 {
+			return nil, fmt.Errorf("database replica %s has no Host or Port", name)
+		}
+		replicas = append(replicas, Replica{Name: name, Host: database.Host, Port: database.Port, Timeout: database.Timeout})
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].Name < replicas[j].Name
+	})
+	return replicas, nil
+}
+
+// ParseReplicationInfo returns the staleness tolerated from the replicas and the interval their replication status is
+// checked at, the defaults applying to the empty settings
+func ParseReplicationInfo(info ReplicationInfo) (time.Duration, time.Duration, error) {
+	maxStaleness, checkInterval := defaultMaxStaleness, defaultReplicaCheckInterval
+	var err error
+	if info.MaxStaleness != "" {
+		if maxStaleness, err = time.ParseDuration(info.MaxStaleness); err != nil || maxStaleness < 0 {
+			return 0, 0, fmt.Errorf("invalid DatabaseReplication MaxStaleness '%s'", info.MaxStaleness)
+		}
+	}
+	if info.CheckInterval != "" {
+		if checkInterval, err = time.ParseDuration(info.CheckInterval); err != nil || checkInterval <= 0 {
+			return 0, 0, fmt.Errorf("invalid DatabaseReplication CheckInterval '%s'", info.CheckInterval)
+		}
+	}
+	return maxStaleness, checkInterval, nil
+}
@@ -256,6 +256,34 @@ func testDBReadings(t *testing.T, db interfaces.DBClient) {
 	if len(readings) != 100 {
 		t.Fatalf("There should be 100 readings, not %d", len(readings))
 	}
+	batches, scanned := 0, 0
+	err = db.ScanReadingsByCreationTime(beforeTime, afterTime, "", 50, func(readings []contract.Reading) error {
+		batches++
+		scanned += len(readings)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error scanning ScanReadingsByCreationTime: %v", err)
+	}
+	if batches != 3 || scanned != 110 {
+		t.Fatalf("There should be 110 readings in 3 batches, not %d in %d", scanned, batches)
+	}
+	scanned = 0
+	err = db.ScanReadingsByCreationTime(beforeTime, afterTime, "name1", 50, func(readings []contract.Reading) error {
+		for _, reading := range readings {
+			if reading.Device != "name1" {
+				t.Fatalf("Reading of device %s scanned with the readings of device name1", reading.Device)
+			}
+		}
+		scanned += len(readings)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error scanning ScanReadingsByCreationTime by device: %v", err)
+	}
+	if scanned == 0 {
+		t.Fatalf("There should be readings of device name1")
+	}
 
 	r := contract.Reading{}
 	r.Id = id
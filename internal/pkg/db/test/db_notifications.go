@@ -12,6 +12,7 @@ import (
 
 	dbp "github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -249,6 +250,65 @@ func testDBSubscription(t *testing.T, db interfaces.DBClient) {
 	if err != nil {
 		t.Fatalf("Fail to get subscription by categories and labels, %v", err)
 	}
+
+	// Test SetSubscriptionCallback and GetSubscriptionCallback
+	callbackUrl := "http://localhost:8080/status"
+	err = db.SetSubscriptionCallback(subscription.ID, callbackUrl)
+	if err != nil {
+		t.Fatalf("Fail to set subscription callback, %v", err)
+	}
+	url, err := db.GetSubscriptionCallback(subscription.ID)
+	if err != nil {
+		t.Fatalf("Fail to get subscription callback, %v", err)
+	}
+	if url != callbackUrl {
+		t.Fatalf("Unexpect test result, callback '%v' not match '%v'", url, callbackUrl)
+	}
+
+	// Test SetSubscriptionRetryPolicy and GetSubscriptionRetryPolicy
+	retryPolicy := notificationsModels.RetryPolicy{MaxAttempts: 5, InitialInterval: "1m", Multiplier: 2}
+	err = db.SetSubscriptionRetryPolicy(subscription.ID, retryPolicy)
+	if err != nil {
+		t.Fatalf("Fail to set subscription retry policy, %v", err)
+	}
+	policy, err := db.GetSubscriptionRetryPolicy(subscription.ID)
+	if err != nil {
+		t.Fatalf("Fail to get subscription retry policy, %v", err)
+	}
+	if policy != retryPolicy {
+		t.Fatalf("Unexpect test result, retry policy '%v' not match '%v'", policy, retryPolicy)
+	}
+
+	// Test SetSubscriptionLocale and GetSubscriptionLocale
+	err = db.SetSubscriptionLocale(subscription.ID, "fr-CA")
+	if err != nil {
+		t.Fatalf("Fail to set subscription locale, %v", err)
+	}
+	locale, err := db.GetSubscriptionLocale(subscription.ID)
+	if err != nil {
+		t.Fatalf("Fail to get subscription locale, %v", err)
+	}
+	if locale != "fr-CA" {
+		t.Fatalf("Unexpect test result, locale '%v' not match '%v'", locale, "fr-CA")
+	}
+
+	// Test the callback, retry policy and locale are removed along with the subscription
+	err = db.DeleteSubscriptionBySlug(slugName)
+	if err != nil {
+		t.Fatalf("Fail to delete subscription by slug, %v", err)
+	}
+	_, err = db.GetSubscriptionCallback(subscription.ID)
+	if err == nil {
+		t.Fatalf("Subscription callback should have been deleted with the subscription")
+	}
+	_, err = db.GetSubscriptionRetryPolicy(subscription.ID)
+	if err == nil {
+		t.Fatalf("Subscription retry policy should have been deleted with the subscription")
+	}
+	_, err = db.GetSubscriptionLocale(subscription.ID)
+	if err == nil {
+		t.Fatalf("Subscription locale should have been deleted with the subscription")
+	}
 }
 
 func testDBTransmission(t *testing.T, db interfaces.DBClient) {
@@ -0,0 +1,111 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package deprecation implements the deviceResource deprecation workflow. A deviceResource is marked deprecated
+// through its attributes, optionally naming the deviceResource which replaces it:
+//
+//	attributes: { deprecated: "true", replacement: "TemperatureCelsius" }
+package deprecation
+
+import (
+	"fmt"
+	"strconv"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	// DeprecatedAttribute is the deviceResource attribute which marks the resource deprecated when set to true.
+	DeprecatedAttribute = "deprecated"
+	// ReplacementAttribute is the deviceResource attribute naming the deviceResource to use instead.
+	ReplacementAttribute = "replacement"
+	// WarningHeader is the HTTP response header carrying a deprecation warning, see RFC 7234 section 5.5.
+	WarningHeader = "Warning"
+)
+
+// Resource is a deprecated deviceResource and its replacement, if any.
+type Resource struct {
+	Name        string `json:"name"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// DeviceUsage lists the deprecated deviceResources still in use by a device through its profile.
+type DeviceUsage struct {
+	DeviceName  string     `json:"deviceName"`
+	ProfileName string     `json:"profileName"`
+	Resources   []Resource `json:"resources"`
+}
+
+// IsDeprecated reports whether the deviceResource is marked deprecated.
+func IsDeprecated(r contract.DeviceResource) bool {
+	deprecated, _ := strconv.ParseBool(r.Attributes[DeprecatedAttribute])
+	return deprecated
+}
+
+// ProfileResources returns the deprecated deviceResources of the profile.
+func ProfileResources(profile contract.DeviceProfile) []Resource {
+	var resources []Resource
+	for _, r := range profile.DeviceResources {
+		if IsDeprecated(r) {
+			resources = append(resources, Resource{Name: r.Name, Replacement: r.Attributes[ReplacementAttribute]})
+		}
+	}
+	return resources
+}
+
+// CommandResources returns the deprecated deviceResources read (or written when set is true) by the profile's
+// command. A command without a deviceCommand of the same name addresses the deviceResource of that name.
+func CommandResources(profile contract.DeviceProfile, commandName string, set bool) []Resource {
+	deprecated := make(map[string]Resource)
+	for _, r := range ProfileResources(profile) {
+		deprecated[r.Name] = r
+	}
+	if len(deprecated) == 0 {
+		return nil
+	}
+
+	names := []string{commandName}
+	for _, dc := range profile.DeviceCommands {
+		if dc.Name != commandName {
+			continue
+		}
+		operations := dc.Get
+		if set {
+			operations = dc.Set
+		}
+		names = names[:0]
+		for _, ro := range operations {
+			names = append(names, ro.DeviceResource, ro.Object)
+		}
+		break
+	}
+
+	var resources []Resource
+	for _, name := range names {
+		if r, ok := deprecated[name]; ok {
+			resources = append(resources, r)
+			delete(deprecated, name)
+		}
+	}
+	return resources
+}
+
+// Warning formats the deprecation warning for the deviceResource as a value of WarningHeader.
+func Warning(r Resource) string {
+	message := fmt.Sprintf("deviceResource %s is deprecated", r.Name)
+	if r.Replacement != "" {
+		message += fmt.Sprintf(", use %s instead", r.Replacement)
+	}
+	return fmt.Sprintf("299 - %q", message)
+}
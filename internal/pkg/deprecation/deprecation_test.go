@@ -0,0 +1,71 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package deprecation
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandResources(t *testing.T) {
+	temperature := Resource{Name: "Temperature", Replacement: "TemperatureCelsius"}
+	setPoint := Resource{Name: "SetPoint"}
+	profile := contract.DeviceProfile{
+		DeviceResources: []contract.DeviceResource{
+			{Name: temperature.Name, Attributes: map[string]string{DeprecatedAttribute: "true", ReplacementAttribute: temperature.Replacement}},
+			{Name: setPoint.Name, Attributes: map[string]string{DeprecatedAttribute: "TRUE"}},
+			{Name: "TemperatureCelsius"},
+			{Name: "Humidity", Attributes: map[string]string{DeprecatedAttribute: "false"}},
+		},
+		DeviceCommands: []contract.ProfileResource{
+			{
+				Name: "Climate",
+				Get:  []contract.ResourceOperation{{DeviceResource: "Temperature"}, {DeviceResource: "Humidity"}},
+				Set:  []contract.ResourceOperation{{DeviceResource: "SetPoint"}},
+			},
+			{
+				Name: "Temperature",
+				Get:  []contract.ResourceOperation{{DeviceResource: "TemperatureCelsius"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		command  string
+		set      bool
+		expected []Resource
+	}{
+		{"get of deviceCommand", "Climate", false, []Resource{temperature}},
+		{"set of deviceCommand", "Climate", true, []Resource{setPoint}},
+		{"deviceCommand shadowing deprecated resource", "Temperature", false, nil},
+		{"deprecated deviceResource", "SetPoint", true, []Resource{setPoint}},
+		{"current deviceResource", "Humidity", false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CommandResources(profile, tt.command, tt.set))
+		})
+	}
+}
+
+func TestWarning(t *testing.T) {
+	assert.Equal(t, `299 - "deviceResource Temperature is deprecated, use TemperatureCelsius instead"`,
+		Warning(Resource{Name: "Temperature", Replacement: "TemperatureCelsius"}))
+	assert.Equal(t, `299 - "deviceResource SetPoint is deprecated"`, Warning(Resource{Name: "SetPoint"}))
+}
@@ -0,0 +1,175 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package devicemetrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+)
+
+// ApiDeviceMetricsByNameRoute is the core-metadata route of the daily activity counters of a device
+const ApiDeviceMetricsByNameRoute = v2.ApiDeviceByNameRoute + "/metrics"
+
+// DayLayout is the layout of the UTC days the counters are aggregated by
+const DayLayout = "2006-01-02"
+
+// Counters count the activity of a device
+type Counters struct {
+	// CommandsIssued is the number of commands issued to the device through core-command
+	CommandsIssued int64 `json:"commandsIssued"`
+	// CommandFailures is the number of those commands which failed
+	CommandFailures int64 `json:"commandFailures"`
+	// EventsReceived is the number of events of the device received by core-data
+	EventsReceived int64 `json:"eventsReceived"`
+	// LastEventReceived is when the last of those events was received, in milliseconds, not kept per day
+	LastEventReceived int64 `json:"lastEventReceived,omitempty"`
+}
+
+// Add returns the sum of the counters, with the latest of the last event times
+func (c Counters) Add(other Counters) Counters {
+	sum := Counters{
+		CommandsIssued:    c.CommandsIssued + other.CommandsIssued,
+		CommandFailures:   c.CommandFailures + other.CommandFailures,
+		EventsReceived:    c.EventsReceived + other.EventsReceived,
+		LastEventReceived: c.LastEventReceived,
+	}
+	if other.LastEventReceived > sum.LastEventReceived {
+		sum.LastEventReceived = other.LastEventReceived
+	}
+	return sum
+}
+
+// IsZero tells whether nothing is counted
+func (c Counters) IsZero() bool {
+	return c == Counters{}
+}
+
+// DailyCounters are the counters of a device aggregated over a UTC day
+type DailyCounters struct {
+	// Day is the UTC day, i.e. '2020-10-30'
+	Day      string `json:"day"`
+	Counters `json:",inline"`
+}
+
+// ReportingInfo configures the reporting of the device activity counted by a service to core-metadata
+type ReportingInfo struct {
+	// Enabled turns on counting and reporting the device activity
+	Enabled bool
+	// FlushInterval is how often the counters are reported, i.e. '30s'
+	FlushInterval string
+}
+
+// Reporter counts the activity of devices in memory and periodically reports it to core-metadata, so that counting
+// doesn't add a request to core-metadata per command or event
+type Reporter struct {
+	urlClient interfaces.URLClient
+	lc        logger.LoggingClient
+	client    *http.Client
+
+	mutex   sync.Mutex
+	pending map[string]Counters
+}
+
+// NewReporter returns a reporter to the core-metadata service at the base URL of the URL client
+func NewReporter(urlClient interfaces.URLClient, lc logger.LoggingClient) *Reporter {
+	return &Reporter{
+		urlClient: urlClient,
+		lc:        lc,
+		client:    &http.Client{Timeout: 10 * time.Second},
+		pending:   make(map[string]Counters),
+	}
+}
+
+// Count adds the counters to the activity of the device reported next. Nothing is counted by a nil reporter, so that
+// services count unconditionally whether reporting is enabled or not.
+func (r *Reporter) Count(deviceName string, counters Counters) {
+	if r == nil || deviceName == "" || counters.IsZero() {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.pending[deviceName] = r.pending[deviceName].Add(counters)
+}
+
+// Flush reports the activity counted since the last flush. The activity of a device which can't be reported is kept
+// for the next flush, unless core-metadata doesn't know the device.
+func (r *Reporter) Flush() {
+	r.mutex.Lock()
+	pending := r.pending
+	r.pending = make(map[string]Counters)
+	r.mutex.Unlock()
+
+	for name, counters := range pending {
+		status, err := r.report(name, counters)
+		if err == nil {
+			continue
+		}
+		r.lc.Warn(fmt.Sprintf("failed to report the activity of device %s: %s", name, err.Error()))
+		if status != http.StatusNotFound {
+			r.Count(name, counters)
+		}
+	}
+}
+
+func (r *Reporter) report(deviceName string, counters Counters) (int, error) {
+	body, err := json.Marshal(counters)
+	if err != nil {
+		return 0, err
+	}
+	baseUrl, err := r.urlClient.Prefix()
+	if err != nil {
+		return 0, err
+	}
+	path := v2.ApiDeviceRoute + "/" + v2.Name + "/" + url.PathEscape(deviceName) + "/metrics"
+	resp, err := r.client.Post(baseUrl+path, clients.ContentTypeJSON, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("core-metadata replied %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Run flushes the counters every interval until ctx is cancelled, then flushes them one last time
+func (r *Reporter) Run(ctx context.Context, wg *sync.WaitGroup, interval time.Duration) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				r.Flush()
+				return
+			case <-ticker.C:
+				r.Flush()
+			}
+		}
+	}()
+}
@@ -0,0 +1,79 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package devicemetrics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountersAdd(t *testing.T) {
+	sum := Counters{EventsReceived: 1, LastEventReceived: 2000}.Add(Counters{CommandsIssued: 1, EventsReceived: 1, LastEventReceived: 1000})
+	assert.Equal(t, Counters{CommandsIssued: 1, EventsReceived: 2, LastEventReceived: 2000}, sum)
+	sum = Counters{CommandsIssued: 1}.Add(Counters{EventsReceived: 1, LastEventReceived: 1000})
+	assert.Equal(t, Counters{CommandsIssued: 1, EventsReceived: 1, LastEventReceived: 1000}, sum)
+}
+
+func TestReporterFlush(t *testing.T) {
+	var mutex sync.Mutex
+	reported := make(map[string]Counters)
+	status := map[string]int{"thermostat": http.StatusOK, "unknown": http.StatusNotFound, "failing": http.StatusInternalServerError}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var counters Counters
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&counters))
+		name := map[string]string{
+			"/api/v2/device/name/thermostat/metrics": "thermostat",
+			"/api/v2/device/name/unknown/metrics":    "unknown",
+			"/api/v2/device/name/failing/metrics":    "failing",
+		}[r.URL.Path]
+		mutex.Lock()
+		reported[name] = reported[name].Add(counters)
+		mutex.Unlock()
+		w.WriteHeader(status[name])
+	}))
+	defer server.Close()
+
+	reporter := NewReporter(local.New(server.URL), logger.NewMockClient())
+	reporter.Count("thermostat", Counters{CommandsIssued: 1, CommandFailures: 1})
+	reporter.Count("thermostat", Counters{CommandsIssued: 1})
+	reporter.Count("thermostat", Counters{})
+	reporter.Count("unknown", Counters{EventsReceived: 1})
+	reporter.Count("failing", Counters{EventsReceived: 2})
+	reporter.Flush()
+
+	assert.Equal(t, Counters{CommandsIssued: 2, CommandFailures: 1}, reported["thermostat"])
+	assert.Equal(t, Counters{EventsReceived: 1}, reported["unknown"])
+	assert.Equal(t, Counters{EventsReceived: 2}, reported["failing"])
+
+	// only the activity which failed to be reported is reported again
+	reporter.Flush()
+	assert.Equal(t, Counters{CommandsIssued: 2, CommandFailures: 1}, reported["thermostat"])
+	assert.Equal(t, Counters{EventsReceived: 1}, reported["unknown"])
+	assert.Equal(t, Counters{EventsReceived: 4}, reported["failing"])
+}
+
+func TestNilReporterCount(t *testing.T) {
+	var reporter *Reporter
+	assert.NotPanics(t, func() { reporter.Count("thermostat", Counters{EventsReceived: 1}) })
+}
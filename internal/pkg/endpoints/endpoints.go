@@ -0,0 +1,207 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-registry/pkg/types"
+
+	"github.com/BurntSushi/toml"
+)
+
+// pingTimeout is how long a service listed in the endpoints file is given to answer its ping
+const pingTimeout = 5 * time.Second
+
+// StandaloneInfo configures the resolution of the other services without the registry, for single box deployments
+// without Consul
+type StandaloneInfo struct {
+	// EndpointsFile is the path of the TOML file listing the endpoints of the services, with the layout of the Clients
+	// section, i.e. [Metadata] Host = 'localhost' Port = 48081. Leave blank to resolve the services from the Clients
+	// configuration or the registry.
+	EndpointsFile string
+	// WatchInterval is how often the endpoints file is checked for changes, i.e. '10s'
+	WatchInterval string
+}
+
+// Registry resolves the services from the endpoints file, reloading it when it changes. It implements registry.Client
+// so that the code looking up services through the registry works the same without Consul. Services don't register,
+// the endpoints file lists them all.
+type Registry struct {
+	path string
+	lc   logger.LoggingClient
+
+	mutex     sync.RWMutex
+	endpoints map[string]bootstrapConfig.ClientInfo
+	modTime   time.Time
+	size      int64
+}
+
+// NewRegistry returns a registry resolving the services from the endpoints file at path
+func NewRegistry(path string, lc logger.LoggingClient) (*Registry, error) {
+	r := &Registry{path: path, lc: lc}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload reads the endpoints file when it changed since it was last read, and tells whether it did
+func (r *Registry) reload() (bool, error) {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false, fmt.Errorf("unable to read endpoints file: %s", err.Error())
+	}
+	r.mutex.RLock()
+	unchanged := r.endpoints != nil && info.ModTime().Equal(r.modTime) && info.Size() == r.size
+	r.mutex.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	endpoints := make(map[string]bootstrapConfig.ClientInfo)
+	if _, err = toml.DecodeFile(r.path, &endpoints); err != nil {
+		return false, fmt.Errorf("unable to parse endpoints file %s: %s", r.path, err.Error())
+	}
+	for name, endpoint := range endpoints {
+		if endpoint.Host == "" || endpoint.Port <= 0 {
+			return false, fmt.Errorf("endpoint %s of file %s must have a host and a port", name, r.path)
+		}
+		if endpoint.Protocol == "" {
+			endpoint.Protocol = "http"
+			endpoints[name] = endpoint
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.endpoints = endpoints
+	r.modTime = info.ModTime()
+	r.size = info.Size()
+	return true, nil
+}
+
+// Watch reloads the endpoints file when it changes, checking every interval until ctx is cancelled. The endpoints
+// are kept when the changed file can't be read.
+func (r *Registry) Watch(ctx context.Context, wg *sync.WaitGroup, interval time.Duration) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reloaded, err := r.reload()
+				if err != nil {
+					r.lc.Error(fmt.Sprintf("keeping the previous endpoints: %s", err.Error()))
+				} else if reloaded {
+					r.lc.Info(fmt.Sprintf("reloaded the endpoints of %s", r.path))
+				}
+			}
+		}
+	}()
+}
+
+// Endpoint returns the endpoint of the named service, named as in the Clients configuration or by its service key
+func (r *Registry) Endpoint(name string) (bootstrapConfig.ClientInfo, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	endpoint, ok := r.endpoints[name]
+	return endpoint, ok
+}
+
+// Register does nothing, the endpoints file lists the services
+func (r *Registry) Register() error {
+	return nil
+}
+
+// Unregister does nothing, the endpoints file lists the services
+func (r *Registry) Unregister() error {
+	return nil
+}
+
+// RegisterCheck does nothing, the services are checked by pinging them
+func (r *Registry) RegisterCheck(string, string, string, string, string) error {
+	return nil
+}
+
+// IsAlive tells whether the endpoints file can be read
+func (r *Registry) IsAlive() bool {
+	_, err := os.Stat(r.path)
+	return err == nil
+}
+
+// GetServiceEndpoint returns the endpoint of the service listed in the endpoints file
+func (r *Registry) GetServiceEndpoint(serviceId string) (types.ServiceEndpoint, error) {
+	endpoint, ok := r.Endpoint(serviceId)
+	if !ok {
+		return types.ServiceEndpoint{}, fmt.Errorf("service %s isn't listed in endpoints file %s", serviceId, r.path)
+	}
+	return types.ServiceEndpoint{ServiceId: serviceId, Host: endpoint.Host, Port: endpoint.Port}, nil
+}
+
+// IsServiceAvailable tells whether the service is listed in the endpoints file and answers its ping
+func (r *Registry) IsServiceAvailable(serviceId string) (bool, error) {
+	endpoint, ok := r.Endpoint(serviceId)
+	if !ok {
+		return false, fmt.Errorf("service %s isn't listed in endpoints file %s", serviceId, r.path)
+	}
+	client := http.Client{Timeout: pingTimeout}
+	resp, err := client.Get(endpoint.Url() + clients.ApiPingRoute)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("service %s ping replied %s", serviceId, resp.Status)
+	}
+	return true, nil
+}
+
+// URLClient resolves the URL of a service for the clients of the contracts module on every request, from the
+// endpoints file when the service runs standalone, from the Clients configuration otherwise.
+type URLClient struct {
+	registry *Registry
+	name     string
+	info     bootstrapConfig.ClientInfo
+	path     string
+}
+
+// NewURLClient returns the URLClient of the path of the named service. The registry is nil unless the service runs
+// standalone, the service is then resolved from info.
+func NewURLClient(registry *Registry, name string, info bootstrapConfig.ClientInfo, path string) URLClient {
+	return URLClient{registry: registry, name: name, info: info, path: path}
+}
+
+// Prefix returns the URL of the path of the service
+func (c URLClient) Prefix() (string, error) {
+	if c.registry != nil {
+		if endpoint, ok := c.registry.Endpoint(c.name); ok {
+			return endpoint.Url() + c.path, nil
+		}
+	}
+	return c.info.Url() + c.path, nil
+}
@@ -0,0 +1,140 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package endpoints
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeEndpoints(t *testing.T, path string, contents string, modTime time.Time) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestRegistryResolvesFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "endpoints")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "endpoints.toml")
+	writeEndpoints(t, path, `
+[Metadata]
+Host = 'metadata.local'
+Port = 48081
+[edgex-core-data]
+Protocol = 'https'
+Host = 'data.local'
+Port = 48080
+`, time.Now().Add(-time.Minute))
+
+	registry, err := NewRegistry(path, logger.NewMockClient())
+	require.NoError(t, err)
+	assert.True(t, registry.IsAlive())
+
+	endpoint, err := registry.GetServiceEndpoint(clients.CoreDataServiceKey)
+	require.NoError(t, err)
+	assert.Equal(t, "data.local", endpoint.Host)
+	assert.Equal(t, 48080, endpoint.Port)
+	_, err = registry.GetServiceEndpoint(clients.SupportNotificationsServiceKey)
+	assert.Error(t, err)
+
+	fallback := bootstrapConfig.ClientInfo{Protocol: "http", Host: "localhost", Port: 48081}
+	urlClient := NewURLClient(registry, "Metadata", fallback, clients.ApiDeviceRoute)
+	prefix, err := urlClient.Prefix()
+	require.NoError(t, err)
+	assert.Equal(t, "http://metadata.local:48081"+clients.ApiDeviceRoute, prefix, "the protocol defaults to http")
+
+	unlisted := NewURLClient(registry, "Notifications", bootstrapConfig.ClientInfo{Protocol: "http", Host: "localhost", Port: 48060}, "")
+	prefix, err = unlisted.Prefix()
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:48060", prefix, "services not listed are resolved from the Clients configuration")
+
+	// a changed file is reloaded, a broken one is kept
+	writeEndpoints(t, path, "[Metadata]\nHost = 'metadata.remote'\nPort = 58081\n", time.Now())
+	reloaded, err := registry.reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	prefix, err = urlClient.Prefix()
+	require.NoError(t, err)
+	assert.Equal(t, "http://metadata.remote:58081"+clients.ApiDeviceRoute, prefix)
+
+	reloaded, err = registry.reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded, "an unchanged file isn't reloaded")
+
+	writeEndpoints(t, path, "[Metadata]\nHost = 'metadata.remote'\n", time.Now().Add(time.Minute))
+	_, err = registry.reload()
+	assert.Error(t, err, "an endpoint must have a port")
+	prefix, err = urlClient.Prefix()
+	require.NoError(t, err)
+	assert.Equal(t, "http://metadata.remote:58081"+clients.ApiDeviceRoute, prefix)
+}
+
+func TestURLClientWithoutRegistry(t *testing.T) {
+	urlClient := NewURLClient(nil, "Metadata", bootstrapConfig.ClientInfo{Protocol: "http", Host: "localhost", Port: 48081}, clients.ApiDeviceRoute)
+	prefix, err := urlClient.Prefix()
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:48081"+clients.ApiDeviceRoute, prefix)
+}
+
+func TestNewRegistryInvalidFile(t *testing.T) {
+	_, err := NewRegistry(filepath.Join(os.TempDir(), "missing-endpoints.toml"), logger.NewMockClient())
+	assert.Error(t, err)
+}
+
+func TestIsServiceAvailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != clients.ApiPingRoute {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("pong"))
+	}))
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverUrl.Port())
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "endpoints")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "endpoints.toml")
+	writeEndpoints(t, path, "[Metadata]\nHost = '"+serverUrl.Hostname()+"'\nPort = "+strconv.Itoa(port)+"\n", time.Now())
+
+	registry, err := NewRegistry(path, logger.NewMockClient())
+	require.NoError(t, err)
+	available, err := registry.IsServiceAvailable("Metadata")
+	require.NoError(t, err)
+	assert.True(t, available)
+
+	available, err = registry.IsServiceAvailable("CoreData")
+	assert.Error(t, err)
+	assert.False(t, available)
+}
@@ -25,6 +25,12 @@ var Command commandErrorConcept
 // ValueDescriptorsErrorConcept represents the accessor for the value-descriptor-specific error concepts
 type commandErrorConcept struct {
 	NotAssociatedWithDevice commandNotAssociatedWithDevice
+	Forbidden               commandForbidden
+	InvalidValueTransform   commandInvalidValueTransform
+	InvalidSimulatedValue   commandInvalidSimulatedValue
+	ResourceNotReadable     commandResourceNotReadable
+	QueueFull               commandQueueFull
+	QueueTimeout            commandQueueTimeout
 }
 
 type commandNotAssociatedWithDevice struct{}
@@ -41,3 +47,93 @@ func (r commandNotAssociatedWithDevice) isA(err error) bool {
 func (r commandNotAssociatedWithDevice) message(err error) string {
 	return err.Error()
 }
+
+type commandForbidden struct{}
+
+func (r commandForbidden) httpErrorCode() int {
+	return http.StatusForbidden
+}
+
+func (r commandForbidden) isA(err error) bool {
+	_, ok := err.(errors.ErrCommandForbidden)
+	return ok
+}
+
+func (r commandForbidden) message(err error) string {
+	return err.Error()
+}
+
+type commandInvalidValueTransform struct{}
+
+func (r commandInvalidValueTransform) httpErrorCode() int {
+	return http.StatusBadRequest
+}
+
+func (r commandInvalidValueTransform) isA(err error) bool {
+	_, ok := err.(errors.ErrInvalidValueTransform)
+	return ok
+}
+
+func (r commandInvalidValueTransform) message(err error) string {
+	return err.Error()
+}
+
+type commandInvalidSimulatedValue struct{}
+
+func (r commandInvalidSimulatedValue) httpErrorCode() int {
+	return http.StatusBadRequest
+}
+
+func (r commandInvalidSimulatedValue) isA(err error) bool {
+	_, ok := err.(errors.ErrInvalidSimulatedValue)
+	return ok
+}
+
+func (r commandInvalidSimulatedValue) message(err error) string {
+	return err.Error()
+}
+
+type commandResourceNotReadable struct{}
+
+func (r commandResourceNotReadable) httpErrorCode() int {
+	return http.StatusNotFound
+}
+
+func (r commandResourceNotReadable) isA(err error) bool {
+	_, ok := err.(errors.ErrResourceNotReadable)
+	return ok
+}
+
+func (r commandResourceNotReadable) message(err error) string {
+	return err.Error()
+}
+
+type commandQueueFull struct{}
+
+func (r commandQueueFull) httpErrorCode() int {
+	return http.StatusTooManyRequests
+}
+
+func (r commandQueueFull) isA(err error) bool {
+	_, ok := err.(errors.ErrCommandQueueFull)
+	return ok
+}
+
+func (r commandQueueFull) message(err error) string {
+	return err.Error()
+}
+
+type commandQueueTimeout struct{}
+
+func (r commandQueueTimeout) httpErrorCode() int {
+	return http.StatusServiceUnavailable
+}
+
+func (r commandQueueTimeout) isA(err error) bool {
+	_, ok := err.(errors.ErrCommandQueueTimeout)
+	return ok
+}
+
+func (r commandQueueTimeout) message(err error) string {
+	return err.Error()
+}
@@ -17,6 +17,7 @@ package errorconcept
 import (
 	"net/http"
 
+	metadata "github.com/edgexfoundry/edgex-go/internal/core/metadata/errors"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 )
 
@@ -30,6 +31,8 @@ type deviceServiceErrorConcept struct {
 	InvalidState                        deviceServiceInvalidState
 	NotUnique                           deviceServiceNotUnique
 	NotFound                            deviceServiceNotFound
+	RegistrationTokenInvalid            deviceServiceRegistrationTokenInvalid
+	RegistrationTokenForbidden          deviceServiceRegistrationTokenForbidden
 }
 
 type deviceServiceAddressableNotFound struct{}
@@ -138,3 +141,33 @@ func (r deviceServiceNotFound) isA(err error) bool {
 func (r deviceServiceNotFound) message(err error) string {
 	return "Device service not found"
 }
+
+type deviceServiceRegistrationTokenInvalid struct{}
+
+func (r deviceServiceRegistrationTokenInvalid) httpErrorCode() int {
+	return http.StatusUnauthorized
+}
+
+func (r deviceServiceRegistrationTokenInvalid) isA(err error) bool {
+	_, ok := err.(metadata.ErrRegistrationTokenInvalid)
+	return ok
+}
+
+func (r deviceServiceRegistrationTokenInvalid) message(err error) string {
+	return err.Error()
+}
+
+type deviceServiceRegistrationTokenForbidden struct{}
+
+func (r deviceServiceRegistrationTokenForbidden) httpErrorCode() int {
+	return http.StatusForbidden
+}
+
+func (r deviceServiceRegistrationTokenForbidden) isA(err error) bool {
+	_, ok := err.(metadata.ErrRegistrationTokenForbidden)
+	return ok
+}
+
+func (r deviceServiceRegistrationTokenForbidden) message(err error) string {
+	return err.Error()
+}
@@ -0,0 +1,74 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+
+// Package featureflag tells whether the experimental behaviors of a service are enabled. The flags are read from the
+// Writable configuration of the service, so they are toggled at runtime through the registry without a rebuild or a
+// restart.
+package featureflag
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// ApiFeatureFlagsRoute lists the states of the feature flags of a service
+const ApiFeatureFlagsRoute = clients.ApiBase + "/featureflags"
+
+// State is the state of a feature flag
+type State struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Flags reads the feature flags of a service from its configuration
+type Flags struct {
+	source func() map[string]bool
+}
+
+// NewFlags returns the flags read from source on each query, the flags missing from source are disabled
+func NewFlags(source func() map[string]bool) *Flags {
+	return &Flags{source: source}
+}
+
+// Enabled tells whether the feature flag is enabled, always false when the service has no feature flags
+func (f *Flags) Enabled(name string) bool {
+	if f == nil {
+		return false
+	}
+	return f.source()[name]
+}
+
+// States returns the states of the feature flags sorted by name
+func (f *Flags) States() []State {
+	states := []State{}
+	if f == nil {
+		return states
+	}
+	for name, enabled := range f.source() {
+		states = append(states, State{Name: name, Enabled: enabled})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Name < states[j].Name })
+	return states
+}
+
+// ReportHandler lists the states of the feature flags, the handler of ApiFeatureFlagsRoute
+func (f *Flags) ReportHandler(w http.ResponseWriter, _ *http.Request, lc logger.LoggingClient) {
+	pkg.Encode(f.States(), w, lc)
+}
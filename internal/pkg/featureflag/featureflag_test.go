@@ -0,0 +1,61 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+
+package featureflag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlags(t *testing.T) {
+	writable := map[string]bool{"RedisReadingIndex": true, "ZeroMQPublisher": false}
+	flags := NewFlags(func() map[string]bool { return writable })
+
+	assert.True(t, flags.Enabled("RedisReadingIndex"))
+	assert.False(t, flags.Enabled("ZeroMQPublisher"))
+	assert.False(t, flags.Enabled("Unknown"))
+
+	// The registry replaces the Writable configuration when a flag is toggled
+	writable = map[string]bool{"RedisReadingIndex": false, "ZeroMQPublisher": true}
+	assert.False(t, flags.Enabled("RedisReadingIndex"))
+	assert.True(t, flags.Enabled("ZeroMQPublisher"))
+	assert.Equal(t, []State{{"RedisReadingIndex", false}, {"ZeroMQPublisher", true}}, flags.States())
+}
+
+func TestNilFlags(t *testing.T) {
+	var flags *Flags
+	assert.False(t, flags.Enabled("RedisReadingIndex"))
+	assert.Empty(t, flags.States())
+}
+
+func TestReportHandler(t *testing.T) {
+	flags := NewFlags(func() map[string]bool { return map[string]bool{"b": true, "a": false} })
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, ApiFeatureFlagsRoute, http.NoBody)
+	flags.ReportHandler(recorder, req, logger.NewMockClient())
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var states []State
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &states))
+	assert.Equal(t, []State{{"a", false}, {"b", true}}, states)
+}
@@ -0,0 +1,305 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package messagebus
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	// Optional properties of the message bus configuration used by the JetStream client
+	usernameProperty = "Username"
+	passwordProperty = "Password"
+	clientIdProperty = "ClientId"
+)
+
+var durableNamePattern = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// JetStreamInfo configures the stream the messages published to NATS JetStream are retained in, and the durable
+// consumers the messages are received from, when the message bus Type is 'jetstream'.
+type JetStreamInfo struct {
+	// Stream is the name of the stream retaining the messages, created or updated from this configuration on connect.
+	// The stream is expected to exist when empty.
+	Stream string
+	// Subjects are the subjects the stream retains the messages of, i.e. 'edgex.events.>'. Topics are mapped to
+	// subjects by replacing '/' with '.', '+' with '*' and '#' with '>'.
+	Subjects []string
+	// Retention is the retention policy of the stream: 'limits' keeps the messages until MaxAge, MaxMsgs or MaxBytes
+	// is reached, 'interest' until all the consumers acknowledged them, 'workqueue' until one consumer did.
+	Retention string
+	// MaxAge is how long the messages are retained, i.e. '24h'. Unlimited when empty.
+	MaxAge string
+	// MaxMsgs is the number of messages retained, the oldest being discarded. Unlimited when zero.
+	MaxMsgs int64
+	// MaxBytes is the size of the messages retained, the oldest being discarded. Unlimited when zero.
+	MaxBytes int64
+	// Storage is where the messages are retained, 'file' or 'memory'.
+	Storage string
+	// Replicas is the number of copies of the stream in a JetStream cluster.
+	Replicas int
+	// Durable is the name of the durable consumers of the subscriptions, so that the messages published while the
+	// service was stopped are received on restart. Each subscribed topic has its own consumer, suffixed with the
+	// topic when more than one topic is subscribed. The consumers are ephemeral when empty.
+	Durable string
+	// AckWait is how long a received message isn't redelivered while it is being handed over, i.e. '30s'.
+	AckWait string
+	// MaxDeliver is how many times a message is delivered before being dropped. Unlimited when zero.
+	MaxDeliver int
+}
+
+// streamConfig returns the configuration of the stream
+func (info JetStreamInfo) streamConfig() (*nats.StreamConfig, error) {
+	config := &nats.StreamConfig{
+		Name:     info.Stream,
+		MaxMsgs:  -1,
+		MaxBytes: -1,
+		Replicas: info.Replicas,
+	}
+	for _, subject := range info.Subjects {
+		config.Subjects = append(config.Subjects, topicSubject(subject))
+	}
+	if info.MaxMsgs > 0 {
+		config.MaxMsgs = info.MaxMsgs
+	}
+	if info.MaxBytes > 0 {
+		config.MaxBytes = info.MaxBytes
+	}
+	if config.Replicas <= 0 {
+		config.Replicas = 1
+	}
+
+	switch strings.ToLower(info.Retention) {
+	case "", "limits":
+		config.Retention = nats.LimitsPolicy
+	case "interest":
+		config.Retention = nats.InterestPolicy
+	case "workqueue":
+		config.Retention = nats.WorkQueuePolicy
+	default:
+		return nil, fmt.Errorf("unknown JetStream retention policy '%s'", info.Retention)
+	}
+
+	switch strings.ToLower(info.Storage) {
+	case "", "file":
+		config.Storage = nats.FileStorage
+	case "memory":
+		config.Storage = nats.MemoryStorage
+	default:
+		return nil, fmt.Errorf("unknown JetStream storage '%s'", info.Storage)
+	}
+
+	if info.MaxAge != "" {
+		maxAge, err := time.ParseDuration(info.MaxAge)
+		if err != nil || maxAge < 0 {
+			return nil, fmt.Errorf("invalid JetStream MaxAge '%s'", info.MaxAge)
+		}
+		config.MaxAge = maxAge
+	}
+	return config, nil
+}
+
+// subscribeOptions returns the options of the subscription to the topic
+func (info JetStreamInfo) subscribeOptions(topic string, topicCount int) ([]nats.SubOpt, error) {
+	options := []nats.SubOpt{nats.ManualAck()}
+	if info.Durable != "" {
+		durable := info.Durable
+		if topicCount > 1 {
+			durable += "-" + durableNamePattern.ReplaceAllString(topic, "_")
+		}
+		options = append(options, nats.Durable(durable))
+	}
+	if info.AckWait != "" {
+		ackWait, err := time.ParseDuration(info.AckWait)
+		if err != nil || ackWait <= 0 {
+			return nil, fmt.Errorf("invalid JetStream AckWait '%s'", info.AckWait)
+		}
+		options = append(options, nats.AckWait(ackWait))
+	}
+	if info.MaxDeliver > 0 {
+		options = append(options, nats.MaxDeliver(info.MaxDeliver))
+	}
+	return options, nil
+}
+
+// topicSubject maps a message bus topic to a NATS subject, i.e. 'events/+/Device1/#' to 'events.*.Device1.>'
+func topicSubject(topic string) string {
+	return strings.NewReplacer("/", ".", "+", "*", "#", ">").Replace(topic)
+}
+
+// jetStreamClient is the message client publishing and receiving the messages through NATS JetStream, the message
+// envelopes are published as JSON like the other message bus implementations.
+type jetStreamClient struct {
+	url     string
+	options []nats.Option
+	info    JetStreamInfo
+
+	mutex         sync.Mutex
+	conn          *nats.Conn
+	js            nats.JetStreamContext
+	subscriptions []*nats.Subscription
+}
+
+// newJetStreamClient creates the JetStream client of the message bus configuration
+func newJetStreamClient(config types.MessageBusConfig, info JetStreamInfo) (*jetStreamClient, error) {
+	host := config.PublishHost
+	if host.IsHostInfoEmpty() {
+		host = config.SubscribeHost
+	}
+	if host.Protocol == "" || host.Protocol == "tcp" {
+		host.Protocol = "nats"
+	}
+
+	// Validate the configuration now rather than when connecting or subscribing.
+	if info.Stream != "" {
+		if _, err := info.streamConfig(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := info.subscribeOptions("", 1); err != nil {
+		return nil, err
+	}
+
+	options := []nats.Option{nats.MaxReconnects(-1)}
+	if clientId := config.Optional[clientIdProperty]; clientId != "" {
+		options = append(options, nats.Name(clientId))
+	}
+	if username := config.Optional[usernameProperty]; username != "" {
+		options = append(options, nats.UserInfo(username, config.Optional[passwordProperty]))
+	}
+
+	return &jetStreamClient{url: host.GetHostURL(), options: options, info: info}, nil
+}
+
+// Connect connects to the NATS server, then creates or updates the stream from the configuration
+func (c *jetStreamClient) Connect() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.conn != nil {
+		return nil
+	}
+
+	conn, err := nats.Connect(c.url, c.options...)
+	if err != nil {
+		return err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	if c.info.Stream != "" {
+		config, _ := c.info.streamConfig()
+		if _, err = js.StreamInfo(config.Name); err != nil {
+			_, err = js.AddStream(config)
+		} else {
+			_, err = js.UpdateStream(config)
+		}
+		if err != nil {
+			conn.Close()
+			return fmt.Errorf("unable to set up JetStream stream %s: %s", config.Name, err.Error())
+		}
+	}
+
+	c.conn = conn
+	c.js = js
+	return nil
+}
+
+// Publish publishes the message envelope to the subject of the topic, once JetStream acknowledged it is retained
+func (c *jetStreamClient) Publish(message types.MessageEnvelope, topic string) error {
+	js, err := c.jetStream()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	_, err = js.Publish(topicSubject(topic), data)
+	return err
+}
+
+// Subscribe receives the messages of the topics from their consumer, a message is acknowledged once handed over to
+// the channel of its topic. The messages which can't be decoded are reported to messageErrors and terminated.
+func (c *jetStreamClient) Subscribe(topics []types.TopicChannel, messageErrors chan error) error {
+	js, err := c.jetStream()
+	if err != nil {
+		return err
+	}
+
+	for _, topic := range topics {
+		options, err := c.info.subscribeOptions(topic.Topic, len(topics))
+		if err != nil {
+			return err
+		}
+
+		messages := topic.Messages
+		subscription, err := js.Subscribe(topicSubject(topic.Topic), func(msg *nats.Msg) {
+			var message types.MessageEnvelope
+			if err := json.Unmarshal(msg.Data, &message); err != nil {
+				messageErrors <- fmt.Errorf("unable to decode message of subject %s: %s", msg.Subject, err.Error())
+				_ = msg.Term()
+				return
+			}
+			messages <- message
+			_ = msg.Ack()
+		}, options...)
+		if err != nil {
+			return fmt.Errorf("unable to subscribe to topic %s: %s", topic.Topic, err.Error())
+		}
+
+		c.mutex.Lock()
+		c.subscriptions = append(c.subscriptions, subscription)
+		c.mutex.Unlock()
+	}
+	return nil
+}
+
+// Disconnect stops the subscriptions, leaving their durable consumers, and closes the connection once the messages
+// being received are handled
+func (c *jetStreamClient) Disconnect() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.conn == nil {
+		return nil
+	}
+	c.subscriptions = nil
+	err := c.conn.Drain()
+	c.conn = nil
+	c.js = nil
+	return err
+}
+
+func (c *jetStreamClient) jetStream() (nats.JetStreamContext, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.js == nil {
+		return nil, fmt.Errorf("not connected to NATS server %s", c.url)
+	}
+	return c.js, nil
+}
@@ -0,0 +1,125 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package messagebus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+	"github.com/nats-io/nats.go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func busConfig(busType string) types.MessageBusConfig {
+	return types.MessageBusConfig{
+		PublishHost: types.HostInfo{Host: "localhost", Port: 4222, Protocol: "tcp"},
+		Type:        busType,
+		Optional:    map[string]string{"ClientId": "core-data", "Username": "edgex", "Password": "secret"},
+	}
+}
+
+func TestNewMessageClient(t *testing.T) {
+	client, err := NewMessageClient(busConfig("JetStream"), JetStreamInfo{Stream: "edgex"})
+	require.NoError(t, err)
+	require.IsType(t, &jetStreamClient{}, client)
+	assert.Equal(t, "nats://localhost:4222", client.(*jetStreamClient).url)
+
+	_, err = NewMessageClient(types.MessageBusConfig{Type: JetStream}, JetStreamInfo{})
+	assert.Error(t, err, "host info should be required")
+
+	_, err = NewMessageClient(busConfig(JetStream), JetStreamInfo{Stream: "edgex", Retention: "forever"})
+	assert.Error(t, err, "invalid stream configuration should be reported on creation")
+
+	_, err = NewMessageClient(busConfig("unknown"), JetStreamInfo{})
+	assert.Error(t, err, "other types should be created by go-mod-messaging")
+}
+
+func TestTopicSubject(t *testing.T) {
+	assert.Equal(t, "events", topicSubject("events"))
+	assert.Equal(t, "events.Random.Device1.Int8", topicSubject("events/Random/Device1/Int8"))
+	assert.Equal(t, "events.*.Device1.>", topicSubject("events/+/Device1/#"))
+}
+
+func TestStreamConfig(t *testing.T) {
+	config, err := JetStreamInfo{
+		Stream:    "edgex",
+		Subjects:  []string{"events", "events/#"},
+		Retention: "WorkQueue",
+		MaxAge:    "24h",
+		MaxMsgs:   1000,
+		Storage:   "memory",
+		Replicas:  3,
+	}.streamConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "edgex", config.Name)
+	assert.Equal(t, []string{"events", "events.>"}, config.Subjects)
+	assert.Equal(t, nats.WorkQueuePolicy, config.Retention)
+	assert.Equal(t, 24*time.Hour, config.MaxAge)
+	assert.Equal(t, int64(1000), config.MaxMsgs)
+	assert.Equal(t, int64(-1), config.MaxBytes, "unset limits should be unlimited")
+	assert.Equal(t, nats.MemoryStorage, config.Storage)
+	assert.Equal(t, 3, config.Replicas)
+
+	config, err = JetStreamInfo{Stream: "edgex"}.streamConfig()
+	require.NoError(t, err)
+	assert.Equal(t, nats.LimitsPolicy, config.Retention)
+	assert.Equal(t, nats.FileStorage, config.Storage)
+	assert.Equal(t, 1, config.Replicas)
+
+	tests := []struct {
+		name string
+		info JetStreamInfo
+	}{
+		{"retention", JetStreamInfo{Stream: "edgex", Retention: "forever"}},
+		{"storage", JetStreamInfo{Stream: "edgex", Storage: "tape"}},
+		{"max age", JetStreamInfo{Stream: "edgex", MaxAge: "a day"}},
+		{"negative max age", JetStreamInfo{Stream: "edgex", MaxAge: "-1h"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.info.streamConfig()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSubscribeOptions(t *testing.T) {
+	info := JetStreamInfo{Durable: "core-command", AckWait: "30s", MaxDeliver: 5}
+
+	options, err := info.subscribeOptions("responses", 1)
+	require.NoError(t, err)
+	assert.Len(t, options, 4)
+
+	options, err = JetStreamInfo{}.subscribeOptions("responses", 1)
+	require.NoError(t, err)
+	assert.Len(t, options, 1, "only manual acknowledgement should be set by default")
+
+	_, err = JetStreamInfo{AckWait: "soon"}.subscribeOptions("responses", 1)
+	assert.Error(t, err)
+	_, err = JetStreamInfo{AckWait: "0s"}.subscribeOptions("responses", 1)
+	assert.Error(t, err)
+}
+
+func TestNotConnected(t *testing.T) {
+	client, err := NewMessageClient(busConfig(JetStream), JetStreamInfo{})
+	require.NoError(t, err)
+
+	assert.Error(t, client.Publish(types.MessageEnvelope{Payload: []byte("{}")}, "events"))
+	assert.Error(t, client.Subscribe([]types.TopicChannel{{Topic: "events"}}, make(chan error)))
+	assert.NoError(t, client.Disconnect())
+}
@@ -0,0 +1,41 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package messagebus creates the message clients of the services, adding the message bus implementations which
+// go-mod-messaging doesn't provide.
+package messagebus
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/pkg/types"
+)
+
+// JetStream is the message bus Type of the NATS JetStream implementation
+const JetStream = "jetstream"
+
+// NewMessageClient creates the message client of the message bus Type, the NATS JetStream client configured by
+// jetStream when the Type is 'jetstream', otherwise the go-mod-messaging client.
+func NewMessageClient(config types.MessageBusConfig, jetStream JetStreamInfo) (messaging.MessageClient, error) {
+	if strings.ToLower(config.Type) != JetStream {
+		return messaging.NewMessageClient(config)
+	}
+
+	if config.PublishHost.IsHostInfoEmpty() && config.SubscribeHost.IsHostInfoEmpty() {
+		return nil, fmt.Errorf("unable to create messageClient: host info not set")
+	}
+	return newJetStreamClient(config, jetStream)
+}
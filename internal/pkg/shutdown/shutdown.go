@@ -0,0 +1,188 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package shutdown coordinates the graceful shutdown of a service: once the service is exiting, the new requests are
+// rejected, the requests and messages being handled are drained, then the pending work is flushed and the resources
+// released in order, before the database is closed.
+package shutdown
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// ShutdownInfo configures the graceful shutdown of the service
+type ShutdownInfo struct {
+	// DrainTimeout is how long the requests and messages being handled are waited for once the service is exiting,
+	// i.e. '20s', before the pending work is flushed regardless.
+	DrainTimeout string
+}
+
+// retryAfterSeconds is the Retry-After of the requests rejected while draining, the time for the service to restart
+const retryAfterSeconds = 5
+
+// httpServer defines the contract used to determine whether or not the http server is running.
+type httpServer interface {
+	IsRunning() bool
+}
+
+type hook struct {
+	name string
+	fn   func()
+}
+
+// Coordinator tracks the requests and messages being handled, and runs the shutdown hooks once they are drained.
+// It implements the httpServer contract of the database bootstrap handlers so that the database is closed last.
+type Coordinator struct {
+	httpServer httpServer
+
+	mutex    sync.Mutex
+	draining bool
+	inFlight int
+	idle     chan struct{}
+	hooks    []hook
+	stopped  bool
+
+	drainedCtx context.Context
+	drained    context.CancelFunc
+}
+
+// NewCoordinator returns a coordinator of the shutdown of the service served by the http server
+func NewCoordinator(httpServer httpServer) *Coordinator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Coordinator{
+		httpServer: httpServer,
+		idle:       make(chan struct{}),
+		drainedCtx: ctx,
+		drained:    cancel,
+	}
+}
+
+// Track records the start of the handling of a request or message, and returns the function recording its end.
+// False is returned once the service is draining, the request or message is then to be rejected.
+func (c *Coordinator) Track() (func(), bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.draining {
+		return nil, false
+	}
+	c.inFlight++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mutex.Lock()
+			defer c.mutex.Unlock()
+			c.inFlight--
+			if c.draining && c.inFlight == 0 {
+				close(c.idle)
+			}
+		})
+	}, true
+}
+
+// InFlight returns the number of requests and messages being handled
+func (c *Coordinator) InFlight() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.inFlight
+}
+
+// Middleware tracks the requests being handled, the requests received while draining are rejected with 503 and the
+// connection closed so that the clients retry against the restarted service.
+func (c *Coordinator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		done, ok := c.Track()
+		if !ok {
+			w.Header().Set("Connection", "close")
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds))
+			http.Error(w, "the service is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer done()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Context returns the context cancelled once the requests and messages being handled are drained, for the workers
+// which must keep up until then, i.e. the subscriptions of the message bus the requests are waiting on.
+func (c *Coordinator) Context() context.Context {
+	return c.drainedCtx
+}
+
+// OnShutdown registers the function flushing the pending work or releasing a resource once the requests and messages
+// are drained. The functions run in the order they are registered, each one returning once it is done.
+func (c *Coordinator) OnShutdown(name string, fn func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.hooks = append(c.hooks, hook{name: name, fn: fn})
+}
+
+// IsRunning returns whether the service is still handling requests or flushing pending work, the database is closed
+// once it returns false.
+func (c *Coordinator) IsRunning() bool {
+	c.mutex.Lock()
+	stopped := c.stopped
+	c.mutex.Unlock()
+	return !stopped || (c.httpServer != nil && c.httpServer.IsRunning())
+}
+
+// Shutdown rejects the new requests and messages, waits for the ones being handled up to the timeout, then cancels
+// the drained context and runs the shutdown hooks.
+func (c *Coordinator) Shutdown(timeout time.Duration, lc logger.LoggingClient) {
+	c.mutex.Lock()
+	if c.draining {
+		c.mutex.Unlock()
+		return
+	}
+	c.draining = true
+	if c.inFlight == 0 {
+		close(c.idle)
+	}
+	inFlight := c.inFlight
+	c.mutex.Unlock()
+
+	if inFlight > 0 {
+		lc.Info(fmt.Sprintf("Draining %d request(s) and message(s) being handled", inFlight))
+	}
+	timer := time.NewTimer(timeout)
+	select {
+	case <-c.idle:
+		timer.Stop()
+	case <-timer.C:
+		lc.Warn(fmt.Sprintf("%d request(s) and message(s) still being handled after %s, shutting down regardless",
+			c.InFlight(), timeout.String()))
+	}
+	c.drained()
+
+	c.mutex.Lock()
+	hooks := c.hooks
+	c.mutex.Unlock()
+	for _, h := range hooks {
+		lc.Debug(fmt.Sprintf("Shutting down %s", h.name))
+		h.fn()
+	}
+
+	c.mutex.Lock()
+	c.stopped = true
+	c.mutex.Unlock()
+	lc.Info("Graceful shutdown completed")
+}
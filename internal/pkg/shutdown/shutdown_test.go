@@ -0,0 +1,104 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package shutdown
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stoppedServer struct{}
+
+func (stoppedServer) IsRunning() bool { return false }
+
+func TestMiddlewareRejectsWhileDraining(t *testing.T) {
+	coordinator := NewCoordinator(stoppedServer{})
+	handler := coordinator.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	coordinator.Shutdown(time.Second, logger.NewMockClient())
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "close", recorder.Header().Get("Connection"))
+	assert.NotEmpty(t, recorder.Header().Get("Retry-After"))
+}
+
+func TestShutdownDrainsBeforeHooks(t *testing.T) {
+	coordinator := NewCoordinator(stoppedServer{})
+	done, ok := coordinator.Track()
+	require.True(t, ok)
+
+	var order []string
+	coordinator.OnShutdown("write-behind", func() { order = append(order, "write-behind") })
+	coordinator.OnShutdown("message bus", func() { order = append(order, "message bus") })
+
+	shutdown := make(chan struct{})
+	go func() {
+		coordinator.Shutdown(time.Minute, logger.NewMockClient())
+		close(shutdown)
+	}()
+
+	select {
+	case <-shutdown:
+		t.Fatal("shutdown should wait for the request being handled")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.NoError(t, coordinator.Context().Err(), "drained context should not be cancelled while draining")
+	assert.True(t, coordinator.IsRunning())
+	_, ok = coordinator.Track()
+	assert.False(t, ok, "new requests should be rejected while draining")
+
+	done()
+	done()
+	select {
+	case <-shutdown:
+	case <-time.After(time.Second):
+		t.Fatal("shutdown should complete once the request is handled")
+	}
+	assert.Error(t, coordinator.Context().Err())
+	assert.Equal(t, []string{"write-behind", "message bus"}, order)
+	assert.Equal(t, 0, coordinator.InFlight())
+	assert.False(t, coordinator.IsRunning())
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	coordinator := NewCoordinator(stoppedServer{})
+	_, ok := coordinator.Track()
+	require.True(t, ok)
+
+	hooked := false
+	coordinator.OnShutdown("write-behind", func() { hooked = true })
+
+	start := time.Now()
+	coordinator.Shutdown(50*time.Millisecond, logger.NewMockClient())
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+	assert.True(t, hooked, "hooks should run once the drain timed out")
+	assert.Equal(t, 1, coordinator.InFlight())
+	assert.False(t, coordinator.IsRunning())
+}
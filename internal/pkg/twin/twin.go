@@ -0,0 +1,195 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+// Package twin holds the desired state of the devices, set through core-metadata, which core-command reconciles
+// against the devices by issuing set commands, along with the values last reported by the devices.
+package twin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/interfaces"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+)
+
+const (
+	// ApiDeviceTwinByNameRoute is the core-metadata route of the twin of a device
+	ApiDeviceTwinByNameRoute = v2.ApiDeviceByNameRoute + "/twin"
+	// ApiDeviceTwinDesiredByNameRoute is the core-metadata route setting the desired state of a device
+	ApiDeviceTwinDesiredByNameRoute = ApiDeviceTwinByNameRoute + "/desired"
+	// ApiDeviceTwinReportedByNameRoute is the core-metadata route core-command reports the reconciliation of a device to
+	ApiDeviceTwinReportedByNameRoute = ApiDeviceTwinByNameRoute + "/reported"
+	// ApiAllDeviceTwinsRoute is the core-metadata route of the twins of all the devices with a desired state
+	ApiAllDeviceTwinsRoute = v2.ApiDeviceRoute + "/twin/" + v2.All
+)
+
+const (
+	// StatePending tells that the desired state was changed since the device was last reconciled
+	StatePending = "Pending"
+	// StateInSync tells that the values reported by the device match the desired state
+	StateInSync = "InSync"
+	// StateFailed tells that the device couldn't be set to the desired state, see the errors of the status
+	StateFailed = "Failed"
+)
+
+// Twin is the desired state of a device, by deviceResource name, and the values of those deviceResources last
+// reported by the device
+type Twin struct {
+	DeviceName string            `json:"deviceName"`
+	Desired    map[string]string `json:"desired"`
+	// Version is incremented each time the desired state changes
+	Version int64 `json:"version"`
+	// Updated is when the desired state last changed, in milliseconds since the epoch
+	Updated  int64             `json:"updated"`
+	Reported map[string]string `json:"reported,omitempty"`
+	Status   Status            `json:"status"`
+}
+
+// Status is the outcome of the last reconciliation of a device
+type Status struct {
+	State string `json:"state"`
+	// Version is the version of the desired state the device was last reconciled against
+	Version int64 `json:"version"`
+	// Reconciled is when the device was last reconciled, in milliseconds since the epoch
+	Reconciled int64 `json:"reconciled,omitempty"`
+	// Errors are the reasons the deviceResources couldn't be set, by deviceResource name
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// DesiredRequest sets the desired state of a device
+type DesiredRequest struct {
+	Desired map[string]string `json:"desired"`
+}
+
+// Report is the outcome of the reconciliation of a device against the version of its desired state
+type Report struct {
+	Version  int64             `json:"version"`
+	Reported map[string]string `json:"reported"`
+	State    string            `json:"state"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
+// Differences returns the sorted names of the deviceResources whose reported value differs from the desired one
+func (t Twin) Differences() []string {
+	var names []string
+	for name, value := range t.Desired {
+		if reported, ok := t.Reported[name]; !ok || reported != value {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDesired replaces the desired state of the twin, which is pending until the device is reconciled against it
+func (t *Twin) SetDesired(desired map[string]string, updated int64) {
+	t.Desired = desired
+	t.Version++
+	t.Updated = updated
+	t.Status = Status{State: StatePending, Version: t.Version, Reconciled: t.Status.Reconciled}
+}
+
+// ApplyReport keeps the values reported by the device. The status of the reconciliation is kept only when it is about
+// the current version of the desired state, the twin stays pending otherwise.
+func (t *Twin) ApplyReport(report Report, reconciled int64) {
+	t.Reported = report.Reported
+	if report.Version != t.Version {
+		t.Status.Reconciled = reconciled
+		return
+	}
+	t.Status = Status{State: report.State, Version: report.Version, Reconciled: reconciled, Errors: report.Errors}
+}
+
+// Client reads the twins from core-metadata and reports their reconciliation
+type Client struct {
+	urlClient interfaces.URLClient
+	client    *http.Client
+}
+
+// NewClient returns a client of the core-metadata service at the base URL of the URL client
+func NewClient(urlClient interfaces.URLClient) *Client {
+	return &Client{urlClient: urlClient, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// pageSize is the number of twins read from core-metadata per request
+const pageSize = 100
+
+// AllTwins returns the twins of all the devices with a desired state
+func (c *Client) AllTwins(ctx context.Context) ([]Twin, error) {
+	baseUrl, err := c.urlClient.Prefix()
+	if err != nil {
+		return nil, err
+	}
+
+	var twins []Twin
+	for offset := 0; ; offset += pageSize {
+		query := url.Values{v2.Offset: {fmt.Sprint(offset)}, v2.Limit: {fmt.Sprint(pageSize)}}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseUrl+ApiAllDeviceTwinsRoute+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Twins []Twin `json:"twins"`
+		}
+		if err = c.do(req, &page); err != nil {
+			return nil, err
+		}
+		twins = append(twins, page.Twins...)
+		if len(page.Twins) < pageSize {
+			return twins, nil
+		}
+	}
+}
+
+// Report reports the reconciliation of the device to core-metadata
+func (c *Client) Report(ctx context.Context, deviceName string, report Report) error {
+	baseUrl, err := c.urlClient.Prefix()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	path := v2.ApiDeviceRoute + "/" + v2.Name + "/" + url.PathEscape(deviceName) + "/twin/reported"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, baseUrl+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+	return c.do(req, nil)
+}
+
+func (c *Client) do(req *http.Request, out interface{}) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("core-metadata replied %s", resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
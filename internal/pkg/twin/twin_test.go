@@ -0,0 +1,87 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package twin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciliation(t *testing.T) {
+	var tw Twin
+	tw.SetDesired(map[string]string{"SetPoint": "21", "Mode": "heat"}, 1000)
+	assert.Equal(t, int64(1), tw.Version)
+	assert.Equal(t, Status{State: StatePending, Version: 1}, tw.Status)
+	assert.Equal(t, []string{"Mode", "SetPoint"}, tw.Differences())
+
+	tw.ApplyReport(Report{Version: 1, Reported: map[string]string{"SetPoint": "21", "Mode": "cool"}, State: StateFailed,
+		Errors: map[string]string{"Mode": "forbidden"}}, 2000)
+	assert.Equal(t, Status{State: StateFailed, Version: 1, Reconciled: 2000, Errors: map[string]string{"Mode": "forbidden"}}, tw.Status)
+	assert.Equal(t, []string{"Mode"}, tw.Differences())
+
+	tw.SetDesired(map[string]string{"SetPoint": "21"}, 3000)
+	tw.ApplyReport(Report{Version: 1, Reported: map[string]string{"SetPoint": "21"}, State: StateInSync}, 4000)
+	assert.Equal(t, Status{State: StatePending, Version: 2, Reconciled: 4000}, tw.Status,
+		"a report about a previous version of the desired state leaves the twin pending")
+	assert.Empty(t, tw.Differences())
+}
+
+func TestClient(t *testing.T) {
+	var reports []Report
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == ApiAllDeviceTwinsRoute:
+			var page struct {
+				Twins []Twin `json:"twins"`
+			}
+			offset := r.URL.Query().Get("offset")
+			count := pageSize
+			if offset != "0" {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				page.Twins = append(page.Twins, Twin{DeviceName: fmt.Sprintf("device-%s-%d", offset, i)})
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v2/device/name/thermostat 1/twin/reported":
+			var report Report
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&report))
+			reports = append(reports, report)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(local.New(server.URL))
+	twins, err := client.AllTwins(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, twins, pageSize+1, "every page is read")
+
+	report := Report{Version: 1, Reported: map[string]string{"SetPoint": "21"}, State: StateInSync}
+	require.NoError(t, client.Report(context.Background(), "thermostat 1", report))
+	assert.Equal(t, []Report{report}, reports)
+
+	assert.Error(t, client.Report(context.Background(), "thermostat/1", report))
+}
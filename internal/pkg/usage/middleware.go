@@ -0,0 +1,144 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package usage
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// ApiUsageRoute reports the usage of the routes of a service
+	ApiUsageRoute = clients.ApiBase + "/usage"
+
+	// Query parameters of the usage report, the period it covers, i.e. "15m", and the route and consumer it is
+	// restricted to
+	PeriodParam   = "period"
+	RouteParam    = "route"
+	ConsumerParam = "consumer"
+
+	// ConsumerUsernameHeader and ConsumerIdHeader identify the consumer of the requests authenticated by the API
+	// gateway
+	ConsumerUsernameHeader = "X-Consumer-Username"
+	ConsumerIdHeader       = "X-Consumer-ID"
+	forwardedForHeader     = "X-Forwarded-For"
+)
+
+// DefaultRecorder counts the requests of the service in buckets of DefaultBucketSize for DefaultRetention
+var DefaultRecorder = NewRecorder(DefaultBucketSize, DefaultRetention)
+
+// Middleware counts the requests of the service with DefaultRecorder
+var Middleware = NewMiddleware(DefaultRecorder)
+
+// NewMiddleware returns a middleware counting the requests per route template and consumer with the recorder
+func NewMiddleware(recorder *Recorder) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The middleware may be registered more than once on a router shared by the v1 and v2 APIs, so make sure
+			// the request is only counted once.
+			if _, ok := w.(*statusWriter); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			begin := time.Now()
+			sw := &statusWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(sw, r)
+			recorder.Record(routeOf(r), r.Method, consumerOf(r), sw.statusCode, time.Since(begin))
+		})
+	}
+}
+
+// ReportHandler reports the usage counted by the recorder, the handler of ApiUsageRoute
+func (r *Recorder) ReportHandler(w http.ResponseWriter, req *http.Request, lc logger.LoggingClient) {
+	query := req.URL.Query()
+	var period time.Duration
+	if value := query.Get(PeriodParam); value != "" {
+		var err error
+		period, err = time.ParseDuration(value)
+		if err != nil || period <= 0 {
+			http.Error(w, "period must be a positive duration, i.e. 15m", http.StatusBadRequest)
+			lc.Error("Invalid usage period: " + value)
+			return
+		}
+	}
+
+	report := r.Report(period, Filter{Route: query.Get(RouteParam), Consumer: query.Get(ConsumerParam)})
+	pkg.Encode(report, w, lc)
+}
+
+// routeOf returns the template of the route the request matched, so that the requests of a route are counted
+// together whatever their path variables
+func routeOf(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
+}
+
+// consumerOf returns the consumer of the request as authenticated by the API gateway, or else the client address
+func consumerOf(r *http.Request) string {
+	if consumer := r.Header.Get(ConsumerUsernameHeader); consumer != "" {
+		return consumer
+	}
+	if consumer := r.Header.Get(ConsumerIdHeader); consumer != "" {
+		return consumer
+	}
+	if forwardedFor := r.Header.Get(forwardedForHeader); forwardedFor != "" {
+		return strings.TrimSpace(strings.Split(forwardedFor, ",")[0])
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// statusWriter keeps the status code of the response
+type statusWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+func (sw *statusWriter) WriteHeader(statusCode int) {
+	if !sw.wroteHeader {
+		sw.statusCode = statusCode
+		sw.wroteHeader = true
+	}
+	sw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (sw *statusWriter) Write(b []byte) (int, error) {
+	sw.wroteHeader = true
+	return sw.ResponseWriter.Write(b)
+}
+
+// Flush sends the output written so far to the client, for streamed responses
+func (sw *statusWriter) Flush() {
+	if flusher, ok := sw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
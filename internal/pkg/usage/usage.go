@@ -0,0 +1,215 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package usage
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBucketSize is the period whose requests are counted together
+	DefaultBucketSize = time.Minute
+	// DefaultRetention is how long requests are counted for, the counts of older requests roll off
+	DefaultRetention = time.Hour
+	// MaxKeysPerBucket bounds the routes and consumers counted apart per bucket, the requests of any other consumer
+	// are counted under OtherConsumer so that spoofed consumers can't exhaust the memory
+	MaxKeysPerBucket = 10000
+	// OtherConsumer is the consumer of the requests counted once a bucket holds MaxKeysPerBucket keys
+	OtherConsumer = "_other"
+)
+
+// RouteUsage is the usage of a route by a consumer
+type RouteUsage struct {
+	Route            string  `json:"route"`
+	Method           string  `json:"method"`
+	Consumer         string  `json:"consumer"`
+	Count            int64   `json:"count"`
+	ClientErrors     int64   `json:"clientErrors"`
+	ServerErrors     int64   `json:"serverErrors"`
+	AverageLatencyMs float64 `json:"averageLatencyMs"`
+	MaxLatencyMs     float64 `json:"maxLatencyMs"`
+}
+
+// Report is the usage of the routes of a service from Start to End, in milliseconds since the epoch, the most used
+// routes first
+type Report struct {
+	Start  int64        `json:"start"`
+	End    int64        `json:"end"`
+	Routes []RouteUsage `json:"routes"`
+}
+
+// Filter selects the usage reported, an empty field selects all
+type Filter struct {
+	Route    string
+	Consumer string
+}
+
+type key struct {
+	route    string
+	method   string
+	consumer string
+}
+
+type counters struct {
+	count        int64
+	clientErrors int64
+	serverErrors int64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+}
+
+type bucket struct {
+	start    time.Time
+	counters map[key]*counters
+}
+
+// Recorder counts the requests and their latencies per route and consumer in a rolling window made of buckets
+type Recorder struct {
+	mutex      sync.Mutex
+	bucketSize time.Duration
+	buckets    []bucket
+	now        func() time.Time
+}
+
+// NewRecorder creates a Recorder counting requests in buckets of bucketSize for the retention period
+func NewRecorder(bucketSize time.Duration, retention time.Duration) *Recorder {
+	n := int(retention / bucketSize)
+	if n < 1 {
+		n = 1
+	}
+	return &Recorder{
+		bucketSize: bucketSize,
+		buckets:    make([]bucket, n),
+		now:        time.Now,
+	}
+}
+
+// Record counts a request of the route by the consumer, with the status code and latency of its response
+func (r *Recorder) Record(route string, method string, consumer string, statusCode int, latency time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	start := r.now().Truncate(r.bucketSize)
+	b := &r.buckets[int(start.UnixNano()/int64(r.bucketSize))%len(r.buckets)]
+	if !b.start.Equal(start) {
+		b.start = start
+		b.counters = make(map[key]*counters)
+	}
+
+	k := key{route: route, method: method, consumer: consumer}
+	c, exists := b.counters[k]
+	if !exists {
+		if len(b.counters) >= MaxKeysPerBucket {
+			k.consumer = OtherConsumer
+			c, exists = b.counters[k]
+		}
+		if !exists {
+			c = &counters{}
+			b.counters[k] = c
+		}
+	}
+	c.count++
+	switch {
+	case statusCode >= 500:
+		c.serverErrors++
+	case statusCode >= 400:
+		c.clientErrors++
+	}
+	c.totalLatency += latency
+	if latency > c.maxLatency {
+		c.maxLatency = latency
+	}
+}
+
+// Retention is the period the requests are counted for
+func (r *Recorder) Retention() time.Duration {
+	return time.Duration(len(r.buckets)) * r.bucketSize
+}
+
+// Report sums up the usage selected by the filter over the last period, the whole retention when period is zero or
+// longer
+func (r *Recorder) Report(period time.Duration, filter Filter) Report {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.now()
+	if period <= 0 || period > r.Retention() {
+		period = r.Retention()
+	}
+	// the buckets overlapping the period are summed up, the oldest of them from its start
+	oldest := now.Add(-period).Truncate(r.bucketSize)
+
+	totals := make(map[key]*counters)
+	for _, b := range r.buckets {
+		if b.start.Before(oldest) || b.start.After(now) {
+			continue
+		}
+		for k, c := range b.counters {
+			if (filter.Route != "" && k.route != filter.Route) || (filter.Consumer != "" && k.consumer != filter.Consumer) {
+				continue
+			}
+			total, exists := totals[k]
+			if !exists {
+				total = &counters{}
+				totals[k] = total
+			}
+			total.count += c.count
+			total.clientErrors += c.clientErrors
+			total.serverErrors += c.serverErrors
+			total.totalLatency += c.totalLatency
+			if c.maxLatency > total.maxLatency {
+				total.maxLatency = c.maxLatency
+			}
+		}
+	}
+
+	routes := make([]RouteUsage, 0, len(totals))
+	for k, c := range totals {
+		routes = append(routes, RouteUsage{
+			Route:            k.route,
+			Method:           k.method,
+			Consumer:         k.consumer,
+			Count:            c.count,
+			ClientErrors:     c.clientErrors,
+			ServerErrors:     c.serverErrors,
+			AverageLatencyMs: milliseconds(c.totalLatency / time.Duration(c.count)),
+			MaxLatencyMs:     milliseconds(c.maxLatency),
+		})
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Count != routes[j].Count {
+			return routes[i].Count > routes[j].Count
+		}
+		if routes[i].Route != routes[j].Route {
+			return routes[i].Route < routes[j].Route
+		}
+		if routes[i].Method != routes[j].Method {
+			return routes[i].Method < routes[j].Method
+		}
+		return routes[i].Consumer < routes[j].Consumer
+	})
+
+	return Report{
+		Start:  oldest.UnixNano() / int64(time.Millisecond),
+		End:    now.UnixNano() / int64(time.Millisecond),
+		Routes: routes,
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
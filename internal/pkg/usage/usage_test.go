@@ -0,0 +1,141 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package usage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRecorder(now *time.Time) *Recorder {
+	recorder := NewRecorder(time.Minute, 10*time.Minute)
+	recorder.now = func() time.Time { return *now }
+	return recorder
+}
+
+func TestRecorderReport(t *testing.T) {
+	now := time.Date(2020, 10, 15, 10, 0, 30, 0, time.UTC)
+	recorder := newTestRecorder(&now)
+
+	recorder.Record("/api/v1/event", http.MethodPost, "device-service", http.StatusOK, 10*time.Millisecond)
+	recorder.Record("/api/v1/event", http.MethodPost, "device-service", http.StatusBadRequest, 30*time.Millisecond)
+	recorder.Record("/api/v1/event", http.MethodGet, "grafana", http.StatusInternalServerError, 5*time.Millisecond)
+	now = now.Add(5 * time.Minute)
+	recorder.Record("/api/v1/event", http.MethodPost, "device-service", http.StatusOK, 20*time.Millisecond)
+	recorder.Record("/api/v1/ping", http.MethodGet, "grafana", http.StatusOK, time.Millisecond)
+
+	report := recorder.Report(0, Filter{})
+	assert.Equal(t, time.Date(2020, 10, 15, 9, 55, 0, 0, time.UTC).UnixNano()/int64(time.Millisecond), report.Start)
+	assert.Equal(t, now.UnixNano()/int64(time.Millisecond), report.End)
+	assert.Equal(t, []RouteUsage{
+		{Route: "/api/v1/event", Method: http.MethodPost, Consumer: "device-service", Count: 3, ClientErrors: 1, AverageLatencyMs: 20, MaxLatencyMs: 30},
+		{Route: "/api/v1/event", Method: http.MethodGet, Consumer: "grafana", Count: 1, ServerErrors: 1, AverageLatencyMs: 5, MaxLatencyMs: 5},
+		{Route: "/api/v1/ping", Method: http.MethodGet, Consumer: "grafana", Count: 1, AverageLatencyMs: 1, MaxLatencyMs: 1},
+	}, report.Routes)
+
+	// the period covers the buckets it overlaps
+	report = recorder.Report(time.Minute, Filter{})
+	require.Len(t, report.Routes, 2)
+	assert.Equal(t, int64(1), report.Routes[0].Count)
+
+	report = recorder.Report(0, Filter{Consumer: "grafana"})
+	assert.Len(t, report.Routes, 2)
+	report = recorder.Report(0, Filter{Route: "/api/v1/ping", Consumer: "device-service"})
+	assert.Empty(t, report.Routes)
+
+	// the counts older than the retention roll off
+	now = now.Add(6 * time.Minute)
+	recorder.Record("/api/v1/ping", http.MethodGet, "grafana", http.StatusOK, time.Millisecond)
+	report = recorder.Report(0, Filter{})
+	require.Len(t, report.Routes, 2)
+	assert.Equal(t, "/api/v1/ping", report.Routes[0].Route)
+	assert.Equal(t, int64(2), report.Routes[0].Count)
+	assert.Equal(t, http.MethodPost, report.Routes[1].Method)
+	assert.Equal(t, int64(1), report.Routes[1].Count)
+}
+
+func TestRecorderMaxKeysPerBucket(t *testing.T) {
+	now := time.Now()
+	recorder := newTestRecorder(&now)
+	for i := 0; i < MaxKeysPerBucket+2; i++ {
+		recorder.Record("/api/v1/ping", http.MethodGet, fmt.Sprintf("consumer-%d", i), http.StatusOK, time.Millisecond)
+	}
+
+	report := recorder.Report(0, Filter{Consumer: OtherConsumer})
+	require.Len(t, report.Routes, 1)
+	assert.Equal(t, int64(2), report.Routes[0].Count)
+	assert.Len(t, recorder.Report(0, Filter{}).Routes, MaxKeysPerBucket+1)
+}
+
+func TestMiddleware(t *testing.T) {
+	now := time.Now()
+	recorder := newTestRecorder(&now)
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/device/name/{name}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodGet)
+	router.HandleFunc(ApiUsageRoute, func(w http.ResponseWriter, r *http.Request) {
+		recorder.ReportHandler(w, r, logger.NewMockClient())
+	}).Methods(http.MethodGet)
+	router.Use(NewMiddleware(recorder))
+	router.Use(NewMiddleware(recorder))
+
+	tests := []struct {
+		name             string
+		header           map[string]string
+		expectedConsumer string
+	}{
+		{"Gateway consumer username", map[string]string{ConsumerUsernameHeader: "grafana", ConsumerIdHeader: "1234"}, "grafana"},
+		{"Gateway consumer id", map[string]string{ConsumerIdHeader: "1234"}, "1234"},
+		{"Forwarded client", map[string]string{"X-Forwarded-For": "10.0.0.1, 172.17.0.2"}, "10.0.0.1"},
+		{"Client", nil, "192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/device/name/Thermostat", nil)
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			report := recorder.Report(0, Filter{Consumer: tt.expectedConsumer})
+			require.Len(t, report.Routes, 1, "the request must be counted once")
+			assert.Equal(t, "/api/v1/device/name/{name}", report.Routes[0].Route)
+			assert.Equal(t, int64(1), report.Routes[0].ClientErrors, "the status code first written must be counted")
+		})
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, ApiUsageRoute+"?"+RouteParam+"=/api/v1/device/name/{name}&"+PeriodParam+"=5m", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+	assert.Len(t, report.Routes, len(tests))
+
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, ApiUsageRoute+"?"+PeriodParam+"=-5m", nil))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -8,9 +8,11 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/database"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/infrastructure/redis"
@@ -45,19 +47,67 @@ func NewDatabase(httpServer httpServer, database interfaces.Database, dBClientIn
 	}
 }
 
+// migrationDryRunEnv set to "true" makes the service only report the pending database schema migrations on startup
+// instead of applying them
+const migrationDryRunEnv = "EDGEX_DB_MIGRATION_DRY_RUN"
+
 // Return the dbClient interface
 func (d Database) newDBClient(
 	lc logger.LoggingClient,
-	credentials bootstrapConfig.Credentials) (v2Interface.DBClient, error) {
+	credentials bootstrapConfig.Credentials,
+	encryptionKeys map[string][]byte,
+	encryptionKeyId string) (v2Interface.DBClient, error) {
 	databaseInfo := d.database.GetDatabaseInfo()["Primary"]
+	var indexCollection string
+	var indexes map[string]db.IndexInfo
+	if databaseIndexes, ok := d.database.(interfaces.DatabaseIndexes); ok {
+		indexCollection, indexes = databaseIndexes.GetDatabaseIndexes()
+	}
+	replicas, err := db.Replicas(d.database.GetDatabaseInfo())
+	if err != nil {
+		return nil, err
+	}
+	var replicationInfo db.ReplicationInfo
+	if databaseReplication, ok := d.database.(interfaces.DatabaseReplication); ok {
+		replicationInfo = databaseReplication.GetDatabaseReplicationInfo()
+	}
+	maxStaleness, replicaCheckInterval, err := db.ParseReplicationInfo(replicationInfo)
+	if err != nil {
+		return nil, err
+	}
 	switch databaseInfo.Type {
 	case "redisdb":
-		return redis.NewClient(
+		client, err := redis.NewClient(
 			db.Configuration{
-				Host: databaseInfo.Host,
-				Port: databaseInfo.Port,
+				Host:                 databaseInfo.Host,
+				Port:                 databaseInfo.Port,
+				Password:             credentials.Password,
+				EncryptionKeys:       encryptionKeys,
+				EncryptionKeyId:      encryptionKeyId,
+				IndexCollection:      indexCollection,
+				Indexes:              indexes,
+				Replicas:             replicas,
+				MaxStaleness:         maxStaleness,
+				ReplicaCheckInterval: replicaCheckInterval,
 			},
 			lc)
+		if err != nil {
+			return nil, err
+		}
+		for _, replica := range replicas {
+			lc.Info(fmt.Sprintf("read-only queries routed to database replica %s at %s:%d", replica.Name, replica.Host, replica.Port))
+		}
+		// the keyspace is migrated before the service uses it, a migration locked by another service sharing the
+		// database is waited for by retrying
+		if err = client.Migrate(os.Getenv(migrationDryRunEnv) == "true"); err != nil {
+			client.CloseSession()
+			return nil, err
+		}
+		if err = client.BuildIndexes(); err != nil {
+			client.CloseSession()
+			return nil, err
+		}
+		return client, nil
 	default:
 		return nil, db.ErrUnsupportedDatabase
 	}
@@ -92,12 +142,17 @@ func (d Database) BootstrapHandler(
 		startupTimer.SleepForInterval()
 	}
 
+	encryptionKeys, encryptionKeyId, ok := database.EncryptionKeys(d.database, secretProvider, startupTimer, lc)
+	if !ok {
+		return false
+	}
+
 	// initialize database.
 	var dbClient v2Interface.DBClient
 
 	for startupTimer.HasNotElapsed() {
 		var err error
-		dbClient, err = d.newDBClient(lc, credentials)
+		dbClient, err = d.newDBClient(lc, credentials, encryptionKeys, encryptionKeyId)
 		if err == nil {
 			break
 		}
@@ -11,6 +11,8 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go"
+	bootstrapContainer "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/dependency"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -22,6 +24,16 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 )
 
+// ApiReadyRoute is the route reporting whether the hard dependencies of the service are satisfied
+const ApiReadyRoute = contractsV2.ApiBase + "/ready"
+
+// ReadyResponse reports the readiness of the service and the status of its dependencies
+type ReadyResponse struct {
+	common.BaseResponse `json:",inline"`
+	Ready               bool                `json:"ready"`
+	Dependencies        []dependency.Status `json:"dependencies,omitempty"`
+}
+
 // V2CommonController controller for V2 REST APIs
 type V2CommonController struct {
 	dic *di.Container
@@ -73,6 +85,26 @@ func (c *V2CommonController) Metrics(writer http.ResponseWriter, request *http.R
 	c.sendResponse(writer, request, contractsV2.ApiMetricsRoute, response, http.StatusOK)
 }
 
+// Ready handles the request to the /ready endpoint. Is used by orchestrators to route traffic to the service only
+// while its hard dependencies are satisfied. It returns 503 when one isn't.
+func (c *V2CommonController) Ready(writer http.ResponseWriter, request *http.Request) {
+	ready, statuses := true, []dependency.Status(nil)
+	if tracker := bootstrapContainer.DependencyTrackerFrom(c.dic.Get); tracker != nil {
+		ready, statuses = tracker.Ready()
+	}
+
+	statusCode := http.StatusOK
+	if !ready {
+		statusCode = http.StatusServiceUnavailable
+	}
+	response := ReadyResponse{
+		BaseResponse: common.NewBaseResponse("", "", statusCode),
+		Ready:        ready,
+		Dependencies: statuses,
+	}
+	c.sendResponse(writer, request, ApiReadyRoute, response, statusCode)
+}
+
 // sendResponse puts together the response packet for the V2 API
 func (c *V2CommonController) sendResponse(
 	writer http.ResponseWriter,
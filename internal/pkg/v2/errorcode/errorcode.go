@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorcode
+
+import (
+	goErrors "errors"
+	"fmt"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+)
+
+// Service codes prefixing the error codes of each service
+const (
+	CoreData     = "CD"
+	CoreMetadata = "MD"
+)
+
+// kindCodes are the numbers of the error codes of the EdgeX error kinds, shared by every service. The numbers are part
+// of the API and must never be reassigned.
+var kindCodes = []struct {
+	number      int
+	kind        errors.ErrKind
+	description string
+}{
+	{1000, errors.KindUnknown, "unknown error"},
+	{1001, errors.KindDatabaseError, "database error"},
+	{1002, errors.KindEntityDoesNotExist, "entity not found"},
+	{1003, errors.KindContractInvalid, "invalid request"},
+	{1004, errors.KindServerError, "unexpected server error"},
+	{1005, errors.KindCommunicationError, "communication error with another service"},
+	{1006, errors.KindLimitExceeded, "limit exceeded"},
+	{1007, errors.KindDuplicateName, "duplicate name"},
+	{1008, errors.KindInvalidId, "invalid id"},
+	{1009, errors.KindServiceUnavailable, "service unavailable"},
+	{1010, errors.KindNotAllowed, "operation not allowed"},
+	{1011, errors.KindServiceLocked, "service locked"},
+	{1012, errors.KindNotImplemented, "not implemented"},
+	{1013, errors.KindRangeNotSatisfiable, "requested range not satisfiable"},
+	{1014, errors.KindClientError, "client error"},
+	{1015, errors.KindIOError, "I/O error"},
+}
+
+// CodeInfo describes an error code
+type CodeInfo struct {
+	Code        string `json:"code"`
+	Kind        string `json:"kind,omitempty"`
+	StatusCode  int    `json:"statusCode"`
+	Description string `json:"description"`
+}
+
+// ErrorResponse is the BaseResponse of an error along with its machine-readable error code
+type ErrorResponse struct {
+	common.BaseResponse `json:",inline"`
+	ErrorCode           string `json:"errorCode"`
+}
+
+// sentinel is a service specific error code of the errors wrapping err
+type sentinel struct {
+	number      int
+	err         error
+	statusCode  int
+	description string
+}
+
+// Registry maps the errors of a service to their stable error codes, e.g. EDGEX-MD-1002. Errors get the code of their
+// kind unless they wrap a sentinel error registered with a service specific code.
+type Registry struct {
+	service   string
+	sentinels []sentinel
+}
+
+// NewRegistry creates the error code registry of the service identified by its service code
+func NewRegistry(service string) *Registry {
+	return &Registry{service: service}
+}
+
+// Register gives the errors wrapping err a service specific error code and status code. Numbers below 2000 are
+// reserved for the error kinds.
+func (r *Registry) Register(number int, err error, statusCode int, description string) *Registry {
+	r.sentinels = append(r.sentinels, sentinel{number: number, err: err, statusCode: statusCode, description: description})
+	return r
+}
+
+func (r *Registry) code(number int) string {
+	return fmt.Sprintf("EDGEX-%s-%04d", r.service, number)
+}
+
+// Code returns the error code and the status code of the error
+func (r *Registry) Code(err errors.EdgeX) (string, int) {
+	for _, s := range r.sentinels {
+		if goErrors.Is(err, s.err) {
+			return r.code(s.number), s.statusCode
+		}
+	}
+	kind := errors.Kind(err)
+	for _, k := range kindCodes {
+		if k.kind == kind {
+			return r.code(k.number), err.Code()
+		}
+	}
+	return r.code(kindCodes[0].number), err.Code()
+}
+
+// NewErrorResponse creates the response of the error, carrying its message and error code
+func (r *Registry) NewErrorResponse(requestId string, err errors.EdgeX) ErrorResponse {
+	code, statusCode := r.Code(err)
+	return ErrorResponse{
+		BaseResponse: common.NewBaseResponse(requestId, err.Message(), statusCode),
+		ErrorCode:    code,
+	}
+}
+
+// Codes lists the error codes of the service ordered by code
+func (r *Registry) Codes() []CodeInfo {
+	codes := make([]CodeInfo, 0, len(kindCodes)+len(r.sentinels))
+	for _, k := range kindCodes {
+		statusCode := errors.NewCommonEdgeX(k.kind, "", nil).Code()
+		codes = append(codes, CodeInfo{Code: r.code(k.number), Kind: string(k.kind), StatusCode: statusCode, Description: k.description})
+	}
+	for _, s := range r.sentinels {
+		codes = append(codes, CodeInfo{Code: r.code(s.number), StatusCode: s.statusCode, Description: s.description})
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i].Code < codes[j].Code
+	})
+	return codes
+}
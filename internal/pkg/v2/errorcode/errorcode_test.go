@@ -0,0 +1,85 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorcode
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestSentinel = goErrors.New("sentinel")
+
+func TestRegistryCode(t *testing.T) {
+	registry := NewRegistry(CoreMetadata).Register(2001, errTestSentinel, http.StatusPreconditionFailed, "sentinel")
+
+	tests := []struct {
+		name               string
+		err                errors.EdgeX
+		expectedCode       string
+		expectedStatusCode int
+	}{
+		{"Not found", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil), "EDGEX-MD-1002", http.StatusNotFound},
+		{"Wrapped kind", errors.NewCommonEdgeXWrapper(errors.NewCommonEdgeX(errors.KindDuplicateName, "duplicate", nil)), "EDGEX-MD-1007", http.StatusConflict},
+		{"Sentinel", errors.NewCommonEdgeX(errors.KindContractInvalid, "modified", errTestSentinel), "EDGEX-MD-2001", http.StatusPreconditionFailed},
+		{"Unknown", errors.NewCommonEdgeX(errors.KindUnknown, "unknown", nil), "EDGEX-MD-1000", http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			response := registry.NewErrorResponse("", testCase.err)
+			assert.Equal(t, testCase.expectedCode, response.ErrorCode)
+			assert.Equal(t, testCase.expectedStatusCode, response.StatusCode)
+			assert.Equal(t, testCase.err.Message(), response.Message)
+		})
+	}
+}
+
+func TestRegistryCodes(t *testing.T) {
+	registry := NewRegistry(CoreData).Register(2001, errTestSentinel, http.StatusPreconditionFailed, "sentinel")
+	codes := registry.Codes()
+
+	require.Len(t, codes, len(kindCodes)+1)
+	seen := make(map[string]bool)
+	for i, c := range codes {
+		assert.False(t, seen[c.Code], "duplicate code %s", c.Code)
+		seen[c.Code] = true
+		if i > 0 {
+			assert.Less(t, codes[i-1].Code, c.Code)
+		}
+	}
+	assert.Equal(t, CodeInfo{Code: "EDGEX-CD-1002", Kind: string(errors.KindEntityDoesNotExist), StatusCode: http.StatusNotFound, Description: "entity not found"}, codes[2])
+	assert.Equal(t, CodeInfo{Code: "EDGEX-CD-2001", StatusCode: http.StatusPreconditionFailed, Description: "sentinel"}, codes[len(codes)-1])
+}
+
+func TestErrorCodesRoute(t *testing.T) {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	router := mux.NewRouter()
+	LoadRestRoutes(router, dic, NewRegistry(CoreData))
+
+	req := httptest.NewRequest(http.MethodGet, ApiErrorCodesRoute, nil)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var response ErrorCodesResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Len(t, response.Codes, len(kindCodes))
+}
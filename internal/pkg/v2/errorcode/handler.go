@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errorcode
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+// ApiErrorCodesRoute lists the error codes of the service
+const ApiErrorCodesRoute = v2.ApiBase + "/errorcodes"
+
+// ErrorCodesResponse lists the error codes of a service
+type ErrorCodesResponse struct {
+	common.BaseResponse `json:",inline"`
+	Codes               []CodeInfo `json:"codes"`
+}
+
+// LoadRestRoutes registers the route listing the error codes of the registry
+func LoadRestRoutes(r *mux.Router, dic *di.Container, registry *Registry) {
+	r.HandleFunc(ApiErrorCodesRoute, func(w http.ResponseWriter, req *http.Request) {
+		lc := container.LoggingClientFrom(dic.Get)
+		response := ErrorCodesResponse{
+			BaseResponse: common.NewBaseResponse("", "", http.StatusOK),
+			Codes:        registry.Codes(),
+		}
+		utils.WriteHttpHeader(w, req.Context(), http.StatusOK)
+		pkg.Encode(response, w, lc)
+	}).Methods(http.MethodGet)
+}
@@ -0,0 +1,293 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filter implements the filter expressions of the list endpoints, i.e.
+// ?filter=operatingState=='UP' and labels contains 'hvac'. An expression compares the fields of the objects, named by
+// their dot separated path in the JSON representation regardless of their case, to literal values with ==, != and
+// contains, and combines the comparisons with and, or, not and parentheses.
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Operator compares the values of a field to a literal value
+type Operator string
+
+const (
+	// Equal matches when a value of the field equals the literal value
+	Equal Operator = "=="
+	// NotEqual matches when no value of the field equals the literal value
+	NotEqual Operator = "!="
+	// Contains matches when the list field holds the literal value, or the string field holds it as a substring
+	Contains Operator = "contains"
+)
+
+// Expression is a parsed filter expression
+type Expression interface {
+	// Matches returns whether the JSON value, as decoded into an interface{}, satisfies the expression
+	Matches(v interface{}) bool
+	String() string
+}
+
+// Comparison compares the field at the dot separated path to the literal value. Numbers and booleans are compared
+// through their JSON text, so that 'UP' and UP, or '1.5' and 1.5, are the same value.
+type Comparison struct {
+	Field    string
+	Operator Operator
+	Value    string
+}
+
+// And matches when all of its terms match
+type And struct {
+	Terms []Expression
+}
+
+// Or matches when any of its terms matches
+type Or struct {
+	Terms []Expression
+}
+
+// Not matches when its term doesn't
+type Not struct {
+	Term Expression
+}
+
+func (c Comparison) Matches(v interface{}) bool {
+	leaves := leavesOf(v, strings.Split(c.Field, "."), false)
+	switch c.Operator {
+	case Equal, NotEqual:
+		found := false
+		for _, l := range leaves {
+			if l.value == c.Value {
+				found = true
+				break
+			}
+		}
+		return found == (c.Operator == Equal)
+	case Contains:
+		for _, l := range leaves {
+			if l.value == c.Value || (!l.element && strings.Contains(l.value, c.Value)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c Comparison) String() string {
+	return fmt.Sprintf("%s %s %s", c.Field, c.Operator, strconv.Quote(c.Value))
+}
+
+func (a And) Matches(v interface{}) bool {
+	for _, term := range a.Terms {
+		if !term.Matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a And) String() string {
+	return joinTerms(a.Terms, " and ")
+}
+
+func (o Or) Matches(v interface{}) bool {
+	for _, term := range o.Terms {
+		if term.Matches(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o Or) String() string {
+	return joinTerms(o.Terms, " or ")
+}
+
+func (n Not) Matches(v interface{}) bool {
+	return !n.Term.Matches(v)
+}
+
+func (n Not) String() string {
+	return "not (" + n.Term.String() + ")"
+}
+
+func joinTerms(terms []Expression, separator string) string {
+	strs := make([]string, len(terms))
+	for i, term := range terms {
+		strs[i] = "(" + term.String() + ")"
+	}
+	return strings.Join(strs, separator)
+}
+
+// AllOf returns the expression matching when all the expressions match, nil ones being ignored. Nil is returned
+// when there is no expression left.
+func AllOf(expressions ...Expression) Expression {
+	var terms []Expression
+	for _, e := range expressions {
+		if e != nil {
+			terms = append(terms, Conjuncts(e)...)
+		}
+	}
+	switch len(terms) {
+	case 0:
+		return nil
+	case 1:
+		return terms[0]
+	}
+	return And{Terms: terms}
+}
+
+// Labels returns the expression matching the objects holding all the labels, nil when there is none, so that the
+// labels query string of the list endpoints combines with the filter expression
+func Labels(labels []string) Expression {
+	terms := make([]Expression, len(labels))
+	for i, label := range labels {
+		terms[i] = Comparison{Field: "labels", Operator: Contains, Value: label}
+	}
+	return AllOf(terms...)
+}
+
+// Conjuncts returns the terms all of which the objects matching the expression match, so that the database may narrow
+// the objects to match down with the indexes answering some of them
+func Conjuncts(e Expression) []Expression {
+	if a, ok := e.(And); ok {
+		var terms []Expression
+		for _, term := range a.Terms {
+			terms = append(terms, Conjuncts(term)...)
+		}
+		return terms
+	}
+	return []Expression{e}
+}
+
+// Match returns whether the object, marshalled to JSON, satisfies the expression
+func Match(e Expression, object interface{}) (bool, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return false, err
+	}
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return false, err
+	}
+	return e.Matches(v), nil
+}
+
+// leaf is a value of a field, element telling whether it is an element of a list
+type leaf struct {
+	value   string
+	element bool
+}
+
+// leavesOf walks the path down the JSON value, collecting the values of the lists on the way. Field names are matched
+// regardless of their case, since the models are stored with their Go field names.
+func leavesOf(v interface{}, path []string, element bool) []leaf {
+	if array, ok := v.([]interface{}); ok {
+		var leaves []leaf
+		for _, e := range array {
+			leaves = append(leaves, leavesOf(e, path, true)...)
+		}
+		return leaves
+	}
+	if len(path) == 0 {
+		switch s := v.(type) {
+		case string:
+			return []leaf{{value: s, element: element}}
+		case float64:
+			return []leaf{{value: strconv.FormatFloat(s, 'f', -1, 64), element: element}}
+		case bool:
+			return []leaf{{value: strconv.FormatBool(s), element: element}}
+		}
+		return nil
+	}
+
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if field, ok := object[path[0]]; ok {
+		return leavesOf(field, path[1:], element)
+	}
+	for key, field := range object {
+		if strings.EqualFold(key, path[0]) {
+			return leavesOf(field, path[1:], element)
+		}
+	}
+	return nil
+}
+
+// ValidateFields returns an error when a field compared by the expression isn't a field holding values of the model,
+// so that a misspelled field is reported rather than matching nothing
+func ValidateFields(e Expression, model interface{}) error {
+	switch t := e.(type) {
+	case Comparison:
+		if !holdsValues(reflect.TypeOf(model), strings.Split(t.Field, ".")) {
+			return fmt.Errorf("unknown field '%s'", t.Field)
+		}
+	case And:
+		for _, term := range t.Terms {
+			if err := ValidateFields(term, model); err != nil {
+				return err
+			}
+		}
+	case Or:
+		for _, term := range t.Terms {
+			if err := ValidateFields(term, model); err != nil {
+				return err
+			}
+		}
+	case Not:
+		return ValidateFields(t.Term, model)
+	}
+	return nil
+}
+
+// holdsValues returns whether the path leads down the type to a field holding values, or lists of values
+func holdsValues(t reflect.Type, path []string) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	if len(path) == 0 {
+		return t.Kind() != reflect.Struct && t.Kind() != reflect.Map
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return holdsValues(t.Elem(), path[1:])
+	case reflect.Struct:
+		field, ok := fieldOf(t, path[0])
+		return ok && holdsValues(field.Type, path[1:])
+	}
+	return false
+}
+
+// fieldOf returns the field of the struct named like in its JSON representation, looking into the embedded structs
+func fieldOf(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if jsonName == "-" || field.PkgPath != "" {
+			continue
+		}
+		if field.Anonymous && jsonName == "" && field.Type.Kind() == reflect.Struct {
+			if embedded, ok := fieldOf(field.Type, name); ok {
+				return embedded, true
+			}
+			continue
+		}
+		if strings.EqualFold(field.Name, name) || (jsonName != "" && strings.EqualFold(jsonName, name)) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
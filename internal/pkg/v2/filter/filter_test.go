@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		expression    string
+		expected      Expression
+		errorExpected bool
+	}{
+		{"Valid - comparison", "operatingState=='UP'", Comparison{"operatingState", Equal, "UP"}, false},
+		{"Valid - and", `operatingState == "UP" AND labels contains 'hvac'`,
+			And{[]Expression{Comparison{"operatingState", Equal, "UP"}, Comparison{"labels", Contains, "hvac"}}}, false},
+		{"Valid - or binds looser than and", "name != 'a' or name == 'b' and notify == true",
+			Or{[]Expression{Comparison{"name", NotEqual, "a"}, And{[]Expression{Comparison{"name", Equal, "b"}, Comparison{"notify", Equal, "true"}}}}}, false},
+		{"Valid - parentheses and not", "not (lastConnected == 1.50 or protocols.modbus-tcp.Address == 'it\\'s')",
+			Not{Or{[]Expression{Comparison{"lastConnected", Equal, "1.5"}, Comparison{"protocols.modbus-tcp.Address", Equal, "it's"}}}}, false},
+		{"Invalid - empty", "", nil, true},
+		{"Invalid - single equal", "name = 'a'", nil, true},
+		{"Invalid - unquoted value", "name == boiler", nil, true},
+		{"Invalid - unterminated string", "name == 'a", nil, true},
+		{"Invalid - missing parenthesis", "(name == 'a'", nil, true},
+		{"Invalid - trailing term", "name == 'a' name == 'b'", nil, true},
+		{"Invalid - keyword as field", "and == 'a'", nil, true},
+		{"Invalid - too deep", strings.Repeat("(", maxDepth+1) + "name == 'a'" + strings.Repeat(")", maxDepth+1), nil, true},
+		{"Invalid - too long", "name == '" + strings.Repeat("a", MaxLength) + "'", nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e, err := Parse(testCase.expression)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, e)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	device := models.Device{
+		Name:           "hvac-boiler-1",
+		OperatingState: models.Up,
+		Labels:         []string{"hvac", "floor-1"},
+		Protocols:      map[string]models.ProtocolProperties{"modbus-tcp": {"Address": "10.0.0.1"}},
+		AutoEvents:     []models.AutoEvent{{Resource: "Temperature"}, {Resource: "Pressure"}},
+		LastConnected:  1500,
+	}
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{"operatingState=='UP' and labels contains 'hvac'", true},
+		{"operatingState=='DOWN' or labels contains 'hvac'", true},
+		{"OperatingState != 'UP'", false},
+		{"labels contains 'hv'", false},
+		{"labels == 'floor-1'", true},
+		{"labels != 'floor-2'", true},
+		{"name contains 'boiler'", true},
+		{"protocols.modbus-tcp.address == '10.0.0.1'", true},
+		{"autoEvents.resource == 'Pressure'", true},
+		{"lastConnected == 1500", true},
+		{"not (lastConnected == 1500)", false},
+		{"description == 'boiler'", false},
+		{"description != 'boiler'", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.expression, func(t *testing.T) {
+			e, err := Parse(testCase.expression)
+			require.NoError(t, err)
+			matched, err := Match(e, device)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, matched)
+		})
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	for expression, valid := range map[string]bool{
+		"operatingState == 'UP' and labels contains 'hvac'": true,
+		"modified == 0":                                 true,
+		"protocols.modbus-tcp.Address == 'a'":           true,
+		"autoEvents.resource == 'a'":                    true,
+		"location.city == 'a'":                          true,
+		"operatingStates == 'UP'":                       false,
+		"not (labels contains 'a' or protocols == 'a')": false,
+		"autoEvents == 'a'":                             false,
+	} {
+		e, err := Parse(expression)
+		require.NoError(t, err)
+		err = ValidateFields(e, models.Device{})
+		assert.Equal(t, valid, err == nil, expression)
+	}
+}
+
+func TestAllOf(t *testing.T) {
+	e, err := Parse("name == 'a' and name == 'b'")
+	require.NoError(t, err)
+
+	assert.Nil(t, AllOf(Labels(nil), nil))
+	assert.Equal(t, e, AllOf(nil, e))
+	assert.Equal(t, And{[]Expression{Comparison{"labels", Contains, "x"}, Comparison{"name", Equal, "a"}, Comparison{"name", Equal, "b"}}},
+		AllOf(Labels([]string{"x"}), e))
+}
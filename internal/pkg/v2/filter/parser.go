@@ -0,0 +1,258 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxLength is the length of the longest expression parsed
+	MaxLength = 2048
+	// maxDepth is how deeply the terms of an expression may be nested
+	maxDepth = 32
+)
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenOpen
+	tokenClose
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+// Parse parses the filter expression, i.e. operatingState=='UP' and (labels contains 'hvac' or name != 'boiler').
+// The keywords and, or, not and contains are matched regardless of their case, string values are quoted with single
+// or double quotes and numbers and booleans may be left unquoted.
+func Parse(s string) (Expression, error) {
+	if len(s) > MaxLength {
+		return nil, fmt.Errorf("filter expression longer than %d characters", MaxLength)
+	}
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	e, err := p.or(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEnd {
+		return nil, fmt.Errorf("unexpected '%s' at position %d", t.text, t.position)
+	}
+	return e, nil
+}
+
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpen, text: "(", position: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenClose, text: ")", position: i})
+			i++
+		case c == '=' || c == '!':
+			if i+1 >= len(s) || s[i+1] != '=' {
+				return nil, fmt.Errorf("invalid operator at position %d, '==' or '!=' expected", i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: s[i : i+2], position: i})
+			i += 2
+		case c == '\'' || c == '"':
+			value, n, err := unquote(s[i:], byte(c))
+			if err != nil {
+				return nil, fmt.Errorf("%s at position %d", err.Error(), i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value, position: i})
+			i += n
+		case c == '-' || unicode.IsDigit(c):
+			j := i + 1
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == '.' || s[j] == 'e' || s[j] == 'E' || s[j] == '+' || s[j] == '-') {
+				j++
+			}
+			number, err := strconv.ParseFloat(s[i:j], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number '%s' at position %d", s[i:j], i)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: strconv.FormatFloat(number, 'f', -1, 64), position: i})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i + 1
+			for j < len(s) && isIdentifierByte(s[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: s[i:j], position: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character '%c' at position %d", c, i)
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, text: "end of expression", position: len(s)}), nil
+}
+
+func isIdentifierByte(b byte) bool {
+	return b == '_' || b == '.' || b == '-' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// unquote returns the value of the string starting with the quote, and the length of the string. A backslash escapes
+// the character following it.
+func unquote(s string, quote byte) (string, int, error) {
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 == len(s) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			i++
+			value.WriteByte(s[i])
+		case quote:
+			return value.String(), i + 1, nil
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) take() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEnd {
+		p.next++
+	}
+	return t
+}
+
+// keyword returns whether the next token is the keyword, taking it when it is
+func (p *parser) keyword(k string) bool {
+	if t := p.peek(); t.kind == tokenIdentifier && strings.EqualFold(t.text, k) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *parser) or(depth int) (Expression, error) {
+	e, err := p.and(depth)
+	if err != nil {
+		return nil, err
+	}
+	terms := []Expression{e}
+	for p.keyword("or") {
+		if e, err = p.and(depth); err != nil {
+			return nil, err
+		}
+		terms = append(terms, e)
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return Or{Terms: terms}, nil
+}
+
+func (p *parser) and(depth int) (Expression, error) {
+	e, err := p.unary(depth)
+	if err != nil {
+		return nil, err
+	}
+	terms := []Expression{e}
+	for p.keyword("and") {
+		if e, err = p.unary(depth); err != nil {
+			return nil, err
+		}
+		terms = append(terms, e)
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return And{Terms: terms}, nil
+}
+
+func (p *parser) unary(depth int) (Expression, error) {
+	if depth >= maxDepth {
+		return nil, fmt.Errorf("filter expression nested deeper than %d", maxDepth)
+	}
+	if p.keyword("not") {
+		e, err := p.unary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return Not{Term: e}, nil
+	}
+	if p.peek().kind == tokenOpen {
+		p.take()
+		e, err := p.or(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if t := p.take(); t.kind != tokenClose {
+			return nil, fmt.Errorf("')' expected at position %d, found '%s'", t.position, t.text)
+		}
+		return e, nil
+	}
+	return p.comparison()
+}
+
+func (p *parser) comparison() (Expression, error) {
+	field := p.take()
+	if field.kind != tokenIdentifier || isKeyword(field.text) {
+		return nil, fmt.Errorf("field name expected at position %d, found '%s'", field.position, field.text)
+	}
+
+	var operator Operator
+	switch t := p.take(); {
+	case t.kind == tokenOperator:
+		operator = Operator(t.text)
+	case t.kind == tokenIdentifier && strings.EqualFold(t.text, string(Contains)):
+		operator = Contains
+	default:
+		return nil, fmt.Errorf("'==', '!=' or 'contains' expected at position %d, found '%s'", t.position, t.text)
+	}
+
+	value := p.take()
+	switch {
+	case value.kind == tokenString || value.kind == tokenNumber:
+	case value.kind == tokenIdentifier && (value.text == "true" || value.text == "false"):
+	default:
+		return nil, fmt.Errorf("value expected at position %d, found '%s'", value.position, value.text)
+	}
+	return Comparison{Field: field.text, Operator: operator, Value: value.text}, nil
+}
+
+func isKeyword(s string) bool {
+	for _, k := range []string{"and", "or", "not", string(Contains)} {
+		if strings.EqualFold(s, k) {
+			return true
+		}
+	}
+	return false
+}
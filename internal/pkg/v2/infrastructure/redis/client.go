@@ -8,9 +8,14 @@ package redis
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
@@ -25,6 +30,7 @@ var once sync.Once
 type Client struct {
 	*redisClient.Client
 	loggingClient logger.LoggingClient
+	replicas      *replicaSet // the read replicas, nil when there are none
 }
 
 func NewClient(config db.Configuration, logger logger.LoggingClient) (*Client, errors.EdgeX) {
@@ -35,13 +41,36 @@ func NewClient(config db.Configuration, logger logger.LoggingClient) (*Client, e
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "redis client creation failed", err)
 	}
+	secondaryIndexes, err = newSecondaryIndexes(config.IndexCollection, config.Indexes)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid database indexes", err)
+	}
+	indexedCollection = config.IndexCollection
+	dc.replicas = newReplicaSet(config, dc.Pool, logger)
 
 	return dc, nil
 }
 
+// BuildIndexes builds the declared indexes which weren't built yet from the stored objects, and drops the indexes of
+// the collection written by the service which aren't declared anymore
+func (c *Client) BuildIndexes() errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	built, edgeXerr := buildIndexes(conn, indexedCollection)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to build the database indexes", edgeXerr)
+	}
+	for _, name := range built {
+		c.loggingClient.Info(fmt.Sprintf("built database index %s", name))
+	}
+	return nil
+}
+
 // CloseSession closes the connections to Redis
 func (c *Client) CloseSession() {
 	c.Pool.Close()
+	c.replicas.close()
 
 	currClient = nil
 	once = sync.Once{}
@@ -62,6 +91,28 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return addEvent(conn, e)
 }
 
+// AddEvents adds the events in order over a single connection. The events added before a failure are returned along
+// with the error, the following events aren't added.
+func (c *Client) AddEvents(events []model.Event) ([]model.Event, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	added := make([]model.Event, 0, len(events))
+	for _, e := range events {
+		if e.Id != "" {
+			if _, err := uuid.Parse(e.Id); err != nil {
+				return added, errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+			}
+		}
+		addedEvent, edgeXerr := addEvent(conn, e)
+		if edgeXerr != nil {
+			return added, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		added = append(added, addedEvent)
+	}
+	return added, nil
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (event model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -105,11 +156,11 @@ func (c *Client) AddDeviceProfile(dp model.DeviceProfile) (model.DeviceProfile,
 	return addDeviceProfile(conn, dp)
 }
 
-// UpdateDeviceProfile updates a new device profile
-func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile) errors.EdgeX {
+// UpdateDeviceProfile updates a device profile, unless it was modified since the Modified timestamp given when not zero
+func (c *Client) UpdateDeviceProfile(dp model.DeviceProfile, modified int64) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
-	return updateDeviceProfile(conn, dp)
+	return updateDeviceProfile(conn, dp, modified)
 }
 
 // DeviceProfileNameExists checks the device profile exists by name
@@ -190,6 +241,19 @@ func (c *Client) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return deviceServiceNameExist(conn, name)
 }
 
+// DeviceProfileById gets a device profile by id
+func (c *Client) DeviceProfileById(id string) (deviceProfile model.DeviceProfile, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceProfile, edgeXerr = deviceProfileById(conn, id)
+	if edgeXerr != nil {
+		return deviceProfile, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return
+}
+
 // DeviceProfileByName gets a device profile by name
 func (c *Client) DeviceProfileByName(name string) (deviceProfile model.DeviceProfile, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -203,6 +267,32 @@ func (c *Client) DeviceProfileByName(name string) (deviceProfile model.DevicePro
 	return
 }
 
+// SetDeviceProfileYaml keeps the YAML file a device profile was uploaded from
+func (c *Client) SetDeviceProfileYaml(name string, data []byte) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := setDeviceProfileYaml(conn, name, data)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return nil
+}
+
+// DeviceProfileYamlByName gets the YAML file a device profile was uploaded from by name
+func (c *Client) DeviceProfileYamlByName(name string) ([]byte, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	data, edgeXerr := deviceProfileYamlByName(conn, name)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return data, nil
+}
+
 // DeleteDeviceProfileById deletes a device profile by id
 func (c *Client) DeleteDeviceProfileById(id string) errors.EdgeX {
 	conn := c.Pool.Get()
@@ -241,6 +331,19 @@ func (c *Client) AllDeviceProfiles(offset int, limit int, labels []string) ([]mo
 	return deviceProfiles, nil
 }
 
+// DeviceProfilesByFilter query device profiles matching the filter expression with offset and limit
+func (c *Client) DeviceProfilesByFilter(expression filter.Expression, offset int, limit int) (deviceProfiles []model.DeviceProfile, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceProfiles, edgeXerr = deviceProfilesByFilter(conn, expression, offset, limit)
+	if edgeXerr != nil {
+		return deviceProfiles, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device profiles by offset %d, limit %d and filter %s", offset, limit, expression), edgeXerr)
+	}
+	return deviceProfiles, nil
+}
+
 // DeviceProfilesByModel query device profiles with offset, limit and model
 func (c *Client) DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -318,6 +421,19 @@ func (c *Client) AllDeviceServices(offset int, limit int, labels []string) (devi
 	return deviceServices, nil
 }
 
+// DeviceServicesByFilter query device services matching the filter expression with offset and limit
+func (c *Client) DeviceServicesByFilter(expression filter.Expression, offset int, limit int) (deviceServices []model.DeviceService, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceServices, edgeXerr = deviceServicesByFilter(conn, expression, offset, limit)
+	if edgeXerr != nil {
+		return deviceServices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device services by offset %d, limit %d and filter %s", offset, limit, expression), edgeXerr)
+	}
+	return deviceServices, nil
+}
+
 // Add a new device
 func (c *Client) AddDevice(d model.Device) (model.Device, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -330,6 +446,13 @@ func (c *Client) AddDevice(d model.Device) (model.Device, errors.EdgeX) {
 	return addDevice(conn, d)
 }
 
+// UpdateDevice updates a device, unless it was modified since the Modified timestamp given when not zero
+func (c *Client) UpdateDevice(d model.Device, modified int64) (model.Device, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return updateDevice(conn, d, modified)
+}
+
 // DeleteDeviceById deletes a device by id
 func (c *Client) DeleteDeviceById(id string) errors.EdgeX {
 	conn := c.Pool.Get()
@@ -356,9 +479,50 @@ func (c *Client) DeleteDeviceByName(name string) errors.EdgeX {
 	return nil
 }
 
+// DeleteDevicesByServiceName deletes in one transaction all the devices of a device service, which must be exactly
+// the devices of the given ids, and returns the number of deleted devices
+func (c *Client) DeleteDevicesByServiceName(name string, ids []string) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deleted, edgeXerr := deleteDevicesByIndex(conn, CreateKey(DeviceCollectionServiceName, name), ids)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the devices of device service %s", name), edgeXerr)
+	}
+
+	return deleted, nil
+}
+
+// DeleteDevicesByLabel deletes in one transaction all the devices carrying a label, which must be exactly the
+// devices of the given ids, and returns the number of deleted devices
+func (c *Client) DeleteDevicesByLabel(label string, ids []string) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deleted, edgeXerr := deleteDevicesByIndex(conn, CreateKey(DeviceCollectionLabel, label), ids)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the devices with label %s", label), edgeXerr)
+	}
+
+	return deleted, nil
+}
+
+// DevicesByIndex query devices holding the value of the named index by offset and limit
+func (c *Client) DevicesByIndex(name string, value string, offset int, limit int) (devices []model.Device, edgeXerr errors.EdgeX) {
+	conn := c.readConn()
+	defer conn.Close()
+
+	devices, edgeXerr = devicesByIndex(conn, name, value, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and index %s value %s", offset, limit, name, value), edgeXerr)
+	}
+	return devices, nil
+}
+
 // DevicesByServiceName query devices by offset, limit and name
 func (c *Client) DevicesByServiceName(offset int, limit int, name string) (devices []model.Device, edgeXerr errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	devices, edgeXerr = devicesByServiceName(conn, offset, limit, name)
@@ -419,7 +583,7 @@ func (c *Client) DeviceByName(name string) (device model.Device, edgeXerr errors
 
 // DevicesByProfileName query devices by offset, limit and profile name
 func (c *Client) DevicesByProfileName(offset int, limit int, profileName string) (devices []model.Device, edgeXerr errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	devices, edgeXerr = devicesByProfileName(conn, offset, limit, profileName)
@@ -445,7 +609,7 @@ func (c *Client) AllEvents(offset int, limit int) ([]model.Event, errors.EdgeX)
 
 // AllDevices query the devices with offset, limit, and labels
 func (c *Client) AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	devices, edgeXerr := devicesByLabels(conn, offset, limit, labels)
@@ -455,6 +619,123 @@ func (c *Client) AllDevices(offset int, limit int, labels []string) ([]model.Dev
 	return devices, nil
 }
 
+// DevicesByFilter query devices matching the filter expression with offset and limit
+func (c *Client) DevicesByFilter(expression filter.Expression, offset int, limit int) (devices []model.Device, edgeXerr errors.EdgeX) {
+	conn := c.readConn()
+	defer conn.Close()
+
+	devices, edgeXerr = devicesByFilter(conn, expression, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices by offset %d, limit %d and filter %s", offset, limit, expression), edgeXerr)
+	}
+	return devices, nil
+}
+
+// DevicesModifiedSince query the devices modified at or after since with offset and limit. The query is sent to the
+// primary: the callers advance their since cursor past the devices returned, and would skip for good the modifications
+// a lagging replica doesn't hold yet.
+func (c *Client) DevicesModifiedSince(since int, offset int, limit int) (devices []model.Device, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, edgeXerr = devicesModifiedSince(conn, since, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query devices modified since %v, offset %d, and limit %d", since, offset, limit), edgeXerr)
+	}
+	return devices, nil
+}
+
+// DeviceProfilesModifiedSince query the device profiles modified at or after since with offset and limit
+func (c *Client) DeviceProfilesModifiedSince(since int, offset int, limit int) (deviceProfiles []model.DeviceProfile, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceProfiles, edgeXerr = deviceProfilesModifiedSince(conn, since, offset, limit)
+	if edgeXerr != nil {
+		return deviceProfiles, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device profiles modified since %v, offset %d, and limit %d", since, offset, limit), edgeXerr)
+	}
+	return deviceProfiles, nil
+}
+
+// DeviceServicesModifiedSince query the device services modified at or after since with offset and limit
+func (c *Client) DeviceServicesModifiedSince(since int, offset int, limit int) (deviceServices []model.DeviceService, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceServices, edgeXerr = deviceServicesModifiedSince(conn, since, offset, limit)
+	if edgeXerr != nil {
+		return deviceServices, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device services modified since %v, offset %d, and limit %d", since, offset, limit), edgeXerr)
+	}
+	return deviceServices, nil
+}
+
+// SetProtocolSchema registers the JSON Schema of the protocol properties, replacing the schema registered before
+func (c *Client) SetProtocolSchema(name string, schema []byte) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return setProtocolSchema(conn, name, schema)
+}
+
+// ProtocolSchemaByName query the JSON Schema of the protocol properties by protocol name
+func (c *Client) ProtocolSchemaByName(name string) ([]byte, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return protocolSchemaByName(conn, name)
+}
+
+// AllProtocolSchemas query the JSON Schemas of all protocols by protocol name
+func (c *Client) AllProtocolSchemas() (map[string][]byte, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return allProtocolSchemas(conn)
+}
+
+// DeleteProtocolSchemaByName deletes the JSON Schema of the protocol properties by protocol name
+func (c *Client) DeleteProtocolSchemaByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return deleteProtocolSchemaByName(conn, name)
+}
+
+// SetResourceMapping sets the mapping of a deviceResource to a protocol, replacing the mapping set before
+func (c *Client) SetResourceMapping(m pkgModels.ResourceMapping) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return setResourceMapping(conn, m)
+}
+
+// ResourceMapping query the mapping of the deviceResource of the profile to the protocol
+func (c *Client) ResourceMapping(profileName string, resourceName string, protocol string) (pkgModels.ResourceMapping, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return resourceMapping(conn, profileName, resourceName, protocol)
+}
+
+// ResourceMappingsByProfileName query the mappings of the deviceResources of the profile to all protocols
+func (c *Client) ResourceMappingsByProfileName(profileName string) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return resourceMappingsByProfileName(conn, profileName)
+}
+
+// ResourceMappingsByProtocol query the mappings of the deviceResources of all profiles to the protocol
+func (c *Client) ResourceMappingsByProtocol(protocol string) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return resourceMappingsByProtocol(conn, protocol)
+}
+
+// DeleteResourceMapping deletes the mapping of the deviceResource of the profile to the protocol
+func (c *Client) DeleteResourceMapping(profileName string, resourceName string, protocol string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+	return deleteResourceMapping(conn, profileName, resourceName, protocol)
+}
+
 // EventsByDeviceName query events by offset, limit and device name
 func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -468,6 +749,32 @@ func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events
 	return events, nil
 }
 
+// EventsByIndex query events holding the value of the named index by offset and limit
+func (c *Client) EventsByIndex(name string, value string, offset int, limit int) (events []model.Event, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr = eventsByIndex(conn, name, value, offset, limit)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by offset %d, limit %d and index %s value %s", offset, limit, name, value), edgeXerr)
+	}
+	return events, nil
+}
+
+// EventsByAssetId query events by offset, limit and asset id
+func (c *Client) EventsByAssetId(offset int, limit int, assetId string) (events []model.Event, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr = eventsByAssetId(conn, offset, limit, assetId)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by offset %d, limit %d and asset id %s", offset, limit, assetId), edgeXerr)
+	}
+	return events, nil
+}
+
 // EventsByTimeRange query events by time range, offset, and limit
 func (c *Client) EventsByTimeRange(start int, end int, offset int, limit int) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -481,6 +788,56 @@ func (c *Client) EventsByTimeRange(start int, end int, offset int, limit int) (e
 	return events, nil
 }
 
+// EventsByIds query the events of the ids, in the order of the ids, skipping the ids of missing events
+func (c *Client) EventsByIds(ids []string) (events []model.Event, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr = eventsByIds(conn, ids)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query events by %d ids", len(ids)), edgeXerr)
+	}
+	return events, nil
+}
+
+// MarkEventsPushed marks the events of the ids as pushed by the consumer group, and returns the ids of the events which
+// don't exist
+func (c *Client) MarkEventsPushed(consumer string, ids []string) (notFound []string, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	notFound, edgeXerr = markEventsPushed(conn, consumer, ids)
+	if edgeXerr != nil {
+		return notFound, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to mark %d events as pushed by consumer group %s", len(ids), consumer), edgeXerr)
+	}
+	return notFound, nil
+}
+
+// UnpushedEvents query the events the consumer group didn't mark as pushed by offset and limit, the oldest first
+func (c *Client) UnpushedEvents(consumer string, offset int, limit int) (events []model.Event, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, edgeXerr = unpushedEvents(conn, consumer, offset, limit)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events not pushed by consumer group %s by offset %d and limit %d", consumer, offset, limit), edgeXerr)
+	}
+	return events, nil
+}
+
+// DeleteEventsPushed forgets the events the consumer group marked as pushed, so that it consumes them again
+func (c *Client) DeleteEventsPushed(consumer string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteEventsPushed(conn, consumer)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the events pushed by consumer group %s", consumer), edgeXerr)
+	}
+	return nil
+}
+
 // ReadingTotalCount returns the total count of Event from the database
 func (c *Client) ReadingTotalCount() (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -496,7 +853,7 @@ func (c *Client) ReadingTotalCount() (uint32, errors.EdgeX) {
 
 // AllReadings query events by offset, limit, and labels
 func (c *Client) AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	readings, edgeXerr := allReadings(conn, offset, limit)
@@ -509,7 +866,7 @@ func (c *Client) AllReadings(offset int, limit int) ([]model.Reading, errors.Edg
 
 // ReadingsByTimeRange query readings by time range, offset, and limit
 func (c *Client) ReadingsByTimeRange(start int, end int, offset int, limit int) (readings []model.Reading, edgeXerr errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	readings, edgeXerr = readingsByTimeRange(conn, start, end, offset, limit)
@@ -520,9 +877,36 @@ func (c *Client) ReadingsByTimeRange(start int, end int, offset int, limit int)
 	return readings, nil
 }
 
+// ScanReadingsByTimeRange passes the readings created within the time range, of the device when its name isn't empty,
+// to fn in batches of at most batchSize readings
+func (c *Client) ScanReadingsByTimeRange(start int, end int, deviceName string, batchSize int, fn func(readings []model.Reading) errors.EdgeX) errors.EdgeX {
+	conn := c.readConn()
+	defer conn.Close()
+
+	edgeXerr := scanReadingsByTimeRange(conn, start, end, deviceName, batchSize, fn)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to scan readings of device %s by time range %v ~ %v", deviceName, start, end), edgeXerr)
+	}
+	return nil
+}
+
+// ReadingsByDeviceResourceAndTimeRange query all readings of the device resource created within the time range
+func (c *Client) ReadingsByDeviceResourceAndTimeRange(deviceName string, resourceName string, start int, end int) (readings []model.Reading, edgeXerr errors.EdgeX) {
+	conn := c.readConn()
+	defer conn.Close()
+
+	readings, edgeXerr = readingsByDeviceResourceAndTimeRange(conn, deviceName, resourceName, start, end)
+	if edgeXerr != nil {
+		return readings, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings of device %s resource %s by time range %v ~ %v", deviceName, resourceName, start, end), edgeXerr)
+	}
+	return readings, nil
+}
+
 // ReadingsByResourceName query readings by offset, limit and resource name
 func (c *Client) ReadingsByResourceName(offset int, limit int, resourceName string) (readings []model.Reading, edgeXerr errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	readings, edgeXerr = readingsByResourceName(conn, offset, limit, resourceName)
@@ -535,7 +919,7 @@ func (c *Client) ReadingsByResourceName(offset int, limit int, resourceName stri
 
 // ReadingsByDeviceName query readings by offset, limit and device name
 func (c *Client) ReadingsByDeviceName(offset int, limit int, name string) (readings []model.Reading, edgeXerr errors.EdgeX) {
-	conn := c.Pool.Get()
+	conn := c.readConn()
 	defer conn.Close()
 
 	readings, edgeXerr = readingsByDeviceName(conn, offset, limit, name)
@@ -558,3 +942,381 @@ func (c *Client) ReadingCountByDeviceName(deviceName string) (uint32, errors.Edg
 
 	return count, nil
 }
+
+// ReadingStatsByDeviceName returns the reading statistics of every device from the database
+func (c *Client) ReadingStatsByDeviceName() ([]pkgModels.ReadingStats, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	stats, edgeXerr := readingStats(conn, ReadingsCollectionStatsDeviceName)
+	if edgeXerr != nil {
+		return stats, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query reading stats by device name", edgeXerr)
+	}
+	return stats, nil
+}
+
+// ReadingStatsByResourceName returns the reading statistics of every resource from the database
+func (c *Client) ReadingStatsByResourceName() ([]pkgModels.ReadingStats, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	stats, edgeXerr := readingStats(conn, ReadingsCollectionStatsResourceName)
+	if edgeXerr != nil {
+		return stats, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query reading stats by resource name", edgeXerr)
+	}
+	return stats, nil
+}
+
+// UpdateDeviceState records the readings as the latest readings of their device's resources
+func (c *Client) UpdateDeviceState(readings []model.Reading) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateDeviceState(conn, readings)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to update device state", edgeXerr)
+	}
+	return nil
+}
+
+// DeviceState query the latest reading of each resource of the device
+func (c *Client) DeviceState(deviceName string) ([]model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, edgeXerr := deviceState(conn, deviceName)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query state of device %s", deviceName), edgeXerr)
+	}
+	return readings, nil
+}
+
+// MemoryUsage reports the database memory and the approximate memory used by each collection, measured on samples
+// entries per collection
+func (c *Client) MemoryUsage(collections []string, samples int) (pkgModels.MemoryUsage, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	usage, edgeXerr := memoryUsage(conn, collections, samples)
+	if edgeXerr != nil {
+		return usage, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query memory usage", edgeXerr)
+	}
+	return usage, nil
+}
+
+// LabelUsage query the number of devices, device profiles and device services carrying each label
+func (c *Client) LabelUsage() ([]pkgModels.LabelUsage, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	usage, edgeXerr := labelUsage(conn)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query label usage", edgeXerr)
+	}
+	return usage, nil
+}
+
+// RenameLabel replaces a label on every device, device profile and device service and returns the number of renamed objects
+func (c *Client) RenameLabel(from string, to string) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	renamed, edgeXerr := renameLabel(conn, from, to)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to rename label %s to %s", from, to), edgeXerr)
+	}
+	return renamed, nil
+}
+
+// TrashDevice deletes the device and keeps it in the trash, replacing the device of the same name trashed before
+func (c *Client) TrashDevice(d model.Device, deleted int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := trashDevice(conn, d, deleted)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to trash the device with name %s", d.Name), edgeXerr)
+	}
+	return nil
+}
+
+// TrashDeviceProfile deletes the device profile and keeps it in the trash, replacing the device profile of the same
+// name trashed before
+func (c *Client) TrashDeviceProfile(dp model.DeviceProfile, deleted int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := trashDeviceProfile(conn, dp, deleted)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to trash the device profile with name %s", dp.Name), edgeXerr)
+	}
+	return nil
+}
+
+// TrashedObjects query the trashed objects of the type by offset and limit, the last deleted first
+func (c *Client) TrashedObjects(objectType string, offset int, limit int) ([]pkgModels.TrashedObject, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, edgeXerr := trashedObjects(conn, objectType, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query trashed %s by offset %d and limit %d", objectType, offset, limit), edgeXerr)
+	}
+	return objects, nil
+}
+
+// TrashedObjectByName query the trashed object of the type by name
+func (c *Client) TrashedObjectByName(objectType string, name string) (pkgModels.TrashedObject, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	object, edgeXerr := trashedObjectByName(conn, objectType, name)
+	if edgeXerr != nil {
+		return object, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query trashed %s with name %s", objectType, name), edgeXerr)
+	}
+	return object, nil
+}
+
+// DeleteTrashedObjectByName removes the object of the type from the trash by name
+func (c *Client) DeleteTrashedObjectByName(objectType string, name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteTrashedObjectByName(conn, objectType, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete trashed %s with name %s", objectType, name), edgeXerr)
+	}
+	return nil
+}
+
+// PurgeTrashedObjects removes the objects of the type deleted before the timestamp from the trash and returns their
+// number
+func (c *Client) PurgeTrashedObjects(objectType string, deletedBefore int64) (int, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	purged, edgeXerr := purgeTrashedObjects(conn, objectType, deletedBefore)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to purge trashed %s", objectType), edgeXerr)
+	}
+	return purged, nil
+}
+
+// SetProfileCatalogUpdate stores the device profile update pulled from the profile catalog, replacing the update of
+// the device profile pulled before
+func (c *Client) SetProfileCatalogUpdate(u pkgModels.ProfileCatalogUpdate) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := setProfileCatalogUpdate(conn, u)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to set the profile catalog update of %s", u.ProfileName), edgeXerr)
+	}
+	return nil
+}
+
+// ProfileCatalogUpdateByName query the profile catalog update of the device profile by name
+func (c *Client) ProfileCatalogUpdateByName(name string) (pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	u, edgeXerr := profileCatalogUpdateByName(conn, name)
+	if edgeXerr != nil {
+		return u, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the profile catalog update of %s", name), edgeXerr)
+	}
+	return u, nil
+}
+
+// AllProfileCatalogUpdates query the profile catalog updates of all device profiles, sorted by profile name
+func (c *Client) AllProfileCatalogUpdates() ([]pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	updates, edgeXerr := allProfileCatalogUpdates(conn)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query the profile catalog updates", edgeXerr)
+	}
+	return updates, nil
+}
+
+// DeleteProfileCatalogUpdateByName deletes the profile catalog update of the device profile by name
+func (c *Client) DeleteProfileCatalogUpdateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteProfileCatalogUpdateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the profile catalog update of %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// SetProfileCatalogVersion records the version of the device profile applied from the profile catalog
+func (c *Client) SetProfileCatalogVersion(name string, version string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := setProfileCatalogVersion(conn, name, version)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to set the profile catalog version of %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// ProfileCatalogVersions query the versions of the device profiles applied from the profile catalog, by profile name
+func (c *Client) ProfileCatalogVersions() (map[string]string, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	versions, edgeXerr := profileCatalogVersions(conn)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query the profile catalog versions", edgeXerr)
+	}
+	return versions, nil
+}
+
+// AddDeviceMetrics adds the counters to the daily activity of the device, dropping the days older than the retention
+func (c *Client) AddDeviceMetrics(name string, counters devicemetrics.Counters, at time.Time, retention time.Duration) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := addDeviceMetrics(conn, name, counters, at, retention)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add metrics of device %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// DeviceMetricsByName query the daily activity counters of the device by offset and limit, newest day first
+func (c *Client) DeviceMetricsByName(name string, offset int, limit int) ([]devicemetrics.DailyCounters, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	metrics, edgeXerr := deviceMetricsByName(conn, name, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query metrics of device %s by offset %d and limit %d", name, offset, limit), edgeXerr)
+	}
+	return metrics, nil
+}
+
+// AddDeviceStateTransitions adds the transitions to the state history of their device, dropping the transitions older
+// than the retention
+func (c *Client) AddDeviceStateTransitions(transitions []pkgModels.DeviceStateTransition, retention time.Duration) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := addDeviceStateTransitions(conn, transitions, retention)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to add device state transitions", edgeXerr)
+	}
+	return nil
+}
+
+// DeviceStateHistory query the state transitions of the device timestamped from start to end by offset and limit, the
+// latest first
+func (c *Client) DeviceStateHistory(name string, start int64, end int64, offset int, limit int) ([]pkgModels.DeviceStateTransition, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	transitions, edgeXerr := deviceStateHistory(conn, name, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query state history of device %s from %d to %d by offset %d and limit %d", name, start, end, offset, limit), edgeXerr)
+	}
+	return transitions, nil
+}
+
+// DevicesLastEventReceived query when the last event of each of the devices was received, the devices without any
+// event being left out
+func (c *Client) DevicesLastEventReceived(names []string) (map[string]int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	lastEvents, edgeXerr := devicesLastEventReceived(conn, names)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query the last event time of the devices", edgeXerr)
+	}
+	return lastEvents, nil
+}
+
+// Migrate applies the pending schema migrations to the keyspace. With dryRun the pending migrations and the number of
+// keys they would change are only logged.
+func (c *Client) Migrate(dryRun bool) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := migrate(conn, dryRun, func(m migration, affected int) {
+		if dryRun {
+			c.loggingClient.Info(fmt.Sprintf("Dry run: database schema migration %d (%s) would change %d key(s)", m.version, m.description, affected))
+		} else {
+			c.loggingClient.Info(fmt.Sprintf("Database schema migrated to version %d (%s), %d key(s) changed", m.version, m.description, affected))
+		}
+	})
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to migrate the database schema", edgeXerr)
+	}
+	return nil
+}
+
+// UpdateDeviceTwinDesired replaces the desired state of the device, creating its twin if needed
+func (c *Client) UpdateDeviceTwinDesired(name string, desired map[string]string, updated int64) (twin.Twin, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	t, edgeXerr := updateDeviceTwin(conn, name, true, func(t *twin.Twin) { t.SetDesired(desired, updated) })
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update the desired state of device %s", name), edgeXerr)
+	}
+	return t, nil
+}
+
+// ReportDeviceTwin keeps the outcome of the reconciliation of the device in its twin
+func (c *Client) ReportDeviceTwin(name string, report twin.Report, reconciled int64) (twin.Twin, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	t, edgeXerr := updateDeviceTwin(conn, name, false, func(t *twin.Twin) { t.ApplyReport(report, reconciled) })
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to report the reconciliation of device %s", name), edgeXerr)
+	}
+	return t, nil
+}
+
+// DeviceTwinByName query the twin of the device
+func (c *Client) DeviceTwinByName(name string) (twin.Twin, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	t, edgeXerr := deviceTwinByName(conn, name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query twin of device %s", name), edgeXerr)
+	}
+	return t, nil
+}
+
+// DeleteDeviceTwinByName deletes the twin of the device, the device isn't reconciled anymore
+func (c *Client) DeleteDeviceTwinByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceTwinByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete twin of device %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AllDeviceTwins query the twins of the devices by offset and limit, the desired state last changed first
+func (c *Client) AllDeviceTwins(offset int, limit int) ([]twin.Twin, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	twins, edgeXerr := allDeviceTwins(conn, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query device twins by offset %d and limit %d", offset, limit), edgeXerr)
+	}
+	return twins, nil
+}
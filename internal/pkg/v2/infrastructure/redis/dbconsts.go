@@ -17,8 +17,16 @@ const (
 	HGET             = "HGET"
 	HEXISTS          = "HEXISTS"
 	HDEL             = "HDEL"
+	HINCRBY          = "HINCRBY"
+	HKEYS            = "HKEYS"
+	EXPIRE           = "EXPIRE"
+	HSETNX           = "HSETNX"
+	HMGET            = "HMGET"
+	HGETALL          = "HGETALL"
+	HVALS            = "HVALS"
 	SADD             = "SADD"
 	SREM             = "SREM"
+	SMEMBERS         = "SMEMBERS"
 	ZADD             = "ZADD"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
@@ -26,11 +34,23 @@ const (
 	ZREVRANGE        = "ZREVRANGE"
 	MGET             = "MGET"
 	ZCARD            = "ZCARD"
+	ZSCORE           = "ZSCORE"
 	ZCOUNT           = "ZCOUNT"
 	UNLINK           = "UNLINK"
 	ZRANGEBYSCORE    = "ZRANGEBYSCORE"
 	ZREVRANGEBYSCORE = "ZREVRANGEBYSCORE"
+	ZREMRANGEBYSCORE = "ZREMRANGEBYSCORE"
 	LIMIT            = "LIMIT"
+	MEMORY           = "MEMORY"
+	USAGE            = "USAGE"
+	INFO             = "INFO"
+	SCAN             = "SCAN"
+	MATCH            = "MATCH"
+	COUNT            = "COUNT"
+	WATCH            = "WATCH"
+	UNWATCH          = "UNWATCH"
+	WITHSCORES       = "WITHSCORES"
+	DISCARD          = "DISCARD"
 )
 
 const (
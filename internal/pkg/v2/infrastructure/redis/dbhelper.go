@@ -5,9 +5,43 @@
 
 package redis
 
-import "strings"
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+)
 
 // CreateKey creates Redis key by connecting the target key with DBKeySeparator
 func CreateKey(targets ...string) string {
 	return strings.Join(targets, DBKeySeparator)
 }
+
+// nextModified returns the Modified timestamp of an object updated now, which is always after the previous one so that
+// the updates in the same millisecond can still be told apart
+func nextModified(previous int64) int64 {
+	ts := common.MakeTimestamp()
+	if ts <= previous {
+		ts = previous + 1
+	}
+	return ts
+}
+
+// marshalPayload marshals the event or reading, encrypting it when the database is configured with encryption keys
+func marshalPayload(v interface{}) ([]byte, error) {
+	m, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return redisClient.EncryptPayload(m)
+}
+
+// unmarshalPayload unmarshals the object, decrypting it first when it was stored encrypted
+func unmarshalPayload(data []byte, v interface{}) error {
+	data, err := redisClient.DecryptPayload(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
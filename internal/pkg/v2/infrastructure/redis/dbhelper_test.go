@@ -16,3 +16,20 @@ func TestCreateKey(t *testing.T) {
 	expected := EventsCollectionDeviceName + DBKeySeparator + "TestDeviceName"
 	assert.Equal(t, expected, result)
 }
+
+func TestParseInfo(t *testing.T) {
+	info := "# Memory\r\nused_memory:1048576\r\nused_memory_human:1.00M\r\nmaxmemory:0\r\nmaxmemory_policy:noeviction\r\n"
+	fields := parseInfo(info)
+	assert.Equal(t, int64(1048576), fields[infoFieldUsedMemory])
+	assert.Equal(t, int64(0), fields[infoFieldMaxMemory])
+	assert.NotContains(t, fields, "maxmemory_policy")
+}
+
+func TestMigrationVersions(t *testing.T) {
+	// databases record the version they are migrated to, so versions must be consecutive starting with 1
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.version, "migration %q has an unexpected version", m.description)
+		assert.NotEmpty(t, m.description)
+	}
+	assert.Equal(t, len(migrations), LatestSchemaVersion())
+}
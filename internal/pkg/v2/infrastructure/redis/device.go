@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -76,22 +78,85 @@ func addDevice(conn redis.Conn, d models.Device) (models.Device, errors.EdgeX) {
 		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device for Redis persistence", err)
 	}
 
-	storedKey := deviceStoredKey(d.Id)
+	indexed, edgeXerr := indexKeys(db.IndexCollectionDevice, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	_ = conn.Send(MULTI)
-	_ = conn.Send(SET, storedKey, dsJSONBytes)
-	_ = conn.Send(ZADD, DeviceCollection, 0, storedKey)
+	sendAddDevice(conn, d, dsJSONBytes, indexed)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device creation failed", err)
+	}
+
+	return d, edgeXerr
+}
+
+// sendAddDevice queues the commands storing the device, JSON marshaled in m, and its index entries in a transaction,
+// indexed are the keys of the declared indexes holding the device
+func sendAddDevice(conn redis.Conn, d models.Device, m []byte, indexed []string) {
+	storedKey := deviceStoredKey(d.Id)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceCollection, d.Modified, storedKey)
 	_ = conn.Send(HSET, DeviceCollectionName, d.Name, storedKey)
 	_ = conn.Send(ZADD, CreateKey(DeviceCollectionServiceName, d.ServiceName), d.Modified, storedKey)
 	_ = conn.Send(ZADD, CreateKey(DeviceCollectionProfileName, d.ProfileName), d.Modified, storedKey)
 	for _, label := range d.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceCollectionLabel, label), d.Modified, storedKey)
 	}
-	_, err = conn.Do(EXEC)
+	for _, key := range indexed {
+		_ = conn.Send(ZADD, key, d.Modified, storedKey)
+	}
+}
+
+// updateDevice replaces the stored device of the same id in one transaction, keeping its activity counters and twin.
+// When modified isn't zero, the update fails with db.ErrModifiedMeanwhile unless it is the Modified timestamp of the
+// stored device, i.e. the device wasn't modified since the update was made from it. The stored device is returned.
+func updateDevice(conn redis.Conn, d models.Device, modified int64) (models.Device, errors.EdgeX) {
+	storedKey := deviceStoredKey(d.Id)
+	if _, err := conn.Do(WATCH, storedKey); err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("watch %s failed", storedKey), err)
+	}
+	defer func() { _, _ = conn.Do(UNWATCH) }()
+
+	old, edgeXerr := deviceById(conn, d.Id)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if d.Name != old.Name {
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device name '%s' not match the exsting '%s' ", d.Name, old.Name), nil)
+	}
+	if modified != 0 && old.Modified != modified {
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device '%s' was modified meanwhile", d.Name), db.ErrModifiedMeanwhile)
+	}
+
+	d.Created = old.Created
+	d.Modified = nextModified(old.Modified)
+	m, err := json.Marshal(d)
 	if err != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device creation failed", err)
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device for Redis persistence", err)
+	}
+	oldIndexed, edgeXerr := indexKeys(db.IndexCollectionDevice, old)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	indexed, edgeXerr := indexKeys(db.IndexCollectionDevice, d)
+	if edgeXerr != nil {
+		return d, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
-	return d, edgeXerr
+	_ = conn.Send(MULTI)
+	sendRemoveDevice(conn, old, oldIndexed)
+	sendAddDevice(conn, d, m, indexed)
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return d, errors.NewCommonEdgeX(errors.KindDatabaseError, "device updating failed", err)
+	} else if reply == nil {
+		// EXEC replies nil when a watched key was changed, the transaction is discarded then
+		return d, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device '%s' was modified meanwhile", d.Name), db.ErrModifiedMeanwhile)
+	}
+	return d, nil
 }
 
 // deviceById query device by id from DB
@@ -140,8 +205,30 @@ func deleteDeviceByName(conn redis.Conn, name string) errors.EdgeX {
 
 // deleteDevice deletes a device
 func deleteDevice(conn redis.Conn, device models.Device) errors.EdgeX {
-	storedKey := deviceStoredKey(device.Id)
+	indexed, edgeXerr := indexKeys(db.IndexCollectionDevice, device)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 	_ = conn.Send(MULTI)
+	sendDeleteDevice(conn, device, indexed)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device deletion failed", err)
+	}
+	return nil
+}
+
+// sendDeleteDevice queues the commands deleting the device, its index entries, activity counters and twin in a
+// transaction, indexed are the keys of the declared indexes holding the device
+func sendDeleteDevice(conn redis.Conn, device models.Device, indexed []string) {
+	sendRemoveDevice(conn, device, indexed)
+	sendDeleteDeviceMetrics(conn, device.Name)
+	sendDeleteDeviceTwin(conn, device.Name)
+}
+
+// sendRemoveDevice queues the commands deleting the device and its index entries in a transaction
+func sendRemoveDevice(conn redis.Conn, device models.Device, indexed []string) {
+	storedKey := deviceStoredKey(device.Id)
 	_ = conn.Send(DEL, storedKey)
 	_ = conn.Send(ZREM, DeviceCollection, storedKey)
 	_ = conn.Send(HDEL, DeviceCollectionName, device.Name)
@@ -150,11 +237,68 @@ func deleteDevice(conn redis.Conn, device models.Device) errors.EdgeX {
 	for _, label := range device.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceCollectionLabel, label), storedKey)
 	}
-	_, err := conn.Do(EXEC)
+	for _, key := range indexed {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
+}
+
+// deleteDevicesByIndex deletes in one transaction all the devices enumerated in the index, which must be exactly the
+// devices of the given ids, and returns the number of deleted devices
+func deleteDevicesByIndex(conn redis.Conn, indexKey string, ids []string) (int, errors.EdgeX) {
+	if _, err := conn.Do(WATCH, indexKey); err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("watch %s failed", indexKey), err)
+	}
+	defer func() { _, _ = conn.Do(UNWATCH) }()
+
+	objects, edgeXerr := getObjectsByRange(conn, indexKey, 0, -1)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	devices := make([]models.Device, len(objects))
+	indexed := make([][]string, len(objects))
+	for i, in := range objects {
+		if err := json.Unmarshal(in, &devices[i]); err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		if indexed[i], edgeXerr = indexKeys(db.IndexCollectionDevice, devices[i]); edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	if !sameDeviceIds(devices, ids) {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "the matching devices changed since the deletion was confirmed", nil)
+	}
+	if len(devices) == 0 {
+		return 0, nil
+	}
+
+	_ = conn.Send(MULTI)
+	for i, device := range devices {
+		sendDeleteDevice(conn, device, indexed[i])
+	}
+	reply, err := conn.Do(EXEC)
 	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device deletion failed", err)
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "devices deletion failed", err)
+	} else if reply == nil {
+		// EXEC replies nil when a watched key was changed, the transaction is discarded then
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "the matching devices were changed during the deletion, please retry", nil)
 	}
-	return nil
+	return len(devices), nil
+}
+
+func sameDeviceIds(devices []models.Device, ids []string) bool {
+	if len(devices) != len(ids) {
+		return false
+	}
+	expected := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		expected[id] = true
+	}
+	for _, d := range devices {
+		if !expected[d.Id] {
+			return false
+		}
+	}
+	return true
 }
 
 // devicesByServiceName query devices by offset, limit and name
@@ -203,6 +347,25 @@ func devicesByLabels(conn redis.Conn, offset int, limit int, labels []string) (d
 	return devices, nil
 }
 
+// devicesByFilter query devices matching the filter expression by offset and limit
+func devicesByFilter(conn redis.Conn, expression filter.Expression, offset int, limit int) (devices []models.Device, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := objectsByFilter(conn, DeviceCollection, deviceFilterIndex, expression, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		d := models.Device{}
+		err := unmarshalPayload(in, &d)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = d
+	}
+	return devices, nil
+}
+
 // devicesByProfileName query devices by offset, limit and profile name
 func devicesByProfileName(conn redis.Conn, offset int, limit int, profileName string) (devices []models.Device, edgeXerr errors.EdgeX) {
 	end := offset + limit - 1
@@ -225,3 +388,41 @@ func devicesByProfileName(conn redis.Conn, offset int, limit int, profileName st
 	}
 	return devices, nil
 }
+
+// devicesModifiedSince query devices modified at or after since by offset and limit
+func devicesModifiedSince(conn redis.Conn, since int, offset int, limit int) (devices []models.Device, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByMinScore(conn, DeviceCollection, since, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		d := models.Device{}
+		err := json.Unmarshal(in, &d)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = d
+	}
+	return devices, nil
+}
+
+// devicesByIndex query devices holding the value of the named index by offset and limit
+func devicesByIndex(conn redis.Conn, name string, value string, offset int, limit int) (devices []models.Device, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := objectsByIndex(conn, db.IndexCollectionDevice, name, value, offset, limit)
+	if edgeXerr != nil {
+		return devices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		d := models.Device{}
+		err := json.Unmarshal(in, &d)
+		if err != nil {
+			return []models.Device{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = d
+	}
+	return devices, nil
+}
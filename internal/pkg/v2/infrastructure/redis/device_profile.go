@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -24,6 +26,8 @@ const (
 	DeviceProfileCollectionLabel        = DeviceProfileCollection + DBKeySeparator + v2.Label
 	DeviceProfileCollectionModel        = DeviceProfileCollection + DBKeySeparator + v2.Model
 	DeviceProfileCollectionManufacturer = DeviceProfileCollection + DBKeySeparator + v2.Manufacturer
+	// DeviceProfileCollectionYaml holds the YAML files the device profiles were uploaded from, by name
+	DeviceProfileCollectionYaml = DeviceProfileCollection + DBKeySeparator + "yaml"
 )
 
 // deviceProfileStoredKey return the device profile's stored key which combines the collection name and object id
@@ -79,23 +83,28 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (addedDeviceProf
 		return addedDeviceProfile, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile for Redis persistence", err)
 	}
 
-	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
+	sendAddDeviceProfile(conn, dp, m)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile creation failed", err)
+	}
+
+	return dp, edgeXerr
+}
+
+// sendAddDeviceProfile queues the commands storing the device profile, JSON marshaled in m, and its index entries in a
+// transaction
+func sendAddDeviceProfile(conn redis.Conn, dp models.DeviceProfile, m []byte) {
+	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(SET, storedKey, m)
-	_ = conn.Send(ZADD, DeviceProfileCollection, 0, storedKey)
+	_ = conn.Send(ZADD, DeviceProfileCollection, dp.Modified, storedKey)
 	_ = conn.Send(HSET, DeviceProfileCollectionName, dp.Name, storedKey)
 	_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionManufacturer, dp.Manufacturer), dp.Modified, storedKey)
 	_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionModel, dp.Model), dp.Modified, storedKey)
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionLabel, label), dp.Modified, storedKey)
 	}
-
-	_, err = conn.Do(EXEC)
-	if err != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile creation failed", err)
-	}
-
-	return dp, edgeXerr
 }
 
 // deviceProfileById query device profile by id from DB
@@ -117,28 +126,34 @@ func deviceProfileByName(conn redis.Conn, name string) (deviceProfile models.Dev
 }
 
 func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX {
-	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
+	sendDeleteDeviceProfile(conn, dp)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile deletion failed", err)
+	}
+	return nil
+}
+
+// sendDeleteDeviceProfile queues the commands deleting the device profile and its index entries in a transaction
+func sendDeleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) {
+	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(DEL, storedKey)
 	_ = conn.Send(ZREM, DeviceProfileCollection, storedKey)
 	_ = conn.Send(HDEL, DeviceProfileCollectionName, dp.Name)
+	_ = conn.Send(HDEL, DeviceProfileCollectionYaml, dp.Name)
 	_ = conn.Send(ZREM, CreateKey(DeviceProfileCollectionManufacturer, dp.Manufacturer), storedKey)
 	_ = conn.Send(ZREM, CreateKey(DeviceProfileCollectionModel, dp.Model), storedKey)
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceProfileCollectionLabel, label), storedKey)
 	}
-
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile deletion failed", err)
-	}
-	return nil
 }
 
-// updateDeviceProfile updates a device profile to DB
-func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr errors.EdgeX) {
-	var oldDeviceProfile models.DeviceProfile
-	oldDeviceProfile, edgeXerr = deviceProfileById(conn, dp.Id)
+// updateDeviceProfile replaces the stored device profile of the same id, or else of the same name, in one
+// transaction. When modified isn't zero, the update fails with db.ErrModifiedMeanwhile unless it is the Modified
+// timestamp of the stored device profile, i.e. the device profile wasn't modified since the update was made from it.
+func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile, modified int64) errors.EdgeX {
+	oldDeviceProfile, edgeXerr := deviceProfileById(conn, dp.Id)
 	if edgeXerr == nil {
 		if dp.Name != oldDeviceProfile.Name {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile name '%s' not match the exsting '%s' ", dp.Name, oldDeviceProfile.Name), nil)
@@ -150,21 +165,66 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 		}
 	}
 
-	edgeXerr = deleteDeviceProfile(conn, oldDeviceProfile)
+	// the device profile is read again once watched, so that the transaction is discarded if it changes meanwhile
+	storedKey := deviceProfileStoredKey(oldDeviceProfile.Id)
+	if _, err := conn.Do(WATCH, storedKey); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("watch %s failed", storedKey), err)
+	}
+	defer func() { _, _ = conn.Do(UNWATCH) }()
+	oldDeviceProfile, edgeXerr = deviceProfileById(conn, oldDeviceProfile.Id)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	if modified != 0 && oldDeviceProfile.Modified != modified {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile '%s' was modified meanwhile", dp.Name), db.ErrModifiedMeanwhile)
+	}
 
-	// Add new one
 	dp.Id = oldDeviceProfile.Id
 	dp.Created = oldDeviceProfile.Created
-	dp.Modified = common.MakeTimestamp()
-	_, edgeXerr = addDeviceProfile(conn, dp)
+	dp.Modified = nextModified(oldDeviceProfile.Modified)
+	m, err := json.Marshal(dp)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile for Redis persistence", err)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceProfile(conn, oldDeviceProfile)
+	sendAddDeviceProfile(conn, dp, m)
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile updating failed", err)
+	} else if reply == nil {
+		// EXEC replies nil when a watched key was changed, the transaction is discarded then
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile '%s' was modified meanwhile", dp.Name), db.ErrModifiedMeanwhile)
+	}
+	return nil
+}
+
+// setDeviceProfileYaml keeps the YAML file the device profile was uploaded from, until the device profile is updated
+// or deleted
+func setDeviceProfileYaml(conn redis.Conn, name string, data []byte) errors.EdgeX {
+	exists, edgeXerr := deviceProfileNameExists(conn, name)
 	if edgeXerr != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile updating failed", edgeXerr)
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile '%s' does not exist", name), nil)
+	}
+	_, err := conn.Do(HSET, DeviceProfileCollectionYaml, name, data)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile yaml storing failed", err)
 	}
+	return nil
+}
 
-	return edgeXerr
+// deviceProfileYamlByName query the YAML file the device profile was uploaded from by name
+func deviceProfileYamlByName(conn redis.Conn, name string) ([]byte, errors.EdgeX) {
+	data, err := redis.Bytes(conn.Do(HGET, DeviceProfileCollectionYaml, name))
+	if err == redis.ErrNil {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no yaml file is kept for device profile '%s'", name), nil)
+	} else if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile yaml query failed", err)
+	}
+	return data, nil
 }
 
 // deleteDeviceProfileById deletes the device profile by id
@@ -216,6 +276,25 @@ func deviceProfilesByLabels(conn redis.Conn, offset int, limit int, labels []str
 	return deviceProfiles, nil
 }
 
+// deviceProfilesByFilter query device profiles matching the filter expression by offset and limit
+func deviceProfilesByFilter(conn redis.Conn, expression filter.Expression, offset int, limit int) (deviceProfiles []models.DeviceProfile, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := objectsByFilter(conn, DeviceProfileCollection, deviceProfileFilterIndex, expression, offset, limit)
+	if edgeXerr != nil {
+		return deviceProfiles, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceProfiles = make([]models.DeviceProfile, len(objects))
+	for i, in := range objects {
+		dp := models.DeviceProfile{}
+		err := unmarshalPayload(in, &dp)
+		if err != nil {
+			return []models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile format parsing failed from the database", err)
+		}
+		deviceProfiles[i] = dp
+	}
+	return deviceProfiles, nil
+}
+
 // deviceProfilesByModel query device profiles by offset, limit and model
 func deviceProfilesByModel(conn redis.Conn, offset int, limit int, model string) (deviceProfiles []models.DeviceProfile, edgeXerr errors.EdgeX) {
 	end := offset + limit - 1
@@ -310,3 +389,22 @@ func deviceProfilesByManufacturerAndModel(conn redis.Conn, offset int, limit int
 	}
 	return deviceProfiles, nil
 }
+
+// deviceProfilesModifiedSince query device profiles modified at or after since by offset and limit
+func deviceProfilesModifiedSince(conn redis.Conn, since int, offset int, limit int) (deviceProfiles []models.DeviceProfile, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByMinScore(conn, DeviceProfileCollection, since, offset, limit)
+	if edgeXerr != nil {
+		return deviceProfiles, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceProfiles = make([]models.DeviceProfile, len(objects))
+	for i, in := range objects {
+		dp := models.DeviceProfile{}
+		err := json.Unmarshal(in, &dp)
+		if err != nil {
+			return []models.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile format parsing failed from the database", err)
+		}
+		deviceProfiles[i] = dp
+	}
+	return deviceProfiles, nil
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -61,8 +62,8 @@ func addDeviceService(conn redis.Conn, ds models.DeviceService) (addedDeviceServ
 	if ds.Created == 0 {
 		ds.Created = ts
 	}
-	// query API will sort the result based on Modified, so even newly created device service shall specify Modified as Created
-	ds.Modified = ds.Created
+	// query API will sort and filter the result based on Modified, so a patched device service shall be moved up
+	ds.Modified = ts
 
 	dsJSONBytes, err := json.Marshal(ds)
 	if err != nil {
@@ -174,3 +175,41 @@ func deviceServicesByLabels(conn redis.Conn, offset int, limit int, labels []str
 	}
 	return deviceServices, nil
 }
+
+// deviceServicesByFilter query device services matching the filter expression by offset and limit
+func deviceServicesByFilter(conn redis.Conn, expression filter.Expression, offset int, limit int) (deviceServices []models.DeviceService, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := objectsByFilter(conn, DeviceServiceCollection, deviceServiceFilterIndex, expression, offset, limit)
+	if edgeXerr != nil {
+		return deviceServices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceServices = make([]models.DeviceService, len(objects))
+	for i, in := range objects {
+		s := models.DeviceService{}
+		err := unmarshalPayload(in, &s)
+		if err != nil {
+			return []models.DeviceService{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service format parsing failed from the database", err)
+		}
+		deviceServices[i] = s
+	}
+	return deviceServices, nil
+}
+
+// deviceServicesModifiedSince query device services modified at or after since by offset and limit
+func deviceServicesModifiedSince(conn redis.Conn, since int, offset int, limit int) (deviceServices []models.DeviceService, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByMinScore(conn, DeviceServiceCollection, since, offset, limit)
+	if edgeXerr != nil {
+		return deviceServices, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceServices = make([]models.DeviceService, len(objects))
+	for i, in := range objects {
+		s := models.DeviceService{}
+		err := json.Unmarshal(in, &s)
+		if err != nil {
+			return []models.DeviceService{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service format parsing failed from the database", err)
+		}
+		deviceServices[i] = s
+	}
+	return deviceServices, nil
+}
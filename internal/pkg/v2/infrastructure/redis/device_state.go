@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// DeviceStateCollection holds a hash per device with the latest reading of each resource of the device
+	DeviceStateCollection = "cd|st"
+	// DeviceStateCollectionOrigin holds a hash per device with the origin of the latest reading of each resource, which
+	// a reading must not be older than to replace the latest reading
+	DeviceStateCollectionOrigin = DeviceStateCollection + DBKeySeparator + "origin"
+)
+
+// updateDeviceStateScript replaces the latest reading of each resource in the device state hash KEYS[1], unless the
+// origin recorded in the hash KEYS[2] is newer. ARGV holds triples of resource name, origin and reading. Origins are
+// compared as decimal strings, since nanosecond origins exceed the integer precision of Lua numbers.
+var updateDeviceStateScript = redis.NewScript(2, `
+local function notOlder(origin, latest)
+  if #origin ~= #latest then
+    return #origin > #latest
+  end
+  return origin >= latest
+end
+local updated = 0
+for i = 1, #ARGV, 3 do
+  local latest = redis.call('HGET', KEYS[2], ARGV[i])
+  if not latest or notOlder(ARGV[i + 1], latest) then
+    redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 2])
+    redis.call('HSET', KEYS[2], ARGV[i], ARGV[i + 1])
+    updated = updated + 1
+  end
+end
+return updated
+`)
+
+// updateDeviceState records the readings as the latest readings of their device's resources, keeping the latest
+// reading of a resource when a reading arrives out of order
+func updateDeviceState(conn redis.Conn, readings []models.Reading) errors.EdgeX {
+	args := make(map[string]redis.Args)
+	for _, r := range readings {
+		base := r.GetBaseReading()
+		m, err := marshalPayload(r)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal reading for Redis persistence", err)
+		}
+		args[base.DeviceName] = args[base.DeviceName].Add(base.ResourceName, strconv.FormatInt(base.Origin, 10), m)
+	}
+
+	for deviceName, a := range args {
+		keysAndArgs := redis.Args{}.Add(CreateKey(DeviceStateCollection, deviceName), CreateKey(DeviceStateCollectionOrigin, deviceName)).AddFlat(a)
+		if _, err := updateDeviceStateScript.Do(conn, keysAndArgs...); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("device %s state update failed", deviceName), err)
+		}
+	}
+	return nil
+}
+
+// deviceState query the latest reading of each resource of the device, sorted by resource name
+func deviceState(conn redis.Conn, deviceName string) ([]models.Reading, errors.EdgeX) {
+	values, err := redis.StringMap(conn.Do(HGETALL, CreateKey(DeviceStateCollection, deviceName)))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query device %s state failed", deviceName), err)
+	}
+	if len(values) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s has no state", deviceName), nil)
+	}
+
+	resourceNames := make([]string, 0, len(values))
+	for resourceName := range values {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+
+	readings := make([]models.Reading, len(resourceNames))
+	for i, resourceName := range resourceNames {
+		readings[i], err = unmarshalReading([]byte(values[resourceName]))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "reading format parsing failed from the database", err)
+		}
+	}
+	return readings, nil
+}
+
+// unmarshalReading parses a reading into a BinaryReading or a SimpleReading by its value type
+func unmarshalReading(in []byte) (models.Reading, error) {
+	in, err := redisClient.DecryptPayload(in)
+	if err != nil {
+		return nil, err
+	}
+	var base models.BaseReading
+	if err := json.Unmarshal(in, &base); err != nil {
+		return nil, err
+	}
+	if base.ValueType == v2.ValueTypeBinary {
+		var br models.BinaryReading
+		err = json.Unmarshal(in, &br)
+		return br, err
+	}
+	var sr models.SimpleReading
+	err = json.Unmarshal(in, &sr)
+	return sr, err
+}
@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"time"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// DeviceCollectionStateHistory holds a sorted set per device of the transitions of its admin and operating states,
+// scored by their timestamp. The history of a device is kept after it is deleted, until it expires.
+const DeviceCollectionStateHistory = DeviceCollection + DBKeySeparator + "statehistory"
+
+func deviceStateHistoryKey(name string) string {
+	return CreateKey(DeviceCollectionStateHistory, name)
+}
+
+// addDeviceStateTransitions adds the transitions to the state history of their device, and drops the transitions older
+// than the retention. The history of a device expires when its states don't change for the retention.
+func addDeviceStateTransitions(conn redis.Conn, transitions []pkgModels.DeviceStateTransition, retention time.Duration) errors.EdgeX {
+	oldest := time.Now().Add(-retention).UnixNano() / int64(time.Millisecond)
+	keys := make(map[string]bool)
+
+	_ = conn.Send(MULTI)
+	for _, t := range transitions {
+		m, err := marshalPayload(t)
+		if err != nil {
+			_ = conn.Send(DISCARD)
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device state transition for Redis persistence", err)
+		}
+		key := deviceStateHistoryKey(t.DeviceName)
+		keys[key] = true
+		_ = conn.Send(ZADD, key, t.Timestamp, m)
+	}
+	for key := range keys {
+		_ = conn.Send(ZREMRANGEBYSCORE, key, InfiniteMin, fmt.Sprintf("(%d", oldest))
+		_ = conn.Send(EXPIRE, key, int64(retention/time.Second))
+	}
+	if _, err := conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device state history update failed", err)
+	}
+	return nil
+}
+
+// deviceStateHistory returns the state transitions of the device timestamped from start to end, in milliseconds, by
+// offset and limit, the latest first
+func deviceStateHistory(conn redis.Conn, name string, start int64, end int64, offset int, limit int) ([]pkgModels.DeviceStateTransition, errors.EdgeX) {
+	values, err := redis.ByteSlices(conn.Do(ZREVRANGEBYSCORE, deviceStateHistoryKey(name), end, start, LIMIT, offset, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query device %s state history failed", name), err)
+	}
+
+	transitions := make([]pkgModels.DeviceStateTransition, len(values))
+	for i, value := range values {
+		if err = unmarshalPayload(value, &transitions[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device state transition format parsing failed from the database", err)
+		}
+	}
+	return transitions, nil
+}
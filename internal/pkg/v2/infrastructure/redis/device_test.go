@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transactionConn replies to GET with the stored objects and to EXEC with execReply, recording the commands queued
+// in the transaction along with the key they apply to
+type transactionConn struct {
+	stored    map[string][]byte
+	execReply interface{}
+	queued    []string
+}
+
+func (c *transactionConn) Close() error { return nil }
+func (c *transactionConn) Err() error   { return nil }
+func (c *transactionConn) Flush() error { return nil }
+
+func (c *transactionConn) Receive() (interface{}, error) { return nil, nil }
+
+func (c *transactionConn) Send(command string, args ...interface{}) error {
+	if len(args) > 0 {
+		command = fmt.Sprintf("%s %v", command, args[0])
+	}
+	c.queued = append(c.queued, command)
+	return nil
+}
+
+func (c *transactionConn) Do(command string, args ...interface{}) (interface{}, error) {
+	switch command {
+	case GET:
+		return c.stored[args[0].(string)], nil
+	case EXEC:
+		return c.execReply, nil
+	}
+	return "OK", nil
+}
+
+func TestUpdateDevice(t *testing.T) {
+	stored := model.Device{
+		Timestamps:  model.Timestamps{Created: 1500000000000, Modified: 1600000000000},
+		Id:          "c6c6ad7d-3b9b-4a8b-8aea-7e1fb35a1f5c",
+		Name:        "device",
+		ServiceName: "service",
+		ProfileName: "profile",
+	}
+	storedJSON, err := json.Marshal(stored)
+	require.NoError(t, err)
+	update := stored
+	update.Timestamps = model.Timestamps{}
+	update.Description = "updated"
+
+	tests := []struct {
+		name       string
+		modified   int64
+		execReply  interface{}
+		expectedOk bool
+	}{
+		{"Valid - not modified meanwhile", stored.Modified, []interface{}{}, true},
+		{"Valid - no version to compare", 0, []interface{}{}, true},
+		{"Invalid - modified before the transaction", stored.Modified - 1, []interface{}{}, false},
+		{"Invalid - modified during the transaction", stored.Modified, nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := &transactionConn{stored: map[string][]byte{deviceStoredKey(stored.Id): storedJSON}, execReply: testCase.execReply}
+
+			updated, edgeXerr := updateDevice(conn, update, testCase.modified)
+
+			if testCase.expectedOk {
+				require.NoError(t, edgeXerr)
+				assert.Equal(t, stored.Created, updated.Created)
+				assert.Greater(t, updated.Modified, stored.Modified)
+				assert.Equal(t, MULTI, conn.queued[0])
+				assert.Contains(t, conn.queued, SET+" "+deviceStoredKey(stored.Id))
+				assert.NotContains(t, conn.queued, UNLINK+" "+deviceTwinKey(stored.Name), "the twin of the device is deleted by the update")
+			} else {
+				require.Error(t, edgeXerr)
+				assert.True(t, goErrors.Is(edgeXerr, db.ErrModifiedMeanwhile), "error doesn't wrap db.ErrModifiedMeanwhile")
+			}
+		})
+	}
+}
+
+func TestNextModified(t *testing.T) {
+	future := int64(1) << 60
+	assert.Equal(t, future+1, nextModified(future))
+	assert.Greater(t, nextModified(0), int64(0))
+}
@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/devicemetrics"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// DeviceCollectionMetrics holds a hash per device of its activity counters, the fields being the UTC day and the
+// name of the counter
+const DeviceCollectionMetrics = DeviceCollection + DBKeySeparator + "metrics"
+
+// DeviceCollectionLastEvent holds a hash of when the last event of each device was received, in milliseconds
+const DeviceCollectionLastEvent = DeviceCollection + DBKeySeparator + "lastevent"
+
+const (
+	metricCommandsIssued  = "commandsIssued"
+	metricCommandFailures = "commandFailures"
+	metricEventsReceived  = "eventsReceived"
+)
+
+func deviceMetricsKey(name string) string {
+	return CreateKey(DeviceCollectionMetrics, name)
+}
+
+// sendDeleteDeviceMetrics queues the deletion of the activity counters of the device in the transaction deleting it
+func sendDeleteDeviceMetrics(conn redis.Conn, name string) {
+	_ = conn.Send(UNLINK, deviceMetricsKey(name))
+	_ = conn.Send(HDEL, DeviceCollectionLastEvent, name)
+}
+
+// addDeviceMetrics adds the counters to the activity of the device on the UTC day of at, and drops the days older than
+// the retention. The counters of a device expire when it has no activity for the retention. The last event time is
+// kept unless it is older than the one stored.
+func addDeviceMetrics(conn redis.Conn, name string, counters devicemetrics.Counters, at time.Time, retention time.Duration) errors.EdgeX {
+	key := deviceMetricsKey(name)
+	day := at.UTC().Format(devicemetrics.DayLayout)
+	oldest := at.Add(-retention).UTC().Format(devicemetrics.DayLayout)
+
+	fields, err := redis.Strings(conn.Do(HKEYS, key))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to read the device metrics", err)
+	}
+	var lastEvent int64
+	if counters.LastEventReceived > 0 {
+		lastEvent, err = redis.Int64(conn.Do(HGET, DeviceCollectionLastEvent, name))
+		if err != nil && err != redis.ErrNil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to read the last event time of the device", err)
+		}
+	}
+
+	_ = conn.Send(MULTI)
+	for _, field := range fields {
+		if fieldDay := strings.SplitN(field, DBKeySeparator, 2)[0]; fieldDay < oldest {
+			_ = conn.Send(HDEL, key, field)
+		}
+	}
+	for metric, count := range map[string]int64{
+		metricCommandsIssued:  counters.CommandsIssued,
+		metricCommandFailures: counters.CommandFailures,
+		metricEventsReceived:  counters.EventsReceived,
+	} {
+		if count != 0 {
+			_ = conn.Send(HINCRBY, key, CreateKey(day, metric), count)
+		}
+	}
+	_ = conn.Send(EXPIRE, key, int64(retention/time.Second))
+	if counters.LastEventReceived > lastEvent {
+		_ = conn.Send(HSET, DeviceCollectionLastEvent, name, counters.LastEventReceived)
+	}
+	if _, err = conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device metrics update failed", err)
+	}
+	return nil
+}
+
+// deviceMetricsByName returns the daily activity counters of the device, newest day first
+func deviceMetricsByName(conn redis.Conn, name string, offset int, limit int) ([]devicemetrics.DailyCounters, errors.EdgeX) {
+	values, err := redis.Int64Map(conn.Do(HGETALL, deviceMetricsKey(name)))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to read the device metrics", err)
+	}
+
+	days := make(map[string]*devicemetrics.DailyCounters)
+	for field, count := range values {
+		parts := strings.SplitN(field, DBKeySeparator, 2)
+		if len(parts) != 2 {
+			continue
+		}
+		daily, ok := days[parts[0]]
+		if !ok {
+			daily = &devicemetrics.DailyCounters{Day: parts[0]}
+			days[parts[0]] = daily
+		}
+		switch parts[1] {
+		case metricCommandsIssued:
+			daily.CommandsIssued = count
+		case metricCommandFailures:
+			daily.CommandFailures = count
+		case metricEventsReceived:
+			daily.EventsReceived = count
+		}
+	}
+
+	metrics := make([]devicemetrics.DailyCounters, 0, len(days))
+	for _, daily := range days {
+		metrics = append(metrics, *daily)
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Day > metrics[j].Day
+	})
+
+	if offset > len(metrics) {
+		return nil, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("offset %d exceeds the %d days of metrics", offset, len(metrics)), nil)
+	}
+	metrics = metrics[offset:]
+	if limit >= 0 && limit < len(metrics) {
+		metrics = metrics[:limit]
+	}
+	return metrics, nil
+}
+
+// devicesLastEventReceived returns when the last event of each of the devices was received, in milliseconds, the
+// devices without any event being left out
+func devicesLastEventReceived(conn redis.Conn, names []string) (map[string]int64, errors.EdgeX) {
+	lastEvents := make(map[string]int64, len(names))
+	if len(names) == 0 {
+		return lastEvents, nil
+	}
+	args := redis.Args{}.Add(DeviceCollectionLastEvent).AddFlat(names)
+	values, err := redis.Values(conn.Do(HMGET, args...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to read the last event time of the devices", err)
+	}
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		lastEvent, err := redis.Int64(value, nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("invalid last event time of device %s", names[i]), err)
+		}
+		lastEvents[names[i]] = lastEvent
+	}
+	return lastEvents, nil
+}
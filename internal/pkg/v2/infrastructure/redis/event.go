@@ -6,11 +6,12 @@
 package redis
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2"
@@ -24,6 +25,7 @@ const (
 	EventsCollectionCreated    = EventsCollection + DBKeySeparator + v2.Created
 	EventsCollectionDeviceName = EventsCollection + DBKeySeparator + v2.Device + DBKeySeparator + v2.Name
 	EventsCollectionReadings   = EventsCollection + DBKeySeparator + "readings"
+	EventsCollectionAssetId    = EventsCollection + DBKeySeparator + "asset" + DBKeySeparator + v2.Id
 )
 
 // asyncDeleteEventsByIds deletes all events with given event Ids.  This function is implemented to be run as a separate
@@ -40,22 +42,26 @@ func (c *Client) asyncDeleteEventsByIds(eventIds []string) {
 		return
 	}
 
+	pushedKeys, edgeXerr := eventsPushedKeys(conn)
+	if edgeXerr != nil {
+		c.loggingClient.Error(fmt.Sprintf("Deleted events failed while retrieving pushed events.  Err: %s", edgeXerr.DebugMessages()))
+		return
+	}
+
 	// iterate each events for deletion in batch
 	queriesInQueue := 0
 	e := models.Event{}
 	_ = conn.Send(MULTI)
 	for i, event := range events {
-		err := json.Unmarshal(event, &e)
+		err := unmarshalPayload(event, &e)
 		if err != nil {
 			c.loggingClient.Error(fmt.Sprintf("unable to marshal event.  Err: %s", err.Error()))
 			continue
 		}
-		storedKey := eventStoredKey(e.Id)
-		_ = conn.Send(UNLINK, storedKey)
-		_ = conn.Send(UNLINK, CreateKey(EventsCollectionReadings, e.Id))
-		_ = conn.Send(ZREM, EventsCollection, storedKey)
-		_ = conn.Send(ZREM, EventsCollectionCreated, storedKey)
-		_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+		if edgeXerr := sendDeleteEvent(conn, e, event, pushedKeys); edgeXerr != nil {
+			c.loggingClient.Error(fmt.Sprintf("unable to unindex event.  Err: %s", edgeXerr.Error()))
+			continue
+		}
 		queriesInQueue++
 
 		if queriesInQueue >= c.BatchSize {
@@ -99,6 +105,67 @@ func (c *Client) DeleteEventsByDeviceName(deviceName string) (edgeXerr errors.Ed
 	return nil
 }
 
+// DeleteEventsByDeviceNameChunk deletes the oldest events of a device, at most limit of them, and their readings within
+// a single transaction, and returns the number of events and readings deleted. Deleting a device's events chunk by
+// chunk bounds the time the database is busy with each deletion.
+func (c *Client) DeleteEventsByDeviceNameChunk(deviceName string, limit int) (events uint32, readings uint32, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	eventIds, err := redis.Strings(conn.Do(ZRANGE, CreateKey(EventsCollectionDeviceName, deviceName), 0, limit-1))
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve event ids of device %s failed", deviceName), err)
+	}
+	if len(eventIds) == 0 {
+		return 0, 0, nil
+	}
+	storedEvents, edgeXerr := getObjectsByIds(conn, common.ConvertStringsToInterfaces(eventIds))
+	if edgeXerr != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	readingIds, edgeXerr := readingIdsByEvents(conn, storedEvents)
+	if edgeXerr != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedReadings, edgeXerr := getObjectsByIds(conn, common.ConvertStringsToInterfaces(readingIds))
+	if edgeXerr != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	pushedKeys, edgeXerr := eventsPushedKeys(conn)
+	if edgeXerr != nil {
+		return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	// unindex the ids of the events deleted since, or the device would never run out of events
+	_ = conn.Send(ZREM, append([]interface{}{CreateKey(EventsCollectionDeviceName, deviceName)}, common.ConvertStringsToInterfaces(eventIds)...)...)
+	for _, reading := range storedReadings {
+		r := models.BaseReading{}
+		if err := unmarshalPayload(reading, &r); err != nil {
+			_, _ = conn.Do(DISCARD)
+			return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to marshal reading", err)
+		}
+		sendDeleteReading(conn, r, len(reading))
+		readings++
+	}
+	for _, event := range storedEvents {
+		e := models.Event{}
+		if err := unmarshalPayload(event, &e); err != nil {
+			_, _ = conn.Do(DISCARD)
+			return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to marshal event", err)
+		}
+		if edgeXerr := sendDeleteEvent(conn, e, event, pushedKeys); edgeXerr != nil {
+			_, _ = conn.Do(DISCARD)
+			return 0, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	if _, err := conn.Do(EXEC); err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("events of device %s delete failed", deviceName), err)
+	}
+
+	return uint32(len(eventIds)), readings, nil
+}
+
 // DeleteEventsByAge deletes events and their corresponding readings that are older than age.  This function is implemented to starts up
 // two goroutines to delete readings and events in the background to achieve better performance.
 func (c *Client) DeleteEventsByAge(age int64) (edgeXerr errors.EdgeX) {
@@ -146,11 +213,16 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 		Tags:        e.Tags,
 	}
 
-	m, err := json.Marshal(event)
+	m, err := marshalPayload(event)
 	if err != nil {
 		return addedEvent, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
 	}
 
+	indexed, edgeXerr := indexKeys(db.IndexCollectionEvent, event)
+	if edgeXerr != nil {
+		return addedEvent, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := eventStoredKey(e.Id)
 	_ = conn.Send(MULTI)
 	// use the SET command to save event as blob
@@ -158,6 +230,12 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 	_ = conn.Send(ZADD, EventsCollection, e.Created, storedKey)
 	_ = conn.Send(ZADD, EventsCollectionCreated, e.Created, storedKey)
 	_ = conn.Send(ZADD, CreateKey(EventsCollectionDeviceName, e.DeviceName), e.Created, storedKey)
+	if assetId := e.Tags[pkgModels.AssetIdTag]; assetId != "" {
+		_ = conn.Send(ZADD, CreateKey(EventsCollectionAssetId, assetId), e.Created, storedKey)
+	}
+	for _, key := range indexed {
+		_ = conn.Send(ZADD, key, e.Created, storedKey)
+	}
 
 	// add reading ids as sorted set under each event id
 	// sort by the order provided by device service
@@ -203,6 +281,18 @@ func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 		}
 	}
 
+	// the readings aren't part of the stored event the indexes are built from
+	stored := e
+	stored.Readings = nil
+	indexed, edgeXerr := indexKeys(db.IndexCollectionEvent, stored)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	pushedKeys, edgeXerr := eventsPushedKeys(conn)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
 	storedKey := eventStoredKey(e.Id)
 	_ = conn.Send(MULTI)
 	_ = conn.Send(UNLINK, storedKey)
@@ -210,6 +300,15 @@ func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	_ = conn.Send(ZREM, EventsCollection, storedKey)
 	_ = conn.Send(ZREM, EventsCollectionCreated, storedKey)
 	_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+	if assetId := e.Tags[pkgModels.AssetIdTag]; assetId != "" {
+		_ = conn.Send(ZREM, CreateKey(EventsCollectionAssetId, assetId), storedKey)
+	}
+	for _, key := range indexed {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
+	for _, key := range pushedKeys {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
 
 	res, err := redis.Values(conn.Do(EXEC))
 	if err != nil {
@@ -232,19 +331,52 @@ func getEventReadingIdsByKeyScoreRange(conn redis.Conn, key string, min string,
 	if edgeXerr != nil {
 		return nil, nil, edgeXerr
 	}
+	readingIds, edgeXerr = readingIdsByEvents(conn, events)
+	if edgeXerr != nil {
+		return nil, nil, edgeXerr
+	}
+	return eventIds, readingIds, nil
+}
+
+// readingIdsByEvents returns the ids of the readings of the stored events
+func readingIdsByEvents(conn redis.Conn, events [][]byte) (readingIds []string, edgeXerr errors.EdgeX) {
 	e := models.Event{}
 	for _, event := range events {
-		err = json.Unmarshal(event, &e)
+		err := unmarshalPayload(event, &e)
 		if err != nil {
-			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to marshal event", err)
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to marshal event", err)
 		}
 		rIds, err := redis.Strings(conn.Do(ZRANGE, CreateKey(EventsCollectionReadings, e.Id), 0, -1))
 		if err != nil {
-			return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve all reading Ids of event %s failed", e.Id), err)
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("retrieve all reading Ids of event %s failed", e.Id), err)
 		}
 		readingIds = append(readingIds, rIds...)
 	}
-	return eventIds, readingIds, nil
+	return readingIds, nil
+}
+
+// sendDeleteEvent queues the commands deleting the stored event and unindexing it
+func sendDeleteEvent(conn redis.Conn, e models.Event, event []byte, pushedKeys []string) errors.EdgeX {
+	indexed, edgeXerr := indexKeys(db.IndexCollectionEvent, event)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+	storedKey := eventStoredKey(e.Id)
+	_ = conn.Send(UNLINK, storedKey)
+	_ = conn.Send(UNLINK, CreateKey(EventsCollectionReadings, e.Id))
+	_ = conn.Send(ZREM, EventsCollection, storedKey)
+	_ = conn.Send(ZREM, EventsCollectionCreated, storedKey)
+	_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+	if assetId := e.Tags[pkgModels.AssetIdTag]; assetId != "" {
+		_ = conn.Send(ZREM, CreateKey(EventsCollectionAssetId, assetId), storedKey)
+	}
+	for _, key := range indexed {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
+	for _, key := range pushedKeys {
+		_ = conn.Send(ZREM, key, storedKey)
+	}
+	return nil
 }
 
 func eventById(conn redis.Conn, id string) (event models.Event, edgeXerr errors.EdgeX) {
@@ -286,6 +418,19 @@ func eventsByDeviceName(conn redis.Conn, offset int, limit int, name string) (ev
 	return convertObjectsToEvents(conn, objects)
 }
 
+// eventsByAssetId query events by offset, limit and the id of the asset they are attached to
+func eventsByAssetId(conn redis.Conn, offset int, limit int, assetId string) (events []models.Event, edgeXerr errors.EdgeX) {
+	end := offset + limit - 1
+	if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
+		end = limit
+	}
+	objects, err := getObjectsByRevRange(conn, CreateKey(EventsCollectionAssetId, assetId), offset, end)
+	if err != nil {
+		return events, errors.NewCommonEdgeXWrapper(err)
+	}
+	return convertObjectsToEvents(conn, objects)
+}
+
 // eventsByTimeRange query events by time range, offset, and limit
 func eventsByTimeRange(conn redis.Conn, start int, end int, offset int, limit int) (events []models.Event, edgeXerr errors.EdgeX) {
 	objects, edgeXerr := getObjectsByScoreRange(conn, EventsCollectionCreated, start, end, offset, limit)
@@ -299,7 +444,7 @@ func convertObjectsToEvents(conn redis.Conn, objects [][]byte) (events []models.
 	events = make([]models.Event, len(objects))
 	for i, in := range objects {
 		e := models.Event{}
-		err := json.Unmarshal(in, &e)
+		err := unmarshalPayload(in, &e)
 		if err != nil {
 			return []models.Event{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "event format parsing failed from the database", err)
 		}
@@ -311,3 +456,75 @@ func convertObjectsToEvents(conn redis.Conn, objects [][]byte) (events []models.
 	}
 	return events, nil
 }
+
+// eventsByIds query the events of the ids, in the order of the ids. The events are read with one MGET, the reading ids
+// of all the events in one pipeline and the readings with another MGET. Ids of missing events are skipped.
+func eventsByIds(conn redis.Conn, ids []string) (events []models.Event, edgeXerr errors.EdgeX) {
+	if len(ids) == 0 {
+		return events, nil
+	}
+	keys := make([]interface{}, len(ids))
+	for i, id := range ids {
+		keys[i] = eventStoredKey(id)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, keys)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	events = make([]models.Event, len(objects))
+	for i, in := range objects {
+		if err := unmarshalPayload(in, &events[i]); err != nil {
+			return []models.Event{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "event format parsing failed from the database", err)
+		}
+	}
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	for _, e := range events {
+		_ = conn.Send(ZRANGE, CreateKey(EventsCollectionReadings, e.Id), 0, -1)
+	}
+	if err := conn.Flush(); err != nil {
+		return events, errors.NewCommonEdgeX(errors.KindDatabaseError, "query reading ids from database failed", err)
+	}
+	readingCounts := make([]int, len(events))
+	var readingKeys []interface{}
+	for i := range events {
+		members, err := redis.Values(conn.Receive())
+		if err != nil {
+			return events, errors.NewCommonEdgeX(errors.KindDatabaseError, "query reading ids from database failed", err)
+		}
+		readingCounts[i] = len(members)
+		readingKeys = append(readingKeys, members...)
+	}
+	if len(readingKeys) == 0 {
+		return events, nil
+	}
+
+	readingObjects, err := redis.ByteSlices(conn.Do(MGET, readingKeys...))
+	if err != nil {
+		return events, errors.NewCommonEdgeX(errors.KindDatabaseError, "query readings from database failed", err)
+	}
+	for i, count := range readingCounts {
+		var found [][]byte
+		for _, obj := range readingObjects[:count] {
+			if obj != nil {
+				found = append(found, obj)
+			}
+		}
+		readingObjects = readingObjects[count:]
+		if events[i].Readings, edgeXerr = convertObjectsToReadings(found); edgeXerr != nil {
+			return events, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return events, nil
+}
+
+// eventsByIndex query events holding the value of the named index by offset and limit
+func eventsByIndex(conn redis.Conn, name string, value string, offset int, limit int) (events []models.Event, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := objectsByIndex(conn, db.IndexCollectionEvent, name, value, offset, limit)
+	if edgeXerr != nil {
+		return events, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return convertObjectsToEvents(conn, objects)
+}
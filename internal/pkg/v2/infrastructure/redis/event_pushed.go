@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	// EventsCollectionPushed holds the names of the consumer groups which marked events as pushed, the stored keys of
+	// the events a consumer group marked are under the key of the consumer group, scored by event creation time
+	EventsCollectionPushed = EventsCollection + DBKeySeparator + "pushed"
+	// unpushedScanBatch is the number of events whose pushed mark is checked per round trip
+	unpushedScanBatch = 500
+)
+
+// eventsPushedKey returns the key of the events marked as pushed by the consumer group
+func eventsPushedKey(consumer string) string {
+	return CreateKey(EventsCollectionPushed, consumer)
+}
+
+// eventsPushedKeys returns the keys of the events marked as pushed by all the consumer groups, so that the marks of
+// deleted events are removed along with them
+func eventsPushedKeys(conn redis.Conn) ([]string, errors.EdgeX) {
+	consumers, err := redis.Strings(conn.Do(SMEMBERS, EventsCollectionPushed))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the consumer groups of pushed events failed", err)
+	}
+	keys := make([]string, len(consumers))
+	for i, consumer := range consumers {
+		keys[i] = eventsPushedKey(consumer)
+	}
+	return keys, nil
+}
+
+// markEventsPushed marks the events of the ids as pushed by the consumer group, and returns the ids of the events
+// which don't exist
+func markEventsPushed(conn redis.Conn, consumer string, ids []string) (notFound []string, edgeXerr errors.EdgeX) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	for _, id := range ids {
+		_ = conn.Send(ZSCORE, EventsCollectionCreated, eventStoredKey(id))
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query events from database failed", err)
+	}
+	args := []interface{}{eventsPushedKey(consumer)}
+	for _, id := range ids {
+		created, err := conn.Receive()
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query events from database failed", err)
+		}
+		if created == nil {
+			notFound = append(notFound, id)
+			continue
+		}
+		args = append(args, created, eventStoredKey(id))
+	}
+	if len(args) == 1 {
+		return notFound, nil
+	}
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SADD, EventsCollectionPushed, consumer)
+	_ = conn.Send(ZADD, args...)
+	if _, err := conn.Do(EXEC); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "mark events as pushed failed", err)
+	}
+	return notFound, nil
+}
+
+// unpushedEvents query the events the consumer group didn't mark as pushed by offset and limit, the oldest first. The
+// events are walked in batches, checking their marks in one pipeline per batch.
+func unpushedEvents(conn redis.Conn, consumer string, offset int, limit int) (events []models.Event, edgeXerr errors.EdgeX) {
+	pushedKey := eventsPushedKey(consumer)
+	var ids []string
+	skipped := 0
+	for start := 0; limit == -1 || len(ids) < limit; start += unpushedScanBatch {
+		keys, err := redis.Strings(conn.Do(ZRANGE, EventsCollectionCreated, start, start+unpushedScanBatch-1))
+		if err != nil {
+			return events, errors.NewCommonEdgeX(errors.KindDatabaseError, "query event ids from database failed", err)
+		}
+		for _, key := range keys {
+			_ = conn.Send(ZSCORE, pushedKey, key)
+		}
+		if err = conn.Flush(); err != nil {
+			return events, errors.NewCommonEdgeX(errors.KindDatabaseError, "query pushed events from database failed", err)
+		}
+		for _, key := range keys {
+			pushed, err := conn.Receive()
+			if err != nil {
+				return events, errors.NewCommonEdgeX(errors.KindDatabaseError, "query pushed events from database failed", err)
+			}
+			switch {
+			case pushed != nil:
+			case skipped < offset:
+				skipped++
+			case limit == -1 || len(ids) < limit:
+				ids = append(ids, strings.TrimPrefix(key, EventsCollection+DBKeySeparator))
+			}
+		}
+		if len(keys) < unpushedScanBatch {
+			break
+		}
+	}
+	return eventsByIds(conn, ids)
+}
+
+// deleteEventsPushed forgets the events the consumer group marked as pushed
+func deleteEventsPushed(conn redis.Conn, consumer string) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	_ = conn.Send(UNLINK, eventsPushedKey(consumer))
+	_ = conn.Send(SREM, EventsCollectionPushed, consumer)
+	res, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "delete pushed events failed", err)
+	}
+	if removed, _ := redis.Int(res[1], nil); removed == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("consumer group %s has no pushed events", consumer), nil)
+	}
+	return nil
+}
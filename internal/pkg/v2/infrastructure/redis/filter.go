@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// filterBatchSize is the number of objects read at once while matching a filter expression
+const filterBatchSize = 1000
+
+// filterIndex returns the key of the sorted set enumerating the objects matching the comparison, when there is one
+type filterIndex func(c filter.Comparison) (string, bool)
+
+// objectsByFilter returns the objects of the collection matching the expression by offset and limit, newest first.
+// The objects to match are narrowed down to those enumerated by the sorted sets answering the comparisons all the
+// matching objects satisfy, the whole collection being matched when there is none.
+func objectsByFilter(conn redis.Conn, collection string, index filterIndex, expression filter.Expression, offset int, limit int) ([][]byte, errors.EdgeX) {
+	if limit == 0 {
+		return nil, nil
+	}
+
+	var keys []string
+	for _, term := range filter.Conjuncts(expression) {
+		if c, ok := term.(filter.Comparison); ok {
+			if key, ok := index(c); ok {
+				keys = append(keys, key)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		keys = []string{collection}
+	}
+	idsSlice := make([][]string, len(keys))
+	for i, key := range keys {
+		ids, err := redis.Strings(conn.Do(ZREVRANGE, key, 0, -1))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query object ids under %s from database failed", key), err)
+		}
+		idsSlice[i] = ids
+	}
+	ids := common.FindCommonStrings(idsSlice...)
+
+	var matched [][]byte
+	skipped := 0
+	for start := 0; start < len(ids); start += filterBatchSize {
+		end := start + filterBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		objects, edgeXerr := getObjectsByIds(conn, common.ConvertStringsToInterfaces(ids[start:end]))
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, object := range objects {
+			var v interface{}
+			if err := unmarshalPayload(object, &v); err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "object format parsing failed from the database", err)
+			}
+			if !expression.Matches(v) {
+				continue
+			}
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			matched = append(matched, object)
+			if len(matched) == limit {
+				return matched, nil
+			}
+		}
+	}
+	return matched, nil
+}
+
+// labelsFilterIndex answers the comparisons on the labels with the sorted sets of the labels of the collection
+func labelsFilterIndex(labelCollection string, c filter.Comparison) (string, bool) {
+	if c.Operator == filter.Contains && strings.EqualFold(c.Field, "labels") {
+		return CreateKey(labelCollection, c.Value), true
+	}
+	return "", false
+}
+
+// deviceFilterIndex answers the comparisons on the labels, the service and profile names, and the fields of the
+// declared indexes
+func deviceFilterIndex(c filter.Comparison) (string, bool) {
+	if key, ok := labelsFilterIndex(DeviceCollectionLabel, c); ok {
+		return key, true
+	}
+	if c.Operator != filter.Equal {
+		return "", false
+	}
+	switch {
+	case strings.EqualFold(c.Field, "serviceName"):
+		return CreateKey(DeviceCollectionServiceName, c.Value), true
+	case strings.EqualFold(c.Field, "profileName"):
+		return CreateKey(DeviceCollectionProfileName, c.Value), true
+	}
+	for _, index := range indexesOf(db.IndexCollectionDevice) {
+		if strings.EqualFold(index.path, c.Field) {
+			return index.key(c.Value), true
+		}
+	}
+	return "", false
+}
+
+// deviceProfileFilterIndex answers the comparisons on the labels, the manufacturer and the model
+func deviceProfileFilterIndex(c filter.Comparison) (string, bool) {
+	if key, ok := labelsFilterIndex(DeviceProfileCollectionLabel, c); ok {
+		return key, true
+	}
+	if c.Operator != filter.Equal {
+		return "", false
+	}
+	switch {
+	case strings.EqualFold(c.Field, "manufacturer"):
+		return CreateKey(DeviceProfileCollectionManufacturer, c.Value), true
+	case strings.EqualFold(c.Field, "model"):
+		return CreateKey(DeviceProfileCollectionModel, c.Value), true
+	}
+	return "", false
+}
+
+// deviceServiceFilterIndex answers the comparisons on the labels
+func deviceServiceFilterIndex(c filter.Comparison) (string, bool) {
+	return labelsFilterIndex(DeviceServiceCollectionLabel, c)
+}
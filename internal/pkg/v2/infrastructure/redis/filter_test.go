@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceFilterIndex(t *testing.T) {
+	indexes, err := newSecondaryIndexes(db.IndexCollectionDevice, map[string]db.IndexInfo{
+		"Address": {Path: "protocols.modbus-tcp.Address"},
+	})
+	require.NoError(t, err)
+	secondaryIndexes = indexes
+	defer func() { secondaryIndexes = nil }()
+
+	tests := []struct {
+		name        string
+		comparison  filter.Comparison
+		expectedKey string
+	}{
+		{"Label", filter.Comparison{Field: "Labels", Operator: filter.Contains, Value: "hvac"}, "md|dv:label:hvac"},
+		{"Service name", filter.Comparison{Field: "serviceName", Operator: filter.Equal, Value: "ds"}, "md|dv:service:name:ds"},
+		{"Profile name", filter.Comparison{Field: "profileName", Operator: filter.Equal, Value: "dp"}, "md|dv:profile:name:dp"},
+		{"Declared index", filter.Comparison{Field: "protocols.modbus-tcp.address", Operator: filter.Equal, Value: "10.0.0.1"}, "md|dv:index:Address:10.0.0.1"},
+		{"Not indexed - label equality", filter.Comparison{Field: "labels", Operator: filter.NotEqual, Value: "hvac"}, ""},
+		{"Not indexed - service name substring", filter.Comparison{Field: "serviceName", Operator: filter.Contains, Value: "ds"}, ""},
+		{"Not indexed - field", filter.Comparison{Field: "operatingState", Operator: filter.Equal, Value: "UP"}, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			key, ok := deviceFilterIndex(testCase.comparison)
+			assert.Equal(t, testCase.expectedKey != "", ok)
+			assert.Equal(t, testCase.expectedKey, key)
+		})
+	}
+}
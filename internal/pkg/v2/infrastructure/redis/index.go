@@ -0,0 +1,304 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// indexBuildBatchSize is the number of objects read at once while building an index
+const indexBuildBatchSize = 1000
+
+// secondaryIndexes are the indexes declared in the configuration, maintained by the helpers writing the objects of
+// their collection. Like the payload cipher, they are set once when the client is created.
+var secondaryIndexes []secondaryIndex
+
+// indexedCollection is the collection written by the service the indexes are declared on. Only its indexes are built
+// and dropped, since the indexes of the other collection are declared by the service writing it.
+var indexedCollection string
+
+// secondaryIndex is a declared index, each value of the indexed field has a sorted set of the stored keys of the
+// objects holding it, scored like the collection of the objects
+type secondaryIndex struct {
+	name       string
+	collection string
+	path       string
+}
+
+func newSecondaryIndexes(collection string, infos map[string]db.IndexInfo) ([]secondaryIndex, error) {
+	if len(infos) > 0 && collection != db.IndexCollectionDevice && collection != db.IndexCollectionEvent {
+		return nil, fmt.Errorf("indexes can't be declared on collection '%s'", collection)
+	}
+	if err := db.ValidateIndexes(infos); err != nil {
+		return nil, err
+	}
+	indexes := make([]secondaryIndex, 0, len(infos))
+	for name, info := range infos {
+		indexes = append(indexes, secondaryIndex{name: name, collection: collection, path: info.Path})
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].name < indexes[j].name
+	})
+	return indexes, nil
+}
+
+// indexesOf returns the declared indexes of the collection
+func indexesOf(collection string) []secondaryIndex {
+	var indexes []secondaryIndex
+	for _, index := range secondaryIndexes {
+		if index.collection == collection {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// indexCollectionKey returns the key of the sorted set enumerating the objects of the collection
+func indexCollectionKey(collection string) string {
+	if collection == db.IndexCollectionEvent {
+		return EventsCollection
+	}
+	return DeviceCollection
+}
+
+// indexDefinitionsKey returns the key of the hash recording the path, by index name, of the indexes built on the
+// collection
+func indexDefinitionsKey(collection string) string {
+	return CreateKey(indexCollectionKey(collection), "index")
+}
+
+// key returns the key of the sorted set of the objects holding the value
+func (i secondaryIndex) key(value string) string {
+	return CreateKey(indexCollectionKey(i.collection), "index", i.name, value)
+}
+
+// values returns the values of the indexed field of the object
+func (i secondaryIndex) values(object interface{}) ([]string, error) {
+	var generic interface{}
+	switch o := object.(type) {
+	case []byte:
+		if err := unmarshalPayload(o, &generic); err != nil {
+			return nil, err
+		}
+	default:
+		b, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(b, &generic); err != nil {
+			return nil, err
+		}
+	}
+	values := indexValues(generic, strings.Split(i.path, "."))
+
+	// an object holding a value several times is indexed once
+	sort.Strings(values)
+	unique := values[:0]
+	for j, v := range values {
+		if j == 0 || v != values[j-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique, nil
+}
+
+// indexValues walks the path down the JSON value. Field names are matched regardless of their case, since the models
+// are stored with their Go field names.
+func indexValues(v interface{}, path []string) []string {
+	if array, ok := v.([]interface{}); ok {
+		var values []string
+		for _, element := range array {
+			values = append(values, indexValues(element, path)...)
+		}
+		return values
+	}
+	if len(path) == 0 {
+		switch s := v.(type) {
+		case string:
+			return []string{s}
+		case float64:
+			return []string{strconv.FormatFloat(s, 'f', -1, 64)}
+		case bool:
+			return []string{strconv.FormatBool(s)}
+		}
+		return nil
+	}
+
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if path[0] == "*" {
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		return keys
+	}
+	if field, ok := object[path[0]]; ok {
+		return indexValues(field, path[1:])
+	}
+	for key, field := range object {
+		if strings.EqualFold(key, path[0]) {
+			return indexValues(field, path[1:])
+		}
+	}
+	return nil
+}
+
+// indexKeys returns the keys of the sorted sets of the declared indexes of the collection the object belongs to, so
+// that the object can be added to or removed from them in the transaction writing it
+func indexKeys(collection string, object interface{}) ([]string, errors.EdgeX) {
+	var keys []string
+	for _, index := range indexesOf(collection) {
+		values, err := index.values(object)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to index the object with %s", index.name), err)
+		}
+		for _, value := range values {
+			keys = append(keys, index.key(value))
+		}
+	}
+	return keys, nil
+}
+
+// objectsByIndex returns the objects of the collection holding the value of the named index, newest first
+func objectsByIndex(conn redis.Conn, collection string, name string, value string, offset int, limit int) ([][]byte, errors.EdgeX) {
+	for _, index := range indexesOf(collection) {
+		if index.name != name {
+			continue
+		}
+		end := offset + limit - 1
+		if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
+			end = limit
+		}
+		objects, edgeXerr := getObjectsByRevRange(conn, index.key(value), offset, end)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		return objects, nil
+	}
+	return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no %s index named %s is declared", collection, name), nil)
+}
+
+// buildIndexes builds the declared indexes which weren't built with their current path, and drops the indexes of the
+// collection which aren't declared anymore. Building an index reads every object of its collection, so it's only done
+// once.
+func buildIndexes(conn redis.Conn, collection string) ([]string, errors.EdgeX) {
+	var built []string
+	if collection == "" {
+		return built, nil
+	}
+	definitions, err := redis.StringMap(conn.Do(HGETALL, indexDefinitionsKey(collection)))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to read the index definitions", err)
+	}
+	declared := indexesOf(collection)
+	for name := range definitions {
+		dropped := true
+		for _, index := range declared {
+			dropped = dropped && index.name != name
+		}
+		if dropped {
+			if edgeXerr := dropIndex(conn, secondaryIndex{name: name, collection: collection}); edgeXerr != nil {
+				return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+		}
+	}
+	for _, index := range declared {
+		if path, ok := definitions[index.name]; ok && path == index.path {
+			continue
+		}
+		if edgeXerr := buildIndex(conn, index); edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		built = append(built, index.name)
+	}
+	return built, nil
+}
+
+// dropIndex deletes the sorted sets and the definition of the index
+func dropIndex(conn redis.Conn, index secondaryIndex) errors.EdgeX {
+	keys, edgeXerr := scanKeys(conn, index.key("*"))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_ = conn.Send(MULTI)
+	for _, key := range keys {
+		_ = conn.Send(UNLINK, key)
+	}
+	_ = conn.Send(HDEL, indexDefinitionsKey(index.collection), index.name)
+	if _, err := conn.Do(EXEC); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to drop index %s", index.name), err)
+	}
+	return nil
+}
+
+// buildIndex rebuilds the index from every object of its collection, then records its definition
+func buildIndex(conn redis.Conn, index secondaryIndex) errors.EdgeX {
+	if edgeXerr := dropIndex(conn, index); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	collectionKey := indexCollectionKey(index.collection)
+	for start := 0; ; start += indexBuildBatchSize {
+		members, err := redis.Values(conn.Do(ZRANGE, collectionKey, start, start+indexBuildBatchSize-1, WITHSCORES))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to read the %s collection", index.collection), err)
+		}
+		if len(members) == 0 {
+			break
+		}
+		var scored []struct {
+			StoredKey string
+			Score     int64
+		}
+		if err = redis.ScanSlice(members, &scored); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "collection reply parsing failed", err)
+		}
+		storedKeys := make([]interface{}, len(scored))
+		for i, s := range scored {
+			storedKeys[i] = s.StoredKey
+		}
+		objects, err := redis.ByteSlices(conn.Do(MGET, storedKeys...))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to read the %s objects", index.collection), err)
+		}
+
+		_ = conn.Send(MULTI)
+		for i, object := range objects {
+			if object == nil {
+				continue
+			}
+			values, err := index.values(object)
+			if err != nil {
+				_, _ = conn.Do(DISCARD)
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to index %s with %s", scored[i].StoredKey, index.name), err)
+			}
+			for _, value := range values {
+				_ = conn.Send(ZADD, index.key(value), scored[i].Score, scored[i].StoredKey)
+			}
+		}
+		if _, err = conn.Do(EXEC); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to build index %s", index.name), err)
+		}
+	}
+
+	if _, err := conn.Do(HSET, indexDefinitionsKey(index.collection), index.name, index.path); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to record index %s", index.name), err)
+	}
+	return nil
+}
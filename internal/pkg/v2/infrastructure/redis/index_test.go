@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	model "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSecondaryIndexes(t *testing.T) {
+	tests := []struct {
+		name          string
+		collection    string
+		indexes       map[string]db.IndexInfo
+		errorExpected bool
+	}{
+		{"Valid", db.IndexCollectionDevice, map[string]db.IndexInfo{"Protocol": {Path: "protocols.*"}}, false},
+		{"Valid - no index", "", nil, false},
+		{"Invalid - unknown collection", "reading", map[string]db.IndexInfo{"Protocol": {Path: "protocols.*"}}, true},
+		{"Invalid - index name", db.IndexCollectionDevice, map[string]db.IndexInfo{"by:protocol": {Path: "protocols.*"}}, true},
+		{"Invalid - empty path", db.IndexCollectionEvent, map[string]db.IndexInfo{"Tag": {Path: ""}}, true},
+		{"Invalid - wildcard not last", db.IndexCollectionEvent, map[string]db.IndexInfo{"Tag": {Path: "tags.*.name"}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			indexes, err := newSecondaryIndexes(testCase.collection, testCase.indexes)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, indexes, len(testCase.indexes))
+		})
+	}
+}
+
+func TestIndexKeys(t *testing.T) {
+	indexes, err := newSecondaryIndexes(db.IndexCollectionDevice, map[string]db.IndexInfo{
+		"Protocol": {Path: "protocols.*"},
+		"Label":    {Path: "labels"},
+		"Address":  {Path: "protocols.modbus-tcp.Address"},
+	})
+	require.NoError(t, err)
+	secondaryIndexes = indexes
+	defer func() { secondaryIndexes = nil }()
+
+	device := model.Device{
+		Name:   "test-device",
+		Labels: []string{"floor-1", "hvac", "floor-1"},
+		Protocols: map[string]model.ProtocolProperties{
+			"modbus-tcp": {"Address": "10.0.0.1"},
+			"other":      {},
+		},
+	}
+	keys, edgeXerr := indexKeys(db.IndexCollectionDevice, device)
+	require.NoError(t, edgeXerr)
+	assert.ElementsMatch(t, []string{
+		CreateKey(DeviceCollection, "index", "Address", "10.0.0.1"),
+		CreateKey(DeviceCollection, "index", "Label", "floor-1"),
+		CreateKey(DeviceCollection, "index", "Label", "hvac"),
+		CreateKey(DeviceCollection, "index", "Protocol", "modbus-tcp"),
+		CreateKey(DeviceCollection, "index", "Protocol", "other"),
+	}, keys)
+
+	// the indexes of the other collection don't apply
+	keys, edgeXerr = indexKeys(db.IndexCollectionEvent, model.Event{Tags: map[string]string{"site": "a"}})
+	require.NoError(t, edgeXerr)
+	assert.Empty(t, keys)
+}
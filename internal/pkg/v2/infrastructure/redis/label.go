@@ -0,0 +1,273 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// labelScanCount is the number of keys hinted to every SCAN iteration when looking up the label sorted sets
+const labelScanCount = 1000
+
+// labelUsage counts the devices, device profiles and device services carrying each label, sorted by label. The
+// counts are taken from the label sorted sets, which Redis removes once they are empty.
+func labelUsage(conn redis.Conn) ([]pkgModels.LabelUsage, errors.EdgeX) {
+	usage := make(map[string]*pkgModels.LabelUsage)
+	counters := []struct {
+		labelKey string
+		count    func(u *pkgModels.LabelUsage) *int64
+	}{
+		{DeviceCollectionLabel, func(u *pkgModels.LabelUsage) *int64 { return &u.Devices }},
+		{DeviceProfileCollectionLabel, func(u *pkgModels.LabelUsage) *int64 { return &u.DeviceProfiles }},
+		{DeviceServiceCollectionLabel, func(u *pkgModels.LabelUsage) *int64 { return &u.DeviceServices }},
+	}
+	for _, counter := range counters {
+		prefix := counter.labelKey + DBKeySeparator
+		keys, edgeXerr := scanKeys(conn, prefix+"*")
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, key := range keys {
+			count, err := redis.Int64(conn.Do(ZCARD, key))
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the number of members of %s failed", key), err)
+			}
+			label := strings.TrimPrefix(key, prefix)
+			if _, ok := usage[label]; !ok {
+				usage[label] = &pkgModels.LabelUsage{Label: label}
+			}
+			*counter.count(usage[label]) = count
+		}
+	}
+
+	result := make([]pkgModels.LabelUsage, 0, len(usage))
+	for _, u := range usage {
+		result = append(result, *u)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Label < result[j].Label })
+	return result, nil
+}
+
+// scanKeys iterates the keyspace with SCAN and returns the keys matching the pattern
+func scanKeys(conn redis.Conn, pattern string) ([]string, errors.EdgeX) {
+	var keys []string
+	cursor := 0
+	for {
+		values, err := redis.Values(conn.Do(SCAN, cursor, MATCH, pattern, COUNT, labelScanCount))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("scan keys matching %s failed", pattern), err)
+		}
+		var batch []string
+		if _, err = redis.Scan(values, &cursor, &batch); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "scan reply parsing failed", err)
+		}
+		keys = append(keys, batch...)
+		if cursor == 0 {
+			return keys, nil
+		}
+	}
+}
+
+// renameLabel replaces the label from with the label to on every device, device profile and device service in a single
+// transaction and returns the number of renamed objects. The label sorted sets of from are watched, so the rename
+// fails instead of overwriting an object changed concurrently.
+func renameLabel(conn redis.Conn, from string, to string) (int, errors.EdgeX) {
+	deviceKey := CreateKey(DeviceCollectionLabel, from)
+	profileKey := CreateKey(DeviceProfileCollectionLabel, from)
+	serviceKey := CreateKey(DeviceServiceCollectionLabel, from)
+	if _, err := conn.Do(WATCH, deviceKey, profileKey, serviceKey); err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("watch label %s failed", from), err)
+	}
+	defer func() { _, _ = conn.Do(UNWATCH) }()
+
+	devices, edgeXerr := labelledObjects(conn, deviceKey)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	profiles, edgeXerr := labelledObjects(conn, profileKey)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	services, edgeXerr := labelledObjects(conn, serviceKey)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	renamed := len(devices) + len(profiles) + len(services)
+	if renamed == 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("label %s is not in use", from), nil)
+	}
+
+	// the renamed objects are modified, so they are moved up in the sorted sets ordered by Modified
+	ts := common.MakeTimestamp()
+	renames := make([]labelRename, 0, renamed)
+	// the YAML files the renamed device profiles were uploaded from don't match them anymore
+	var profileNames []interface{}
+	for _, object := range devices {
+		var d models.Device
+		if err := json.Unmarshal(object, &d); err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "device format parsing failed from the database", err)
+		}
+		unindexed, edgeXerr := indexKeys(db.IndexCollectionDevice, d)
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		d.Labels = replaceLabel(d.Labels, from, to)
+		d.Modified = ts
+		indexed, edgeXerr := indexKeys(db.IndexCollectionDevice, d)
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		rename, edgeXerr := newLabelRename(d, deviceStoredKey(d.Id), DeviceCollectionLabel, d.Labels, deviceKey,
+			append([]string{
+				DeviceCollection,
+				CreateKey(DeviceCollectionServiceName, d.ServiceName),
+				CreateKey(DeviceCollectionProfileName, d.ProfileName),
+			}, indexed...)...)
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		// the declared indexes may hold the labels, the device is removed from those of the replaced label
+		rename.unindexed = keysNotIn(unindexed, indexed)
+		renames = append(renames, rename)
+	}
+	for _, object := range profiles {
+		var dp models.DeviceProfile
+		if err := json.Unmarshal(object, &dp); err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "device profile format parsing failed from the database", err)
+		}
+		dp.Labels = replaceLabel(dp.Labels, from, to)
+		dp.Modified = ts
+		rename, edgeXerr := newLabelRename(dp, deviceProfileStoredKey(dp.Id), DeviceProfileCollectionLabel, dp.Labels, profileKey,
+			DeviceProfileCollection,
+			CreateKey(DeviceProfileCollectionManufacturer, dp.Manufacturer),
+			CreateKey(DeviceProfileCollectionModel, dp.Model))
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		renames = append(renames, rename)
+		profileNames = append(profileNames, dp.Name)
+	}
+	for _, object := range services {
+		var ds models.DeviceService
+		if err := json.Unmarshal(object, &ds); err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "device service format parsing failed from the database", err)
+		}
+		ds.Labels = replaceLabel(ds.Labels, from, to)
+		ds.Modified = ts
+		rename, edgeXerr := newLabelRename(ds, deviceServiceStoredKey(ds.Id), DeviceServiceCollectionLabel, ds.Labels, serviceKey,
+			DeviceServiceCollection)
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		renames = append(renames, rename)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, rename := range renames {
+		_ = conn.Send(SET, rename.storedKey, rename.object)
+		for _, index := range rename.indexes {
+			_ = conn.Send(ZADD, index, ts, rename.storedKey)
+		}
+		_ = conn.Send(ZREM, rename.fromKey, rename.storedKey)
+		for _, key := range rename.unindexed {
+			_ = conn.Send(ZREM, key, rename.storedKey)
+		}
+		for _, label := range rename.labels {
+			_ = conn.Send(ZADD, CreateKey(rename.labelCollection, label), ts, rename.storedKey)
+		}
+	}
+	if len(profileNames) > 0 {
+		_ = conn.Send(HDEL, append([]interface{}{DeviceProfileCollectionYaml}, profileNames...)...)
+	}
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("label %s rename failed", from), err)
+	} else if reply == nil {
+		// EXEC replies nil when a watched key was changed, the transaction is discarded then
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("label %s was changed during the rename, please retry", from), nil)
+	}
+	return renamed, nil
+}
+
+// labelledObjects query all objects enumerated in the label sorted set
+func labelledObjects(conn redis.Conn, labelKey string) ([][]byte, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, labelKey, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objects, nil
+}
+
+// labelRename holds the renamed object and the sorted sets to update for it. The indexes are the other sorted sets
+// enumerating the object, which are rescored with the new Modified.
+type labelRename struct {
+	storedKey       string
+	object          []byte
+	labelCollection string
+	labels          []string
+	fromKey         string
+	indexes         []string
+	unindexed       []string
+}
+
+func newLabelRename(object interface{}, storedKey string, labelCollection string, labels []string, fromKey string, indexes ...string) (labelRename, errors.EdgeX) {
+	m, err := json.Marshal(object)
+	if err != nil {
+		return labelRename{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal object for Redis persistence", err)
+	}
+	return labelRename{
+		storedKey:       storedKey,
+		object:          m,
+		labelCollection: labelCollection,
+		labels:          labels,
+		fromKey:         fromKey,
+		indexes:         indexes,
+	}, nil
+}
+
+// replaceLabel returns the labels with from replaced by to, dropping to when the labels already carried it
+func replaceLabel(labels []string, from string, to string) []string {
+	result := make([]string, 0, len(labels))
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		if label == from {
+			label = to
+		}
+		if seen[label] {
+			continue
+		}
+		seen[label] = true
+		result = append(result, label)
+	}
+	return result
+}
+
+// keysNotIn returns the keys which aren't in others
+func keysNotIn(keys []string, others []string) []string {
+	var result []string
+	for _, key := range keys {
+		found := false
+		for _, other := range others {
+			found = found || key == other
+		}
+		if !found {
+			result = append(result, key)
+		}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// Fields of the INFO memory section
+const (
+	infoFieldUsedMemory = "used_memory"
+	infoFieldMaxMemory  = "maxmemory"
+)
+
+// memoryUsage reports the database memory and estimates the memory used by each collection from a sample of its
+// entries.  Each collection is a sorted set whose members are the keys of the collection's entries.
+func memoryUsage(conn redis.Conn, collections []string, samples int) (usage pkgModels.MemoryUsage, edgeXerr errors.EdgeX) {
+	info, err := redis.String(conn.Do(INFO, "memory"))
+	if err != nil {
+		return usage, errors.NewCommonEdgeX(errors.KindDatabaseError, "query memory info failed", err)
+	}
+	fields := parseInfo(info)
+	usage.UsedMemory = fields[infoFieldUsedMemory]
+	usage.MaxMemory = fields[infoFieldMaxMemory]
+
+	usage.Collections = make([]pkgModels.CollectionMemoryUsage, len(collections))
+	for i, collection := range collections {
+		usage.Collections[i], edgeXerr = collectionMemoryUsage(conn, collection, samples)
+		if edgeXerr != nil {
+			return usage, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	return usage, nil
+}
+
+// collectionMemoryUsage measures up to samples entries spread evenly over the collection and extrapolates their
+// average size to the whole collection.
+func collectionMemoryUsage(conn redis.Conn, collection string, samples int) (usage pkgModels.CollectionMemoryUsage, edgeXerr errors.EdgeX) {
+	usage.Name = collection
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(ZCARD, collection)
+	_ = conn.Send(MEMORY, USAGE, collection)
+	replies, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return usage, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query memory usage of %s failed", collection), err)
+	}
+	usage.Count, err = redis.Int64(replies[0], nil)
+	if err != nil {
+		return usage, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query size of %s failed", collection), err)
+	}
+	// MEMORY USAGE returns nil when the collection doesn't exist
+	usage.IndexBytes, _ = redis.Int64(replies[1], nil)
+
+	if usage.Count == 0 || samples <= 0 {
+		usage.EstimatedBytes = usage.IndexBytes
+		return usage, nil
+	}
+	if int64(samples) > usage.Count {
+		samples = int(usage.Count)
+	}
+
+	_ = conn.Send(MULTI)
+	for i := 0; i < samples; i++ {
+		index := int64(i) * usage.Count / int64(samples)
+		_ = conn.Send(ZRANGE, collection, index, index)
+	}
+	replies, err = redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return usage, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query sample keys of %s failed", collection), err)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, reply := range replies {
+		keys, _ := redis.Strings(reply, nil)
+		for _, key := range keys {
+			_ = conn.Send(MEMORY, USAGE, key)
+		}
+	}
+	replies, err = redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return usage, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query memory usage of %s entries failed", collection), err)
+	}
+
+	var total int64
+	for _, reply := range replies {
+		// Entries removed since their key was sampled return nil and are left out of the average
+		if size, err := redis.Int64(reply, nil); err == nil {
+			total += size
+			usage.Sampled++
+		}
+	}
+	if usage.Sampled > 0 {
+		usage.AverageBytes = total / int64(usage.Sampled)
+	}
+	usage.EstimatedBytes = usage.AverageBytes*usage.Count + usage.IndexBytes
+
+	return usage, nil
+}
+
+// parseInfo returns the integer fields of an INFO reply
+func parseInfo(info string) map[string]int64 {
+	fields := make(map[string]int64)
+	for name, raw := range infoFields(info) {
+		if value, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			fields[name] = value
+		}
+	}
+	return fields
+}
+
+// infoFields returns the fields of an INFO reply
+func infoFields(info string) map[string]string {
+	fields := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(info))
+	for scanner.Scan() {
+		parts := strings.SplitN(strings.TrimSpace(scanner.Text()), ":", 2)
+		if len(parts) == 2 {
+			fields[parts[0]] = parts[1]
+		}
+	}
+	return fields
+}
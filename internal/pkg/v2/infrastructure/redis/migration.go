@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+)
+
+const (
+	// SchemaVersionKey holds the version of the last migration applied to the keyspace
+	SchemaVersionKey = "edgex|schema" + DBKeySeparator + "version"
+	// SchemaMigrationLockKey is held by the service migrating the keyspace, so services sharing the database don't
+	// migrate it concurrently
+	SchemaMigrationLockKey = "edgex|schema" + DBKeySeparator + "lock"
+	// schemaMigrationLockTTL releases the lock of a service which stopped while migrating
+	schemaMigrationLockTTL = 5 * time.Minute
+)
+
+// migration changes the key layout of the keyspace from the previous schema version to version. migrate returns the
+// number of keys it changed, or would change when dryRun is true, in which case nothing must be written.
+type migration struct {
+	version     int
+	description string
+	migrate     func(conn redis.Conn, dryRun bool) (int, errors.EdgeX)
+}
+
+// migrations are applied in order on startup, a new migration is appended with the next version. Released
+// migrations must never be changed or removed as databases record the version they are migrated to.
+var migrations = []migration{
+	{1, "score the device collection by Modified", scoreDeviceCollectionByModified},
+}
+
+// LatestSchemaVersion is the schema version of a keyspace with all migrations applied
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// migrate applies the migrations newer than the schema version of the keyspace and records the version after each
+// migration, so a failed migration is retried on the next start. With dryRun the pending migrations are only reported.
+func migrate(conn redis.Conn, dryRun bool, report func(m migration, affected int)) errors.EdgeX {
+	if !dryRun {
+		owner := uuid.New().String()
+		_, err := redis.String(conn.Do(SET, SchemaMigrationLockKey, owner, "NX", "PX", schemaMigrationLockTTL.Milliseconds()))
+		if err == redis.ErrNil {
+			return errors.NewCommonEdgeX(errors.KindServiceLocked, "the database schema is being migrated by another service", nil)
+		} else if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "database schema migration lock failed", err)
+		}
+		defer func() { _, _ = conn.Do(DEL, SchemaMigrationLockKey) }()
+	}
+
+	current, edgeXerr := schemaVersion(conn)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if current > LatestSchemaVersion() {
+		return errors.NewCommonEdgeX(errors.KindServerError,
+			fmt.Sprintf("database schema version %d is newer than the latest known version %d", current, LatestSchemaVersion()), nil)
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		affected, edgeXerr := m.migrate(conn, dryRun)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("database schema migration to version %d failed", m.version), edgeXerr)
+		}
+		if !dryRun {
+			if _, err := conn.Do(SET, SchemaVersionKey, m.version); err != nil {
+				return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("database schema version %d recording failed", m.version), err)
+			}
+		}
+		report(m, affected)
+	}
+	return nil
+}
+
+// schemaVersion query the schema version of the keyspace, a keyspace without version was never migrated
+func schemaVersion(conn redis.Conn) (int, errors.EdgeX) {
+	version, err := redis.Int(conn.Do(GET, SchemaVersionKey))
+	if err == redis.ErrNil {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "query database schema version failed", err)
+	}
+	return version, nil
+}
+
+// scoreDeviceCollectionByModified rescores the devices added with score 0 by their Modified timestamp, which the
+// device collection is sorted and filtered by since devices can be queried by modification time
+func scoreDeviceCollectionByModified(conn redis.Conn, dryRun bool) (int, errors.EdgeX) {
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, DeviceCollection, 0, 0))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "query unscored devices failed", err)
+	}
+	if dryRun || len(storedKeys) == 0 {
+		return len(storedKeys), nil
+	}
+
+	args := redis.Args{}.AddFlat(storedKeys)
+	objects, err := redis.ByteSlices(conn.Do(MGET, args...))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "query unscored devices failed", err)
+	}
+	scores := make([]int64, len(objects))
+	for i, object := range objects {
+		if object == nil { // the device was deleted meanwhile
+			continue
+		}
+		var d struct{ Modified int64 }
+		if err := json.Unmarshal(object, &d); err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		scores[i] = d.Modified
+	}
+
+	_ = conn.Send(MULTI)
+	for i, object := range objects {
+		if object != nil {
+			_ = conn.Send(ZADD, DeviceCollection, scores[i], storedKeys[i])
+		}
+	}
+	if _, err = conn.Do(EXEC); err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "device rescoring failed", err)
+	}
+	return len(storedKeys), nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ProfileCatalogCollection prefixes the hash holding the device profile updates pulled from the profile catalog by
+// profile name, and the hash holding the catalog versions applied by profile name
+const ProfileCatalogCollection = "md|pc"
+
+var (
+	profileCatalogUpdatesKey  = CreateKey(ProfileCatalogCollection, "update")
+	profileCatalogVersionsKey = CreateKey(ProfileCatalogCollection, "version")
+)
+
+// setProfileCatalogUpdate stores the update, replacing the update of the device profile pulled before
+func setProfileCatalogUpdate(conn redis.Conn, u pkgModels.ProfileCatalogUpdate) errors.EdgeX {
+	value, err := json.Marshal(u)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal profile catalog update for Redis persistence", err)
+	}
+	if _, err = conn.Do(HSET, profileCatalogUpdatesKey, u.ProfileName, value); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("profile catalog update of %s setting failed", u.ProfileName), err)
+	}
+	return nil
+}
+
+// profileCatalogUpdateByName query the update of the device profile from DB
+func profileCatalogUpdateByName(conn redis.Conn, name string) (pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+	var u pkgModels.ProfileCatalogUpdate
+	value, err := redis.Bytes(conn.Do(HGET, profileCatalogUpdatesKey, name))
+	if err == redis.ErrNil {
+		return u, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("profile catalog update of %s doesn't exist in the database", name), err)
+	} else if err != nil {
+		return u, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query profile catalog update of %s from the database failed", name), err)
+	}
+	if err = json.Unmarshal(value, &u); err != nil {
+		return u, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile catalog update parsing failed from the database", err)
+	}
+	return u, nil
+}
+
+// allProfileCatalogUpdates query the updates of all device profiles, sorted by profile name
+func allProfileCatalogUpdates(conn redis.Conn) ([]pkgModels.ProfileCatalogUpdate, errors.EdgeX) {
+	values, err := redis.ByteSlices(conn.Do(HVALS, profileCatalogUpdatesKey))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query profile catalog updates from the database failed", err)
+	}
+	updates := make([]pkgModels.ProfileCatalogUpdate, len(values))
+	for i, value := range values {
+		if err = json.Unmarshal(value, &updates[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile catalog update parsing failed from the database", err)
+		}
+	}
+	sort.Slice(updates, func(i, j int) bool { return updates[i].ProfileName < updates[j].ProfileName })
+	return updates, nil
+}
+
+// deleteProfileCatalogUpdateByName deletes the update of the device profile
+func deleteProfileCatalogUpdateByName(conn redis.Conn, name string) errors.EdgeX {
+	count, err := redis.Int(conn.Do(HDEL, profileCatalogUpdatesKey, name))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("profile catalog update of %s deletion failed", name), err)
+	}
+	if count == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("profile catalog update of %s doesn't exist in the database", name), nil)
+	}
+	return nil
+}
+
+// setProfileCatalogVersion records the catalog version of the device profile applied
+func setProfileCatalogVersion(conn redis.Conn, name string, version string) errors.EdgeX {
+	if _, err := conn.Do(HSET, profileCatalogVersionsKey, name, version); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("profile catalog version of %s setting failed", name), err)
+	}
+	return nil
+}
+
+// profileCatalogVersions query the catalog versions applied by device profile name
+func profileCatalogVersions(conn redis.Conn) (map[string]string, errors.EdgeX) {
+	versions, err := redis.StringMap(conn.Do(HGETALL, profileCatalogVersionsKey))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query profile catalog versions from the database failed", err)
+	}
+	return versions, nil
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ProtocolSchemaCollection is the hash holding the JSON Schema of each protocol by protocol name
+const ProtocolSchemaCollection = "md|ps"
+
+// setProtocolSchema stores the schema of the protocol, replacing the schema registered before
+func setProtocolSchema(conn redis.Conn, name string, schema []byte) errors.EdgeX {
+	_, err := conn.Do(HSET, ProtocolSchemaCollection, name, schema)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("protocol schema %s registration failed", name), err)
+	}
+	return nil
+}
+
+// protocolSchemaByName query the schema of the protocol from DB
+func protocolSchemaByName(conn redis.Conn, name string) ([]byte, errors.EdgeX) {
+	schema, err := redis.Bytes(conn.Do(HGET, ProtocolSchemaCollection, name))
+	if err == redis.ErrNil {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("protocol schema %s doesn't exist in the database", name), err)
+	} else if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query protocol schema %s from the database failed", name), err)
+	}
+	return schema, nil
+}
+
+// allProtocolSchemas query the schemas of all protocols from DB
+func allProtocolSchemas(conn redis.Conn) (map[string][]byte, errors.EdgeX) {
+	values, err := redis.Values(conn.Do(HGETALL, ProtocolSchemaCollection))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query protocol schemas from the database failed", err)
+	}
+
+	schemas := make(map[string][]byte, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		name, err := redis.String(values[i], nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "protocol schema name parsing failed from the database", err)
+		}
+		schema, err := redis.Bytes(values[i+1], nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "protocol schema parsing failed from the database", err)
+		}
+		schemas[name] = schema
+	}
+	return schemas, nil
+}
+
+// deleteProtocolSchemaByName deletes the schema of the protocol
+func deleteProtocolSchemaByName(conn redis.Conn, name string) errors.EdgeX {
+	deleted, err := redis.Int(conn.Do(HDEL, ProtocolSchemaCollection, name))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("protocol schema %s deletion failed", name), err)
+	} else if deleted == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("protocol schema %s doesn't exist in the database", name), nil)
+	}
+	return nil
+}
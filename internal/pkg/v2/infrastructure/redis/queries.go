@@ -6,7 +6,6 @@
 package redis
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
@@ -24,7 +23,7 @@ func getObjectById(conn redis.Conn, id string, out interface{}) errors.EdgeX {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query object %T by id from the database failed", out), err)
 	}
 
-	err = json.Unmarshal(obj, out)
+	err = unmarshalPayload(obj, out)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("object %T format parsing failed from the database", out), err)
 	}
@@ -92,6 +91,24 @@ func getObjectsByScoreRange(conn redis.Conn, key string, start int, end int, off
 	return getObjectsByIds(conn, common.ConvertStringsToInterfaces(objIds))
 }
 
+// getObjectsByMinScore query objects by offset and limit whose score in the specified sorted set is at least min, in
+// descending score order.
+func getObjectsByMinScore(conn redis.Conn, key string, min int, offset int, limit int) (objects [][]byte, edgeXerr errors.EdgeX) {
+	count, err := redis.Int(conn.Do(ZCOUNT, key, min, InfiniteMax))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("count objects under %s from database failed", key), err)
+	} else if count == 0 { // return nil slice when there is no records satisfied with the min score in the DB
+		return nil, nil
+	} else if offset >= count { // return RangeNotSatisfiable error when offset is out of range
+		return nil, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v offset:%v", count, offset), nil)
+	}
+	objIds, err := redis.Strings(conn.Do(ZREVRANGEBYSCORE, key, InfiniteMax, min, LIMIT, offset, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+	}
+	return getObjectsByIds(conn, common.ConvertStringsToInterfaces(objIds))
+}
+
 // getObjectsByLabelsAndSomeRange retrieves the entries for keys enumerated in a sorted set using the specified Redis range
 // command (i.e. RANGE, REVRANGE). The entries are retrieved in the order specified by the supplied Redis command.
 func getObjectsByLabelsAndSomeRange(conn redis.Conn, command string, key string, labels []string, start int, end int) ([][]byte, errors.EdgeX) {
@@ -6,7 +6,6 @@
 package redis
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/common"
@@ -47,17 +46,12 @@ func (c *Client) asyncDeleteReadingsByIds(readingIds []string) {
 	r := models.BaseReading{}
 	_ = conn.Send(MULTI)
 	for i, reading := range readings {
-		err := json.Unmarshal(reading, &r)
+		err := unmarshalPayload(reading, &r)
 		if err != nil {
 			c.loggingClient.Error(fmt.Sprintf("unable to marshal reading.  Err: %s", err.Error()))
 			continue
 		}
-		storedKey := readingStoredKey(r.Id)
-		_ = conn.Send(UNLINK, storedKey)
-		_ = conn.Send(ZREM, ReadingsCollection, storedKey)
-		_ = conn.Send(ZREM, ReadingsCollectionCreated, storedKey)
-		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
-		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
+		sendDeleteReading(conn, r, len(reading))
 		queriesInQueue++
 
 		if queriesInQueue >= c.BatchSize {
@@ -102,14 +96,14 @@ func addReading(conn redis.Conn, r models.Reading) (reading models.Reading, edge
 		if err = checkReadingValue(baseReading); err != nil {
 			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
-		m, err = json.Marshal(newReading)
+		m, err = marshalPayload(newReading)
 		reading = newReading
 	case models.SimpleReading:
 		baseReading = &newReading.BaseReading
 		if err = checkReadingValue(baseReading); err != nil {
 			return nil, errors.NewCommonEdgeXWrapper(err)
 		}
-		m, err = json.Marshal(newReading)
+		m, err = marshalPayload(newReading)
 		reading = newReading
 	default:
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unsupported reading type", nil)
@@ -125,6 +119,7 @@ func addReading(conn redis.Conn, r models.Reading) (reading models.Reading, edge
 	_ = conn.Send(ZADD, ReadingsCollectionCreated, baseReading.Created, storedKey)
 	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceName, baseReading.DeviceName), baseReading.Created, storedKey)
 	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionResourceName, baseReading.ResourceName), baseReading.Created, storedKey)
+	sendAddReadingStats(conn, baseReading.DeviceName, baseReading.ResourceName, baseReading.Created, len(m))
 
 	return reading, nil
 }
@@ -133,18 +128,19 @@ func addReading(conn redis.Conn, r models.Reading) (reading models.Reading, edge
 func deleteReadingById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	r := models.BaseReading{}
 	storedKey := readingStoredKey(id)
-	edgeXerr = getObjectById(conn, storedKey, &r)
-	if edgeXerr != nil {
-		return edgeXerr
+	obj, err := redis.Bytes(conn.Do(GET, storedKey))
+	if err == redis.ErrNil {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("fail to query reading, because id: %s doesn't exist in the database", id), err)
+	} else if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query reading by id from the database failed", err)
+	}
+	if err = unmarshalPayload(obj, &r); err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "reading format parsing failed from the database", err)
 	}
 
 	_ = conn.Send(MULTI)
-	_ = conn.Send(UNLINK, storedKey)
-	_ = conn.Send(ZREM, ReadingsCollection, storedKey)
-	_ = conn.Send(ZREM, ReadingsCollectionCreated, storedKey)
-	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
-	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
-	_, err := conn.Do(EXEC)
+	sendDeleteReading(conn, r, len(obj))
+	_, err = conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("reading[id:%s] delete failed", id), err)
 	}
@@ -152,6 +148,17 @@ func deleteReadingById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	return nil
 }
 
+// sendDeleteReading queues the commands deleting the stored reading of the given size and unindexing it
+func sendDeleteReading(conn redis.Conn, r models.BaseReading, size int) {
+	storedKey := readingStoredKey(r.Id)
+	_ = conn.Send(UNLINK, storedKey)
+	_ = conn.Send(ZREM, ReadingsCollection, storedKey)
+	_ = conn.Send(ZREM, ReadingsCollectionCreated, storedKey)
+	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
+	sendDeleteReadingStats(conn, r.DeviceName, r.ResourceName, size)
+}
+
 func checkReadingValue(b *models.BaseReading) errors.EdgeX {
 	if b.Created == 0 {
 		b.Created = common.MakeTimestamp()
@@ -229,13 +236,68 @@ func readingsByTimeRange(conn redis.Conn, start int, end int, offset int, limit
 	return convertObjectsToReadings(objects)
 }
 
+// scanReadingsByTimeRange passes the readings created within the time range, of the device when its name isn't empty,
+// in order of creation, to fn in batches of at most batchSize readings. The readings of a device are scanned from its
+// own index, and the next batch is fetched only once fn returns.
+func scanReadingsByTimeRange(conn redis.Conn, start int, end int, deviceName string, batchSize int, fn func(readings []models.Reading) errors.EdgeX) errors.EdgeX {
+	if batchSize <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid batch size %d", batchSize), nil)
+	}
+	key := ReadingsCollectionCreated
+	if deviceName != "" {
+		key = CreateKey(ReadingsCollectionDeviceName, deviceName)
+	}
+	for offset := 0; ; offset += batchSize {
+		ids, err := redis.Strings(conn.Do(ZRANGEBYSCORE, key, start, end, LIMIT, offset, batchSize))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "query reading ids from database failed", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		// the readings deleted since their ids were fetched are skipped
+		objects, edgeXerr := getObjectsByIds(conn, common.ConvertStringsToInterfaces(ids))
+		if edgeXerr != nil {
+			return edgeXerr
+		}
+		readings, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return edgeXerr
+		}
+		if edgeXerr = fn(readings); edgeXerr != nil {
+			return edgeXerr
+		}
+		if len(ids) < batchSize {
+			return nil
+		}
+	}
+}
+
+// readingsByDeviceResourceAndTimeRange query all readings of the device resource created within the time range
+func readingsByDeviceResourceAndTimeRange(conn redis.Conn, deviceName string, resourceName string, start int, end int) (readings []models.Reading, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, CreateKey(ReadingsCollectionDeviceName, deviceName), start, end, 0, -1)
+	if edgeXerr != nil {
+		return readings, edgeXerr
+	}
+	deviceReadings, edgeXerr := convertObjectsToReadings(objects)
+	if edgeXerr != nil {
+		return readings, edgeXerr
+	}
+	for _, r := range deviceReadings {
+		if r.GetBaseReading().ResourceName == resourceName {
+			readings = append(readings, r)
+		}
+	}
+	return readings, nil
+}
+
 func convertObjectsToReadings(objects [][]byte) (readings []models.Reading, edgeXerr errors.EdgeX) {
 	readings = make([]models.Reading, len(objects))
 	for i, in := range objects {
 		// as V2 APi doesn't deal with BinaryReading at this moment, convert to SimpleReading here
 		// Shall update the logic here when working on BinaryReading in the future
 		sr := models.SimpleReading{}
-		err := json.Unmarshal(in, &sr)
+		err := unmarshalPayload(in, &sr)
 		if err != nil {
 			return []models.Reading{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "reading format parsing failed from the database", err)
 		}
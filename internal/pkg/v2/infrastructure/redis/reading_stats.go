@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	ReadingsCollectionStats             = ReadingsCollection + DBKeySeparator + "stats"
+	ReadingsCollectionStatsDeviceName   = ReadingsCollectionStats + DBKeySeparator + v2.Device + DBKeySeparator + v2.Name
+	ReadingsCollectionStatsResourceName = ReadingsCollectionStats + DBKeySeparator + v2.ResourceName
+)
+
+// Fields of the hash holding the statistics of a single device or resource
+const (
+	statsFieldCount = "count"
+	statsFieldBytes = "bytes"
+	statsFieldFirst = "first"
+	statsFieldLast  = "last"
+)
+
+// sendAddReadingStats queues the commands which account for a newly stored reading in the device and resource
+// statistics.  It must be called within the MULTI transaction which stores the reading.
+func sendAddReadingStats(conn redis.Conn, deviceName string, resourceName string, created int64, size int) {
+	for _, s := range []struct{ collection, name string }{
+		{ReadingsCollectionStatsDeviceName, deviceName},
+		{ReadingsCollectionStatsResourceName, resourceName},
+	} {
+		key := CreateKey(s.collection, s.name)
+		_ = conn.Send(SADD, s.collection, s.name)
+		_ = conn.Send(HINCRBY, key, statsFieldCount, 1)
+		_ = conn.Send(HINCRBY, key, statsFieldBytes, size)
+		_ = conn.Send(HSETNX, key, statsFieldFirst, created)
+		_ = conn.Send(HSET, key, statsFieldLast, created)
+	}
+}
+
+// sendDeleteReadingStats queues the commands which remove a deleted reading from the device and resource statistics.
+// The first and last timestamps are left untouched as they record the ingestion history.  It must be called within
+// the MULTI transaction which deletes the reading.
+func sendDeleteReadingStats(conn redis.Conn, deviceName string, resourceName string, size int) {
+	for _, key := range []string{
+		CreateKey(ReadingsCollectionStatsDeviceName, deviceName),
+		CreateKey(ReadingsCollectionStatsResourceName, resourceName),
+	} {
+		_ = conn.Send(HINCRBY, key, statsFieldCount, -1)
+		_ = conn.Send(HINCRBY, key, statsFieldBytes, -size)
+	}
+}
+
+// readingStats returns the statistics of every device or resource name stored under the given stats collection
+func readingStats(conn redis.Conn, collection string) (stats []pkgModels.ReadingStats, edgeXerr errors.EdgeX) {
+	names, err := redis.Strings(conn.Do(SMEMBERS, collection))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query names from %s failed", collection), err)
+	}
+
+	stats = make([]pkgModels.ReadingStats, 0, len(names))
+	if len(names) == 0 {
+		return stats, nil
+	}
+
+	_ = conn.Send(MULTI)
+	for _, name := range names {
+		_ = conn.Send(HMGET, CreateKey(collection, name), statsFieldCount, statsFieldBytes, statsFieldFirst, statsFieldLast)
+	}
+	replies, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query reading stats from %s failed", collection), err)
+	}
+
+	for i, reply := range replies {
+		values, err := redis.Int64s(reply, nil)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("reading stats of %s parsing failed", names[i]), err)
+		}
+		// Missing hash fields are returned as nil and parsed as zero
+		if values[0] <= 0 {
+			continue
+		}
+		stats = append(stats, pkgModels.ReadingStats{
+			Name:         names[i],
+			Count:        uint64(values[0]),
+			Bytes:        uint64(values[1]),
+			FirstReading: values[2],
+			LastReading:  values[3],
+		})
+	}
+
+	return stats, nil
+}
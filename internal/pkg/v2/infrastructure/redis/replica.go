@@ -0,0 +1,196 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// replica is a read replica of the database, queried while its replication status is fresh enough
+type replica struct {
+	name string
+	pool *redis.Pool
+
+	mutex   sync.Mutex
+	checked time.Time
+	usable  bool
+	// caughtUp is when the replica last was at the replication offset of the primary
+	caughtUp time.Time
+}
+
+// replicaSet routes the read-only queries to the usable replicas in turn
+type replicaSet struct {
+	primary       *redis.Pool
+	replicas      []*replica
+	maxStaleness  time.Duration
+	checkInterval time.Duration
+	next          uint32
+	lc            logger.LoggingClient
+}
+
+func newReplicaSet(config db.Configuration, primary *redis.Pool, lc logger.LoggingClient) *replicaSet {
+	if len(config.Replicas) == 0 {
+		return nil
+	}
+	set := &replicaSet{primary: primary, maxStaleness: config.MaxStaleness, checkInterval: config.ReplicaCheckInterval, lc: lc}
+	for _, r := range config.Replicas {
+		set.replicas = append(set.replicas, &replica{
+			name: r.Name,
+			pool: redisClient.NewPool(r.Host, r.Port, r.Timeout, config.Password),
+		})
+	}
+	return set
+}
+
+// conn returns a connection to the next usable replica, nil when none is usable
+func (s *replicaSet) conn() redis.Conn {
+	if s == nil {
+		return nil
+	}
+	start := atomic.AddUint32(&s.next, 1)
+	for i := range s.replicas {
+		r := s.replicas[(int(start)+i)%len(s.replicas)]
+		if s.isUsable(r) {
+			return r.pool.Get()
+		}
+	}
+	return nil
+}
+
+// isUsable returns whether the replica can be queried, checking its replication status once per check interval. The
+// callers arriving while the status is checked use the previous one.
+func (s *replicaSet) isUsable(r *replica) bool {
+	r.mutex.Lock()
+	if time.Since(r.checked) < s.checkInterval {
+		usable := r.usable
+		r.mutex.Unlock()
+		return usable
+	}
+	r.checked = time.Now()
+	wasUsable := r.usable
+	r.mutex.Unlock()
+
+	usable, reason := s.check(r)
+
+	r.mutex.Lock()
+	r.usable = usable
+	r.mutex.Unlock()
+	switch {
+	case usable && !wasUsable:
+		s.lc.Info(fmt.Sprintf("querying database replica %s", r.name))
+	case !usable && wasUsable:
+		s.lc.Warn(fmt.Sprintf("not querying database replica %s anymore: %s", r.name, reason))
+	}
+	return usable
+}
+
+// check reads the replication offsets of the primary then of the replica, and returns whether the replica is fresh
+// enough or why it isn't
+func (s *replicaSet) check(r *replica) (bool, string) {
+	primaryOffset, _, err := s.offset(s.primary)
+	if err != nil {
+		return false, fmt.Sprintf("primary: %s", err.Error())
+	}
+	replicaOffset, isPrimary, err := s.offset(r.pool)
+	if err != nil {
+		return false, err.Error()
+	}
+	if isPrimary {
+		return true, ""
+	}
+	staleness, err := r.staleness(replicaOffset, primaryOffset, time.Now())
+	if err != nil {
+		return false, err.Error()
+	}
+	if staleness > s.maxStaleness {
+		return false, fmt.Sprintf("behind the primary for %s", staleness.String())
+	}
+	return true, ""
+}
+
+// offset returns the replication offset of the Redis server of the pool, and whether it is a primary
+func (s *replicaSet) offset(pool *redis.Pool) (int64, bool, error) {
+	conn := pool.Get()
+	defer conn.Close()
+
+	info, err := redis.String(conn.Do(INFO, "replication"))
+	if err != nil {
+		return 0, false, err
+	}
+	return replicationOffset(info)
+}
+
+// staleness returns how stale the data of the replica is, given its replication offset and the one of the primary
+// read before it: not stale when the replica is at the offset of the primary, the time since it last was otherwise.
+// An error is returned when it never was.
+func (r *replica) staleness(replicaOffset int64, primaryOffset int64, now time.Time) (time.Duration, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if replicaOffset >= primaryOffset {
+		r.caughtUp = now
+	}
+	if r.caughtUp.IsZero() {
+		return 0, fmt.Errorf("behind the primary by %d bytes", primaryOffset-replicaOffset)
+	}
+	return now.Sub(r.caughtUp), nil
+}
+
+// replicationOffset returns the offset of the replication stream the data of a Redis server is at from its INFO
+// replication section, and whether it is a primary. An error is returned while a replica synchronizes with the
+// primary, its data being incomplete.
+func replicationOffset(info string) (int64, bool, error) {
+	fields := infoFields(info)
+	switch {
+	case fields["role"] == "master":
+		offset, err := strconv.ParseInt(fields["master_repl_offset"], 10, 64)
+		if err != nil {
+			return 0, true, fmt.Errorf("invalid replication offset '%s'", fields["master_repl_offset"])
+		}
+		return offset, true, nil
+	case fields["role"] != "slave":
+		return 0, false, fmt.Errorf("unknown replication role '%s'", fields["role"])
+	case fields["master_sync_in_progress"] == "1":
+		return 0, false, fmt.Errorf("synchronizing with the primary")
+	case fields["master_link_status"] != "up" && fields["master_link_down_since_seconds"] == "-1":
+		return 0, false, fmt.Errorf("never synchronized with the primary")
+	}
+	offset, err := strconv.ParseInt(fields["slave_repl_offset"], 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid replication offset '%s'", fields["slave_repl_offset"])
+	}
+	return offset, false, nil
+}
+
+// close closes the connections to the replicas
+func (s *replicaSet) close() {
+	if s == nil {
+		return
+	}
+	for _, r := range s.replicas {
+		_ = r.pool.Close()
+	}
+}
+
+// readConn returns a connection to a usable read replica, or to the primary when there is none, for the read-only
+// queries tolerating the staleness of the replicas
+func (c *Client) readConn() redis.Conn {
+	if conn := c.replicas.conn(); conn != nil {
+		return conn
+	}
+	return c.Pool.Get()
+}
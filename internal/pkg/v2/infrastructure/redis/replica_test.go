@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicationOffset(t *testing.T) {
+	tests := []struct {
+		name            string
+		info            string
+		expectedOffset  int64
+		expectedPrimary bool
+		errorExpected   bool
+	}{
+		{"Primary", "# Replication\r\nrole:master\r\nconnected_slaves:1\r\nmaster_repl_offset:1200\r\n", 1200, true, false},
+		{"Replica - link up", "# Replication\r\nrole:slave\r\nmaster_link_status:up\r\nmaster_last_io_seconds_ago:3\r\nmaster_sync_in_progress:0\r\nslave_repl_offset:1100\r\n", 1100, false, false},
+		{"Replica - link down", "role:slave\r\nmaster_link_status:down\r\nmaster_sync_in_progress:0\r\nmaster_link_down_since_seconds:12\r\nslave_repl_offset:900\r\n", 900, false, false},
+		{"Replica - synchronizing", "role:slave\r\nmaster_link_status:down\r\nmaster_sync_in_progress:1\r\n", 0, false, true},
+		{"Replica - never synchronized", "role:slave\r\nmaster_link_status:down\r\nmaster_sync_in_progress:0\r\nmaster_link_down_since_seconds:-1\r\n", 0, false, true},
+		{"Replica - no offset", "role:slave\r\nmaster_link_status:up\r\nmaster_sync_in_progress:0\r\n", 0, false, true},
+		{"Unknown role", "# Replication\r\n", 0, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			offset, primary, err := replicationOffset(testCase.info)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOffset, offset)
+			assert.Equal(t, testCase.expectedPrimary, primary)
+		})
+	}
+}
+
+func TestReplicaStaleness(t *testing.T) {
+	r := &replica{}
+	now := time.Now()
+
+	// lagging since the service started
+	_, err := r.staleness(900, 1000, now)
+	require.Error(t, err)
+
+	// caught up with the primary, even with the link up the replica is stale as soon as it lags behind
+	staleness, err := r.staleness(1000, 1000, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), staleness)
+	staleness, err = r.staleness(1000, 1500, now.Add(3*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 3*time.Second, staleness)
+	staleness, err = r.staleness(1400, 2000, now.Add(8*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 8*time.Second, staleness)
+
+	// caught up again
+	staleness, err = r.staleness(2100, 2000, now.Add(9*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), staleness)
+}
+
+func TestReplicas(t *testing.T) {
+	databases := map[string]bootstrapConfig.Database{
+		"Primary":  {Type: "redisdb", Host: "redis", Port: 6379},
+		"Replica2": {Type: "redisdb", Host: "redis-replica-2", Port: 6379},
+		"Replica1": {Host: "redis-replica-1", Port: 6380, Timeout: 5000},
+	}
+	replicas, err := db.Replicas(databases)
+	require.NoError(t, err)
+	assert.Equal(t, []db.Replica{
+		{Name: "Replica1", Host: "redis-replica-1", Port: 6380, Timeout: 5000},
+		{Name: "Replica2", Host: "redis-replica-2", Port: 6379},
+	}, replicas)
+
+	databases["Replica3"] = bootstrapConfig.Database{Type: "mongodb", Host: "mongo", Port: 27017}
+	_, err = db.Replicas(databases)
+	assert.Error(t, err)
+
+	_, _, err = db.ParseReplicationInfo(db.ReplicationInfo{MaxStaleness: "5 seconds"})
+	assert.Error(t, err)
+	maxStaleness, checkInterval, err := db.ParseReplicationInfo(db.ReplicationInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, maxStaleness)
+	assert.Equal(t, time.Second, checkInterval)
+}
+
+func TestNilReplicaSet(t *testing.T) {
+	var replicas *replicaSet
+	assert.Nil(t, replicas.conn())
+	replicas.close()
+}
@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ResourceMappingCollection is the hash holding the resource mappings by profile, resource and protocol, along with
+// the sets of the mappings of each profile and of each protocol
+const ResourceMappingCollection = "md|rm"
+
+// resourceMappingField returns the field of the mapping of the deviceResource to the protocol in the hash
+func resourceMappingField(profileName string, resourceName string, protocol string) string {
+	return CreateKey(profileName, resourceName, protocol)
+}
+
+func resourceMappingProfileKey(profileName string) string {
+	return CreateKey(ResourceMappingCollection, "profile", profileName)
+}
+
+func resourceMappingProtocolKey(protocol string) string {
+	return CreateKey(ResourceMappingCollection, "protocol", protocol)
+}
+
+// setResourceMapping stores the mapping, replacing the mapping of the deviceResource to the protocol set before
+func setResourceMapping(conn redis.Conn, m pkgModels.ResourceMapping) errors.EdgeX {
+	value, err := json.Marshal(m)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal resource mapping for Redis persistence", err)
+	}
+	field := resourceMappingField(m.ProfileName, m.ResourceName, m.Protocol)
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(HSET, ResourceMappingCollection, field, value)
+	_ = conn.Send(SADD, resourceMappingProfileKey(m.ProfileName), field)
+	_ = conn.Send(SADD, resourceMappingProtocolKey(m.Protocol), field)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("resource mapping %s setting failed", field), err)
+	}
+	return nil
+}
+
+// resourceMapping query the mapping of the deviceResource to the protocol from DB
+func resourceMapping(conn redis.Conn, profileName string, resourceName string, protocol string) (pkgModels.ResourceMapping, errors.EdgeX) {
+	var m pkgModels.ResourceMapping
+	field := resourceMappingField(profileName, resourceName, protocol)
+	value, err := redis.Bytes(conn.Do(HGET, ResourceMappingCollection, field))
+	if err == redis.ErrNil {
+		return m, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("resource mapping %s doesn't exist in the database", field), err)
+	} else if err != nil {
+		return m, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query resource mapping %s from the database failed", field), err)
+	}
+	if err = json.Unmarshal(value, &m); err != nil {
+		return m, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource mapping parsing failed from the database", err)
+	}
+	return m, nil
+}
+
+// resourceMappingsBySet query the mappings whose fields are members of the set, sorted by profile, resource and
+// protocol
+func resourceMappingsBySet(conn redis.Conn, key string) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	fields, err := redis.Strings(conn.Do(SMEMBERS, key))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query resource mappings from the database failed", err)
+	}
+	if len(fields) == 0 {
+		return []pkgModels.ResourceMapping{}, nil
+	}
+
+	args := redis.Args{ResourceMappingCollection}.AddFlat(fields)
+	values, err := redis.ByteSlices(conn.Do(HMGET, args...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query resource mappings from the database failed", err)
+	}
+
+	mappings := make([]pkgModels.ResourceMapping, 0, len(values))
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		var m pkgModels.ResourceMapping
+		if err = json.Unmarshal(value, &m); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource mapping parsing failed from the database", err)
+		}
+		mappings = append(mappings, m)
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		a, b := mappings[i], mappings[j]
+		if a.ProfileName != b.ProfileName {
+			return a.ProfileName < b.ProfileName
+		}
+		if a.ResourceName != b.ResourceName {
+			return a.ResourceName < b.ResourceName
+		}
+		return a.Protocol < b.Protocol
+	})
+	return mappings, nil
+}
+
+// resourceMappingsByProfileName query the mappings of the deviceResources of the profile from DB
+func resourceMappingsByProfileName(conn redis.Conn, profileName string) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	return resourceMappingsBySet(conn, resourceMappingProfileKey(profileName))
+}
+
+// resourceMappingsByProtocol query the mappings of the deviceResources of all profiles to the protocol from DB
+func resourceMappingsByProtocol(conn redis.Conn, protocol string) ([]pkgModels.ResourceMapping, errors.EdgeX) {
+	return resourceMappingsBySet(conn, resourceMappingProtocolKey(protocol))
+}
+
+// deleteResourceMapping deletes the mapping of the deviceResource to the protocol
+func deleteResourceMapping(conn redis.Conn, profileName string, resourceName string, protocol string) errors.EdgeX {
+	field := resourceMappingField(profileName, resourceName, protocol)
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(HDEL, ResourceMappingCollection, field)
+	_ = conn.Send(SREM, resourceMappingProfileKey(profileName), field)
+	_ = conn.Send(SREM, resourceMappingProtocolKey(protocol), field)
+	replies, err := redis.Values(conn.Do(EXEC))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("resource mapping %s deletion failed", field), err)
+	}
+	if deleted, _ := redis.Int(replies[0], nil); deleted == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("resource mapping %s doesn't exist in the database", field), nil)
+	}
+	return nil
+}
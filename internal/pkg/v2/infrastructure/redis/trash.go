@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/v2/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// TrashCollection holds per object type the hash of the trashed objects by name and the sorted set of their names
+// scored by deletion time
+const TrashCollection = "md|trash"
+
+// trashKey returns the key of the hash holding the trashed objects of the type by name
+func trashKey(objectType string) string {
+	return CreateKey(TrashCollection, objectType)
+}
+
+// trashDeletedKey returns the key of the sorted set holding the names of the trashed objects of the type scored by
+// deletion time
+func trashDeletedKey(objectType string) string {
+	return CreateKey(TrashCollection, objectType, "deleted")
+}
+
+// trashedObjectJSON returns the JSON of the trashed object holding the device or device profile
+func trashedObjectJSON(objectType string, id string, name string, deleted int64, v interface{}) ([]byte, errors.EdgeX) {
+	m, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unable to JSON marshal %s for Redis persistence", objectType), err)
+	}
+	m, err = json.Marshal(pkgModels.TrashedObject{Type: objectType, Id: id, Name: name, Deleted: deleted, Object: m})
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal trashed object for Redis persistence", err)
+	}
+	return m, nil
+}
+
+// sendTrash queues the commands keeping the object in the trash in a transaction, the object replaces any object of
+// the same type and name trashed before
+func sendTrash(conn redis.Conn, objectType string, name string, deleted int64, m []byte) {
+	_ = conn.Send(HSET, trashKey(objectType), name, m)
+	_ = conn.Send(ZADD, trashDeletedKey(objectType), deleted, name)
+}
+
+// trashDevice deletes the device and keeps it in the trash in one transaction
+func trashDevice(conn redis.Conn, d models.Device, deleted int64) errors.EdgeX {
+	indexed, edgeXerr := indexKeys(db.IndexCollectionDevice, d)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	m, edgeXerr := trashedObjectJSON(pkgModels.TrashTypeDevice, d.Id, d.Name, deleted, d)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDevice(conn, d, indexed)
+	sendTrash(conn, pkgModels.TrashTypeDevice, d.Name, deleted, m)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device trashing failed", err)
+	}
+	return nil
+}
+
+// trashDeviceProfile deletes the device profile and keeps it in the trash in one transaction
+func trashDeviceProfile(conn redis.Conn, dp models.DeviceProfile, deleted int64) errors.EdgeX {
+	m, edgeXerr := trashedObjectJSON(pkgModels.TrashTypeDeviceProfile, dp.Id, dp.Name, deleted, dp)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceProfile(conn, dp)
+	sendTrash(conn, pkgModels.TrashTypeDeviceProfile, dp.Name, deleted, m)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile trashing failed", err)
+	}
+	return nil
+}
+
+// trashedObjects query the trashed objects of the type by offset and limit, the last deleted first
+func trashedObjects(conn redis.Conn, objectType string, offset int, limit int) ([]pkgModels.TrashedObject, errors.EdgeX) {
+	end := offset + limit - 1
+	if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
+		end = limit
+	}
+	names, err := redis.Values(conn.Do(ZREVRANGE, trashDeletedKey(objectType), offset, end))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query trashed %s names from the database failed", objectType), err)
+	}
+	if len(names) == 0 {
+		return []pkgModels.TrashedObject{}, nil
+	}
+
+	values, err := redis.ByteSlices(conn.Do(HMGET, redis.Args{trashKey(objectType)}.Add(names...)...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query trashed %s from the database failed", objectType), err)
+	}
+	objects := make([]pkgModels.TrashedObject, 0, len(values))
+	for _, value := range values {
+		// the object may have been restored or purged in between
+		if value == nil {
+			continue
+		}
+		var object pkgModels.TrashedObject
+		if err := json.Unmarshal(value, &object); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "trashed object format parsing failed from the database", err)
+		}
+		objects = append(objects, object)
+	}
+	return objects, nil
+}
+
+// trashedObjectByName query the trashed object of the type by name
+func trashedObjectByName(conn redis.Conn, objectType string, name string) (object pkgModels.TrashedObject, edgeXerr errors.EdgeX) {
+	value, err := redis.Bytes(conn.Do(HGET, trashKey(objectType), name))
+	if err == redis.ErrNil {
+		return object, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s %s doesn't exist in the trash", objectType, name), err)
+	} else if err != nil {
+		return object, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query trashed %s %s from the database failed", objectType, name), err)
+	}
+	if err := json.Unmarshal(value, &object); err != nil {
+		return object, errors.NewCommonEdgeX(errors.KindDatabaseError, "trashed object format parsing failed from the database", err)
+	}
+	return object, nil
+}
+
+// deleteTrashedObjectByName removes the object of the type from the trash
+func deleteTrashedObjectByName(conn redis.Conn, objectType string, name string) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	_ = conn.Send(HDEL, trashKey(objectType), name)
+	_ = conn.Send(ZREM, trashDeletedKey(objectType), name)
+	replies, err := redis.Ints(conn.Do(EXEC))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("trashed %s %s deletion failed", objectType, name), err)
+	} else if len(replies) == 0 || replies[0] == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s %s doesn't exist in the trash", objectType, name), nil)
+	}
+	return nil
+}
+
+// purgeTrashedObjects removes the objects of the type deleted before the timestamp from the trash and returns their
+// number
+func purgeTrashedObjects(conn redis.Conn, objectType string, deletedBefore int64) (int, errors.EdgeX) {
+	deletedKey := trashDeletedKey(objectType)
+	names, err := redis.Values(conn.Do(ZRANGEBYSCORE, deletedKey, InfiniteMin, fmt.Sprintf("(%d", deletedBefore)))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query trashed %s names from the database failed", objectType), err)
+	}
+	if len(names) == 0 {
+		return 0, nil
+	}
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(HDEL, redis.Args{trashKey(objectType)}.Add(names...)...)
+	_ = conn.Send(ZREM, redis.Args{deletedKey}.Add(names...)...)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("trashed %s purge failed", objectType), err)
+	}
+	return len(names), nil
+}
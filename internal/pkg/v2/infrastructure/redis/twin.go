@@ -0,0 +1,109 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/twin"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// DeviceCollectionTwin holds the twin of a device per key, and the sorted set of those keys scored by the time the
+// desired state last changed
+const DeviceCollectionTwin = DeviceCollection + DBKeySeparator + "twin"
+
+func deviceTwinKey(name string) string {
+	return CreateKey(DeviceCollectionTwin, name)
+}
+
+// sendDeleteDeviceTwin queues the deletion of the twin of the device in the transaction deleting it
+func sendDeleteDeviceTwin(conn redis.Conn, name string) {
+	_ = conn.Send(UNLINK, deviceTwinKey(name))
+	_ = conn.Send(ZREM, DeviceCollectionTwin, deviceTwinKey(name))
+}
+
+// deviceTwinByName query the twin of the device
+func deviceTwinByName(conn redis.Conn, name string) (t twin.Twin, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deviceTwinKey(name), &t)
+	if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+		return t, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s has no twin", name), edgeXerr)
+	} else if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return t, nil
+}
+
+// updateDeviceTwin watches the twin of the device while it is read and modified by update, then stores it. The update
+// fails instead of overwriting a twin changed concurrently. A missing twin is passed as the zero twin when create is
+// true, and fails the update otherwise.
+func updateDeviceTwin(conn redis.Conn, name string, create bool, update func(t *twin.Twin)) (twin.Twin, errors.EdgeX) {
+	key := deviceTwinKey(name)
+	if _, err := conn.Do(WATCH, key); err != nil {
+		return twin.Twin{}, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("watch twin of device %s failed", name), err)
+	}
+	defer func() { _, _ = conn.Do(UNWATCH) }()
+
+	t, edgeXerr := deviceTwinByName(conn, name)
+	if edgeXerr != nil && (!create || errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist) {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	t.DeviceName = name
+	update(&t)
+	m, err := json.Marshal(t)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device twin for Redis persistence", err)
+	}
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, key, m)
+	_ = conn.Send(ZADD, DeviceCollectionTwin, t.Updated, key)
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("twin of device %s update failed", name), err)
+	} else if reply == nil {
+		// EXEC replies nil when a watched key was changed, the transaction is discarded then
+		return t, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("twin of device %s was changed during the update, please retry", name), nil)
+	}
+	return t, nil
+}
+
+// deleteDeviceTwinByName deletes the twin of the device
+func deleteDeviceTwinByName(conn redis.Conn, name string) errors.EdgeX {
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTwin(conn, name)
+	replies, err := redis.Ints(conn.Do(EXEC))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("twin of device %s deletion failed", name), err)
+	} else if len(replies) == 0 || replies[0] == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device %s has no twin", name), nil)
+	}
+	return nil
+}
+
+// allDeviceTwins query the twins by offset and limit, the desired state last changed first
+func allDeviceTwins(conn redis.Conn, offset int, limit int) ([]twin.Twin, errors.EdgeX) {
+	end := offset + limit - 1
+	if limit == -1 { //-1 limit means that clients want to retrieve all remaining records after offset from DB, so specifying -1 for end
+		end = limit
+	}
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceCollectionTwin, offset, end)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	twins := make([]twin.Twin, len(objects))
+	for i, in := range objects {
+		if err := json.Unmarshal(in, &twins[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "device twin format parsing failed from the database", err)
+		}
+	}
+	return twins, nil
+}
@@ -0,0 +1,10 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// AssetIdTag is the event tag holding the id of the asset the originating device is attached to. Events are indexed
+// by this tag so the events of all devices attached to an asset can be queried together.
+const AssetIdTag = "assetId"
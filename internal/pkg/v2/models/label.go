@@ -0,0 +1,14 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// LabelUsage holds the number of devices, device profiles and device services carrying a label
+type LabelUsage struct {
+	Label          string
+	Devices        int64
+	DeviceProfiles int64
+	DeviceServices int64
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// MemoryUsage holds the memory consumed by the database and an estimate of the memory used by each collection.
+type MemoryUsage struct {
+	// UsedMemory is the total number of bytes allocated by the database
+	UsedMemory int64
+	// MaxMemory is the configured memory limit of the database in bytes, 0 when no limit is set
+	MaxMemory   int64
+	Collections []CollectionMemoryUsage
+}
+
+// CollectionMemoryUsage holds the approximate memory used by the entries of a single collection. The entry size is
+// sampled, so the estimate is only as accurate as the sampled entries are representative.
+type CollectionMemoryUsage struct {
+	// Name is the key of the sorted set enumerating the collection's entries
+	Name string
+	// Count is the number of entries in the collection
+	Count int64
+	// Sampled is the number of entries whose memory usage was measured
+	Sampled int
+	// IndexBytes is the memory used by the sorted set enumerating the collection's entries
+	IndexBytes int64
+	// AverageBytes is the average memory used by a sampled entry
+	AverageBytes int64
+	// EstimatedBytes is the estimated memory used by all entries and the index
+	EstimatedBytes int64
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// The statuses of the device profile updates pulled from the profile catalog
+const (
+	ProfileCatalogUpdatePending  = "PENDING"
+	ProfileCatalogUpdateRejected = "REJECTED"
+)
+
+// The actions applying the device profile updates pulled from the profile catalog
+const (
+	ProfileCatalogActionAdd    = "ADD"
+	ProfileCatalogActionUpdate = "UPDATE"
+)
+
+// ProfileCatalogUpdate is a version of a device profile published in the profile catalog, newer than the version
+// applied from the catalog, waiting to be approved
+type ProfileCatalogUpdate struct {
+	ProfileName string
+	// Version is the version of the device profile in the catalog
+	Version string
+	// AppliedVersion is the version of the device profile last applied from the catalog, empty when none was
+	AppliedVersion string
+	// Action is ADD when the device profile doesn't exist, UPDATE otherwise
+	Action string
+	// Status is PENDING until the update is rejected, a rejected version isn't pulled again
+	Status string
+	// Format is the format of the device profile file, yaml or json
+	Format string
+	// Profile is the device profile file as published in the catalog
+	Profile []byte
+	// Fetched is when the update was pulled, in milliseconds since the epoch
+	Fetched int64
+}
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ReadingStats holds the statistics core-data maintains for the readings of a single device or resource. The counters
+// are updated as readings are added and removed, so they are available without scanning the stored readings.
+type ReadingStats struct {
+	// Name is the device name or resource name the statistics belong to
+	Name string
+	// Count is the number of readings currently stored
+	Count uint64
+	// Bytes is the approximate storage size of the readings currently stored
+	Bytes uint64
+	// FirstReading is the Created timestamp of the earliest reading ingested
+	FirstReading int64
+	// LastReading is the Created timestamp of the latest reading ingested
+	LastReading int64
+}
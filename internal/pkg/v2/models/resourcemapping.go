@@ -0,0 +1,17 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ResourceMapping maps a deviceResource of a device profile to the model of an external protocol, i.e. the OPC UA
+// node or the Modbus registers holding the resource, through attributes validated against the schema of the protocol
+type ResourceMapping struct {
+	ProfileName  string
+	ResourceName string
+	Protocol     string
+	Attributes   map[string]string
+	// Modified is when the mapping was last set, in milliseconds since the epoch
+	Modified int64
+}
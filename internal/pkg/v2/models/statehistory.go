@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// The states of a device whose transitions are recorded
+const (
+	DeviceStateAdmin     = "adminState"
+	DeviceStateOperating = "operatingState"
+)
+
+// DeviceStateTransition is a change of the admin or operating state of a device
+type DeviceStateTransition struct {
+	DeviceName string
+	// State is adminState or operatingState
+	State string
+	From  string
+	To    string
+	// Reason is why the state changed, as told by the client changing it
+	Reason string
+	// Timestamp is when the state changed, in milliseconds since the epoch
+	Timestamp int64
+}
@@ -0,0 +1,23 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// The types of the objects kept in the trash
+const (
+	TrashTypeDevice        = "device"
+	TrashTypeDeviceProfile = "deviceprofile"
+)
+
+// TrashedObject is a deleted device or device profile kept in the trash until it is restored or purged
+type TrashedObject struct {
+	Type string
+	Id   string
+	Name string
+	// Deleted is when the object was moved to the trash, in milliseconds since the epoch
+	Deleted int64
+	// Object is the device or device profile as JSON
+	Object []byte
+}
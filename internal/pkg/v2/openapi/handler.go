@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// ApiOpenAPIRoute serves the OpenAPI document of the service
+	ApiOpenAPIRoute = v2.ApiBase + "/openapi.json"
+	// ApiSwaggerUIRoute serves Swagger UI rendering the OpenAPI document, when enabled
+	ApiSwaggerUIRoute = v2.ApiBase + "/swagger"
+)
+
+// OpenAPIInfo configures the Swagger UI served next to the OpenAPI document
+type OpenAPIInfo struct {
+	// EnableSwaggerUI serves Swagger UI at /api/v2/swagger
+	EnableSwaggerUI bool
+	// SwaggerUIAssetsURL is the URL of the swagger-ui-dist assets the page loads
+	SwaggerUIAssetsURL string
+}
+
+// LoadRestRoutes registers the route of the OpenAPI document of the service, and the Swagger UI route when enabled.
+// The document is built from the routes registered to the router when it is requested, so it may be loaded before
+// the other routes.
+func LoadRestRoutes(r *mux.Router, dic *di.Container, serviceKey string, contracts Contracts, config OpenAPIInfo) {
+	r.HandleFunc(ApiOpenAPIRoute, documentHandler(r, dic, serviceKey, contracts)).Methods(http.MethodGet)
+	if config.EnableSwaggerUI {
+		r.HandleFunc(ApiSwaggerUIRoute, swaggerUIHandler(dic, config.SwaggerUIAssetsURL)).Methods(http.MethodGet)
+	}
+}
+
+func documentHandler(r *mux.Router, dic *di.Container, serviceKey string, contracts Contracts) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		lc := container.LoggingClientFrom(dic.Get)
+
+		document, err := Build(r, Info{Title: serviceKey, Version: edgex.Version}, contracts)
+		if err != nil {
+			lc.Error("Unable to build the OpenAPI document", "error", err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(document); err != nil {
+			lc.Error("Unable to write the OpenAPI document", "error", err.Error())
+		}
+	}
+}
+
+var swaggerUIPage = template.Must(template.New("swagger").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>Swagger UI</title>
+  <link rel="stylesheet" href="{{.AssetsURL}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="{{.AssetsURL}}/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function() {
+      SwaggerUIBundle({url: "{{.DocumentURL}}", dom_id: "#swagger-ui"});
+    };
+  </script>
+</body>
+</html>
+`))
+
+func swaggerUIHandler(dic *di.Container, assetsURL string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set(clients.ContentType, "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		err := swaggerUIPage.Execute(w, struct {
+			AssetsURL   string
+			DocumentURL string
+		}{assetsURL, ApiOpenAPIRoute})
+		if err != nil {
+			container.LoggingClientFrom(dic.Get).Error("Unable to write the Swagger UI page", "error", err.Error())
+		}
+	}
+}
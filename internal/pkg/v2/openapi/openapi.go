@@ -0,0 +1,230 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi generates the OpenAPI 3 document of a service's V2 API from the routes registered to its router and
+// the DTOs its routes exchange, so that the document served always matches the deployed service.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/errorcode"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// Version is the version of the OpenAPI specification the documents follow
+	Version = "3.0.0"
+
+	componentsSchemasPath = "#/components/schemas/"
+)
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info describes the service of the document
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem holds the operations of a path by lower case HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes a route
+type Operation struct {
+	OperationId string              `json:"operationId,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a path parameter of a route
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody describes the body a route accepts
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a response of a route
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas of the DTOs referenced by the operations
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Schema is a JSON Schema as supported by OpenAPI 3
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// Endpoint identifies a route by its HTTP method and path template
+type Endpoint struct {
+	Method string
+	Path   string
+}
+
+// Contract holds the DTOs a route exchanges. Request is the zero value of the body the route decodes, nil when it
+// doesn't accept a JSON body, and Response the zero value of the body it encodes with StatusCode, 200 when not set.
+type Contract struct {
+	Request    interface{}
+	Response   interface{}
+	StatusCode int
+}
+
+// Contracts holds the DTOs exchanged by the routes of a service
+type Contracts map[Endpoint]Contract
+
+// commonContracts holds the DTOs exchanged by the routes every V2 service registers
+var commonContracts = Contracts{
+	{http.MethodGet, v2.ApiPingRoute}:    {Response: common.PingResponse{}},
+	{http.MethodGet, v2.ApiVersionRoute}: {Response: common.VersionResponse{}},
+	{http.MethodGet, v2.ApiConfigRoute}:  {Response: common.ConfigResponse{}},
+	{http.MethodGet, v2.ApiMetricsRoute}: {Response: common.MetricsResponse{}},
+
+	{http.MethodGet, errorcode.ApiErrorCodesRoute}: {Response: errorcode.ErrorCodesResponse{}},
+}
+
+var pathVariable = regexp.MustCompile(`{([^{}:]+)(?::([^{}]+))?}`)
+
+// Build returns the document of the V2 routes registered to the router. Routes without a contract, neither in the
+// contracts nor among the common routes, are documented without their bodies.
+func Build(router *mux.Router, info Info, contracts Contracts) (Document, error) {
+	g := newSchemaGenerator()
+	document := Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+	}
+	errorResponse := Response{
+		Description: "An error occurred",
+		Content:     jsonContent(g.schemaOf(common.BaseResponse{})),
+	}
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil || !strings.HasPrefix(template, v2.ApiBase) {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			// routes matching any method aren't part of the API
+			return nil
+		}
+
+		path, parameters := parsePathTemplate(template)
+		for _, method := range methods {
+			endpoint := Endpoint{Method: method, Path: template}
+			contract, ok := contracts[endpoint]
+			if !ok {
+				contract = commonContracts[endpoint]
+			}
+			operation := &Operation{
+				OperationId: handlerName(route.GetHandler()),
+				Parameters:  parameters,
+				Responses:   map[string]Response{"default": errorResponse},
+			}
+			if contract.Request != nil {
+				operation.RequestBody = &RequestBody{Required: true, Content: jsonContent(g.schemaOf(contract.Request))}
+			}
+			statusCode := contract.StatusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			response := Response{Description: http.StatusText(statusCode)}
+			if contract.Response != nil {
+				response.Content = jsonContent(g.schemaOf(contract.Response))
+			}
+			operation.Responses[strconv.Itoa(statusCode)] = response
+
+			if document.Paths[path] == nil {
+				document.Paths[path] = make(PathItem)
+			}
+			document.Paths[path][strings.ToLower(method)] = operation
+		}
+		return nil
+	})
+	if err != nil {
+		return Document{}, err
+	}
+
+	document.Components = Components{Schemas: g.components}
+	return document, nil
+}
+
+// parsePathTemplate returns the OpenAPI path of the mux path template, with the patterns of its variables moved to
+// the path parameters
+func parsePathTemplate(template string) (string, []Parameter) {
+	var parameters []Parameter
+	path := pathVariable.ReplaceAllStringFunc(template, func(variable string) string {
+		match := pathVariable.FindStringSubmatch(variable)
+		parameters = append(parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string", Pattern: match[2]},
+		})
+		return "{" + match[1] + "}"
+	})
+	return path, parameters
+}
+
+// handlerName returns the name of the method or function handling the route, e.g. AddEvent
+func handlerName(handler http.Handler) string {
+	f, ok := handler.(http.HandlerFunc)
+	if !ok {
+		return ""
+	}
+	name := runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+	name = strings.TrimSuffix(name, "-fm")
+	name = name[strings.LastIndex(name, ".")+1:]
+	if strings.HasPrefix(name, "func") {
+		// anonymous function
+		return ""
+	}
+	return name
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{clients.ContentTypeJSON: {Schema: schema}}
+}
@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	v2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequest struct {
+	common.BaseRequest `json:",inline"`
+	Name               string            `json:"name" validate:"required"`
+	Labels             []string          `json:"labels,omitempty"`
+	Properties         map[string]string `json:"properties"`
+	Payload            []byte            `json:"payload"`
+	hidden             string
+}
+
+type testController struct{}
+
+func (testController) AddThing(http.ResponseWriter, *http.Request) {}
+
+func (testController) ThingsByLimit(http.ResponseWriter, *http.Request) {}
+
+func TestBuild(t *testing.T) {
+	var c testController
+	r := mux.NewRouter()
+	r.HandleFunc(v2.ApiBase+"/thing", c.AddThing).Methods(http.MethodPost)
+	r.HandleFunc(v2.ApiBase+"/thing/all/{limit:[0-9]+}", c.ThingsByLimit).Methods(http.MethodGet)
+	r.HandleFunc(v2.ApiPingRoute, c.AddThing).Methods(http.MethodGet)
+	// not part of the V2 API
+	r.HandleFunc("/api/v1/thing", c.AddThing).Methods(http.MethodGet)
+
+	document, err := Build(r, Info{Title: "test", Version: "1.0"}, Contracts{
+		{Method: http.MethodPost, Path: v2.ApiBase + "/thing"}: {
+			Request:    []testRequest{},
+			Response:   []common.BaseWithIdResponse{},
+			StatusCode: http.StatusMultiStatus,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, Version, document.OpenAPI)
+	assert.Len(t, document.Paths, 3)
+
+	add := document.Paths[v2.ApiBase+"/thing"]["post"]
+	require.NotNil(t, add)
+	assert.Equal(t, "AddThing", add.OperationId)
+	require.NotNil(t, add.RequestBody)
+	body := add.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "array", body.Type)
+	assert.Equal(t, "#/components/schemas/testRequest", body.Items.Ref)
+	assert.Contains(t, add.Responses, "207")
+	assert.Contains(t, add.Responses, "default")
+
+	request := document.Components.Schemas["testRequest"]
+	require.NotNil(t, request)
+	// the inline BaseRequest fields are flattened
+	assert.Contains(t, request.Properties, "requestId")
+	assert.Equal(t, "string", request.Properties["name"].Type)
+	assert.Equal(t, "array", request.Properties["labels"].Type)
+	assert.Equal(t, "object", request.Properties["properties"].Type)
+	assert.Equal(t, "byte", request.Properties["payload"].Format)
+	assert.NotContains(t, request.Properties, "hidden")
+	assert.Equal(t, []string{"name"}, request.Required)
+
+	byLimit := document.Paths[v2.ApiBase+"/thing/all/{limit}"]["get"]
+	require.NotNil(t, byLimit)
+	require.Len(t, byLimit.Parameters, 1)
+	assert.Equal(t, "limit", byLimit.Parameters[0].Name)
+	assert.Equal(t, "[0-9]+", byLimit.Parameters[0].Schema.Pattern)
+	assert.Nil(t, byLimit.Responses["200"].Content, "route without contract documented with a body")
+
+	ping := document.Paths[v2.ApiPingRoute]["get"]
+	require.NotNil(t, ping)
+	assert.Equal(t, "#/components/schemas/PingResponse", ping.Responses["200"].Content["application/json"].Schema.Ref)
+}
@@ -13,12 +13,22 @@ import (
 	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/v2/filter"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/errors"
 	contractsV2 "github.com/edgexfoundry/go-mod-core-contracts/v2"
 	"github.com/gorilla/mux"
 )
 
+// ModifiedSince is the query string specifying the timestamp in milliseconds since which the queried objects were modified
+const ModifiedSince = "modifiedSince"
+
+// Filter is the query string holding the filter expression of the list endpoints, i.e. operatingState=='UP'
+const Filter = "filter"
+
+// maxInt is the largest value of int on the target platform
+const maxInt = int(^uint(0) >> 1)
+
 func WriteHttpHeader(w http.ResponseWriter, ctx context.Context, statusCode int) {
 	w.Header().Set(clients.CorrelationHeader, correlation.FromContext(ctx))
 	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
@@ -40,6 +50,23 @@ func ParseGetAllObjectsRequestQueryString(r *http.Request, minOffset int, maxOff
 	return offset, limit, labels, err
 }
 
+// ParseFilterQueryString parses the filter expression of the query string, nil being returned when there is none. The
+// fields compared by the expression must be fields of the model listed.
+func ParseFilterQueryString(r *http.Request, model interface{}) (filter.Expression, errors.EdgeX) {
+	values, ok := r.URL.Query()[Filter]
+	if !ok || strings.TrimSpace(values[0]) == "" {
+		return nil, nil
+	}
+	expression, err := filter.Parse(values[0])
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s", Filter, values[0]), err)
+	}
+	if err = filter.ValidateFields(expression, model); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid querystring %s's value %s", Filter, values[0]), err)
+	}
+	return expression, nil
+}
+
 // Parse the specified query string key to an integer.  If specified query string key is found more than once in the
 // http request, only the first specified query string will be parsed and converted to an integer.  If no specified
 // query string key could be found in the http request, specified default value will be returned.  EdgeX error will be
@@ -80,27 +107,78 @@ func ParseQueryStringToStrings(r *http.Request, queryStringKey string, separator
 }
 
 func ParseTimeRangeOffsetLimit(r *http.Request, minOffset int, maxOffset int, minLimit int, maxLimit int) (start int, end int, offset int, limit int, edgexErr errors.EdgeX) {
-	start, edgexErr = ParsePathParamToInt(r, contractsV2.Start)
+	start, end, edgexErr = ParseTimeRangePathParams(r)
 	if edgexErr != nil {
 		return start, end, offset, limit, edgexErr
 	}
-	end, edgexErr = ParsePathParamToInt(r, contractsV2.End)
+	offset, edgexErr = ParseQueryStringToInt(r, contractsV2.Offset, contractsV2.DefaultOffset, minOffset, maxOffset)
+	if edgexErr != nil {
+		return start, end, offset, limit, edgexErr
+	}
+	limit, edgexErr = ParseQueryStringToInt(r, contractsV2.Limit, contractsV2.DefaultLimit, minLimit, maxLimit)
 	if edgexErr != nil {
 		return start, end, offset, limit, edgexErr
 	}
+
+	return start, end, offset, limit, nil
+}
+
+// ParseTimeRangePathParams parses the start and end path parameters, in milliseconds, of a time range.
+func ParseTimeRangePathParams(r *http.Request) (start int, end int, edgexErr errors.EdgeX) {
+	start, edgexErr = ParsePathParamToInt(r, contractsV2.Start)
+	if edgexErr != nil {
+		return start, end, edgexErr
+	}
+	end, edgexErr = ParsePathParamToInt(r, contractsV2.End)
+	if edgexErr != nil {
+		return start, end, edgexErr
+	}
 	if end < start {
-		return start, end, offset, limit, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+		return start, end, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+	}
+	return start, end, nil
+}
+
+// ParseModifiedSinceOffsetLimit parses the required modifiedSince query string along with the optional offset and limit.
+func ParseModifiedSinceOffsetLimit(r *http.Request, minOffset int, maxOffset int, minLimit int, maxLimit int) (since int, offset int, limit int, edgexErr errors.EdgeX) {
+	if len(r.URL.Query().Get(ModifiedSince)) == 0 {
+		return since, offset, limit, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is required", ModifiedSince), nil)
+	}
+	since, edgexErr = ParseQueryStringToInt(r, ModifiedSince, 0, 0, maxInt)
+	if edgexErr != nil {
+		return since, offset, limit, edgexErr
 	}
 	offset, edgexErr = ParseQueryStringToInt(r, contractsV2.Offset, contractsV2.DefaultOffset, minOffset, maxOffset)
 	if edgexErr != nil {
-		return start, end, offset, limit, edgexErr
+		return since, offset, limit, edgexErr
 	}
 	limit, edgexErr = ParseQueryStringToInt(r, contractsV2.Limit, contractsV2.DefaultLimit, minLimit, maxLimit)
 	if edgexErr != nil {
-		return start, end, offset, limit, edgexErr
+		return since, offset, limit, edgexErr
 	}
 
-	return start, end, offset, limit, nil
+	return since, offset, limit, nil
+}
+
+// ParseTimeRangeQueryString parses the required start and end query strings, in milliseconds, of a time range.
+func ParseTimeRangeQueryString(r *http.Request) (start int, end int, edgexErr errors.EdgeX) {
+	for _, key := range []string{contractsV2.Start, contractsV2.End} {
+		if len(r.URL.Query().Get(key)) == 0 {
+			return start, end, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is required", key), nil)
+		}
+	}
+	start, edgexErr = ParseQueryStringToInt(r, contractsV2.Start, 0, 0, maxInt)
+	if edgexErr != nil {
+		return start, end, edgexErr
+	}
+	end, edgexErr = ParseQueryStringToInt(r, contractsV2.End, 0, 0, maxInt)
+	if edgexErr != nil {
+		return start, end, edgexErr
+	}
+	if end < start {
+		return start, end, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be less than start's value %v", end, start), nil)
+	}
+	return start, end, nil
 }
 
 // Parse the specified path parameter to an integer.  EdgeX error will be returned if any parsing error occurs or
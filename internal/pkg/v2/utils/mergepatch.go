@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/errors"
+)
+
+// ContentTypeMergePatchJSON is the media type of a JSON Merge Patch document as defined in RFC 7396
+const ContentTypeMergePatchJSON = "application/merge-patch+json"
+
+// MergePatch applies the JSON Merge Patch (RFC 7396) document to the original JSON document and returns the patched
+// document.  Members of the patch replace the original members, null members remove them and nested objects are
+// merged recursively.
+func MergePatch(original []byte, patch []byte) ([]byte, errors.EdgeX) {
+	var target interface{}
+	if err := json.Unmarshal(original, &target); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to parse the original document", err)
+	}
+	var p interface{}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the merge patch document", err)
+	}
+
+	patched, err := json.Marshal(mergePatchValue(target, p))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the patched document", err)
+	}
+	return patched, nil
+}
+
+func mergePatchValue(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatchValue(targetObject[name], value)
+	}
+	return targetObject
+}
+
+// ETag returns a strong entity tag computed from the JSON representation of v
+func ETag(v interface{}) (string, errors.EdgeX) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "failed to encode the entity", err)
+	}
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// ETagMatches reports whether the If-Match header value matches etag.  An empty header matches any entity tag.
+func ETagMatches(ifMatch string, etag string) bool {
+	if strings.TrimSpace(ifMatch) == "" {
+		return true
+	}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
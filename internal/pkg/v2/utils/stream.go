@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+const (
+	// ContentTypeNDJSON is the content type of newline delimited JSON, one JSON document per line
+	ContentTypeNDJSON = "application/x-ndjson"
+	// StreamBatchSize is the number of objects fetched from the database at once while streaming them
+	StreamBatchSize = 1000
+)
+
+// StreamWriter writes objects to a response as they are fetched, either as the elements of a JSON array or as newline
+// delimited JSON, so that a response of any size is written without holding more than a batch of objects in memory.
+type StreamWriter struct {
+	w       io.Writer
+	encoder *json.Encoder
+	ndjson  bool
+	count   int
+}
+
+// NewStreamWriter sets the content type of the response and returns the writer of its objects
+func NewStreamWriter(w http.ResponseWriter, ndjson bool) *StreamWriter {
+	if ndjson {
+		w.Header().Set(clients.ContentType, ContentTypeNDJSON)
+	} else {
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	}
+	return &StreamWriter{w: w, encoder: json.NewEncoder(w), ndjson: ndjson}
+}
+
+// Write writes the object to the response
+func (s *StreamWriter) Write(object interface{}) error {
+	if err := s.writeSeparator(); err != nil {
+		return err
+	}
+	// the encoder ends every object with a newline, which keeps the JSON array valid
+	if err := s.encoder.Encode(object); err != nil {
+		return err
+	}
+	s.count++
+	return nil
+}
+
+// Flush sends the objects written so far to the client
+func (s *StreamWriter) Flush() {
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (s *StreamWriter) writeSeparator() error {
+	if s.ndjson {
+		return nil
+	}
+	separator := ","
+	if s.count == 0 {
+		separator = "["
+	}
+	_, err := io.WriteString(s.w, separator)
+	return err
+}
+
+// Close ends the JSON array
+func (s *StreamWriter) Close() error {
+	if s.ndjson {
+		return nil
+	}
+	end := "]\n"
+	if s.count == 0 {
+		end = "[]\n"
+	}
+	_, err := io.WriteString(s.w, end)
+	return err
+}
+
+// AcceptsNDJSON tells whether the client asked for newline delimited JSON
+func AcceptsNDJSON(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if strings.TrimSpace(strings.Split(accept, ";")[0]) == ContentTypeNDJSON {
+			return true
+		}
+	}
+	return false
+}
@@ -26,6 +26,8 @@ type ConfigurationStruct struct {
 	Writable          WritableInfo
 	SecretService     secretstoreclient.SecretServiceInfo
 	TokenFileProvider TokenFileProviderInfo
+	RemoteNodes       RemoteNodesInfo
+	Join              JoinInfo
 }
 
 type WritableInfo struct {
@@ -44,6 +46,34 @@ type TokenFileProviderInfo struct {
 	OutputFilename string
 }
 
+// RemoteNodesInfo configures the join server of the primary node, which issues the tokens of the services of the
+// secondary nodes (run with --serveJoin)
+type RemoteNodesInfo struct {
+	// Address the join server listens on, i.e. ':8443'
+	ListenAddr string
+	// TLS certificate and key of the join server
+	ServerCertFile string
+	ServerKeyFile  string
+	// CA certificate sent to the joining nodes to verify the secret store and the services of the primary node
+	CAFile string
+	// JSON file of the nodes allowed to join, with the SHA-256 of their join secret and their services
+	NodesFile string
+}
+
+// JoinInfo configures the join of a secondary node to the primary node (run with --join)
+type JoinInfo struct {
+	// URL of the join server of the primary node, i.e. 'https://edgex-primary:8443'
+	PrimaryUrl string
+	// Name of this node in the nodes file of the primary node
+	NodeName string
+	// File holding the join secret of this node
+	JoinSecretFile string
+	// CA certificate verifying the join server of the primary node
+	PrimaryCAFile string
+	// File the CA certificate received from the primary node is written to, empty to not write it
+	CAOutputFile string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -19,10 +19,12 @@ package fileprovider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"sync"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/config"
 	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/container"
 	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
 
@@ -30,17 +32,26 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
 	"github.com/edgexfoundry/go-mod-secrets/pkg/token/authtokenloader"
 	"github.com/edgexfoundry/go-mod-secrets/pkg/token/fileioperformer"
 )
 
 type Bootstrap struct {
-	exitCode int
+	exitCode  int
+	serveJoin bool
+	join      bool
 }
 
-func NewBootstrap() *Bootstrap {
+// NewBootstrap creates the bootstrap of the token provider. With serveJoin, the tokens of the services of the primary
+// node are generated and the joins of the secondary nodes served afterwards. With join, the node joins the primary
+// node instead of generating its tokens.
+func NewBootstrap(serveJoin bool, join bool) *Bootstrap {
 	return &Bootstrap{
-		exitCode: 0,
+		exitCode:  0,
+		serveJoin: serveJoin,
+		join:      join,
 	}
 }
 
@@ -50,11 +61,20 @@ func (b *Bootstrap) ExitCode() int {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	cfg := container.ConfigurationFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	fileOpener := fileioperformer.NewDefaultFileIoPerformer()
+
+	if b.join {
+		if err := b.joinPrimary(cfg, lc, fileOpener); err != nil {
+			lc.Error(fmt.Sprintf("error occurred joining primary node: %s", err.Error()))
+			b.exitCode = 1
+		}
+		return false // Tell bootstrap.Run() to exit wait loop and terminate
+	}
+
 	tokenProvider := authtokenloader.NewAuthTokenLoader(fileOpener)
 
 	var req internal.HttpCaller
@@ -82,7 +102,47 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 	if err != nil {
 		lc.Error(fmt.Sprintf("error occurred generating tokens: %s", err.Error()))
 		b.exitCode = 1
+		return false
+	}
+
+	if !b.serveJoin {
+		return false // Tell bootstrap.Run() to exit wait loop and terminate
+	}
+
+	joinServer := NewJoinServer(lc, fileOpener, tokenProvider, vaultClient, cfg.TokenFileProvider, cfg.RemoteNodes)
+	listener, err := joinServer.Listen()
+	if err != nil {
+		lc.Error(fmt.Sprintf("error occurred starting join server: %s", err.Error()))
+		b.exitCode = 1
+		return false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := joinServer.Serve(ctx, listener); err != nil {
+			lc.Error(fmt.Sprintf("error occurred serving joins: %s", err.Error()))
+			b.exitCode = 1
+		}
+	}()
+
+	return true
+}
+
+// joinPrimary joins the primary node, verifying its join server with the CA certificate of the primary node
+func (b *Bootstrap) joinPrimary(cfg *config.ConfigurationStruct, lc logger.LoggingClient, fileOpener fileioperformer.FileIoPerformer) error {
+	caReader, err := fileOpener.OpenFileReader(cfg.Join.PrimaryCAFile, os.O_RDONLY, 0400)
+	if err != nil {
+		return fmt.Errorf("failed to load primary node CA certificate: %s", err.Error())
+	}
+	primaryUrl, err := url.Parse(cfg.Join.PrimaryUrl)
+	if err != nil {
+		return fmt.Errorf("invalid primary node URL %s: %s", cfg.Join.PrimaryUrl, err.Error())
+	}
+	req := secretstoreclient.NewRequestor(lc).WithTLS(caReader, primaryUrl.Hostname())
+	if req == nil {
+		return fmt.Errorf("failed to load primary node CA certificate %s", cfg.Join.PrimaryCAFile)
 	}
 
-	return false // Tell bootstrap.Run() to exit wait loop and terminate
+	return NewJoinClient(lc, fileOpener, req, cfg.TokenFileProvider, cfg.Join).Run()
 }
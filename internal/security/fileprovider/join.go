@@ -0,0 +1,368 @@
+//
+// Copyright (c) 2026 EdgeX Foundry Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under
+// the License.
+//
+// SPDX-License-Identifier: Apache-2.0'
+//
+
+package fileprovider
+
+/*
+
+Example nodes file of the primary node, the join secret of node-2 being the
+content of its JoinSecretFile
+
+{
+  "node-2": {
+    "join_secret_sha256": "<hex encoded SHA-256 of the join secret>",
+    "services": [ "device-modbus", "device-virtual" ]
+  }
+}
+
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/config"
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/authtokenloader"
+	"github.com/edgexfoundry/go-mod-secrets/pkg/token/fileioperformer"
+)
+
+// ApiJoinRoute is the route of the join server of the primary node
+const ApiJoinRoute = clients.ApiBase + "/join"
+
+const bearerPrefix = "Bearer "
+
+type RemoteNodeConfFile map[string]RemoteNode
+
+type RemoteNode struct {
+	JoinSecretSHA256 string   `json:"join_secret_sha256"`
+	Services         []string `json:"services"`
+}
+
+// JoinRequest is the body of a join, the join secret of the node being the bearer token of the request
+type JoinRequest struct {
+	Node string `json:"node"`
+}
+
+// JoinResponse holds the Vault tokens of the services of the joining node keyed by service name, and the CA
+// certificate of the primary node in PEM format
+type JoinResponse struct {
+	Tokens map[string]interface{} `json:"tokens"`
+	CA     string                 `json:"ca,omitempty"`
+}
+
+func LoadRemoteNodeConfig(fileOpener fileioperformer.FileIoPerformer, path string, nodeConf *RemoteNodeConfFile) error {
+	reader, err := fileOpener.OpenFileReader(path, os.O_RDONLY, 0400)
+	if err != nil {
+		return err
+	}
+	readCloser := fileioperformer.MakeReadCloser(reader)
+	defer readCloser.Close()
+
+	return json.NewDecoder(readCloser).Decode(nodeConf)
+}
+
+// JoinServer issues the tokens of the services of the secondary nodes joining the primary node. The nodes file is
+// read on every join, so that nodes are added without restarting the server.
+type JoinServer struct {
+	provider    *fileTokenProvider
+	nodesConfig config.RemoteNodesInfo
+}
+
+// NewJoinServer creates the join server, the privileged token creating the tokens of the joining nodes just as for
+// the services of the primary node
+func NewJoinServer(logger logger.LoggingClient,
+	fileOpener fileioperformer.FileIoPerformer,
+	tokenProvider authtokenloader.AuthTokenLoader,
+	vaultClient secretstoreclient.SecretStoreClient,
+	tokenConfig config.TokenFileProviderInfo,
+	nodesConfig config.RemoteNodesInfo) *JoinServer {
+	return &JoinServer{
+		provider: &fileTokenProvider{
+			logger:        logger,
+			fileOpener:    fileOpener,
+			tokenProvider: tokenProvider,
+			vaultClient:   vaultClient,
+			tokenConfig:   tokenConfig,
+		},
+		nodesConfig: nodesConfig,
+	}
+}
+
+// Listen opens the TLS listener of the join server
+func (s *JoinServer) Listen() (net.Listener, error) {
+	cert, err := tls.LoadX509KeyPair(s.nodesConfig.ServerCertFile, s.nodesConfig.ServerKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load join server certificate: %s", err.Error())
+	}
+	return tls.Listen("tcp", s.nodesConfig.ListenAddr, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	})
+}
+
+// Serve serves the joins on the listener until the context is done
+func (s *JoinServer) Serve(ctx context.Context, listener net.Listener) error {
+	mux := http.NewServeMux()
+	mux.Handle(ApiJoinRoute, s)
+	server := &http.Server{Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+
+	s.provider.logger.Info(fmt.Sprintf("serving joins on %s", listener.Addr().String()))
+	if err := server.Serve(listener); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// ServeHTTP authenticates the joining node with its join secret, and responds with fresh tokens for its services
+func (s *JoinServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	lc := s.provider.logger
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		http.Error(w, "missing join secret", http.StatusUnauthorized)
+		return
+	}
+	joinSecret := strings.TrimPrefix(authorization, bearerPrefix)
+
+	var joinRequest JoinRequest
+	if err := json.NewDecoder(r.Body).Decode(&joinRequest); err != nil {
+		http.Error(w, "invalid join request", http.StatusBadRequest)
+		return
+	}
+
+	var nodeConf RemoteNodeConfFile
+	if err := LoadRemoteNodeConfig(s.provider.fileOpener, s.nodesConfig.NodesFile, &nodeConf); err != nil {
+		lc.Error(fmt.Sprintf("failed to read nodes file %s: %s", s.nodesConfig.NodesFile, err.Error()))
+		http.Error(w, "join unavailable", http.StatusInternalServerError)
+		return
+	}
+
+	// Unknown nodes and wrong secrets are refused alike
+	node, ok := nodeConf[joinRequest.Node]
+	hash := sha256.Sum256([]byte(joinSecret))
+	if !ok || subtle.ConstantTimeCompare([]byte(hex.EncodeToString(hash[:])), []byte(strings.ToLower(node.JoinSecretSHA256))) != 1 {
+		lc.Warn(fmt.Sprintf("refused join of node '%s' from %s", joinRequest.Node, r.RemoteAddr))
+		http.Error(w, "join refused", http.StatusUnauthorized)
+		return
+	}
+
+	response, err := s.join(node)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to join node %s: %s", joinRequest.Node, err.Error()))
+		http.Error(w, "join failed", http.StatusInternalServerError)
+		return
+	}
+
+	lc.Info(fmt.Sprintf("node %s joined with %d service tokens", joinRequest.Node, len(response.Tokens)))
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// join creates the tokens of the services of the node. The services missing from the token configuration use the
+// policy/token defaults.
+func (s *JoinServer) join(node RemoteNode) (JoinResponse, error) {
+	response := JoinResponse{Tokens: make(map[string]interface{})}
+
+	privilegedToken, err := s.provider.tokenProvider.Load(s.provider.tokenConfig.PrivilegedTokenPath)
+	if err != nil {
+		return response, fmt.Errorf("failed to read privileged access token: %s", err.Error())
+	}
+
+	tokenConf, err := s.provider.loadTokenConf()
+	if err != nil {
+		return response, err
+	}
+
+	for _, serviceName := range node.Services {
+		serviceConfig, ok := tokenConf[serviceName]
+		if !ok {
+			serviceConfig = ServiceKey{UseDefaults: true}
+		}
+		token, err := s.provider.createServiceToken(privilegedToken, serviceName, serviceConfig)
+		if err != nil {
+			return response, err
+		}
+		response.Tokens[serviceName] = token
+	}
+
+	if s.nodesConfig.CAFile != "" {
+		reader, err := s.provider.fileOpener.OpenFileReader(s.nodesConfig.CAFile, os.O_RDONLY, 0400)
+		if err != nil {
+			return response, fmt.Errorf("failed to read CA certificate %s: %s", s.nodesConfig.CAFile, err.Error())
+		}
+		readCloser := fileioperformer.MakeReadCloser(reader)
+		defer readCloser.Close()
+		ca, err := ioutil.ReadAll(readCloser)
+		if err != nil {
+			return response, fmt.Errorf("failed to read CA certificate %s: %s", s.nodesConfig.CAFile, err.Error())
+		}
+		response.CA = string(ca)
+	}
+
+	return response, nil
+}
+
+// JoinClient joins a secondary node to the primary node, and writes the tokens it is issued as the token files of
+// its services
+type JoinClient struct {
+	provider   *fileTokenProvider
+	caller     internal.HttpCaller
+	joinConfig config.JoinInfo
+}
+
+// NewJoinClient creates the join client, the caller verifying the join server with the CA certificate of the
+// primary node
+func NewJoinClient(logger logger.LoggingClient,
+	fileOpener fileioperformer.FileIoPerformer,
+	caller internal.HttpCaller,
+	tokenConfig config.TokenFileProviderInfo,
+	joinConfig config.JoinInfo) *JoinClient {
+	return &JoinClient{
+		provider: &fileTokenProvider{
+			logger:      logger,
+			fileOpener:  fileOpener,
+			tokenConfig: tokenConfig,
+		},
+		caller:     caller,
+		joinConfig: joinConfig,
+	}
+}
+
+// Run joins the primary node
+func (c *JoinClient) Run() error {
+	lc := c.provider.logger
+	lc.Info(fmt.Sprintf("joining primary node %s as node %s", c.joinConfig.PrimaryUrl, c.joinConfig.NodeName))
+
+	joinSecret, err := c.readJoinSecret()
+	if err != nil {
+		lc.Error(err.Error())
+		return err
+	}
+
+	body, err := json.Marshal(JoinRequest{Node: c.joinConfig.NodeName})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(c.joinConfig.PrimaryUrl, "/")+ApiJoinRoute, bytes.NewReader(body))
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create join request: %s", err.Error()))
+		return err
+	}
+	req.Header.Set("Authorization", bearerPrefix+joinSecret)
+	req.Header.Set(clients.ContentType, clients.ContentTypeJSON)
+
+	resp, err := c.caller.Do(req)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to join primary node: %s", err.Error()))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+		err = fmt.Errorf("join refused by primary node: %d %s", resp.StatusCode, strings.TrimSpace(string(message)))
+		lc.Error(err.Error())
+		return err
+	}
+
+	var joinResponse JoinResponse
+	if err := json.NewDecoder(resp.Body).Decode(&joinResponse); err != nil {
+		lc.Error(fmt.Sprintf("failed to decode join response: %s", err.Error()))
+		return err
+	}
+
+	for serviceName, token := range joinResponse.Tokens {
+		if err := c.provider.writeTokenFile(serviceName, nil, token); err != nil {
+			return err
+		}
+	}
+
+	if c.joinConfig.CAOutputFile != "" && joinResponse.CA != "" {
+		if err := c.writeCA(joinResponse.CA); err != nil {
+			lc.Error(err.Error())
+			return err
+		}
+	}
+
+	lc.Info(fmt.Sprintf("joined primary node with %d service tokens", len(joinResponse.Tokens)))
+	return nil
+}
+
+// readJoinSecret reads the join secret of the node
+func (c *JoinClient) readJoinSecret() (string, error) {
+	reader, err := c.provider.fileOpener.OpenFileReader(c.joinConfig.JoinSecretFile, os.O_RDONLY, 0400)
+	if err != nil {
+		return "", fmt.Errorf("failed to read join secret %s: %s", c.joinConfig.JoinSecretFile, err.Error())
+	}
+	readCloser := fileioperformer.MakeReadCloser(reader)
+	defer readCloser.Close()
+
+	secret, err := ioutil.ReadAll(readCloser)
+	if err != nil {
+		return "", fmt.Errorf("failed to read join secret %s: %s", c.joinConfig.JoinSecretFile, err.Error())
+	}
+	joinSecret := strings.TrimSpace(string(secret))
+	if joinSecret == "" {
+		return "", fmt.Errorf("join secret %s is empty", c.joinConfig.JoinSecretFile)
+	}
+	return joinSecret, nil
+}
+
+// writeCA writes the CA certificate of the primary node
+func (c *JoinClient) writeCA(ca string) error {
+	caDir := filepath.Dir(c.joinConfig.CAOutputFile)
+	if err := c.provider.fileOpener.MkdirAll(caDir, os.FileMode(0755)); err != nil {
+		return fmt.Errorf("failed to create base directory path(s) %s: %s", caDir, err.Error())
+	}
+
+	writeCloser, err := c.provider.fileOpener.OpenFileWriter(c.joinConfig.CAOutputFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0644))
+	if err != nil {
+		return fmt.Errorf("failed open CA certificate for writing %s: %s", c.joinConfig.CAOutputFile, err.Error())
+	}
+	if _, err := writeCloser.Write([]byte(ca)); err != nil {
+		_ = writeCloser.Close()
+		return fmt.Errorf("failed to write CA certificate: %s", err.Error())
+	}
+	return writeCloser.Close()
+}
@@ -0,0 +1,164 @@
+//
+// Copyright (c) 2026 EdgeX Foundry Contributors
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under
+// the License.
+//
+// SPDX-License-Identifier: Apache-2.0'
+//
+
+package fileprovider
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/fileprovider/config"
+	. "github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	loaderMock "github.com/edgexfoundry/go-mod-secrets/pkg/token/authtokenloader/mocks"
+	fileMock "github.com/edgexfoundry/go-mod-secrets/pkg/token/fileioperformer/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nodesFile      = "nodes.json"
+	caFile         = "ca.pem"
+	caPem          = "-----BEGIN CERTIFICATE-----\nfake\n-----END CERTIFICATE-----\n"
+	joinSecretFile = "/run/edgex/join-secret"
+	caOutputFile   = "/run/edgex/ca/ca.pem"
+	joinSecret     = "s3cr3t"
+)
+
+func newTestJoinServer() (*httptest.Server, *MockSecretStoreClient) {
+	hash := sha256.Sum256([]byte(joinSecret))
+	nodes := `{"node-2":{"join_secret_sha256":"` + hex.EncodeToString(hash[:]) + `","services":["device-virtual","service1"]}}`
+
+	mockFileIoPerformer := &fileMock.FileIoPerformer{}
+	mockFileIoPerformer.On("OpenFileReader", nodesFile, os.O_RDONLY, os.FileMode(0400)).
+		Return(func(string, int, os.FileMode) io.Reader { return strings.NewReader(nodes) }, nil)
+	mockFileIoPerformer.On("OpenFileReader", configFile, os.O_RDONLY, os.FileMode(0400)).
+		Return(func(string, int, os.FileMode) io.Reader { return strings.NewReader(`{"service1":{}}`) }, nil)
+	mockFileIoPerformer.On("OpenFileReader", caFile, os.O_RDONLY, os.FileMode(0400)).
+		Return(func(string, int, os.FileMode) io.Reader { return strings.NewReader(caPem) }, nil)
+
+	mockAuthTokenLoader := &loaderMock.AuthTokenLoader{}
+	mockAuthTokenLoader.On("Load", privilegedTokenPath).Return("fake-priv-token", nil)
+
+	mockSecretStoreClient := &MockSecretStoreClient{}
+	mockSecretStoreClient.On("InstallPolicy", "fake-priv-token", mock.Anything, mock.Anything).Return(http.StatusNoContent, nil)
+	mockSecretStoreClient.On("CreateToken", "fake-priv-token", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			setCreateTokenResponse(args.Get(2).(*interface{}))
+		}).
+		Return(http.StatusOK, nil)
+
+	joinServer := NewJoinServer(logger.MockLogger{}, mockFileIoPerformer, mockAuthTokenLoader, mockSecretStoreClient,
+		config.TokenFileProviderInfo{PrivilegedTokenPath: privilegedTokenPath, ConfigFile: configFile},
+		config.RemoteNodesInfo{CAFile: caFile, NodesFile: nodesFile})
+	mux := http.NewServeMux()
+	mux.Handle(ApiJoinRoute, joinServer)
+	return httptest.NewTLSServer(mux), mockSecretStoreClient
+}
+
+func newTestJoinClient(server *httptest.Server, nodeName string, secret string) (*JoinClient, *fileMock.FileIoPerformer, map[string]*bytes.Buffer) {
+	buffers := map[string]*bytes.Buffer{
+		"device-virtual": new(bytes.Buffer),
+		"service1":       new(bytes.Buffer),
+		"ca":             new(bytes.Buffer),
+	}
+	mockFileIoPerformer := &fileMock.FileIoPerformer{}
+	mockFileIoPerformer.On("OpenFileReader", joinSecretFile, os.O_RDONLY, os.FileMode(0400)).Return(strings.NewReader(secret+"\n"), nil)
+	for _, serviceName := range []string{"device-virtual", "service1"} {
+		serviceDir := filepath.Join(outputDir, serviceName)
+		mockFileIoPerformer.On("MkdirAll", serviceDir, os.FileMode(0700)).Return(nil)
+		mockFileIoPerformer.On("OpenFileWriter", filepath.Join(serviceDir, outputFilename), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0600)).
+			Return(&writeCloserBuffer{buffers[serviceName]}, nil)
+	}
+	mockFileIoPerformer.On("MkdirAll", filepath.Dir(caOutputFile), os.FileMode(0755)).Return(nil)
+	mockFileIoPerformer.On("OpenFileWriter", caOutputFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0644)).
+		Return(&writeCloserBuffer{buffers["ca"]}, nil)
+
+	client := NewJoinClient(logger.MockLogger{}, mockFileIoPerformer, server.Client(),
+		config.TokenFileProviderInfo{OutputDir: outputDir, OutputFilename: outputFilename},
+		config.JoinInfo{
+			PrimaryUrl:     server.URL,
+			NodeName:       nodeName,
+			JoinSecretFile: joinSecretFile,
+			CAOutputFile:   caOutputFile,
+		})
+	return client, mockFileIoPerformer, buffers
+}
+
+func TestJoin(t *testing.T) {
+	server, mockSecretStoreClient := newTestJoinServer()
+	defer server.Close()
+	client, mockFileIoPerformer, buffers := newTestJoinClient(server, "node-2", joinSecret)
+
+	err := client.Run()
+
+	require.NoError(t, err)
+	mockFileIoPerformer.AssertExpectations(t)
+	mockSecretStoreClient.AssertCalled(t, "InstallPolicy", "fake-priv-token", "edgex-service-device-virtual", mock.Anything)
+	mockSecretStoreClient.AssertCalled(t, "InstallPolicy", "fake-priv-token", "edgex-service-service1", "{}")
+	mockSecretStoreClient.AssertNumberOfCalls(t, "CreateToken", 2)
+	assert.Equal(t, expectedTokenFile("device-virtual"), buffers["device-virtual"].Bytes())
+	assert.Equal(t, expectedTokenFile("service1"), buffers["service1"].Bytes())
+	assert.Equal(t, caPem, buffers["ca"].String())
+}
+
+func TestJoinRefused(t *testing.T) {
+	tests := []struct {
+		name     string
+		nodeName string
+		secret   string
+	}{
+		{"wrong secret", "node-2", "wrong"},
+		{"unknown node", "node-3", joinSecret},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mockSecretStoreClient := newTestJoinServer()
+			defer server.Close()
+			client, mockFileIoPerformer, _ := newTestJoinClient(server, tt.nodeName, tt.secret)
+
+			err := client.Run()
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "401")
+			mockSecretStoreClient.AssertNotCalled(t, "CreateToken", mock.Anything, mock.Anything, mock.Anything)
+			mockFileIoPerformer.AssertNotCalled(t, "OpenFileWriter", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestJoinMethodNotAllowed(t *testing.T) {
+	server, _ := newTestJoinServer()
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL + ApiJoinRoute)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
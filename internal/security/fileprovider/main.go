@@ -37,15 +37,19 @@ import (
 func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<- bool) {
 	startupTimer := startup.NewStartUpTimer(clients.SecurityFileTokenProviderServiceKey)
 
-	// All common command-line flags have been moved to DefaultCommonFlags. Service specific flags can be add here,
-	// by inserting service specific flag prior to call to commonFlags.Parse().
-	// Example:
-	// 		flags.FlagSet.StringVar(&myvar, "m", "", "Specify a ....")
-	//      ....
-	//      flags.Parse(os.Args[1:])
-	//
+	var serveJoin bool
+	var join bool
 
-	f := flags.New()
+	// All common command-line flags have been moved to bootstrap. Service specific flags are add here,
+	// but DO NOT call flag.Parse() as it is called by bootstrap.Run() below
+	// Service specific used is passed below.
+	f := flags.NewWithUsage(
+		"    --serveJoin                     Serves the joins of the secondary nodes once the tokens are generated\n" +
+			"    --join                          Joins the primary node to obtain the tokens instead of generating them",
+	)
+
+	f.FlagSet.BoolVar(&serveJoin, "serveJoin", false, "")
+	f.FlagSet.BoolVar(&join, "join", false, "")
 	f.Parse(os.Args[1:])
 
 	configuration := &config.ConfigurationStruct{}
@@ -55,7 +59,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 		},
 	})
 
-	bootStrapper := NewBootstrap()
+	bootStrapper := NewBootstrap(serveJoin, join)
 
 	bootstrap.Run(
 		ctx,
@@ -77,141 +77,165 @@ func (p *fileTokenProvider) Run() error {
 		return err
 	}
 
+	tokenConf, err := p.loadTokenConf()
+	if err != nil {
+		return err
+	}
+
+	for serviceName, serviceConfig := range tokenConf {
+		createTokenResponse, err := p.createServiceToken(privilegedToken, serviceName, serviceConfig)
+		if err != nil {
+			return err
+		}
+
+		if err := p.writeTokenFile(serviceName, serviceConfig.FilePermissions, createTokenResponse); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadTokenConf loads the token configuration file merged with the token configuration of the environment
+func (p *fileTokenProvider) loadTokenConf() (TokenConfFile, error) {
 	tokenConfEnv, err := GetTokenConfigFromEnv()
 	if err != nil {
 		p.logger.Error(fmt.Sprintf("failed to get token config from environment variable %s with error: %s", addSecretstoreTokensEnvKey, err.Error()))
-		return err
+		return nil, err
 	}
 
 	var tokenConf TokenConfFile
 	if err := LoadTokenConfig(p.fileOpener, p.tokenConfig.ConfigFile, &tokenConf); err != nil {
 		p.logger.Error(fmt.Sprintf("failed to read token configuration file %s: %s", p.tokenConfig.ConfigFile, err.Error()))
-		return err
+		return nil, err
 	}
 
 	// merge the additional token configuration list from environment variable
 	// note that the configuration file takes precedence, as the tokenConf will override
 	// the tokenConfEnv with same duplicate keys
 	// The tokenConfEnv only uses default settings.
-	tokenConf = tokenConfEnv.mergeWith(tokenConf)
+	return tokenConfEnv.mergeWith(tokenConf), nil
+}
 
-	for serviceName, serviceConfig := range tokenConf {
-		p.logger.Info(fmt.Sprintf("generating policy/token defaults for service %s", serviceName))
+// createServiceToken installs the policy of the service and creates its token, returning the Vault response
+func (p *fileTokenProvider) createServiceToken(privilegedToken string, serviceName string, serviceConfig ServiceKey) (interface{}, error) {
+	p.logger.Info(fmt.Sprintf("generating policy/token defaults for service %s", serviceName))
 
-		servicePolicy := make(map[string]interface{})
-		createTokenParameters := make(map[string]interface{})
+	servicePolicy := make(map[string]interface{})
+	createTokenParameters := make(map[string]interface{})
 
-		if serviceConfig.UseDefaults {
-			p.logger.Info(fmt.Sprintf("using policy/token defaults for service %s", serviceName))
-			servicePolicy = makeDefaultTokenPolicy(serviceName)
-			createTokenParameters = makeDefaultTokenParameters(serviceName)
-		}
+	if serviceConfig.UseDefaults {
+		p.logger.Info(fmt.Sprintf("using policy/token defaults for service %s", serviceName))
+		servicePolicy = makeDefaultTokenPolicy(serviceName)
+		createTokenParameters = makeDefaultTokenParameters(serviceName)
+	}
 
-		if serviceConfig.CustomPolicy != nil {
-			customPolicy := serviceConfig.CustomPolicy
-			if customPolicy["path"] != nil {
-				customPaths := customPolicy["path"].(map[string]interface{})
-				if servicePolicy["path"] == nil {
-					servicePolicy["path"] = make(map[string]interface{})
-				}
-				for k, v := range customPaths {
-					(servicePolicy["path"]).(map[string]interface{})[k] = v
-				}
+	if serviceConfig.CustomPolicy != nil {
+		customPolicy := serviceConfig.CustomPolicy
+		if customPolicy["path"] != nil {
+			customPaths := customPolicy["path"].(map[string]interface{})
+			if servicePolicy["path"] == nil {
+				servicePolicy["path"] = make(map[string]interface{})
+			}
+			for k, v := range customPaths {
+				(servicePolicy["path"]).(map[string]interface{})[k] = v
 			}
 		}
+	}
 
-		if serviceConfig.CustomTokenParameters != nil {
-			// Custom token parameters override the defaults
-			createTokenParameters = mergeMaps(createTokenParameters, serviceConfig.CustomTokenParameters)
-		}
+	if serviceConfig.CustomTokenParameters != nil {
+		// Custom token parameters override the defaults
+		createTokenParameters = mergeMaps(createTokenParameters, serviceConfig.CustomTokenParameters)
+	}
 
-		// Set a meta property that consuming serices can use to automatically scope secret queries
-		createTokenParameters["meta"] = map[string]interface{}{
-			"edgex-service-name": serviceName,
-		}
+	// Set a meta property that consuming serices can use to automatically scope secret queries
+	createTokenParameters["meta"] = map[string]interface{}{
+		"edgex-service-name": serviceName,
+	}
 
-		// Always create a policy with this name
-		policyName := "edgex-service-" + serviceName
+	// Always create a policy with this name
+	policyName := "edgex-service-" + serviceName
 
-		policyBytes, err := json.Marshal(servicePolicy)
-		if err != nil {
-			p.logger.Error(fmt.Sprintf("failed encode service policy for %s: %s", serviceName, err.Error()))
-			return err
-		}
+	policyBytes, err := json.Marshal(servicePolicy)
+	if err != nil {
+		p.logger.Error(fmt.Sprintf("failed encode service policy for %s: %s", serviceName, err.Error()))
+		return nil, err
+	}
 
-		if _, err := p.vaultClient.InstallPolicy(privilegedToken, policyName, string(policyBytes)); err != nil {
-			p.logger.Error(fmt.Sprintf("failed to install policy %s: %s", policyName, err.Error()))
-			return err
-		}
+	if _, err := p.vaultClient.InstallPolicy(privilegedToken, policyName, string(policyBytes)); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to install policy %s: %s", policyName, err.Error()))
+		return nil, err
+	}
 
-		var createTokenResponse interface{}
+	var createTokenResponse interface{}
 
-		if _, err = p.vaultClient.CreateToken(privilegedToken, createTokenParameters, &createTokenResponse); err != nil {
-			p.logger.Error(fmt.Sprintf("failed to create vault token for service %s: %s", serviceName, err.Error()))
-			return err
-		}
+	if _, err = p.vaultClient.CreateToken(privilegedToken, createTokenParameters, &createTokenResponse); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to create vault token for service %s: %s", serviceName, err.Error()))
+		return nil, err
+	}
 
-		outputTokenDir := filepath.Join(p.tokenConfig.OutputDir, serviceName)
-		outputTokenFilename := filepath.Join(outputTokenDir, p.tokenConfig.OutputFilename)
-		if err := p.fileOpener.MkdirAll(outputTokenDir, os.FileMode(0700)); err != nil {
-			p.logger.Error(fmt.Sprintf("failed to create base directory path(s) %s: %s", outputTokenDir, err.Error()))
-			return err
-		}
+	return createTokenResponse, nil
+}
 
-		p.logger.Info(fmt.Sprintf("creating token file %s", outputTokenFilename))
-		writeCloser, err := p.fileOpener.OpenFileWriter(outputTokenFilename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0600))
-		if err != nil {
-			p.logger.Error(fmt.Sprintf("failed open token file for writing %s: %s", outputTokenFilename, err.Error()))
-			return err
-		}
-		// writeCloser is writable file -- explicitly close() to ensure we catch errors writing to it
-
-		permissionable, ok := writeCloser.(permissionable)
-		if ok {
-			if serviceConfig.FilePermissions != nil &&
-				(serviceConfig.FilePermissions).ModeOctal != nil {
-				mode, err := strconv.ParseInt(*(serviceConfig.FilePermissions).ModeOctal, 8, 32)
-				if err != nil {
-					_ = writeCloser.Close()
-					p.logger.Error(fmt.Sprintf("invalid file mode %s: %s", *(serviceConfig.FilePermissions).ModeOctal, err.Error()))
-					return err
-				}
-				if err := permissionable.Chmod(os.FileMode(mode)); err != nil {
-					_ = writeCloser.Close()
-					p.logger.Error(fmt.Sprintf("failed to set file mode on %s: %s", outputTokenFilename, err.Error()))
-					return err
-				}
+// writeTokenFile writes the token of the service to {OutputDir}/{serviceName}/{OutputFilename}
+func (p *fileTokenProvider) writeTokenFile(serviceName string, filePermissions *FilePermissions, createTokenResponse interface{}) error {
+	outputTokenDir := filepath.Join(p.tokenConfig.OutputDir, serviceName)
+	outputTokenFilename := filepath.Join(outputTokenDir, p.tokenConfig.OutputFilename)
+	if err := p.fileOpener.MkdirAll(outputTokenDir, os.FileMode(0700)); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to create base directory path(s) %s: %s", outputTokenDir, err.Error()))
+		return err
+	}
+
+	p.logger.Info(fmt.Sprintf("creating token file %s", outputTokenFilename))
+	writeCloser, err := p.fileOpener.OpenFileWriter(outputTokenFilename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0600))
+	if err != nil {
+		p.logger.Error(fmt.Sprintf("failed open token file for writing %s: %s", outputTokenFilename, err.Error()))
+		return err
+	}
+	// writeCloser is writable file -- explicitly close() to ensure we catch errors writing to it
+
+	permissionable, ok := writeCloser.(permissionable)
+	if ok {
+		if filePermissions != nil && filePermissions.ModeOctal != nil {
+			mode, err := strconv.ParseInt(*filePermissions.ModeOctal, 8, 32)
+			if err != nil {
+				_ = writeCloser.Close()
+				p.logger.Error(fmt.Sprintf("invalid file mode %s: %s", *filePermissions.ModeOctal, err.Error()))
+				return err
 			}
-			if serviceConfig.FilePermissions != nil &&
-				(serviceConfig.FilePermissions).Uid != nil &&
-				(serviceConfig.FilePermissions).Gid != nil {
-				err := permissionable.Chown(*(serviceConfig.FilePermissions).Uid, *(serviceConfig.FilePermissions).Gid)
-				if err != nil {
-					_ = writeCloser.Close()
-					p.logger.Error(fmt.Sprintf("failed to set file user/group on %s: %s", outputTokenFilename, err.Error()))
-					return err
-				}
+			if err := permissionable.Chmod(os.FileMode(mode)); err != nil {
+				_ = writeCloser.Close()
+				p.logger.Error(fmt.Sprintf("failed to set file mode on %s: %s", outputTokenFilename, err.Error()))
+				return err
 			}
 		}
-
-		encoder := json.NewEncoder(writeCloser)
-		if encoder == nil {
-			_ = writeCloser.Close()
-			err = fmt.Errorf("unable to create JSON output encoder")
-			return err
+		if filePermissions != nil && filePermissions.Uid != nil && filePermissions.Gid != nil {
+			err := permissionable.Chown(*filePermissions.Uid, *filePermissions.Gid)
+			if err != nil {
+				_ = writeCloser.Close()
+				p.logger.Error(fmt.Sprintf("failed to set file user/group on %s: %s", outputTokenFilename, err.Error()))
+				return err
+			}
 		}
+	}
 
-		// Write resulting token
-		if err := encoder.Encode(createTokenResponse); err != nil {
-			_ = writeCloser.Close()
-			p.logger.Error(fmt.Sprintf("failed to write token file: %s", err.Error()))
-			return err
-		}
+	encoder := json.NewEncoder(writeCloser)
+	if encoder == nil {
+		_ = writeCloser.Close()
+		err = fmt.Errorf("unable to create JSON output encoder")
+		return err
+	}
 
-		if err := writeCloser.Close(); err != nil {
-			p.logger.Error(fmt.Sprintf("failed to close %s: %s", outputTokenFilename, err.Error()))
-			return err
-		}
+	// Write resulting token
+	if err := encoder.Encode(createTokenResponse); err != nil {
+		_ = writeCloser.Close()
+		p.logger.Error(fmt.Sprintf("failed to write token file: %s", err.Error()))
+		return err
+	}
+
+	if err := writeCloser.Close(); err != nil {
+		p.logger.Error(fmt.Sprintf("failed to close %s: %s", outputTokenFilename, err.Error()))
+		return err
 	}
 
 	return nil
@@ -31,6 +31,9 @@ type ConfigurationStruct struct {
 	SecretStore   bootstrapConfig.SecretStoreInfo
 	SecretService SecretServiceInfo
 	Clients       map[string]bootstrapConfig.ClientInfo
+	RouteWatch    RouteWatchInfo
+	// TrustedHeaders forwards the validated claims of the callers to the services in signed headers
+	TrustedHeaders TrustedHeadersInfo
 }
 
 type WritableInfo struct {
@@ -89,6 +92,37 @@ func (s SecretServiceInfo) GetSecretSvcBaseURL() string {
 	return fmt.Sprintf("%s://%s:%d", s.Protocol, s.Server, s.Port)
 }
 
+// RouteWatchInfo configures the registry watch which adds proxy routes for services registered after setup ran
+type RouteWatchInfo struct {
+	Protocol string
+	Host     string
+	Port     int
+	// Interval is how often the registry is polled for new services
+	Interval string
+	// ServicePrefixes limits the watch to registered services whose name starts with one of the prefixes
+	ServicePrefixes []string
+}
+
+// TrustedHeadersInfo configures the claims of the callers' JWTs the gateway forwards to the services in the trusted
+// headers, signed with a key shared with the services
+type TrustedHeadersInfo struct {
+	// KeyPath is the file holding the key the trusted headers are signed with, generated when missing. The claims
+	// aren't forwarded when empty.
+	KeyPath string
+	// KeyEnv is the environment variable of Kong holding the key of KeyPath, which the Lua code of the plugin reads so
+	// that the key isn't exposed by the admin API
+	KeyEnv string
+	// SubjectClaim, RolesClaim and TenantClaim are the names of the JWT claims forwarded as the subject, roles and
+	// tenant of the caller. The roles claim may hold a string or an array of strings.
+	SubjectClaim string
+	RolesClaim   string
+	TenantClaim  string
+}
+
+func (r RouteWatchInfo) GetRegistryBaseURL() string {
+	return fmt.Sprintf("%s://%s:%d", r.Protocol, r.Host, r.Port)
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -39,6 +39,7 @@ type Bootstrap struct {
 	userTobeCreated    string
 	userOfGroup        string
 	userToBeDeleted    string
+	watchRegistry      bool
 }
 
 func NewBootstrap(
@@ -47,7 +48,8 @@ func NewBootstrap(
 	resetNeeded bool,
 	userTobeCreated string,
 	userOfGroup string,
-	userToBeDeleted string) *Bootstrap {
+	userToBeDeleted string,
+	watchRegistry bool) *Bootstrap {
 
 	return &Bootstrap{
 		insecureSkipVerify: insecureSkipVerify,
@@ -56,6 +58,7 @@ func NewBootstrap(
 		userTobeCreated:    userTobeCreated,
 		userOfGroup:        userOfGroup,
 		userToBeDeleted:    userToBeDeleted,
+		watchRegistry:      watchRegistry,
 	}
 }
 
@@ -71,7 +74,7 @@ func (b *Bootstrap) haltIfError(lc logger.LoggingClient, err error) {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the data service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	configuration := container.ConfigurationFrom(dic.Get)
 
@@ -132,5 +135,11 @@ func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ sta
 		b.haltIfError(lc, t.Delete())
 	}
 
+	if b.watchRegistry {
+		// keep running so services registered later get their proxy routes
+		b.haltIfError(lc, s.WatchRegistry(ctx, wg))
+		return true
+	}
+
 	return false
 }
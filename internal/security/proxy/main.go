@@ -21,12 +21,12 @@ import (
 	"os"
 
 	"github.com/edgexfoundry/edgex-go/internal"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers/secret"
 	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
 	"github.com/edgexfoundry/edgex-go/internal/security/proxy/container"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/flags"
-	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/handlers"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -44,6 +44,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 	var userTobeCreated string
 	var userOfGroup string
 	var userToBeDeleted string
+	var watchRegistry bool
 
 	// All common command-line flags have been moved to bootstrap. Service specific flags are added below.
 	f := flags.NewWithUsage(
@@ -52,7 +53,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 			"    --reset=true/false              Indicate if security service should be reset to initialization status\n" +
 			"    --useradd=<username>            Create an account and return JWT\n" +
 			"    --group=<groupname>             Group name the user belongs to\n" +
-			"    --userdel=<username>            Delete an account",
+			"    --userdel=<username>            Delete an account\n" +
+			"    --watch=true/false              Keep running and add proxy routes for services registered in the registry",
 	)
 
 	if len(os.Args) < 2 {
@@ -65,6 +67,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 	f.FlagSet.StringVar(&userTobeCreated, "useradd", "", "")
 	f.FlagSet.StringVar(&userOfGroup, "group", "user", "")
 	f.FlagSet.StringVar(&userToBeDeleted, "userdel", "", "")
+	f.FlagSet.BoolVar(&watchRegistry, "watch", false, "")
 	f.Parse(os.Args[1:])
 
 	configuration := &config.ConfigurationStruct{}
@@ -84,14 +87,15 @@ func Main(ctx context.Context, cancel context.CancelFunc, _ *mux.Router, _ chan<
 		startupTimer,
 		dic,
 		[]interfaces.BootstrapHandler{
-			handlers.SecureProviderBootstrapHandler,
+			secret.BootstrapHandler,
 			NewBootstrap(
 				insecureSkipVerify,
 				initNeeded,
 				resetNeeded,
 				userTobeCreated,
 				userOfGroup,
-				userToBeDeleted).BootstrapHandler,
+				userToBeDeleted,
+				watchRegistry).BootstrapHandler,
 		},
 	)
 }
@@ -0,0 +1,192 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+const (
+	registryCatalogServicesPath = "v1/catalog/services"
+	registryCatalogServicePath  = "v1/catalog/service"
+)
+
+// clientServiceKeys are the registry service keys of the clients, by lower case client name
+var clientServiceKeys = map[string]string{
+	"coredata":      clients.CoreDataServiceKey,
+	"metadata":      clients.CoreMetaDataServiceKey,
+	"command":       clients.CoreCommandServiceKey,
+	"notifications": clients.SupportNotificationsServiceKey,
+	"scheduler":     clients.SupportSchedulerServiceKey,
+	"logging":       clients.SupportLoggingServiceKey,
+	"rulesengine":   "edgex-support-rulesengine",
+	"virtualdevice": "edgex-device-virtual",
+}
+
+// registryServiceInstance is an instance of a service as listed in the registry catalog
+type registryServiceInstance struct {
+	Address        string
+	ServiceName    string
+	ServiceAddress string
+	ServicePort    int
+}
+
+// WatchRegistry polls the registry until ctx is cancelled and sets up a proxy service and route for every registered
+// service matching the configured prefixes which doesn't have one yet. Authentication and the ACL are global Kong
+// plugins and the certificate is bound by SNI, so they cover the new routes as well; the plugins are ensured once
+// before watching in case setup was never run with --init.
+func (s *Service) WatchRegistry(ctx context.Context, wg *sync.WaitGroup) error {
+	interval, err := time.ParseDuration(s.configuration.RouteWatch.Interval)
+	if err != nil {
+		return fmt.Errorf("invalid RouteWatch interval '%s': %s", s.configuration.RouteWatch.Interval, err.Error())
+	}
+
+	if err = s.initAuthMethod(s.configuration.KongAuth.Name, s.configuration.KongAuth.TokenTTL); err != nil {
+		return err
+	}
+	if err = s.initACL(s.configuration.KongACL.Name, s.configuration.KongACL.WhiteList); err != nil {
+		return err
+	}
+	if err = s.initTrustedHeaders(); err != nil {
+		return err
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		s.loggingClient.Info(fmt.Sprintf("watching %s for services to add proxy routes for",
+			s.configuration.RouteWatch.GetRegistryBaseURL()))
+		for {
+			if err := s.syncRegistryRoutes(); err != nil {
+				s.loggingClient.Error(fmt.Sprintf("failed to sync proxy routes with the registry: %s", err.Error()))
+			}
+
+			select {
+			case <-ctx.Done():
+				s.loggingClient.Info("stopped watching the registry for proxy routes")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// syncRegistryRoutes sets up the proxy service and route of each watched service in the registry which isn't routed
+// yet. A service that fails is retried on the next sync without holding up the others.
+func (s *Service) syncRegistryRoutes() error {
+	var names map[string][]string
+	if err := s.getRegistry(registryCatalogServicesPath, &names); err != nil {
+		return err
+	}
+
+	for name := range names {
+		routeName := strings.ToLower(name)
+		if _, exists := s.routes[routeName]; exists || !s.isWatchedService(name) {
+			continue
+		}
+
+		var instances []registryServiceInstance
+		if err := s.getRegistry(registryCatalogServicePath+"/"+url.PathEscape(name), &instances); err != nil {
+			s.loggingClient.Error(err.Error())
+			continue
+		}
+		if len(instances) == 0 {
+			continue
+		}
+
+		host := instances[0].ServiceAddress
+		if host == "" {
+			host = instances[0].Address
+		}
+		serviceParams := &KongService{
+			Name:     routeName,
+			Host:     host,
+			Port:     instances[0].ServicePort,
+			Protocol: "http",
+		}
+		if err := s.initKongService(serviceParams); err != nil {
+			continue
+		}
+
+		routeParams := &KongRoute{
+			Paths: []string{"/" + routeName},
+			Name:  routeName,
+		}
+		if err := s.initKongRoutes(routeParams, routeName); err != nil {
+			continue
+		}
+		s.loggingClient.Info(fmt.Sprintf("added proxy route /%s for registered service %s", routeName, name))
+	}
+
+	return nil
+}
+
+// isWatchedService reports whether name matches one of the configured prefixes and isn't the registry service key of
+// one of the configured clients, which are set up by --init instead. A client of unknown service key is taken to be
+// named after it.
+func (s *Service) isWatchedService(name string) bool {
+	for clientName := range s.configuration.Clients {
+		serviceKey, known := clientServiceKeys[strings.ToLower(clientName)]
+		if !known {
+			serviceKey = clientName
+		}
+		if strings.EqualFold(serviceKey, name) {
+			return false
+		}
+	}
+	for _, prefix := range s.configuration.RouteWatch.ServicePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) getRegistry(path string, result interface{}) error {
+	tokens := []string{s.configuration.RouteWatch.GetRegistryBaseURL(), path}
+	req, err := http.NewRequest(http.MethodGet, strings.Join(tokens, "/"), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create registry request for %s: %s", path, err.Error())
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get %s from the registry: %s", path, err.Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %s from the registry with HTTP error code %d", path, resp.StatusCode)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode %s from the registry: %s", path, err.Error())
+	}
+	return nil
+}
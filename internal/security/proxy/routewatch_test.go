@@ -0,0 +1,114 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncRegistryRoutes(t *testing.T) {
+	var mutex sync.Mutex
+	var posted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/catalog/services":
+			_, _ = w.Write([]byte(`{"app-rules":[],"edgex-core-data":[],"app-broken":[],"consul":[]}`))
+		case "/v1/catalog/service/app-rules":
+			_, _ = w.Write([]byte(`[{"Address":"10.0.0.1","ServiceName":"app-rules","ServiceAddress":"app-rules","ServicePort":48095}]`))
+		case "/v1/catalog/service/app-broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			require.Equal(t, http.MethodPost, r.Method)
+			if r.URL.Path == "/services" {
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "app-rules", r.PostForm.Get("host"))
+				assert.Equal(t, "48095", r.PostForm.Get("port"))
+			}
+			mutex.Lock()
+			posted = append(posted, r.URL.Path)
+			mutex.Unlock()
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer ts.Close()
+
+	host, port, err := parseHostAndPort(ts, t)
+	require.NoError(t, err)
+	configuration := &config.ConfigurationStruct{
+		KongURL: config.KongUrlInfo{Server: host, AdminPort: port},
+		RouteWatch: config.RouteWatchInfo{
+			Protocol:        "http",
+			Host:            host,
+			Port:            port,
+			Interval:        "30s",
+			ServicePrefixes: []string{"app-", "edgex-"},
+		},
+		Clients: map[string]bootstrapConfig.ClientInfo{"CoreData": {}},
+	}
+
+	mockLogger := logger.MockLogger{}
+	service := NewService(NewRequestor(true, 10, "", mockLogger), mockLogger, configuration)
+	require.NoError(t, service.syncRegistryRoutes())
+	assert.Equal(t, []string{"/services", "/services/app-rules/routes"}, posted)
+	assert.Contains(t, service.routes, "app-rules")
+
+	// already routed services are skipped on the next sync
+	posted = nil
+	require.NoError(t, service.syncRegistryRoutes())
+	assert.Empty(t, posted)
+}
+
+func TestIsWatchedService(t *testing.T) {
+	configuration := &config.ConfigurationStruct{
+		RouteWatch: config.RouteWatchInfo{ServicePrefixes: []string{"app-", "edgex-"}},
+		Clients: map[string]bootstrapConfig.ClientInfo{
+			"CoreData":      {},
+			"Rulesengine":   {},
+			"app-functions": {},
+		},
+	}
+	mockLogger := logger.MockLogger{}
+	service := NewService(NewRequestor(true, 10, "", mockLogger), mockLogger, configuration)
+
+	tests := []struct {
+		name     string
+		service  string
+		expected bool
+	}{
+		{"client", "edgex-core-data", false},
+		{"client of other case", "edgex-support-rulesengine", false},
+		{"client of unknown service key", "app-functions", false},
+		{"not a client", "edgex-core-metadata", true},
+		{"prefixed", "app-rules", true},
+		{"not prefixed", "consul", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, service.isWatchedService(tt.service))
+		})
+	}
+}
@@ -176,6 +176,11 @@ func (s *Service) Init() error {
 		return err
 	}
 
+	err = s.initTrustedHeaders()
+	if err != nil {
+		return err
+	}
+
 	s.loggingClient.Info("finishing initialization for reverse proxy")
 	return nil
 }
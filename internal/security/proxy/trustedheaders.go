@@ -0,0 +1,169 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package proxy
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+)
+
+// trustedHeadersPluginID is the ID of the global Kong plugin setting the trusted headers, fixed so that setting it up
+// again replaces it with the current claims
+const trustedHeadersPluginID = "8b6d3a8e-5f2c-4c1e-9d47-3f0e2b7a1c55"
+
+// trustedHeadersPluginCode is the Lua code the Kong post-function plugin runs once the jwt plugin validated the token.
+// It clears the trusted headers sent by the caller, then sets them from the claims of the token, signed like
+// claims.Sign for the method and path of the upstream request. The key is read from the environment of Kong rather
+// than held by the code, which the admin API returns to any reader of the plugin.
+const trustedHeadersPluginCode = `return function()
+  for _, h in ipairs({%s}) do kong.service.request.clear_header(h) end
+  local key = os.getenv(%s)
+  if not key or key == "" then return end
+  local token = kong.ctx.shared.authenticated_jwt_token
+  if not token then return end
+  local payload = token:match("^[^.]+%%.([^.]+)%%.")
+  if not payload then return end
+  payload = payload:gsub("-", "+"):gsub("_", "/")
+  payload = payload .. string.rep("=", (4 - #payload %% 4) %% 4)
+  local decoded = ngx.decode_base64(payload)
+  local jwt = decoded and require("cjson.safe").decode(decoded)
+  if type(jwt) ~= "table" then return end
+  local function value(v)
+    if type(v) == "table" then return table.concat(v, ",") end
+    if v == nil then return "" end
+    return tostring(v)
+  end
+  local subject, roles, tenant = value(jwt[%s]), value(jwt[%s]), value(jwt[%s])
+  local timestamp = tostring(ngx.time())
+  local path = ngx.var.upstream_uri:match("^[^?]*")
+  local signed = table.concat({subject, roles, tenant, timestamp, kong.request.get_method(), path}, "\n")
+  local signature = ngx.encode_base64(ngx.hmac_sha1(key, signed))
+  kong.service.request.set_header(%s, subject)
+  kong.service.request.set_header(%s, roles)
+  kong.service.request.set_header(%s, tenant)
+  kong.service.request.set_header(%s, timestamp)
+  kong.service.request.set_header(%s, signature)
+end`
+
+// kongFunctionPlugin is a Kong serverless function plugin
+type kongFunctionPlugin struct {
+	Name   string             `json:"name"`
+	Config kongFunctionConfig `json:"config"`
+}
+
+type kongFunctionConfig struct {
+	Access []string `json:"access"`
+}
+
+// initTrustedHeaders sets up the global plugin forwarding the validated claims of the callers to the services in
+// trusted headers, signed with the key kept at the configured path, which is generated when missing. Kong reads the
+// key from the configured environment variable, which the deployment sets from the file. The claims are only known
+// with jwt authentication, the plugin isn't set up otherwise.
+func (s *Service) initTrustedHeaders() error {
+	info := s.configuration.TrustedHeaders
+	if info.KeyPath == "" {
+		return nil
+	}
+	if s.configuration.KongAuth.Name != "jwt" {
+		s.loggingClient.Warn(fmt.Sprintf("trusted headers not set up, they require jwt authentication rather than %s",
+			s.configuration.KongAuth.Name))
+		return nil
+	}
+	if info.KeyEnv == "" {
+		return errors.New("trusted headers require the environment variable holding the key in Kong")
+	}
+
+	if _, err := loadOrCreateKey(info.KeyPath); err != nil {
+		return fmt.Errorf("failed to load the key of the trusted headers: %s", err.Error())
+	}
+
+	quoted := make([]string, len(claims.Headers))
+	for i, h := range claims.Headers {
+		quoted[i] = strconv.Quote(h)
+	}
+	code := fmt.Sprintf(trustedHeadersPluginCode,
+		strings.Join(quoted, ", "),
+		strconv.Quote(info.KeyEnv),
+		strconv.Quote(info.SubjectClaim), strconv.Quote(info.RolesClaim), strconv.Quote(info.TenantClaim),
+		strconv.Quote(claims.SubjectHeader), strconv.Quote(claims.RolesHeader), strconv.Quote(claims.TenantHeader),
+		strconv.Quote(claims.TimestampHeader), strconv.Quote(claims.SignatureHeader))
+
+	body, err := json.Marshal(kongFunctionPlugin{Name: "post-function", Config: kongFunctionConfig{Access: []string{code}}})
+	if err != nil {
+		return err
+	}
+	tokens := []string{s.configuration.KongURL.GetProxyBaseURL(), PluginsPath, trustedHeadersPluginID}
+	req, err := http.NewRequest(http.MethodPut, strings.Join(tokens, "/"), bytes.NewReader(body))
+	if err != nil {
+		e := fmt.Sprintf("failed to create trusted headers request -- %s", err.Error())
+		s.loggingClient.Error(e)
+		return err
+	}
+	req.Header.Add(clients.ContentType, clients.ContentTypeJSON)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		e := fmt.Sprintf("failed to set up trusted headers -- %s", err.Error())
+		s.loggingClient.Error(e)
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		s.loggingClient.Info("successful to set up trusted headers")
+	default:
+		e := fmt.Sprintf("failed to set up trusted headers with errorcode %d", resp.StatusCode)
+		s.loggingClient.Error(e)
+		return errors.New(e)
+	}
+	return nil
+}
+
+// loadOrCreateKey returns the key kept at the path, generating a random one readable by its owner only when the file
+// doesn't exist
+func loadOrCreateKey(path string) ([]byte, error) {
+	key, err := claims.LoadKey(path)
+	if err == nil || !os.IsNotExist(err) {
+		return key, err
+	}
+
+	b := make([]byte, 32)
+	if _, err = rand.Read(b); err != nil {
+		return nil, err
+	}
+	key = []byte(hex.EncodeToString(b))
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(path, key, 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
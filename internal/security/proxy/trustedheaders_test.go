@@ -0,0 +1,102 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package proxy
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/security/proxy/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitTrustedHeaders(t *testing.T) {
+	var plugins []kongFunctionPlugin
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/"+PluginsPath+"/"+trustedHeadersPluginID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var plugin kongFunctionPlugin
+		if err := json.NewDecoder(r.Body).Decode(&plugin); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		plugins = append(plugins, plugin)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	host, port, err := parseHostAndPort(ts, t)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "trustedheaders")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	keyPath := filepath.Join(dir, "secrets", "claims.key")
+
+	cfg := config.ConfigurationStruct{
+		KongURL:  config.KongUrlInfo{Server: host, AdminPort: port},
+		KongAuth: config.KongAuthInfo{Name: "jwt"},
+		TrustedHeaders: config.TrustedHeadersInfo{
+			KeyPath:      keyPath,
+			KeyEnv:       "EDGEX_TRUSTED_HEADERS_KEY",
+			SubjectClaim: "sub",
+			RolesClaim:   "roles",
+			TenantClaim:  "tenant",
+		},
+	}
+	svc := NewService(&http.Client{}, logger.MockLogger{}, &cfg)
+
+	// The key is generated on the first setup and kept on the next ones
+	require.NoError(t, svc.initTrustedHeaders())
+	require.NoError(t, svc.initTrustedHeaders())
+	key, err := claims.LoadKey(keyPath)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, plugins[0], plugins[1])
+	assert.Equal(t, "post-function", plugins[0].Name)
+	require.Len(t, plugins[0].Config.Access, 1)
+	code := plugins[0].Config.Access[0]
+	for _, s := range []string{`os.getenv("EDGEX_TRUSTED_HEADERS_KEY")`, `jwt["sub"]`, `jwt["roles"]`, `jwt["tenant"]`,
+		`kong.request.get_method()`, `ngx.var.upstream_uri`, `"` + claims.SignatureHeader + `"`} {
+		assert.True(t, strings.Contains(code, s), "plugin code missing %s", s)
+	}
+	assert.False(t, strings.Contains(code, string(key)), "plugin code holds the key")
+
+	// Not set up without a key path or with oauth2 authentication
+	plugins = nil
+	oauth2 := cfg
+	oauth2.KongAuth.Name = "oauth2"
+	disabled := cfg
+	disabled.TrustedHeaders.KeyPath = ""
+	for _, c := range []config.ConfigurationStruct{oauth2, disabled} {
+		c := c
+		svc := NewService(&http.Client{}, logger.MockLogger{}, &c)
+		require.NoError(t, svc.initTrustedHeaders())
+	}
+	assert.Empty(t, plugins)
+}
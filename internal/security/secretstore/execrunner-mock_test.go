@@ -21,6 +21,10 @@ func (m *mockExecRunner) SetStdout(stdout io.Writer) {
 	m.Called(stdout)
 }
 
+func (m *mockExecRunner) SetStdin(stdin io.Reader) {
+	m.Called(stdin)
+}
+
 func (m *mockExecRunner) LookPath(file string) (string, error) {
 	arguments := m.Called(file)
 	return arguments.String(0), arguments.Error(1)
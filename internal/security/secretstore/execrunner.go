@@ -22,11 +22,13 @@ type CmdRunner interface {
 // ExecRunner is mockable interface for wrapping os/exec functionality
 type ExecRunner interface {
 	SetStdout(stdout io.Writer)
+	SetStdin(stdin io.Reader)
 	LookPath(file string) (string, error)
 	CommandContext(ctx context.Context, name string, arg ...string) CmdRunner
 }
 
 type execWrapper struct {
+	Stdin  io.Reader
 	Stdout io.Writer
 	Stderr io.Writer
 }
@@ -45,6 +47,11 @@ func (w *execWrapper) SetStdout(stdout io.Writer) {
 	w.Stdout = stdout
 }
 
+// SetStdin allows feeding subprocesses' stdin (for passing secrets without writing them to disk)
+func (w *execWrapper) SetStdin(stdin io.Reader) {
+	w.Stdin = stdin
+}
+
 // LookPath wraps os/exec.LookPath
 func (w *execWrapper) LookPath(file string) (string, error) {
 	return exec.LookPath(file)
@@ -53,6 +60,7 @@ func (w *execWrapper) LookPath(file string) (string, error) {
 // CommandContext wraps os/exec.CommandContext
 func (w *execWrapper) CommandContext(ctx context.Context, name string, arg ...string) CmdRunner {
 	cmd := exec.CommandContext(ctx, name, arg...)
+	cmd.Stdin = w.Stdin
 	cmd.Stdout = w.Stdout
 	cmd.Stderr = w.Stderr
 	return cmd
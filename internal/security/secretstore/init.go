@@ -89,7 +89,17 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 	vmkEncryption := NewVMKEncryption(fileOpener, pipedHexReader, kdf)
 
 	hook := os.Getenv("IKM_HOOK")
-	if len(hook) > 0 {
+	if configuration.SecretService.TpmSealing.Enabled {
+		sealer := NewTpmSealer(ctx, lc, NewDefaultExecRunner(), configuration.SecretService)
+		ikm, err := sealer.LoadIKM()
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to unseal vault master key encryption key material from the TPM: %s", err.Error()))
+			return false
+		}
+		vmkEncryption.SetIKM(ikm)
+		defer vmkEncryption.WipeIKM() // Ensure IKM is wiped from memory
+		lc.Info("Enabled encryption of Vault master key with TPM sealed key material")
+	} else if len(hook) > 0 {
 		err := vmkEncryption.LoadIKM(hook)
 		defer vmkEncryption.WipeIKM() // Ensure IKM is wiped from memory
 		if err != nil {
@@ -0,0 +1,147 @@
+//
+// Copyright (c) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+const (
+	// tpmSealedPublicFile and tpmSealedPrivateFile hold the sealed input key material in the token folder, they can
+	// only be loaded by the TPM which sealed them
+	tpmSealedPublicFile  = "tpm-ikm.pub"
+	tpmSealedPrivateFile = "tpm-ikm.priv"
+	tpmIkmLength         = 32
+)
+
+// TpmSealer seals the input key material of the Vault master key encryption to a TPM 2.0 device by running the
+// tpm2-tools, so the key shares on disk can only be decrypted on the same hardware
+type TpmSealer struct {
+	ctx        context.Context
+	lc         logger.LoggingClient
+	execRunner ExecRunner
+	config     secretstoreclient.TpmSealingInfo
+	folder     string
+}
+
+// NewTpmSealer creates a TpmSealer keeping the sealed key material in the token folder of the secret service
+func NewTpmSealer(
+	ctx context.Context,
+	lc logger.LoggingClient,
+	execRunner ExecRunner,
+	secretConfig secretstoreclient.SecretServiceInfo) *TpmSealer {
+
+	return &TpmSealer{
+		ctx:        ctx,
+		lc:         lc,
+		execRunner: execRunner,
+		config:     secretConfig.TpmSealing,
+		folder:     secretConfig.TokenFolderPath,
+	}
+}
+
+// LoadIKM unseals the input key material, creating and sealing it on first use
+func (s *TpmSealer) LoadIKM() ([]byte, error) {
+	publicPath := filepath.Join(s.folder, tpmSealedPublicFile)
+	privatePath := filepath.Join(s.folder, tpmSealedPrivateFile)
+
+	workDir, err := ioutil.TempDir("", "tpm")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TPM working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+	primaryContext := filepath.Join(workDir, "primary.ctx")
+
+	if _, err := s.run(nil, "tpm2_createprimary", "-C", "o", "-c", primaryContext); err != nil {
+		return nil, err
+	}
+
+	if !fileExists(publicPath) || !fileExists(privatePath) {
+		s.lc.Info("sealing new vault master key encryption key material to the TPM")
+		ikm := make([]byte, tpmIkmLength)
+		if _, err := rand.Read(ikm); err != nil {
+			return nil, fmt.Errorf("failed to generate input key material: %w", err)
+		}
+
+		args := []string{"-C", primaryContext, "-i", "-", "-u", publicPath, "-r", privatePath}
+		if s.config.PcrList != "" {
+			policy := filepath.Join(workDir, "pcr.policy")
+			if _, err := s.run(nil, "tpm2_createpolicy", "--policy-pcr", "-l", s.config.PcrList, "-L", policy); err != nil {
+				wipeKey(ikm)
+				return nil, err
+			}
+			// without userwithauth the sealed object can only be unsealed by satisfying the PCR policy
+			args = append(args, "-L", policy, "-a", "fixedtpm|fixedparent")
+		}
+		if _, err := s.run(ikm, "tpm2_create", args...); err != nil {
+			wipeKey(ikm)
+			return nil, err
+		}
+		return ikm, nil
+	}
+
+	objectContext := filepath.Join(workDir, "ikm.ctx")
+	if _, err := s.run(nil, "tpm2_load", "-C", primaryContext, "-u", publicPath, "-r", privatePath, "-c", objectContext); err != nil {
+		return nil, err
+	}
+	args := []string{"-c", objectContext}
+	if s.config.PcrList != "" {
+		args = append(args, "-p", "pcr:"+s.config.PcrList)
+	}
+	ikm, err := s.run(nil, "tpm2_unseal", args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(ikm) != tpmIkmLength {
+		wipeKey(ikm)
+		return nil, fmt.Errorf("unsealed %d bytes of input key material, expecting %d", len(ikm), tpmIkmLength)
+	}
+	return ikm, nil
+}
+
+// run runs a tpm2-tools command with stdin and returns its stdout
+func (s *TpmSealer) run(stdin []byte, tool string, args ...string) ([]byte, error) {
+	if s.config.ToolsPath != "" {
+		tool = filepath.Join(s.config.ToolsPath, tool)
+	}
+	path, err := s.execRunner.LookPath(tool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate %s: %w", tool, err)
+	}
+	if s.config.Tcti != "" {
+		args = append([]string{"--tcti=" + s.config.Tcti}, args...)
+	}
+
+	var stdout bytes.Buffer
+	s.execRunner.SetStdout(&stdout)
+	s.execRunner.SetStdin(bytes.NewReader(stdin))
+	defer s.execRunner.SetStdin(nil)
+
+	cmd := s.execRunner.CommandContext(s.ctx, path, args...)
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("%s failed to launch: %w", tool, err)
+	}
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w", tool, err)
+	}
+	return stdout.Bytes(), nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,144 @@
+//
+// Copyright (c) 2026 EdgeX Foundry Contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/security/secretstoreclient"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTpm simulates the tpm2-tools sealing data to an object file and unsealing it
+type fakeTpm struct {
+	stdin    io.Reader
+	stdout   io.Writer
+	commands []string
+	fail     string
+}
+
+type fakeTpmCmd struct {
+	run func() error
+}
+
+func (c *fakeTpmCmd) Start() error { return nil }
+func (c *fakeTpmCmd) Wait() error  { return c.run() }
+
+func (f *fakeTpm) SetStdout(stdout io.Writer) { f.stdout = stdout }
+func (f *fakeTpm) SetStdin(stdin io.Reader)   { f.stdin = stdin }
+func (f *fakeTpm) LookPath(file string) (string, error) {
+	return file, nil
+}
+
+func (f *fakeTpm) CommandContext(_ context.Context, name string, arg ...string) CmdRunner {
+	f.commands = append(f.commands, name+" "+strings.Join(arg, " "))
+	stdin, stdout := f.stdin, f.stdout
+	option := func(flag string) string {
+		for i := range arg[:len(arg)-1] {
+			if arg[i] == flag {
+				return arg[i+1]
+			}
+		}
+		return ""
+	}
+	return &fakeTpmCmd{run: func() error {
+		if filepath.Base(name) == f.fail {
+			return errors.New("tpm failure")
+		}
+		switch filepath.Base(name) {
+		case "tpm2_create":
+			secret, err := ioutil.ReadAll(stdin)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(option("-u"), []byte("public"), 0600); err != nil {
+				return err
+			}
+			return ioutil.WriteFile(option("-r"), secret, 0600)
+		case "tpm2_load":
+			secret, err := ioutil.ReadFile(option("-r"))
+			if err != nil {
+				return err
+			}
+			return ioutil.WriteFile(option("-c"), secret, 0600)
+		case "tpm2_unseal":
+			secret, err := ioutil.ReadFile(option("-c"))
+			if err != nil {
+				return err
+			}
+			_, err = stdout.Write(secret)
+			return err
+		}
+		return nil
+	}}
+}
+
+func TestTpmSealerLoadIKM(t *testing.T) {
+	folder, err := ioutil.TempDir("", "tpmsealing")
+	require.NoError(t, err)
+	defer os.RemoveAll(folder)
+
+	config := secretstoreclient.SecretServiceInfo{
+		TokenFolderPath: folder,
+		TpmSealing: secretstoreclient.TpmSealingInfo{
+			Enabled: true,
+			Tcti:    "device:/dev/tpmrm0",
+			PcrList: "sha256:0,7",
+		},
+	}
+
+	tpm := &fakeTpm{}
+	sealer := NewTpmSealer(context.Background(), logger.MockLogger{}, tpm, config)
+
+	// the key material is sealed on first use
+	sealed, err := sealer.LoadIKM()
+	require.NoError(t, err)
+	assert.Len(t, sealed, tpmIkmLength)
+	assert.FileExists(t, filepath.Join(folder, tpmSealedPublicFile))
+	assert.FileExists(t, filepath.Join(folder, tpmSealedPrivateFile))
+	require.Len(t, tpm.commands, 3)
+	assert.True(t, strings.HasPrefix(tpm.commands[0], "tpm2_createprimary --tcti=device:/dev/tpmrm0"))
+	assert.Contains(t, tpm.commands[1], "tpm2_createpolicy --tcti=device:/dev/tpmrm0 --policy-pcr -l sha256:0,7")
+	assert.Contains(t, tpm.commands[2], "-a fixedtpm|fixedparent")
+
+	// and unsealed afterwards
+	tpm.commands = nil
+	unsealed, err := sealer.LoadIKM()
+	require.NoError(t, err)
+	assert.Equal(t, sealed, unsealed)
+	require.Len(t, tpm.commands, 3)
+	assert.Contains(t, tpm.commands[2], "tpm2_unseal --tcti=device:/dev/tpmrm0 -c")
+	assert.Contains(t, tpm.commands[2], "-p pcr:sha256:0,7")
+
+	// e.g. the PCRs changed
+	tpm.fail = "tpm2_unseal"
+	_, err = sealer.LoadIKM()
+	assert.Error(t, err)
+}
+
+func TestTpmSealerUnsealedLength(t *testing.T) {
+	folder, err := ioutil.TempDir("", "tpmsealing")
+	require.NoError(t, err)
+	defer os.RemoveAll(folder)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(folder, tpmSealedPublicFile), []byte("public"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(folder, tpmSealedPrivateFile), []byte("short"), 0600))
+
+	sealer := NewTpmSealer(context.Background(), logger.MockLogger{}, &fakeTpm{}, secretstoreclient.SecretServiceInfo{TokenFolderPath: folder})
+	_, err = sealer.LoadIKM()
+	assert.Error(t, err)
+}
@@ -88,6 +88,12 @@ func (v *VMKEncryption) LoadIKM(ikmBinPath string) error {
 	return nil
 }
 
+// SetIKM uses the input key material, e.g. unsealed from a TPM, taking ownership of it
+func (v *VMKEncryption) SetIKM(ikm []byte) {
+	v.ikm = ikm
+	v.encrypting = true
+}
+
 // WipeIKM scrubs the input key material from memory
 func (v *VMKEncryption) WipeIKM() {
 	// Note: make() is defined to zero-fill the array
@@ -39,6 +39,20 @@ type SecretServiceInfo struct {
 	PasswordProvider            string
 	PasswordProviderArgs        []string
 	RevokeRootTokens            bool
+	TpmSealing                  TpmSealingInfo
+}
+
+// TpmSealingInfo seals the input key material encrypting the Vault master key shares to a TPM 2.0 device using the
+// tpm2-tools, instead of reading it from IKM_HOOK. The key material is created on first start and unsealed at every
+// start on the same hardware.
+type TpmSealingInfo struct {
+	Enabled bool
+	// Tcti selects the TPM, e.g. 'device:/dev/tpmrm0'; the tpm2-tools default applies when empty
+	Tcti string
+	// PcrList binds the key material to platform configuration registers, e.g. 'sha256:0,7'; not bound when empty
+	PcrList string
+	// ToolsPath is the directory of the tpm2-tools executables, looked up on PATH when empty
+	ToolsPath string
 }
 
 func (s SecretServiceInfo) GetSecretSvcBaseURL() string {
@@ -0,0 +1,213 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// CoreDataClientName is the name of the client of core-data serving the attachments the notifications reference
+const CoreDataClientName = "CoreData"
+
+// attachmentClient fetches the attachments the notifications reference from core-data
+var attachmentClient = &http.Client{Timeout: 30 * time.Second}
+
+// postedAttachments are the attachments posted along the notification
+type postedAttachments struct {
+	Attachments []notificationsModels.NotificationAttachment `json:"attachments"`
+}
+
+// validateAttachments checks the attachments posted with a notification against the configured limits. The content of
+// the referenced attachments is checked once it is fetched.
+func validateAttachments(attachments []notificationsModels.NotificationAttachment, config notificationsConfig.ConfigurationStruct) error {
+	names := make(map[string]bool)
+	var total int64
+	for _, a := range attachments {
+		if a.Name == "" {
+			return errors.NewErrInvalidAttachment(a.Name, "name is required")
+		}
+		if strings.ContainsAny(a.Name, "/\\\r\n") {
+			return errors.NewErrInvalidAttachment(a.Name, "name must be a file name")
+		}
+		if names[a.Name] {
+			return errors.NewErrInvalidAttachment(a.Name, "name is duplicated")
+		}
+		names[a.Name] = true
+
+		switch {
+		case len(a.Content) > 0 && a.Reference != "":
+			return errors.NewErrInvalidAttachment(a.Name, "content and reference are exclusive")
+		case a.Reference != "":
+			if !strings.HasPrefix(a.Reference, "/") {
+				return errors.NewErrInvalidAttachment(a.Name, "reference must be a path in core-data")
+			}
+			if _, ok := config.Clients[CoreDataClientName]; !ok {
+				return errors.NewErrInvalidAttachment(a.Name, "no core-data client configured to fetch the reference")
+			}
+			if a.ContentType != "" {
+				if err := checkAttachmentContentType(a.Name, a.ContentType, config.Attachments); err != nil {
+					return err
+				}
+			}
+		case len(a.Content) > 0:
+			if err := checkAttachmentContentType(a.Name, a.ContentType, config.Attachments); err != nil {
+				return err
+			}
+			if err := checkAttachmentSize(a.Name, int64(len(a.Content)), &total, config.Attachments); err != nil {
+				return err
+			}
+		default:
+			return errors.NewErrInvalidAttachment(a.Name, "content or reference is required")
+		}
+	}
+	return nil
+}
+
+// checkAttachmentContentType checks the media type of the attachment is one of the allowed ones
+func checkAttachmentContentType(name string, contentType string, info notificationsConfig.AttachmentsInfo) error {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return errors.NewErrInvalidAttachment(name, fmt.Sprintf("invalid content type '%s'", contentType))
+	}
+	for _, allowed := range info.ContentTypes {
+		allowed = strings.ToLower(allowed)
+		if mediaType == allowed || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*"))) {
+			return nil
+		}
+	}
+	return errors.NewErrInvalidAttachment(name, fmt.Sprintf("content type '%s' isn't allowed", mediaType))
+}
+
+// checkAttachmentSize checks the size of the attachment, and the total size of the attachments so far once it is added
+func checkAttachmentSize(name string, size int64, total *int64, info notificationsConfig.AttachmentsInfo) error {
+	if info.MaxSize > 0 && size > info.MaxSize {
+		return errors.NewErrInvalidAttachment(name, fmt.Sprintf("larger than %d bytes", info.MaxSize))
+	}
+	*total += size
+	if info.MaxTotalSize > 0 && *total > info.MaxTotalSize {
+		return errors.NewErrInvalidAttachment(name, fmt.Sprintf("attachments larger than %d bytes in total", info.MaxTotalSize))
+	}
+	return nil
+}
+
+// loadAttachments returns the attachments of the notification, fetching the content of the referenced ones from
+// core-data. An error is returned when an attachment can't be fetched or exceeds the configured limits, the emails
+// sending the notification failing then so that they are resent.
+func loadAttachments(
+	n models.Notification,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) ([]notificationsModels.NotificationAttachment, error) {
+
+	attachments, err := dbClient.GetNotificationAttachments(n.ID)
+	if err == db.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the attachments of notification %s: %s", n.Slug, err.Error())
+	}
+
+	var total int64
+	for i, a := range attachments {
+		if a.Reference != "" {
+			if a, err = fetchAttachment(a, config); err != nil {
+				return nil, err
+			}
+			attachments[i] = a
+		}
+		if err = checkAttachmentSize(a.Name, int64(len(a.Content)), &total, config.Attachments); err != nil {
+			return nil, err
+		}
+	}
+	return attachments, nil
+}
+
+// fetchAttachment returns the attachment with the content of its reference in core-data, of the content type of the
+// response unless the attachment has one
+func fetchAttachment(a notificationsModels.NotificationAttachment, config notificationsConfig.ConfigurationStruct) (notificationsModels.NotificationAttachment, error) {
+	url := config.Clients[CoreDataClientName].Url() + a.Reference
+	response, err := attachmentClient.Get(url)
+	if err != nil {
+		return a, fmt.Errorf("unable to fetch attachment '%s' from %s: %s", a.Name, url, err.Error())
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return a, fmt.Errorf("unable to fetch attachment '%s' from %s: %s", a.Name, url, response.Status)
+	}
+
+	var body io.Reader = response.Body
+	if config.Attachments.MaxSize > 0 {
+		body = io.LimitReader(response.Body, config.Attachments.MaxSize+1)
+	}
+	if a.Content, err = ioutil.ReadAll(body); err != nil {
+		return a, fmt.Errorf("unable to fetch attachment '%s' from %s: %s", a.Name, url, err.Error())
+	}
+	if a.ContentType == "" {
+		a.ContentType = response.Header.Get("Content-Type")
+	}
+	if err = checkAttachmentContentType(a.Name, a.ContentType, config.Attachments); err != nil {
+		return a, err
+	}
+	return a, nil
+}
+
+// writeAttachments writes the attachments as base64 encoded parts of the multipart message
+func writeAttachments(mw *multipart.Writer, attachments []notificationsModels.NotificationAttachment) error {
+	for _, a := range attachments {
+		mediaType, params, err := mime.ParseMediaType(a.ContentType)
+		if err != nil {
+			return err
+		}
+		params["name"] = a.Name
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", mime.FormatMediaType(mediaType, params))
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Name}))
+		header.Set("Content-Transfer-Encoding", "base64")
+		part, err := mw.CreatePart(header)
+		if err != nil {
+			return err
+		}
+
+		// base64 lines are limited to 76 characters
+		encoded := base64.StdEncoding.EncodeToString(a.Content)
+		for len(encoded) > 76 {
+			if _, err = io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+				return err
+			}
+			encoded = encoded[76:]
+		}
+		if _, err = io.WriteString(part, encoded+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func attachmentsConfig(coreData *httptest.Server) notificationsConfig.ConfigurationStruct {
+	config := notificationsConfig.ConfigurationStruct{
+		Attachments: notificationsConfig.AttachmentsInfo{
+			MaxSize:      8,
+			MaxTotalSize: 12,
+			ContentTypes: []string{"text/csv", "image/*"},
+		},
+	}
+	if coreData != nil {
+		u, _ := url.Parse(coreData.URL)
+		port, _ := strconv.Atoi(u.Port())
+		config.Clients = map[string]bootstrapConfig.ClientInfo{
+			CoreDataClientName: {Protocol: u.Scheme, Host: u.Hostname(), Port: port},
+		}
+	}
+	return config
+}
+
+func TestValidateAttachments(t *testing.T) {
+	config := attachmentsConfig(httptest.NewServer(http.NotFoundHandler()))
+	csv := notificationsModels.NotificationAttachment{Name: "readings.csv", ContentType: "text/csv", Content: []byte("a,b\n1,2")}
+	snapshot := notificationsModels.NotificationAttachment{Name: "snapshot.png", Reference: "/api/v1/reading/1"}
+
+	tests := []struct {
+		name        string
+		attachments []notificationsModels.NotificationAttachment
+		valid       bool
+	}{
+		{"Valid - content", []notificationsModels.NotificationAttachment{csv}, true},
+		{"Valid - reference", []notificationsModels.NotificationAttachment{csv, snapshot}, true},
+		{"Valid - wildcard content type", []notificationsModels.NotificationAttachment{{Name: "a.jpg", ContentType: "image/jpeg", Content: []byte("jpg")}}, true},
+		{"Invalid - no name", []notificationsModels.NotificationAttachment{{ContentType: "text/csv", Content: []byte("a")}}, false},
+		{"Invalid - path as name", []notificationsModels.NotificationAttachment{{Name: "../a.csv", ContentType: "text/csv", Content: []byte("a")}}, false},
+		{"Invalid - duplicated name", []notificationsModels.NotificationAttachment{csv, csv}, false},
+		{"Invalid - no content", []notificationsModels.NotificationAttachment{{Name: "a.csv", ContentType: "text/csv"}}, false},
+		{"Invalid - content and reference", []notificationsModels.NotificationAttachment{{Name: "a.csv", ContentType: "text/csv", Content: []byte("a"), Reference: "/a"}}, false},
+		{"Invalid - reference not a path", []notificationsModels.NotificationAttachment{{Name: "a.png", Reference: "http://a/b"}}, false},
+		{"Invalid - content type not allowed", []notificationsModels.NotificationAttachment{{Name: "a.exe", ContentType: "application/octet-stream", Content: []byte("a")}}, false},
+		{"Invalid - no content type", []notificationsModels.NotificationAttachment{{Name: "a.csv", Content: []byte("a")}}, false},
+		{"Invalid - too large", []notificationsModels.NotificationAttachment{{Name: "a.csv", ContentType: "text/csv", Content: []byte("123456789")}}, false},
+		{"Invalid - too large in total", []notificationsModels.NotificationAttachment{csv, {Name: "b.csv", ContentType: "text/csv", Content: []byte("123456")}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAttachments(tt.attachments, config)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	err := validateAttachments([]notificationsModels.NotificationAttachment{snapshot}, attachmentsConfig(nil))
+	assert.Error(t, err, "reference without core-data client")
+}
+
+func TestLoadAttachments(t *testing.T) {
+	coreData := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/snapshot":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("png"))
+		case "/large":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte("123456789"))
+		case "/json":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte("{}"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer coreData.Close()
+	config := attachmentsConfig(coreData)
+	csv := notificationsModels.NotificationAttachment{Name: "readings.csv", ContentType: "text/csv", Content: []byte("a,b\n1,2")}
+
+	tests := []struct {
+		name      string
+		reference string
+		valid     bool
+	}{
+		{"Valid", "/snapshot", true},
+		{"Invalid - not found", "/missing", false},
+		{"Invalid - too large", "/large", false},
+		{"Invalid - content type not allowed", "/json", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := models.Notification{ID: tt.name, Slug: tt.name}
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("GetNotificationAttachments", n.ID).Return([]notificationsModels.NotificationAttachment{
+				csv, {Name: "snapshot.png", Reference: tt.reference},
+			}, nil)
+
+			attachments, err := loadAttachments(n, dbClientMock, config)
+			if !tt.valid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, attachments, 2)
+			assert.Equal(t, csv, attachments[0])
+			assert.Equal(t, "image/png", attachments[1].ContentType)
+			assert.Equal(t, []byte("png"), attachments[1].Content)
+		})
+	}
+}
+
+func TestBuildSmtpMessageWithAttachments(t *testing.T) {
+	attachments := []notificationsModels.NotificationAttachment{
+		{Name: "readings.csv", ContentType: "text/csv", Content: []byte("device,value\r\nBoiler,101")},
+		{Name: "snapshot.png", ContentType: "image/png", Content: []byte(strings.Repeat("\x89PNG", 40))},
+	}
+
+	result, err := buildSmtpMessageWithAttachments("sender@example.com", "Overheat", []string{"a@example.com"}, "text/html", "<p>Overheat</p>", attachments)
+	require.NoError(t, err)
+
+	message, err := mail.ReadMessage(strings.NewReader(string(result)))
+	require.NoError(t, err)
+	assert.Equal(t, "Overheat", message.Header.Get("Subject"))
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	body, err := reader.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `text/html; charset="UTF-8"`, body.Header.Get("Content-Type"))
+	content, err := ioutil.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Overheat</p>\r\n", string(content))
+
+	for _, a := range attachments {
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		assert.Equal(t, a.Name, part.FileName())
+		assert.Equal(t, "base64", part.Header.Get("Content-Transfer-Encoding"))
+		lines := strings.Split(strings.TrimSpace(readPart(t, part)), "\r\n")
+		for _, line := range lines {
+			assert.LessOrEqual(t, len(line), 76)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(lines, ""))
+		require.NoError(t, err)
+		assert.Equal(t, a.Content, decoded)
+	}
+	_, err = reader.NextPart()
+	assert.Error(t, err, "no more parts expected")
+}
+
+func readPart(t *testing.T, part *multipart.Part) string {
+	content, err := ioutil.ReadAll(part)
+	require.NoError(t, err)
+	return string(content)
+}
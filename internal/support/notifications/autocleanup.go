@@ -0,0 +1,97 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+)
+
+// startAutoCleanup deletes the notifications older than the age configured for their severity every cleanup
+// interval until ctx is cancelled. The ages are read on every cleanup, so changes apply without a restart. Nothing is
+// cleaned up when no cleanup interval is configured.
+func startAutoCleanup(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) error {
+	autoCleanup := notificationsContainer.ConfigurationFrom(dic.Get).Writable.AutoCleanup
+	if autoCleanup.Interval == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(autoCleanup.Interval)
+	if err != nil {
+		return fmt.Errorf("invalid AutoCleanup Interval '%s': %s", autoCleanup.Interval, err.Error())
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			ages := notificationsContainer.ConfigurationFrom(dic.Get).Writable.AutoCleanup.Ages
+			autoCleanupBySeverity(ages, container.DBClientFrom(dic.Get), lc)
+		}
+	}()
+	return nil
+}
+
+// autoCleanupBySeverity deletes the notifications of every severity with an age which are older than it
+func autoCleanupBySeverity(ages map[string]string, dbClient interfaces.DBClient, lc logger.LoggingClient) {
+	severities := make([]string, 0, len(ages))
+	for severity := range ages {
+		severities = append(severities, severity)
+	}
+	sort.Strings(severities)
+
+	for _, severity := range severities {
+		age, err := time.ParseDuration(ages[severity])
+		if err != nil {
+			lc.Error(fmt.Sprintf("invalid AutoCleanup age '%s' of severity %s: %s", ages[severity], severity, err.Error()))
+			continue
+		}
+		criteria := models.CleanupCriteria{Severities: []string{severity}, Age: age.Milliseconds()}
+		if err = criteria.Validate(); err != nil {
+			lc.Error(fmt.Sprintf("invalid AutoCleanup age of severity %s: %s", severity, err.Error()))
+			continue
+		}
+
+		result, err := dbClient.CleanupByCriteria(criteria)
+		if err != nil {
+			lc.Error(fmt.Sprintf("failed to clean up %s notifications: %s", severity, err.Error()))
+			continue
+		}
+		lc.Debug(fmt.Sprintf("cleaned up %d %s notification(s) and %d transmission(s) older than %s",
+			result.Notifications, severity, result.Transmissions, ages[severity]))
+	}
+}
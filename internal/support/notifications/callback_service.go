@@ -0,0 +1,102 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// transmissionStatusCallback is posted to the status callback URL of a subscription once a transmission to it has
+// reached its final status: SENT, FAILED or TRXESCALATED.
+type transmissionStatusCallback struct {
+	Subscription string              `json:"subscription"`
+	Transmission models.Transmission `json:"transmission"`
+}
+
+// callbackTransmissionStatus posts the final status of the transmission to the status callback URL of every
+// subscription of the transmission's receiver which has the transmission's channel and registered a callback.
+func callbackTransmissionStatus(t models.Transmission, lc logger.LoggingClient, dbClient interfaces.DBClient) {
+	subs, err := dbClient.GetSubscriptionByReceiver(t.Receiver)
+	if err != nil {
+		lc.Error("Unable to get subscriptions to call back the status of transmission: " + t.ID)
+		return
+	}
+
+	for _, s := range subs {
+		if !hasChannel(s, t.Channel) {
+			continue
+		}
+		url, err := dbClient.GetSubscriptionCallback(s.ID)
+		if err != nil {
+			if err != db.ErrNotFound {
+				lc.Error("Unable to get status callback of subscription: " + s.Slug + ", issue: " + err.Error())
+			}
+			continue
+		}
+		postTransmissionStatus(url, transmissionStatusCallback{Subscription: s.Slug, Transmission: t}, lc)
+	}
+}
+
+func postTransmissionStatus(url string, callback transmissionStatusCallback, lc logger.LoggingClient) {
+	body, err := json.Marshal(callback)
+	if err != nil {
+		lc.Error("Unable to encode status of transmission: " + callback.Transmission.ID + ", issue: " + err.Error())
+		return
+	}
+
+	rs, err := http.Post(url, clients.ContentTypeJSON, bytes.NewBuffer(body))
+	if err != nil {
+		lc.Error("Problems calling back status of transmission: " + callback.Transmission.ID + " to: " + url + ", issue: " + err.Error())
+		return
+	}
+	defer rs.Body.Close()
+
+	if rs.StatusCode < http.StatusOK || rs.StatusCode >= http.StatusMultipleChoices {
+		lc.Error("Status callback of transmission: " + callback.Transmission.ID + " to: " + url + " got response status code: " + rs.Status)
+		return
+	}
+	lc.Debug("Called back status " + string(callback.Transmission.Status) + " of transmission: " + callback.Transmission.ID + " to: " + url)
+}
+
+func hasChannel(s models.Subscription, c models.Channel) bool {
+	for _, ch := range s.Channels {
+		if ch.Type == c.Type && ch.Url == c.Url && equalStrings(ch.MailAddresses, c.MailAddresses) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleFailedTransmissionCallsBackFinalStatus(t *testing.T) {
+	var received []transmissionStatusCallback
+	callbackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var callback transmissionStatusCallback
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&callback))
+		received = append(received, callback)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer callbackServer.Close()
+
+	channel := models.Channel{Type: models.ChannelType(models.Rest), Url: "http://abc.def/alert"}
+	subs := []models.Subscription{
+		{ID: "with-callback", Slug: "with-callback", Receiver: "ops", Channels: []models.Channel{channel}},
+		{ID: "without-callback", Slug: "without-callback", Receiver: "ops", Channels: []models.Channel{channel}},
+		{ID: "other-channel", Slug: "other-channel", Receiver: "ops", Channels: []models.Channel{{Type: models.ChannelType(models.Rest), Url: "http://other"}}},
+	}
+	dbClient := &mocks.DBClient{}
+	dbClient.On("GetSubscriptionByReceiver", "ops").Return(subs, nil)
+	dbClient.On("GetSubscriptionCallback", "with-callback").Return(callbackServer.URL, nil)
+	dbClient.On("GetSubscriptionCallback", "without-callback").Return("", db.ErrNotFound)
+
+	dbClient.On("GetSubscriptionRetryPolicy", mock.Anything).Return(notificationsModels.RetryPolicy{}, db.ErrNotFound)
+
+	config := notificationsConfig.ConfigurationStruct{Writable: notificationsConfig.WritableInfo{
+		RetryPolicies: map[string]notificationsModels.RetryPolicy{models.Rest: {MaxAttempts: 3, InitialInterval: "1h"}},
+	}}
+	tests := []struct {
+		name           string
+		severity       models.NotificationsSeverity
+		status         models.TransmissionStatus
+		resendCount    int
+		expectCallback bool
+	}{
+		{"sent", models.Normal, models.Sent, 0, true},
+		{"failed normal severity", models.Normal, models.Failed, 0, true},
+		{"failed critical severity to be resent", models.Critical, models.Failed, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			transmission := models.Transmission{
+				ID:           "transmission",
+				Notification: models.Notification{Slug: "notice", Sender: "core-data", Category: models.Swhealth, Severity: tt.severity, Content: "disk full"},
+				Receiver:     "ops",
+				Channel:      channel,
+				Status:       tt.status,
+				ResendCount:  tt.resendCount,
+			}
+
+			handleFailedTransmission(transmission, logger.NewMockClient(), dbClient, config)
+
+			if !tt.expectCallback {
+				assert.Empty(t, received)
+				return
+			}
+			require.Len(t, received, 1)
+			assert.Equal(t, "with-callback", received[0].Subscription)
+			assert.Equal(t, tt.status, received[0].Transmission.Status)
+			assert.Equal(t, "transmission", received[0].Transmission.ID)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// Categories are hierarchical, their levels separated by dots, e.g. 'factory.line1.motor'. As the category of a
+// notification is limited to SECURITY, HW_HEALTH and SW_HEALTH, a notification carries its hierarchical categories in
+// labels prefixed by CategoryLabelPrefix, e.g. 'category:factory.line1.motor'.
+//
+// Subscriptions and routing rules may end a category with the wildcard level, e.g. 'factory.line1.*', which matches
+// every category below 'factory.line1' at any depth, but not 'factory.line1' itself.
+const (
+	CategoryLabelPrefix = "category:"
+	CategorySeparator   = "."
+	CategoryWildcard    = "*"
+)
+
+// notificationCategories returns the category of the notification along with the hierarchical categories of its
+// labels.
+func notificationCategories(n models.Notification) []string {
+	categories := []string{string(n.Category)}
+	for _, label := range n.Labels {
+		if strings.HasPrefix(label, CategoryLabelPrefix) {
+			categories = append(categories, strings.TrimPrefix(label, CategoryLabelPrefix))
+		}
+	}
+	return categories
+}
+
+// categoryPatterns returns the category and every wildcard pattern matching it, from the most to the least specific:
+// 'factory.line1.motor', 'factory.line1.*', 'factory.*' and '*'. Subscriptions are indexed by the categories they
+// subscribe to, so the subscriptions of a category are found by looking up these patterns rather than by matching
+// the patterns of every subscription.
+func categoryPatterns(category string) []string {
+	patterns := []string{category}
+	for i := len(category) - 1; i >= 0; i-- {
+		if category[i:i+1] == CategorySeparator {
+			patterns = append(patterns, category[:i+1]+CategoryWildcard)
+		}
+	}
+	return append(patterns, CategoryWildcard)
+}
+
+// distributionCategories returns the categories subscriptions to the notification may subscribe to, without
+// duplicates.
+func distributionCategories(n models.Notification) []string {
+	var categories []string
+	seen := make(map[string]bool)
+	for _, category := range notificationCategories(n) {
+		for _, pattern := range categoryPatterns(category) {
+			if !seen[pattern] {
+				seen[pattern] = true
+				categories = append(categories, pattern)
+			}
+		}
+	}
+	return categories
+}
+
+// categoryMatches reports whether the category satisfies the pattern, a category possibly ending with the wildcard
+// level.
+func categoryMatches(pattern string, category string) bool {
+	if pattern == CategoryWildcard {
+		return true
+	}
+	if strings.HasSuffix(pattern, CategorySeparator+CategoryWildcard) {
+		prefix := strings.TrimSuffix(pattern, CategoryWildcard)
+		return len(category) > len(prefix) && strings.HasPrefix(category, prefix)
+	}
+	return pattern == category
+}
+
+// validateCategoryPattern checks the levels of the category aren't empty and that only its last level is the
+// wildcard.
+func validateCategoryPattern(pattern string) error {
+	levels := strings.Split(pattern, CategorySeparator)
+	for i, level := range levels {
+		switch {
+		case level == "":
+			return errors.NewErrInvalidCategoryPattern(pattern, "empty level")
+		case level == CategoryWildcard && i != len(levels)-1:
+			return errors.NewErrInvalidCategoryPattern(pattern, "the wildcard can only be the last level")
+		case level != CategoryWildcard && strings.Contains(level, CategoryWildcard):
+			return errors.NewErrInvalidCategoryPattern(pattern, "the wildcard must be a whole level")
+		}
+	}
+	return nil
+}
+
+func validateSubscribedCategories(s models.Subscription) error {
+	for _, category := range s.SubscribedCategories {
+		if err := validateCategoryPattern(string(category)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCategoryPatterns(t *testing.T) {
+	assert.Equal(t, []string{"SECURITY", "*"}, categoryPatterns("SECURITY"))
+	assert.Equal(
+		t,
+		[]string{"factory.line1.motor", "factory.line1.*", "factory.*", "*"},
+		categoryPatterns("factory.line1.motor"))
+}
+
+func TestDistributionCategories(t *testing.T) {
+	n := models.Notification{
+		Category: models.Hwhealth,
+		Labels:   []string{"motor", "category:factory.line1.motor", "category:factory.line2"},
+	}
+	expected := []string{
+		"HW_HEALTH", "*",
+		"factory.line1.motor", "factory.line1.*", "factory.*",
+		"factory.line2",
+	}
+	assert.Equal(t, expected, distributionCategories(n))
+}
+
+func TestCategoryMatches(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		category string
+		expected bool
+	}{
+		{"factory.line1.motor", "factory.line1.motor", true},
+		{"factory.line1.motor", "factory.line1", false},
+		{"factory.line1.*", "factory.line1.motor", true},
+		{"factory.line1.*", "factory.line1.motor.temperature", true},
+		{"factory.line1.*", "factory.line1", false},
+		{"factory.line1.*", "factory.line10.motor", false},
+		{"*", "SECURITY", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.category, func(t *testing.T) {
+			assert.Equal(t, tt.expected, categoryMatches(tt.pattern, tt.category))
+		})
+	}
+}
+
+func TestValidateCategoryPattern(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		expectError bool
+	}{
+		{"SECURITY", false},
+		{"factory.line1.motor", false},
+		{"factory.line1.*", false},
+		{"*", false},
+		{"", true},
+		{"factory..motor", true},
+		{"factory.", true},
+		{"factory.*.motor", true},
+		{"factory.line*", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			err := validateCategoryPattern(tt.pattern)
+			assert.Equal(t, tt.expectError, err != nil)
+		})
+	}
+}
@@ -16,10 +16,15 @@
 package notifications
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
@@ -37,8 +42,16 @@ func cleanupHandler(
 		defer r.Body.Close()
 	}
 
+	criteria, err := cleanupCriteriaFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
 	lc.Info("Cleaning up of notifications and transmissions")
-	cleanupHandlerCloser(w, dbClient.Cleanup(), lc)
+	result, err := dbClient.CleanupByCriteria(criteria)
+	cleanupHandlerCloser(w, result, err, lc)
 }
 
 func cleanupAgeHandler(
@@ -51,7 +64,7 @@ func cleanupAgeHandler(
 		defer r.Body.Close()
 	}
 	vars := mux.Vars(r)
-	age, err := strconv.Atoi(vars["age"])
+	age, err := strconv.ParseInt(vars["age"], 10, 64)
 	// Problem converting age
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -59,18 +72,59 @@ func cleanupAgeHandler(
 		return
 	}
 
+	criteria, err := cleanupCriteriaFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+	criteria.Age = age
+
 	lc.Info("Cleaning up of notifications and transmissions")
-	cleanupHandlerCloser(w, dbClient.CleanupOld(age), lc)
+	result, err := dbClient.CleanupByCriteria(criteria)
+	cleanupHandlerCloser(w, result, err, lc)
+}
+
+// cleanupCriteriaFromQuery reads the criteria of a cleanup from the query parameters of the request. Every list
+// parameter may be repeated or hold comma separated values.
+func cleanupCriteriaFromQuery(r *http.Request) (models.CleanupCriteria, error) {
+	query := r.URL.Query()
+	criteria := models.CleanupCriteria{
+		Statuses:   queryValues(query, STATUS),
+		Severities: queryValues(query, SEVERITY),
+		Categories: queryValues(query, CATEGORY),
+		Senders:    queryValues(query, SENDER),
+	}
+	if age := query.Get(AGE); age != "" {
+		var err error
+		if criteria.Age, err = strconv.ParseInt(age, 10, 64); err != nil {
+			return criteria, fmt.Errorf("invalid cleanup age '%s'", age)
+		}
+	}
+	return criteria, criteria.Validate()
+}
+
+func queryValues(query url.Values, key string) []string {
+	var values []string
+	for _, value := range query[key] {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
 }
 
-func cleanupHandlerCloser(w http.ResponseWriter, err error, lc logger.LoggingClient) {
+func cleanupHandlerCloser(w http.ResponseWriter, result models.CleanupResult, err error, lc logger.LoggingClient) {
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		lc.Error(err.Error())
 		return
 	}
 
+	lc.Info(fmt.Sprintf("Cleaned up %d notification(s) and %d transmission(s)", result.Notifications, result.Transmissions))
 	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
 	w.WriteHeader(http.StatusAccepted)
-	w.Write([]byte("true"))
+	_ = json.NewEncoder(w).Encode(result)
 }
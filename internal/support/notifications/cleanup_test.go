@@ -0,0 +1,117 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanupHandler(t *testing.T) {
+	deleted := models.CleanupResult{Notifications: 3, Transmissions: 5}
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedCriteria models.CleanupCriteria
+		dbError          error
+		expectedStatus   int
+	}{
+		{"Everything", "", models.CleanupCriteria{}, nil, http.StatusAccepted},
+		{
+			"Filtered",
+			"?status=PROCESSED,ESCALATED&severity=NORMAL&category=SW_HEALTH&sender=device-a&sender=device-b&age=60000",
+			models.CleanupCriteria{
+				Statuses:   []string{"PROCESSED", "ESCALATED"},
+				Severities: []string{"NORMAL"},
+				Categories: []string{"SW_HEALTH"},
+				Senders:    []string{"device-a", "device-b"},
+				Age:        60000,
+			},
+			nil,
+			http.StatusAccepted,
+		},
+		{"Invalid status", "?status=DONE", models.CleanupCriteria{}, nil, http.StatusBadRequest},
+		{"Invalid severity", "?severity=LOW", models.CleanupCriteria{}, nil, http.StatusBadRequest},
+		{"Invalid age", "?age=old", models.CleanupCriteria{}, nil, http.StatusBadRequest},
+		{"Database error", "", models.CleanupCriteria{}, testError, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("CleanupByCriteria", tt.expectedCriteria).Return(deleted, tt.dbError)
+
+			rr := httptest.NewRecorder()
+			cleanupHandler(rr, httptest.NewRequest(http.MethodDelete, "/"+CLEANUP+tt.query, nil), logger.NewMockClient(), dbClientMock)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusAccepted {
+				return
+			}
+			var result models.CleanupResult
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &result))
+			assert.Equal(t, deleted, result)
+			dbClientMock.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCleanupAgeHandler(t *testing.T) {
+	criteria := models.CleanupCriteria{Severities: []string{"CRITICAL"}, Age: 3600000}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("CleanupByCriteria", criteria).Return(models.CleanupResult{Notifications: 1}, nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/"+CLEANUP+"/"+AGE+"/3600000?severity=CRITICAL&age=1", nil)
+	req = mux.SetURLVars(req, map[string]string{AGE: "3600000"})
+	rr := httptest.NewRecorder()
+	cleanupAgeHandler(rr, req, logger.NewMockClient(), dbClientMock)
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+	assert.JSONEq(t, `{"notifications":1,"transmissions":0}`, rr.Body.String())
+	dbClientMock.AssertExpectations(t)
+}
+
+func TestAutoCleanupBySeverity(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("CleanupByCriteria", models.CleanupCriteria{
+		Severities: []string{"CRITICAL"},
+		Age:        (720 * time.Hour).Milliseconds(),
+	}).Return(models.CleanupResult{}, nil).Once()
+	dbClientMock.On("CleanupByCriteria", models.CleanupCriteria{
+		Severities: []string{"NORMAL"},
+		Age:        (24 * time.Hour).Milliseconds(),
+	}).Return(models.CleanupResult{}, testError).Once()
+
+	autoCleanupBySeverity(
+		map[string]string{"CRITICAL": "720h", "NORMAL": "24h", "LOW": "1h", "SECURITY": "not a duration"},
+		dbClientMock,
+		logger.NewMockClient())
+
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNumberOfCalls(t, "CleanupByCriteria", 2)
+}
@@ -16,6 +16,13 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
@@ -27,14 +34,193 @@ type ConfigurationStruct struct {
 	Service     bootstrapConfig.ServiceInfo
 	Smtp        SmtpInfo
 	SecretStore bootstrapConfig.SecretStoreInfo
+
+	// MailTemplates renders the emails in the locales of the subscriptions
+	MailTemplates MailTemplatesInfo
+
+	// DatabaseEncryption encrypts the stored notifications and transmissions
+	DatabaseEncryption db.EncryptionInfo
+
+	// DeliveryMetrics tracks the delivery success rates, latencies and retries per channel type over rolling windows
+	DeliveryMetrics DeliveryMetricsInfo
+
+	// RequestLimits rejects the request bodies larger than the configured sizes with 413
+	RequestLimits bodylimit.LimitsInfo
+	// TrustedHeaders verifies the claims of the caller forwarded by the API gateway
+	TrustedHeaders claims.TrustedHeadersInfo
+
+	// RestChannels configure how the notifications are sent to the REST channels, by name
+	RestChannels map[string]RestChannelInfo
+
+	// Attachments limits the files the emails sending the notifications carry
+	Attachments AttachmentsInfo
+
+	// ConfigDrift reports the drift of the running configuration, and reloads the sections drifted in the registry
+	ConfigDrift configdrift.DriftInfo
 }
 
 type WritableInfo struct {
-	ResendLimit     int
 	LogLevel        string
 	InsecureSecrets bootstrapConfig.InsecureSecrets
+	// RetryPolicies are the resend policies of failed transmissions by channel type, EMAIL or REST. Transmissions
+	// through a channel type without policy aren't resent. A subscription can override the policy of its channels.
+	RetryPolicies map[string]models.RetryPolicy
+	// RoutingRules are evaluated in order of their names against every notification before it is distributed
+	RoutingRules map[string]RoutingRuleInfo
+	// AutoCleanup periodically deletes old notifications and their transmissions
+	AutoCleanup AutoCleanupInfo
+	// SenderVerification restricts posting notifications to the senders of the allow-list
+	SenderVerification SenderVerificationInfo
+	// DuplicateSuppression coalesces identical notifications from the same sender
+	DuplicateSuppression DuplicateSuppressionInfo
+	// DeliveryObjectives are the delivery service level objectives by channel type, EMAIL or REST, reported with the
+	// delivery metrics
+	DeliveryObjectives map[string]DeliveryObjectiveInfo
+	// Compression compresses the responses of the service
+	Compression compression.ResponsesInfo
+	// FeatureFlags enable the experimental behaviors of the service by name, toggled at runtime through the registry
+	FeatureFlags map[string]bool
+}
+
+// DeliveryMetricsInfo configures the rolling windows the delivery metrics are reported over.
+type DeliveryMetricsInfo struct {
+	// Windows are the rolling windows the delivery metrics are reported over, e.g. ['5m', '1h', '24h']. The deliveries
+	// are tracked for the longest window, by the minute.
+	Windows []string
 }
 
+// DeliveryObjectiveInfo is the service level objective of the deliveries through a channel type. An objective is met
+// while the deliveries over its window satisfy every non-zero target.
+type DeliveryObjectiveInfo struct {
+	// Window is the rolling window the objective is evaluated over, e.g. '1h', at most the longest of the
+	// DeliveryMetrics Windows
+	Window string
+	// SuccessRate is the minimum ratio of successful delivery attempts, e.g. 0.99
+	SuccessRate float64
+	// LatencyP95 is the maximum 95th percentile latency of the successful delivery attempts, e.g. '30s'
+	LatencyP95 string
+}
+
+// DuplicateSuppressionInfo configures the coalescing of identical notifications, i.e. of the same sender, category,
+// severity, content, description, content type and labels, so that a flapping sensor doesn't flood the subscribers.
+type DuplicateSuppressionInfo struct {
+	// Window is how long after a notification the identical notifications are coalesced into it, counting their
+	// occurrences instead of being distributed, e.g. '10m'. Nothing is coalesced when empty.
+	Window string
+}
+
+// SenderVerificationInfo configures the verification of the identity of the callers posting notifications. Callers
+// are identified by the subject of their JWT, forwarded by the API gateway in the trusted headers, or by the internal
+// token minted for them when they are added to the allow-list.
+type SenderVerificationInfo struct {
+	// Enabled rejects the notifications of callers which aren't verified as an allowed sender, or which post on behalf
+	// of another sender
+	Enabled bool
+}
+
+// AutoCleanupInfo configures the periodic cleanup of notifications, keeping them for a different age per severity.
+type AutoCleanupInfo struct {
+	// Interval is how often the cleanup runs, e.g. '1h'. Nothing is cleaned up when empty. Changes apply after a
+	// restart.
+	Interval string
+	// Ages are how long notifications are kept by severity, CRITICAL or NORMAL, e.g. '720h'. Notifications of a
+	// severity without age are kept forever.
+	Ages map[string]string
+}
+
+// RoutingRuleInfo matches notifications and changes how they are distributed. A notification matches when it
+// satisfies every non-empty match list; the actions of a matching rule are applied before the next rule is evaluated.
+type RoutingRuleInfo struct {
+	// Severities the notification may have
+	Severities []string
+	// Categories the notification may have, hierarchical categories possibly ending with the wildcard level, e.g.
+	// 'factory.line1.*'
+	Categories []string
+	// Labels of which the notification must carry at least one
+	Labels []string
+	// Senders the notification may come from
+	Senders []string
+	// Suppress drops the notification without distributing it and ends rule evaluation
+	Suppress bool
+	// Severity replaces the severity of the notification, CRITICAL or NORMAL
+	Severity string
+	// AddLabels are added to the labels of the notification, which subscriptions are matched against
+	AddLabels []string
+	// Subscriptions are the slugs of subscriptions the notification is sent to in addition to the matching ones
+	Subscriptions []string
+}
+
+// MailTemplatesInfo configures the templates rendering the emails in the locales of the subscriptions.
+type MailTemplatesInfo struct {
+	// Directory contains the templates named <category>.<locale>.tmpl, e.g. 'default.fr-CA.tmpl', rendering the
+	// notifications of the category, or of any category for the 'default' templates. The emails contain the
+	// notification content when empty.
+	Directory string
+	// DefaultLocale is the locale the emails are rendered in when the subscription has none, or no template is
+	// available in its locale or language
+	DefaultLocale string
+}
+
+// AttachmentsInfo configures the attachments the notifications may carry, which the emails sending them include.
+type AttachmentsInfo struct {
+	// MaxSize is the maximum size, in bytes, of an attachment. 0 leaves the attachments unlimited.
+	MaxSize int64
+	// MaxTotalSize is the maximum size, in bytes, of all the attachments of a notification. 0 leaves it unlimited.
+	MaxTotalSize int64
+	// ContentTypes are the media types the attachments may have, possibly ending with the wildcard subtype, e.g.
+	// 'image/*'. Notifications can't carry attachments when empty.
+	ContentTypes []string
+}
+
+// The authentication modes of the REST channels
+const (
+	// RestAuthModeBearer sends the token secret in the Authorization header
+	RestAuthModeBearer = "bearer"
+	// RestAuthModeBasic sends the username and password secrets in the Authorization header
+	RestAuthModeBasic = "basic"
+)
+
+// RestChannelInfo configures the requests sending the notifications to the REST channels whose URL starts with Url.
+// The channels matching no configuration are sent a POST request, whatever its response status.
+type RestChannelInfo struct {
+	// Url is the prefix of the URLs of the channels the configuration applies to, e.g. 'https://hooks.example.com/'.
+	// The configuration with the longest matching Url applies.
+	Url string
+	// Method is the HTTP method of the requests, POST when empty
+	Method string
+	// Headers are static headers added to the requests, e.g. X-Tenant = 'plant1'
+	Headers map[string]string
+	// AuthMode is 'bearer' or 'basic' to authenticate with the secrets read from SecretPath on every request, the token
+	// secret or the username and password secrets respectively. The requests aren't authenticated when empty.
+	AuthMode string
+	// SecretPath is the path in the secret store of the secrets of the AuthMode
+	SecretPath string
+	// ExpectedStatusCodes are the response status codes of the successful transmissions, any 2xx when empty. The
+	// other codes fail the transmission, which is then resent by the retry policy of the channel type.
+	ExpectedStatusCodes []int
+	// Timeout is how long a request may take, e.g. '30s'. No timeout applies when empty.
+	Timeout string
+	// TLS verifies the certificate of the receivers, and authenticates with a client certificate
+	TLS RestChannelTLSInfo
+}
+
+// RestChannelTLSInfo configures the TLS connections of a REST channel
+type RestChannelTLSInfo struct {
+	// CAFile is the PEM file of the certificate authorities the receiver certificates are verified against, the system
+	// ones when empty
+	CAFile string
+	// CertFile and KeyFile are the PEM files of the client certificate and its key, for mutual TLS
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the host name the receiver certificates are verified against
+	ServerName string
+	// InsecureSkipVerify accepts any receiver certificate, i.e. self-signed ones. For testing only.
+	InsecureSkipVerify bool
+}
+
+// SmtpAuthModeXOAuth2 authenticates to the SMTP server with OAuth2 access tokens
+const SmtpAuthModeXOAuth2 = "xoauth2"
+
 type SmtpInfo struct {
 	Host                 string
 	Username             string
@@ -43,6 +229,13 @@ type SmtpInfo struct {
 	Sender               string
 	EnableSelfSignedCert bool
 	Subject              string
+	// AuthMode is 'xoauth2' to authenticate with OAuth2 access tokens instead of the password
+	AuthMode string
+	// SecretPath is the path in the secret store of the OAuth2 clientId, clientSecret and refreshToken, or of an
+	// accessToken kept fresh by another party
+	SecretPath string
+	// TokenURL is the OAuth2 token endpoint the access tokens are refreshed from
+	TokenURL string
 }
 
 // The earlier releases do not have Username field and are using Sender field where Usename will
@@ -85,6 +278,16 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 	return ok
 }
 
+// GetFeatureFlags returns the feature flags of the Writable configuration, by name.
+func (c *ConfigurationStruct) GetFeatureFlags() map[string]bool {
+	return c.Writable.FeatureFlags
+}
+
+// GetConfigDriftInfo returns the configuration of the drift detection of the running configuration.
+func (c *ConfigurationStruct) GetConfigDriftInfo() configdrift.DriftInfo {
+	return c.ConfigDrift
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
@@ -114,6 +317,11 @@ func (c *ConfigurationStruct) GetDatabaseInfo() map[string]bootstrapConfig.Datab
 	return c.Databases
 }
 
+// GetDatabaseEncryptionInfo returns the payload encryption configuration.
+func (c *ConfigurationStruct) GetDatabaseEncryptionInfo() db.EncryptionInfo {
+	return c.DatabaseEncryption
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
@@ -37,9 +37,20 @@ var (
 	SENDER       = "sender"
 	RECEIVER     = "receiver"
 	AGE          = "age"
+	STATUS       = "status"
+	SEVERITY     = "severity"
+	CATEGORY     = "category"
 	NEW          = "new"
 	ESCALATED    = "escalated"
 	ACKNOWLEDGED = "acknowledged"
 	FAILED       = "failed"
 	SENT         = "sent"
+	TEST         = "test"
+	CALLBACK     = "callback"
+	RETRY        = "retry"
+	LOCALE       = "locale"
+	ALLOWED      = "allowedsender"
+	OCCURRENCES  = "occurrences"
+	DELIVERY     = "delivery"
+	DISTRIBUTION = "distribution"
 )
@@ -0,0 +1,363 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	// deliveryBucketSize is the period whose deliveries are counted together
+	deliveryBucketSize = time.Minute
+	// maxLatencySamples bounds the latencies kept per channel type and bucket to compute the percentiles from
+	maxLatencySamples = 1000
+)
+
+// defaultDeliveryWindows are the windows reported when none is configured
+var defaultDeliveryWindows = []string{"5m", "1h", "24h"}
+
+// deliveryMetrics tracks the deliveries of the service, replaced by the bootstrap handler to track them over the
+// configured windows
+var deliveryMetrics = newDeliveryRecorder(24 * time.Hour)
+
+// ChannelDelivery is the delivery metrics of a channel type over a window
+type ChannelDelivery struct {
+	Channel         string  `json:"channel"`
+	Attempts        int64   `json:"attempts"`
+	Failures        int64   `json:"failures"`
+	Retries         int64   `json:"retries"`
+	SuccessRate     float64 `json:"successRate"`
+	MedianLatencyMs float64 `json:"medianLatencyMs"`
+	P95LatencyMs    float64 `json:"p95LatencyMs"`
+}
+
+// DeliveryWindow is the delivery metrics per channel type from Start to End, in milliseconds since the epoch
+type DeliveryWindow struct {
+	Window   string            `json:"window"`
+	Start    int64             `json:"start"`
+	End      int64             `json:"end"`
+	Channels []ChannelDelivery `json:"channels"`
+}
+
+// ObjectiveStatus tells whether the deliveries through a channel type meet their service level objective
+type ObjectiveStatus struct {
+	Channel           string   `json:"channel"`
+	Window            string   `json:"window"`
+	TargetSuccessRate float64  `json:"targetSuccessRate,omitempty"`
+	SuccessRate       float64  `json:"successRate"`
+	TargetP95Ms       float64  `json:"targetP95LatencyMs,omitempty"`
+	P95LatencyMs      float64  `json:"p95LatencyMs"`
+	Met               bool     `json:"met"`
+	Breaches          []string `json:"breaches,omitempty"`
+}
+
+// DeliveryReport is the delivery metrics over the configured windows and the status of the delivery objectives
+type DeliveryReport struct {
+	Windows    []DeliveryWindow  `json:"windows"`
+	Objectives []ObjectiveStatus `json:"objectives"`
+}
+
+// serviceMetrics is the telemetry of the service along with its delivery metrics
+type serviceMetrics struct {
+	telemetry.SystemUsage
+	Delivery DeliveryReport
+}
+
+type deliveryCounters struct {
+	attempts  int64
+	failures  int64
+	retries   int64
+	latencies []time.Duration
+}
+
+type deliveryBucket struct {
+	start    time.Time
+	channels map[string]*deliveryCounters
+}
+
+// deliveryRecorder counts the delivery attempts per channel type in a rolling window made of buckets
+type deliveryRecorder struct {
+	mutex   sync.Mutex
+	buckets []deliveryBucket
+	now     func() time.Time
+}
+
+func newDeliveryRecorder(retention time.Duration) *deliveryRecorder {
+	n := int(retention / deliveryBucketSize)
+	if n < 1 {
+		n = 1
+	}
+	return &deliveryRecorder{buckets: make([]deliveryBucket, n), now: time.Now}
+}
+
+// newConfiguredDeliveryRecorder creates a recorder keeping the deliveries for the longest of the configured windows
+func newConfiguredDeliveryRecorder(config notificationsConfig.DeliveryMetricsInfo) (*deliveryRecorder, error) {
+	windows, err := deliveryWindows(config)
+	if err != nil {
+		return nil, err
+	}
+	var retention time.Duration
+	for _, window := range windows {
+		if window > retention {
+			retention = window
+		}
+	}
+	return newDeliveryRecorder(retention), nil
+}
+
+// deliveryWindows parses the configured windows, or the default ones when none is configured
+func deliveryWindows(config notificationsConfig.DeliveryMetricsInfo) ([]time.Duration, error) {
+	names := config.Windows
+	if len(names) == 0 {
+		names = defaultDeliveryWindows
+	}
+	windows := make([]time.Duration, len(names))
+	for i, name := range names {
+		window, err := time.ParseDuration(name)
+		if err != nil || window < deliveryBucketSize {
+			return nil, fmt.Errorf("invalid DeliveryMetrics window '%s', expected a duration of at least %s", name, deliveryBucketSize)
+		}
+		windows[i] = window
+	}
+	return windows, nil
+}
+
+// record counts a delivery attempt through the channel type with the status and latency of the attempt. A resend is
+// counted as a retry.
+func (r *deliveryRecorder) record(channelType string, status models.TransmissionStatus, resend bool, latency time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	start := r.now().Truncate(deliveryBucketSize)
+	b := &r.buckets[int(start.UnixNano()/int64(deliveryBucketSize))%len(r.buckets)]
+	if !b.start.Equal(start) {
+		b.start = start
+		b.channels = make(map[string]*deliveryCounters)
+	}
+	c, exists := b.channels[channelType]
+	if !exists {
+		c = &deliveryCounters{}
+		b.channels[channelType] = c
+	}
+
+	c.attempts++
+	if resend {
+		c.retries++
+	}
+	if status == models.Failed {
+		c.failures++
+	} else if len(c.latencies) < maxLatencySamples {
+		c.latencies = append(c.latencies, latency)
+	}
+}
+
+// retention is the period the deliveries are counted for
+func (r *deliveryRecorder) retention() time.Duration {
+	return time.Duration(len(r.buckets)) * deliveryBucketSize
+}
+
+// window sums up the deliveries per channel type over the last period, at most the retention
+func (r *deliveryRecorder) window(name string, period time.Duration) DeliveryWindow {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.now()
+	if period > r.retention() {
+		period = r.retention()
+	}
+	// the buckets overlapping the period are summed up, the oldest of them from its start
+	oldest := now.Add(-period).Truncate(deliveryBucketSize)
+
+	totals := make(map[string]*deliveryCounters)
+	for _, b := range r.buckets {
+		if b.start.Before(oldest) || b.start.After(now) {
+			continue
+		}
+		for channelType, c := range b.channels {
+			total, exists := totals[channelType]
+			if !exists {
+				total = &deliveryCounters{}
+				totals[channelType] = total
+			}
+			total.attempts += c.attempts
+			total.failures += c.failures
+			total.retries += c.retries
+			total.latencies = append(total.latencies, c.latencies...)
+		}
+	}
+
+	channels := make([]ChannelDelivery, 0, len(totals))
+	for channelType, c := range totals {
+		sort.Slice(c.latencies, func(i, j int) bool { return c.latencies[i] < c.latencies[j] })
+		channels = append(channels, ChannelDelivery{
+			Channel:         channelType,
+			Attempts:        c.attempts,
+			Failures:        c.failures,
+			Retries:         c.retries,
+			SuccessRate:     successRate(c.attempts, c.failures),
+			MedianLatencyMs: milliseconds(percentile(c.latencies, 0.5)),
+			P95LatencyMs:    milliseconds(percentile(c.latencies, 0.95)),
+		})
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Channel < channels[j].Channel })
+
+	return DeliveryWindow{
+		Window:   name,
+		Start:    oldest.UnixNano() / int64(time.Millisecond),
+		End:      now.UnixNano() / int64(time.Millisecond),
+		Channels: channels,
+	}
+}
+
+// report sums up the deliveries over the configured windows and evaluates the objectives, by channel type
+func (r *deliveryRecorder) report(
+	config notificationsConfig.DeliveryMetricsInfo,
+	objectives map[string]notificationsConfig.DeliveryObjectiveInfo) (DeliveryReport, error) {
+
+	names := config.Windows
+	if len(names) == 0 {
+		names = defaultDeliveryWindows
+	}
+	windows, err := deliveryWindows(config)
+	if err != nil {
+		return DeliveryReport{}, err
+	}
+	report := DeliveryReport{Windows: make([]DeliveryWindow, len(windows)), Objectives: []ObjectiveStatus{}}
+	for i, window := range windows {
+		report.Windows[i] = r.window(names[i], window)
+	}
+
+	channelTypes := make([]string, 0, len(objectives))
+	for channelType := range objectives {
+		channelTypes = append(channelTypes, channelType)
+	}
+	sort.Strings(channelTypes)
+	for _, channelType := range channelTypes {
+		status, err := r.evaluate(channelType, objectives[channelType])
+		if err != nil {
+			return DeliveryReport{}, err
+		}
+		report.Objectives = append(report.Objectives, status)
+	}
+	return report, nil
+}
+
+// evaluate tells whether the deliveries through the channel type over the window of the objective meet it. Without
+// deliveries the objective is met.
+func (r *deliveryRecorder) evaluate(channelType string, objective notificationsConfig.DeliveryObjectiveInfo) (ObjectiveStatus, error) {
+	period, err := time.ParseDuration(objective.Window)
+	if err != nil || period <= 0 {
+		return ObjectiveStatus{}, fmt.Errorf("invalid Window '%s' of the %s delivery objective", objective.Window, channelType)
+	}
+	var latencyP95 time.Duration
+	if objective.LatencyP95 != "" {
+		if latencyP95, err = time.ParseDuration(objective.LatencyP95); err != nil {
+			return ObjectiveStatus{}, fmt.Errorf("invalid LatencyP95 '%s' of the %s delivery objective", objective.LatencyP95, channelType)
+		}
+	}
+
+	status := ObjectiveStatus{
+		Channel:           channelType,
+		Window:            objective.Window,
+		TargetSuccessRate: objective.SuccessRate,
+		SuccessRate:       1,
+		TargetP95Ms:       milliseconds(latencyP95),
+		Met:               true,
+	}
+	for _, c := range r.window(objective.Window, period).Channels {
+		if c.Channel != channelType {
+			continue
+		}
+		status.SuccessRate = c.SuccessRate
+		status.P95LatencyMs = c.P95LatencyMs
+	}
+	if objective.SuccessRate > 0 && status.SuccessRate < objective.SuccessRate {
+		status.Breaches = append(status.Breaches, fmt.Sprintf("success rate %.4f below %.4f", status.SuccessRate, objective.SuccessRate))
+	}
+	if latencyP95 > 0 && status.P95LatencyMs > status.TargetP95Ms {
+		status.Breaches = append(status.Breaches, fmt.Sprintf("95th percentile latency %.0fms above %.0fms", status.P95LatencyMs, status.TargetP95Ms))
+	}
+	status.Met = len(status.Breaches) == 0
+	return status, nil
+}
+
+func successRate(attempts int64, failures int64) float64 {
+	if attempts == 0 {
+		return 1
+	}
+	return float64(attempts-failures) / float64(attempts)
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies, 0 when there are none
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	} else if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// restGetDeliveryMetrics reports the delivery metrics over the configured windows and the status of the delivery
+// objectives
+func restGetDeliveryMetrics(
+	w http.ResponseWriter,
+	lc logger.LoggingClient,
+	config notificationsConfig.ConfigurationStruct) {
+
+	report, err := deliveryMetrics.report(config.DeliveryMetrics, config.Writable.DeliveryObjectives)
+	if err != nil {
+		lc.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	pkg.Encode(report, w, lc)
+}
+
+// restGetMetrics reports the telemetry of the service along with its delivery metrics
+func restGetMetrics(
+	w http.ResponseWriter,
+	lc logger.LoggingClient,
+	config notificationsConfig.ConfigurationStruct) {
+
+	metrics := serviceMetrics{SystemUsage: telemetry.NewSystemUsage()}
+	report, err := deliveryMetrics.report(config.DeliveryMetrics, config.Writable.DeliveryObjectives)
+	if err != nil {
+		lc.Error(err.Error())
+	} else {
+		metrics.Delivery = report
+	}
+	pkg.Encode(metrics, w, lc)
+}
@@ -0,0 +1,133 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 1; i <= 20; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 10*time.Millisecond, percentile(latencies, 0.5))
+	assert.Equal(t, 19*time.Millisecond, percentile(latencies, 0.95))
+	assert.Equal(t, time.Duration(0), percentile(nil, 0.95))
+}
+
+func TestDeliveryRecorderReport(t *testing.T) {
+	now := time.Date(2020, 11, 2, 10, 30, 0, 0, time.UTC)
+	recorder := newDeliveryRecorder(time.Hour)
+	recorder.now = func() time.Time { return now.Add(-30 * time.Minute) }
+	recorder.record(string(models.Email), models.Failed, false, time.Second)
+	recorder.now = func() time.Time { return now }
+	for i := 1; i <= 9; i++ {
+		recorder.record(string(models.Email), models.Sent, i > 6, time.Duration(i)*time.Second)
+	}
+	recorder.record(string(models.Rest), models.Failed, false, time.Second)
+	recorder.record(string(models.Rest), models.Sent, true, 10*time.Millisecond)
+
+	config := notificationsConfig.DeliveryMetricsInfo{Windows: []string{"5m", "1h"}}
+	objectives := map[string]notificationsConfig.DeliveryObjectiveInfo{
+		string(models.Email): {Window: "1h", SuccessRate: 0.95, LatencyP95: "30s"},
+		string(models.Rest):  {Window: "5m", LatencyP95: "1s"},
+	}
+	report, err := recorder.report(config, objectives)
+	require.NoError(t, err)
+
+	require.Len(t, report.Windows, 2)
+	assert.Equal(t, "5m", report.Windows[0].Window)
+	require.Len(t, report.Windows[0].Channels, 2)
+	email := report.Windows[0].Channels[0]
+	assert.Equal(t, ChannelDelivery{
+		Channel: string(models.Email), Attempts: 9, Failures: 0, Retries: 3, SuccessRate: 1, MedianLatencyMs: 5000, P95LatencyMs: 9000,
+	}, email)
+	rest := report.Windows[0].Channels[1]
+	assert.Equal(t, int64(1), rest.Failures)
+	assert.Equal(t, 0.5, rest.SuccessRate)
+	assert.Equal(t, float64(10), rest.P95LatencyMs, "failed attempts don't count towards the latency")
+
+	assert.Equal(t, int64(10), report.Windows[1].Channels[0].Attempts, "the failure 30 minutes ago is in the hour window")
+
+	require.Len(t, report.Objectives, 2)
+	assert.False(t, report.Objectives[0].Met)
+	assert.Equal(t, 0.9, report.Objectives[0].SuccessRate)
+	assert.Len(t, report.Objectives[0].Breaches, 1)
+	assert.True(t, report.Objectives[1].Met)
+}
+
+func TestDeliveryRecorderRollsOff(t *testing.T) {
+	now := time.Date(2020, 11, 2, 10, 30, 0, 0, time.UTC)
+	recorder := newDeliveryRecorder(time.Hour)
+	recorder.now = func() time.Time { return now }
+	recorder.record(string(models.Email), models.Failed, false, time.Second)
+
+	recorder.now = func() time.Time { return now.Add(2 * time.Hour) }
+	report, err := recorder.report(notificationsConfig.DeliveryMetricsInfo{Windows: []string{"1h"}}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, report.Windows[0].Channels)
+}
+
+func TestDeliveryWindows(t *testing.T) {
+	windows, err := deliveryWindows(notificationsConfig.DeliveryMetricsInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{5 * time.Minute, time.Hour, 24 * time.Hour}, windows)
+
+	_, err = deliveryWindows(notificationsConfig.DeliveryMetricsInfo{Windows: []string{"30s"}})
+	assert.Error(t, err, "a window must be at least a bucket")
+	_, err = deliveryWindows(notificationsConfig.DeliveryMetricsInfo{Windows: []string{"hour"}})
+	assert.Error(t, err)
+}
+
+func TestRestGetDeliveryMetrics(t *testing.T) {
+	tests := []struct {
+		name           string
+		objective      notificationsConfig.DeliveryObjectiveInfo
+		expectedStatus int
+	}{
+		{"valid", notificationsConfig.DeliveryObjectiveInfo{Window: "1h", SuccessRate: 0.99}, http.StatusOK},
+		{"invalid objective window", notificationsConfig.DeliveryObjectiveInfo{Window: "hour"}, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := notificationsConfig.ConfigurationStruct{}
+			config.Writable.DeliveryObjectives = map[string]notificationsConfig.DeliveryObjectiveInfo{string(models.Email): tt.objective}
+			rr := httptest.NewRecorder()
+			restGetDeliveryMetrics(rr, logger.NewMockClient(), config)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var report DeliveryReport
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+			assert.Len(t, report.Windows, 3)
+			require.Len(t, report.Objectives, 1)
+		})
+	}
+}
@@ -16,13 +16,19 @@
 package notifications
 
 import (
+	"fmt"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
+// distribute sends the notification to the subscriptions matching it, and to those the routing rules route it to,
+// and records how it was distributed
 func distribute(
 	n models.Notification,
 	lc logger.LoggingClient,
@@ -30,19 +36,67 @@ func distribute(
 	config notificationsConfig.ConfigurationStruct) error {
 
 	lc.Debug("DistributionCoordinator start distributing notification: " + n.Slug)
-	var categories []string
-	categories = append(categories, string(n.Category))
-	subs, err := dbClient.GetSubscriptionByCategoriesLabels(categories, n.Labels)
+	d := notificationsModels.NotificationDistribution{NotificationId: n.ID, Slug: n.Slug, Started: db.MakeTimestamp()}
+	defer recordDistribution(&d, lc, dbClient)
+
+	n, routes, suppressed := applyRoutingRules(n, config.Writable.RoutingRules, lc)
+	d.Severity, d.Labels = string(n.Severity), n.Labels
+	if suppressed {
+		lc.Info("Notification suppressed by routing rules: " + n.Slug)
+		d.Suppressed = true
+		return nil
+	}
+
+	subs, err := dbClient.GetSubscriptionByCategoriesLabels(distributionCategories(n), n.Labels)
 	if err != nil {
 		lc.Error("Unable to get subscriptions to distribute notification:" + n.Slug)
+		d.Error = err.Error()
 		return err
 	}
-	for _, sub := range subs {
-		send(n, sub, lc, dbClient, config)
+	matched := len(subs)
+	subs = appendRoutedSubscriptions(subs, routes, lc, dbClient)
+	for _, slug := range routes {
+		if !containsSubscription(subs, slug) && !contains(d.UnresolvedRoutes, slug) {
+			d.UnresolvedRoutes = append(d.UnresolvedRoutes, slug)
+		}
+	}
+
+	d.Subscriptions = make([]notificationsModels.DistributionSubscription, len(subs))
+	for i, sub := range subs {
+		d.Subscriptions[i] = notificationsModels.DistributionSubscription{
+			Slug:      sub.Slug,
+			Receiver:  sub.Receiver,
+			MatchedBy: notificationsModels.MatchedByCategoriesLabels,
+			Attempts:  send(n, sub, lc, dbClient, config),
+		}
+		if i >= matched {
+			d.Subscriptions[i].MatchedBy = notificationsModels.MatchedByRoutingRule
+		}
 	}
 	return nil
 }
 
+// recordDistribution stores the record of the distribution of the notification, completed now. A notification which
+// wasn't stored, i.e. an escalation notice, has no record.
+func recordDistribution(d *notificationsModels.NotificationDistribution, lc logger.LoggingClient, dbClient interfaces.DBClient) {
+	if d.NotificationId == "" {
+		return
+	}
+	d.Completed = db.MakeTimestamp()
+	if err := dbClient.SetNotificationDistribution(*d); err != nil {
+		lc.Error(fmt.Sprintf("Unable to record the distribution of notification %s: %s", d.Slug, err.Error()))
+	}
+}
+
+func containsSubscription(subs []models.Subscription, slug string) bool {
+	for _, sub := range subs {
+		if sub.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
 func resend(
 	t models.Transmission,
 	lc logger.LoggingClient,
@@ -53,16 +107,20 @@ func resend(
 	resendViaChannel(t, lc, dbClient, config)
 }
 
+// send sends the notification through every channel of the subscription, returning the attempts
 func send(
 	n models.Notification,
 	s models.Subscription,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	config notificationsConfig.ConfigurationStruct) {
+	config notificationsConfig.ConfigurationStruct) []notificationsModels.DistributionAttempt {
 
-	for _, ch := range s.Channels {
-		sendViaChannel(n, ch, s.Receiver, lc, dbClient, config)
+	locale := subscriptionLocale(s, lc, dbClient)
+	attempts := make([]notificationsModels.DistributionAttempt, len(s.Channels))
+	for i, ch := range s.Channels {
+		attempts[i] = sendViaChannel(n, ch, s.Receiver, locale, lc, dbClient, config)
 	}
+	return attempts
 }
 
 func criticalSeverityResend(
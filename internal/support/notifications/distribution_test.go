@@ -0,0 +1,128 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistributeRecordsDistribution(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	n := contract.Notification{ID: notificationId, Slug: "boiler-overheat", Category: contract.Hwhealth, Severity: contract.Normal}
+	matched := contract.Subscription{Slug: "maintenance", Receiver: "Maintenance Team",
+		Channels: []contract.Channel{{Type: contract.ChannelType(contract.Rest), Url: receiver.URL}}}
+	routed := contract.Subscription{Slug: "on-call", Receiver: "On Call",
+		Channels: []contract.Channel{{Type: contract.ChannelType(contract.Rest), Url: "http://localhost:1"}}}
+	config := notificationsConfig.ConfigurationStruct{}
+	config.Writable.RoutingRules = map[string]notificationsConfig.RoutingRuleInfo{
+		"Escalate": {Subscriptions: []string{"on-call", "missing"}},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("GetSubscriptionByCategoriesLabels", mock.Anything, mock.Anything).Return([]contract.Subscription{matched}, nil)
+	dbClientMock.On("GetSubscriptionBySlug", "on-call").Return(routed, nil)
+	dbClientMock.On("GetSubscriptionBySlug", "missing").Return(contract.Subscription{}, db.ErrNotFound)
+	dbClientMock.On("AddTransmission", mock.Anything).Return("transmission-id", nil)
+	dbClientMock.On("GetTransmissionById", "transmission-id").Return(contract.Transmission{ID: "transmission-id"}, nil)
+	dbClientMock.On("GetSubscriptionByReceiver", mock.Anything).Return([]contract.Subscription{}, nil)
+	var recorded models.NotificationDistribution
+	dbClientMock.On("SetNotificationDistribution", mock.Anything).Run(func(args mock.Arguments) {
+		recorded = args.Get(0).(models.NotificationDistribution)
+	}).Return(nil)
+
+	require.NoError(t, distribute(n, logger.NewMockClient(), dbClientMock, config))
+
+	assert.Equal(t, notificationId, recorded.NotificationId)
+	assert.True(t, recorded.Completed >= recorded.Started)
+	assert.Equal(t, []string{"missing"}, recorded.UnresolvedRoutes)
+	require.Len(t, recorded.Subscriptions, 2)
+	assert.Equal(t, models.MatchedByCategoriesLabels, recorded.Subscriptions[0].MatchedBy)
+	assert.Equal(t, models.MatchedByRoutingRule, recorded.Subscriptions[1].MatchedBy)
+	require.Len(t, recorded.Subscriptions[0].Attempts, 1)
+	assert.Equal(t, string(contract.Sent), recorded.Subscriptions[0].Attempts[0].Status)
+	assert.Equal(t, receiver.URL, recorded.Subscriptions[0].Attempts[0].Target)
+	assert.Equal(t, "transmission-id", recorded.Subscriptions[0].Attempts[0].TransmissionId)
+	require.Len(t, recorded.Subscriptions[1].Attempts, 1)
+	assert.Equal(t, string(contract.Failed), recorded.Subscriptions[1].Attempts[0].Status)
+}
+
+func TestGetNotificationDistribution(t *testing.T) {
+	distribution := models.NotificationDistribution{
+		NotificationId: notificationId,
+		Subscriptions: []models.DistributionSubscription{{
+			Slug:     "maintenance",
+			Attempts: []models.DistributionAttempt{{Status: string(contract.Failed), TransmissionId: "transmission-id"}},
+		}},
+	}
+
+	tests := []struct {
+		name            string
+		id              string
+		expectedStatus  int
+		expectedOutcome string
+	}{
+		{"OK", notificationId, http.StatusOK, string(contract.Trxescalated)},
+		{"Notification not found", "unknown", http.StatusNotFound, ""},
+		{"Not distributed yet", "pending", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("GetNotificationById", notificationId).Return(contract.Notification{ID: notificationId}, nil)
+			dbClientMock.On("GetNotificationById", "pending").Return(contract.Notification{ID: "pending"}, nil)
+			dbClientMock.On("GetNotificationById", "unknown").Return(contract.Notification{}, db.ErrNotFound)
+			dbClientMock.On("GetNotificationDistribution", notificationId).Return(distribution, nil)
+			dbClientMock.On("GetNotificationDistribution", "pending").Return(models.NotificationDistribution{}, db.ErrNotFound)
+			dbClientMock.On("GetTransmissionById", "transmission-id").Return(contract.Transmission{Status: contract.Trxescalated, ResendCount: 3}, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/notification/id/"+tt.id+"/distribution", http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{ID: tt.id})
+			rr := httptest.NewRecorder()
+			restGetNotificationDistribution(rr, req, logger.NewMockClient(), dbClientMock)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var d models.NotificationDistribution
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &d))
+			attempt := d.Subscriptions[0].Attempts[0]
+			assert.Equal(t, string(contract.Failed), attempt.Status)
+			assert.Equal(t, tt.expectedOutcome, attempt.Outcome)
+			assert.Equal(t, 3, attempt.ResendCount)
+		})
+	}
+}
@@ -57,3 +57,53 @@ func NewErrInvalidEmailAddresses(addresses []string, description string) error {
 	return ErrInvalidEmailAddresses{description: description,
 		addresses: addresses}
 }
+
+type ErrInvalidCategoryPattern struct {
+	pattern     string
+	description string
+}
+
+func (e ErrInvalidCategoryPattern) Error() string {
+	return fmt.Sprintf("Invalid category '%s', Reason: %s", e.pattern, e.description)
+}
+
+func NewErrInvalidCategoryPattern(pattern string, description string) error {
+	return ErrInvalidCategoryPattern{pattern: pattern, description: description}
+}
+
+type ErrSenderUnverified struct {
+	description string
+}
+
+func (e ErrSenderUnverified) Error() string {
+	return fmt.Sprintf("Sender not verified, Reason: %s", e.description)
+}
+
+func NewErrSenderUnverified(description string) error {
+	return ErrSenderUnverified{description: description}
+}
+
+type ErrSenderForbidden struct {
+	sender string
+}
+
+func (e ErrSenderForbidden) Error() string {
+	return fmt.Sprintf("Sender '%s' is not allowed to post notifications", e.sender)
+}
+
+func NewErrSenderForbidden(sender string) error {
+	return ErrSenderForbidden{sender: sender}
+}
+
+type ErrInvalidAttachment struct {
+	name        string
+	description string
+}
+
+func (e ErrInvalidAttachment) Error() string {
+	return fmt.Sprintf("Invalid attachment '%s', Reason: %s", e.name, e.description)
+}
+
+func NewErrInvalidAttachment(name string, description string) error {
+	return ErrInvalidAttachment{name: name, description: description}
+}
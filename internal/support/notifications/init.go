@@ -16,8 +16,13 @@ package notifications
 
 import (
 	"context"
+	"net/http"
 	"sync"
+	"time"
 
+	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
 
@@ -37,7 +42,37 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization for the notifications service.
-func (b *Bootstrap) BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
+	smtpTokens = newOAuth2TokenSource(bootstrapContainer.SecretProviderFrom(dic.Get), &http.Client{Timeout: 30 * time.Second})
+
+	senders, err := newRestChannelSenders(notificationsContainer.ConfigurationFrom(dic.Get).RestChannels, bootstrapContainer.SecretProviderFrom(dic.Get))
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Error(err.Error())
+		return false
+	}
+	restChannels = senders
+
+	recorder, err := newConfiguredDeliveryRecorder(notificationsContainer.ConfigurationFrom(dic.Get).DeliveryMetrics)
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Error(err.Error())
+		return false
+	}
+	deliveryMetrics = recorder
+
+	if info := notificationsContainer.ConfigurationFrom(dic.Get).MailTemplates; info.Directory != "" {
+		templates, err := loadMailTemplates(info)
+		if err != nil {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Error(err.Error())
+			return false
+		}
+		mailTemplates = templates
+	}
+
+	if err := startAutoCleanup(ctx, wg, dic); err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Error(err.Error())
+		return false
+	}
+
 	return true
 }
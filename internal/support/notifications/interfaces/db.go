@@ -16,6 +16,10 @@
 package interfaces
 
 import (
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -52,6 +56,35 @@ type DBClient interface {
 	UpdateSubscription(s contract.Subscription) error
 	DeleteSubscriptionById(id string) error
 	DeleteSubscriptionBySlug(id string) error
+	SetSubscriptionCallback(id string, url string) error
+	GetSubscriptionCallback(id string) (string, error)
+	DeleteSubscriptionCallback(id string) error
+	SetSubscriptionRetryPolicy(id string, policy models.RetryPolicy) error
+	GetSubscriptionRetryPolicy(id string) (models.RetryPolicy, error)
+	DeleteSubscriptionRetryPolicy(id string) error
+	SetSubscriptionLocale(id string, locale string) error
+	GetSubscriptionLocale(id string) (string, error)
+	DeleteSubscriptionLocale(id string) error
+
+	// Allowed senders
+	AddAllowedSender(s models.AllowedSender) error
+	GetAllowedSenders() ([]models.AllowedSender, error)
+	GetAllowedSender(sender string) (models.AllowedSender, error)
+	GetAllowedSenderByToken(hash string) (models.AllowedSender, error)
+	DeleteAllowedSender(sender string) error
+
+	// Notification occurrences
+	AddNotificationOccurrences(id string, o models.NotificationOccurrences, window time.Duration) error
+	CoalesceNotification(hash string, at int64) (string, models.NotificationOccurrences, error)
+	GetNotificationOccurrences(id string) (models.NotificationOccurrences, error)
+
+	// Notification attachments
+	SetNotificationAttachments(id string, attachments []models.NotificationAttachment) error
+	GetNotificationAttachments(id string) ([]models.NotificationAttachment, error)
+
+	// Notification distributions
+	SetNotificationDistribution(d models.NotificationDistribution) error
+	GetNotificationDistribution(id string) (models.NotificationDistribution, error)
 
 	// Transmissions
 	GetTransmissionById(id string) (contract.Transmission, error)
@@ -68,4 +101,5 @@ type DBClient interface {
 	// General Cleanup
 	Cleanup() error
 	CleanupOld(age int) error
+	CleanupByCriteria(criteria models.CleanupCriteria) (models.CleanupResult, error)
 }
@@ -4,12 +4,29 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import notificationsmodels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+import time "time"
 
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
 	mock.Mock
 }
 
+// AddAllowedSender provides a mock function with given fields: s
+func (_m *DBClient) AddAllowedSender(s notificationsmodels.AllowedSender) error {
+	ret := _m.Called(s)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.AllowedSender) error); ok {
+		r0 = rf(s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddNotification provides a mock function with given fields: n
 func (_m *DBClient) AddNotification(n models.Notification) (string, error) {
 	ret := _m.Called(n)
@@ -31,6 +48,20 @@ func (_m *DBClient) AddNotification(n models.Notification) (string, error) {
 	return r0, r1
 }
 
+// AddNotificationOccurrences provides a mock function with given fields: id, o, window
+func (_m *DBClient) AddNotificationOccurrences(id string, o notificationsmodels.NotificationOccurrences, window time.Duration) error {
+	ret := _m.Called(id, o, window)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, notificationsmodels.NotificationOccurrences, time.Duration) error); ok {
+		r0 = rf(id, o, window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddSubscription provides a mock function with given fields: s
 func (_m *DBClient) AddSubscription(s models.Subscription) (string, error) {
 	ret := _m.Called(s)
@@ -87,6 +118,27 @@ func (_m *DBClient) Cleanup() error {
 	return r0
 }
 
+// CleanupByCriteria provides a mock function with given fields: criteria
+func (_m *DBClient) CleanupByCriteria(criteria notificationsmodels.CleanupCriteria) (notificationsmodels.CleanupResult, error) {
+	ret := _m.Called(criteria)
+
+	var r0 notificationsmodels.CleanupResult
+	if rf, ok := ret.Get(0).(func(notificationsmodels.CleanupCriteria) notificationsmodels.CleanupResult); ok {
+		r0 = rf(criteria)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.CleanupResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(notificationsmodels.CleanupCriteria) error); ok {
+		r1 = rf(criteria)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CleanupOld provides a mock function with given fields: age
 func (_m *DBClient) CleanupOld(age int) error {
 	ret := _m.Called(age)
@@ -106,6 +158,48 @@ func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// CoalesceNotification provides a mock function with given fields: hash, at
+func (_m *DBClient) CoalesceNotification(hash string, at int64) (string, notificationsmodels.NotificationOccurrences, error) {
+	ret := _m.Called(hash, at)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, int64) string); ok {
+		r0 = rf(hash, at)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 notificationsmodels.NotificationOccurrences
+	if rf, ok := ret.Get(1).(func(string, int64) notificationsmodels.NotificationOccurrences); ok {
+		r1 = rf(hash, at)
+	} else {
+		r1 = ret.Get(1).(notificationsmodels.NotificationOccurrences)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, int64) error); ok {
+		r2 = rf(hash, at)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DeleteAllowedSender provides a mock function with given fields: sender
+func (_m *DBClient) DeleteAllowedSender(sender string) error {
+	ret := _m.Called(sender)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(sender)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteNotificationById provides a mock function with given fields: id
 func (_m *DBClient) DeleteNotificationById(id string) error {
 	ret := _m.Called(id)
@@ -176,6 +270,48 @@ func (_m *DBClient) DeleteSubscriptionBySlug(id string) error {
 	return r0
 }
 
+// DeleteSubscriptionCallback provides a mock function with given fields: id
+func (_m *DBClient) DeleteSubscriptionCallback(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionLocale provides a mock function with given fields: id
+func (_m *DBClient) DeleteSubscriptionLocale(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionRetryPolicy provides a mock function with given fields: id
+func (_m *DBClient) DeleteSubscriptionRetryPolicy(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteTransmission provides a mock function with given fields: age, status
 func (_m *DBClient) DeleteTransmission(age int64, status models.TransmissionStatus) error {
 	ret := _m.Called(age, status)
@@ -190,6 +326,71 @@ func (_m *DBClient) DeleteTransmission(age int64, status models.TransmissionStat
 	return r0
 }
 
+// GetAllowedSender provides a mock function with given fields: sender
+func (_m *DBClient) GetAllowedSender(sender string) (notificationsmodels.AllowedSender, error) {
+	ret := _m.Called(sender)
+
+	var r0 notificationsmodels.AllowedSender
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.AllowedSender); ok {
+		r0 = rf(sender)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.AllowedSender)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(sender)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllowedSenderByToken provides a mock function with given fields: hash
+func (_m *DBClient) GetAllowedSenderByToken(hash string) (notificationsmodels.AllowedSender, error) {
+	ret := _m.Called(hash)
+
+	var r0 notificationsmodels.AllowedSender
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.AllowedSender); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.AllowedSender)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllowedSenders provides a mock function with given fields:
+func (_m *DBClient) GetAllowedSenders() ([]notificationsmodels.AllowedSender, error) {
+	ret := _m.Called()
+
+	var r0 []notificationsmodels.AllowedSender
+	if rf, ok := ret.Get(0).(func() []notificationsmodels.AllowedSender); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.AllowedSender)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNewNormalNotifications provides a mock function with given fields: limit
 func (_m *DBClient) GetNewNormalNotifications(limit int) ([]models.Notification, error) {
 	ret := _m.Called(limit)
@@ -236,6 +437,29 @@ func (_m *DBClient) GetNewNotifications(limit int) ([]models.Notification, error
 	return r0, r1
 }
 
+// GetNotificationAttachments provides a mock function with given fields: id
+func (_m *DBClient) GetNotificationAttachments(id string) ([]notificationsmodels.NotificationAttachment, error) {
+	ret := _m.Called(id)
+
+	var r0 []notificationsmodels.NotificationAttachment
+	if rf, ok := ret.Get(0).(func(string) []notificationsmodels.NotificationAttachment); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.NotificationAttachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNotificationById provides a mock function with given fields: id
 func (_m *DBClient) GetNotificationById(id string) (models.Notification, error) {
 	ret := _m.Called(id)
@@ -301,6 +525,48 @@ func (_m *DBClient) GetNotificationBySlug(slug string) (models.Notification, err
 	return r0, r1
 }
 
+// GetNotificationDistribution provides a mock function with given fields: id
+func (_m *DBClient) GetNotificationDistribution(id string) (notificationsmodels.NotificationDistribution, error) {
+	ret := _m.Called(id)
+
+	var r0 notificationsmodels.NotificationDistribution
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.NotificationDistribution); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationDistribution)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationOccurrences provides a mock function with given fields: id
+func (_m *DBClient) GetNotificationOccurrences(id string) (notificationsmodels.NotificationOccurrences, error) {
+	ret := _m.Called(id)
+
+	var r0 notificationsmodels.NotificationOccurrences
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.NotificationOccurrences); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationOccurrences)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNotifications provides a mock function with given fields:
 func (_m *DBClient) GetNotifications() ([]models.Notification, error) {
 	ret := _m.Called()
@@ -550,6 +816,69 @@ func (_m *DBClient) GetSubscriptionBySlug(slug string) (models.Subscription, err
 	return r0, r1
 }
 
+// GetSubscriptionCallback provides a mock function with given fields: id
+func (_m *DBClient) GetSubscriptionCallback(id string) (string, error) {
+	ret := _m.Called(id)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubscriptionLocale provides a mock function with given fields: id
+func (_m *DBClient) GetSubscriptionLocale(id string) (string, error) {
+	ret := _m.Called(id)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubscriptionRetryPolicy provides a mock function with given fields: id
+func (_m *DBClient) GetSubscriptionRetryPolicy(id string) (notificationsmodels.RetryPolicy, error) {
+	ret := _m.Called(id)
+
+	var r0 notificationsmodels.RetryPolicy
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.RetryPolicy); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.RetryPolicy)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSubscriptions provides a mock function with given fields:
 func (_m *DBClient) GetSubscriptions() ([]models.Subscription, error) {
 	ret := _m.Called()
@@ -746,6 +1075,76 @@ func (_m *DBClient) MarkNotificationProcessed(n models.Notification) error {
 	return r0
 }
 
+// SetNotificationAttachments provides a mock function with given fields: id, attachments
+func (_m *DBClient) SetNotificationAttachments(id string, attachments []notificationsmodels.NotificationAttachment) error {
+	ret := _m.Called(id, attachments)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []notificationsmodels.NotificationAttachment) error); ok {
+		r0 = rf(id, attachments)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetNotificationDistribution provides a mock function with given fields: d
+func (_m *DBClient) SetNotificationDistribution(d notificationsmodels.NotificationDistribution) error {
+	ret := _m.Called(d)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(notificationsmodels.NotificationDistribution) error); ok {
+		r0 = rf(d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSubscriptionCallback provides a mock function with given fields: id, url
+func (_m *DBClient) SetSubscriptionCallback(id string, url string) error {
+	ret := _m.Called(id, url)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, url)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSubscriptionLocale provides a mock function with given fields: id, locale
+func (_m *DBClient) SetSubscriptionLocale(id string, locale string) error {
+	ret := _m.Called(id, locale)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, locale)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSubscriptionRetryPolicy provides a mock function with given fields: id, policy
+func (_m *DBClient) SetSubscriptionRetryPolicy(id string, policy notificationsmodels.RetryPolicy) error {
+	ret := _m.Called(id, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, notificationsmodels.RetryPolicy) error); ok {
+		r0 = rf(id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateNotification provides a mock function with given fields: n
 func (_m *DBClient) UpdateNotification(n models.Notification) error {
 	ret := _m.Called(n)
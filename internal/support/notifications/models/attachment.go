@@ -0,0 +1,30 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+// NotificationAttachment is a file the emails sending a notification carry, i.e. a CSV of the offending readings.
+// Its content is either posted with the notification or referenced in core-data, and fetched when the emails are sent.
+type NotificationAttachment struct {
+	// Name is the file name of the attachment, i.e. readings.csv
+	Name string `json:"name"`
+	// ContentType is the media type of the attachment, i.e. text/csv. It is the one core-data responds with when
+	// empty for a Reference.
+	ContentType string `json:"contentType,omitempty"`
+	// Content is the content of the attachment, base64 encoded in JSON
+	Content []byte `json:"content,omitempty"`
+	// Reference is the path of the content in core-data, i.e. /api/v1/reading/device/Camera/1
+	Reference string `json:"reference,omitempty"`
+}
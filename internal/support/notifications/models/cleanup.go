@@ -0,0 +1,92 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+import (
+	"fmt"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// CleanupCriteria selects the notifications deleted by a cleanup along with their transmissions. A notification is
+// selected when it satisfies every non-empty list and is older than Age.
+type CleanupCriteria struct {
+	// Statuses the notification may have
+	Statuses []string
+	// Severities the notification may have
+	Severities []string
+	// Categories the notification may have
+	Categories []string
+	// Senders the notification may come from
+	Senders []string
+	// Age is the number of milliseconds since the creation of the notification it must exceed, 0 selects any age
+	Age int64
+}
+
+// Validate checks the statuses, severities and categories of the criteria are known to notifications
+func (c CleanupCriteria) Validate() error {
+	for _, status := range c.Statuses {
+		switch contract.NotificationsStatus(status) {
+		case contract.New, contract.Processed, contract.Escalated:
+		default:
+			return fmt.Errorf("invalid notification status '%s'", status)
+		}
+	}
+	for _, severity := range c.Severities {
+		switch contract.NotificationsSeverity(severity) {
+		case contract.Critical, contract.Normal:
+		default:
+			return fmt.Errorf("invalid notification severity '%s'", severity)
+		}
+	}
+	for _, category := range c.Categories {
+		switch contract.NotificationsCategory(category) {
+		case contract.Security, contract.Hwhealth, contract.Swhealth:
+		default:
+			return fmt.Errorf("invalid notification category '%s'", category)
+		}
+	}
+	if c.Age < 0 {
+		return fmt.Errorf("cleanup age must not be negative")
+	}
+	return nil
+}
+
+// Matches tells whether the notification, ignoring its age, is selected by the criteria
+func (c CleanupCriteria) Matches(n contract.Notification) bool {
+	return matchesAny(c.Statuses, string(n.Status)) &&
+		matchesAny(c.Severities, string(n.Severity)) &&
+		matchesAny(c.Categories, string(n.Category)) &&
+		matchesAny(c.Senders, n.Sender)
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// CleanupResult counts what a cleanup deleted
+type CleanupResult struct {
+	Notifications int `json:"notifications"`
+	Transmissions int `json:"transmissions"`
+}
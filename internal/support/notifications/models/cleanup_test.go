@@ -0,0 +1,81 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+import (
+	"testing"
+
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanupCriteriaMatches(t *testing.T) {
+	n := contract.Notification{
+		Sender:   "device-a",
+		Category: contract.Swhealth,
+		Severity: contract.Normal,
+		Status:   contract.Processed,
+	}
+
+	tests := []struct {
+		name     string
+		criteria CleanupCriteria
+		expected bool
+	}{
+		{"No criteria", CleanupCriteria{}, true},
+		{"Matching status", CleanupCriteria{Statuses: []string{"NEW", "PROCESSED"}}, true},
+		{"Other status", CleanupCriteria{Statuses: []string{"NEW"}}, false},
+		{"Other severity", CleanupCriteria{Severities: []string{"CRITICAL"}}, false},
+		{"Other category", CleanupCriteria{Categories: []string{"SECURITY"}}, false},
+		{"Other sender", CleanupCriteria{Senders: []string{"device-b"}}, false},
+		{
+			"Every criterion matching",
+			CleanupCriteria{
+				Statuses:   []string{"PROCESSED"},
+				Severities: []string{"NORMAL"},
+				Categories: []string{"SW_HEALTH"},
+				Senders:    []string{"device-a"},
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.criteria.Matches(n))
+		})
+	}
+}
+
+func TestCleanupCriteriaValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		criteria    CleanupCriteria
+		expectError bool
+	}{
+		{"Valid", CleanupCriteria{Statuses: []string{"ESCALATED"}, Severities: []string{"CRITICAL"}, Categories: []string{"HW_HEALTH"}, Age: 10}, false},
+		{"Invalid status", CleanupCriteria{Statuses: []string{"SENT"}}, true},
+		{"Invalid severity", CleanupCriteria{Severities: []string{"LOW"}}, true},
+		{"Invalid category", CleanupCriteria{Categories: []string{"HEALTH"}}, true},
+		{"Negative age", CleanupCriteria{Age: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.criteria.Validate()
+			assert.Equal(t, tt.expectError, err != nil)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+const (
+	// MatchedByCategoriesLabels marks the subscriptions matching the categories or labels of the notification
+	MatchedByCategoriesLabels = "categories/labels"
+	// MatchedByRoutingRule marks the subscriptions the routing rules routed the notification to
+	MatchedByRoutingRule = "routing rule"
+)
+
+// NotificationDistribution traces how a notification was distributed: the subscriptions it matched, the channels
+// attempted for each of them and how the attempts went, so that a missing alert can be diagnosed without correlating
+// the transmissions by hand.
+type NotificationDistribution struct {
+	NotificationId string `json:"notificationId"`
+	Slug           string `json:"slug"`
+	// Started and Completed are when the distribution started and when every channel was attempted once, in
+	// milliseconds
+	Started   int64 `json:"started"`
+	Completed int64 `json:"completed"`
+	// Severity and Labels are those of the notification once the routing rules applied
+	Severity string   `json:"severity"`
+	Labels   []string `json:"labels,omitempty"`
+	// Suppressed tells a routing rule suppressed the notification, nothing was sent
+	Suppressed bool `json:"suppressed,omitempty"`
+	// Error is why the subscriptions couldn't be looked up, nothing was sent
+	Error string `json:"error,omitempty"`
+	// UnresolvedRoutes are the slugs of the subscriptions the routing rules routed the notification to which don't
+	// exist
+	UnresolvedRoutes []string                   `json:"unresolvedRoutes,omitempty"`
+	Subscriptions    []DistributionSubscription `json:"subscriptions"`
+}
+
+// DistributionSubscription is a subscription a notification was distributed to
+type DistributionSubscription struct {
+	Slug     string `json:"slug"`
+	Receiver string `json:"receiver"`
+	// MatchedBy is how the subscription was selected, MatchedByCategoriesLabels or MatchedByRoutingRule
+	MatchedBy string                `json:"matchedBy"`
+	Attempts  []DistributionAttempt `json:"attempts"`
+}
+
+// DistributionAttempt is the first attempt at sending a notification through a channel of a subscription
+type DistributionAttempt struct {
+	Channel string `json:"channel"`
+	// Target is the URL of the REST channel, or the addresses of the email channel
+	Target string `json:"target"`
+	// Started is when the attempt started, in milliseconds, and Duration how long it took
+	Started  int64 `json:"started"`
+	Duration int64 `json:"duration"`
+	// Status and Response are the outcome of the attempt
+	Status   string `json:"status"`
+	Response string `json:"response,omitempty"`
+	// TransmissionId is the transmission persisted for the attempt, empty when it couldn't be persisted
+	TransmissionId string `json:"transmissionId,omitempty"`
+	// Outcome and ResendCount are the current status of the transmission, final once it isn't resent anymore, and
+	// how many times it was resent. They are filled in when the distribution is read.
+	Outcome     string `json:"outcome,omitempty"`
+	ResendCount int    `json:"resendCount"`
+}
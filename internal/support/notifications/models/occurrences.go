@@ -0,0 +1,27 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+// NotificationOccurrences counts the identical notifications coalesced into a notification by duplicate suppression.
+// Identical notifications come from the same sender with the same content, identified by their Hash.
+type NotificationOccurrences struct {
+	Hash string `json:"hash"`
+	// Count is the number of identical notifications posted, including the one they were coalesced into
+	Count int `json:"count"`
+	// First and Last are when the first and the last identical notifications were posted
+	First int64 `json:"first"`
+	Last  int64 `json:"last"`
+}
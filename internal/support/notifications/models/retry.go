@@ -0,0 +1,79 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// RetryPolicy controls how a failed transmission of a critical notification is resent before it is escalated. The
+// n-th resend waits InitialInterval * Multiplier^(n-1), capped at MaxInterval, randomly spread by +/- Jitter of it.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a transmission is sent, including the first send
+	MaxAttempts int `json:"maxAttempts"`
+	// InitialInterval is the wait before the first resend, e.g. '5s'
+	InitialInterval string `json:"initialInterval"`
+	// MaxInterval caps the wait between resends, no cap when empty
+	MaxInterval string `json:"maxInterval,omitempty"`
+	// Multiplier grows the wait after every resend, the wait is constant when it is 1 or less
+	Multiplier float64 `json:"multiplier,omitempty"`
+	// Jitter is the fraction of the wait, between 0 and 1, it is randomly shortened or lengthened by
+	Jitter float64 `json:"jitter,omitempty"`
+}
+
+// Validate checks the policy can compute the wait of every resend
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 {
+		return fmt.Errorf("retry policy maxAttempts must be at least 1")
+	}
+	if _, err := time.ParseDuration(p.InitialInterval); err != nil {
+		return fmt.Errorf("invalid retry policy initialInterval '%s': %s", p.InitialInterval, err.Error())
+	}
+	if p.MaxInterval != "" {
+		if _, err := time.ParseDuration(p.MaxInterval); err != nil {
+			return fmt.Errorf("invalid retry policy maxInterval '%s': %s", p.MaxInterval, err.Error())
+		}
+	}
+	if p.Multiplier < 0 {
+		return fmt.Errorf("retry policy multiplier must not be negative")
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry policy jitter must be between 0 and 1")
+	}
+	return nil
+}
+
+// Backoff returns the wait before the resend following resendCount resends. random is a number in [0, 1) choosing
+// where the wait falls within the jitter.
+func (p RetryPolicy) Backoff(resendCount int, random float64) (time.Duration, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	initial, _ := time.ParseDuration(p.InitialInterval)
+
+	wait := float64(initial)
+	if p.Multiplier > 1 {
+		wait *= math.Pow(p.Multiplier, float64(resendCount))
+	}
+	if p.MaxInterval != "" {
+		max, _ := time.ParseDuration(p.MaxInterval)
+		wait = math.Min(wait, float64(max))
+	}
+	wait += wait * p.Jitter * (2*random - 1)
+	return time.Duration(wait), nil
+}
@@ -0,0 +1,57 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	exponential := RetryPolicy{MaxAttempts: 6, InitialInterval: "30s", MaxInterval: "3m", Multiplier: 2}
+	constant := RetryPolicy{MaxAttempts: 3, InitialInterval: "5s"}
+	jittered := RetryPolicy{MaxAttempts: 3, InitialInterval: "10s", Jitter: 0.2}
+
+	tests := []struct {
+		name        string
+		policy      RetryPolicy
+		resendCount int
+		random      float64
+		expected    time.Duration
+	}{
+		{"first resend", exponential, 0, 0.5, 30 * time.Second},
+		{"third resend", exponential, 2, 0.5, 2 * time.Minute},
+		{"capped at max interval", exponential, 4, 0.5, 3 * time.Minute},
+		{"constant", constant, 2, 0.5, 5 * time.Second},
+		{"shortest jitter", jittered, 0, 0, 8 * time.Second},
+		{"longest jitter", jittered, 0, 1, 12 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wait, err := tt.policy.Backoff(tt.resendCount, tt.random)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, wait)
+		})
+	}
+}
+
+func TestRetryPolicyBackoffInvalid(t *testing.T) {
+	_, err := RetryPolicy{MaxAttempts: 3, InitialInterval: "5s", MaxInterval: "later"}.Backoff(0, 0.5)
+	assert.Error(t, err)
+}
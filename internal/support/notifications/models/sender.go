@@ -0,0 +1,25 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package models
+
+// AllowedSender is a sender of the allow-list, allowed to post notifications when sender verification is enabled. The
+// sender is verified by the subject of the caller's JWT or by the internal token minted for it.
+type AllowedSender struct {
+	Sender string `json:"sender"`
+	// TokenHash is the SHA-256 hash of the internal token of the sender, empty when it is only verified by JWT
+	TokenHash string `json:"tokenHash,omitempty"`
+	Created   int64  `json:"created"`
+}
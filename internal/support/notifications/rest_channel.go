@@ -0,0 +1,227 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/secret"
+)
+
+// RestTokenKey is the key of the bearer token of a REST channel in the secret store
+const RestTokenKey = "token"
+
+// restChannels sends the notifications to the configured REST channels, set at bootstrap
+var restChannels *restChannelSenders
+
+// restChannel sends the requests of the REST channels matching its configuration
+type restChannel struct {
+	name   string
+	info   notificationsConfig.RestChannelInfo
+	method string
+	client *http.Client
+}
+
+// restChannelSenders are the configured REST channels, the longest Url first
+type restChannelSenders struct {
+	secretProvider bootstrapInterfaces.SecretProvider
+	channels       []restChannel
+}
+
+func newRestChannelSenders(infos map[string]notificationsConfig.RestChannelInfo, secretProvider bootstrapInterfaces.SecretProvider) (*restChannelSenders, error) {
+	senders := &restChannelSenders{secretProvider: secretProvider}
+	for name, info := range infos {
+		c, err := newRestChannel(name, info)
+		if err != nil {
+			return nil, err
+		}
+		senders.channels = append(senders.channels, c)
+	}
+	sort.Slice(senders.channels, func(i, j int) bool {
+		if len(senders.channels[i].info.Url) != len(senders.channels[j].info.Url) {
+			return len(senders.channels[i].info.Url) > len(senders.channels[j].info.Url)
+		}
+		return senders.channels[i].name < senders.channels[j].name
+	})
+	return senders, nil
+}
+
+func newRestChannel(name string, info notificationsConfig.RestChannelInfo) (restChannel, error) {
+	c := restChannel{name: name, info: info, method: strings.ToUpper(info.Method)}
+	if info.Url == "" {
+		return c, fmt.Errorf("REST channel %s has no Url", name)
+	}
+	if c.method == "" {
+		c.method = http.MethodPost
+	}
+	switch strings.ToLower(info.AuthMode) {
+	case "":
+	case notificationsConfig.RestAuthModeBearer, notificationsConfig.RestAuthModeBasic:
+		if info.SecretPath == "" {
+			return c, fmt.Errorf("REST channel %s AuthMode '%s' requires a SecretPath", name, info.AuthMode)
+		}
+	default:
+		return c, fmt.Errorf("invalid REST channel %s AuthMode '%s', '%s' or '%s' expected",
+			name, info.AuthMode, notificationsConfig.RestAuthModeBearer, notificationsConfig.RestAuthModeBasic)
+	}
+	for _, code := range info.ExpectedStatusCodes {
+		if code < 100 || code > 599 {
+			return c, fmt.Errorf("invalid REST channel %s expected status code %d", name, code)
+		}
+	}
+
+	c.client = &http.Client{}
+	if info.Timeout != "" {
+		timeout, err := time.ParseDuration(info.Timeout)
+		if err != nil || timeout <= 0 {
+			return c, fmt.Errorf("invalid REST channel %s Timeout '%s'", name, info.Timeout)
+		}
+		c.client.Timeout = timeout
+	}
+	tlsConfig, err := restChannelTLSConfig(info.TLS)
+	if err != nil {
+		return c, fmt.Errorf("invalid REST channel %s TLS settings: %s", name, err.Error())
+	}
+	if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		c.client.Transport = transport
+	}
+	return c, nil
+}
+
+// restChannelTLSConfig returns the TLS configuration of the REST channel, nil when the defaults apply
+func restChannelTLSConfig(info notificationsConfig.RestChannelTLSInfo) (*tls.Config, error) {
+	if info == (notificationsConfig.RestChannelTLSInfo{}) {
+		return nil, nil
+	}
+	config := &tls.Config{ServerName: info.ServerName, InsecureSkipVerify: info.InsecureSkipVerify}
+	if info.CAFile != "" {
+		pem, err := ioutil.ReadFile(info.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", info.CAFile)
+		}
+	}
+	if info.CertFile != "" || info.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(info.CertFile, info.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+	return config, nil
+}
+
+// channelOf returns the configured REST channel with the longest Url the URL starts with, nil when there is none
+func (r *restChannelSenders) channelOf(url string) *restChannel {
+	if r == nil {
+		return nil
+	}
+	for i := range r.channels {
+		if strings.HasPrefix(url, r.channels[i].info.Url) {
+			return &r.channels[i]
+		}
+	}
+	return nil
+}
+
+// send sends the message to the URL of the channel, and returns the response status. An error is returned when the
+// request fails, including when the context is done first, or the status isn't expected.
+func (r *restChannelSenders) send(
+	ctx context.Context,
+	c *restChannel,
+	message string,
+	url string,
+	contentType string) (string, error) {
+
+	request, err := http.NewRequestWithContext(ctx, c.method, url, bytes.NewBufferString(message))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", contentType)
+	for name, value := range c.info.Headers {
+		request.Header.Set(name, value)
+	}
+	if err = r.authenticate(c, request); err != nil {
+		return "", err
+	}
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	_ = response.Body.Close()
+
+	if !c.expects(response.StatusCode) {
+		return response.Status, fmt.Errorf("unexpected response status code: %s", response.Status)
+	}
+	return response.Status, nil
+}
+
+// authenticate sets the Authorization header of the request from the secrets of the channel, read on every request so
+// that rotated secrets apply right away
+func (r *restChannelSenders) authenticate(c *restChannel, request *http.Request) error {
+	mode := strings.ToLower(c.info.AuthMode)
+	if mode == "" {
+		return nil
+	}
+	secrets, err := r.secretProvider.GetSecrets(c.info.SecretPath)
+	if err != nil {
+		return fmt.Errorf("unable to read the secrets of REST channel %s from '%s': %s", c.name, c.info.SecretPath, err.Error())
+	}
+	switch mode {
+	case notificationsConfig.RestAuthModeBearer:
+		if secrets[RestTokenKey] == "" {
+			return fmt.Errorf("expecting %s secret in '%s'", RestTokenKey, c.info.SecretPath)
+		}
+		request.Header.Set("Authorization", "Bearer "+secrets[RestTokenKey])
+	case notificationsConfig.RestAuthModeBasic:
+		if secrets[secret.UsernameKey] == "" {
+			return fmt.Errorf("expecting %s secret in '%s'", secret.UsernameKey, c.info.SecretPath)
+		}
+		request.SetBasicAuth(secrets[secret.UsernameKey], secrets[secret.PasswordKey])
+	}
+	return nil
+}
+
+// expects returns whether the response status code is one of a successful transmission
+func (c *restChannel) expects(code int) bool {
+	if len(c.info.ExpectedStatusCodes) == 0 {
+		return code >= http.StatusOK && code < http.StatusMultipleChoices
+	}
+	for _, expected := range c.info.ExpectedStatusCodes {
+		if code == expected {
+			return true
+		}
+	}
+	return false
+}
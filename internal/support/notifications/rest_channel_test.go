@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestSendThroughConfiguredChannel(t *testing.T) {
+	var received *http.Request
+	status := http.StatusAccepted
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("GetSecrets", "webhooks").Return(map[string]string{RestTokenKey: "token"}, nil)
+	secretProvider.On("GetSecrets", "basic").Return(map[string]string{"username": "edgex", "password": "secret"}, nil)
+	senders, err := newRestChannelSenders(map[string]notificationsConfig.RestChannelInfo{
+		"Webhooks": {
+			Url:                 server.URL + "/",
+			Method:              "put",
+			Headers:             map[string]string{"X-Tenant": "plant1"},
+			AuthMode:            "bearer",
+			SecretPath:          "webhooks",
+			ExpectedStatusCodes: []int{http.StatusAccepted},
+			Timeout:             "5s",
+			TLS:                 notificationsConfig.RestChannelTLSInfo{InsecureSkipVerify: true},
+		},
+		"Basic": {
+			Url:        server.URL + "/basic/",
+			AuthMode:   "basic",
+			SecretPath: "basic",
+			TLS:        notificationsConfig.RestChannelTLSInfo{InsecureSkipVerify: true},
+		},
+	}, secretProvider)
+	require.NoError(t, err)
+	restChannels = senders
+	defer func() { restChannels = nil }()
+
+	tr := restSend("alert", server.URL+"/alerts", "", logger.NewMockClient())
+	assert.Equal(t, models.TransmissionStatus(models.Sent), tr.Status, tr.Response)
+	require.NotNil(t, received)
+	assert.Equal(t, http.MethodPut, received.Method)
+	assert.Equal(t, "plant1", received.Header.Get("X-Tenant"))
+	assert.Equal(t, "Bearer token", received.Header.Get("Authorization"))
+	assert.Equal(t, "text/plain", received.Header.Get("Content-Type"))
+
+	status = http.StatusOK
+	tr = restSend("alert", server.URL+"/alerts", "", logger.NewMockClient())
+	assert.Equal(t, models.TransmissionStatus(models.Failed), tr.Status, "a status which isn't expected should fail the transmission")
+
+	tr = restSend("alert", server.URL+"/basic/alerts", "application/json", logger.NewMockClient())
+	assert.Equal(t, models.TransmissionStatus(models.Sent), tr.Status, tr.Response)
+	assert.Equal(t, http.MethodPost, received.Method, "the channel with the longest Url should apply")
+	username, password, ok := received.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "edgex", username)
+	assert.Equal(t, "secret", password)
+
+	status = http.StatusInternalServerError
+	tr = restSend("alert", server.URL+"/basic/alerts", "application/json", logger.NewMockClient())
+	assert.Equal(t, models.TransmissionStatus(models.Failed), tr.Status, "any 2xx status should be expected by default")
+}
+
+func TestNewRestChannelSendersInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		info notificationsConfig.RestChannelInfo
+	}{
+		{"no url", notificationsConfig.RestChannelInfo{}},
+		{"auth mode", notificationsConfig.RestChannelInfo{Url: "http://localhost", AuthMode: "digest", SecretPath: "hooks"}},
+		{"no secret path", notificationsConfig.RestChannelInfo{Url: "http://localhost", AuthMode: "bearer"}},
+		{"status code", notificationsConfig.RestChannelInfo{Url: "http://localhost", ExpectedStatusCodes: []int{2000}}},
+		{"timeout", notificationsConfig.RestChannelInfo{Url: "http://localhost", Timeout: "soon"}},
+		{"CA file", notificationsConfig.RestChannelInfo{Url: "https://localhost", TLS: notificationsConfig.RestChannelTLSInfo{CAFile: "/nonexistent/ca.pem"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newRestChannelSenders(map[string]notificationsConfig.RestChannelInfo{"Hooks": tt.info}, &mocks.SecretProvider{})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+)
+
+// restGetNotificationDistribution returns how the notification was distributed, each attempt completed with the
+// current status of its transmission so that the outcome of the resends is known
+func restGetNotificationDistribution(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	id := mux.Vars(r)[ID]
+	if _, err := dbClient.GetNotificationById(id); err != nil {
+		lc.Error(err.Error())
+		if err == db.ErrNotFound {
+			err = errors.NewErrNotificationNotFound(id)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	d, err := dbClient.GetNotificationDistribution(id)
+	if err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, fmt.Sprintf("notification %s isn't distributed yet", id), http.StatusNotFound)
+		} else {
+			lc.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	for i := range d.Subscriptions {
+		for j := range d.Subscriptions[i].Attempts {
+			attempt := &d.Subscriptions[i].Attempts[j]
+			if attempt.TransmissionId == "" {
+				continue
+			}
+			t, err := dbClient.GetTransmissionById(attempt.TransmissionId)
+			if err != nil {
+				// the transmission may have been cleaned up since
+				lc.Debug(fmt.Sprintf("unable to get transmission %s of notification %s: %s", attempt.TransmissionId, id, err.Error()))
+				continue
+			}
+			attempt.Outcome, attempt.ResendCount = string(t.Status), t.ResendCount
+		}
+	}
+
+	pkg.Encode(d, w, lc)
+}
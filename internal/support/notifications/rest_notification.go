@@ -17,6 +17,7 @@ package notifications
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -45,9 +46,16 @@ func notificationHandler(
 		defer r.Body.Close()
 	}
 
+	var body json.RawMessage
 	var n models.Notification
-	dec := json.NewDecoder(r.Body)
-	err := dec.Decode(&n)
+	var posted postedAttachments
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err == nil {
+		err = json.Unmarshal(body, &n)
+	}
+	if err == nil {
+		err = json.Unmarshal(body, &posted)
+	}
 
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -55,6 +63,49 @@ func notificationHandler(
 		return
 	}
 
+	if err = validateAttachments(posted.Attachments, config); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
+	if err = verifySender(r, &n, dbClient, config.Writable.SenderVerification); err != nil {
+		lc.Error(err.Error())
+		switch err.(type) {
+		case errors.ErrSenderUnverified:
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.ErrSenderForbidden:
+			http.Error(w, err.Error(), http.StatusForbidden)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	window, err := suppressionWindow(config.Writable.DuplicateSuppression)
+	if err != nil {
+		lc.Error(err.Error())
+	}
+	if window > 0 {
+		suppressionMutex.Lock()
+		defer suppressionMutex.Unlock()
+
+		id, occurrences, err := coalesceNotification(n, dbClient)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+		if id != "" {
+			lc.Debug(fmt.Sprintf("Notification %s coalesced into %s, %d occurrences", n.Slug, id, occurrences.Count))
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.Header().Set(OccurrencesHeader, strconv.Itoa(occurrences.Count))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(id))
+			return
+		}
+	}
+
 	lc.Info("Posting Notification: " + n.String())
 	n.Status = models.NotificationsStatus(models.New)
 	n.ID, err = dbClient.AddNotification(n)
@@ -72,6 +123,20 @@ func notificationHandler(
 		return
 	}
 
+	if len(posted.Attachments) > 0 {
+		if err = dbClient.SetNotificationAttachments(n.ID, posted.Attachments); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(fmt.Sprintf("Trouble storing the attachments of %s: %s", n.Slug, err.Error()))
+			return
+		}
+	}
+
+	if window > 0 {
+		if err = recordOccurrences(n, window, dbClient); err != nil {
+			lc.Error(fmt.Sprintf("Trouble recording the occurrences of %s, identical notifications won't be coalesced: %s", n.Slug, err.Error()))
+		}
+	}
+
 	err = distributeAndMark(n, lc, dbClient, config)
 	if err != nil {
 		return
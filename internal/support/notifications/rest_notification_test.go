@@ -36,6 +36,7 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
 )
 
 // TestURI this is not really used since we are using the HTTP testing framework and not creating routes, but rather
@@ -895,11 +896,11 @@ func TestNotificationHandler(t *testing.T) {
 	notificationInvalidCategoriesAndLabels := createInvalidCategoriesAndLabelsNotification()
 
 	var categories []string
-	categories = append(categories, string(notificationNormal.Category))
+	categories = append(categories, string(notificationNormal.Category), CategoryWildcard)
 	var labels = []string{"first-label", "second-label"}
 
 	var badCategories []string
-	badCategories = append(badCategories, string(notificationInvalidCategoriesAndLabels.Category))
+	badCategories = append(badCategories, string(notificationInvalidCategoriesAndLabels.Category), CategoryWildcard)
 	var badLabels = []string{"first-bad-label", "second-bad-label"}
 
 	tests := []struct {
@@ -915,6 +916,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationNormal)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationNormal, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationNormal)}, []interface{}{nil}},
 			}),
 			http.StatusAccepted,
@@ -926,6 +928,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationCritical)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationCritical, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationCritical)}, []interface{}{nil}},
 			}),
 			http.StatusAccepted,
@@ -937,6 +940,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationInvalid)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{invalidNotificationId}, []interface{}{notificationInvalid, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationInvalid)}, []interface{}{nil}},
 			}),
 			http.StatusBadRequest,
@@ -948,6 +952,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationCritical)}, []interface{}{invalidNotificationId, testError}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationCritical, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationCritical)}, []interface{}{nil}},
 			}),
 			http.StatusConflict,
@@ -959,6 +964,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationCritical)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationBad, testError}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationCritical)}, []interface{}{nil}},
 			}),
 			http.StatusInternalServerError,
@@ -970,6 +976,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationCritical)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationCritical, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, nil}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationCritical)}, []interface{}{nil}},
 			}),
 			http.StatusAccepted,
@@ -981,6 +988,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationCritical)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationCritical, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{categories, labels}, []interface{}{[]contract.Subscription{}, testError}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationCritical)}, []interface{}{testError}},
 			}),
 			http.StatusOK,
@@ -992,6 +1000,7 @@ func TestNotificationHandler(t *testing.T) {
 				{"AddNotification", []interface{}{validateNotification(&notificationInvalidCategoriesAndLabels)}, []interface{}{notificationId, nil}},
 				{"GetNotificationById", []interface{}{notificationId}, []interface{}{notificationInvalidCategoriesAndLabels, nil}},
 				{"GetSubscriptionByCategoriesLabels", []interface{}{badCategories, badLabels}, []interface{}{[]contract.Subscription{}, testError}},
+				{"SetNotificationDistribution", []interface{}{mock.Anything}, []interface{}{nil}},
 				{"MarkNotificationProcessed", []interface{}{validateNotification(&notificationInvalidCategoriesAndLabels)}, []interface{}{testError}},
 			}),
 			http.StatusBadRequest,
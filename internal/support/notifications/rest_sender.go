@@ -0,0 +1,210 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// SenderTokenHeader carries the internal token minted for an allowed sender
+const SenderTokenHeader = "X-Sender-Token"
+
+// allowedSenderRequest is the body of a request adding a sender to the allow-list
+type allowedSenderRequest struct {
+	Sender string `json:"sender"`
+	// MintToken mints an internal token the sender presents when it has no JWT, replacing its previous token
+	MintToken bool `json:"mintToken"`
+}
+
+// allowedSenderResponse describes a sender of the allow-list. The token is only returned when it is minted.
+type allowedSenderResponse struct {
+	Sender   string `json:"sender"`
+	HasToken bool   `json:"hasToken"`
+	Token    string `json:"token,omitempty"`
+	Created  int64  `json:"created"`
+}
+
+// verifySender checks the caller posting the notification is verified as an allowed sender posting on its own behalf,
+// identified by its internal token or by the subject of its JWT forwarded by the API gateway in the trusted headers.
+// The bearer token of the request isn't trusted, since the service may be reached without going through the gateway.
+// The sender of a notification without sender is set to the verified sender.
+func verifySender(
+	r *http.Request,
+	n *contract.Notification,
+	dbClient interfaces.DBClient,
+	verification notificationsConfig.SenderVerificationInfo) error {
+
+	if !verification.Enabled {
+		return nil
+	}
+
+	var allowed models.AllowedSender
+	var err error
+	if token := r.Header.Get(SenderTokenHeader); token != "" {
+		allowed, err = dbClient.GetAllowedSenderByToken(senderTokenHash(token))
+		if err == db.ErrNotFound {
+			return errors.NewErrSenderUnverified("unknown sender token")
+		}
+	} else {
+		c, ok := claims.FromContext(r.Context())
+		if !ok || c.Subject == "" {
+			return errors.NewErrSenderUnverified("missing trusted headers or " + SenderTokenHeader + " header")
+		}
+		allowed, err = dbClient.GetAllowedSender(c.Subject)
+		if err == db.ErrNotFound {
+			return errors.NewErrSenderForbidden(c.Subject)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if n.Sender == "" {
+		n.Sender = allowed.Sender
+	} else if n.Sender != allowed.Sender {
+		return errors.NewErrSenderForbidden(n.Sender)
+	}
+	return nil
+}
+
+// senderTokenHash returns the hash internal sender tokens are stored and looked up by
+func senderTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAllowedSenderResponse(s models.AllowedSender) allowedSenderResponse {
+	return allowedSenderResponse{Sender: s.Sender, HasToken: s.TokenHash != "", Created: s.Created}
+}
+
+func restGetAllowedSenders(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	senders, err := dbClient.GetAllowedSenders()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	responses := make([]allowedSenderResponse, len(senders))
+	for i, s := range senders {
+		responses[i] = toAllowedSenderResponse(s)
+	}
+	pkg.Encode(responses, w, lc)
+}
+
+func restAddAllowedSender(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var request allowedSenderRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding allowed sender: " + err.Error())
+		return
+	}
+	if request.Sender == "" {
+		http.Error(w, "sender is required", http.StatusBadRequest)
+		lc.Error("Error adding allowed sender: sender is required")
+		return
+	}
+
+	allowed := models.AllowedSender{Sender: request.Sender, Created: db.MakeTimestamp()}
+	var token string
+	if request.MintToken {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+		token = base64.RawURLEncoding.EncodeToString(secret)
+		allowed.TokenHash = senderTokenHash(token)
+	}
+
+	if err := dbClient.AddAllowedSender(allowed); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Allowed sender " + allowed.Sender + " to post notifications")
+	response := toAllowedSenderResponse(allowed)
+	response.Token = token
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func restDeleteAllowedSender(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	sender := mux.Vars(r)[SENDER]
+	if err := dbClient.DeleteAllowedSender(sender); err != nil {
+		lc.Error(err.Error())
+		if err == db.ErrNotFound {
+			http.Error(w, "Allowed sender '"+sender+"' not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	lc.Info("Removed sender " + sender + " from the allow-list")
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
@@ -0,0 +1,143 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testSenderToken = "sender-token"
+
+// senderRequest returns a request of the caller with the subject forwarded in the trusted headers, or with the
+// subject in its unverified JWT only
+func senderRequest(t *testing.T, subject string, bearerSubject string, token string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, TestURI, nil)
+	if subject != "" {
+		req = req.WithContext(claims.NewContext(req.Context(), claims.Claims{Subject: subject}))
+	}
+	if bearerSubject != "" {
+		bearer, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": bearerSubject}).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	if token != "" {
+		req.Header.Set(SenderTokenHeader, token)
+	}
+	return req
+}
+
+func TestVerifySender(t *testing.T) {
+	verification := notificationsConfig.SenderVerificationInfo{Enabled: true}
+	allowed := models.AllowedSender{Sender: TestSender, TokenHash: senderTokenHash(testSenderToken)}
+
+	tests := []struct {
+		name           string
+		verification   notificationsConfig.SenderVerificationInfo
+		subject        string
+		bearerSubject  string
+		token          string
+		sender         string
+		expectedSender string
+		expectedErr    bool
+	}{
+		{"Disabled", notificationsConfig.SenderVerificationInfo{}, "", "", "", "anyone", "anyone", false},
+		{"Allowed subject", verification, TestSender, "", "", TestSender, TestSender, false},
+		{"Allowed subject without sender", verification, TestSender, "", "", "", TestSender, false},
+		{"Allowed token", verification, "", "", testSenderToken, TestSender, TestSender, false},
+		{"Subject on behalf of another sender", verification, TestSender, "", "", "other", "", true},
+		{"Subject not allowed", verification, "other", "", "", "other", "", true},
+		{"Unknown token", verification, "", "", "other-token", TestSender, "", true},
+		{"Unverified JWT of an allowed subject", verification, "", TestSender, "", TestSender, "", true},
+		{"Unidentified", verification, "", "", "", TestSender, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("GetAllowedSender", TestSender).Return(allowed, nil)
+			dbClientMock.On("GetAllowedSender", "other").Return(models.AllowedSender{}, db.ErrNotFound)
+			dbClientMock.On("GetAllowedSenderByToken", allowed.TokenHash).Return(allowed, nil)
+			dbClientMock.On("GetAllowedSenderByToken", mock.Anything).Return(models.AllowedSender{}, db.ErrNotFound)
+
+			n := contract.Notification{Sender: tt.sender}
+			err := verifySender(senderRequest(t, tt.subject, tt.bearerSubject, tt.token), &n, dbClientMock, tt.verification)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSender, n.Sender)
+		})
+	}
+}
+
+func TestAddAllowedSender(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedToken  bool
+	}{
+		{"OK", `{"sender":"device-virtual"}`, http.StatusCreated, false},
+		{"OK with token", `{"sender":"device-virtual","mintToken":true}`, http.StatusCreated, true},
+		{"Missing sender", `{"mintToken":true}`, http.StatusBadRequest, false},
+		{"Malformed body", `{`, http.StatusBadRequest, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClientMock := &mocks.DBClient{}
+			dbClientMock.On("AddAllowedSender", mock.Anything).Return(nil)
+
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/"+ALLOWED, strings.NewReader(tt.body))
+			restAddAllowedSender(rr, req, logger.NewMockClient(), dbClientMock)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+			var response allowedSenderResponse
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+			assert.Equal(t, "device-virtual", response.Sender)
+			assert.Equal(t, tt.expectedToken, response.HasToken)
+			assert.Equal(t, tt.expectedToken, response.Token != "")
+
+			// only the hash of the token is stored
+			stored := dbClientMock.Calls[0].Arguments.Get(0).(models.AllowedSender)
+			if tt.expectedToken {
+				assert.Equal(t, senderTokenHash(response.Token), stored.TokenHash)
+			} else {
+				assert.Empty(t, stored.TokenHash)
+			}
+		})
+	}
+}
@@ -18,12 +18,16 @@ package notifications
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/operators/subscription"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients"
@@ -80,6 +84,13 @@ func restAddSubscription(
 		return
 	}
 
+	err = validateSubscribedCategories(s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
 	lc.Info("Posting Subscription: " + s.String())
 	op := subscription.NewAddExecutor(dbClient, s)
 	err = op.Execute()
@@ -118,6 +129,13 @@ func restUpdateSubscription(
 		return
 	}
 
+	err = validateSubscribedCategories(s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
 	// Check if the subscription exists
 	s2, err := dbClient.GetSubscriptionBySlug(s.Slug)
 	if err != nil {
@@ -395,3 +413,384 @@ func subscriptionsByReceiverHandler(
 	pkg.Encode(s, w, lc)
 
 }
+
+// restTestSubscriptionBySlug sends a synthetic notification through every channel of the subscription and reports the
+// delivery result of each channel. Nothing is persisted, so the test doesn't show up in the transmission history.
+func restTestSubscriptionBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	vars := mux.Vars(r)
+	slug := vars["slug"]
+
+	op := subscription.NewSlugExecutor(dbClient, slug)
+	s, err := op.Execute()
+	if err != nil {
+		switch err.(type) {
+		case errors.ErrSubscriptionNotFound:
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	lc.Info("Testing subscription: " + slug)
+	n := models.Notification{
+		Slug:        "test-" + slug,
+		Sender:      clients.SupportNotificationsServiceKey,
+		Category:    models.Swhealth,
+		Severity:    models.Normal,
+		Content:     "This is a test notification for subscription " + slug + ".",
+		ContentType: clients.ContentTypeText,
+	}
+
+	timeout := time.Duration(config.Service.Timeout) * time.Millisecond
+	results := make([]channelTestResult, len(s.Channels))
+	for i, c := range s.Channels {
+		results[i] = testViaChannel(n, c, lc, config.Smtp, timeout)
+	}
+
+	pkg.Encode(results, w, lc)
+}
+
+// subscriptionCallback is the status callback of a subscription, the URL transmissions to the subscription post their
+// final status to
+type subscriptionCallback struct {
+	Url string `json:"url"`
+}
+
+func restSetSubscriptionCallbackBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var callback subscriptionCallback
+	err := json.NewDecoder(r.Body).Decode(&callback)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding subscription callback: " + err.Error())
+		return
+	}
+	if u, err := url.Parse(callback.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		const errorMessage = "subscription callback url must be an absolute http or https URL"
+		http.Error(w, errorMessage, http.StatusBadRequest)
+		lc.Error(errorMessage)
+		return
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Setting status callback of subscription: " + s.Slug + " to: " + callback.Url)
+	if err = dbClient.SetSubscriptionCallback(s.ID, callback.Url); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restGetSubscriptionCallbackBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	callbackUrl, err := dbClient.GetSubscriptionCallback(s.ID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "subscription "+s.Slug+" has no status callback", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	pkg.Encode(subscriptionCallback{Url: callbackUrl}, w, lc)
+}
+
+func restDeleteSubscriptionCallbackBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Deleting status callback of subscription: " + s.Slug)
+	if err := dbClient.DeleteSubscriptionCallback(s.ID); err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "subscription "+s.Slug+" has no status callback", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restSetSubscriptionRetryPolicyBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var policy notificationsModels.RetryPolicy
+	err := json.NewDecoder(r.Body).Decode(&policy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding subscription retry policy: " + err.Error())
+		return
+	}
+	if err = policy.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Setting retry policy of subscription: " + s.Slug)
+	if err = dbClient.SetSubscriptionRetryPolicy(s.ID, policy); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restGetSubscriptionRetryPolicyBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	policy, err := dbClient.GetSubscriptionRetryPolicy(s.ID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "subscription "+s.Slug+" has no retry policy", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	pkg.Encode(policy, w, lc)
+}
+
+func restDeleteSubscriptionRetryPolicyBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Deleting retry policy of subscription: " + s.Slug)
+	if err := dbClient.DeleteSubscriptionRetryPolicy(s.ID); err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "subscription "+s.Slug+" has no retry policy", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// subscriptionLocaleRequest is the body of the requests setting the locale of a subscription
+type subscriptionLocaleRequest struct {
+	Locale string `json:"locale"`
+}
+
+func restSetSubscriptionLocaleBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var req subscriptionLocaleRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding subscription locale: " + err.Error())
+		return
+	}
+	if !validLocale(req.Locale) {
+		http.Error(w, "invalid locale: "+req.Locale, http.StatusBadRequest)
+		lc.Error("invalid locale: " + req.Locale)
+		return
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Setting locale of subscription: " + s.Slug)
+	if err = dbClient.SetSubscriptionLocale(s.ID, req.Locale); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+func restGetSubscriptionLocaleBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	locale, err := dbClient.GetSubscriptionLocale(s.ID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "subscription "+s.Slug+" has no locale", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+	pkg.Encode(subscriptionLocaleRequest{Locale: locale}, w, lc)
+}
+
+func restDeleteSubscriptionLocaleBySlug(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	s, ok := subscriptionFromRequest(w, r, lc, dbClient)
+	if !ok {
+		return
+	}
+
+	lc.Info("Deleting locale of subscription: " + s.Slug)
+	if err := dbClient.DeleteSubscriptionLocale(s.ID); err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, "subscription "+s.Slug+" has no locale", http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+// subscriptionFromRequest returns the subscription named by the slug of the request, or writes the error response
+// if it can't be loaded.
+func subscriptionFromRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) (models.Subscription, bool) {
+
+	vars := mux.Vars(r)
+	op := subscription.NewSlugExecutor(dbClient, vars["slug"])
+	s, err := op.Execute()
+	if err != nil {
+		switch err.(type) {
+		case errors.ErrSubscriptionNotFound:
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return s, false
+	}
+	return s, true
+}
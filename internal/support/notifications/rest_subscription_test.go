@@ -25,8 +25,10 @@ import (
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -565,3 +567,166 @@ func createMockSubscriptionLoaderUpdateGetErr() interfaces.DBClient {
 	myMock.On("UpdateSubscription", subscriptionForAdd).Return(errors.New("test error"))
 	return &myMock
 }
+
+func TestTestSubscriptionBySlug(t *testing.T) {
+	okServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer okServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+	unresponsive := make(chan struct{})
+	unresponsiveServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unresponsive
+	}))
+	defer unresponsiveServer.Close()
+	defer close(unresponsive)
+
+	s := contract.Subscription{
+		Slug: TestSlug,
+		Channels: []contract.Channel{
+			{Type: contract.ChannelType(contract.Rest), Url: okServer.URL},
+			{Type: contract.ChannelType(contract.Rest), Url: failingServer.URL},
+			{Type: contract.ChannelType(contract.Rest), Url: unresponsiveServer.URL},
+		},
+	}
+	found := &mocks.DBClient{}
+	found.On("GetSubscriptionBySlug", TestSlug).Return(s, nil)
+
+	tests := []struct {
+		name             string
+		dbMock           interfaces.DBClient
+		expectedStatus   int
+		expectedStatuses []contract.TransmissionStatus
+	}{
+		{"OK", found, http.StatusOK, []contract.TransmissionStatus{contract.Sent, contract.Failed, contract.Failed}},
+		{"Subscription not found", createMockSubscriptionLoader("GetSubscriptionBySlug", TestSlug, db.ErrNotFound), http.StatusNotFound, nil},
+		{"Other error from database", createMockSubscriptionLoader("GetSubscriptionBySlug", TestSlug, errors.New("Test error")), http.StatusInternalServerError, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPost, TestSubscriptionURI, nil), map[string]string{SLUG: TestSlug})
+			rr := httptest.NewRecorder()
+			configuration := config.ConfigurationStruct{}
+			configuration.Service.Timeout = 100
+			restTestSubscriptionBySlug(rr, req, logger.NewMockClient(), tt.dbMock, configuration)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Fatalf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+			if tt.expectedStatuses == nil {
+				return
+			}
+
+			var results []channelTestResult
+			if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+				t.Fatalf("unable to decode response: %s", err.Error())
+			}
+			if len(results) != len(tt.expectedStatuses) {
+				t.Fatalf("result count mismatch -- expected %v got %v", len(tt.expectedStatuses), len(results))
+			}
+			for i, expected := range tt.expectedStatuses {
+				if results[i].Status != expected {
+					t.Errorf("channel %d status mismatch -- expected %v got %v", i, expected, results[i].Status)
+				}
+			}
+		})
+	}
+}
+
+func TestSetSubscriptionCallbackBySlug(t *testing.T) {
+	s := contract.Subscription{ID: TestId, Slug: TestSlug}
+	found := &mocks.DBClient{}
+	found.On("GetSubscriptionBySlug", TestSlug).Return(s, nil)
+	found.On("SetSubscriptionCallback", TestId, "http://incidents.example/status").Return(nil)
+
+	tests := []struct {
+		name           string
+		body           string
+		dbMock         interfaces.DBClient
+		expectedStatus int
+	}{
+		{"OK", `{"url":"http://incidents.example/status"}`, found, http.StatusOK},
+		{"Relative URL", `{"url":"/status"}`, found, http.StatusBadRequest},
+		{"Unsupported scheme", `{"url":"ftp://incidents.example/status"}`, found, http.StatusBadRequest},
+		{"Malformed body", `{"url":`, found, http.StatusBadRequest},
+		{"Subscription not found", `{"url":"http://incidents.example/status"}`, createMockSubscriptionLoader("GetSubscriptionBySlug", TestSlug, db.ErrNotFound), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, TestSubscriptionURI, strings.NewReader(tt.body)), map[string]string{SLUG: TestSlug})
+			rr := httptest.NewRecorder()
+			restSetSubscriptionCallbackBySlug(rr, req, logger.NewMockClient(), tt.dbMock)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Fatalf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+		})
+	}
+}
+
+func TestSetSubscriptionRetryPolicyBySlug(t *testing.T) {
+	s := contract.Subscription{ID: TestId, Slug: TestSlug}
+	policy := notificationsModels.RetryPolicy{MaxAttempts: 5, InitialInterval: "1m", MaxInterval: "30m", Multiplier: 3, Jitter: 0.1}
+	found := &mocks.DBClient{}
+	found.On("GetSubscriptionBySlug", TestSlug).Return(s, nil)
+	found.On("SetSubscriptionRetryPolicy", TestId, policy).Return(nil)
+
+	tests := []struct {
+		name           string
+		body           string
+		dbMock         interfaces.DBClient
+		expectedStatus int
+	}{
+		{"OK", `{"maxAttempts":5,"initialInterval":"1m","maxInterval":"30m","multiplier":3,"jitter":0.1}`, found, http.StatusOK},
+		{"No attempts", `{"maxAttempts":0,"initialInterval":"1m"}`, found, http.StatusBadRequest},
+		{"Invalid interval", `{"maxAttempts":5,"initialInterval":"soon"}`, found, http.StatusBadRequest},
+		{"Jitter out of range", `{"maxAttempts":5,"initialInterval":"1m","jitter":1.5}`, found, http.StatusBadRequest},
+		{"Malformed body", `{"maxAttempts":`, found, http.StatusBadRequest},
+		{"Subscription not found", `{"maxAttempts":5,"initialInterval":"1m"}`, createMockSubscriptionLoader("GetSubscriptionBySlug", TestSlug, db.ErrNotFound), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, TestSubscriptionURI, strings.NewReader(tt.body)), map[string]string{SLUG: TestSlug})
+			rr := httptest.NewRecorder()
+			restSetSubscriptionRetryPolicyBySlug(rr, req, logger.NewMockClient(), tt.dbMock)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Fatalf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+		})
+	}
+}
+
+func TestSetSubscriptionLocaleBySlug(t *testing.T) {
+	s := contract.Subscription{ID: TestId, Slug: TestSlug}
+	found := &mocks.DBClient{}
+	found.On("GetSubscriptionBySlug", TestSlug).Return(s, nil)
+	found.On("SetSubscriptionLocale", TestId, "fr-CA").Return(nil)
+
+	tests := []struct {
+		name           string
+		body           string
+		dbMock         interfaces.DBClient
+		expectedStatus int
+	}{
+		{"OK", `{"locale":"fr-CA"}`, found, http.StatusOK},
+		{"Empty locale", `{"locale":""}`, found, http.StatusBadRequest},
+		{"Invalid locale", `{"locale":"fr CA"}`, found, http.StatusBadRequest},
+		{"Malformed body", `{"locale":`, found, http.StatusBadRequest},
+		{"Subscription not found", `{"locale":"fr-CA"}`, createMockSubscriptionLoader("GetSubscriptionBySlug", TestSlug, db.ErrNotFound), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodPut, TestSubscriptionURI, strings.NewReader(tt.body)), map[string]string{SLUG: TestSlug})
+			rr := httptest.NewRecorder()
+			restSetSubscriptionLocaleBySlug(rr, req, logger.NewMockClient(), tt.dbMock)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Fatalf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// scheduleResend schedules the resend of a failed transmission by its retry policy and returns false when the
+// transmission has no resend attempts left.
+func scheduleResend(
+	t models.Transmission,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) bool {
+
+	policy, ok := retryPolicy(t, lc, dbClient, config)
+	if !ok || t.ResendCount+1 >= policy.MaxAttempts {
+		return false
+	}
+	wait, err := policy.Backoff(t.ResendCount, rand.Float64())
+	if err != nil {
+		lc.Error("Unable to schedule resend of transmission: " + t.ID + ", issue: " + err.Error())
+		return false
+	}
+
+	lc.Debug("Resending transmission: " + t.ID + " in " + wait.String())
+	time.AfterFunc(wait, func() {
+		criticalSeverityResend(t, lc, dbClient, config)
+	})
+	return true
+}
+
+// retryPolicy returns the retry policy of the subscription the transmission was sent to if it has one, otherwise the
+// retry policy configured for the transmission's channel type.
+func retryPolicy(
+	t models.Transmission,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) (notificationsModels.RetryPolicy, bool) {
+
+	subs, err := dbClient.GetSubscriptionByReceiver(t.Receiver)
+	if err != nil {
+		lc.Error("Unable to get subscriptions to look up the retry policy of transmission: " + t.ID)
+	}
+	for _, s := range subs {
+		if !hasChannel(s, t.Channel) {
+			continue
+		}
+		policy, err := dbClient.GetSubscriptionRetryPolicy(s.ID)
+		if err == nil {
+			return policy, true
+		}
+		if err != db.ErrNotFound {
+			lc.Error("Unable to get retry policy of subscription: " + s.Slug + ", issue: " + err.Error())
+		}
+	}
+
+	policy, ok := config.Writable.RetryPolicies[string(t.Channel.Type)]
+	return policy, ok
+}
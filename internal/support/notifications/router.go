@@ -19,9 +19,14 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
-	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/usage"
 	notificationsContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -52,12 +57,38 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	r.HandleFunc(
 		clients.ApiMetricsRoute,
 		func(w http.ResponseWriter, _ *http.Request) {
-			pkg.Encode(telemetry.NewSystemUsage(), w, bootstrapContainer.LoggingClientFrom(dic.Get))
+			restGetMetrics(w, bootstrapContainer.LoggingClientFrom(dic.Get), *notificationsContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		clients.ApiMetricsRoute+"/"+DELIVERY,
+		func(w http.ResponseWriter, _ *http.Request) {
+			restGetDeliveryMetrics(w, bootstrapContainer.LoggingClientFrom(dic.Get), *notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Usage
+	r.HandleFunc(
+		usage.ApiUsageRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			usage.DefaultRecorder.ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Feature flags
+	r.HandleFunc(
+		featureflag.ApiFeatureFlagsRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.FeatureFlagsFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Configuration drift
+	r.HandleFunc(
+		configdrift.ApiConfigDriftRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.ConfigDriftDetectorFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	b := r.PathPrefix(clients.ApiBase).Subrouter()
 
 	// Notifications
@@ -89,6 +120,15 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
+	b.HandleFunc(
+		"/"+NOTIFICATION+"/"+SLUG+"/{"+SLUG+"}/"+OCCURRENCES,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetNotificationOccurrences(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/"+NOTIFICATION+"/"+SLUG+"/{"+SLUG+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -177,6 +217,35 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				*notificationsContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodGet)
 
+	// Allowed senders
+	b.HandleFunc(
+		"/"+ALLOWED,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetAllowedSenders(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+ALLOWED,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddAllowedSender(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+ALLOWED+"/{"+SENDER+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteAllowedSender(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+
 	// GetSubscriptions
 	b.HandleFunc(
 		"/"+SUBSCRIPTION,
@@ -223,6 +292,60 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restSetSubscriptionRetryPolicyBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSubscriptionRetryPolicyBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+RETRY,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteSubscriptionRetryPolicyBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+LOCALE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restSetSubscriptionLocaleBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+LOCALE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSubscriptionLocaleBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+LOCALE,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteSubscriptionLocaleBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -232,6 +355,43 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				bootstrapContainer.LoggingClientFrom(dic.Get),
 				container.DBClientFrom(dic.Get))
 		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+TEST,
+		func(w http.ResponseWriter, r *http.Request) {
+			restTestSubscriptionBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				*notificationsContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+CALLBACK,
+		func(w http.ResponseWriter, r *http.Request) {
+			restSetSubscriptionCallbackBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodPut)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+CALLBACK,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetSubscriptionCallbackBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}/"+CALLBACK,
+		func(w http.ResponseWriter, r *http.Request) {
+			restDeleteSubscriptionCallbackBySlug(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodDelete)
 	b.HandleFunc(
 		"/"+SUBSCRIPTION+"/"+SLUG+"/{"+SLUG+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -408,7 +568,25 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 
+	v2 := r.PathPrefix("/api/v2").Subrouter()
+
+	v2.HandleFunc(
+		"/"+NOTIFICATION+"/"+ID+"/{"+ID+"}/"+DISTRIBUTION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetNotificationDistribution(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(usage.Middleware)
+	r.Use(bodylimit.NewMiddleware(notificationsContainer.ConfigurationFrom(dic.Get).RequestLimits, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(claims.NewMiddleware(notificationsContainer.ConfigurationFrom(dic.Get).TrustedHeaders, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(compression.NewMiddleware(func() compression.ResponsesInfo {
+		return notificationsContainer.ConfigurationFrom(dic.Get).Writable.Compression
+	}))
 }
@@ -0,0 +1,144 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"fmt"
+	"sort"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// applyRoutingRules evaluates the routing rules in order of their names and returns the notification with the
+// actions of the matching rules applied, the slugs of the subscriptions it is routed to in addition to the matching
+// ones and whether it was suppressed.
+func applyRoutingRules(
+	n models.Notification,
+	rules map[string]notificationsConfig.RoutingRuleInfo,
+	lc logger.LoggingClient) (routed models.Notification, subscriptions []string, suppressed bool) {
+
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// copy the labels so that added labels don't modify the caller's notification
+	n.Labels = append([]string(nil), n.Labels...)
+	for _, name := range names {
+		rule := rules[name]
+		if !ruleMatches(n, rule) {
+			continue
+		}
+
+		lc.Debug(fmt.Sprintf("routing rule %s matches notification %s", name, n.Slug))
+		if rule.Suppress {
+			return n, nil, true
+		}
+		switch models.NotificationsSeverity(rule.Severity) {
+		case "":
+		case models.Critical, models.Normal:
+			n.Severity = models.NotificationsSeverity(rule.Severity)
+		default:
+			lc.Error(fmt.Sprintf("routing rule %s has invalid severity '%s', expecting %s or %s", name, rule.Severity, models.Critical, models.Normal))
+		}
+		for _, label := range rule.AddLabels {
+			if !contains(n.Labels, label) {
+				n.Labels = append(n.Labels, label)
+			}
+		}
+		subscriptions = append(subscriptions, rule.Subscriptions...)
+	}
+
+	return n, subscriptions, false
+}
+
+// ruleMatches reports whether the notification satisfies every non-empty match list of the rule.
+func ruleMatches(n models.Notification, rule notificationsConfig.RoutingRuleInfo) bool {
+	if len(rule.Severities) > 0 && !contains(rule.Severities, string(n.Severity)) {
+		return false
+	}
+	if len(rule.Categories) > 0 && !matchesCategory(rule.Categories, n) {
+		return false
+	}
+	if len(rule.Senders) > 0 && !contains(rule.Senders, n.Sender) {
+		return false
+	}
+	if len(rule.Labels) > 0 {
+		for _, label := range n.Labels {
+			if contains(rule.Labels, label) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// matchesCategory reports whether one of the categories of the notification satisfies one of the patterns.
+func matchesCategory(patterns []string, n models.Notification) bool {
+	for _, category := range notificationCategories(n) {
+		for _, pattern := range patterns {
+			if categoryMatches(pattern, category) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// appendRoutedSubscriptions adds the subscriptions with the given slugs which aren't in subs yet. A routed
+// subscription which can't be found is logged and skipped.
+func appendRoutedSubscriptions(
+	subs []models.Subscription,
+	slugs []string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) []models.Subscription {
+
+	for _, slug := range slugs {
+		exists := false
+		for _, sub := range subs {
+			if sub.Slug == slug {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			continue
+		}
+
+		sub, err := dbClient.GetSubscriptionBySlug(slug)
+		if err != nil {
+			lc.Error(fmt.Sprintf("unable to get subscription %s the notification is routed to: %s", slug, err.Error()))
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
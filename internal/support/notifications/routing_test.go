@@ -0,0 +1,114 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"errors"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRoutingRules(t *testing.T) {
+	n := models.Notification{
+		Slug:     "door-open",
+		Sender:   "device-door",
+		Category: models.Security,
+		Severity: models.Normal,
+		Labels:   []string{"door"},
+	}
+
+	tests := []struct {
+		name                  string
+		rules                 map[string]notificationsConfig.RoutingRuleInfo
+		expectedSeverity      models.NotificationsSeverity
+		expectedLabels        []string
+		expectedSubscriptions []string
+		expectedSuppressed    bool
+	}{
+		{"No rules", nil, models.Normal, []string{"door"}, nil, false},
+		{
+			"Escalate security and route",
+			map[string]notificationsConfig.RoutingRuleInfo{
+				"10-security": {Categories: []string{"SECURITY"}, Severity: "CRITICAL", AddLabels: []string{"security-team", "door"}, Subscriptions: []string{"oncall"}},
+			},
+			models.Critical, []string{"door", "security-team"}, []string{"oncall"}, false,
+		},
+		{
+			"Later rule matches changes of earlier rule",
+			map[string]notificationsConfig.RoutingRuleInfo{
+				"20-critical": {Severities: []string{"CRITICAL"}, Subscriptions: []string{"pager"}},
+				"10-security": {Categories: []string{"SECURITY"}, Severity: "CRITICAL"},
+			},
+			models.Critical, []string{"door"}, []string{"pager"}, false,
+		},
+		{
+			"Not all criteria match",
+			map[string]notificationsConfig.RoutingRuleInfo{
+				"10-sender": {Categories: []string{"SECURITY"}, Senders: []string{"device-camera"}, Suppress: true},
+				"20-labels": {Labels: []string{"window"}, Suppress: true},
+			},
+			models.Normal, []string{"door"}, nil, false,
+		},
+		{
+			"Suppress",
+			map[string]notificationsConfig.RoutingRuleInfo{
+				"10-route":    {Subscriptions: []string{"oncall"}},
+				"20-suppress": {Senders: []string{"device-door"}, Labels: []string{"window", "door"}, Suppress: true},
+				"30-route":    {Subscriptions: []string{"pager"}},
+			},
+			models.Normal, []string{"door"}, nil, true,
+		},
+		{
+			"Invalid severity is ignored",
+			map[string]notificationsConfig.RoutingRuleInfo{
+				"10-invalid": {Severity: "MINOR", Subscriptions: []string{"oncall"}},
+			},
+			models.Normal, []string{"door"}, []string{"oncall"}, false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routed, subscriptions, suppressed := applyRoutingRules(n, tt.rules, logger.NewMockClient())
+			assert.Equal(t, tt.expectedSuppressed, suppressed)
+			if suppressed {
+				return
+			}
+			assert.Equal(t, tt.expectedSeverity, routed.Severity)
+			assert.Equal(t, tt.expectedLabels, routed.Labels)
+			assert.Equal(t, tt.expectedSubscriptions, subscriptions)
+			assert.Equal(t, []string{"door"}, n.Labels, "the original notification must not be modified")
+		})
+	}
+}
+
+func TestAppendRoutedSubscriptions(t *testing.T) {
+	matched := []models.Subscription{{Slug: "ops"}}
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("GetSubscriptionBySlug", "oncall").Return(models.Subscription{Slug: "oncall"}, nil)
+	dbClientMock.On("GetSubscriptionBySlug", "missing").Return(models.Subscription{}, errors.New("not found"))
+
+	subs := appendRoutedSubscriptions(matched, []string{"ops", "oncall", "missing"}, logger.NewMockClient(), dbClientMock)
+
+	assert.Equal(t, []models.Subscription{{Slug: "ops"}, {Slug: "oncall"}}, subs)
+	dbClientMock.AssertNotCalled(t, "GetSubscriptionBySlug", "ops")
+}
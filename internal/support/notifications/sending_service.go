@@ -17,12 +17,16 @@ package notifications
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net"
 	"net/http"
 	mail "net/smtp"
+	"net/textproto"
 	"strconv"
 	"strings"
 	"time"
@@ -30,30 +34,42 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
+// sendViaChannel sends the notification through the channel and persists the transmission, returning the attempt
+// for the distribution record of the notification
 func sendViaChannel(
 	n models.Notification,
 	c models.Channel,
 	receiver string,
+	locale string,
 	lc logger.LoggingClient,
 	dbClient interfaces.DBClient,
-	config notificationsConfig.ConfigurationStruct) {
+	config notificationsConfig.ConfigurationStruct) notificationsModels.DistributionAttempt {
 
 	lc.Debug("Sending notification: " + n.Slug + ", via channel: " + c.String())
 	var tr models.TransmissionRecord
+	begin := time.Now()
+	attempt := notificationsModels.DistributionAttempt{Channel: string(c.Type), Target: c.Url, Started: db.MakeTimestamp()}
 	if c.Type == models.ChannelType(models.Email) {
-		tr = sendMail(n.Content, c.MailAddresses, n.ContentType, lc, config.Smtp)
+		attempt.Target = strings.Join(c.MailAddresses, ",")
+		tr = mailNotification(n, c.MailAddresses, locale, lc, dbClient, config)
 	} else {
 		tr = restSend(n.Content, c.Url, n.ContentType, lc)
 	}
+	deliveryMetrics.record(string(c.Type), tr.Status, false, time.Since(begin))
+	attempt.Duration = time.Since(begin).Milliseconds()
+	attempt.Status, attempt.Response = string(tr.Status), tr.Response
 	t, err := persistTransmission(tr, n, c, receiver, lc, dbClient)
 	if err == nil {
+		attempt.TransmissionId = t.ID
 		handleFailedTransmission(t, lc, dbClient, config)
 	}
+	return attempt
 }
 
 func resendViaChannel(
@@ -63,11 +79,13 @@ func resendViaChannel(
 	config notificationsConfig.ConfigurationStruct) {
 
 	var tr models.TransmissionRecord
+	begin := time.Now()
 	if t.Channel.Type == models.ChannelType(models.Email) {
-		tr = sendMail(t.Notification.Content, t.Channel.MailAddresses, t.Notification.ContentType, lc, config.Smtp)
+		tr = mailNotification(t.Notification, t.Channel.MailAddresses, transmissionLocale(t, lc, dbClient), lc, dbClient, config)
 	} else {
 		tr = restSend(t.Notification.Content, t.Channel.Url, t.Notification.ContentType, lc)
 	}
+	deliveryMetrics.record(string(t.Channel.Type), tr.Status, true, time.Since(begin))
 	t.ResendCount = t.ResendCount + 1
 	t.Status = tr.Status
 	t.Records = append(t.Records, tr)
@@ -110,8 +128,29 @@ func persistTransmission(
 	return trx, nil
 }
 
+// mailNotification renders the notification in the locale and emails it with its attachments to the addressees
+func mailNotification(
+	n models.Notification,
+	addressees []string,
+	locale string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	config notificationsConfig.ConfigurationStruct) models.TransmissionRecord {
+
+	attachments, err := loadAttachments(n, dbClient, config)
+	if err != nil {
+		lc.Error("Problems sending message to: " + strings.Join(addressees, ",") + ", issue: " + err.Error())
+		return getTransmissionRecord(err.Error(), models.Failed)
+	}
+	smtp := config.Smtp
+	subject, content := renderMail(n, locale, lc, smtp)
+	smtp.Subject = subject
+	return sendMail(content, attachments, addressees, n.ContentType, lc, smtp)
+}
+
 func sendMail(
 	message string,
+	attachments []notificationsModels.NotificationAttachment,
 	addressees []string,
 	contentType string,
 	lc logger.LoggingClient,
@@ -120,6 +159,15 @@ func sendMail(
 	tr := getTransmissionRecord("SMTP server received", models.Sent)
 
 	smtpMessage := buildSmtpMessage(smtp.Sender, smtp.Subject, addressees, contentType, message)
+	if len(attachments) > 0 {
+		var err error
+		if smtpMessage, err = buildSmtpMessageWithAttachments(smtp.Sender, smtp.Subject, addressees, contentType, message, attachments); err != nil {
+			lc.Error("Problems building message to: " + strings.Join(addressees, ",") + ", issue: " + err.Error())
+			tr.Status = models.Failed
+			tr.Response = err.Error()
+			return tr
+		}
+	}
 
 	err := smtpSend(addressees, smtpMessage, smtp)
 	if err != nil {
@@ -149,19 +197,65 @@ func buildSmtpMessage(sender string, subject string, toAddresses []string, conte
 
 	buf.WriteString(smtpNewline)
 
+	writeSmtpLines(buf, message)
+
+	return []byte(buf.String())
+}
+
+// buildSmtpMessageWithAttachments builds a multipart/mixed message of the message followed by the attachments
+func buildSmtpMessageWithAttachments(
+	sender string,
+	subject string,
+	toAddresses []string,
+	contentType string,
+	message string,
+	attachments []notificationsModels.NotificationAttachment) ([]byte, error) {
+
+	smtpNewline := "\r\n"
+
+	buf := bytes.NewBufferString("Subject: " + subject + smtpNewline)
+	buf.WriteString("From: " + sender + smtpNewline)
+	buf.WriteString("To: " + strings.Join(toAddresses, ",") + smtpNewline)
+
+	mw := multipart.NewWriter(buf)
+	buf.WriteString(fmt.Sprintf("MIME-Version: 1.0\r\nContent-Type: multipart/mixed; boundary=\"%s\"\r\n", mw.Boundary()))
+	buf.WriteString(smtpNewline)
+
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", fmt.Sprintf("%s; charset=\"UTF-8\"", contentType))
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	writeSmtpLines(part, message)
+
+	if err = writeAttachments(mw, attachments); err != nil {
+		return nil, err
+	}
+	if err = mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeSmtpLines writes the message in lines of at most 1000 characters, CRLF included
+func writeSmtpLines(w io.Writer, message string) {
+	smtpNewline := "\r\n"
+
 	//maximum line size is 1000
 	//split on newline first then break further as needed
 	for _, line := range strings.Split(message, smtpNewline) {
 		ln := 998
 		idx := 0
 		for len(line) > idx+ln {
-			buf.WriteString(line[idx:idx+ln] + smtpNewline)
+			_, _ = io.WriteString(w, line[idx:idx+ln]+smtpNewline)
 			idx += ln
 		}
-		buf.WriteString(line[idx:] + smtpNewline)
+		_, _ = io.WriteString(w, line[idx:]+smtpNewline)
 	}
-
-	return []byte(buf.String())
 }
 
 func restSend(message string, url string, contentType string, lc logger.LoggingClient) models.TransmissionRecord {
@@ -171,6 +265,18 @@ func restSend(message string, url string, contentType string, lc logger.LoggingC
 		contentType = "text/plain"
 	}
 
+	if c := restChannels.channelOf(url); c != nil {
+		status, err := restChannels.send(context.Background(), c, message, url, contentType)
+		if err != nil {
+			lc.Error("Problems sending message to: " + url + " through REST channel " + c.name + ", issue: " + err.Error())
+			tr.Status = models.Failed
+			tr.Response = err.Error()
+			return tr
+		}
+		tr.Response = "Got response status code: " + status
+		return tr
+	}
+
 	rs, err := http.Post(url, contentType, bytes.NewBuffer([]byte(message)))
 	if err != nil {
 		lc.Error("Problems sending message to: " + url)
@@ -190,26 +296,37 @@ func handleFailedTransmission(
 	config notificationsConfig.ConfigurationStruct) {
 
 	n := t.Notification
-	if t.ResendCount >= config.Writable.ResendLimit {
-		lc.Error("Too many transmission resend attempts!  Giving up on transmission: " + t.ID + ", for notification: " + n.Slug)
-	}
 	if t.Status == models.Failed && n.Status != models.Escalated {
 		lc.Debug("Handling failed transmission for: " + t.ID + " for notification: " + t.Notification.Slug + ", resends so far: " + strconv.Itoa(t.ResendCount))
 		if n.Severity == models.Critical {
-			if t.ResendCount < config.Writable.ResendLimit {
-				time.AfterFunc(time.Second*5, func() {
-					criticalSeverityResend(t, lc, dbClient, config)
-				})
-			} else {
-				escalate(t, lc, dbClient, config)
-				t.Status = models.Trxescalated
-				dbClient.UpdateTransmission(t)
+			if scheduleResend(t, lc, dbClient, config) {
+				return
 			}
+			lc.Error("Too many transmission resend attempts!  Giving up on transmission: " + t.ID + ", for notification: " + n.Slug)
+			escalate(t, lc, dbClient, config)
+			t.Status = models.Trxescalated
+			dbClient.UpdateTransmission(t)
 		}
 	}
+
+	// the transmission won't be resent anymore, so its status is final
+	callbackTransmissionStatus(t, lc, dbClient)
 }
 
 func deduceAuth(s notificationsConfig.SmtpInfo) (mail.Auth, error) {
+	if strings.EqualFold(s.AuthMode, notificationsConfig.SmtpAuthModeXOAuth2) {
+		if s.CheckUsername() == "" {
+			return nil, errors.New("Notifications: Expecting username")
+		}
+		if smtpTokens == nil {
+			return nil, errors.New("Notifications: SMTP OAuth2 tokens unavailable")
+		}
+		token, err := smtpTokens.Token(s)
+		if err != nil {
+			return nil, err
+		}
+		return &xoauth2Auth{username: s.CheckUsername(), token: token, host: s.Host}, nil
+	}
 	if s.CheckUsername() == "" && s.Password == "" {
 		return nil, errors.New("Notifications: Expecting username")
 	}
@@ -292,3 +409,62 @@ func smtpSend(to []string, msg []byte, s notificationsConfig.SmtpInfo) error {
 	}
 	return c.Quit()
 }
+
+// channelTestResult reports the outcome of sending a test notification through a single channel.
+type channelTestResult struct {
+	Type     models.ChannelType        `json:"type"`
+	Target   string                    `json:"target"`
+	Status   models.TransmissionStatus `json:"status"`
+	Response string                    `json:"response"`
+}
+
+// testViaChannel sends the notification through the channel without persisting a transmission or scheduling resends,
+// so the channel settings can be verified. Unlike restSend, a REST endpoint answering with a non-2xx status code is
+// reported as failed, or with a status code it isn't expected to when its REST channel is configured. A REST endpoint
+// not answering within the timeout is reported as failed, no timeout applying when it is zero.
+func testViaChannel(
+	n models.Notification,
+	c models.Channel,
+	lc logger.LoggingClient,
+	smtp notificationsConfig.SmtpInfo,
+	timeout time.Duration) channelTestResult {
+
+	if c.Type == models.ChannelType(models.Email) {
+		tr := sendMail(n.Content, nil, c.MailAddresses, n.ContentType, lc, smtp)
+		return channelTestResult{Type: c.Type, Target: strings.Join(c.MailAddresses, ","), Status: tr.Status, Response: tr.Response}
+	}
+
+	result := channelTestResult{Type: c.Type, Target: c.Url, Status: models.Sent}
+	if rc := restChannels.channelOf(c.Url); rc != nil {
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		status, err := restChannels.send(ctx, rc, n.Content, c.Url, n.ContentType)
+		if err != nil {
+			result.Status = models.Failed
+			result.Response = err.Error()
+			return result
+		}
+		result.Response = "Got response status code: " + status
+		return result
+	}
+
+	client := &http.Client{Timeout: timeout}
+	rs, err := client.Post(c.Url, n.ContentType, bytes.NewBuffer([]byte(n.Content)))
+	if err != nil {
+		result.Status = models.Failed
+		result.Response = err.Error()
+		return result
+	}
+	defer rs.Body.Close()
+
+	result.Response = "Got response status code: " + rs.Status
+	if rs.StatusCode < http.StatusOK || rs.StatusCode >= http.StatusMultipleChoices {
+		result.Status = models.Failed
+	}
+	return result
+}
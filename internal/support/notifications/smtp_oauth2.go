@@ -0,0 +1,152 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	mail "net/smtp"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces"
+)
+
+// Keys of the OAuth2 secrets of the SMTP server in the secret store
+const (
+	SmtpClientIdKey     = "clientId"
+	SmtpClientSecretKey = "clientSecret"
+	SmtpRefreshTokenKey = "refreshToken"
+	SmtpAccessTokenKey  = "accessToken"
+)
+
+// tokenExpiryMargin is how long before its expiry an access token is refreshed
+const tokenExpiryMargin = time.Minute
+
+// smtpTokens provides the access tokens of the XOAUTH2 SMTP authentication, set at bootstrap
+var smtpTokens *oauth2TokenSource
+
+// xoauth2Auth implements the XOAUTH2 SMTP authentication mechanism
+type xoauth2Auth struct {
+	username string
+	token    string
+	host     string
+}
+
+func (a *xoauth2Auth) Start(server *mail.ServerInfo) (string, []byte, error) {
+	// like PLAIN, the bearer token must only be sent over an encrypted connection
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, errors.New("Notifications: unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, errors.New("Notifications: wrong host name")
+	}
+	return "XOAUTH2", []byte("user=" + a.username + "\x01auth=Bearer " + a.token + "\x01\x01"), nil
+}
+
+func (a *xoauth2Auth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if more {
+		// the server challenges with the error details, an empty response lets it fail the authentication
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
+
+// oauth2TokenSource refreshes the OAuth2 access tokens of the SMTP server from the credentials in the secret store and
+// caches them until they expire. When the secrets hold an access token and no refresh token, the access token is
+// assumed to be kept fresh in the secret store by another party and is read on every use.
+type oauth2TokenSource struct {
+	secretProvider bootstrapInterfaces.SecretProvider
+	client         *http.Client
+	mutex          sync.Mutex
+	key            string
+	token          string
+	expiry         time.Time
+}
+
+func newOAuth2TokenSource(secretProvider bootstrapInterfaces.SecretProvider, client *http.Client) *oauth2TokenSource {
+	return &oauth2TokenSource{secretProvider: secretProvider, client: client}
+}
+
+// Token returns a valid access token of the SMTP server
+func (ts *oauth2TokenSource) Token(s notificationsConfig.SmtpInfo) (string, error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	key := s.SecretPath + "|" + s.TokenURL
+	if ts.key == key && ts.token != "" && time.Now().Add(tokenExpiryMargin).Before(ts.expiry) {
+		return ts.token, nil
+	}
+
+	secrets, err := ts.secretProvider.GetSecrets(s.SecretPath)
+	if err != nil {
+		return "", fmt.Errorf("Notifications: unable to read the SMTP OAuth2 secrets from '%s': %s", s.SecretPath, err.Error())
+	}
+	if secrets[SmtpRefreshTokenKey] == "" {
+		if secrets[SmtpAccessTokenKey] == "" {
+			return "", fmt.Errorf("Notifications: expecting %s or %s secret in '%s'", SmtpRefreshTokenKey, SmtpAccessTokenKey, s.SecretPath)
+		}
+		return secrets[SmtpAccessTokenKey], nil
+	}
+
+	token, expiry, err := ts.refresh(s.TokenURL, secrets)
+	if err != nil {
+		return "", err
+	}
+	ts.key, ts.token, ts.expiry = key, token, expiry
+	return token, nil
+}
+
+// refresh exchanges the refresh token for a new access token at the token endpoint
+func (ts *oauth2TokenSource) refresh(tokenURL string, secrets map[string]string) (string, time.Time, error) {
+	if tokenURL == "" {
+		return "", time.Time{}, errors.New("Notifications: expecting the SMTP OAuth2 TokenURL")
+	}
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {secrets[SmtpRefreshTokenKey]},
+		"client_id":     {secrets[SmtpClientIdKey]},
+		"client_secret": {secrets[SmtpClientSecretKey]},
+	}
+	rs, err := ts.client.Post(tokenURL, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("Notifications: unable to refresh the SMTP access token: %s", err.Error())
+	}
+	defer rs.Body.Close()
+
+	var body struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err = json.NewDecoder(rs.Body).Decode(&body); err != nil && rs.StatusCode == http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("Notifications: unable to decode the SMTP access token: %s", err.Error())
+	}
+	if rs.StatusCode != http.StatusOK || body.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("Notifications: unable to refresh the SMTP access token, status %s %s %s", rs.Status, body.Error, body.ErrorDescription)
+	}
+	return body.AccessToken, time.Now().Add(time.Duration(body.ExpiresIn) * time.Second), nil
+}
@@ -0,0 +1,113 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	mail "net/smtp"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/interfaces/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuth2TokenSourceRefresh(t *testing.T) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("refresh_token") != "refresh-token" || r.PostForm.Get("client_id") != "client" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		refreshes++
+		_, _ = w.Write([]byte(`{"access_token":"access-token","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("GetSecrets", "smtp").Return(map[string]string{
+		SmtpClientIdKey:     "client",
+		SmtpClientSecretKey: "secret",
+		SmtpRefreshTokenKey: "refresh-token",
+	}, nil)
+	secretProvider.On("GetSecrets", "revoked").Return(map[string]string{SmtpRefreshTokenKey: "revoked"}, nil)
+
+	ts := newOAuth2TokenSource(secretProvider, server.Client())
+	s := notificationsConfig.SmtpInfo{SecretPath: "smtp", TokenURL: server.URL}
+
+	token, err := ts.Token(s)
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", token)
+
+	// the access token is cached until it expires
+	token, err = ts.Token(s)
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", token)
+	assert.Equal(t, 1, refreshes)
+
+	_, err = ts.Token(notificationsConfig.SmtpInfo{SecretPath: "revoked", TokenURL: server.URL})
+	assert.Error(t, err)
+	_, err = ts.Token(notificationsConfig.SmtpInfo{SecretPath: "smtp"})
+	assert.Error(t, err)
+}
+
+func TestOAuth2TokenSourceAccessToken(t *testing.T) {
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("GetSecrets", "smtp").Return(map[string]string{SmtpAccessTokenKey: "access-token"}, nil)
+	secretProvider.On("GetSecrets", "empty").Return(map[string]string{}, nil)
+	secretProvider.On("GetSecrets", "missing").Return(nil, errors.New("no secrets"))
+
+	ts := newOAuth2TokenSource(secretProvider, http.DefaultClient)
+
+	token, err := ts.Token(notificationsConfig.SmtpInfo{SecretPath: "smtp"})
+	require.NoError(t, err)
+	assert.Equal(t, "access-token", token)
+
+	_, err = ts.Token(notificationsConfig.SmtpInfo{SecretPath: "empty"})
+	assert.Error(t, err)
+	_, err = ts.Token(notificationsConfig.SmtpInfo{SecretPath: "missing"})
+	assert.Error(t, err)
+}
+
+func TestDeduceAuthXOAuth2(t *testing.T) {
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("GetSecrets", "smtp").Return(map[string]string{SmtpAccessTokenKey: "access-token"}, nil)
+	smtpTokens = newOAuth2TokenSource(secretProvider, http.DefaultClient)
+	defer func() { smtpTokens = nil }()
+
+	auth, err := deduceAuth(notificationsConfig.SmtpInfo{
+		Host:       "smtp.example.com",
+		Username:   "jdoe@example.com",
+		AuthMode:   "XOAUTH2",
+		SecretPath: "smtp",
+	})
+	require.NoError(t, err)
+
+	proto, toServer, err := auth.Start(&mail.ServerInfo{Name: "smtp.example.com", TLS: true})
+	require.NoError(t, err)
+	assert.Equal(t, "XOAUTH2", proto)
+	assert.Equal(t, "user=jdoe@example.com\x01auth=Bearer access-token\x01\x01", string(toServer))
+
+	// the token isn't sent over an unencrypted connection
+	_, _, err = auth.Start(&mail.ServerInfo{Name: "smtp.example.com"})
+	assert.Error(t, err)
+}
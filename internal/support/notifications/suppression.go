@@ -0,0 +1,127 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+)
+
+// OccurrencesHeader carries the number of occurrences of a notification identical notifications were coalesced into
+const OccurrencesHeader = "X-Notification-Occurrences"
+
+// suppressionMutex serializes the coalescing of notifications, so that identical notifications posted concurrently
+// aren't both distributed
+var suppressionMutex sync.Mutex
+
+// suppressionWindow returns how long identical notifications are coalesced, zero when they aren't
+func suppressionWindow(suppression notificationsConfig.DuplicateSuppressionInfo) (time.Duration, error) {
+	if suppression.Window == "" {
+		return 0, nil
+	}
+	window, err := time.ParseDuration(suppression.Window)
+	if err != nil {
+		return 0, fmt.Errorf("invalid DuplicateSuppression Window '%s': %s", suppression.Window, err.Error())
+	}
+	return window, nil
+}
+
+// notificationHash identifies the identical notifications of a sender by their content, ignoring their slug and the
+// order of their labels
+func notificationHash(n contract.Notification) string {
+	labels := append([]string{}, n.Labels...)
+	sort.Strings(labels)
+	fields := append([]string{n.Sender, string(n.Category), string(n.Severity), n.Content, n.Description, n.ContentType}, labels...)
+
+	h := sha256.New()
+	for _, field := range fields {
+		_, _ = h.Write([]byte(field))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// coalesceNotification counts the notification as an occurrence of the identical notification posted within the
+// window, returning its id. It returns an empty id when there is no such notification, the notification is then
+// distributed and must be recorded by recordOccurrences.
+func coalesceNotification(n contract.Notification, dbClient interfaces.DBClient) (string, models.NotificationOccurrences, error) {
+	id, occurrences, err := dbClient.CoalesceNotification(notificationHash(n), db.MakeTimestamp())
+	if err == db.ErrNotFound {
+		return "", occurrences, nil
+	}
+	return id, occurrences, err
+}
+
+// recordOccurrences starts coalescing the notifications identical to the notification for the window
+func recordOccurrences(n contract.Notification, window time.Duration, dbClient interfaces.DBClient) error {
+	occurrences := models.NotificationOccurrences{
+		Hash:  notificationHash(n),
+		Count: 1,
+		First: n.Created,
+		Last:  n.Created,
+	}
+	return dbClient.AddNotificationOccurrences(n.ID, occurrences, window)
+}
+
+func restGetNotificationOccurrences(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	slug := mux.Vars(r)[SLUG]
+	n, err := dbClient.GetNotificationBySlug(slug)
+	if err != nil {
+		lc.Error(err.Error())
+		if err == db.ErrNotFound {
+			err = errors.NewErrNotificationNotFound(slug)
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	occurrences, err := dbClient.GetNotificationOccurrences(n.ID)
+	if err != nil {
+		if err == db.ErrNotFound {
+			http.Error(w, fmt.Sprintf("no occurrences are counted for notification %s", slug), http.StatusNotFound)
+		} else {
+			lc.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	pkg.Encode(occurrences, w, lc)
+}
@@ -0,0 +1,143 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationHash(t *testing.T) {
+	n := createNotificationBySeverityLevel(contract.Normal)
+
+	identical := n
+	identical.ID = ""
+	identical.Slug = "another-slug"
+	identical.Labels = []string{n.Labels[1], n.Labels[0]}
+	assert.Equal(t, notificationHash(n), notificationHash(identical), "slug and label order are ignored")
+
+	otherSender := n
+	otherSender.Sender = "Sender A"
+	otherContent := n
+	otherContent.Content = "Hello again, Notification!"
+	otherSeverity := n
+	otherSeverity.Severity = contract.Critical
+	for _, other := range []contract.Notification{otherSender, otherContent, otherSeverity} {
+		assert.NotEqual(t, notificationHash(n), notificationHash(other))
+	}
+}
+
+func TestNotificationHandlerDuplicateSuppression(t *testing.T) {
+	config := notificationsConfig.ConfigurationStruct{}
+	config.Writable.DuplicateSuppression.Window = "10m"
+	n := createNotificationBySeverityLevel(contract.Normal)
+	hash := notificationHash(n)
+
+	t.Run("first occurrence distributed", func(t *testing.T) {
+		dbClientMock := &mocks.DBClient{}
+		dbClientMock.On("CoalesceNotification", hash, mock.Anything).Return("", models.NotificationOccurrences{}, db.ErrNotFound)
+		dbClientMock.On("AddNotification", mock.Anything).Return(notificationId, nil)
+		dbClientMock.On("GetNotificationById", notificationId).Return(n, nil)
+		dbClientMock.On("GetSubscriptionByCategoriesLabels", mock.Anything, mock.Anything).Return([]contract.Subscription{}, nil)
+		dbClientMock.On("SetNotificationDistribution", mock.Anything).Return(nil)
+		dbClientMock.On("MarkNotificationProcessed", mock.Anything).Return(nil)
+		expected := models.NotificationOccurrences{Hash: hash, Count: 1, First: n.Created, Last: n.Created}
+		dbClientMock.On("AddNotificationOccurrences", notificationId, expected, 10*time.Minute).Return(nil)
+
+		rr := httptest.NewRecorder()
+		notificationHandler(rr, createNotificationHandlerRequestWithBody(http.MethodPost, n, nil), logger.NewMockClient(), dbClientMock, config)
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		dbClientMock.AssertCalled(t, "AddNotificationOccurrences", notificationId, expected, 10*time.Minute)
+	})
+
+	t.Run("duplicate coalesced", func(t *testing.T) {
+		dbClientMock := &mocks.DBClient{}
+		occurrences := models.NotificationOccurrences{Hash: hash, Count: 3}
+		dbClientMock.On("CoalesceNotification", hash, mock.Anything).Return(notificationId, occurrences, nil)
+
+		rr := httptest.NewRecorder()
+		notificationHandler(rr, createNotificationHandlerRequestWithBody(http.MethodPost, n, nil), logger.NewMockClient(), dbClientMock, config)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, notificationId, rr.Body.String())
+		assert.Equal(t, "3", rr.Header().Get(OccurrencesHeader))
+		dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		dbClientMock := &mocks.DBClient{}
+		dbClientMock.On("AddNotification", mock.Anything).Return(notificationId, nil)
+		dbClientMock.On("GetNotificationById", notificationId).Return(n, nil)
+		dbClientMock.On("GetSubscriptionByCategoriesLabels", mock.Anything, mock.Anything).Return([]contract.Subscription{}, nil)
+		dbClientMock.On("SetNotificationDistribution", mock.Anything).Return(nil)
+		dbClientMock.On("MarkNotificationProcessed", mock.Anything).Return(nil)
+
+		rr := httptest.NewRecorder()
+		notificationHandler(rr, createNotificationHandlerRequestWithBody(http.MethodPost, n, nil), logger.NewMockClient(), dbClientMock, notificationsConfig.ConfigurationStruct{})
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		dbClientMock.AssertNotCalled(t, "CoalesceNotification", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetNotificationOccurrences(t *testing.T) {
+	n := createNotificationBySeverityLevel(contract.Normal)
+	occurrences := models.NotificationOccurrences{Hash: notificationHash(n), Count: 42, First: 1, Last: 2}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("GetNotificationBySlug", n.Slug).Return(n, nil)
+	dbClientMock.On("GetNotificationBySlug", "unknown").Return(contract.Notification{}, db.ErrNotFound)
+	dbClientMock.On("GetNotificationOccurrences", n.ID).Return(occurrences, nil)
+
+	tests := []struct {
+		name           string
+		slug           string
+		expectedStatus int
+	}{
+		{"Counted", n.Slug, http.StatusOK},
+		{"Unknown notification", "unknown", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, TestURI, nil), map[string]string{SLUG: tt.slug})
+			rr := httptest.NewRecorder()
+			restGetNotificationOccurrences(rr, req, logger.NewMockClient(), dbClientMock)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var actual models.NotificationOccurrences
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &actual))
+				assert.Equal(t, occurrences, actual)
+			}
+		})
+	}
+}
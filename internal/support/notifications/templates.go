@@ -0,0 +1,216 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/interfaces"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+const (
+	// defaultTemplateName is the name of the template rendering the notifications of a category without template
+	defaultTemplateName = "default"
+	// templateExtension is the extension of the mail template files, named <category>.<locale>.tmpl
+	templateExtension = ".tmpl"
+	// subjectTemplateName is the template a mail template defines to render the subject of the emails
+	subjectTemplateName = "subject"
+)
+
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,8}([-_][A-Za-z0-9]{1,8})*$`)
+
+// mailTemplates renders the emails in the locales of the subscriptions, replaced by the bootstrap handler with the
+// configured templates. Without templates, the emails contain the notification content.
+var mailTemplates *mailTemplateSet
+
+// mailTemplateSet is the mail templates by category and locale, with the locale the emails are rendered in when a
+// subscription has none or no template is available in its locale.
+type mailTemplateSet struct {
+	defaultLocale string
+	templates     map[string]map[string]*template.Template
+}
+
+// loadMailTemplates parses the templates of the configured directory, named <category>.<locale>.tmpl, e.g.
+// 'default.fr-CA.tmpl'. A template renders the body of the emails from the notification, and may define the
+// 'subject' template to render their subject. No templates are loaded when the directory is empty.
+func loadMailTemplates(info notificationsConfig.MailTemplatesInfo) (*mailTemplateSet, error) {
+	set := &mailTemplateSet{
+		defaultLocale: normalizeLocale(info.DefaultLocale),
+		templates:     map[string]map[string]*template.Template{},
+	}
+	if info.Directory == "" {
+		return set, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(info.Directory, "*"+templateExtension))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		base := strings.TrimSuffix(filepath.Base(file), templateExtension)
+		i := strings.LastIndex(base, ".")
+		if i <= 0 || !validLocale(base[i+1:]) {
+			return nil, fmt.Errorf("mail template %s isn't named <category>.<locale>%s", file, templateExtension)
+		}
+		name, locale := base[:i], normalizeLocale(base[i+1:])
+
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		t, err := template.New(base).Option("missingkey=zero").Parse(string(content))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse mail template %s: %s", file, err.Error())
+		}
+		if set.templates[name] == nil {
+			set.templates[name] = map[string]*template.Template{}
+		}
+		set.templates[name][locale] = t
+	}
+	return set, nil
+}
+
+// render returns the subject and body of the email sending the notification to a subscriber of the locale. The
+// locales are tried from the most to the least specific, e.g. 'fr-CA' then 'fr', then the default locale; in each,
+// the template of the notification category is preferred to the default template. The notification content is sent
+// with the fallback subject when no template applies or it fails to render.
+func (s *mailTemplateSet) render(n models.Notification, locale string, subject string) (string, string, error) {
+	if s == nil {
+		return subject, n.Content, nil
+	}
+
+	names := []string{defaultTemplateName}
+	if n.Category != "" {
+		names = []string{string(n.Category), defaultTemplateName}
+	}
+	for _, l := range localeChain(locale, s.defaultLocale) {
+		for _, name := range names {
+			t, ok := s.templates[name][l]
+			if !ok {
+				continue
+			}
+
+			var body bytes.Buffer
+			if err := t.Execute(&body, n); err != nil {
+				return subject, n.Content, err
+			}
+			if t.Lookup(subjectTemplateName) != nil {
+				var buf bytes.Buffer
+				if err := t.ExecuteTemplate(&buf, subjectTemplateName, n); err != nil {
+					return subject, n.Content, err
+				}
+				subject = strings.TrimSpace(buf.String())
+			}
+			return subject, strings.TrimSpace(body.String()), nil
+		}
+	}
+	return subject, n.Content, nil
+}
+
+// localeChain returns the locales to look the templates up in, from the locale to its language, then the default
+// locale and its language, without duplicates.
+func localeChain(locale string, defaultLocale string) []string {
+	var chain []string
+	for _, l := range []string{normalizeLocale(locale), normalizeLocale(defaultLocale)} {
+		for l != "" {
+			if !containsLocale(chain, l) {
+				chain = append(chain, l)
+			}
+			i := strings.LastIndex(l, "-")
+			if i < 0 {
+				break
+			}
+			l = l[:i]
+		}
+	}
+	return chain
+}
+
+// normalizeLocale returns the locale in lower case with hyphen separators, so that 'fr_CA' and 'fr-ca' match
+// 'fr-CA'.
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// validLocale tells whether the locale is a language tag, e.g. 'fr' or 'fr-CA'
+func validLocale(locale string) bool {
+	return localePattern.MatchString(locale)
+}
+
+func containsLocale(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// renderMail returns the subject and body of the email sending the notification to a subscriber of the locale
+func renderMail(n models.Notification, locale string, lc logger.LoggingClient, smtp notificationsConfig.SmtpInfo) (string, string) {
+	subject, body, err := mailTemplates.render(n, locale, smtp.Subject)
+	if err != nil {
+		lc.Error("Unable to render the email of notification: " + n.Slug + " in locale: " + locale + ", issue: " + err.Error())
+	}
+	return subject, body
+}
+
+// subscriptionLocale returns the locale of the subscription, empty when it has none or no mail templates are loaded
+func subscriptionLocale(s models.Subscription, lc logger.LoggingClient, dbClient interfaces.DBClient) string {
+	if mailTemplates == nil {
+		return ""
+	}
+	locale, err := dbClient.GetSubscriptionLocale(s.ID)
+	if err != nil {
+		if err != db.ErrNotFound {
+			lc.Error("Unable to get locale of subscription: " + s.Slug + ", issue: " + err.Error())
+		}
+		return ""
+	}
+	return locale
+}
+
+// transmissionLocale returns the locale of the subscription the transmission was sent to, empty when it has none or
+// no mail templates are loaded
+func transmissionLocale(t models.Transmission, lc logger.LoggingClient, dbClient interfaces.DBClient) string {
+	if mailTemplates == nil {
+		return ""
+	}
+	subs, err := dbClient.GetSubscriptionByReceiver(t.Receiver)
+	if err != nil {
+		lc.Error("Unable to get subscriptions to look up the locale of transmission: " + t.ID)
+	}
+	for _, s := range subs {
+		if !hasChannel(s, t.Channel) {
+			continue
+		}
+		if locale := subscriptionLocale(s, lc, dbClient); locale != "" {
+			return locale
+		}
+	}
+	return ""
+}
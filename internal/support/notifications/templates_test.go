@@ -0,0 +1,110 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *
+ *******************************************************************************/
+
+package notifications
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	notificationsConfig "github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocaleChain(t *testing.T) {
+	tests := []struct {
+		name          string
+		locale        string
+		defaultLocale string
+		expected      []string
+	}{
+		{"Region then language then default", "fr-CA", "en", []string{"fr-ca", "fr", "en"}},
+		{"Underscore separator", "fr_CA", "en", []string{"fr-ca", "fr", "en"}},
+		{"Default locale with region", "de", "en-US", []string{"de", "en-us", "en"}},
+		{"Same language as default", "en-GB", "en", []string{"en-gb", "en"}},
+		{"No locale", "", "en", []string{"en"}},
+		{"No locale nor default", "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, localeChain(tt.locale, tt.defaultLocale))
+		})
+	}
+}
+
+func TestRenderMail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mail-templates")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"default.en.tmpl":     `{{define "subject"}}Notification {{.Slug}}{{end}}{{.Content}}`,
+		"default.fr.tmpl":     `{{define "subject"}}Notification {{.Slug}}{{end}}Contenu : {{.Content}}`,
+		"SECURITY.fr-CA.tmpl": `{{define "subject"}}Alerte {{.Slug}}{{end}}Alerte : {{.Content}}`,
+		"default.de.tmpl":     `Inhalt: {{.Content}}`,
+	}
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	set, err := loadMailTemplates(notificationsConfig.MailTemplatesInfo{Directory: dir, DefaultLocale: "en"})
+	require.NoError(t, err)
+
+	security := models.Notification{Slug: "door", Content: "open", Category: models.Security}
+	health := models.Notification{Slug: "disk", Content: "full", Category: models.Swhealth}
+	tests := []struct {
+		name            string
+		notification    models.Notification
+		locale          string
+		expectedSubject string
+		expectedBody    string
+	}{
+		{"Category template in locale", security, "fr-CA", "Alerte door", "Alerte : open"},
+		{"Default template in locale", health, "fr-CA", "Notification disk", "Contenu : full"},
+		{"Language template", security, "fr-FR", "Notification door", "Contenu : open"},
+		{"Default locale", security, "es", "Notification door", "open"},
+		{"No locale", health, "", "Notification disk", "full"},
+		{"Template without subject", health, "de", "EdgeX Notification", "Inhalt: full"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject, body, err := set.render(tt.notification, tt.locale, "EdgeX Notification")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSubject, subject)
+			assert.Equal(t, tt.expectedBody, body)
+		})
+	}
+
+	var none *mailTemplateSet
+	subject, body, err := none.render(security, "fr-CA", "EdgeX Notification")
+	require.NoError(t, err)
+	assert.Equal(t, "EdgeX Notification", subject)
+	assert.Equal(t, "open", body)
+}
+
+func TestLoadMailTemplatesInvalidName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mail-templates")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "default.tmpl"), []byte("{{.Content}}"), 0644))
+	_, err = loadMailTemplates(notificationsConfig.MailTemplatesInfo{Directory: dir})
+	assert.Error(t, err)
+}
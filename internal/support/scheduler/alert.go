@@ -0,0 +1,123 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// the number of consecutive failed executions, by interval action id
+var (
+	failureMutex        sync.Mutex
+	consecutiveFailures = make(map[string]int)
+)
+
+// alertFailures counts the consecutive failed executions of the interval action, and notifies them through
+// support-notifications once they reach the FailureAlert threshold. A successful execution resets the count, so the
+// next run of failures is alerted again. Nothing is notified when the notifications client is nil.
+func alertFailures(
+	intervalAction contract.IntervalAction,
+	intervalName string,
+	err error,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	notificationsClient notifications.NotificationsClient) {
+
+	failures := countFailure(intervalAction.ID, err)
+	info := configuration.Writable.FailureAlert
+	if notificationsClient == nil || info.Threshold <= 0 || failures != info.Threshold {
+		return
+	}
+
+	n, nErr := failureNotification(intervalAction, intervalName, failures, err, info)
+	if nErr != nil {
+		lc.Error(fmt.Sprintf("unable to alert the failures of the interval action : %s : %s", intervalAction.Name, nErr.Error()))
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(configuration.Service.Timeout)*time.Millisecond)
+	defer cancel()
+	if sendErr := notificationsClient.SendNotification(ctx, n); sendErr != nil {
+		lc.Error(fmt.Sprintf("unable to send the notification about the interval action : %s : %s", intervalAction.Name, sendErr.Error()))
+		return
+	}
+	lc.Info(fmt.Sprintf("notified the %d consecutive failures of the interval action : %s", failures, intervalAction.Name))
+}
+
+// countFailure returns the number of consecutive failed executions of the interval action once the execution's error
+// is counted, 0 when the execution succeeded
+func countFailure(intervalActionId string, err error) int {
+	failureMutex.Lock()
+	defer failureMutex.Unlock()
+
+	if err == nil {
+		delete(consecutiveFailures, intervalActionId)
+		return 0
+	}
+	consecutiveFailures[intervalActionId]++
+	return consecutiveFailures[intervalActionId]
+}
+
+// deleteFailureCount drops the count of consecutive failed executions of the interval action
+func deleteFailureCount(intervalActionId string) {
+	failureMutex.Lock()
+	defer failureMutex.Unlock()
+
+	delete(consecutiveFailures, intervalActionId)
+}
+
+// failureNotification returns the notification of the consecutive failures of the interval action, with the
+// configured severity and category, CRITICAL and SW_HEALTH when empty
+func failureNotification(
+	intervalAction contract.IntervalAction,
+	intervalName string,
+	failures int,
+	err error,
+	info config.FailureAlertInfo) (notifications.Notification, error) {
+
+	severity := notifications.CRITICAL
+	if info.Severity != "" {
+		severity = notifications.SeverityEnum(info.Severity)
+		if severity != notifications.CRITICAL && severity != notifications.NORMAL {
+			return notifications.Notification{}, fmt.Errorf("invalid FailureAlert Severity '%s'", info.Severity)
+		}
+	}
+	category := notifications.SW_HEALTH
+	if info.Category != "" {
+		category = notifications.CategoryEnum(info.Category)
+		if category != notifications.SW_HEALTH && category != notifications.HW_HEALTH && category != notifications.SECURITY {
+			return notifications.Notification{}, fmt.Errorf("invalid FailureAlert Category '%s'", info.Category)
+		}
+	}
+
+	return notifications.Notification{
+		Slug:     fmt.Sprintf("scheduler-%s-%d", intervalAction.Name, time.Now().UnixNano()),
+		Sender:   clients.SupportSchedulerServiceKey,
+		Category: category,
+		Severity: severity,
+		Content: fmt.Sprintf("Interval action %s of interval %s failed %d consecutive times: %s",
+			intervalAction.Name, intervalName, failures, err.Error()),
+		Labels: []string{"scheduler", intervalAction.Name},
+	}, nil
+}
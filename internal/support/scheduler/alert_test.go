@@ -0,0 +1,99 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotificationsClient struct {
+	sent []notifications.Notification
+}
+
+func (c *recordingNotificationsClient) SendNotification(_ context.Context, n notifications.Notification) error {
+	c.sent = append(c.sent, n)
+	return nil
+}
+
+func TestAlertFailures(t *testing.T) {
+	lc := logger.NewMockClient()
+	configuration := &config.ConfigurationStruct{}
+	configuration.Writable.FailureAlert = config.FailureAlertInfo{Threshold: 2, Severity: "NORMAL", Category: "HW_HEALTH"}
+	client := &recordingNotificationsClient{}
+	action := contract.IntervalAction{ID: "alert-id", Name: "scrub-aged-events"}
+	defer deleteFailureCount(action.ID)
+	failed := errors.New("request returned status code : 500")
+
+	alertFailures(action, "midnight", failed, lc, configuration, client)
+	assert.Empty(t, client.sent, "the failures below the threshold are not expected to be alerted")
+
+	alertFailures(action, "midnight", failed, lc, configuration, client)
+	require.Len(t, client.sent, 1)
+	n := client.sent[0]
+	assert.Equal(t, notifications.NORMAL, n.Severity)
+	assert.Equal(t, notifications.HW_HEALTH, n.Category)
+	assert.Equal(t, clients.SupportSchedulerServiceKey, n.Sender)
+	assert.Contains(t, n.Content, "scrub-aged-events")
+	assert.Contains(t, n.Content, "2 consecutive times")
+
+	alertFailures(action, "midnight", failed, lc, configuration, client)
+	assert.Len(t, client.sent, 1, "the run of failures is expected to be alerted once")
+
+	// a successful execution resets the count, the next run of failures being alerted again
+	alertFailures(action, "midnight", nil, lc, configuration, client)
+	alertFailures(action, "midnight", failed, lc, configuration, client)
+	alertFailures(action, "midnight", failed, lc, configuration, client)
+	assert.Len(t, client.sent, 2)
+}
+
+func TestAlertFailuresDisabled(t *testing.T) {
+	lc := logger.NewMockClient()
+	configuration := &config.ConfigurationStruct{}
+	client := &recordingNotificationsClient{}
+	action := contract.IntervalAction{ID: "disabled-id", Name: "scrub-pushed-events"}
+	defer deleteFailureCount(action.ID)
+
+	for i := 0; i < 3; i++ {
+		alertFailures(action, "midnight", errors.New("failed"), lc, configuration, client)
+	}
+	assert.Empty(t, client.sent)
+}
+
+func TestFailureNotification(t *testing.T) {
+	action := contract.IntervalAction{Name: "scrub-aged-events"}
+	failed := errors.New("failed")
+
+	n, err := failureNotification(action, "midnight", 3, failed, config.FailureAlertInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, notifications.CRITICAL, n.Severity)
+	assert.Equal(t, notifications.SW_HEALTH, n.Category)
+
+	_, err = failureNotification(action, "midnight", 3, failed, config.FailureAlertInfo{Severity: "URGENT"})
+	assert.Error(t, err)
+	_, err = failureNotification(action, "midnight", 3, failed, config.FailureAlertInfo{Category: "NETWORK"})
+	assert.Error(t, err)
+}
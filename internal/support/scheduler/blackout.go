@@ -0,0 +1,136 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func getBlackoutCalendarByName(name string, dbClient interfaces.DBClient) (models.BlackoutCalendar, error) {
+	calendar, err := dbClient.BlackoutCalendarByName(name)
+	if err == db.ErrNotFound {
+		err = errors.NewErrBlackoutCalendarNotFound(name)
+	}
+	return calendar, err
+}
+
+// addBlackoutCalendar stores a new blackout calendar and adds it to the scheduler queue
+func addBlackoutCalendar(
+	calendar models.BlackoutCalendar,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	if err := calendar.Validate(); err != nil {
+		return errors.NewErrInvalidBlackoutCalendar(err.Error())
+	}
+	_, err := dbClient.BlackoutCalendarByName(calendar.Name)
+	if err == nil {
+		return errors.NewErrBlackoutCalendarNameInUse(calendar.Name)
+	} else if err != db.ErrNotFound {
+		return err
+	}
+	return setBlackoutCalendar(calendar, dbClient, scClient)
+}
+
+// updateBlackoutCalendar replaces an existing blackout calendar, the intervals it is attached to apply the new
+// calendar from their next execution
+func updateBlackoutCalendar(
+	calendar models.BlackoutCalendar,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	if err := calendar.Validate(); err != nil {
+		return errors.NewErrInvalidBlackoutCalendar(err.Error())
+	}
+	if _, err := getBlackoutCalendarByName(calendar.Name, dbClient); err != nil {
+		return err
+	}
+	return setBlackoutCalendar(calendar, dbClient, scClient)
+}
+
+func setBlackoutCalendar(
+	calendar models.BlackoutCalendar,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	if err := dbClient.SetBlackoutCalendar(calendar); err != nil {
+		return err
+	}
+	return scClient.SetBlackoutCalendar(calendar)
+}
+
+// deleteBlackoutCalendarByName removes a blackout calendar which isn't attached to any interval
+func deleteBlackoutCalendarByName(
+	name string,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	if _, err := getBlackoutCalendarByName(name, dbClient); err != nil {
+		return err
+	}
+
+	intervals, err := dbClient.Intervals()
+	if err != nil {
+		return err
+	}
+	for _, interval := range intervals {
+		names, err := getIntervalBlackoutCalendars(interval.ID, dbClient)
+		if err != nil {
+			return err
+		}
+		for _, n := range names {
+			if n == name {
+				return errors.NewErrBlackoutCalendarStillInUse(name)
+			}
+		}
+	}
+
+	if err = dbClient.DeleteBlackoutCalendarByName(name); err != nil {
+		return err
+	}
+	return scClient.RemoveBlackoutCalendar(name)
+}
+
+// getIntervalBlackoutCalendars returns the names of the blackout calendars attached to the interval, none when it has
+// none
+func getIntervalBlackoutCalendars(intervalId string, dbClient interfaces.DBClient) ([]string, error) {
+	names, err := dbClient.IntervalBlackoutCalendars(intervalId)
+	if err == db.ErrNotFound {
+		return []string{}, nil
+	}
+	return names, err
+}
+
+// setIntervalBlackoutCalendars attaches the named blackout calendars to the interval and applies them to the scheduler
+// queue. Empty names detach all the calendars of the interval.
+func setIntervalBlackoutCalendars(
+	intervalId string,
+	names []string,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	for _, name := range names {
+		if _, err := getBlackoutCalendarByName(name, dbClient); err != nil {
+			return err
+		}
+	}
+	if err := dbClient.SetIntervalBlackoutCalendars(intervalId, names); err != nil {
+		return err
+	}
+	return scClient.SetIntervalBlackoutCalendars(intervalId, names)
+}
@@ -17,6 +17,11 @@ package config
 import (
 	"fmt"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
@@ -29,13 +34,64 @@ type ConfigurationStruct struct {
 	Service         bootstrapConfig.ServiceInfo
 	Intervals       map[string]IntervalInfo
 	IntervalActions map[string]IntervalActionInfo
+	MessageQueue    MessageQueueInfo
 	SecretStore     bootstrapConfig.SecretStoreInfo
+
+	// ScheduleDefinitions are applied at startup after the Intervals and IntervalActions
+	ScheduleDefinitions ScheduleDefinitionsInfo
+
+	// RequestLimits rejects the request bodies larger than the configured sizes with 413
+	RequestLimits bodylimit.LimitsInfo
+	// TrustedHeaders verifies the claims of the caller forwarded by the API gateway
+	TrustedHeaders claims.TrustedHeadersInfo
+	// ConfigDrift reports the drift of the running configuration, and reloads the sections drifted in the registry
+	ConfigDrift configdrift.DriftInfo
 }
 
 type WritableInfo struct {
 	ScheduleIntervalTime int
 	LogLevel             string
 	InsecureSecrets      bootstrapConfig.InsecureSecrets
+	ExecutionLock        ExecutionLockInfo
+	// DefaultOverlapPolicy applies to the interval actions without an overlap policy of their own, one of skip, queue
+	// or parallel
+	DefaultOverlapPolicy string
+	// Compression compresses the responses of the service
+	Compression compression.ResponsesInfo
+	// FeatureFlags enable the experimental behaviors of the service by name, toggled at runtime through the registry
+	FeatureFlags map[string]bool
+	// FailureAlert notifies the interval actions failing consecutively through support-notifications
+	FailureAlert FailureAlertInfo
+}
+
+// ExecutionLockInfo configures the coordination of scheduler instances which share the database for high availability
+type ExecutionLockInfo struct {
+	// Enabled makes each instance acquire the lock of the interval's period in the database before executing its
+	// actions, so an interval fires once per period across all instances. Periods are counted from the interval's
+	// Start, or from the Unix epoch when it has none. The lock lasts one period, or LockTime for run once intervals.
+	Enabled bool
+	// LockTime is how long the lock of a run once interval is held
+	LockTime string
+}
+
+// FailureAlertInfo configures the notification sent through support-notifications when an interval action fails
+// Threshold consecutive times. The notification is sent once per run of failures, a successful execution resetting the
+// count.
+type FailureAlertInfo struct {
+	// Threshold is the number of consecutive failures alerted, 0 disables the alerts
+	Threshold int
+	// Severity of the notification, CRITICAL or NORMAL
+	Severity string
+	// Category of the notification, SW_HEALTH, HW_HEALTH or SECURITY
+	Category string
+}
+
+// ScheduleDefinitionsInfo configures the declarative definitions of intervals and interval actions applied at startup,
+// in the format of the api/v1/definitions API
+type ScheduleDefinitionsInfo struct {
+	// File is the YAML file of definitions, added when missing and updated when different by name. Nothing is applied
+	// when empty.
+	File string
 }
 
 type IntervalInfo struct {
@@ -73,6 +129,36 @@ type IntervalActionInfo struct {
 	Path string
 	// Associated Schedule for the Event
 	Interval string
+	// Message bus topic the Parameters are published to when Protocol is MESSAGEBUS
+	Topic string
+	// OverlapPolicy applies when the action is due while its previous execution is still in flight, one of skip,
+	// queue or parallel. Writable.DefaultOverlapPolicy applies when empty.
+	OverlapPolicy string
+}
+
+// MessageBusProtocol is the IntervalAction protocol used to publish the action's parameters to the message bus
+// instead of sending a REST request.
+const MessageBusProtocol = "MESSAGEBUS"
+
+// DeviceCommandProtocol is the IntervalAction protocol used to issue a device command through core-command instead of
+// sending a REST request. Target is the device name, Path the command name and Method GET or PUT, Parameters being the
+// body of a PUT command.
+const DeviceCommandProtocol = "DEVICECOMMAND"
+
+// MessageQueueInfo provides parameters related to connecting to the message bus used by MESSAGEBUS interval actions
+type MessageQueueInfo struct {
+	// Host is the hostname or IP address of the broker, if applicable.
+	Host string
+	// Port defines the port on which to access the message queue.
+	Port int
+	// Protocol indicates the protocol to use when accessing the message queue.
+	Protocol string
+	// Indicates the message queue platform being used. Leave blank to disable MESSAGEBUS interval actions.
+	Type string
+	// Provides additional configuration properties which do not fit within the existing field.
+	// Typically the key is the name of the configuration property and the value is a string representation of the
+	// desired value for the configuration property.
+	Optional map[string]string
 }
 
 // URI constructs a URI from the protocol, host and port and returns that as a string.
@@ -110,6 +196,16 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 	return ok
 }
 
+// GetFeatureFlags returns the feature flags of the Writable configuration, by name.
+func (c *ConfigurationStruct) GetFeatureFlags() map[string]bool {
+	return c.Writable.FeatureFlags
+}
+
+// GetConfigDriftInfo returns the configuration of the drift detection of the running configuration.
+func (c *ConfigurationStruct) GetConfigDriftInfo() configdrift.DriftInfo {
+	return c.ConfigDrift
+}
+
 // GetBootstrap returns the configuration elements required by the bootstrap.  Currently, a copy of the configuration
 // data is returned.  This is intended to be temporary -- since ConfigurationStruct drives the configuration.toml's
 // structure -- until we can make backwards-breaking configuration.toml changes (which would consolidate these fields
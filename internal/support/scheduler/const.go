@@ -31,6 +31,16 @@ const (
 	TIMELAYOUT     = "20060102T150405"
 	SCRUB          = "scrub"
 	TARGET         = "target"
+	OVERLAP        = "overlap"
+	INFLIGHT       = "inflight"
+	HISTORY        = "history"
+	BLACKOUT       = "blackout"
+	NEXT           = "next"
+	COUNT          = "count"
+
+	BLACKOUTCALENDAR = "blackoutcalendar"
+	DEFINITIONS      = "definitions"
+	DELAYEDACTION    = "delayedaction"
 
 	/* ---------------- URL PARAM NAMES -----------------------*/
 	ContentTypeKey       = "Content-Type"
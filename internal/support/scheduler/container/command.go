@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/command"
+)
+
+// CommandClientName contains the name of the core-command client instance in the DIC.
+var CommandClientName = di.TypeInstanceToName((*command.CommandClient)(nil))
+
+// CommandClientFrom helper function queries the DIC and returns the core-command client used by DEVICECOMMAND interval
+// actions.
+func CommandClientFrom(get di.Get) command.CommandClient {
+	client, ok := get(CommandClientName).(command.CommandClient)
+	if !ok {
+		return nil
+	}
+	return client
+}
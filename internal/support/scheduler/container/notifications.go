@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+)
+
+// NotificationsClientName contains the name of the support-notifications client instance in the DIC.
+var NotificationsClientName = di.TypeInstanceToName((*notifications.NotificationsClient)(nil))
+
+// NotificationsClientFrom helper function queries the DIC and returns the support-notifications client used to alert
+// the interval actions failing consecutively.
+func NotificationsClientFrom(get di.Get) notifications.NotificationsClient {
+	client, ok := get(NotificationsClientName).(notifications.NotificationsClient)
+	if !ok {
+		return nil
+	}
+	return client
+}
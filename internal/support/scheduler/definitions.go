@@ -0,0 +1,328 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"io/ioutil"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/robfig/cron"
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
+)
+
+// loadScheduleDefinitionsFile applies the schedule definitions of the YAML file, if any
+func loadScheduleDefinitionsFile(
+	file string,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	if file == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var definitions models.ScheduleDefinitions
+	if err = yaml.UnmarshalStrict(data, &definitions); err != nil {
+		return errors.NewErrInvalidScheduleDefinitions(err.Error())
+	}
+
+	applied, err := applyScheduleDefinitions(definitions, lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+	lc.Info("applied schedule definitions", "file", file,
+		"intervals added", len(applied.Intervals.Added), "intervals updated", len(applied.Intervals.Updated),
+		"interval actions added", len(applied.IntervalActions.Added),
+		"interval actions updated", len(applied.IntervalActions.Updated))
+	return nil
+}
+
+// applyScheduleDefinitions adds the intervals and interval actions of the definitions which don't exist and updates
+// the ones which differ, by name. The definitions are validated as a whole first, so that invalid definitions change
+// nothing.
+func applyScheduleDefinitions(
+	definitions models.ScheduleDefinitions,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) (models.ScheduleDefinitionsApplied, error) {
+
+	applied := models.ScheduleDefinitionsApplied{
+		Intervals:       models.DefinitionChanges{Added: []string{}, Updated: []string{}, Unchanged: []string{}},
+		IntervalActions: models.DefinitionChanges{Added: []string{}, Updated: []string{}, Unchanged: []string{}},
+	}
+	if err := validateScheduleDefinitions(definitions, dbClient); err != nil {
+		return applied, err
+	}
+
+	for _, d := range definitions.Intervals {
+		existing, err := dbClient.IntervalByName(d.Name)
+		if err != nil && err != db.ErrNotFound {
+			return applied, err
+		}
+
+		i := toIntervalContract(d)
+		if err == db.ErrNotFound {
+			if _, err = addNewInterval(i, dbClient, scClient); err != nil {
+				return applied, err
+			}
+			lc.Info("added interval from definitions", "name", d.Name)
+			applied.Intervals.Added = append(applied.Intervals.Added, d.Name)
+			continue
+		}
+
+		if toIntervalDefinition(existing) == d {
+			applied.Intervals.Unchanged = append(applied.Intervals.Unchanged, d.Name)
+			continue
+		}
+		i.ID = existing.ID
+		i.Timestamps = existing.Timestamps
+		if err = interval.NewUpdateExecutor(dbClient, scClient, i).Execute(); err != nil {
+			return applied, err
+		}
+		lc.Info("updated interval from definitions", "name", d.Name)
+		applied.Intervals.Updated = append(applied.Intervals.Updated, d.Name)
+	}
+
+	for _, d := range definitions.IntervalActions {
+		existing, err := dbClient.IntervalActionByName(d.Name)
+		if err != nil && err != db.ErrNotFound {
+			return applied, err
+		}
+
+		a := toIntervalActionContract(d)
+		if err == db.ErrNotFound {
+			id, err := addNewIntervalAction(a, dbClient, scClient)
+			if err != nil {
+				return applied, err
+			}
+			if d.OverlapPolicy != "" {
+				if err = setIntervalActionOverlapPolicy(id, d.OverlapPolicy, dbClient, scClient); err != nil {
+					return applied, err
+				}
+			}
+			lc.Info("added interval action from definitions", "name", d.Name)
+			applied.IntervalActions.Added = append(applied.IntervalActions.Added, d.Name)
+			continue
+		}
+
+		changed := intervalActionDiffers(existing, d)
+		if changed {
+			a.ID = existing.ID
+			// the definitions don't carry the credentials of the interval action
+			a.User, a.Publisher, a.Password = existing.User, existing.Publisher, existing.Password
+			if err = updateIntervalAction(a, dbClient, scClient); err != nil {
+				return applied, err
+			}
+		}
+		if d.OverlapPolicy != "" {
+			policy, err := dbClient.GetIntervalActionOverlapPolicy(existing.ID)
+			if err != nil && err != db.ErrNotFound {
+				return applied, err
+			}
+			if policy != d.OverlapPolicy {
+				if err = setIntervalActionOverlapPolicy(existing.ID, d.OverlapPolicy, dbClient, scClient); err != nil {
+					return applied, err
+				}
+				changed = true
+			}
+		}
+
+		if !changed {
+			applied.IntervalActions.Unchanged = append(applied.IntervalActions.Unchanged, d.Name)
+			continue
+		}
+		lc.Info("updated interval action from definitions", "name", d.Name)
+		applied.IntervalActions.Updated = append(applied.IntervalActions.Updated, d.Name)
+	}
+
+	return applied, nil
+}
+
+// exportScheduleDefinitions returns the definitions of all the intervals and interval actions, ordered by name so
+// that exports of the same schedules are identical.
+func exportScheduleDefinitions(dbClient interfaces.DBClient) (models.ScheduleDefinitions, error) {
+	var definitions models.ScheduleDefinitions
+
+	intervals, err := dbClient.Intervals()
+	if err != nil {
+		return definitions, err
+	}
+	for _, i := range intervals {
+		definitions.Intervals = append(definitions.Intervals, toIntervalDefinition(i))
+	}
+	sort.Slice(definitions.Intervals, func(i, j int) bool {
+		return definitions.Intervals[i].Name < definitions.Intervals[j].Name
+	})
+
+	intervalActions, err := dbClient.IntervalActions()
+	if err != nil {
+		return definitions, err
+	}
+	for _, a := range intervalActions {
+		d := toIntervalActionDefinition(a)
+		policy, err := dbClient.GetIntervalActionOverlapPolicy(a.ID)
+		if err != nil && err != db.ErrNotFound {
+			return definitions, err
+		}
+		d.OverlapPolicy = policy
+		definitions.IntervalActions = append(definitions.IntervalActions, d)
+	}
+	sort.Slice(definitions.IntervalActions, func(i, j int) bool {
+		return definitions.IntervalActions[i].Name < definitions.IntervalActions[j].Name
+	})
+
+	return definitions, nil
+}
+
+// validateScheduleDefinitions checks the definitions before any of them is applied: names are required and unique,
+// times, frequencies, cron expressions and overlap policies must parse, and interval actions must reference an
+// interval of the definitions or of the database.
+func validateScheduleDefinitions(definitions models.ScheduleDefinitions, dbClient interfaces.DBClient) error {
+	intervals := map[string]bool{}
+	for _, d := range definitions.Intervals {
+		if d.Name == "" {
+			return errors.NewErrInvalidScheduleDefinitions("interval name is required")
+		}
+		if intervals[d.Name] {
+			return errors.NewErrInvalidScheduleDefinitions("interval " + d.Name + " is defined more than once")
+		}
+		intervals[d.Name] = true
+
+		for _, t := range []string{d.Start, d.End} {
+			if t == "" {
+				continue
+			}
+			if _, err := msToTime(t); err != nil {
+				return errors.NewErrInvalidScheduleDefinitions("interval " + d.Name + " has invalid time " + t)
+			}
+		}
+		if d.Frequency != "" {
+			if _, err := parseFrequency(d.Frequency); err != nil {
+				return errors.NewErrInvalidScheduleDefinitions("interval " + d.Name + " has invalid frequency " + d.Frequency)
+			}
+		}
+		if d.Cron != "" {
+			if _, err := cron.Parse(d.Cron); err != nil {
+				return errors.NewErrInvalidScheduleDefinitions("interval " + d.Name + " has invalid cron " + d.Cron)
+			}
+		}
+	}
+
+	intervalActions := map[string]bool{}
+	for _, d := range definitions.IntervalActions {
+		if d.Name == "" {
+			return errors.NewErrInvalidScheduleDefinitions("interval action name is required")
+		}
+		if intervalActions[d.Name] {
+			return errors.NewErrInvalidScheduleDefinitions("interval action " + d.Name + " is defined more than once")
+		}
+		intervalActions[d.Name] = true
+
+		if d.Target == "" {
+			return errors.NewErrInvalidScheduleDefinitions("interval action " + d.Name + " has no target")
+		}
+		if d.OverlapPolicy != "" && !d.OverlapPolicy.IsValid() {
+			return errors.NewErrInvalidScheduleDefinitions("interval action " + d.Name + " has invalid overlap policy " + string(d.OverlapPolicy))
+		}
+		if d.Interval == "" {
+			return errors.NewErrInvalidScheduleDefinitions("interval action " + d.Name + " has no interval")
+		}
+		if intervals[d.Interval] {
+			continue
+		}
+		if _, err := dbClient.IntervalByName(d.Interval); err != nil {
+			if err == db.ErrNotFound {
+				return errors.NewErrInvalidScheduleDefinitions("interval action " + d.Name + " references unknown interval " + d.Interval)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// intervalActionDiffers tells whether applying the definition changes the interval action. The topic of an interval
+// action is kept when the update has none, so an empty topic matches any.
+func intervalActionDiffers(a contract.IntervalAction, d models.IntervalActionDefinition) bool {
+	existing := toIntervalActionDefinition(a)
+	existing.OverlapPolicy = d.OverlapPolicy
+	if d.Topic == "" {
+		existing.Topic = ""
+	}
+	return existing != d
+}
+
+func toIntervalContract(d models.IntervalDefinition) contract.Interval {
+	return contract.Interval{
+		Name:      d.Name,
+		Start:     d.Start,
+		End:       d.End,
+		Frequency: d.Frequency,
+		Cron:      d.Cron,
+		RunOnce:   d.RunOnce,
+	}
+}
+
+func toIntervalDefinition(i contract.Interval) models.IntervalDefinition {
+	return models.IntervalDefinition{
+		Name:      i.Name,
+		Start:     i.Start,
+		End:       i.End,
+		Frequency: i.Frequency,
+		Cron:      i.Cron,
+		RunOnce:   i.RunOnce,
+	}
+}
+
+func toIntervalActionContract(d models.IntervalActionDefinition) contract.IntervalAction {
+	return contract.IntervalAction{
+		Name:       d.Name,
+		Interval:   d.Interval,
+		Address:    d.Host,
+		Port:       d.Port,
+		Protocol:   d.Protocol,
+		HTTPMethod: d.Method,
+		Target:     d.Target,
+		Path:       d.Path,
+		Parameters: d.Parameters,
+		Topic:      d.Topic,
+	}
+}
+
+func toIntervalActionDefinition(a contract.IntervalAction) models.IntervalActionDefinition {
+	return models.IntervalActionDefinition{
+		Name:       a.Name,
+		Interval:   a.Interval,
+		Host:       a.Address,
+		Port:       a.Port,
+		Protocol:   a.Protocol,
+		Method:     a.HTTPMethod,
+		Target:     a.Target,
+		Path:       a.Path,
+		Parameters: a.Parameters,
+		Topic:      a.Topic,
+	}
+}
@@ -0,0 +1,189 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	schedulerErrors "github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+var (
+	definedInterval       = models.IntervalDefinition{Name: "hourly", Start: "20200101T000000", Frequency: "1h"}
+	definedIntervalAction = models.IntervalActionDefinition{
+		Name:          "scrub-hourly",
+		Interval:      "hourly",
+		Host:          "localhost",
+		Port:          48080,
+		Protocol:      "http",
+		Method:        "DELETE",
+		Target:        "core-data",
+		Path:          "/api/v1/event/scrub",
+		OverlapPolicy: models.OverlapSkip,
+	}
+)
+
+func TestApplyScheduleDefinitionsUnchanged(t *testing.T) {
+	dbClient := &dbMock.DBClient{}
+	scClient := &dbMock.SchedulerQueueClient{}
+	existingInterval := toIntervalContract(definedInterval)
+	existingInterval.ID = "interval-id"
+	existingAction := toIntervalActionContract(definedIntervalAction)
+	existingAction.ID = "action-id"
+	dbClient.On("IntervalByName", definedInterval.Name).Return(existingInterval, nil)
+	dbClient.On("IntervalActionByName", definedIntervalAction.Name).Return(existingAction, nil)
+	dbClient.On("GetIntervalActionOverlapPolicy", existingAction.ID).Return(models.OverlapSkip, nil)
+
+	definitions := models.ScheduleDefinitions{
+		Intervals:       []models.IntervalDefinition{definedInterval},
+		IntervalActions: []models.IntervalActionDefinition{definedIntervalAction},
+	}
+	applied, err := applyScheduleDefinitions(definitions, logger.NewMockClient(), dbClient, scClient)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{definedInterval.Name}, applied.Intervals.Unchanged)
+	assert.Equal(t, []string{definedIntervalAction.Name}, applied.IntervalActions.Unchanged)
+	assert.Empty(t, applied.Intervals.Added)
+	assert.Empty(t, applied.Intervals.Updated)
+	assert.Empty(t, applied.IntervalActions.Added)
+	assert.Empty(t, applied.IntervalActions.Updated)
+	dbClient.AssertNotCalled(t, "UpdateInterval", mock.Anything)
+	dbClient.AssertNotCalled(t, "UpdateIntervalAction", mock.Anything)
+}
+
+func TestApplyScheduleDefinitionsChanges(t *testing.T) {
+	dbClient := &dbMock.DBClient{}
+	scClient := &dbMock.SchedulerQueueClient{}
+	existingInterval := toIntervalContract(definedInterval)
+	existingInterval.ID = "interval-id"
+	existingAction := toIntervalActionContract(definedIntervalAction)
+	existingAction.ID = "action-id"
+	existingAction.Path = "/api/v1/event/removeold"
+	existingAction.User = "scheduler"
+
+	added := models.IntervalDefinition{Name: "daily", Frequency: "24h"}
+	dbClient.On("IntervalByName", added.Name).Return(contract.Interval{}, db.ErrNotFound)
+	dbClient.On("AddInterval", mock.Anything).Return("daily-id", nil)
+	scClient.On("AddIntervalToQueue", mock.Anything).Return(nil)
+	dbClient.On("IntervalByName", definedInterval.Name).Return(existingInterval, nil)
+	dbClient.On("IntervalActionByName", definedIntervalAction.Name).Return(existingAction, nil)
+	dbClient.On("IntervalActionById", existingAction.ID).Return(existingAction, nil)
+	scClient.On("QueryIntervalActionByName", definedIntervalAction.Name).Return(existingAction, nil)
+	scClient.On("UpdateIntervalActionQueue", mock.Anything).Return(nil)
+	dbClient.On("UpdateIntervalAction", mock.Anything).Return(nil)
+	dbClient.On("GetIntervalActionOverlapPolicy", existingAction.ID).Return(models.OverlapPolicy(""), db.ErrNotFound)
+	dbClient.On("SetIntervalActionOverlapPolicy", existingAction.ID, models.OverlapSkip).Return(nil)
+	scClient.On("SetIntervalActionOverlapPolicy", existingAction.ID, models.OverlapSkip).Return(nil)
+
+	definitions := models.ScheduleDefinitions{
+		Intervals:       []models.IntervalDefinition{added, definedInterval},
+		IntervalActions: []models.IntervalActionDefinition{definedIntervalAction},
+	}
+	applied, err := applyScheduleDefinitions(definitions, logger.NewMockClient(), dbClient, scClient)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{added.Name}, applied.Intervals.Added)
+	assert.Equal(t, []string{definedInterval.Name}, applied.Intervals.Unchanged)
+	assert.Equal(t, []string{definedIntervalAction.Name}, applied.IntervalActions.Updated)
+	dbClient.AssertCalled(t, "UpdateIntervalAction", mock.MatchedBy(func(a contract.IntervalAction) bool {
+		return a.Path == definedIntervalAction.Path && a.User == existingAction.User
+	}))
+	scClient.AssertExpectations(t)
+}
+
+func TestValidateScheduleDefinitions(t *testing.T) {
+	dbClient := &dbMock.DBClient{}
+	dbClient.On("IntervalByName", "unknown").Return(contract.Interval{}, db.ErrNotFound)
+
+	unknownInterval := definedIntervalAction
+	unknownInterval.Interval = "unknown"
+	noTarget := definedIntervalAction
+	noTarget.Target = ""
+	badPolicy := definedIntervalAction
+	badPolicy.OverlapPolicy = "sometimes"
+
+	tests := []struct {
+		name        string
+		definitions models.ScheduleDefinitions
+		expectError bool
+	}{
+		{"Valid", models.ScheduleDefinitions{
+			Intervals:       []models.IntervalDefinition{definedInterval},
+			IntervalActions: []models.IntervalActionDefinition{definedIntervalAction}}, false},
+		{"Interval without name", models.ScheduleDefinitions{
+			Intervals: []models.IntervalDefinition{{Frequency: "1h"}}}, true},
+		{"Duplicate interval", models.ScheduleDefinitions{
+			Intervals: []models.IntervalDefinition{definedInterval, definedInterval}}, true},
+		{"Invalid start", models.ScheduleDefinitions{
+			Intervals: []models.IntervalDefinition{{Name: "bad", Start: "yesterday"}}}, true},
+		{"Invalid frequency", models.ScheduleDefinitions{
+			Intervals: []models.IntervalDefinition{{Name: "bad", Frequency: "often"}}}, true},
+		{"Invalid cron", models.ScheduleDefinitions{
+			Intervals: []models.IntervalDefinition{{Name: "bad", Cron: "every day"}}}, true},
+		{"Unknown interval", models.ScheduleDefinitions{
+			IntervalActions: []models.IntervalActionDefinition{unknownInterval}}, true},
+		{"No target", models.ScheduleDefinitions{
+			Intervals:       []models.IntervalDefinition{definedInterval},
+			IntervalActions: []models.IntervalActionDefinition{noTarget}}, true},
+		{"Invalid overlap policy", models.ScheduleDefinitions{
+			Intervals:       []models.IntervalDefinition{definedInterval},
+			IntervalActions: []models.IntervalActionDefinition{badPolicy}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScheduleDefinitions(tt.definitions, dbClient)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.IsType(t, schedulerErrors.ErrInvalidScheduleDefinitions{}, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestExportScheduleDefinitions(t *testing.T) {
+	dbClient := &dbMock.DBClient{}
+	hourly := toIntervalContract(definedInterval)
+	daily := contract.Interval{ID: "daily-id", Name: "daily", Frequency: "24h"}
+	action := toIntervalActionContract(definedIntervalAction)
+	action.ID = "action-id"
+	dbClient.On("Intervals").Return([]contract.Interval{hourly, daily}, nil)
+	dbClient.On("IntervalActions").Return([]contract.IntervalAction{action}, nil)
+	dbClient.On("GetIntervalActionOverlapPolicy", action.ID).Return(models.OverlapSkip, nil)
+
+	definitions, err := exportScheduleDefinitions(dbClient)
+	require.NoError(t, err)
+	require.Len(t, definitions.Intervals, 2)
+	assert.Equal(t, "daily", definitions.Intervals[0].Name)
+	assert.Equal(t, definedInterval, definitions.Intervals[1])
+	assert.Equal(t, []models.IntervalActionDefinition{definedIntervalAction}, definitions.IntervalActions)
+
+	// the export applies as is
+	data, err := yaml.Marshal(definitions)
+	require.NoError(t, err)
+	var decoded models.ScheduleDefinitions
+	require.NoError(t, yaml.UnmarshalStrict(data, &decoded))
+	assert.Equal(t, definitions, decoded)
+}
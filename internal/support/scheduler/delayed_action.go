@@ -0,0 +1,208 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/command"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	"github.com/google/uuid"
+)
+
+// getDelayedActions returns the delayed actions which didn't run yet, the first to run first
+func getDelayedActions(dbClient interfaces.DBClient) ([]models.DelayedAction, error) {
+	actions, err := dbClient.DelayedActions()
+	if err != nil {
+		return nil, err
+	}
+	// the layout of RunAt sorts in chronological order
+	sort.Slice(actions, func(i, j int) bool {
+		if actions[i].RunAt != actions[j].RunAt {
+			return actions[i].RunAt < actions[j].RunAt
+		}
+		return actions[i].Name < actions[j].Name
+	})
+	return actions, nil
+}
+
+func getDelayedActionByName(name string, dbClient interfaces.DBClient) (models.DelayedAction, error) {
+	action, err := dbClient.DelayedActionByName(name)
+	if err == db.ErrNotFound {
+		err = errors.NewErrDelayedActionNotFound(name)
+	}
+	return action, err
+}
+
+// addDelayedAction stores a new delayed action and adds it to the scheduler queue, and returns its id. The delay of
+// the action is resolved to the time it runs at.
+func addDelayedAction(
+	action models.DelayedAction,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) (string, error) {
+
+	if err := action.Validate(); err != nil {
+		return "", errors.NewErrInvalidDelayedAction(err.Error())
+	}
+	if err := validateDelayedAction(delayedIntervalAction(action)); err != nil {
+		return "", errors.NewErrInvalidDelayedAction(err.Error())
+	}
+	_, err := dbClient.DelayedActionByName(action.Name)
+	if err == nil {
+		return "", errors.NewErrDelayedActionNameInUse(action.Name)
+	} else if err != db.ErrNotFound {
+		return "", err
+	}
+
+	if action.Delay != "" {
+		delay, _ := time.ParseDuration(action.Delay)
+		action.RunAt = time.Now().Add(delay).UTC().Format(TIMELAYOUT)
+		action.Delay = ""
+	}
+	action.ID = uuid.New().String()
+	action.Created = db.MakeTimestamp()
+
+	if err = dbClient.AddDelayedAction(action); err != nil {
+		return "", err
+	}
+	if err = scClient.AddDelayedAction(action); err != nil {
+		return "", err
+	}
+	return action.ID, nil
+}
+
+// deleteDelayedActionByName cancels a delayed action which didn't run yet
+func deleteDelayedActionByName(
+	name string,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	err := dbClient.DeleteDelayedActionByName(name)
+	if err == db.ErrNotFound {
+		return errors.NewErrDelayedActionNotFound(name)
+	} else if err != nil {
+		return err
+	}
+	return scClient.RemoveDelayedAction(name)
+}
+
+// validateDelayedAction checks the action of a delayed action the way the action of an interval action is checked
+// when it is executed, as a delayed action which fails to run isn't retried
+func validateDelayedAction(intervalAction contract.IntervalAction) error {
+	switch {
+	case isMessageBusAction(intervalAction):
+		if intervalAction.Topic == "" {
+			return fmt.Errorf("the topic is required for %s actions", config.MessageBusProtocol)
+		}
+	case isDeviceCommandAction(intervalAction):
+		return validateDeviceCommandAction(intervalAction)
+	case !validMethod(intervalAction.HTTPMethod):
+		return fmt.Errorf("invalid httpMethod %q, %s or %s expected", intervalAction.HTTPMethod, http.MethodPost, http.MethodGet)
+	}
+	return nil
+}
+
+// delayedIntervalAction returns the interval action, without interval, executing the action of the delayed action
+func delayedIntervalAction(action models.DelayedAction) contract.IntervalAction {
+	return contract.IntervalAction{
+		ID:         action.ID,
+		Created:    action.Created,
+		Name:       action.Name,
+		Target:     action.Target,
+		Protocol:   action.Protocol,
+		HTTPMethod: action.HTTPMethod,
+		Address:    action.Address,
+		Port:       action.Port,
+		Path:       action.Path,
+		Parameters: action.Parameters,
+		Topic:      action.Topic,
+	}
+}
+
+// triggerDelayedActions runs the delayed actions which are due, each one in its own go routine. A delayed action is
+// deleted from the database before it runs and only the scheduler instance deleting it runs it, so that it runs once
+// when several instances share the database.
+func triggerDelayedActions(
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	msgClient messaging.MessageClient,
+	cmdClient command.CommandClient,
+	dbClient interfaces.DBClient) {
+
+	for _, action := range dueDelayedActions(time.Now()) {
+		err := dbClient.DeleteDelayedActionByName(action.Name)
+		if err == db.ErrNotFound {
+			lc.Debug(fmt.Sprintf("the delayed action : %s was cancelled or run by another scheduler instance", action.Name))
+			continue
+		} else if err != nil {
+			lc.Error(fmt.Sprintf("failed to delete the delayed action : %s, retrying on the next tick : %s", action.Name, err.Error()))
+			requeueDelayedAction(action)
+			continue
+		}
+
+		intervalAction := delayedIntervalAction(action)
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					lc.Error(fmt.Sprintf("delayed action : %s execution error : %v", intervalAction.Name, r))
+				}
+			}()
+			result, err := executeIntervalAction(intervalAction, lc, configuration, msgClient, cmdClient)
+			if err != nil {
+				lc.Error(fmt.Sprintf("the delayed action : %s failed : %s", intervalAction.Name, err.Error()))
+				return
+			}
+			lc.Info(fmt.Sprintf("the delayed action : %s ran", intervalAction.Name))
+			lc.Debug(fmt.Sprintf("the delayed action : %s returned : %s", intervalAction.Name, result))
+		}()
+	}
+}
+
+// dueDelayedActions removes the delayed actions due at t from the scheduler queue and returns them, the first to run
+// first
+func dueDelayedActions(t time.Time) []models.DelayedAction {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	var due []models.DelayedAction
+	for name, action := range delayedActionNameToActionMap {
+		if runAt, err := action.Time(); err != nil || !runAt.After(t) {
+			due = append(due, action)
+			delete(delayedActionNameToActionMap, name)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].RunAt < due[j].RunAt
+	})
+	return due
+}
+
+func requeueDelayedAction(action models.DelayedAction) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delayedActionNameToActionMap[action.Name] = action
+}
@@ -80,6 +80,43 @@ func NewErrIntervalActionTargetNameRequired(id string) error {
 	return ErrIntervalActionTargetNameRequired{id: id}
 }
 
+type ErrIntervalActionTopicRequired struct {
+	name string
+}
+
+func (e ErrIntervalActionTopicRequired) Error() string {
+	return fmt.Sprintf("intervalAction [ %s ] uses the message bus but no topic was provided", e.name)
+}
+
+func NewErrIntervalActionTopicRequired(name string) error {
+	return ErrIntervalActionTopicRequired{name: name}
+}
+
+type ErrIntervalActionInvalidDeviceCommand struct {
+	name   string
+	reason string
+}
+
+func (e ErrIntervalActionInvalidDeviceCommand) Error() string {
+	return fmt.Sprintf("intervalAction [ %s ] is not a valid device command: %s", e.name, e.reason)
+}
+
+func NewErrIntervalActionInvalidDeviceCommand(name string, reason string) error {
+	return ErrIntervalActionInvalidDeviceCommand{name: name, reason: reason}
+}
+
+type ErrInvalidOverlapPolicy struct {
+	policy string
+}
+
+func (e ErrInvalidOverlapPolicy) Error() string {
+	return fmt.Sprintf("invalid overlap policy: %s, must be skip, queue or parallel", e.policy)
+}
+
+func NewErrInvalidOverlapPolicy(policy string) error {
+	return ErrInvalidOverlapPolicy{policy: policy}
+}
+
 type ErrIntervalActionNameInUse struct {
 	name string
 }
@@ -150,3 +187,101 @@ func (e ErrLimitExceeded) Error() string {
 func NewErrLimitExceeded(limit int) error {
 	return ErrLimitExceeded{limit: limit}
 }
+
+// BlackoutCalendar
+type ErrBlackoutCalendarNotFound struct {
+	name string
+}
+
+func (e ErrBlackoutCalendarNotFound) Error() string {
+	return fmt.Sprintf("no blackout calendar found with name: %s", e.name)
+}
+
+func NewErrBlackoutCalendarNotFound(name string) error {
+	return ErrBlackoutCalendarNotFound{name: name}
+}
+
+type ErrBlackoutCalendarNameInUse struct {
+	name string
+}
+
+func (e ErrBlackoutCalendarNameInUse) Error() string {
+	return fmt.Sprintf("blackout calendar name: %s in use", e.name)
+}
+
+func NewErrBlackoutCalendarNameInUse(name string) error {
+	return ErrBlackoutCalendarNameInUse{name: name}
+}
+
+type ErrBlackoutCalendarStillInUse struct {
+	name string
+}
+
+func (e ErrBlackoutCalendarStillInUse) Error() string {
+	return fmt.Sprintf("blackout calendar still attached to interval(s): %s", e.name)
+}
+
+func NewErrBlackoutCalendarStillInUse(name string) error {
+	return ErrBlackoutCalendarStillInUse{name: name}
+}
+
+type ErrInvalidBlackoutCalendar struct {
+	reason string
+}
+
+func (e ErrInvalidBlackoutCalendar) Error() string {
+	return "invalid blackout calendar: " + e.reason
+}
+
+func NewErrInvalidBlackoutCalendar(reason string) error {
+	return ErrInvalidBlackoutCalendar{reason: reason}
+}
+
+type ErrInvalidScheduleDefinitions struct {
+	reason string
+}
+
+func (e ErrInvalidScheduleDefinitions) Error() string {
+	return "invalid schedule definitions: " + e.reason
+}
+
+func NewErrInvalidScheduleDefinitions(reason string) error {
+	return ErrInvalidScheduleDefinitions{reason: reason}
+}
+
+// DelayedAction
+type ErrDelayedActionNotFound struct {
+	name string
+}
+
+func (e ErrDelayedActionNotFound) Error() string {
+	return fmt.Sprintf("no delayed action found with name: %s", e.name)
+}
+
+func NewErrDelayedActionNotFound(name string) error {
+	return ErrDelayedActionNotFound{name: name}
+}
+
+type ErrDelayedActionNameInUse struct {
+	name string
+}
+
+func (e ErrDelayedActionNameInUse) Error() string {
+	return fmt.Sprintf("delayed action name: %s in use", e.name)
+}
+
+func NewErrDelayedActionNameInUse(name string) error {
+	return ErrDelayedActionNameInUse{name: name}
+}
+
+type ErrInvalidDelayedAction struct {
+	reason string
+}
+
+func (e ErrInvalidDelayedAction) Error() string {
+	return "invalid delayed action: " + e.reason
+}
+
+func NewErrInvalidDelayedAction(reason string) error {
+	return ErrInvalidDelayedAction{reason: reason}
+}
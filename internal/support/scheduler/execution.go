@@ -0,0 +1,212 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+)
+
+// executionHistorySize is the number of completed executions kept in the execution history of each interval action
+const executionHistorySize = 20
+
+// the interval action executions in flight and the executions completed last, by interval action id
+var (
+	executionMutex   sync.Mutex
+	inFlight         = make(map[string][]*models.IntervalActionExecution)
+	queuedExecution  = make(map[string][]func())
+	executionHistory = make(map[string][]models.IntervalActionExecutionResult)
+)
+
+// dispatchIntervalAction runs the interval action in its own go routine, run returns the result recorded in the
+// execution history. When an execution of the interval action is still in flight the overlap policy decides whether
+// the execution is skipped, queued behind it or run alongside it.
+func dispatchIntervalAction(
+	intervalAction contract.IntervalAction,
+	intervalName string,
+	policy models.OverlapPolicy,
+	run func() (string, error),
+	lc logger.LoggingClient) {
+
+	executionMutex.Lock()
+	defer executionMutex.Unlock()
+
+	if len(inFlight[intervalAction.ID]) > 0 {
+		switch policy {
+		case models.OverlapParallel:
+			lc.Debug(fmt.Sprintf("the interval action : %s is in flight, running the next execution in parallel", intervalAction.Name))
+		case models.OverlapQueue:
+			lc.Debug(fmt.Sprintf("the interval action : %s is in flight, queueing the next execution", intervalAction.Name))
+			queuedExecution[intervalAction.ID] = append(queuedExecution[intervalAction.ID], func() {
+				startExecution(intervalAction, intervalName, policy, run, lc)
+			})
+			updateQueued(intervalAction.ID)
+			return
+		default:
+			lc.Warn(fmt.Sprintf("the interval action : %s is still in flight, skipping the execution", intervalAction.Name))
+			return
+		}
+	}
+
+	startExecution(intervalAction, intervalName, policy, run, lc)
+}
+
+// startExecution records the execution in flight and runs it. The caller holds the executionMutex.
+func startExecution(
+	intervalAction contract.IntervalAction,
+	intervalName string,
+	policy models.OverlapPolicy,
+	run func() (string, error),
+	lc logger.LoggingClient) {
+
+	execution := &models.IntervalActionExecution{
+		IntervalAction: intervalAction.Name,
+		Interval:       intervalName,
+		OverlapPolicy:  policy,
+		Started:        db.MakeTimestamp(),
+		Queued:         len(queuedExecution[intervalAction.ID]),
+	}
+	inFlight[intervalAction.ID] = append(inFlight[intervalAction.ID], execution)
+
+	go func() {
+		var result string
+		var err error
+		defer func() {
+			completeExecution(intervalAction.ID, execution, result, err)
+		}()
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v", r)
+				lc.Error(fmt.Sprintf("interval action : %s execution error : %v", intervalAction.Name, r))
+			}
+		}()
+		result, err = run()
+	}()
+}
+
+// completeExecution records the result of the execution in the execution history, removes the execution from the
+// executions in flight and starts the next queued execution
+func completeExecution(intervalActionId string, execution *models.IntervalActionExecution, result string, err error) {
+	executionMutex.Lock()
+	defer executionMutex.Unlock()
+
+	completed := models.IntervalActionExecutionResult{
+		IntervalAction: execution.IntervalAction,
+		Interval:       execution.Interval,
+		Started:        execution.Started,
+		Completed:      db.MakeTimestamp(),
+		Succeeded:      err == nil,
+		Result:         result,
+	}
+	if err != nil {
+		completed.Result = err.Error()
+	}
+	history := append([]models.IntervalActionExecutionResult{completed}, executionHistory[intervalActionId]...)
+	if len(history) > executionHistorySize {
+		history = history[:executionHistorySize]
+	}
+	executionHistory[intervalActionId] = history
+
+	executions := inFlight[intervalActionId]
+	for i, e := range executions {
+		if e == execution {
+			executions = append(executions[:i], executions[i+1:]...)
+			break
+		}
+	}
+	if len(executions) == 0 {
+		delete(inFlight, intervalActionId)
+	} else {
+		inFlight[intervalActionId] = executions
+	}
+
+	if queued := queuedExecution[intervalActionId]; len(queued) > 0 {
+		next := queued[0]
+		if len(queued) == 1 {
+			delete(queuedExecution, intervalActionId)
+		} else {
+			queuedExecution[intervalActionId] = queued[1:]
+		}
+		next()
+	}
+	updateQueued(intervalActionId)
+}
+
+// updateQueued refreshes the number of queued executions reported by the executions in flight. The caller holds the
+// executionMutex.
+func updateQueued(intervalActionId string) {
+	for _, e := range inFlight[intervalActionId] {
+		e.Queued = len(queuedExecution[intervalActionId])
+	}
+}
+
+// inFlightExecutions returns a copy of the executions in flight, the oldest first
+func inFlightExecutions() []models.IntervalActionExecution {
+	executionMutex.Lock()
+	defer executionMutex.Unlock()
+
+	executions := make([]models.IntervalActionExecution, 0, len(inFlight))
+	for _, actionExecutions := range inFlight {
+		for _, e := range actionExecutions {
+			executions = append(executions, *e)
+		}
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].Started < executions[j].Started
+	})
+	return executions
+}
+
+// overlapPolicy returns the overlap policy of the interval action if it has one, otherwise the default policy
+func overlapPolicy(intervalActionId string, configuration *config.ConfigurationStruct) models.OverlapPolicy {
+	mutex.Lock()
+	policy, exists := intervalActionIdToOverlapPolicyMap[intervalActionId]
+	mutex.Unlock()
+
+	if exists {
+		return policy
+	}
+	if policy = models.OverlapPolicy(configuration.Writable.DefaultOverlapPolicy); policy.IsValid() {
+		return policy
+	}
+	return models.OverlapSkip
+}
+
+// executionResults returns a copy of the execution history of the interval action, the latest first
+func executionResults(intervalActionId string) []models.IntervalActionExecutionResult {
+	executionMutex.Lock()
+	defer executionMutex.Unlock()
+
+	history := executionHistory[intervalActionId]
+	results := make([]models.IntervalActionExecutionResult, len(history))
+	copy(results, history)
+	return results
+}
+
+// deleteExecutionHistory drops the execution history of the interval action
+func deleteExecutionHistory(intervalActionId string) {
+	executionMutex.Lock()
+	defer executionMutex.Unlock()
+
+	delete(executionHistory, intervalActionId)
+}
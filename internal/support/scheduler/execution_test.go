@@ -0,0 +1,128 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchIntervalAction(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		policy           models.OverlapPolicy
+		expectedInFlight int
+		expectedQueued   int
+		expectedRuns     int32
+	}{
+		{models.OverlapSkip, 1, 0, 1},
+		{models.OverlapQueue, 1, 2, 3},
+		{models.OverlapParallel, 3, 0, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			action := contract.IntervalAction{ID: "id-" + string(tt.policy), Name: "scrub-" + string(tt.policy)}
+			release := make(chan struct{})
+			var runs int32
+			run := func() (string, error) {
+				atomic.AddInt32(&runs, 1)
+				<-release
+				return "", nil
+			}
+
+			// the action is due three times while its first execution is in flight
+			for i := 0; i < 3; i++ {
+				dispatchIntervalAction(action, "midnight", tt.policy, run, lc)
+			}
+
+			executions := executionsOf(action.Name)
+			require.Len(t, executions, tt.expectedInFlight)
+			assert.Equal(t, tt.expectedQueued, executions[0].Queued)
+			assert.Equal(t, "midnight", executions[0].Interval)
+			assert.Equal(t, tt.policy, executions[0].OverlapPolicy)
+
+			close(release)
+			require.Eventually(t, func() bool {
+				return len(executionsOf(action.Name)) == 0
+			}, time.Second, time.Millisecond)
+			assert.Equal(t, tt.expectedRuns, atomic.LoadInt32(&runs))
+			assert.Len(t, executionResults(action.ID), int(tt.expectedRuns), "each execution must be recorded in the history")
+		})
+	}
+}
+
+func TestExecutionHistory(t *testing.T) {
+	lc := logger.NewMockClient()
+	action := contract.IntervalAction{ID: "id-history", Name: "close-valve"}
+	defer deleteExecutionHistory(action.ID)
+
+	outcomes := []error{nil, errors.New("device is locked")}
+	for i := 0; i < executionHistorySize+1; i++ {
+		err := outcomes[i%2]
+		dispatchIntervalAction(action, "evening", models.OverlapQueue, func() (string, error) {
+			return "done", err
+		}, lc)
+		require.Eventually(t, func() bool {
+			return len(executionsOf(action.Name)) == 0
+		}, time.Second, time.Millisecond)
+	}
+
+	history := executionResults(action.ID)
+	require.Len(t, history, executionHistorySize, "the history must be capped")
+	assert.True(t, history[0].Succeeded, "the latest execution must come first")
+	assert.Equal(t, "done", history[0].Result)
+	assert.False(t, history[1].Succeeded)
+	assert.Equal(t, "device is locked", history[1].Result)
+	assert.Equal(t, "evening", history[1].Interval)
+	assert.True(t, history[1].Completed >= history[1].Started)
+
+	deleteExecutionHistory(action.ID)
+	assert.Empty(t, executionResults(action.ID))
+}
+
+func TestOverlapPolicy(t *testing.T) {
+	clearMaps()
+	intervalActionIdToOverlapPolicyMap["queued"] = models.OverlapQueue
+
+	configuration := &config.ConfigurationStruct{}
+	assert.Equal(t, models.OverlapQueue, overlapPolicy("queued", configuration))
+	assert.Equal(t, models.OverlapSkip, overlapPolicy("other", configuration), "skip must apply without default")
+
+	configuration.Writable.DefaultOverlapPolicy = string(models.OverlapParallel)
+	assert.Equal(t, models.OverlapParallel, overlapPolicy("other", configuration))
+}
+
+func executionsOf(intervalAction string) []models.IntervalActionExecution {
+	var executions []models.IntervalActionExecution
+	for _, e := range inFlightExecutions() {
+		if e.IntervalAction == intervalAction {
+			executions = append(executions, e)
+		}
+	}
+	return executions
+}
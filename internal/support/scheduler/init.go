@@ -22,11 +22,20 @@ import (
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/endpoints"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/command"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
 
 	"github.com/gorilla/mux"
 )
@@ -44,7 +53,7 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the scheduler service.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
 
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -58,14 +67,58 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		},
 	})
 
-	err := LoadScheduler(lc, container.DBClientFrom(dic.Get), scClient, configuration)
+	var msgClient messaging.MessageClient
+	if configuration.MessageQueue.Type != "" {
+		var ok bool
+		msgClient, ok = connectMessageBus(ctx, wg, startupTimer, lc, configuration)
+		if !ok {
+			return false
+		}
+		dic.Update(di.ServiceConstructorMap{
+			schedulerContainer.MessagingClientName: func(get di.Get) interface{} {
+				return msgClient
+			},
+		})
+	}
+
+	// DEVICECOMMAND interval actions issue their commands through core-command
+	var cmdClient command.CommandClient
+	if commandInfo, ok := configuration.Clients["Command"]; ok {
+		cmdClient = command.NewCommandClient(endpoints.NewURLClient(
+			container.EndpointsRegistryFrom(dic.Get), "Command", commandInfo, clients.ApiDeviceRoute))
+		dic.Update(di.ServiceConstructorMap{
+			schedulerContainer.CommandClientName: func(get di.Get) interface{} {
+				return cmdClient
+			},
+		})
+	}
+
+	// the interval actions failing consecutively are alerted through support-notifications
+	var notificationsClient notifications.NotificationsClient
+	if notificationsInfo, ok := configuration.Clients["Notifications"]; ok {
+		notificationsClient = notifications.NewNotificationsClient(endpoints.NewURLClient(
+			container.EndpointsRegistryFrom(dic.Get), "Notifications", notificationsInfo, clients.ApiNotificationRoute))
+		dic.Update(di.ServiceConstructorMap{
+			schedulerContainer.NotificationsClientName: func(get di.Get) interface{} {
+				return notificationsClient
+			},
+		})
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	lock, _ := dbClient.(interfaces.ExecutionLock)
+	if lock == nil && configuration.Writable.ExecutionLock.Enabled {
+		lc.Warn("the database doesn't support execution locks, intervals fire on every scheduler instance")
+	}
+
+	err := LoadScheduler(lc, dbClient, scClient, configuration)
 	if err != nil {
 		lc.Error(fmt.Sprintf("Failed to load schedules and events %s", err.Error()))
 		return false
 	}
 
 	ticker := time.NewTicker(time.Duration(configuration.Writable.ScheduleIntervalTime) * time.Millisecond)
-	StartTicker(ticker, lc, configuration)
+	StartTicker(ticker, lc, configuration, msgClient, cmdClient, notificationsClient, lock, dbClient)
 
 	wg.Add(1)
 	go func() {
@@ -77,3 +130,64 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 
 	return true
 }
+
+// connectMessageBus creates and connects the messaging client used by MESSAGEBUS interval actions and disconnects it
+// when the service is exiting.
+func connectMessageBus(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	startupTimer startup.Timer,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct) (messaging.MessageClient, bool) {
+
+	msgClient, err := messaging.NewMessageClient(
+		msgTypes.MessageBusConfig{
+			PublishHost: msgTypes.HostInfo{
+				Host:     configuration.MessageQueue.Host,
+				Port:     configuration.MessageQueue.Port,
+				Protocol: configuration.MessageQueue.Protocol,
+			},
+			Type:     configuration.MessageQueue.Type,
+			Optional: configuration.MessageQueue.Optional,
+		})
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to create messaging client: %s", err.Error()))
+		return nil, false
+	}
+
+	for startupTimer.HasNotElapsed() {
+		err = msgClient.Connect()
+		if err == nil {
+			break
+		}
+
+		lc.Warn(fmt.Sprintf("couldn't connect to message bus: %s", err.Error()))
+		startupTimer.SleepForInterval()
+	}
+
+	if err != nil {
+		lc.Error("failed to connect to message bus in allotted time")
+		return nil, false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		if err := msgClient.Disconnect(); err != nil {
+			lc.Error("failed to disconnect from the Message Bus")
+			return
+		}
+		lc.Info("Message Bus disconnected")
+	}()
+
+	lc.Info(fmt.Sprintf(
+		"Connected to %s Message Bus @ %s://%s:%d for MESSAGEBUS interval actions",
+		configuration.MessageQueue.Type,
+		configuration.MessageQueue.Protocol,
+		configuration.MessageQueue.Host,
+		configuration.MessageQueue.Port))
+
+	return msgClient, true
+}
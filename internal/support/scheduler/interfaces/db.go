@@ -14,6 +14,8 @@
 package interfaces
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -72,6 +74,45 @@ type DBClient interface {
 	// Remove IntervalAction by id
 	DeleteIntervalActionById(id string) error
 
+	// Set the OverlapPolicy of an IntervalAction by id
+	SetIntervalActionOverlapPolicy(id string, policy models.OverlapPolicy) error
+
+	// Get the OverlapPolicy of an IntervalAction by id, db.ErrNotFound when it has none
+	GetIntervalActionOverlapPolicy(id string) (models.OverlapPolicy, error)
+
+	// Remove the OverlapPolicy of an IntervalAction by id
+	DeleteIntervalActionOverlapPolicy(id string) error
+
+	// Return all the blackout calendars
+	BlackoutCalendars() ([]models.BlackoutCalendar, error)
+
+	// Return a blackout calendar by name
+	BlackoutCalendarByName(name string) (models.BlackoutCalendar, error)
+
+	// Add a blackout calendar or replace the calendar with the same name
+	SetBlackoutCalendar(calendar models.BlackoutCalendar) error
+
+	// Remove a blackout calendar by name
+	DeleteBlackoutCalendarByName(name string) error
+
+	// Attach the named blackout calendars to an Interval by id, detach them all when names is empty
+	SetIntervalBlackoutCalendars(intervalId string, names []string) error
+
+	// Get the names of the blackout calendars attached to an Interval by id, db.ErrNotFound when it has none
+	IntervalBlackoutCalendars(intervalId string) ([]string, error)
+
+	// Return all the delayed actions
+	DelayedActions() ([]models.DelayedAction, error)
+
+	// Return a delayed action by name
+	DelayedActionByName(name string) (models.DelayedAction, error)
+
+	// Add a delayed action
+	AddDelayedAction(action models.DelayedAction) error
+
+	// Remove a delayed action by name, db.ErrNotFound when it was already removed
+	DeleteDelayedActionByName(name string) error
+
 	// ************************** UTILITY FUNCTION(S) ***************************
 
 	// Scrub all scheduler interval actions from the database data (only used in test)
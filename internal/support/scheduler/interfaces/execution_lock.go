@@ -0,0 +1,27 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package interfaces
+
+import (
+	"time"
+)
+
+// ExecutionLock is implemented by databases able to coordinate interval executions between scheduler instances
+// sharing the database, so that each interval fires on a single instance.
+type ExecutionLock interface {
+	// TryIntervalLock acquires the execution lock of the interval's slot for owner and ttl. It returns false without
+	// error when another scheduler instance holds the lock, having executed the slot already.
+	TryIntervalLock(intervalId string, slot int64, owner string, ttl time.Duration) (bool, error)
+}
@@ -4,12 +4,27 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 // DBClient is an autogenerated mock type for the DBClient type
 type DBClient struct {
 	mock.Mock
 }
 
+// AddDelayedAction provides a mock function with given fields: action
+func (_m *DBClient) AddDelayedAction(action schedulerModels.DelayedAction) error {
+	ret := _m.Called(action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(schedulerModels.DelayedAction) error); ok {
+		r0 = rf(action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddInterval provides a mock function with given fields: interval
 func (_m *DBClient) AddInterval(interval models.Interval) (string, error) {
 	ret := _m.Called(interval)
@@ -52,11 +67,127 @@ func (_m *DBClient) AddIntervalAction(intervalAction models.IntervalAction) (str
 	return r0, r1
 }
 
+// BlackoutCalendarByName provides a mock function with given fields: name
+func (_m *DBClient) BlackoutCalendarByName(name string) (schedulerModels.BlackoutCalendar, error) {
+	ret := _m.Called(name)
+
+	var r0 schedulerModels.BlackoutCalendar
+	if rf, ok := ret.Get(0).(func(string) schedulerModels.BlackoutCalendar); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.BlackoutCalendar)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlackoutCalendars provides a mock function with given fields:
+func (_m *DBClient) BlackoutCalendars() ([]schedulerModels.BlackoutCalendar, error) {
+	ret := _m.Called()
+
+	var r0 []schedulerModels.BlackoutCalendar
+	if rf, ok := ret.Get(0).(func() []schedulerModels.BlackoutCalendar); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.BlackoutCalendar)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with given fields:
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// DelayedActionByName provides a mock function with given fields: name
+func (_m *DBClient) DelayedActionByName(name string) (schedulerModels.DelayedAction, error) {
+	ret := _m.Called(name)
+
+	var r0 schedulerModels.DelayedAction
+	if rf, ok := ret.Get(0).(func(string) schedulerModels.DelayedAction); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.DelayedAction)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DelayedActions provides a mock function with given fields:
+func (_m *DBClient) DelayedActions() ([]schedulerModels.DelayedAction, error) {
+	ret := _m.Called()
+
+	var r0 []schedulerModels.DelayedAction
+	if rf, ok := ret.Get(0).(func() []schedulerModels.DelayedAction); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.DelayedAction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBlackoutCalendarByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteBlackoutCalendarByName(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDelayedActionByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDelayedActionByName(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteIntervalActionById provides a mock function with given fields: id
 func (_m *DBClient) DeleteIntervalActionById(id string) error {
 	ret := _m.Called(id)
@@ -71,6 +202,20 @@ func (_m *DBClient) DeleteIntervalActionById(id string) error {
 	return r0
 }
 
+// DeleteIntervalActionOverlapPolicy provides a mock function with given fields: id
+func (_m *DBClient) DeleteIntervalActionOverlapPolicy(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteIntervalById provides a mock function with given fields: id
 func (_m *DBClient) DeleteIntervalById(id string) error {
 	ret := _m.Called(id)
@@ -85,6 +230,27 @@ func (_m *DBClient) DeleteIntervalById(id string) error {
 	return r0
 }
 
+// GetIntervalActionOverlapPolicy provides a mock function with given fields: id
+func (_m *DBClient) GetIntervalActionOverlapPolicy(id string) (schedulerModels.OverlapPolicy, error) {
+	ret := _m.Called(id)
+
+	var r0 schedulerModels.OverlapPolicy
+	if rf, ok := ret.Get(0).(func(string) schedulerModels.OverlapPolicy); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(schedulerModels.OverlapPolicy)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IntervalActionById provides a mock function with given fields: id
 func (_m *DBClient) IntervalActionById(id string) (models.IntervalAction, error) {
 	ret := _m.Called(id)
@@ -219,6 +385,29 @@ func (_m *DBClient) IntervalActionsWithLimit(limit int) ([]models.IntervalAction
 	return r0, r1
 }
 
+// IntervalBlackoutCalendars provides a mock function with given fields: intervalId
+func (_m *DBClient) IntervalBlackoutCalendars(intervalId string) ([]string, error) {
+	ret := _m.Called(intervalId)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(intervalId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(intervalId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IntervalById provides a mock function with given fields: id
 func (_m *DBClient) IntervalById(id string) (models.Interval, error) {
 	ret := _m.Called(id)
@@ -349,6 +538,48 @@ func (_m *DBClient) ScrubAllIntervals() (int, error) {
 	return r0, r1
 }
 
+// SetBlackoutCalendar provides a mock function with given fields: calendar
+func (_m *DBClient) SetBlackoutCalendar(calendar schedulerModels.BlackoutCalendar) error {
+	ret := _m.Called(calendar)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(schedulerModels.BlackoutCalendar) error); ok {
+		r0 = rf(calendar)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalActionOverlapPolicy provides a mock function with given fields: id, policy
+func (_m *DBClient) SetIntervalActionOverlapPolicy(id string, policy schedulerModels.OverlapPolicy) error {
+	ret := _m.Called(id, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, schedulerModels.OverlapPolicy) error); ok {
+		r0 = rf(id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalBlackoutCalendars provides a mock function with given fields: intervalId, names
+func (_m *DBClient) SetIntervalBlackoutCalendars(intervalId string, names []string) error {
+	ret := _m.Called(intervalId, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(intervalId, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateInterval provides a mock function with given fields: interval
 func (_m *DBClient) UpdateInterval(interval models.Interval) error {
 	ret := _m.Called(interval)
@@ -4,12 +4,27 @@ package mocks
 
 import mock "github.com/stretchr/testify/mock"
 import models "github.com/edgexfoundry/go-mod-core-contracts/models"
+import schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 // SchedulerQueueClient is an autogenerated mock type for the SchedulerQueueClient type
 type SchedulerQueueClient struct {
 	mock.Mock
 }
 
+// AddDelayedAction provides a mock function with given fields: action
+func (_m *SchedulerQueueClient) AddDelayedAction(action schedulerModels.DelayedAction) error {
+	ret := _m.Called(action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(schedulerModels.DelayedAction) error); ok {
+		r0 = rf(action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddIntervalActionToQueue provides a mock function with given fields: intervalAction
 func (_m *SchedulerQueueClient) AddIntervalActionToQueue(intervalAction models.IntervalAction) error {
 	ret := _m.Called(intervalAction)
@@ -59,6 +74,38 @@ func (_m *SchedulerQueueClient) Connect() (string, error) {
 	return r0, r1
 }
 
+// QueryIntervalActionHistory provides a mock function with given fields: intervalActionId
+func (_m *SchedulerQueueClient) QueryIntervalActionHistory(intervalActionId string) []schedulerModels.IntervalActionExecutionResult {
+	ret := _m.Called(intervalActionId)
+
+	var r0 []schedulerModels.IntervalActionExecutionResult
+	if rf, ok := ret.Get(0).(func(string) []schedulerModels.IntervalActionExecutionResult); ok {
+		r0 = rf(intervalActionId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.IntervalActionExecutionResult)
+		}
+	}
+
+	return r0
+}
+
+// QueryInFlightExecutions provides a mock function with given fields:
+func (_m *SchedulerQueueClient) QueryInFlightExecutions() []schedulerModels.IntervalActionExecution {
+	ret := _m.Called()
+
+	var r0 []schedulerModels.IntervalActionExecution
+	if rf, ok := ret.Get(0).(func() []schedulerModels.IntervalActionExecution); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.IntervalActionExecution)
+		}
+	}
+
+	return r0
+}
+
 // QueryIntervalActionByID provides a mock function with given fields: intervalActionId
 func (_m *SchedulerQueueClient) QueryIntervalActionByID(intervalActionId string) (models.IntervalAction, error) {
 	ret := _m.Called(intervalActionId)
@@ -143,6 +190,57 @@ func (_m *SchedulerQueueClient) QueryIntervalByName(intervalName string) (models
 	return r0, r1
 }
 
+// QueryIntervalNextExecutions provides a mock function with given fields: intervalName, count
+func (_m *SchedulerQueueClient) QueryIntervalNextExecutions(intervalName string, count int) ([]schedulerModels.ScheduledExecution, error) {
+	ret := _m.Called(intervalName, count)
+
+	var r0 []schedulerModels.ScheduledExecution
+	if rf, ok := ret.Get(0).(func(string, int) []schedulerModels.ScheduledExecution); ok {
+		r0 = rf(intervalName, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulerModels.ScheduledExecution)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(intervalName, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveBlackoutCalendar provides a mock function with given fields: name
+func (_m *SchedulerQueueClient) RemoveBlackoutCalendar(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveDelayedAction provides a mock function with given fields: name
+func (_m *SchedulerQueueClient) RemoveDelayedAction(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveIntervalActionQueue provides a mock function with given fields: intervalActionId
 func (_m *SchedulerQueueClient) RemoveIntervalActionQueue(intervalActionId string) error {
 	ret := _m.Called(intervalActionId)
@@ -171,6 +269,48 @@ func (_m *SchedulerQueueClient) RemoveIntervalInQueue(intervalId string) error {
 	return r0
 }
 
+// SetBlackoutCalendar provides a mock function with given fields: calendar
+func (_m *SchedulerQueueClient) SetBlackoutCalendar(calendar schedulerModels.BlackoutCalendar) error {
+	ret := _m.Called(calendar)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(schedulerModels.BlackoutCalendar) error); ok {
+		r0 = rf(calendar)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalActionOverlapPolicy provides a mock function with given fields: intervalActionId, policy
+func (_m *SchedulerQueueClient) SetIntervalActionOverlapPolicy(intervalActionId string, policy schedulerModels.OverlapPolicy) error {
+	ret := _m.Called(intervalActionId, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, schedulerModels.OverlapPolicy) error); ok {
+		r0 = rf(intervalActionId, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetIntervalBlackoutCalendars provides a mock function with given fields: intervalId, names
+func (_m *SchedulerQueueClient) SetIntervalBlackoutCalendars(intervalId string, names []string) error {
+	ret := _m.Called(intervalId, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(intervalId, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateIntervalActionQueue provides a mock function with given fields: intervalAction
 func (_m *SchedulerQueueClient) UpdateIntervalActionQueue(intervalAction models.IntervalAction) error {
 	ret := _m.Called(intervalAction)
@@ -14,6 +14,8 @@
 package interfaces
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
 
@@ -36,6 +38,9 @@ type SchedulerQueueClient interface {
 	// Remote the Interval from the Scheduler Queue
 	RemoveIntervalInQueue(intervalId string) error
 
+	// Return up to count upcoming executions of the Interval by Name from the Scheduler Interval Context
+	QueryIntervalNextExecutions(intervalName string, count int) ([]models.ScheduledExecution, error)
+
 	// ************************* INTERVAL ACTIONS *******************************
 
 	// Return IntervalAction by ID from the Scheduler IntervalAction Context
@@ -53,6 +58,34 @@ type SchedulerQueueClient interface {
 	// Remove IntervalAction from the Scheduler Queue
 	RemoveIntervalActionQueue(intervalActionId string) error
 
+	// Set the OverlapPolicy of an IntervalAction in the Scheduler Queue, the default policy applies when empty
+	SetIntervalActionOverlapPolicy(intervalActionId string, policy models.OverlapPolicy) error
+
+	// ************************* BLACKOUT CALENDARS *******************************
+
+	// Add a blackout calendar to the Scheduler Queue or replace the calendar with the same name
+	SetBlackoutCalendar(calendar models.BlackoutCalendar) error
+
+	// Remove a blackout calendar from the Scheduler Queue
+	RemoveBlackoutCalendar(name string) error
+
+	// Attach the named blackout calendars to an Interval in the Scheduler Queue, detach them all when names is empty
+	SetIntervalBlackoutCalendars(intervalId string, names []string) error
+
+	// ************************* DELAYED ACTIONS *******************************
+
+	// Add a delayed action to the Scheduler Queue, it runs once at its time
+	AddDelayedAction(action models.DelayedAction) error
+
+	// Remove a delayed action from the Scheduler Queue, nothing happens when it isn't queued
+	RemoveDelayedAction(name string) error
+
+	// Return the IntervalAction executions in flight
+	QueryInFlightExecutions() []models.IntervalActionExecution
+
+	// Return the last completed executions of an IntervalAction, the latest first
+	QueryIntervalActionHistory(intervalActionId string) []models.IntervalActionExecutionResult
+
 	// Check if we can connect to Scheduler Queue
 	Connect() (string, error)
 }
@@ -19,8 +19,10 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 func addNewIntervalAction(
@@ -42,6 +44,18 @@ func addNewIntervalAction(
 		return "", errors.NewErrIntervalActionTargetNameRequired(intervalAction.ID)
 	}
 
+	// Validate the Topic for message bus actions
+	if isMessageBusAction(intervalAction) && intervalAction.Topic == "" {
+		return "", errors.NewErrIntervalActionTopicRequired(name)
+	}
+
+	// Validate the device and command of device command actions
+	if isDeviceCommandAction(intervalAction) {
+		if err := validateDeviceCommandAction(intervalAction); err != nil {
+			return "", errors.NewErrIntervalActionInvalidDeviceCommand(name, err.Error())
+		}
+	}
+
 	// Validate the Interval
 	interval := intervalAction.Interval
 	if interval != "" {
@@ -167,12 +181,29 @@ func updateIntervalAction(
 	if protocol != to.Protocol {
 		to.Protocol = protocol
 	}
+	// Path
+	path := from.Path
+	if path != to.Path {
+		to.Path = path
+	}
 	// Parameters
 	params := from.Parameters
 	if params != to.Parameters {
 		to.Parameters = params
 	}
 
+	// Validate the Topic for message bus actions
+	if isMessageBusAction(to) && to.Topic == "" {
+		return errors.NewErrIntervalActionTopicRequired(to.Name)
+	}
+
+	// Validate the device and command of device command actions
+	if isDeviceCommandAction(to) {
+		if err := validateDeviceCommandAction(to); err != nil {
+			return errors.NewErrIntervalActionInvalidDeviceCommand(to.Name, err.Error())
+		}
+	}
+
 	// Validate the IntervalAction does not exist in the scheduler queue
 	_, err = scClient.QueryIntervalActionByName(to.Name)
 	if err == nil {
@@ -310,6 +341,42 @@ func deleteIntervalAction(intervalAction contract.IntervalAction, dbClient inter
 	return nil
 }
 
+// setIntervalActionOverlapPolicy stores the overlap policy of the interval action and applies it to the scheduler
+// queue. An empty policy restores the default policy.
+func setIntervalActionOverlapPolicy(
+	id string,
+	policy models.OverlapPolicy,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	if policy == "" {
+		if err := dbClient.DeleteIntervalActionOverlapPolicy(id); err != nil && err != db.ErrNotFound {
+			return err
+		}
+	} else {
+		if !policy.IsValid() {
+			return errors.NewErrInvalidOverlapPolicy(string(policy))
+		}
+		if err := dbClient.SetIntervalActionOverlapPolicy(id, policy); err != nil {
+			return err
+		}
+	}
+	return scClient.SetIntervalActionOverlapPolicy(id, policy)
+}
+
+// getIntervalActionOverlapPolicy returns the overlap policy of the interval action, the default policy when it has none
+func getIntervalActionOverlapPolicy(
+	id string,
+	dbClient interfaces.DBClient,
+	configuration *config.ConfigurationStruct) (models.OverlapPolicy, error) {
+
+	policy, err := dbClient.GetIntervalActionOverlapPolicy(id)
+	if err == db.ErrNotFound {
+		return overlapPolicy(id, configuration), nil
+	}
+	return policy, err
+}
+
 func scrubAllInteralActions(lc logger.LoggingClient, dbClient interfaces.DBClient) (int, error) {
 	lc.Info("Scrubbing All IntervalAction(s).")
 
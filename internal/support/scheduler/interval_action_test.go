@@ -20,6 +20,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 
+	schedulerErrors "github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
 )
 
@@ -166,6 +167,59 @@ func TestUpdateIntervalAction(t *testing.T) {
 	myMock.AssertExpectations(t)
 }
 
+func TestUpdateIntervalActionMessageBusRequiresTopic(t *testing.T) {
+	reset()
+	myMock := &dbMock.DBClient{}
+	mySchedulerMock := &dbMock.SchedulerQueueClient{}
+
+	myMock.On("IntervalActionById",
+		mock.Anything).Return(models.IntervalAction{Name: testIntervalActionName}, nil)
+
+	myMock.On("IntervalByName",
+		mock.Anything).Return(models.Interval{}, nil)
+
+	nIntervalAction := models.IntervalAction{
+		Name:     testIntervalActionName,
+		Target:   testIntervalActionTarget,
+		Interval: testIntervalActionInterval,
+		Protocol: "messagebus",
+	}
+
+	err := updateIntervalAction(nIntervalAction, myMock, mySchedulerMock)
+	if _, ok := err.(schedulerErrors.ErrIntervalActionTopicRequired); !ok {
+		t.Fatalf("expected ErrIntervalActionTopicRequired, got %v", err)
+	}
+
+	myMock.AssertNotCalled(t, "UpdateIntervalAction", mock.Anything)
+}
+
+func TestUpdateIntervalActionDeviceCommandRequiresCommand(t *testing.T) {
+	reset()
+	myMock := &dbMock.DBClient{}
+	mySchedulerMock := &dbMock.SchedulerQueueClient{}
+
+	myMock.On("IntervalActionById",
+		mock.Anything).Return(models.IntervalAction{Name: testIntervalActionName}, nil)
+
+	myMock.On("IntervalByName",
+		mock.Anything).Return(models.Interval{}, nil)
+
+	nIntervalAction := models.IntervalAction{
+		Name:       testIntervalActionName,
+		Target:     testIntervalActionTarget,
+		Interval:   testIntervalActionInterval,
+		Protocol:   "DEVICECOMMAND",
+		HTTPMethod: "PUT",
+	}
+
+	err := updateIntervalAction(nIntervalAction, myMock, mySchedulerMock)
+	if _, ok := err.(schedulerErrors.ErrIntervalActionInvalidDeviceCommand); !ok {
+		t.Fatalf("expected ErrIntervalActionInvalidDeviceCommand, got %v", err)
+	}
+
+	myMock.AssertNotCalled(t, "UpdateIntervalAction", mock.Anything)
+}
+
 func TestDeleteIntervalActionById(t *testing.T) {
 	reset()
 
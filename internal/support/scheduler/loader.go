@@ -18,8 +18,11 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 // Utility function for adding configured locally intervals and scheduled events
@@ -55,6 +58,12 @@ func LoadScheduler(
 		return errLCA
 	}
 
+	// apply the schedule definitions file
+	errSD := loadScheduleDefinitionsFile(configuration.ScheduleDefinitions.File, lc, dbClient, scClient)
+	if errSD != nil {
+		return errSD
+	}
+
 	lc.Info("finished loading intervals, interval actions")
 
 	return nil
@@ -132,10 +141,66 @@ func addReceivedIntervals(
 	return nil
 }
 
+// Add the blackout calendars to scheduler memory, and attach them to the received intervals
+func addReceivedBlackoutCalendars(
+	intervals []contract.Interval,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	calendars, err := dbClient.BlackoutCalendars()
+	if err != nil {
+		lc.Error("problem querying the blackout calendars", "message", err.Error())
+		return err
+	}
+	for _, calendar := range calendars {
+		if err = scClient.SetBlackoutCalendar(calendar); err != nil {
+			return err
+		}
+		lc.Info("added blackout calendar", "name", calendar.Name)
+	}
+
+	for _, interval := range intervals {
+		names, err := dbClient.IntervalBlackoutCalendars(interval.ID)
+		if err == db.ErrNotFound {
+			continue
+		} else if err != nil {
+			lc.Error("problem querying the blackout calendars of interval", "name", interval.Name, "message", err.Error())
+			return err
+		}
+		if err = scClient.SetIntervalBlackoutCalendars(interval.ID, names); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add the delayed actions which didn't run yet to scheduler memory, the ones due while the scheduler was stopped run on
+// the first tick
+func addReceivedDelayedActions(
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) error {
+
+	actions, err := dbClient.DelayedActions()
+	if err != nil {
+		lc.Error("problem querying the delayed actions", "message", err.Error())
+		return err
+	}
+	for _, action := range actions {
+		if err = scClient.AddDelayedAction(action); err != nil {
+			return err
+		}
+		lc.Info("added delayed action", "name", action.Name, "runAt", action.RunAt)
+	}
+	return nil
+}
+
 // Iterate over the received interval action(s)
 func addReceivedIntervalActions(
 	intervalActions []contract.IntervalAction,
 	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
 	scClient interfaces.SchedulerQueueClient) error {
 
 	for _, intervalAction := range intervalActions {
@@ -150,6 +215,17 @@ func addReceivedIntervalActions(
 			return err
 		}
 		lc.Info("added interval action", "name", intervalAction.Name, "id", intervalAction.ID)
+
+		policy, err := dbClient.GetIntervalActionOverlapPolicy(intervalAction.ID)
+		if err == db.ErrNotFound {
+			continue
+		} else if err != nil {
+			lc.Error("problem querying the overlap policy of interval action", "name", intervalAction.Name, "message", err.Error())
+			return err
+		}
+		if err = scClient.SetIntervalActionOverlapPolicy(intervalAction.ID, policy); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -251,6 +327,11 @@ func loadConfigIntervalActions(
 	intervalActions := configuration.IntervalActions
 
 	for ia := range intervalActions {
+		policy := models.OverlapPolicy(intervalActions[ia].OverlapPolicy)
+		if policy != "" && !policy.IsValid() {
+			return errors.NewErrInvalidOverlapPolicy(string(policy))
+		}
+
 		intervalAction := contract.IntervalAction{
 			Name:       intervalActions[ia].Name,
 			Interval:   intervalActions[ia].Interval,
@@ -261,6 +342,7 @@ func loadConfigIntervalActions(
 			Protocol:   intervalActions[ia].Protocol,
 			HTTPMethod: intervalActions[ia].Method,
 			Address:    intervalActions[ia].Host,
+			Topic:      intervalActions[ia].Topic,
 		}
 
 		// query scheduler in memory queue and determine of intervalAction exists
@@ -283,6 +365,12 @@ func loadConfigIntervalActions(
 				return errAddIntervalAction
 
 			}
+
+			if policy != "" {
+				if err = setIntervalActionOverlapPolicy(intervalAction.ID, policy, dbClient, scClient); err != nil {
+					return err
+				}
+			}
 		} else {
 			lc.Debug(
 				"did not load interval action as it exists in the scheduler database" +
@@ -308,12 +396,22 @@ func loadSupportSchedulerDBInformation(
 		return err
 	}
 
+	err = addReceivedBlackoutCalendars(receivedIntervals, lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
 	intervalActions, err := getSchedulerDBIntervalActions(lc, dbClient)
 	if err != nil {
 		return err
 	}
 
-	err = addReceivedIntervalActions(intervalActions, lc, scClient)
+	err = addReceivedIntervalActions(intervalActions, lc, dbClient, scClient)
+	if err != nil {
+		return err
+	}
+
+	err = addReceivedDelayedActions(lc, dbClient, scClient)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,129 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// BlackoutTimeLayout is the layout of the start and end of blackout ranges, the same as the start and end of intervals.
+// Times are UTC.
+const BlackoutTimeLayout = "20060102T150405"
+
+// BlackoutCalendar lists the periods during which the interval actions of the intervals it is attached to don't fire.
+// A calendar can be attached to several intervals.
+type BlackoutCalendar struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Ranges are explicit periods, e.g. a plant shutdown
+	Ranges []BlackoutRange `json:"ranges,omitempty"`
+	// Holidays are whole days recurring every year
+	Holidays []RecurringHoliday `json:"holidays,omitempty"`
+}
+
+// BlackoutRange is the period from Start, included, to End, excluded
+type BlackoutRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// RecurringHoliday is a day of the year, e.g. month 12 and day 25
+type RecurringHoliday struct {
+	Name  string `json:"name,omitempty"`
+	Month int    `json:"month"`
+	Day   int    `json:"day"`
+}
+
+// IntervalBlackoutCalendars are the names of the blackout calendars attached to an interval
+type IntervalBlackoutCalendars struct {
+	BlackoutCalendars []string `json:"blackoutCalendars"`
+}
+
+// Validate checks the calendar has a name, its ranges end after they start and its holidays are days of the year
+func (c BlackoutCalendar) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	for _, r := range c.Ranges {
+		start, end, err := r.parse()
+		if err != nil {
+			return err
+		}
+		if !end.After(start) {
+			return fmt.Errorf("range end %s isn't after its start %s", r.End, r.Start)
+		}
+	}
+	for _, h := range c.Holidays {
+		if h.Month < 1 || h.Month > 12 {
+			return fmt.Errorf("holiday month %d isn't between 1 and 12", h.Month)
+		}
+		// 2020 is a leap year, so February 29 is a valid holiday
+		if date := time.Date(2020, time.Month(h.Month), h.Day, 0, 0, 0, 0, time.UTC); h.Day < 1 || date.Day() != h.Day {
+			return fmt.Errorf("holiday day %d isn't a day of month %d", h.Day, h.Month)
+		}
+	}
+	return nil
+}
+
+// Contains tells whether t falls in one of the ranges or holidays of the calendar
+func (c BlackoutCalendar) Contains(t time.Time) bool {
+	t = t.UTC()
+	for _, r := range c.Ranges {
+		start, end, err := r.parse()
+		if err == nil && !t.Before(start) && t.Before(end) {
+			return true
+		}
+	}
+	for _, h := range c.Holidays {
+		if int(t.Month()) == h.Month && t.Day() == h.Day {
+			return true
+		}
+	}
+	return false
+}
+
+// End returns the end of the blackout t falls in, the latest one when ranges or holidays overlap at t, and whether t
+// falls in a blackout at all. Holidays end at midnight UTC.
+func (c BlackoutCalendar) End(t time.Time) (time.Time, bool) {
+	t = t.UTC()
+	var end time.Time
+	for _, r := range c.Ranges {
+		start, rangeEnd, err := r.parse()
+		if err == nil && !t.Before(start) && t.Before(rangeEnd) && rangeEnd.After(end) {
+			end = rangeEnd
+		}
+	}
+	for _, h := range c.Holidays {
+		if int(t.Month()) == h.Month && t.Day() == h.Day {
+			if dayEnd := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC); dayEnd.After(end) {
+				end = dayEnd
+			}
+		}
+	}
+	return end, !end.IsZero()
+}
+
+func (r BlackoutRange) parse() (time.Time, time.Time, error) {
+	start, err := time.Parse(BlackoutTimeLayout, r.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid range start '%s', expected %s", r.Start, BlackoutTimeLayout)
+	}
+	end, err := time.Parse(BlackoutTimeLayout, r.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid range end '%s', expected %s", r.End, BlackoutTimeLayout)
+	}
+	return start, end, nil
+}
@@ -0,0 +1,64 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// ScheduleDefinitions declares intervals and interval actions by name, so that the schedules can be kept in version
+// control. Applying the definitions adds the missing intervals and interval actions and updates the differing ones,
+// leaving the others untouched, so they can be applied any number of times.
+type ScheduleDefinitions struct {
+	Intervals       []IntervalDefinition       `json:"intervals,omitempty" yaml:"intervals,omitempty"`
+	IntervalActions []IntervalActionDefinition `json:"intervalActions,omitempty" yaml:"intervalActions,omitempty"`
+}
+
+// IntervalDefinition is the declarative definition of an interval
+type IntervalDefinition struct {
+	Name string `json:"name" yaml:"name"`
+	// Start time in ISO 8601 format YYYYMMDD'T'HHmmss
+	Start string `json:"start,omitempty" yaml:"start,omitempty"`
+	// End time in ISO 8601 format YYYYMMDD'T'HHmmss
+	End       string `json:"end,omitempty" yaml:"end,omitempty"`
+	Frequency string `json:"frequency,omitempty" yaml:"frequency,omitempty"`
+	Cron      string `json:"cron,omitempty" yaml:"cron,omitempty"`
+	RunOnce   bool   `json:"runOnce,omitempty" yaml:"runOnce,omitempty"`
+}
+
+// IntervalActionDefinition is the declarative definition of an interval action
+type IntervalActionDefinition struct {
+	Name       string `json:"name" yaml:"name"`
+	Interval   string `json:"interval" yaml:"interval"`
+	Host       string `json:"host,omitempty" yaml:"host,omitempty"`
+	Port       int    `json:"port,omitempty" yaml:"port,omitempty"`
+	Protocol   string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Method     string `json:"method,omitempty" yaml:"method,omitempty"`
+	Target     string `json:"target" yaml:"target"`
+	Path       string `json:"path,omitempty" yaml:"path,omitempty"`
+	Parameters string `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	Topic      string `json:"topic,omitempty" yaml:"topic,omitempty"`
+	// OverlapPolicy is left unchanged when empty
+	OverlapPolicy OverlapPolicy `json:"overlapPolicy,omitempty" yaml:"overlapPolicy,omitempty"`
+}
+
+// ScheduleDefinitionsApplied reports the names of the intervals and interval actions applying definitions changed
+type ScheduleDefinitionsApplied struct {
+	Intervals       DefinitionChanges `json:"intervals"`
+	IntervalActions DefinitionChanges `json:"intervalActions"`
+}
+
+// DefinitionChanges is the names of the definitions which were added, updated or already up to date
+type DefinitionChanges struct {
+	Added     []string `json:"added"`
+	Updated   []string `json:"updated"`
+	Unchanged []string `json:"unchanged"`
+}
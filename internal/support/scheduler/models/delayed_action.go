@@ -0,0 +1,74 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// DelayedAction is an action run once at a given time, without any interval, then deleted. Its action is defined
+// like the one of an interval action.
+type DelayedAction struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	// RunAt is when the action runs in the layout of the start and end of intervals, UTC
+	RunAt string `json:"runAt,omitempty"`
+	// Delay is how long after it is added the action runs, i.e. '15m', when RunAt isn't given
+	Delay      string `json:"delay,omitempty"`
+	Created    int64  `json:"created,omitempty"`
+	Target     string `json:"target"`
+	Protocol   string `json:"protocol,omitempty"`
+	HTTPMethod string `json:"httpMethod,omitempty"`
+	Address    string `json:"address,omitempty"`
+	Port       int    `json:"port,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Parameters string `json:"parameters,omitempty"`
+	Topic      string `json:"topic,omitempty"`
+}
+
+// Validate checks the delayed action has a name and a target, and either a time or a positive delay to run at
+func (a DelayedAction) Validate() error {
+	if a.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if a.Target == "" {
+		return fmt.Errorf("target is required")
+	}
+	switch {
+	case a.RunAt == "" && a.Delay == "":
+		return fmt.Errorf("runAt or delay is required")
+	case a.RunAt != "" && a.Delay != "":
+		return fmt.Errorf("runAt and delay are exclusive")
+	case a.RunAt != "":
+		if _, err := a.Time(); err != nil {
+			return err
+		}
+	default:
+		if delay, err := time.ParseDuration(a.Delay); err != nil || delay <= 0 {
+			return fmt.Errorf("invalid delay '%s', a positive duration is expected", a.Delay)
+		}
+	}
+	return nil
+}
+
+// Time returns when the delayed action runs
+func (a DelayedAction) Time() (time.Time, error) {
+	t, err := time.Parse(BlackoutTimeLayout, a.RunAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid runAt '%s', expected %s", a.RunAt, BlackoutTimeLayout)
+	}
+	return t, nil
+}
@@ -0,0 +1,65 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// OverlapPolicy decides what happens when an interval action is due while its previous execution is still in flight
+type OverlapPolicy string
+
+const (
+	// OverlapSkip drops the execution which is due
+	OverlapSkip OverlapPolicy = "skip"
+	// OverlapQueue runs the execution which is due once the execution in flight completes
+	OverlapQueue OverlapPolicy = "queue"
+	// OverlapParallel runs the execution which is due next to the execution in flight
+	OverlapParallel OverlapPolicy = "parallel"
+)
+
+// IsValid returns whether the policy is one of the known overlap policies
+func (p OverlapPolicy) IsValid() bool {
+	switch p {
+	case OverlapSkip, OverlapQueue, OverlapParallel:
+		return true
+	}
+	return false
+}
+
+// IntervalActionOverlap is the overlap policy of an interval action
+type IntervalActionOverlap struct {
+	OverlapPolicy OverlapPolicy `json:"overlapPolicy"`
+}
+
+// IntervalActionExecution is an execution of an interval action in flight
+type IntervalActionExecution struct {
+	IntervalAction string        `json:"intervalAction"`
+	Interval       string        `json:"interval"`
+	OverlapPolicy  OverlapPolicy `json:"overlapPolicy"`
+	// Started is the time the execution started, in milliseconds since the epoch
+	Started int64 `json:"started"`
+	// Queued is the number of executions of the interval action waiting for the executions in flight to complete
+	Queued int `json:"queued"`
+}
+
+// IntervalActionExecutionResult is a completed execution of an interval action, kept in the execution history
+type IntervalActionExecutionResult struct {
+	IntervalAction string `json:"intervalAction"`
+	Interval       string `json:"interval"`
+	// Started is the time the execution started, in milliseconds since the epoch
+	Started int64 `json:"started"`
+	// Completed is the time the execution completed, in milliseconds since the epoch
+	Completed int64 `json:"completed"`
+	Succeeded bool  `json:"succeeded"`
+	// Result is the response to the request, the publication or the device command, or the reason the execution failed
+	Result string `json:"result,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package models
+
+// ScheduledExecution is an upcoming execution of an interval
+type ScheduledExecution struct {
+	// Time is in the layout of the start and end of intervals, UTC
+	Time string `json:"time"`
+	// Timestamp is the time in milliseconds since the epoch
+	Timestamp int64 `json:"timestamp"`
+}
@@ -14,6 +14,10 @@
 package intervalaction
 
 import (
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 )
@@ -61,6 +65,19 @@ func addNewIntervalAction(iaa intervalActionAdd) (string, error) {
 		return "", errors.NewErrIntervalActionTargetNameRequired(iaa.intervalAction.ID)
 	}
 
+	// Validate the Topic for message bus actions
+	if strings.EqualFold(iaa.intervalAction.Protocol, config.MessageBusProtocol) && iaa.intervalAction.Topic == "" {
+		return "", errors.NewErrIntervalActionTopicRequired(name)
+	}
+
+	// Validate the device and command of device command actions
+	if strings.EqualFold(iaa.intervalAction.Protocol, config.DeviceCommandProtocol) {
+		method := strings.ToUpper(iaa.intervalAction.HTTPMethod)
+		if iaa.intervalAction.Path == "" || (method != http.MethodGet && method != http.MethodPut) {
+			return "", errors.NewErrIntervalActionInvalidDeviceCommand(name, "the path must be the command name and the method GET or PUT")
+		}
+	}
+
 	// Validate the Interval
 	interval := iaa.intervalAction.Interval
 	if interval != "" {
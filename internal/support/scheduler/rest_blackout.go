@@ -0,0 +1,227 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/gorilla/mux"
+)
+
+// Return all the blackout calendars
+func restGetBlackoutCalendars(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	calendars, err := dbClient.BlackoutCalendars()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+	pkg.Encode(calendars, w, lc)
+}
+
+/*
+Handler to add or update a blackout calendar
+Status code 400 - bad request, malformed or invalid calendar, or name in use when adding
+Status code 404 - calendar not found when updating
+Status code 500 - unanticipated issues
+api/v1/blackoutcalendar
+*/
+func blackoutCalendarHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var calendar schedulerModels.BlackoutCalendar
+	if err := json.NewDecoder(r.Body).Decode(&calendar); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding blackout calendar: " + err.Error())
+		return
+	}
+
+	var err error
+	if r.Method == http.MethodPost {
+		lc.Info("Posting new BlackoutCalendar: " + calendar.Name)
+		err = addBlackoutCalendar(calendar, dbClient, scClient)
+	} else {
+		lc.Info("Updating BlackoutCalendar: " + calendar.Name)
+		err = updateBlackoutCalendar(calendar, dbClient, scClient)
+	}
+	if err != nil {
+		handleBlackoutCalendarRestErrors(err, w, lc)
+		return
+	}
+
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("true"))
+}
+
+/*
+Handler for the BlackoutCalendar By-Name API
+Status code 400 - calendar still attached to intervals when deleting
+Status code 404 - calendar not found
+Status code 500 - unanticipated issues
+api/v1/blackoutcalendar/name/{name}
+*/
+func blackoutCalendarByNameHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		calendar, err := getBlackoutCalendarByName(name, dbClient)
+		if err != nil {
+			handleBlackoutCalendarRestErrors(err, w, lc)
+			return
+		}
+		pkg.Encode(calendar, w, lc)
+	case http.MethodDelete:
+		lc.Info("Deleting BlackoutCalendar: " + name)
+		if err = deleteBlackoutCalendarByName(name, dbClient, scClient); err != nil {
+			handleBlackoutCalendarRestErrors(err, w, lc)
+			return
+		}
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("true"))
+	}
+}
+
+/*
+Handler for the Interval Blackout Calendars By-Name API
+Status code 400 - bad request, malformed body or unknown blackout calendar
+Status code 404 - interval not found
+Status code 500 - unanticipated issues
+api/v1/interval/name/{name}/blackout
+*/
+func intervalBlackoutByNameHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	interval, err := getIntervalByName(name, dbClient)
+	if err != nil {
+		switch x := err.(type) {
+		case errors.ErrIntervalNotFound:
+			http.Error(w, x.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, x.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		names, err := getIntervalBlackoutCalendars(interval.ID, dbClient)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+		pkg.Encode(schedulerModels.IntervalBlackoutCalendars{BlackoutCalendars: names}, w, lc)
+	case http.MethodPut, http.MethodDelete:
+		var blackout schedulerModels.IntervalBlackoutCalendars
+		if r.Method == http.MethodPut {
+			if err = json.NewDecoder(r.Body).Decode(&blackout); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				lc.Error("Error decoding the blackout calendars: " + err.Error())
+				return
+			}
+		}
+
+		lc.Info("Setting the blackout calendars of Interval: " + name)
+		if err = setIntervalBlackoutCalendars(interval.ID, blackout.BlackoutCalendars, dbClient, scClient); err != nil {
+			switch err.(type) {
+			case errors.ErrBlackoutCalendarNotFound:
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			default:
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			lc.Error(err.Error())
+			return
+		}
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("true"))
+	}
+}
+
+func handleBlackoutCalendarRestErrors(err error, w http.ResponseWriter, lc logger.LoggingClient) {
+	lc.Error(err.Error())
+	switch err.(type) {
+	case errors.ErrBlackoutCalendarNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.ErrInvalidBlackoutCalendar, errors.ErrBlackoutCalendarNameInUse, errors.ErrBlackoutCalendarStillInUse:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,201 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testBlackoutCalendar = schedulerModels.BlackoutCalendar{
+	Name:     "plant-holidays",
+	Ranges:   []schedulerModels.BlackoutRange{{Start: "20191223T000000", End: "20200102T000000"}},
+	Holidays: []schedulerModels.RecurringHoliday{{Name: "Labour day", Month: 5, Day: 1}},
+}
+
+func TestBlackoutCalendarContains(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected bool
+	}{
+		{"In range", time.Date(2019, 12, 25, 12, 0, 0, 0, time.UTC), true},
+		{"Range start", time.Date(2019, 12, 23, 0, 0, 0, 0, time.UTC), true},
+		{"Range end", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), false},
+		{"Holiday", time.Date(2021, 5, 1, 23, 59, 0, 0, time.UTC), true},
+		{"Holiday in other zone", time.Date(2021, 5, 2, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)), true},
+		{"Outside", time.Date(2021, 5, 2, 0, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, testBlackoutCalendar.Contains(tt.time))
+		})
+	}
+}
+
+func TestBlackoutCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		calendar    schedulerModels.BlackoutCalendar
+		expectedErr bool
+	}{
+		{"Valid", testBlackoutCalendar, false},
+		{"Leap day", schedulerModels.BlackoutCalendar{Name: "leap", Holidays: []schedulerModels.RecurringHoliday{{Month: 2, Day: 29}}}, false},
+		{"Missing name", schedulerModels.BlackoutCalendar{}, true},
+		{"Invalid month", schedulerModels.BlackoutCalendar{Name: "x", Holidays: []schedulerModels.RecurringHoliday{{Month: 13, Day: 1}}}, true},
+		{"Invalid day", schedulerModels.BlackoutCalendar{Name: "x", Holidays: []schedulerModels.RecurringHoliday{{Month: 4, Day: 31}}}, true},
+		{"Invalid range time", schedulerModels.BlackoutCalendar{Name: "x", Ranges: []schedulerModels.BlackoutRange{{Start: "2019-12-23", End: "20200102T000000"}}}, true},
+		{"Range ending before start", schedulerModels.BlackoutCalendar{Name: "x", Ranges: []schedulerModels.BlackoutRange{{Start: "20200102T000000", End: "20191223T000000"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedErr, tt.calendar.Validate() != nil)
+		})
+	}
+}
+
+func TestBlackoutCalendarHandler(t *testing.T) {
+	valid, _ := json.Marshal(testBlackoutCalendar)
+
+	tests := []struct {
+		name           string
+		method         string
+		body           string
+		getError       error
+		expectedStatus int
+	}{
+		{"Add", http.MethodPost, string(valid), db.ErrNotFound, http.StatusOK},
+		{"Add name in use", http.MethodPost, string(valid), nil, http.StatusBadRequest},
+		{"Add invalid", http.MethodPost, `{"name":"x","holidays":[{"month":0,"day":1}]}`, db.ErrNotFound, http.StatusBadRequest},
+		{"Add malformed", http.MethodPost, `{`, nil, http.StatusBadRequest},
+		{"Update", http.MethodPut, string(valid), nil, http.StatusOK},
+		{"Update not found", http.MethodPut, string(valid), db.ErrNotFound, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClient := &mocks.DBClient{}
+			dbClient.On("BlackoutCalendarByName", testBlackoutCalendar.Name).Return(testBlackoutCalendar, tt.getError)
+			dbClient.On("SetBlackoutCalendar", mock.Anything).Return(nil)
+			scClient := &mocks.SchedulerQueueClient{}
+			scClient.On("SetBlackoutCalendar", mock.Anything).Return(nil)
+
+			req := httptest.NewRequest(tt.method, "/"+BLACKOUTCALENDAR, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+			blackoutCalendarHandler(rr, req, logger.NewMockClient(), dbClient, scClient)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				scClient.AssertCalled(t, "SetBlackoutCalendar", testBlackoutCalendar)
+			}
+		})
+	}
+}
+
+func TestDeleteBlackoutCalendarByName(t *testing.T) {
+	tests := []struct {
+		name           string
+		getError       error
+		attached       []string
+		expectedStatus int
+	}{
+		{"Delete", nil, []string{"other"}, http.StatusOK},
+		{"Not found", db.ErrNotFound, nil, http.StatusNotFound},
+		{"Still attached", nil, []string{testBlackoutCalendar.Name}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClient := &mocks.DBClient{}
+			dbClient.On("BlackoutCalendarByName", testBlackoutCalendar.Name).Return(testBlackoutCalendar, tt.getError)
+			dbClient.On("Intervals").Return([]contract.Interval{intervalForAdd}, nil)
+			dbClient.On("IntervalBlackoutCalendars", intervalForAdd.ID).Return(tt.attached, nil)
+			dbClient.On("DeleteBlackoutCalendarByName", testBlackoutCalendar.Name).Return(nil)
+			scClient := &mocks.SchedulerQueueClient{}
+			scClient.On("RemoveBlackoutCalendar", testBlackoutCalendar.Name).Return(nil)
+
+			req := httptest.NewRequest(http.MethodDelete, "/"+BLACKOUTCALENDAR+"/"+NAME+"/"+testBlackoutCalendar.Name, nil)
+			req = mux.SetURLVars(req, map[string]string{NAME: testBlackoutCalendar.Name})
+			rr := httptest.NewRecorder()
+			blackoutCalendarByNameHandler(rr, req, logger.NewMockClient(), dbClient, scClient)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				scClient.AssertCalled(t, "RemoveBlackoutCalendar", testBlackoutCalendar.Name)
+			} else {
+				dbClient.AssertNotCalled(t, "DeleteBlackoutCalendarByName", mock.Anything)
+			}
+		})
+	}
+}
+
+func TestIntervalBlackoutByName(t *testing.T) {
+	tests := []struct {
+		name              string
+		method            string
+		body              string
+		intervalError     error
+		calendarError     error
+		expectedStatus    int
+		expectedCalendars []string
+	}{
+		{"Get", http.MethodGet, "", nil, nil, http.StatusOK, []string{testBlackoutCalendar.Name}},
+		{"Set", http.MethodPut, `{"blackoutCalendars":["plant-holidays"]}`, nil, nil, http.StatusOK, []string{testBlackoutCalendar.Name}},
+		{"Set unknown calendar", http.MethodPut, `{"blackoutCalendars":["plant-holidays"]}`, nil, db.ErrNotFound, http.StatusBadRequest, nil},
+		{"Set malformed", http.MethodPut, `{`, nil, nil, http.StatusBadRequest, nil},
+		{"Delete", http.MethodDelete, "", nil, nil, http.StatusOK, nil},
+		{"Interval not found", http.MethodGet, "", db.ErrNotFound, nil, http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClient := &mocks.DBClient{}
+			dbClient.On("IntervalByName", intervalForAdd.Name).Return(intervalForAdd, tt.intervalError)
+			dbClient.On("IntervalBlackoutCalendars", intervalForAdd.ID).Return([]string{testBlackoutCalendar.Name}, nil)
+			dbClient.On("BlackoutCalendarByName", testBlackoutCalendar.Name).Return(testBlackoutCalendar, tt.calendarError)
+			dbClient.On("SetIntervalBlackoutCalendars", intervalForAdd.ID, mock.Anything).Return(nil)
+			scClient := &mocks.SchedulerQueueClient{}
+			scClient.On("SetIntervalBlackoutCalendars", intervalForAdd.ID, mock.Anything).Return(nil)
+
+			req := httptest.NewRequest(tt.method, TestURI+"/"+NAME+"/"+intervalForAdd.Name+"/"+BLACKOUT, bytes.NewBufferString(tt.body))
+			req = mux.SetURLVars(req, map[string]string{NAME: intervalForAdd.Name})
+			rr := httptest.NewRecorder()
+			intervalBlackoutByNameHandler(rr, req, logger.NewMockClient(), dbClient, scClient)
+
+			require.Equal(t, tt.expectedStatus, rr.Code)
+			switch {
+			case tt.method == http.MethodGet && tt.expectedStatus == http.StatusOK:
+				var blackout schedulerModels.IntervalBlackoutCalendars
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &blackout))
+				assert.Equal(t, tt.expectedCalendars, blackout.BlackoutCalendars)
+			case tt.expectedStatus == http.StatusOK:
+				scClient.AssertCalled(t, "SetIntervalBlackoutCalendars", intervalForAdd.ID, tt.expectedCalendars)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"gopkg.in/yaml.v2"
+)
+
+/*
+Handler for the schedule definitions API, exporting all the intervals and interval actions as YAML with GET and
+applying the intervals and interval actions of a YAML document with PUT, which reports the added, updated and
+unchanged ones
+Status code 400 - malformed or invalid definitions
+Status code 500 - unanticipated issues
+api/v1/definitions
+*/
+func scheduleDefinitionsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	if r.Method == http.MethodGet {
+		definitions, err := exportScheduleDefinitions(dbClient)
+		if err != nil {
+			lc.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		data, err := yaml.Marshal(definitions)
+		if err != nil {
+			lc.Error(err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(clients.ContentType, clients.ContentTypeYAML)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+		return
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error reading the schedule definitions: " + err.Error())
+		return
+	}
+	var definitions schedulerModels.ScheduleDefinitions
+	if err = yaml.UnmarshalStrict(data, &definitions); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding the schedule definitions: " + err.Error())
+		return
+	}
+
+	lc.Info("Applying schedule definitions")
+	applied, err := applyScheduleDefinitions(definitions, lc, dbClient, scClient)
+	if err != nil {
+		lc.Error(err.Error())
+		switch err.(type) {
+		case errors.ErrInvalidScheduleDefinitions, errors.ErrIntervalActionTopicRequired,
+			errors.ErrIntervalActionInvalidDeviceCommand, errors.ErrInvalidOverlapPolicy:
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	pkg.Encode(applied, w, lc)
+}
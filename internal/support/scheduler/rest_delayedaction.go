@@ -0,0 +1,143 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/gorilla/mux"
+)
+
+// Return the delayed actions which didn't run yet, the first to run first
+func restGetDelayedActions(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	actions, err := getDelayedActions(dbClient)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+	pkg.Encode(actions, w, lc)
+}
+
+/*
+Handler to add a delayed action, run once at its time then deleted, returns its id
+Status code 400 - bad request, malformed or invalid delayed action, or name in use
+Status code 500 - unanticipated issues
+api/v1/delayedaction
+*/
+func restAddDelayedAction(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	var action schedulerModels.DelayedAction
+	if err := json.NewDecoder(r.Body).Decode(&action); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error decoding delayed action: " + err.Error())
+		return
+	}
+
+	lc.Info("Posting new DelayedAction: " + action.Name)
+	id, err := addDelayedAction(action, dbClient, scClient)
+	if err != nil {
+		handleDelayedActionRestErrors(err, w, lc)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(id))
+}
+
+/*
+Handler for the DelayedAction By-Name API, deleting a delayed action cancels it
+Status code 404 - delayed action not found, or already run
+Status code 500 - unanticipated issues
+api/v1/delayedaction/name/{name}
+*/
+func delayedActionByNameHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		action, err := getDelayedActionByName(name, dbClient)
+		if err != nil {
+			handleDelayedActionRestErrors(err, w, lc)
+			return
+		}
+		pkg.Encode(action, w, lc)
+	case http.MethodDelete:
+		lc.Info("Deleting DelayedAction: " + name)
+		if err = deleteDelayedActionByName(name, dbClient, scClient); err != nil {
+			handleDelayedActionRestErrors(err, w, lc)
+			return
+		}
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("true"))
+	}
+}
+
+func handleDelayedActionRestErrors(err error, w http.ResponseWriter, lc logger.LoggingClient) {
+	lc.Error(err.Error())
+	switch err.(type) {
+	case errors.ErrDelayedActionNotFound:
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.ErrInvalidDelayedAction, errors.ErrDelayedActionNameInUse:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,138 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelayedActionValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		action      schedulerModels.DelayedAction
+		expectedErr bool
+	}{
+		{"Valid run at", schedulerModels.DelayedAction{Name: "cleanup", Target: "core-data", RunAt: "20201231T235959"}, false},
+		{"Valid delay", schedulerModels.DelayedAction{Name: "cleanup", Target: "core-data", Delay: "15m"}, false},
+		{"Missing name", schedulerModels.DelayedAction{Target: "core-data", Delay: "15m"}, true},
+		{"Missing target", schedulerModels.DelayedAction{Name: "cleanup", Delay: "15m"}, true},
+		{"Missing time", schedulerModels.DelayedAction{Name: "cleanup", Target: "core-data"}, true},
+		{"Run at and delay", schedulerModels.DelayedAction{Name: "cleanup", Target: "core-data", RunAt: "20201231T235959", Delay: "15m"}, true},
+		{"Invalid run at", schedulerModels.DelayedAction{Name: "cleanup", Target: "core-data", RunAt: "2020-12-31"}, true},
+		{"Negative delay", schedulerModels.DelayedAction{Name: "cleanup", Target: "core-data", Delay: "-1s"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedErr, tt.action.Validate() != nil)
+		})
+	}
+}
+
+func TestAddDelayedAction(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		getError       error
+		expectedStatus int
+	}{
+		{"Add with delay", `{"name":"cleanup","target":"core-data","httpMethod":"DELETE","path":"/api/v1/event/scrub","delay":"1h"}`, db.ErrNotFound, http.StatusOK},
+		{"Add message bus", `{"name":"cleanup","target":"core-data","protocol":"MESSAGEBUS","topic":"maintenance","runAt":"20201231T235959"}`, db.ErrNotFound, http.StatusOK},
+		{"Add name in use", `{"name":"cleanup","target":"core-data","httpMethod":"DELETE","delay":"1h"}`, nil, http.StatusBadRequest},
+		{"Add invalid", `{"name":"cleanup","target":"core-data","httpMethod":"DELETE"}`, db.ErrNotFound, http.StatusBadRequest},
+		{"Add without topic", `{"name":"cleanup","target":"core-data","protocol":"MESSAGEBUS","delay":"1h"}`, db.ErrNotFound, http.StatusBadRequest},
+		{"Add without method", `{"name":"cleanup","target":"core-data","delay":"1h"}`, db.ErrNotFound, http.StatusBadRequest},
+		{"Add malformed", `{`, db.ErrNotFound, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClient := &mocks.DBClient{}
+			dbClient.On("DelayedActionByName", "cleanup").Return(schedulerModels.DelayedAction{}, tt.getError)
+			dbClient.On("AddDelayedAction", mock.Anything).Return(nil)
+			scClient := &mocks.SchedulerQueueClient{}
+			scClient.On("AddDelayedAction", mock.Anything).Return(nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/"+DELAYEDACTION, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+			restAddDelayedAction(rr, req, logger.NewMockClient(), dbClient, scClient)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				scClient.AssertCalled(t, "AddDelayedAction", mock.MatchedBy(func(a schedulerModels.DelayedAction) bool {
+					return a.ID == rr.Body.String() && a.Delay == "" && a.Validate() == nil
+				}))
+			}
+		})
+	}
+}
+
+func TestDeleteDelayedActionByName(t *testing.T) {
+	dbClient := &mocks.DBClient{}
+	dbClient.On("DeleteDelayedActionByName", "cleanup").Return(nil)
+	dbClient.On("DeleteDelayedActionByName", "unknown").Return(db.ErrNotFound)
+	scClient := &mocks.SchedulerQueueClient{}
+	scClient.On("RemoveDelayedAction", "cleanup").Return(nil)
+
+	for name, expectedStatus := range map[string]int{"cleanup": http.StatusOK, "unknown": http.StatusNotFound} {
+		req := httptest.NewRequest(http.MethodDelete, "/"+DELAYEDACTION+"/"+NAME+"/"+name, nil)
+		req = mux.SetURLVars(req, map[string]string{NAME: name})
+		rr := httptest.NewRecorder()
+		delayedActionByNameHandler(rr, req, logger.NewMockClient(), dbClient, scClient)
+
+		assert.Equal(t, expectedStatus, rr.Code, name)
+	}
+	scClient.AssertNumberOfCalls(t, "RemoveDelayedAction", 1)
+}
+
+func TestTriggerDelayedActions(t *testing.T) {
+	defer clearMaps()
+
+	now := time.Now()
+	qc := NewSchedulerQueueClient(logger.NewMockClient())
+	for name, runAt := range map[string]time.Time{"due": now.Add(-time.Minute), "cancelled": now.Add(-time.Second), "pending": now.Add(time.Hour)} {
+		_ = qc.AddDelayedAction(schedulerModels.DelayedAction{
+			Name:     name,
+			RunAt:    runAt.UTC().Format(TIMELAYOUT),
+			Protocol: config.MessageBusProtocol,
+			Topic:    "maintenance",
+		})
+	}
+
+	dbClient := &mocks.DBClient{}
+	dbClient.On("DeleteDelayedActionByName", "due").Return(nil)
+	dbClient.On("DeleteDelayedActionByName", "cancelled").Return(db.ErrNotFound)
+
+	triggerDelayedActions(logger.NewMockClient(), &config.ConfigurationStruct{}, nil, nil, dbClient)
+
+	dbClient.AssertNumberOfCalls(t, "DeleteDelayedActionByName", 2)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Len(t, delayedActionNameToActionMap, 1)
+	assert.Contains(t, delayedActionNameToActionMap, "pending")
+}
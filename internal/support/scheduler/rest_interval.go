@@ -323,3 +323,63 @@ func restScrubAllIntervals(
 	w.Write([]byte(strconv.Itoa(count)))
 
 }
+
+// defaultNextExecutions is the number of upcoming executions previewed when the count isn't given
+const defaultNextExecutions = 10
+
+// restGetIntervalNextExecutions previews the upcoming executions of an interval so that its schedule can be checked
+// before it goes live. Times are UTC, the interval fires on its frequency and the cron expression isn't scheduled on.
+func restGetIntervalNextExecutions(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient,
+	configuration *config.ConfigurationStruct) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	count := defaultNextExecutions
+	if value := r.URL.Query().Get(COUNT); value != "" {
+		count, err = strconv.Atoi(value)
+		if err != nil || count < 1 {
+			http.Error(w, "count must be a positive integer", http.StatusBadRequest)
+			lc.Error("Invalid count of next executions: " + value)
+			return
+		}
+	}
+	if count > configuration.Service.MaxResultCount {
+		http.Error(w, "Exceeded max limit", http.StatusRequestEntityTooLarge)
+		lc.Error("Exceeded max limit")
+		return
+	}
+
+	if _, err = getIntervalByName(name, dbClient); err != nil {
+		switch err.(type) {
+		case errors.ErrIntervalNotFound:
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	executions, err := scClient.QueryIntervalNextExecutions(name, count)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		lc.Error(err.Error())
+		return
+	}
+	pkg.Encode(executions, w, lc)
+}
@@ -26,9 +26,12 @@ import (
 	schedConfig "github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval"
 	mockDB "github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/interval/mocks"
 
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
 	errors "github.com/edgexfoundry/go-mod-core-contracts/clients/types"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
@@ -818,3 +821,55 @@ func TestScrubIntervals(t *testing.T) {
 		})
 	}
 }
+
+func TestIntervalNextExecutions(t *testing.T) {
+	executions := []schedulerModels.ScheduledExecution{{Time: "20991224T000000", Timestamp: 4101321600000}}
+	configuration := &schedConfig.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{MaxResultCount: 50}}
+
+	tests := []struct {
+		name           string
+		query          string
+		dbError        error
+		expectedCount  int
+		expectedStatus int
+	}{
+		{"OK", "", nil, defaultNextExecutions, http.StatusOK},
+		{"OK with count", "?" + COUNT + "=3", nil, 3, http.StatusOK},
+		{"Invalid count", "?" + COUNT + "=0", nil, 0, http.StatusBadRequest},
+		{"Malformed count", "?" + COUNT + "=ten", nil, 0, http.StatusBadRequest},
+		{"Count exceeds max limit", "?" + COUNT + "=51", nil, 0, http.StatusRequestEntityTooLarge},
+		{"Interval not found", "", db.ErrNotFound, 0, http.StatusNotFound},
+		{"Other error from database", "", goErrors.New("test error"), 0, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dbClient := &mocks.DBClient{}
+			dbClient.On("IntervalByName", TestName).Return(intervalForAdd, tt.dbError)
+			scClient := &mocks.SchedulerQueueClient{}
+			scClient.On("QueryIntervalNextExecutions", TestName, tt.expectedCount).Return(executions, nil)
+
+			req := httptest.NewRequest(http.MethodGet, TestURI+"/"+NAME+"/"+TestName+"/"+NEXT+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{NAME: TestName})
+			rr := httptest.NewRecorder()
+			restGetIntervalNextExecutions(rr, req, logger.NewMockClient(), dbClient, scClient, configuration)
+
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+				return
+			}
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var actual []schedulerModels.ScheduledExecution
+			if err := json.NewDecoder(response.Body).Decode(&actual); err != nil {
+				t.Errorf("unable to decode the executions: %s", err.Error())
+				return
+			}
+			if len(actual) != 1 || actual[0] != executions[0] {
+				t.Errorf("executions mismatch -- expected %v got %v", executions, actual)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/errors"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/operators/intervalaction"
 )
 
@@ -87,6 +88,10 @@ func restAddIntervalAction(
 			http.Error(w, t.Error(), http.StatusBadRequest)
 		case errors.ErrIntervalNotFound:
 			http.Error(w, t.Error(), http.StatusBadRequest)
+		case errors.ErrIntervalActionTopicRequired:
+			http.Error(w, t.Error(), http.StatusBadRequest)
+		case errors.ErrIntervalActionInvalidDeviceCommand:
+			http.Error(w, t.Error(), http.StatusBadRequest)
 		default:
 			http.Error(w, t.Error(), http.StatusInternalServerError)
 		}
@@ -146,6 +151,10 @@ func intervalActionHandler(
 			switch t := err.(type) {
 			case errors.ErrIntervalActionNameInUse:
 				http.Error(w, t.Error(), http.StatusBadRequest)
+			case errors.ErrIntervalActionTopicRequired:
+				http.Error(w, t.Error(), http.StatusBadRequest)
+			case errors.ErrIntervalActionInvalidDeviceCommand:
+				http.Error(w, t.Error(), http.StatusBadRequest)
 			case errors.ErrInvalidTimeFormat:
 				http.Error(w, t.Error(), http.StatusBadRequest)
 			case errors.ErrInvalidFrequencyFormat:
@@ -188,6 +197,10 @@ func intervalActionHandler(
 				http.Error(w, t.Error(), http.StatusBadRequest)
 			case errors.ErrIntervalNameInUse:
 				http.Error(w, t.Error(), http.StatusBadRequest)
+			case errors.ErrIntervalActionTopicRequired:
+				http.Error(w, t.Error(), http.StatusBadRequest)
+			case errors.ErrIntervalActionInvalidDeviceCommand:
+				http.Error(w, t.Error(), http.StatusBadRequest)
 			default:
 				http.Error(w, err.Error(), http.StatusServiceUnavailable)
 			}
@@ -417,3 +430,137 @@ func scrubIntervalActionsHandler(
 		w.Write([]byte(strconv.Itoa(count)))
 	}
 }
+
+/*
+Handler for the IntervalAction Overlap Policy By-Name API
+Status code 400 - bad request, malformed or invalid overlap policy
+Status code 404 - interval action not found
+Status code 500 - unanticipated issues
+api/v1/intervalaction/name/{name}/overlap
+*/
+func intervalActionOverlapByNameHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient,
+	configuration *config.ConfigurationStruct) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	intervalAction, err := getIntervalActionByName(name, dbClient)
+	if err != nil {
+		switch x := err.(type) {
+		case errors.ErrIntervalActionNotFound:
+			http.Error(w, x.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, x.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		policy, err := getIntervalActionOverlapPolicy(intervalAction.ID, dbClient, configuration)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			lc.Error(err.Error())
+			return
+		}
+		pkg.Encode(schedulerModels.IntervalActionOverlap{OverlapPolicy: policy}, w, lc)
+	case http.MethodPut, http.MethodDelete:
+		var overlap schedulerModels.IntervalActionOverlap
+		if r.Method == http.MethodPut {
+			if err = json.NewDecoder(r.Body).Decode(&overlap); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				lc.Error("Error decoding the overlap policy: " + err.Error())
+				return
+			}
+			if overlap.OverlapPolicy == "" {
+				http.Error(w, "overlapPolicy is required", http.StatusBadRequest)
+				return
+			}
+		}
+
+		lc.Info("Setting the overlap policy of IntervalAction: " + name + " to: " + string(overlap.OverlapPolicy))
+		if err = setIntervalActionOverlapPolicy(intervalAction.ID, overlap.OverlapPolicy, dbClient, scClient); err != nil {
+			switch err.(type) {
+			case errors.ErrInvalidOverlapPolicy:
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			default:
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			lc.Error(err.Error())
+			return
+		}
+		w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("true"))
+	}
+}
+
+// Return the IntervalAction executions in flight
+func restGetInFlightExecutions(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+	pkg.Encode(scClient.QueryInFlightExecutions(), w, lc)
+}
+
+/*
+Handler for the IntervalAction Execution History By-Name API
+Status code 404 - interval action not found
+Status code 500 - unanticipated issues
+api/v1/intervalaction/name/{name}/history
+*/
+func intervalActionHistoryByNameHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	dbClient interfaces.DBClient,
+	scClient interfaces.SchedulerQueueClient) {
+
+	if r.Body != nil {
+		defer r.Body.Close()
+	}
+
+	// URL parameters
+	vars := mux.Vars(r)
+	name, err := url.QueryUnescape(vars["name"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error("Error un-escaping the value name: " + err.Error())
+		return
+	}
+
+	intervalAction, err := getIntervalActionByName(name, dbClient)
+	if err != nil {
+		switch x := err.(type) {
+		case errors.ErrIntervalActionNotFound:
+			http.Error(w, x.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, x.Error(), http.StatusInternalServerError)
+		}
+		lc.Error(err.Error())
+		return
+	}
+
+	pkg.Encode(scClient.QueryIntervalActionHistory(intervalAction.ID), w, lc)
+}
@@ -20,12 +20,14 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	schedConfig "github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 
@@ -33,6 +35,7 @@ import (
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
 
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
 )
 
 var intervalActionForAdd = contract.IntervalAction{
@@ -168,6 +171,87 @@ func TestAddIntervalAction(t *testing.T) {
 	}
 }
 
+func TestIntervalActionOverlapByName(t *testing.T) {
+	configuration := &schedConfig.ConfigurationStruct{
+		Writable: schedConfig.WritableInfo{DefaultOverlapPolicy: string(schedulerModels.OverlapParallel)},
+	}
+
+	tests := []struct {
+		name           string
+		request        *http.Request
+		dbMock         *mocks.DBClient
+		expectedStatus int
+		expectedPolicy schedulerModels.OverlapPolicy
+	}{
+		{
+			name:           "Get",
+			request:        createRequestIntervalActionOverlap(http.MethodGet, ""),
+			dbMock:         createMockIntervalActionOverlap(schedulerModels.OverlapQueue, nil),
+			expectedStatus: http.StatusOK,
+			expectedPolicy: schedulerModels.OverlapQueue,
+		},
+		{
+			name:           "Get default",
+			request:        createRequestIntervalActionOverlap(http.MethodGet, ""),
+			dbMock:         createMockIntervalActionOverlap("", db.ErrNotFound),
+			expectedStatus: http.StatusOK,
+			expectedPolicy: schedulerModels.OverlapParallel,
+		},
+		{
+			name:           "Set",
+			request:        createRequestIntervalActionOverlap(http.MethodPut, `{"overlapPolicy":"queue"}`),
+			dbMock:         createMockIntervalActionOverlap("", nil),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Set invalid policy",
+			request:        createRequestIntervalActionOverlap(http.MethodPut, `{"overlapPolicy":"stack"}`),
+			dbMock:         createMockIntervalActionOverlap("", nil),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Set without policy",
+			request:        createRequestIntervalActionOverlap(http.MethodPut, `{}`),
+			dbMock:         createMockIntervalActionOverlap("", nil),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Delete",
+			request:        createRequestIntervalActionOverlap(http.MethodDelete, ""),
+			dbMock:         createMockIntervalActionOverlap("", nil),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Interval action not found",
+			request:        createRequestIntervalActionOverlap(http.MethodGet, ""),
+			dbMock:         createMockIntervalActionOverlapNotFound(),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scClient := &mocks.SchedulerQueueClient{}
+			scClient.On("SetIntervalActionOverlapPolicy", intervalActionForAdd.ID, mock.Anything).Return(nil)
+
+			rr := httptest.NewRecorder()
+			intervalActionOverlapByNameHandler(rr, tt.request, logger.NewMockClient(), tt.dbMock, scClient, configuration)
+			response := rr.Result()
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("status code mismatch -- expected %v got %v", tt.expectedStatus, response.StatusCode)
+				return
+			}
+			if tt.expectedPolicy != "" {
+				var overlap schedulerModels.IntervalActionOverlap
+				_ = json.NewDecoder(response.Body).Decode(&overlap)
+				if overlap.OverlapPolicy != tt.expectedPolicy {
+					t.Errorf("overlap policy mismatch -- expected %v got %v", tt.expectedPolicy, overlap.OverlapPolicy)
+				}
+			}
+		})
+	}
+}
+
 func createMockIntervalActionLoaderAllSuccess() interfaces.DBClient {
 	myMock := mocks.DBClient{}
 	myMock.On("IntervalActions").Return(createIntervalActions(1), nil)
@@ -239,6 +323,27 @@ func createMockIntervalActionLoaderSCAddSuccess() interfaces.SchedulerQueueClien
 	return &myMock
 }
 
+func createMockIntervalActionOverlap(policy schedulerModels.OverlapPolicy, err error) *mocks.DBClient {
+	myMock := mocks.DBClient{}
+	myMock.On("IntervalActionByName", intervalActionForAdd.Name).Return(intervalActionForAdd, nil)
+	myMock.On("GetIntervalActionOverlapPolicy", intervalActionForAdd.ID).Return(policy, err)
+	myMock.On("SetIntervalActionOverlapPolicy", intervalActionForAdd.ID, schedulerModels.OverlapQueue).Return(nil)
+	myMock.On("DeleteIntervalActionOverlapPolicy", intervalActionForAdd.ID).Return(nil)
+	return &myMock
+}
+
+func createMockIntervalActionOverlapNotFound() *mocks.DBClient {
+	myMock := mocks.DBClient{}
+	myMock.On("IntervalActionByName", intervalActionForAdd.Name).Return(contract.IntervalAction{}, db.ErrNotFound)
+	return &myMock
+}
+
+func createRequestIntervalActionOverlap(method string, body string) *http.Request {
+	uri := TestIntervalActionURI + "/" + NAME + "/" + url.PathEscape(intervalActionForAdd.Name) + "/" + OVERLAP
+	req := httptest.NewRequest(method, uri, bytes.NewBufferString(body))
+	return mux.SetURLVars(req, map[string]string{NAME: intervalActionForAdd.Name})
+}
+
 func createRequestIntervalAction() *http.Request {
 	req := httptest.NewRequest(http.MethodGet, TestIntervalActionURI, nil)
 	return mux.SetURLVars(req, map[string]string{})
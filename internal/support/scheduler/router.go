@@ -18,9 +18,15 @@ import (
 	"net/http"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/configdrift"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/featureflag"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/telemetry"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/usage"
 	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
@@ -57,6 +63,27 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 	// Version
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Usage
+	r.HandleFunc(
+		usage.ApiUsageRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			usage.DefaultRecorder.ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Feature flags
+	r.HandleFunc(
+		featureflag.ApiFeatureFlagsRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.FeatureFlagsFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
+	// Configuration drift
+	r.HandleFunc(
+		configdrift.ApiConfigDriftRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			container.ConfigDriftDetectorFrom(dic.Get).ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	// Interval
 	r.HandleFunc(clients.
 		ApiIntervalRoute,
@@ -126,6 +153,27 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				schedulerContainer.QueueFrom(dic.Get),
 				container.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
+	interval.HandleFunc(
+		"/"+NAME+"/{"+NAME+"}/"+BLACKOUT,
+		func(w http.ResponseWriter, r *http.Request) {
+			intervalBlackoutByNameHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet, http.MethodPut, http.MethodDelete)
+	interval.HandleFunc(
+		"/"+NAME+"/{"+NAME+"}/"+NEXT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetIntervalNextExecutions(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get),
+				schedulerContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	// Scrub "Intervals and IntervalActions"
 	interval.HandleFunc(
 		"/"+SCRUB+"/",
@@ -170,6 +218,15 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				schedulerContainer.ConfigurationFrom(dic.Get))
 		}).Methods(http.MethodPut)
 	intervalAction := r.PathPrefix(clients.ApiIntervalActionRoute).Subrouter()
+	intervalAction.HandleFunc(
+		"/"+INFLIGHT,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetInFlightExecutions(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	intervalAction.HandleFunc(
 		"/{"+ID+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -190,6 +247,27 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get),
 				schedulerContainer.QueueFrom(dic.Get))
 		}).Methods(http.MethodGet, http.MethodDelete)
+	intervalAction.HandleFunc(
+		"/"+NAME+"/{"+NAME+"}/"+OVERLAP,
+		func(w http.ResponseWriter, r *http.Request) {
+			intervalActionOverlapByNameHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get),
+				schedulerContainer.ConfigurationFrom(dic.Get))
+		}).Methods(http.MethodGet, http.MethodPut, http.MethodDelete)
+	intervalAction.HandleFunc(
+		"/"+NAME+"/{"+NAME+"}/"+HISTORY,
+		func(w http.ResponseWriter, r *http.Request) {
+			intervalActionHistoryByNameHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	intervalAction.HandleFunc(
 		"/"+TARGET+"/{"+TARGET+"}",
 		func(w http.ResponseWriter, r *http.Request) {
@@ -220,7 +298,89 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 				container.DBClientFrom(dic.Get))
 		}).Methods(http.MethodDelete)
 
+	// BlackoutCalendar
+	r.HandleFunc(
+		clients.ApiBase+"/"+BLACKOUTCALENDAR,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetBlackoutCalendars(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		clients.ApiBase+"/"+BLACKOUTCALENDAR,
+		func(w http.ResponseWriter, r *http.Request) {
+			blackoutCalendarHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodPost, http.MethodPut)
+	blackoutCalendar := r.PathPrefix(clients.ApiBase + "/" + BLACKOUTCALENDAR).Subrouter()
+	blackoutCalendar.HandleFunc(
+		"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			blackoutCalendarByNameHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet, http.MethodDelete)
+
+	// DelayedAction
+	r.HandleFunc(
+		clients.ApiBase+"/"+DELAYEDACTION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restGetDelayedActions(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+	r.HandleFunc(
+		clients.ApiBase+"/"+DELAYEDACTION,
+		func(w http.ResponseWriter, r *http.Request) {
+			restAddDelayedAction(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodPost)
+	delayedAction := r.PathPrefix(clients.ApiBase + "/" + DELAYEDACTION).Subrouter()
+	delayedAction.HandleFunc(
+		"/"+NAME+"/{"+NAME+"}",
+		func(w http.ResponseWriter, r *http.Request) {
+			delayedActionByNameHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet, http.MethodDelete)
+
+	// Schedule definitions
+	r.HandleFunc(
+		clients.ApiBase+"/"+DEFINITIONS,
+		func(w http.ResponseWriter, r *http.Request) {
+			scheduleDefinitionsHandler(
+				w,
+				r,
+				bootstrapContainer.LoggingClientFrom(dic.Get),
+				container.DBClientFrom(dic.Get),
+				schedulerContainer.QueueFrom(dic.Get))
+		}).Methods(http.MethodGet, http.MethodPut)
+
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(usage.Middleware)
+	r.Use(bodylimit.NewMiddleware(schedulerContainer.ConfigurationFrom(dic.Get).RequestLimits, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(claims.NewMiddleware(schedulerContainer.ConfigurationFrom(dic.Get).TrustedHeaders, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(compression.NewMiddleware(func() compression.ResponsesInfo {
+		return schedulerContainer.ConfigurationFrom(dic.Get).Writable.Compression
+	}))
 }
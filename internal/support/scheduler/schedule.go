@@ -9,6 +9,7 @@ package scheduler
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -18,11 +19,19 @@ import (
 	"sync"
 	"time"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/command"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
 	contract "github.com/edgexfoundry/go-mod-core-contracts/models"
+	"github.com/edgexfoundry/go-mod-messaging/messaging"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/pkg/types"
+	"github.com/google/uuid"
 	queueV1 "gopkg.in/eapache/queue.v1"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 // the interval specific shared variables
@@ -35,12 +44,27 @@ var (
 	intervalActionIdToIntervalMap           = make(map[string]string)
 	intervalActionNameToIntervalMap         = make(map[string]string)
 	intervalActionNameToIntervalActionIdMap = make(map[string]string)
+	intervalActionIdToOverlapPolicyMap      = make(map[string]models.OverlapPolicy)
+	blackoutCalendarNameToCalendarMap       = make(map[string]models.BlackoutCalendar)
+	intervalIdToBlackoutCalendarsMap        = make(map[string][]string)
+	delayedActionNameToActionMap            = make(map[string]models.DelayedAction)
+	// instanceId identifies this scheduler instance as the holder of interval execution locks
+	instanceId = uuid.New().String()
 )
 
-func StartTicker(ticker *time.Ticker, lc logger.LoggingClient, configuration *config.ConfigurationStruct) {
+func StartTicker(
+	ticker *time.Ticker,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	msgClient messaging.MessageClient,
+	cmdClient command.CommandClient,
+	notificationsClient notifications.NotificationsClient,
+	lock interfaces.ExecutionLock,
+	dbClient interfaces.DBClient) {
 	go func() {
 		for range ticker.C {
-			triggerInterval(lc, configuration)
+			triggerInterval(lc, configuration, msgClient, cmdClient, notificationsClient, lock)
+			triggerDelayedActions(lc, configuration, msgClient, cmdClient, dbClient)
 		}
 	}()
 }
@@ -60,12 +84,16 @@ func clearQueue() {
 }
 
 func clearMaps() {
-	intervalIdToContextMap = make(map[string]*IntervalContext)        // map : interval id -> interval context
-	intervalNameToContextMap = make(map[string]*IntervalContext)      // map : interval name -> interval context
-	intervalNameToIdMap = make(map[string]string)                     // map : interval name -> interval id
-	intervalActionIdToIntervalMap = make(map[string]string)           // map : interval action id -> interval id
-	intervalActionNameToIntervalMap = make(map[string]string)         // map : interval action name -> interval id
-	intervalActionNameToIntervalActionIdMap = make(map[string]string) // map : interval action name -> interval actionId
+	intervalIdToContextMap = make(map[string]*IntervalContext)                   // map : interval id -> interval context
+	intervalNameToContextMap = make(map[string]*IntervalContext)                 // map : interval name -> interval context
+	intervalNameToIdMap = make(map[string]string)                                // map : interval name -> interval id
+	intervalActionIdToIntervalMap = make(map[string]string)                      // map : interval action id -> interval id
+	intervalActionNameToIntervalMap = make(map[string]string)                    // map : interval action name -> interval id
+	intervalActionNameToIntervalActionIdMap = make(map[string]string)            // map : interval action name -> interval actionId
+	intervalActionIdToOverlapPolicyMap = make(map[string]models.OverlapPolicy)   // map : interval action id -> overlap policy
+	blackoutCalendarNameToCalendarMap = make(map[string]models.BlackoutCalendar) // map : blackout calendar name -> calendar
+	intervalIdToBlackoutCalendarsMap = make(map[string][]string)                 // map : interval id -> blackout calendar names
+	delayedActionNameToActionMap = make(map[string]models.DelayedAction)         // map : delayed action name -> delayed action
 
 }
 
@@ -209,6 +237,7 @@ func (qc *QueueClient) RemoveIntervalInQueue(intervalId string) error {
 	}
 
 	deleteIntervalOperation(intervalContext.Interval, intervalContext)
+	delete(intervalIdToBlackoutCalendarsMap, intervalId)
 
 	qc.loggingClient.Info(fmt.Sprintf("removed the interval with id: %s from the scheduler queue", intervalId))
 
@@ -418,13 +447,148 @@ func (qc *QueueClient) RemoveIntervalActionQueue(intervalActionId string) error
 	}
 
 	delete(intervalContext.IntervalActionsMap, intervalActionId)
+	delete(intervalActionIdToOverlapPolicyMap, intervalActionId)
+	deleteExecutionHistory(intervalActionId)
+	deleteFailureCount(intervalActionId)
 
 	qc.loggingClient.Info(fmt.Sprintf("removed the intervalAction with id: %s", intervalActionId))
 
 	return nil
 }
 
-func triggerInterval(lc logger.LoggingClient, configuration *config.ConfigurationStruct) {
+func (qc *QueueClient) SetIntervalActionOverlapPolicy(intervalActionId string, policy models.OverlapPolicy) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, exists := intervalActionIdToIntervalMap[intervalActionId]; !exists {
+		return fmt.Errorf("could not find interval id with interval action id : %s", intervalActionId)
+	}
+
+	if policy == "" {
+		delete(intervalActionIdToOverlapPolicyMap, intervalActionId)
+		qc.loggingClient.Debug(fmt.Sprintf("the intervalAction with id: %s uses the default overlap policy", intervalActionId))
+		return nil
+	}
+	intervalActionIdToOverlapPolicyMap[intervalActionId] = policy
+	qc.loggingClient.Debug(fmt.Sprintf("set the overlap policy of the intervalAction with id: %s to %s", intervalActionId, policy))
+
+	return nil
+}
+
+func (qc *QueueClient) SetBlackoutCalendar(calendar models.BlackoutCalendar) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	blackoutCalendarNameToCalendarMap[calendar.Name] = calendar
+	qc.loggingClient.Debug(fmt.Sprintf("set the blackout calendar with name: %s", calendar.Name))
+
+	return nil
+}
+
+func (qc *QueueClient) RemoveBlackoutCalendar(name string) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, exists := blackoutCalendarNameToCalendarMap[name]; !exists {
+		return fmt.Errorf("scheduler could not find blackout calendar with name : %s", name)
+	}
+	delete(blackoutCalendarNameToCalendarMap, name)
+	qc.loggingClient.Debug(fmt.Sprintf("removed the blackout calendar with name: %s", name))
+
+	return nil
+}
+
+func (qc *QueueClient) SetIntervalBlackoutCalendars(intervalId string, names []string) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if _, exists := intervalIdToContextMap[intervalId]; !exists {
+		return fmt.Errorf("scheduler could not find interval context with interval id : %s", intervalId)
+	}
+
+	if len(names) == 0 {
+		delete(intervalIdToBlackoutCalendarsMap, intervalId)
+		qc.loggingClient.Debug(fmt.Sprintf("detached the blackout calendars of the interval with id: %s", intervalId))
+		return nil
+	}
+	intervalIdToBlackoutCalendarsMap[intervalId] = names
+	qc.loggingClient.Debug(fmt.Sprintf("attached the blackout calendars %v to the interval with id: %s", names, intervalId))
+
+	return nil
+}
+
+func (qc *QueueClient) AddDelayedAction(action models.DelayedAction) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delayedActionNameToActionMap[action.Name] = action
+	qc.loggingClient.Debug(fmt.Sprintf("added the delayed action with name: %s running at: %s", action.Name, action.RunAt))
+
+	return nil
+}
+
+func (qc *QueueClient) RemoveDelayedAction(name string) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delete(delayedActionNameToActionMap, name)
+	qc.loggingClient.Debug(fmt.Sprintf("removed the delayed action with name: %s", name))
+
+	return nil
+}
+
+func (qc *QueueClient) QueryInFlightExecutions() []models.IntervalActionExecution {
+	return inFlightExecutions()
+}
+
+// QueryIntervalNextExecutions returns up to count upcoming executions of the interval with the given name, skipping
+// the blackouts of its calendars
+func (qc *QueueClient) QueryIntervalActionHistory(intervalActionId string) []models.IntervalActionExecutionResult {
+	return executionResults(intervalActionId)
+}
+
+func (qc *QueueClient) QueryIntervalNextExecutions(intervalName string, count int) ([]models.ScheduledExecution, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	intervalContext, exists := intervalNameToContextMap[intervalName]
+	if !exists {
+		return nil, fmt.Errorf("scheduler could not find interval with interval with name : %s", intervalName)
+	}
+
+	var calendars []models.BlackoutCalendar
+	for _, name := range intervalIdToBlackoutCalendarsMap[intervalContext.Interval.ID] {
+		if calendar, exists := blackoutCalendarNameToCalendarMap[name]; exists {
+			calendars = append(calendars, calendar)
+		}
+	}
+	blackoutEnd := func(t time.Time) (time.Time, bool) {
+		var end time.Time
+		for _, calendar := range calendars {
+			if calendarEnd, blackout := calendar.End(t); blackout && calendarEnd.After(end) {
+				end = calendarEnd
+			}
+		}
+		return end, !end.IsZero()
+	}
+
+	executions := make([]models.ScheduledExecution, 0, count)
+	for _, t := range intervalContext.nextExecutions(count, time.Now(), blackoutEnd) {
+		executions = append(executions, models.ScheduledExecution{
+			Time:      t.UTC().Format(TIMELAYOUT),
+			Timestamp: t.UnixNano() / int64(time.Millisecond),
+		})
+	}
+	return executions, nil
+}
+
+func triggerInterval(
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	msgClient messaging.MessageClient,
+	cmdClient command.CommandClient,
+	notificationsClient notifications.NotificationsClient,
+	lock interfaces.ExecutionLock) {
 	nowEpoch := time.Now().Unix()
 
 	defer func() {
@@ -455,7 +619,7 @@ func triggerInterval(lc logger.LoggingClient, configuration *config.Configuratio
 					wg.Add(1)
 
 					// execute it in a individual go routine
-					go execute(intervalContext, &wg, lc, configuration)
+					go execute(intervalContext, &wg, lc, configuration, msgClient, cmdClient, notificationsClient, lock)
 				} else {
 					intervalQueue.Add(intervalContext)
 				}
@@ -470,9 +634,23 @@ func execute(
 	context *IntervalContext,
 	wg *sync.WaitGroup,
 	lc logger.LoggingClient,
-	configuration *config.ConfigurationStruct) {
+	configuration *config.ConfigurationStruct,
+	msgClient messaging.MessageClient,
+	cmdClient command.CommandClient,
+	notificationsClient notifications.NotificationsClient,
+	lock interfaces.ExecutionLock) {
 
 	intervalActionMap := context.IntervalActionsMap
+	if !ownsExecution(context, lc, configuration, lock) {
+		// another instance executes the actions, only keep the schedule in step
+		intervalActionMap = nil
+	} else if calendar, blackout := inBlackout(context.Interval.ID, time.Now()); blackout {
+		lc.Info(fmt.Sprintf(
+			"the interval : %s is in a blackout of calendar : %s, skipping its interval actions",
+			context.Interval.Name,
+			calendar))
+		intervalActionMap = nil
+	}
 
 	defer wg.Done()
 
@@ -484,36 +662,23 @@ func execute(
 
 	lc.Debug(fmt.Sprintf("%d interval action need to be executed.", len(intervalActionMap)))
 
-	// execute interval action one by one
+	// dispatch the interval actions, each one executes in its own go routine
 	for eventId := range intervalActionMap {
 		lc.Debug(
 			"the event with id : " + eventId +
 				" belongs to interval : " + context.Interval.ID + " will be executing!")
 		intervalAction, _ := intervalActionMap[eventId]
 
-		executingUrl := getUrlStr(intervalAction)
-		lc.Debug("the event with id : " + eventId + " will request url : " + executingUrl)
-
-		httpMethod := intervalAction.HTTPMethod
-		if !validMethod(httpMethod) {
-			lc.Error(fmt.Sprintf("net/http: invalid method %q", httpMethod))
-			return
-		}
-
-		req, err := getHttpRequest(httpMethod, executingUrl, intervalAction, lc)
-
-		if err != nil {
-			lc.Error("create new request occurs error : " + err.Error())
-		}
-
-		client := &http.Client{
-			Timeout: time.Duration(configuration.Service.Timeout) * time.Millisecond,
-		}
-		responseBytes, statusCode, err := sendRequestAndGetResponse(client, req)
-		responseStr := string(responseBytes)
-
-		lc.Debug(fmt.Sprintf("execution returns status code : %d", statusCode))
-		lc.Debug("execution returns response content : " + responseStr)
+		dispatchIntervalAction(
+			intervalAction,
+			context.Interval.Name,
+			overlapPolicy(intervalAction.ID, configuration),
+			func() (string, error) {
+				result, err := executeIntervalAction(intervalAction, lc, configuration, msgClient, cmdClient)
+				alertFailures(intervalAction, context.Interval.Name, err, lc, configuration, notificationsClient)
+				return result, err
+			},
+			lc)
 	}
 
 	context.UpdateNextTime()
@@ -529,6 +694,120 @@ func execute(
 	return
 }
 
+// inBlackout tells whether t falls in one of the blackout calendars attached to the interval, and returns the name of
+// that calendar
+func inBlackout(intervalId string, t time.Time) (string, bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for _, name := range intervalIdToBlackoutCalendarsMap[intervalId] {
+		calendar, exists := blackoutCalendarNameToCalendarMap[name]
+		if exists && calendar.Contains(t) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// executeIntervalAction publishes the interval action to the message bus, issues its device command or sends its
+// request, and returns the response recorded in the execution history
+func executeIntervalAction(
+	intervalAction contract.IntervalAction,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	msgClient messaging.MessageClient,
+	cmdClient command.CommandClient) (string, error) {
+
+	eventId := intervalAction.ID
+	if isMessageBusAction(intervalAction) {
+		if err := publishIntervalAction(intervalAction, msgClient); err != nil {
+			lc.Error(fmt.Sprintf("the event with id : %s failed to publish : %s", eventId, err.Error()))
+			return "", err
+		}
+		lc.Debug(fmt.Sprintf("the event with id : %s published to topic : %s", eventId, intervalAction.Topic))
+		return "published to topic : " + intervalAction.Topic, nil
+	}
+
+	if isDeviceCommandAction(intervalAction) {
+		response, err := issueDeviceCommand(intervalAction, configuration, cmdClient)
+		if err != nil {
+			lc.Error(fmt.Sprintf("the event with id : %s failed to issue command : %s of device : %s : %s",
+				eventId, intervalAction.Path, intervalAction.Target, err.Error()))
+			return "", err
+		}
+		lc.Debug(fmt.Sprintf("the event with id : %s issued command : %s of device : %s", eventId, intervalAction.Path, intervalAction.Target))
+		return response, nil
+	}
+
+	executingUrl := getUrlStr(intervalAction)
+	lc.Debug("the event with id : " + eventId + " will request url : " + executingUrl)
+
+	httpMethod := intervalAction.HTTPMethod
+	if !validMethod(httpMethod) {
+		err := fmt.Errorf("net/http: invalid method %q", httpMethod)
+		lc.Error(err.Error())
+		return "", err
+	}
+
+	req, err := getHttpRequest(httpMethod, executingUrl, intervalAction, lc)
+
+	if err != nil {
+		lc.Error("create new request occurs error : " + err.Error())
+		return "", err
+	}
+
+	client := &http.Client{
+		Timeout: time.Duration(configuration.Service.Timeout) * time.Millisecond,
+	}
+	responseBytes, statusCode, err := sendRequestAndGetResponse(client, req)
+	responseStr := string(responseBytes)
+
+	lc.Debug(fmt.Sprintf("execution returns status code : %d", statusCode))
+	lc.Debug("execution returns response content : " + responseStr)
+
+	if err != nil {
+		return "", err
+	} else if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return "", fmt.Errorf("request returned status code : %d : %s", statusCode, responseStr)
+	}
+	return responseStr, nil
+}
+
+// ownsExecution reports whether this instance executes the interval's actions due at its NextTime. With the execution
+// lock enabled only the instance acquiring the lock of the interval's slot does, the lock lasting one period so that
+// the instances firing the same slot later skip it. When the lock can't be acquired because of an error the execution
+// is skipped, as executing could fire the interval on several instances.
+func ownsExecution(
+	context *IntervalContext,
+	lc logger.LoggingClient,
+	configuration *config.ConfigurationStruct,
+	lock interfaces.ExecutionLock) bool {
+
+	if !configuration.Writable.ExecutionLock.Enabled || lock == nil {
+		return true
+	}
+
+	ttl := context.Frequency
+	if context.Interval.RunOnce || ttl <= 0 {
+		var err error
+		ttl, err = time.ParseDuration(configuration.Writable.ExecutionLock.LockTime)
+		if err != nil {
+			lc.Error(fmt.Sprintf("invalid ExecutionLock LockTime '%s' : %s", configuration.Writable.ExecutionLock.LockTime, err.Error()))
+			return false
+		}
+	}
+
+	acquired, err := lock.TryIntervalLock(context.Interval.ID, context.executionSlot(), instanceId, ttl)
+	if err != nil {
+		lc.Error(fmt.Sprintf("failed to acquire the lock of interval : %s, skipping execution : %s", context.Interval.Name, err.Error()))
+		return false
+	}
+	if !acquired {
+		lc.Debug(fmt.Sprintf("the interval : %s is executed by another scheduler instance", context.Interval.Name))
+	}
+	return acquired
+}
+
 // TODO xmlviking We may need to modify this for authorization type in the future
 func getHttpRequest(
 	httpMethod string,
@@ -560,6 +839,71 @@ func getHttpRequest(
 	return req, err
 }
 
+// isMessageBusAction returns true when the interval action publishes to the message bus instead of sending a REST
+// request.
+func isMessageBusAction(intervalAction contract.IntervalAction) bool {
+	return strings.EqualFold(intervalAction.Protocol, config.MessageBusProtocol)
+}
+
+// publishIntervalAction publishes the interval action's parameters as the payload to the interval action's topic.
+func publishIntervalAction(intervalAction contract.IntervalAction, msgClient messaging.MessageClient) error {
+	if msgClient == nil {
+		return errors.New("the message bus is not configured, set MessageQueue.Type to enable MESSAGEBUS interval actions")
+	}
+
+	ctx := context.WithValue(context.Background(), clients.CorrelationHeader, uuid.New().String())
+	ctx = context.WithValue(ctx, clients.ContentType, clients.ContentTypeJSON)
+	envelope := msgTypes.NewMessageEnvelope([]byte(strings.TrimSpace(intervalAction.Parameters)), ctx)
+
+	return msgClient.Publish(envelope, intervalAction.Topic)
+}
+
+// isDeviceCommandAction returns true when the interval action issues a device command through core-command instead of
+// sending a REST request.
+func isDeviceCommandAction(intervalAction contract.IntervalAction) bool {
+	return strings.EqualFold(intervalAction.Protocol, config.DeviceCommandProtocol)
+}
+
+// validateDeviceCommandAction checks that the device command interval action names the device, the command and
+// whether the command is read or set.
+func validateDeviceCommandAction(intervalAction contract.IntervalAction) error {
+	if intervalAction.Target == "" {
+		return errors.New("the target must be the device name")
+	}
+	if intervalAction.Path == "" {
+		return errors.New("the path must be the command name")
+	}
+	method := strings.ToUpper(intervalAction.HTTPMethod)
+	if method != http.MethodGet && method != http.MethodPut {
+		return fmt.Errorf("the method must be %s or %s, not %q", http.MethodGet, http.MethodPut, intervalAction.HTTPMethod)
+	}
+	return nil
+}
+
+// issueDeviceCommand issues the GET or PUT command named by the path to the device named by the target through
+// core-command, and returns the response of the device.
+func issueDeviceCommand(
+	intervalAction contract.IntervalAction,
+	configuration *config.ConfigurationStruct,
+	cmdClient command.CommandClient) (string, error) {
+
+	if cmdClient == nil {
+		return "", errors.New("the core-command client is not configured, set Clients.Command to enable DEVICECOMMAND interval actions")
+	}
+	if err := validateDeviceCommandAction(intervalAction); err != nil {
+		return "", err
+	}
+
+	ctx := context.WithValue(context.Background(), clients.CorrelationHeader, uuid.New().String())
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(configuration.Service.Timeout)*time.Millisecond)
+	defer cancel()
+
+	if strings.EqualFold(intervalAction.HTTPMethod, http.MethodGet) {
+		return cmdClient.GetDeviceCommandByNames(ctx, intervalAction.Target, intervalAction.Path)
+	}
+	return cmdClient.PutDeviceCommandByNames(ctx, intervalAction.Target, intervalAction.Path, strings.TrimSpace(intervalAction.Parameters))
+}
+
 func getUrlStr(intervalAction contract.IntervalAction) string {
 	return intervalAction.GetBaseURL() + intervalAction.Path
 }
@@ -0,0 +1,291 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExecutionLock grants the lock of each interval's slot to the first owner, the locks never expiring.
+type fakeExecutionLock struct {
+	owners map[string]string
+	ttls   map[string]time.Duration
+	err    error
+}
+
+func newFakeExecutionLock() *fakeExecutionLock {
+	return &fakeExecutionLock{owners: make(map[string]string), ttls: make(map[string]time.Duration)}
+}
+
+func (f *fakeExecutionLock) TryIntervalLock(intervalId string, slot int64, owner string, ttl time.Duration) (bool, error) {
+	if f.err != nil {
+		return false, f.err
+	}
+	f.ttls[intervalId] = ttl
+	key := fmt.Sprintf("%s:%d", intervalId, slot)
+	if holder, held := f.owners[key]; held && holder != owner {
+		return false, nil
+	}
+	f.owners[key] = owner
+	return true, nil
+}
+
+func TestOwnsExecution(t *testing.T) {
+	lc := logger.NewMockClient()
+	enabled := &config.ConfigurationStruct{
+		Writable: config.WritableInfo{ExecutionLock: config.ExecutionLockInfo{Enabled: true, LockTime: "1h"}},
+	}
+	disabled := &config.ConfigurationStruct{}
+
+	periodic := &IntervalContext{Interval: models.Interval{ID: "periodic", Name: "periodic"}, Frequency: 10 * time.Second}
+	runOnce := &IntervalContext{Interval: models.Interval{ID: "once", Name: "once", RunOnce: true}}
+	held := &IntervalContext{Interval: models.Interval{ID: "held", Name: "held"}, Frequency: time.Second}
+
+	lock := newFakeExecutionLock()
+	lock.owners[fmt.Sprintf("%s:%d", held.Interval.ID, held.executionSlot())] = "another instance"
+
+	assert.True(t, ownsExecution(held, lc, disabled, lock), "lock must be ignored when disabled")
+	assert.True(t, ownsExecution(held, lc, enabled, nil), "database without lock support must execute")
+
+	assert.True(t, ownsExecution(periodic, lc, enabled, lock))
+	assert.Equal(t, 10*time.Second, lock.ttls[periodic.Interval.ID])
+	assert.True(t, ownsExecution(runOnce, lc, enabled, lock))
+	assert.Equal(t, time.Hour, lock.ttls[runOnce.Interval.ID])
+	assert.False(t, ownsExecution(held, lc, enabled, lock))
+
+	lock.err = errors.New("database unreachable")
+	assert.False(t, ownsExecution(periodic, lc, enabled, lock))
+}
+
+func TestOwnsExecutionAtDifferentPhases(t *testing.T) {
+	lc := logger.NewMockClient()
+	enabled := &config.ConfigurationStruct{
+		Writable: config.WritableInfo{ExecutionLock: config.ExecutionLockInfo{Enabled: true, LockTime: "1h"}},
+	}
+	lock := newFakeExecutionLock()
+
+	// Two instances loading the same interval without Start 7 seconds apart, each scheduling it from then on
+	interval := models.Interval{ID: "phases", Name: "phases", Frequency: "PT10S"}
+	loaded := time.Date(2020, 1, 1, 0, 0, 3, 0, time.UTC)
+	instances := map[string]*IntervalContext{
+		"first":  {Interval: interval, Frequency: 10 * time.Second, NextTime: loaded},
+		"second": {Interval: interval, Frequency: 10 * time.Second, NextTime: loaded.Add(7 * time.Second)},
+	}
+
+	defer func(id string) { instanceId = id }(instanceId)
+	executions := make(map[int64]int)
+	end := loaded.Add(time.Minute)
+	for {
+		// fire the instance due first
+		var owner string
+		for name, context := range instances {
+			if owner == "" || context.NextTime.Before(instances[owner].NextTime) {
+				owner = name
+			}
+		}
+		context := instances[owner]
+		if context.NextTime.After(end) {
+			break
+		}
+		instanceId = owner
+		if ownsExecution(context, lc, enabled, lock) {
+			executions[context.executionSlot()]++
+		}
+		context.NextTime = context.NextTime.Add(context.Frequency)
+	}
+
+	// the interval fired once per period, whichever instance was first
+	assert.Len(t, executions, 7)
+	for slot, count := range executions {
+		assert.Equal(t, 1, count, "slot %d", slot)
+	}
+}
+
+func TestInBlackout(t *testing.T) {
+	clearMaps()
+	defer clearMaps()
+
+	qc := NewSchedulerQueueClient(logger.NewMockClient())
+	interval := models.Interval{ID: "blackout", Name: "blackout", Start: "20160101T000000", Frequency: "PT1H"}
+	assert.NoError(t, qc.AddIntervalToQueue(interval))
+	defer clearQueue()
+
+	calendar := schedulerModels.BlackoutCalendar{
+		Name:     "christmas",
+		Holidays: []schedulerModels.RecurringHoliday{{Month: 12, Day: 25}},
+	}
+	christmas := time.Date(2019, 12, 25, 10, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, qc.SetIntervalBlackoutCalendars(interval.ID, []string{calendar.Name}))
+	_, blackout := inBlackout(interval.ID, christmas)
+	assert.False(t, blackout, "unknown calendars must be ignored")
+
+	assert.NoError(t, qc.SetBlackoutCalendar(calendar))
+	name, blackout := inBlackout(interval.ID, christmas)
+	assert.True(t, blackout)
+	assert.Equal(t, calendar.Name, name)
+	_, blackout = inBlackout(interval.ID, christmas.AddDate(0, 0, 1))
+	assert.False(t, blackout)
+
+	assert.NoError(t, qc.SetIntervalBlackoutCalendars(interval.ID, nil))
+	_, blackout = inBlackout(interval.ID, christmas)
+	assert.False(t, blackout)
+
+	assert.Error(t, qc.SetIntervalBlackoutCalendars("unknown", []string{calendar.Name}))
+	assert.NoError(t, qc.RemoveBlackoutCalendar(calendar.Name))
+	assert.Error(t, qc.RemoveBlackoutCalendar(calendar.Name))
+}
+
+func TestNextExecutions(t *testing.T) {
+	lc := logger.NewMockClient()
+	now := time.Now()
+	calendar := schedulerModels.BlackoutCalendar{
+		Name:     "maintenance",
+		Ranges:   []schedulerModels.BlackoutRange{{Start: "20990101T050000", End: "20990101T130000"}},
+		Holidays: []schedulerModels.RecurringHoliday{{Month: 1, Day: 3}},
+	}
+	noBlackout := func(time.Time) (time.Time, bool) { return time.Time{}, false }
+
+	tests := []struct {
+		name        string
+		interval    models.Interval
+		count       int
+		blackoutEnd func(time.Time) (time.Time, bool)
+		expected    []string
+	}{
+		{"Frequency", models.Interval{Start: "20990101T000000", Frequency: "PT6H"}, 3, noBlackout,
+			[]string{"20990101T000000", "20990101T060000", "20990101T120000"}},
+		{"Until end", models.Interval{Start: "20990101T000000", End: "20990101T120000", Frequency: "PT6H"}, 10, noBlackout,
+			[]string{"20990101T000000", "20990101T060000", "20990101T120000"}},
+		{"Run once", models.Interval{Start: "20990101T000000", Frequency: "PT6H", RunOnce: true}, 10, noBlackout,
+			[]string{"20990101T000000"}},
+		{"Blackouts", models.Interval{Start: "20990101T000000", Frequency: "PT6H"}, 4, calendar.End,
+			[]string{"20990101T000000", "20990101T180000", "20990102T000000", "20990102T060000"}},
+		{"Holiday", models.Interval{Start: "20990102T120000", Frequency: "PT12H"}, 3, calendar.End,
+			[]string{"20990102T120000", "20990104T000000", "20990104T120000"}},
+		{"Complete", models.Interval{Start: "20000101T000000", Frequency: "PT6H", RunOnce: true}, 10, noBlackout, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context := IntervalContext{}
+			context.Reset(tt.interval, lc)
+
+			var actual []string
+			for _, execution := range context.nextExecutions(tt.count, now, tt.blackoutEnd) {
+				actual = append(actual, execution.Format(TIMELAYOUT))
+			}
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestQueryIntervalNextExecutions(t *testing.T) {
+	clearMaps()
+	defer clearMaps()
+
+	qc := NewSchedulerQueueClient(logger.NewMockClient())
+	interval := models.Interval{ID: "next", Name: "next", Start: "20991224T000000", Frequency: "24h"}
+	assert.NoError(t, qc.AddIntervalToQueue(interval))
+	defer clearQueue()
+	assert.NoError(t, qc.SetBlackoutCalendar(schedulerModels.BlackoutCalendar{
+		Name:     "christmas",
+		Holidays: []schedulerModels.RecurringHoliday{{Month: 12, Day: 25}},
+	}))
+	assert.NoError(t, qc.SetIntervalBlackoutCalendars(interval.ID, []string{"christmas"}))
+
+	executions, err := qc.QueryIntervalNextExecutions(interval.Name, 2)
+	assert.NoError(t, err)
+	expected := time.Date(2099, 12, 26, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []schedulerModels.ScheduledExecution{
+		{Time: "20991224T000000", Timestamp: expected.AddDate(0, 0, -2).UnixNano() / int64(time.Millisecond)},
+		{Time: "20991226T000000", Timestamp: expected.UnixNano() / int64(time.Millisecond)},
+	}, executions)
+
+	_, err = qc.QueryIntervalNextExecutions("unknown", 2)
+	assert.Error(t, err)
+}
+
+// fakeCommandClient records the device commands issued through core-command
+type fakeCommandClient struct {
+	issued []string
+}
+
+func (f *fakeCommandClient) Get(context.Context, string, string) (string, error) {
+	return "", errors.New("not supported")
+}
+
+func (f *fakeCommandClient) Put(context.Context, string, string, string) (string, error) {
+	return "", errors.New("not supported")
+}
+
+func (f *fakeCommandClient) GetDeviceCommandByNames(_ context.Context, deviceName string, commandName string) (string, error) {
+	f.issued = append(f.issued, fmt.Sprintf("GET %s %s", deviceName, commandName))
+	return `{"readings":[]}`, nil
+}
+
+func (f *fakeCommandClient) PutDeviceCommandByNames(_ context.Context, deviceName string, commandName string, body string) (string, error) {
+	f.issued = append(f.issued, fmt.Sprintf("PUT %s %s %s", deviceName, commandName, body))
+	return "", nil
+}
+
+func TestExecuteDeviceCommandIntervalAction(t *testing.T) {
+	lc := logger.NewMockClient()
+	configuration := &config.ConfigurationStruct{}
+	configuration.Service.Timeout = 1000
+
+	tests := []struct {
+		name             string
+		action           models.IntervalAction
+		expectedIssued   []string
+		expectedResponse string
+		errorExpected    bool
+	}{
+		{"get", models.IntervalAction{Protocol: "DEVICECOMMAND", Target: "valve", Path: "state", HTTPMethod: "GET"},
+			[]string{"GET valve state"}, `{"readings":[]}`, false},
+		{"set", models.IntervalAction{Protocol: "devicecommand", Target: "valve", Path: "state", HTTPMethod: "put", Parameters: ` {"state":"closed"} `},
+			[]string{`PUT valve state {"state":"closed"}`}, "", false},
+		{"no command", models.IntervalAction{Protocol: "DEVICECOMMAND", Target: "valve", HTTPMethod: "GET"}, nil, "", true},
+		{"invalid method", models.IntervalAction{Protocol: "DEVICECOMMAND", Target: "valve", Path: "state", HTTPMethod: "POST"}, nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdClient := &fakeCommandClient{}
+			response, err := executeIntervalAction(tt.action, lc, configuration, nil, cmdClient)
+			if tt.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResponse, response)
+			assert.Equal(t, tt.expectedIssued, cmdClient.issued)
+		})
+	}
+
+	_, err := executeIntervalAction(tests[0].action, lc, configuration, nil, nil)
+	assert.Error(t, err, "the device command must fail without core-command client")
+}
@@ -84,6 +84,20 @@ func (sc *IntervalContext) Reset(interval models.Interval, lc logger.LoggingClie
 	}
 }
 
+// executionSlot returns the number of the period the execution due at NextTime falls in, the same on every scheduler
+// instance. Periods are counted from the interval's Start, or from the Unix epoch for intervals without Start since
+// each instance then starts the schedule when it loads the interval. Run once intervals have a single slot.
+func (sc *IntervalContext) executionSlot() int64 {
+	if sc.Interval.RunOnce || sc.Frequency <= 0 {
+		return 0
+	}
+	anchor := time.Unix(0, 0)
+	if sc.Interval.Start != "" {
+		anchor = sc.StartTime
+	}
+	return int64(sc.NextTime.Sub(anchor) / sc.Frequency)
+}
+
 func (sc *IntervalContext) IsComplete() bool {
 	return sc.isComplete(time.Now())
 }
@@ -105,8 +119,51 @@ func (sc *IntervalContext) GetInfo() string {
 }
 
 func (sc *IntervalContext) isComplete(time time.Time) bool {
-	complete := (sc.StartTime.Unix() < time.Unix() && sc.Interval.RunOnce) ||
-		(sc.NextTime.Unix() > sc.EndTime.Unix()) ||
-		((sc.MaxIterations != 0) && (sc.CurrentIterations >= sc.MaxIterations))
-	return complete
+	return sc.completeAt(sc.NextTime, sc.CurrentIterations, time)
+}
+
+// maxPreviewBlackouts bounds the blackouts skipped while previewing executions, so that a calendar blacking out every
+// day of the year doesn't preview forever
+const maxPreviewBlackouts = 1000
+
+// nextExecutions returns up to count upcoming executions of the interval from now, the way the scheduler fires them:
+// every frequency from the next time until the end, once for run once intervals, and skipping the times blackoutEnd
+// reports in a blackout until that blackout ends
+func (sc *IntervalContext) nextExecutions(
+	count int,
+	now time.Time,
+	blackoutEnd func(time.Time) (time.Time, bool)) []time.Time {
+
+	var executions []time.Time
+	next := sc.NextTime
+	iterations := sc.CurrentIterations
+	blackouts := 0
+	for len(executions) < count && !sc.completeAt(next, iterations, now) {
+		if end, blackout := blackoutEnd(next); blackout {
+			blackouts++
+			if sc.Frequency <= 0 || blackouts > maxPreviewBlackouts {
+				break
+			}
+			// the skipped times still count as iterations, like in execute
+			skipped := int64((end.Sub(next) + sc.Frequency - 1) / sc.Frequency)
+			next = next.Add(time.Duration(skipped) * sc.Frequency)
+			iterations += skipped
+			continue
+		}
+
+		executions = append(executions, next)
+		if sc.Interval.RunOnce || sc.Frequency <= 0 {
+			break
+		}
+		next = next.Add(sc.Frequency)
+		iterations++
+	}
+	return executions
+}
+
+// completeAt tells whether the interval is complete at time with the next time and iterations given
+func (sc *IntervalContext) completeAt(next time.Time, iterations int64, time time.Time) bool {
+	return (sc.StartTime.Unix() < time.Unix() && sc.Interval.RunOnce) ||
+		(next.Unix() > sc.EndTime.Unix()) ||
+		((sc.MaxIterations != 0) && (iterations >= sc.MaxIterations))
 }
@@ -15,6 +15,10 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/config"
 )
 
@@ -29,12 +33,50 @@ type ConfigurationStruct struct {
 	Registry         bootstrapConfig.RegistryInfo
 	FormatSpecifier  string
 	SecretStore      bootstrapConfig.SecretStoreInfo
+
+	// RequestLimits rejects the request bodies larger than the configured sizes with 413
+	RequestLimits bodylimit.LimitsInfo
+	// TrustedHeaders verifies the claims of the caller forwarded by the API gateway
+	TrustedHeaders claims.TrustedHeadersInfo
+
+	// Watchdog monitors the health of the managed services and restarts the unhealthy ones
+	Watchdog WatchdogInfo
+}
+
+// WatchdogInfo configures the monitoring of the health endpoints of the managed services and the policy restarting the
+// unhealthy ones through the executor.
+type WatchdogInfo struct {
+	// Enabled starts the watchdog. Changes apply after a restart.
+	Enabled bool
+	// Services are the keys of the monitored services, e.g. 'edgex-core-data', whose health endpoint is the ping
+	// endpoint of their client
+	Services []string
+	// Interval is how often the health endpoints are probed, e.g. '30s'
+	Interval string
+	// Timeout is how long a health endpoint may take to answer, e.g. '5s'
+	Timeout string
+	// FailureThreshold is the number of consecutive failed probes after which a service is restarted
+	FailureThreshold int
+	// MaxRestarts is the maximum number of restarts of a service within Window, after which the watchdog gives up
+	// restarting it until it is healthy again. 0 only alerts about the unhealthy services.
+	MaxRestarts int
+	// Window is the period the restarts are counted over, e.g. '1h'
+	Window string
+	// InitialBackoff is the minimum wait after a restart before restarting the service again, doubled with each restart
+	// within Window up to MaxBackoff, e.g. '30s'
+	InitialBackoff string
+	MaxBackoff     string
+	// Alert sends a notification through support-notifications when a service is restarted, fails to restart or is
+	// given up on
+	Alert bool
 }
 
 type WritableInfo struct {
 	ResendLimit     int
 	LogLevel        string
 	InsecureSecrets bootstrapConfig.InsecureSecrets
+	// Compression compresses the responses of the service
+	Compression compression.ResponsesInfo
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
@@ -0,0 +1,34 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/watchdog"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/di"
+)
+
+// WatchdogName contains the name of the watchdog.Watchdog instance in the DIC.
+var WatchdogName = di.TypeInstanceToName((*watchdog.Watchdog)(nil))
+
+// WatchdogFrom helper function queries the DIC and returns the watchdog.Watchdog instance, nil when the watchdog isn't
+// enabled.
+func WatchdogFrom(get di.Get) *watchdog.Watchdog {
+	w, ok := get(WatchdogName).(*watchdog.Watchdog)
+	if !ok {
+		return nil
+	}
+	return w
+}
@@ -14,10 +14,34 @@
 
 package executor
 
-import "os/exec"
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
 
 // CommandExecutor provides the common callout to the configuration-defined executor.
 func CommandExecutor(executorPath, serviceName, operation string) (string, error) {
 	bytes, err := exec.Command(executorPath, serviceName, operation).CombinedOutput()
 	return string(bytes), err
 }
+
+// StreamCommandExecutor provides the callout to the configuration-defined executor for operations whose output is
+// streamed, such as logs.  The executor's stdout is written to w as it's produced; its stderr is returned as the error
+// if the executor fails.
+func StreamCommandExecutor(ctx context.Context, w io.Writer, executorPath string, arg ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executorPath, arg...)
+	cmd.Stdout = w
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("%s (%s)", message, err.Error())
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package executor
+
+import (
+	"context"
+	"io"
+
+	"github.com/edgexfoundry/edgex-go/internal/system"
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/interfaces"
+)
+
+// logs contains references to dependencies required to retrieve service logs via executor use case.
+type logs struct {
+	executor     interfaces.StreamCommandExecutor
+	executorPath string
+}
+
+// NewLogs is a factory function that returns an initialized logs receiver struct.
+func NewLogs(executor interfaces.StreamCommandExecutor, executorPath string) *logs {
+	return &logs{
+		executor:     executor,
+		executorPath: executorPath,
+	}
+}
+
+// Stream implements the Logs interface to write the logs of the service, as retrieved by the configuration-defined
+// executor (e.g. docker logs or journald), to w.  since and tail are passed to the executor as-is, empty when unset.
+func (e logs) Stream(ctx context.Context, w io.Writer, service, since, tail string) error {
+	return e.executor(ctx, w, e.executorPath, service, system.Logs, since, tail)
+}
@@ -16,6 +16,9 @@ package agent
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/urlclient/local"
@@ -26,6 +29,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/system/agent/executor"
 	"github.com/edgexfoundry/edgex-go/internal/system/agent/getconfig"
 	"github.com/edgexfoundry/edgex-go/internal/system/agent/setconfig"
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/watchdog"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/bootstrap/startup"
@@ -33,6 +37,7 @@ import (
 
 	contracts "github.com/edgexfoundry/go-mod-core-contracts/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/clients/general"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
 	"github.com/gorilla/mux"
 )
 
@@ -49,7 +54,7 @@ func NewBootstrap(router *mux.Router) *Bootstrap {
 }
 
 // BootstrapHandler fulfills the BootstrapHandler contract.  It implements agent-specific initialization.
-func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	loadRestRoutes(b.router, dic)
 
 	configuration := container.ConfigurationFrom(dic.Get)
@@ -91,6 +96,9 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 				bootstrapContainer.LoggingClientFrom(get),
 				configuration.ExecutorPath)
 		},
+		container.LogsInterfaceName: func(get di.Get) interface{} {
+			return executor.NewLogs(executor.StreamCommandExecutor, configuration.ExecutorPath)
+		},
 		container.GetConfigInterfaceName: func(get di.Get) interface{} {
 			logging := bootstrapContainer.LoggingClientFrom(get)
 			return getconfig.New(
@@ -115,6 +123,54 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		)
 	}
 
+	if configuration.Watchdog.Enabled && !b.startWatchdog(ctx, wg, dic) {
+		return false
+	}
+
+	return true
+}
+
+// startWatchdog starts monitoring the health of the configured services, probing the ping endpoint of their client.
+func (b *Bootstrap) startWatchdog(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) bool {
+	configuration := container.ConfigurationFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	defaultServices := b.listDefaultServices()
+	services := make(map[string]string, len(configuration.Watchdog.Services))
+	for _, serviceKey := range configuration.Watchdog.Services {
+		client, ok := configuration.Clients[defaultServices[serviceKey]]
+		if !ok {
+			lc.Error(fmt.Sprintf("watchdog: no client is configured for service %s", serviceKey))
+			return false
+		}
+		services[serviceKey] = client.Url() + contracts.ApiPingRoute
+	}
+
+	var notificationsClient notifications.NotificationsClient
+	if configuration.Watchdog.Alert {
+		notificationsClient = notifications.NewNotificationsClient(
+			local.New(configuration.Clients["Notifications"].Url() + contracts.ApiNotificationRoute))
+	}
+
+	w, err := watchdog.New(
+		configuration.Watchdog,
+		services,
+		watchdog.PingCheck(&http.Client{}),
+		container.OperationsFrom(dic.Get),
+		notificationsClient,
+		lc)
+	if err != nil {
+		lc.Error(err.Error())
+		return false
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.WatchdogName: func(get di.Get) interface{} {
+			return w
+		},
+	})
+	w.Run(ctx, wg)
+
+	lc.Info(fmt.Sprintf("watchdog monitoring services: %s", strings.Join(configuration.Watchdog.Services, ", ")))
 	return true
 }
 
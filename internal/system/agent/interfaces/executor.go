@@ -14,4 +14,11 @@
 
 package interfaces
 
+import (
+	"context"
+	"io"
+)
+
 type CommandExecutor func(executorPath, serviceName, operation string) (string, error)
+
+type StreamCommandExecutor func(ctx context.Context, w io.Writer, executorPath string, arg ...string) error
@@ -16,14 +16,22 @@
 package agent
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/bodylimit"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/claims"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/compression"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/usage"
 	"github.com/edgexfoundry/edgex-go/internal/system/agent/container"
 	"github.com/edgexfoundry/edgex-go/internal/system/agent/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/watchdog"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/di"
@@ -70,6 +78,11 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 		func(w http.ResponseWriter, r *http.Request) {
 			healthHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get), bootstrapContainer.RegistryFrom(dic.Get))
 		}).Methods(http.MethodGet)
+	b.HandleFunc(
+		"/watchdog",
+		func(w http.ResponseWriter, r *http.Request) {
+			watchdogHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get), container.WatchdogFrom(dic.Get))
+		}).Methods(http.MethodGet)
 	b.HandleFunc(
 		"/ping",
 		func(w http.ResponseWriter, _ *http.Request) {
@@ -77,11 +90,32 @@ func loadRestRoutes(r *mux.Router, dic *di.Container) {
 			_, _ = w.Write([]byte("pong"))
 		}).Methods(http.MethodGet)
 
+	v2 := r.PathPrefix("/api/v2").Subrouter()
+
+	v2.HandleFunc(
+		"/system/service/{name}/logs",
+		func(w http.ResponseWriter, r *http.Request) {
+			logsHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get), container.LogsFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	r.HandleFunc(clients.ApiVersionRoute, pkg.VersionHandler).Methods(http.MethodGet)
 
+	// Usage
+	r.HandleFunc(
+		usage.ApiUsageRoute,
+		func(w http.ResponseWriter, r *http.Request) {
+			usage.DefaultRecorder.ReportHandler(w, r, bootstrapContainer.LoggingClientFrom(dic.Get))
+		}).Methods(http.MethodGet)
+
 	r.Use(correlation.ManageHeader)
 	r.Use(correlation.OnResponseComplete)
 	r.Use(correlation.OnRequestBegin)
+	r.Use(usage.Middleware)
+	r.Use(bodylimit.NewMiddleware(container.ConfigurationFrom(dic.Get).RequestLimits, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(claims.NewMiddleware(container.ConfigurationFrom(dic.Get).TrustedHeaders, bootstrapContainer.LoggingClientFrom(dic.Get)))
+	r.Use(compression.NewMiddleware(func() compression.ResponsesInfo {
+		return container.ConfigurationFrom(dic.Get).Writable.Compression
+	}))
 }
 
 // metricsHandler implements a controller to execute a metrics request.
@@ -130,6 +164,23 @@ func operationHandler(
 	pkg.Encode(operationsImpl.Do(o.Services, o.Action), w, lc)
 }
 
+// watchdogHandler implements a controller to report the health and restarts of the services monitored by the watchdog.
+func watchdogHandler(
+	w http.ResponseWriter,
+	_ *http.Request,
+	lc logger.LoggingClient,
+	dog *watchdog.Watchdog) {
+
+	if dog == nil {
+		const errorMessage = "the watchdog is not enabled"
+		http.Error(w, errorMessage, http.StatusNotFound)
+		lc.Error(errorMessage)
+		return
+	}
+
+	pkg.Encode(dog.Statuses(), w, lc)
+}
+
 // getConfigHandler implements a controller to execute a get configuration request.
 func getConfigHandler(
 	w http.ResponseWriter,
@@ -184,3 +235,78 @@ func healthHandler(
 
 	pkg.Encode(getHealth(strings.Split(vars["services"], ","), registryClient), w, lc)
 }
+
+// defaultLogsTail is the number of lines returned by a logs request which specifies neither since nor tail.
+const defaultLogsTail = "100"
+
+// logsHandler implements a controller to stream the logs of a service, optionally limited to those written since a
+// timestamp or duration ago and/or to the last tail lines.
+func logsHandler(
+	w http.ResponseWriter,
+	r *http.Request,
+	lc logger.LoggingClient,
+	logsImpl interfaces.Logs) {
+
+	vars := mux.Vars(r)
+	service := vars["name"]
+	since := r.URL.Query().Get("since")
+	tail := r.URL.Query().Get("tail")
+
+	if err := validateLogsParameters(since, tail); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		lc.Error(err.Error())
+		return
+	}
+	if since == "" && tail == "" {
+		tail = defaultLogsTail
+	}
+
+	lc.Debug("retrieving logs of " + service)
+
+	sw := &streamWriter{ResponseWriter: w}
+	if err := logsImpl.Stream(r.Context(), sw, service, since, tail); err != nil {
+		lc.Error(fmt.Sprintf("failed to retrieve logs of %s: %s", service, err.Error()))
+		if !sw.started {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// validateLogsParameters verifies since is a duration, an RFC 3339 timestamp or a Unix timestamp and tail is a number
+// of lines or "all", as understood by the executors.
+func validateLogsParameters(since, tail string) error {
+	if since != "" {
+		_, durationErr := time.ParseDuration(since)
+		_, timestampErr := time.Parse(time.RFC3339, since)
+		_, unixErr := strconv.ParseInt(since, 10, 64)
+		if durationErr != nil && timestampErr != nil && unixErr != nil {
+			return fmt.Errorf("invalid since '%s': must be a duration, an RFC 3339 timestamp or a Unix timestamp", since)
+		}
+	}
+	if tail != "" && tail != "all" {
+		if lines, err := strconv.Atoi(tail); err != nil || lines < 0 {
+			return fmt.Errorf("invalid tail '%s': must be a non-negative number of lines or 'all'", tail)
+		}
+	}
+	return nil
+}
+
+// streamWriter writes the streamed logs as plain text and flushes each write so it reaches the client right away.
+type streamWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (sw *streamWriter) Write(b []byte) (int, error) {
+	if !sw.started {
+		sw.started = true
+		sw.Header().Set(clients.ContentType, clients.ContentTypeText)
+		sw.WriteHeader(http.StatusOK)
+	}
+
+	n, err := sw.ResponseWriter.Write(b)
+	if flusher, ok := sw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
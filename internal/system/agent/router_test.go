@@ -0,0 +1,81 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type logsStub struct {
+	output string
+	err    error
+	since  string
+	tail   string
+}
+
+func (l *logsStub) Stream(_ context.Context, w io.Writer, _, since, tail string) error {
+	l.since, l.tail = since, tail
+	if l.output != "" {
+		_, _ = w.Write([]byte(l.output))
+	}
+	return l.err
+}
+
+func TestLogsHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		stub               logsStub
+		expectedStatusCode int
+		expectedBody       string
+		expectedSince      string
+		expectedTail       string
+	}{
+		{"default tail", "", logsStub{output: "line\n"}, http.StatusOK, "line\n", "", defaultLogsTail},
+		{"since duration", "?since=10m", logsStub{output: "line\n"}, http.StatusOK, "line\n", "10m", ""},
+		{"since timestamp and tail", "?since=2020-10-01T10:00:00Z&tail=all", logsStub{output: "line\n"}, http.StatusOK, "line\n", "2020-10-01T10:00:00Z", "all"},
+		{"invalid since", "?since=yesterday", logsStub{}, http.StatusBadRequest, "", "", ""},
+		{"invalid tail", "?tail=-1", logsStub{}, http.StatusBadRequest, "", "", ""},
+		{"executor fails", "", logsStub{err: errors.New("container edgex-core-data not found")}, http.StatusInternalServerError, "container edgex-core-data not found\n", "", defaultLogsTail},
+		{"executor fails after streaming", "", logsStub{output: "line\n", err: errors.New("signal: killed")}, http.StatusOK, "line\n", "", defaultLogsTail},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stub := test.stub
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/system/service/edgex-core-data/logs"+test.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"name": "edgex-core-data"})
+			recorder := httptest.NewRecorder()
+
+			logsHandler(recorder, req, logger.NewMockClient(), &stub)
+
+			assert.Equal(t, test.expectedStatusCode, recorder.Code)
+			if test.expectedBody != "" {
+				assert.Equal(t, test.expectedBody, recorder.Body.String())
+			}
+			assert.Equal(t, test.expectedSince, stub.since)
+			assert.Equal(t, test.expectedTail, stub.tail)
+		})
+	}
+}
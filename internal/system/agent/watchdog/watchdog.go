@@ -0,0 +1,361 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/system"
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/config"
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/system/executor"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+)
+
+// HealthCheck probes the health endpoint of a managed service, returning why it is unhealthy.
+type HealthCheck func(ctx context.Context, url string) error
+
+// ServiceStatus is the health and restart history of a service monitored by the watchdog.
+type ServiceStatus struct {
+	Service             string `json:"service"`
+	Healthy             bool   `json:"healthy"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+	LastError           string `json:"lastError,omitempty"`
+	// Restarts is the number of restarts within the policy window
+	Restarts int `json:"restarts"`
+	// LastRestart is the time of the last restart, in milliseconds since the epoch
+	LastRestart int64 `json:"lastRestart,omitempty"`
+	// NextRestart is the earliest time of the next restart, in milliseconds since the epoch
+	NextRestart int64 `json:"nextRestart,omitempty"`
+	// GaveUp is set once the restarts within the policy window reached the maximum, until the service is healthy again
+	GaveUp bool `json:"gaveUp"`
+}
+
+// policy is the parsed restart policy of the watchdog configuration.
+type policy struct {
+	interval         time.Duration
+	timeout          time.Duration
+	failureThreshold int
+	maxRestarts      int
+	window           time.Duration
+	initialBackoff   time.Duration
+	maxBackoff       time.Duration
+}
+
+// serviceState is the health and restart history of a monitored service.
+type serviceState struct {
+	url                 string
+	healthy             bool
+	consecutiveFailures int
+	lastError           string
+	restarts            []time.Time
+	nextRestart         time.Time
+	gaveUp              bool
+}
+
+// Watchdog monitors the health endpoints of the managed services and restarts the unhealthy ones according to the
+// restart policy: a service failing FailureThreshold consecutive probes is restarted, at most MaxRestarts times within
+// Window, waiting an exponential backoff between restarts. The restarts, and giving up on restarting, are alerted
+// through support-notifications.
+type Watchdog struct {
+	policy        policy
+	check         HealthCheck
+	operations    interfaces.Operations
+	notifications notifications.NotificationsClient
+	lc            logger.LoggingClient
+	now           func() time.Time
+
+	mutex    sync.Mutex
+	services map[string]*serviceState
+}
+
+// New returns a watchdog of the services, by service key to health endpoint URL. Restarts aren't alerted when the
+// notifications client is nil.
+func New(
+	info config.WatchdogInfo,
+	services map[string]string,
+	check HealthCheck,
+	operations interfaces.Operations,
+	notificationsClient notifications.NotificationsClient,
+	lc logger.LoggingClient) (*Watchdog, error) {
+
+	p, err := parsePolicy(info)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watchdog{
+		policy:        p,
+		check:         check,
+		operations:    operations,
+		notifications: notificationsClient,
+		lc:            lc,
+		now:           time.Now,
+		services:      make(map[string]*serviceState, len(services)),
+	}
+	for service, url := range services {
+		w.services[service] = &serviceState{url: url, healthy: true}
+	}
+	return w, nil
+}
+
+// Run probes the services on the policy interval until the context is done.
+func (w *Watchdog) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(w.policy.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.probe(ctx)
+			}
+		}
+	}()
+}
+
+// Statuses returns the status of the monitored services, ordered by service key.
+func (w *Watchdog) Statuses() []ServiceStatus {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	now := w.now()
+	statuses := make([]ServiceStatus, 0, len(w.services))
+	for _, service := range w.serviceKeys() {
+		s := w.services[service]
+		s.restarts = w.restartsWithinWindow(s, now)
+		status := ServiceStatus{
+			Service:             service,
+			Healthy:             s.healthy,
+			ConsecutiveFailures: s.consecutiveFailures,
+			LastError:           s.lastError,
+			Restarts:            len(s.restarts),
+			GaveUp:              s.gaveUp,
+		}
+		if len(s.restarts) > 0 {
+			status.LastRestart = toMillis(s.restarts[len(s.restarts)-1])
+		}
+		if !s.nextRestart.IsZero() && s.nextRestart.After(now) {
+			status.NextRestart = toMillis(s.nextRestart)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// probe checks the health of every service and applies the restart policy to the unhealthy ones.
+func (w *Watchdog) probe(ctx context.Context) {
+	w.mutex.Lock()
+	keys := w.serviceKeys()
+	w.mutex.Unlock()
+
+	for _, service := range keys {
+		w.mutex.Lock()
+		url := w.services[service].url
+		w.mutex.Unlock()
+
+		checkCtx, cancel := context.WithTimeout(ctx, w.policy.timeout)
+		err := w.check(checkCtx, url)
+		cancel()
+		w.observe(ctx, service, err)
+	}
+}
+
+// observe records the outcome of a health probe of the service, restarting it when the policy says so.
+func (w *Watchdog) observe(ctx context.Context, service string, healthErr error) {
+	w.mutex.Lock()
+	s := w.services[service]
+	now := w.now()
+
+	if healthErr == nil {
+		recovered := s.gaveUp
+		s.healthy = true
+		s.consecutiveFailures = 0
+		s.lastError = ""
+		s.gaveUp = false
+		w.mutex.Unlock()
+		if recovered {
+			w.lc.Info(fmt.Sprintf("watchdog: service %s is healthy again", service))
+			w.alert(ctx, service, notifications.NORMAL, fmt.Sprintf("Service %s is healthy again", service))
+		}
+		return
+	}
+
+	s.healthy = false
+	s.consecutiveFailures++
+	s.lastError = healthErr.Error()
+	w.lc.Warn(fmt.Sprintf("watchdog: service %s failed health check %d time(s): %s", service, s.consecutiveFailures, s.lastError))
+	if s.gaveUp || s.consecutiveFailures < w.policy.failureThreshold || now.Before(s.nextRestart) {
+		w.mutex.Unlock()
+		return
+	}
+
+	s.restarts = w.restartsWithinWindow(s, now)
+	if len(s.restarts) >= w.policy.maxRestarts {
+		s.gaveUp = true
+		w.mutex.Unlock()
+		message := fmt.Sprintf(
+			"Service %s is unhealthy and was restarted %d time(s) within %s, giving up restarting it: %s",
+			service, w.policy.maxRestarts, w.policy.window, healthErr.Error())
+		w.lc.Error("watchdog: " + message)
+		w.alert(ctx, service, notifications.CRITICAL, message)
+		return
+	}
+
+	s.restarts = append(s.restarts, now)
+	s.consecutiveFailures = 0
+	s.nextRestart = now.Add(w.backoff(len(s.restarts)))
+	w.mutex.Unlock()
+
+	w.lc.Info(fmt.Sprintf("watchdog: restarting unhealthy service %s", service))
+	if err := w.restart(service); err != nil {
+		message := fmt.Sprintf("Service %s is unhealthy and failed to restart: %s", service, err.Error())
+		w.lc.Error("watchdog: " + message)
+		w.alert(ctx, service, notifications.CRITICAL, message)
+		return
+	}
+	w.alert(ctx, service, notifications.NORMAL, fmt.Sprintf("Service %s was unhealthy and has been restarted: %s", service, healthErr.Error()))
+}
+
+// restart restarts the service through the configured executor.
+func (w *Watchdog) restart(service string) error {
+	for _, result := range w.operations.Do([]string{service}, executor.Restart) {
+		if failure, ok := result.(*system.FailureResult); ok {
+			return fmt.Errorf("%s", failure.ErrorMessage)
+		}
+	}
+	return nil
+}
+
+// alert sends a notification about the service, when alerts are enabled.
+func (w *Watchdog) alert(ctx context.Context, service string, severity notifications.SeverityEnum, content string) {
+	if w.notifications == nil {
+		return
+	}
+
+	n := notifications.Notification{
+		Slug:     fmt.Sprintf("watchdog-%s-%d", service, w.now().UnixNano()),
+		Sender:   clients.SystemManagementAgentServiceKey,
+		Category: notifications.SW_HEALTH,
+		Severity: severity,
+		Content:  content,
+		Labels:   []string{"watchdog", service},
+	}
+	alertCtx, cancel := context.WithTimeout(ctx, w.policy.timeout)
+	defer cancel()
+	if err := w.notifications.SendNotification(alertCtx, n); err != nil {
+		w.lc.Error(fmt.Sprintf("watchdog: unable to send notification about service %s: %s", service, err.Error()))
+	}
+}
+
+// backoff returns how long to wait after the n-th restart within the window before restarting again.
+func (w *Watchdog) backoff(n int) time.Duration {
+	backoff := w.policy.initialBackoff
+	for i := 1; i < n; i++ {
+		backoff *= 2
+		if backoff >= w.policy.maxBackoff {
+			return w.policy.maxBackoff
+		}
+	}
+	return backoff
+}
+
+// restartsWithinWindow returns the restarts of the service since the start of the policy window.
+func (w *Watchdog) restartsWithinWindow(s *serviceState, now time.Time) []time.Time {
+	start := now.Add(-w.policy.window)
+	var restarts []time.Time
+	for _, t := range s.restarts {
+		if t.After(start) {
+			restarts = append(restarts, t)
+		}
+	}
+	return restarts
+}
+
+func (w *Watchdog) serviceKeys() []string {
+	keys := make([]string, 0, len(w.services))
+	for service := range w.services {
+		keys = append(keys, service)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// PingCheck probes the ping endpoint of a service, which is healthy when it answers with 200.
+func PingCheck(client *http.Client) HealthCheck {
+	return func(ctx context.Context, url string) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("health endpoint %s returned %s", url, resp.Status)
+		}
+		return nil
+	}
+}
+
+func parsePolicy(info config.WatchdogInfo) (policy, error) {
+	p := policy{failureThreshold: info.FailureThreshold, maxRestarts: info.MaxRestarts}
+	durations := []struct {
+		name  string
+		value string
+		to    *time.Duration
+	}{
+		{"Interval", info.Interval, &p.interval},
+		{"Timeout", info.Timeout, &p.timeout},
+		{"Window", info.Window, &p.window},
+		{"InitialBackoff", info.InitialBackoff, &p.initialBackoff},
+		{"MaxBackoff", info.MaxBackoff, &p.maxBackoff},
+	}
+	for _, d := range durations {
+		duration, err := time.ParseDuration(d.value)
+		if err != nil || duration <= 0 {
+			return p, fmt.Errorf("invalid watchdog %s: '%s'", d.name, d.value)
+		}
+		*d.to = duration
+	}
+	if p.failureThreshold < 1 {
+		return p, fmt.Errorf("invalid watchdog FailureThreshold: %d", p.failureThreshold)
+	}
+	if p.maxRestarts < 0 {
+		return p, fmt.Errorf("invalid watchdog MaxRestarts: %d", p.maxRestarts)
+	}
+	if p.maxBackoff < p.initialBackoff {
+		p.maxBackoff = p.initialBackoff
+	}
+	return p, nil
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,190 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package watchdog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/system"
+	"github.com/edgexfoundry/edgex-go/internal/system/agent/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/clients/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testService = "edgex-core-data"
+
+var testInfo = config.WatchdogInfo{
+	Enabled:          true,
+	Interval:         "30s",
+	Timeout:          "5s",
+	FailureThreshold: 2,
+	MaxRestarts:      2,
+	Window:           "1h",
+	InitialBackoff:   "1m",
+	MaxBackoff:       "3m",
+	Alert:            true,
+}
+
+type fakeOperations struct {
+	restarts []string
+	fail     bool
+}
+
+func (o *fakeOperations) Do(services []string, operation string) []interface{} {
+	o.restarts = append(o.restarts, services...)
+	if o.fail {
+		return []interface{}{system.Failure(services[0], operation, "test", "container not found")}
+	}
+	return []interface{}{system.Success(services[0], operation, "test")}
+}
+
+type fakeNotifications struct {
+	sent []notifications.Notification
+}
+
+func (n *fakeNotifications) SendNotification(_ context.Context, notification notifications.Notification) error {
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func newTestWatchdog(t *testing.T, operations *fakeOperations, alerts *fakeNotifications, now *time.Time) *Watchdog {
+	w, err := New(testInfo, map[string]string{testService: "http://localhost:48080/api/v1/ping"}, nil, operations, alerts, logger.NewMockClient())
+	require.NoError(t, err)
+	w.now = func() time.Time { return *now }
+	return w
+}
+
+func TestObserveRestartPolicy(t *testing.T) {
+	operations := &fakeOperations{}
+	alerts := &fakeNotifications{}
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	w := newTestWatchdog(t, operations, alerts, &now)
+	unhealthy := errors.New("connection refused")
+	ctx := context.Background()
+
+	// a single failure is below the threshold
+	w.observe(ctx, testService, unhealthy)
+	assert.Empty(t, operations.restarts)
+
+	// the second consecutive failure restarts the service
+	w.observe(ctx, testService, unhealthy)
+	require.Len(t, operations.restarts, 1)
+	require.Len(t, alerts.sent, 1)
+	assert.Equal(t, notifications.NORMAL, alerts.sent[0].Severity)
+
+	// the failures within the backoff don't restart it
+	now = now.Add(30 * time.Second)
+	w.observe(ctx, testService, unhealthy)
+	w.observe(ctx, testService, unhealthy)
+	assert.Len(t, operations.restarts, 1)
+	status := w.Statuses()[0]
+	assert.Equal(t, 1, status.Restarts)
+	assert.Equal(t, toMillis(now.Add(30*time.Second)), status.NextRestart)
+
+	// past the backoff it is restarted again
+	now = now.Add(time.Minute)
+	w.observe(ctx, testService, unhealthy)
+	assert.Len(t, operations.restarts, 2)
+
+	// the maximum restarts within the window are reached, the watchdog gives up
+	now = now.Add(5 * time.Minute)
+	w.observe(ctx, testService, unhealthy)
+	w.observe(ctx, testService, unhealthy)
+	assert.Len(t, operations.restarts, 2)
+	require.Len(t, alerts.sent, 3)
+	assert.Equal(t, notifications.CRITICAL, alerts.sent[2].Severity)
+	assert.True(t, w.Statuses()[0].GaveUp)
+
+	// the watchdog resumes once the service is healthy again
+	w.observe(ctx, testService, nil)
+	status = w.Statuses()[0]
+	assert.True(t, status.Healthy)
+	assert.False(t, status.GaveUp)
+	assert.Len(t, alerts.sent, 4)
+
+	// restarts older than the window don't count
+	now = now.Add(2 * time.Hour)
+	w.observe(ctx, testService, unhealthy)
+	w.observe(ctx, testService, unhealthy)
+	assert.Len(t, operations.restarts, 3)
+}
+
+func TestObserveFailedRestart(t *testing.T) {
+	operations := &fakeOperations{fail: true}
+	alerts := &fakeNotifications{}
+	now := time.Now()
+	w := newTestWatchdog(t, operations, alerts, &now)
+
+	w.observe(context.Background(), testService, errors.New("timeout"))
+	w.observe(context.Background(), testService, errors.New("timeout"))
+
+	require.Len(t, alerts.sent, 1)
+	assert.Equal(t, notifications.CRITICAL, alerts.sent[0].Severity)
+	assert.Contains(t, alerts.sent[0].Content, "container not found")
+}
+
+func TestBackoff(t *testing.T) {
+	now := time.Now()
+	w := newTestWatchdog(t, &fakeOperations{}, nil, &now)
+
+	assert.Equal(t, time.Minute, w.backoff(1))
+	assert.Equal(t, 2*time.Minute, w.backoff(2))
+	assert.Equal(t, 3*time.Minute, w.backoff(3))
+	assert.Equal(t, 3*time.Minute, w.backoff(10))
+}
+
+func TestNewInvalidPolicy(t *testing.T) {
+	noInterval := testInfo
+	noInterval.Interval = ""
+	noThreshold := testInfo
+	noThreshold.FailureThreshold = 0
+	badWindow := testInfo
+	badWindow.Window = "hourly"
+
+	for name, info := range map[string]config.WatchdogInfo{
+		"No interval":  noInterval,
+		"No threshold": noThreshold,
+		"Bad window":   badWindow,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(info, nil, nil, &fakeOperations{}, nil, logger.NewMockClient())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPingCheck(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("pong"))
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	check := PingCheck(&http.Client{})
+	assert.NoError(t, check(context.Background(), healthy.URL))
+	assert.Error(t, check(context.Background(), unhealthy.URL))
+}
@@ -25,6 +25,7 @@ const (
 	Stop    = "stop"
 	Restart = "restart"
 	Metrics = system.Metrics
+	Logs    = system.Logs
 
 	executorType        = "docker"
 	failedStartPrefix   = "Error starting service"
@@ -314,3 +314,61 @@ func TestMissingArguments(t *testing.T) {
 	assert.Equal(t, 0, executor.Called)
 	assert.Equal(t, system.Failure("", "", executorType, messageMissingArguments()), result)
 }
+
+func TestLogsArguments(t *testing.T) {
+	const stoppedContainer = `[{"State": {"Running": false}}]`
+	tests := []struct {
+		name                 string
+		args                 []string
+		expectedArguments    []string
+		expectedErrorMessage string
+		executorCalls        []executorStubCall
+	}{
+		{
+			"missing arguments",
+			[]string{executableName, serviceName},
+			nil,
+			messageMissingArguments(),
+			nil,
+		},
+		{
+			"container not found",
+			executeArguments(serviceName, Logs),
+			nil,
+			messageContainerNotFound(serviceName),
+			[]executorStubCall{{[]string{inspect, serviceName}, []byte("[]"), nil}},
+		},
+		{
+			"all logs of a stopped container",
+			executeArguments(serviceName, Logs),
+			[]string{Logs, serviceName},
+			"",
+			[]executorStubCall{{[]string{inspect, serviceName}, []byte(stoppedContainer), nil}},
+		},
+		{
+			"since and tail",
+			append(executeArguments(serviceName, Logs), "10m", "50"),
+			[]string{Logs, "--since", "10m", "--tail", "50", serviceName},
+			"",
+			[]executorStubCall{{[]string{inspect, serviceName}, []byte(stoppedContainer), nil}},
+		},
+		{
+			"tail only",
+			append(executeArguments(serviceName, Logs), "", "50"),
+			[]string{Logs, "--tail", "50", serviceName},
+			"",
+			[]executorStubCall{{[]string{inspect, serviceName}, []byte(stoppedContainer), nil}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executor := newExecutor(test.executorCalls)
+
+			arguments, errorMessage := LogsArguments(test.args, executor.commandExecutor)
+
+			assert.Equal(t, test.expectedArguments, arguments)
+			assert.Equal(t, test.expectedErrorMessage, errorMessage)
+			assert.Equal(t, len(test.executorCalls), executor.Called)
+		})
+	}
+}
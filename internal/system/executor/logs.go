@@ -0,0 +1,38 @@
+/*******************************************************************************
+ * Copyright 2026 EdgeX Foundry Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package executor
+
+// LogsArguments returns the Docker command to be executed to retrieve the logs of the service named in args, which
+// are the executor's command line arguments: <executable> <service> logs [since] [tail]. An error message is returned
+// instead if the service's container can't be found, so the executor can report it before any logs are written.
+func LogsArguments(args []string, executor CommandExecutor) ([]string, string) {
+	if len(args) < 3 {
+		return nil, messageMissingArguments()
+	}
+
+	service := args[1]
+	if _, errorMessage := isContainerRunning(service, executor); errorMessage != "" {
+		return nil, errorMessage
+	}
+
+	arguments := []string{Logs}
+	if len(args) > 3 && args[3] != "" {
+		arguments = append(arguments, "--since", args[3])
+	}
+	if len(args) > 4 && args[4] != "" {
+		arguments = append(arguments, "--tail", args[4])
+	}
+	return append(arguments, service), ""
+}
@@ -16,7 +16,10 @@ package system
 
 import "encoding/json"
 
-const Metrics = "metrics"
+const (
+	Metrics = "metrics"
+	Logs    = "logs"
+)
 
 // Result provides a generic interface implemented by receivers intended to return their struct as a request result.
 type Result interface {